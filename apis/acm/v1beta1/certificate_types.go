@@ -37,9 +37,49 @@ type DomainValidationOption struct {
 	// +immutable
 	DomainName string `json:"domainName"`
 
-	// Method to validate certificate
+	// The domain that ACM sends validation emails to. Required when the
+	// certificate is validated with EMAIL.
 	// +immutable
-	ValidationDomain string `json:"validationDomain"`
+	// +optional
+	ValidationDomain string `json:"validationDomain,omitempty"`
+
+	// CreateRoute53Records creates the DNS validation record of the domain in
+	// the Route53 hosted zone given by HostedZoneID when the certificate is
+	// validated with DNS. The record is kept when the certificate is deleted,
+	// as ACM uses the same record for all certificates of the domain.
+	// +optional
+	CreateRoute53Records bool `json:"createRoute53Records,omitempty"`
+
+	// HostedZoneID is the ID of the Route53 hosted zone to create the DNS
+	// validation record in.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/route53/v1alpha1.HostedZone
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a HostedZone to retrieve its ID.
+	// +optional
+	HostedZoneIDRef *xpv1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a HostedZone to retrieve
+	// its ID.
+	// +optional
+	HostedZoneIDSelector *xpv1.Selector `json:"hostedZoneIdSelector,omitempty"`
+}
+
+// DomainValidation is the validation status of a domain of the certificate.
+type DomainValidation struct {
+	// Fully qualified domain name (FQDN) of the validated domain.
+	DomainName string `json:"domainName"`
+
+	// The validation status of the domain.
+	// +kubebuilder:validation:Enum=PENDING_VALIDATION;SUCCESS;FAILED
+	ValidationStatus string `json:"validationStatus,omitempty"`
+
+	// The method used to validate the domain.
+	ValidationMethod string `json:"validationMethod,omitempty"`
+
+	// The DNS record to create to validate the domain with DNS.
+	ResourceRecord *ResourceRecord `json:"resourceRecord,omitempty"`
 }
 
 // CertificateSpec defines the desired state of Certificate
@@ -74,6 +114,9 @@ type CertificateExternalStatus struct {
 	// "_a79865eb4cd1a6ab990a45779b4e0b96.yourdomain.com", only
 	// "_a79865eb4cd1a6ab990a45779b4e0b96" must be used.
 	ResourceRecord *ResourceRecord `json:"resourceRecord,omitempty"`
+
+	// The validation status of each domain of the certificate.
+	DomainValidations []DomainValidation `json:"domainValidations,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
@@ -166,10 +209,11 @@ type CertificateOptions struct {
 // +kubebuilder:storageversion
 
 // Certificate is a managed resource that represents an AWS Certificate Manager.
-// +kubebuilder:printcolumn:name="DOMAINNAME",type="string",JSONPath=".spec.forProvider.domainName"
-// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DOMAINNAME",type="string",JSONPath=".spec.forProvider.domainName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -61,6 +61,13 @@ func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus
 		*out = new(ResourceRecord)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DomainValidations != nil {
+		in, out := &in.DomainValidations, &out.DomainValidations
+		*out = make([]DomainValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
@@ -145,7 +152,7 @@ func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(DomainValidationOption)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -216,9 +223,44 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainValidation) DeepCopyInto(out *DomainValidation) {
+	*out = *in
+	if in.ResourceRecord != nil {
+		in, out := &in.ResourceRecord, &out.ResourceRecord
+		*out = new(ResourceRecord)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainValidation.
+func (in *DomainValidation) DeepCopy() *DomainValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainValidationOption) DeepCopyInto(out *DomainValidationOption) {
 	*out = *in
+	if in.HostedZoneID != nil {
+		in, out := &in.HostedZoneID, &out.HostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostedZoneIDRef != nil {
+		in, out := &in.HostedZoneIDRef, &out.HostedZoneIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.HostedZoneIDSelector != nil {
+		in, out := &in.HostedZoneIDSelector, &out.HostedZoneIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainValidationOption.
@@ -22,6 +22,7 @@ import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	v1beta1 "github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
+	v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -49,5 +50,23 @@ func (mg *Certificate) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.CertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CertificateAuthorityARNRef = rsp.ResolvedReference
 
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.DomainValidationOptions); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DomainValidationOptions[i3].HostedZoneID),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.DomainValidationOptions[i3].HostedZoneIDRef,
+			Selector:     mg.Spec.ForProvider.DomainValidationOptions[i3].HostedZoneIDSelector,
+			To: reference.To{
+				List:    &v1alpha1.HostedZoneList{},
+				Managed: &v1alpha1.HostedZone{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.DomainValidationOptions[i3].HostedZoneID")
+		}
+		mg.Spec.ForProvider.DomainValidationOptions[i3].HostedZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.DomainValidationOptions[i3].HostedZoneIDRef = rsp.ResolvedReference
+
+	}
 	return nil
 }
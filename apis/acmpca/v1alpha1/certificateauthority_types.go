@@ -192,10 +192,11 @@ type CertificateAuthorityStatus struct {
 // +kubebuilder:object:root=true
 
 // CertificateAuthority is a managed resource that represents an AWS CertificateAuthority Manager.
-// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
-// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -42,6 +42,14 @@ type CertificateAuthorityParameters struct {
 	// +optional
 	PermanentDeletionTimeInDays *int32 `json:"permanentDeletionTimeInDays,omitempty"`
 
+	// Activation of the certificate authority by installing its CA
+	// certificate while it is pending one. The CA certificate is imported if
+	// a secret containing it is referenced, otherwise it is issued by the
+	// parent certificate authority or, for a ROOT, by the certificate
+	// authority itself.
+	// +optional
+	Activation *Activation `json:"activation,omitempty"`
+
 	// Status of the certificate authority.
 	// This value cannot be configured at creation, but can be updated to set a
 	// CA to ACTIVE or DISABLED.
@@ -71,8 +79,17 @@ type RevocationConfiguration struct {
 
 	// Name of the S3 bucket that contains the CRL
 	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/s3/v1beta1.Bucket
 	S3BucketName *string `json:"s3BucketName,omitempty"`
 
+	// S3BucketNameRef references a Bucket to retrieve its name
+	// +optional
+	S3BucketNameRef *xpv1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket to retrieve its name
+	// +optional
+	S3BucketNameSelector *xpv1.Selector `json:"s3BucketNameSelector,omitempty"`
+
 	// Alias for the CRL distribution point
 	// +optional
 	CustomCname *string `json:"customCname,omitempty"`
@@ -82,6 +99,67 @@ type RevocationConfiguration struct {
 	ExpirationInDays *int32 `json:"expirationInDays,omitempty"`
 }
 
+// Activation configures how the CA certificate of a certificate authority is
+// installed.
+type Activation struct {
+
+	// ARN of the parent certificate authority that issues the CA certificate
+	// of a SUBORDINATE certificate authority.
+	// +optional
+	// +crossplane:generate:reference:type=CertificateAuthority
+	ParentCertificateAuthorityARN *string `json:"parentCertificateAuthorityARN,omitempty"`
+
+	// ParentCertificateAuthorityARNRef references a CertificateAuthority to
+	// retrieve its Arn
+	// +optional
+	ParentCertificateAuthorityARNRef *xpv1.Reference `json:"parentCertificateAuthorityARNRef,omitempty"`
+
+	// ParentCertificateAuthorityARNSelector selects a reference to a
+	// CertificateAuthority to retrieve its Arn
+	// +optional
+	ParentCertificateAuthorityARNSelector *xpv1.Selector `json:"parentCertificateAuthorityARNSelector,omitempty"`
+
+	// ARN of the template used to issue the CA certificate. Defaults to
+	// RootCACertificate/V1 for a ROOT and to
+	// SubordinateCACertificate_PathLen0/V1 for a SUBORDINATE certificate
+	// authority.
+	// +optional
+	TemplateARN *string `json:"templateARN,omitempty"`
+
+	// Algorithm the issuing certificate authority uses to sign the CA
+	// certificate. Defaults to the signing algorithm of this certificate
+	// authority.
+	// +optional
+	// +kubebuilder:validation:Enum=SHA512WITHECDSA;SHA256WITHECDSA;SHA384WITHECDSA;SHA512WITHRSA;SHA256WITHRSA;SHA384WITHRSA
+	SigningAlgorithm *types.SigningAlgorithm `json:"signingAlgorithm,omitempty"`
+
+	// Validity of the issued CA certificate. Defaults to 10 years.
+	// +optional
+	Validity *Validity `json:"validity,omitempty"`
+
+	// CertificateSecretRef references the key of a secret that contains the
+	// PEM encoded CA certificate to import instead of issuing one.
+	// +optional
+	CertificateSecretRef *xpv1.SecretKeySelector `json:"certificateSecretRef,omitempty"`
+
+	// CertificateChainSecretRef references the key of a secret that contains
+	// the PEM encoded certificate chain of the imported CA certificate.
+	// +optional
+	CertificateChainSecretRef *xpv1.SecretKeySelector `json:"certificateChainSecretRef,omitempty"`
+}
+
+// Validity is the period of time an issued certificate is valid for
+type Validity struct {
+
+	// Type of the validity value
+	// +kubebuilder:validation:Enum=END_DATE;ABSOLUTE;DAYS;MONTHS;YEARS
+	Type types.ValidityPeriodType `json:"type"`
+
+	// Number of days, months or years, or the end of the validity as a
+	// YYYYMMDDHHMMSS timestamp for END_DATE or Unix time for ABSOLUTE
+	Value int64 `json:"value"`
+}
+
 // CertificateAuthorityConfiguration is
 type CertificateAuthorityConfiguration struct {
 
@@ -193,10 +271,11 @@ type CertificateAuthorityStatus struct {
 // +kubebuilder:storageversion
 
 // CertificateAuthority is a managed resource that represents an AWS CertificateAuthority Manager.
-// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
-// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".spec.forProvider.status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -53,7 +53,9 @@ type CertificateAuthorityPermissionParameters struct {
 	// +immutable
 	CertificateAuthorityARNSelector *xpv1.Selector `json:"certificateAuthorityARNSelector,omitempty"`
 
-	// The actions that the specified AWS service principal can use.
+	// The actions that the specified AWS service principal can use. Defaults
+	// to IssueCertificate, GetCertificate and ListPermissions, which ACM
+	// needs to renew the certificates issued by the certificate authority.
 	// +optional
 	// +immutable
 	Actions []string `json:"actions,omitempty"`
@@ -76,6 +78,7 @@ type CertificateAuthorityPermissionParameters struct {
 // CertificateAuthorityPermission is a managed resource that represents an AWS CertificateAuthorityPermission Manager.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
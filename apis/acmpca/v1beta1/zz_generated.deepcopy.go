@@ -22,10 +22,66 @@ limitations under the License.
 package v1beta1
 
 import (
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Activation) DeepCopyInto(out *Activation) {
+	*out = *in
+	if in.ParentCertificateAuthorityARN != nil {
+		in, out := &in.ParentCertificateAuthorityARN, &out.ParentCertificateAuthorityARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARNRef != nil {
+		in, out := &in.ParentCertificateAuthorityARNRef, &out.ParentCertificateAuthorityARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ParentCertificateAuthorityARNSelector != nil {
+		in, out := &in.ParentCertificateAuthorityARNSelector, &out.ParentCertificateAuthorityARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateARN != nil {
+		in, out := &in.TemplateARN, &out.TemplateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SigningAlgorithm != nil {
+		in, out := &in.SigningAlgorithm, &out.SigningAlgorithm
+		*out = new(types.SigningAlgorithm)
+		**out = **in
+	}
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(Validity)
+		**out = **in
+	}
+	if in.CertificateSecretRef != nil {
+		in, out := &in.CertificateSecretRef, &out.CertificateSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.CertificateChainSecretRef != nil {
+		in, out := &in.CertificateChainSecretRef, &out.CertificateChainSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Activation.
+func (in *Activation) DeepCopy() *Activation {
+	if in == nil {
+		return nil
+	}
+	out := new(Activation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateAuthority) DeepCopyInto(out *CertificateAuthority) {
 	*out = *in
@@ -130,6 +186,11 @@ func (in *CertificateAuthorityParameters) DeepCopyInto(out *CertificateAuthority
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Activation != nil {
+		in, out := &in.Activation, &out.Activation
+		*out = new(Activation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -326,6 +387,16 @@ func (in *RevocationConfiguration) DeepCopyInto(out *RevocationConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.S3BucketNameRef != nil {
+		in, out := &in.S3BucketNameRef, &out.S3BucketNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.S3BucketNameSelector != nil {
+		in, out := &in.S3BucketNameSelector, &out.S3BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CustomCname != nil {
 		in, out := &in.CustomCname, &out.CustomCname
 		*out = new(string)
@@ -417,3 +488,18 @@ func (in *Tag) DeepCopy() *Tag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Validity) DeepCopyInto(out *Validity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Validity.
+func (in *Validity) DeepCopy() *Validity {
+	if in == nil {
+		return nil
+	}
+	out := new(Validity)
+	in.DeepCopyInto(out)
+	return out
+}
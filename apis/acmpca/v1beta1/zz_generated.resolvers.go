@@ -21,10 +21,58 @@ package v1beta1
 import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this CertificateAuthority.
+func (mg *CertificateAuthority) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	if mg.Spec.ForProvider.RevocationConfiguration != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RevocationConfiguration.S3BucketName),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.RevocationConfiguration.S3BucketNameRef,
+			Selector:     mg.Spec.ForProvider.RevocationConfiguration.S3BucketNameSelector,
+			To: reference.To{
+				List:    &v1beta1.BucketList{},
+				Managed: &v1beta1.Bucket{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.RevocationConfiguration.S3BucketName")
+		}
+		mg.Spec.ForProvider.RevocationConfiguration.S3BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.RevocationConfiguration.S3BucketNameRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.Activation != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARN),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARNRef,
+			Selector:     mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARNSelector,
+			To: reference.To{
+				List:    &CertificateAuthorityList{},
+				Managed: &CertificateAuthority{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARN")
+		}
+		mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Activation.ParentCertificateAuthorityARNRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
 // ResolveReferences of this CertificateAuthorityPermission.
 func (mg *CertificateAuthorityPermission) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// Import modes of a RestAPI body.
+const (
+	// RestAPIModeMerge merges the body into the existing definition of the
+	// RestAPI.
+	RestAPIModeMerge = "merge"
+
+	// RestAPIModeOverwrite replaces the existing definition of the RestAPI
+	// with the body.
+	RestAPIModeOverwrite = "overwrite"
+)
+
+// CustomRestAPIParameters includes the custom fields.
+type CustomRestAPIParameters struct {
+	// Body is an OpenAPI 2.0 or 3.0 definition of the RestAPI in JSON or
+	// YAML. The definition is imported into the RestAPI after it has been
+	// created and is put again whenever it changes. Resources, Methods and
+	// integrations described by the definition are owned by the body and
+	// should not be managed by other resources at the same time.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// Mode decides whether the Body is merged into the existing definition
+	// or overwrites it. Defaults to overwrite.
+	// +optional
+	// +kubebuilder:validation:Enum=merge;overwrite
+	Mode *string `json:"mode,omitempty"`
+
+	// FailOnWarnings rolls back the import of the Body when a warning is
+	// encountered.
+	// +optional
+	FailOnWarnings *bool `json:"failOnWarnings,omitempty"`
+
+	// Parameters are the custom header parameters of the import, such as
+	// endpointConfigurationTypes, ignore or basepath.
+	// +optional
+	Parameters map[string]*string `json:"parameters,omitempty"`
+}
+
+// CustomResourceParameters includes the custom fields.
+type CustomResourceParameters struct {
+	// RestAPIID is the ID of the RestAPI the Resource belongs to.
+	// +immutable
+	// +crossplane:generate:reference:type=RestAPI
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set the RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set the
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// ParentID is the ID of the parent Resource. The root Resource of the
+	// RestAPI is used when it is not set.
+	// +optional
+	// +crossplane:generate:reference:type=Resource
+	ParentID *string `json:"parentId,omitempty"`
+
+	// ParentIDRef is a reference to a Resource used to set the ParentID.
+	// +optional
+	ParentIDRef *xpv1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects a reference to a Resource used to set the
+	// ParentID.
+	// +optional
+	ParentIDSelector *xpv1.Selector `json:"parentIdSelector,omitempty"`
+}
+
+// CustomMethodParameters includes the custom fields.
+type CustomMethodParameters struct {
+	// RestAPIID is the ID of the RestAPI the Method belongs to.
+	// +immutable
+	// +crossplane:generate:reference:type=RestAPI
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set the RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set the
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// ResourceID is the ID of the Resource the Method belongs to. The root
+	// Resource of the RestAPI is used when it is not set.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Resource
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ResourceIDRef is a reference to a Resource used to set the ResourceID.
+	// +optional
+	ResourceIDRef *xpv1.Reference `json:"resourceIdRef,omitempty"`
+
+	// ResourceIDSelector selects a reference to a Resource used to set the
+	// ResourceID.
+	// +optional
+	ResourceIDSelector *xpv1.Selector `json:"resourceIdSelector,omitempty"`
+}
+
+// CustomDeploymentParameters includes the custom fields.
+type CustomDeploymentParameters struct {
+	// RestAPIID is the ID of the RestAPI that is deployed.
+	// +immutable
+	// +crossplane:generate:reference:type=RestAPI
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set the RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set the
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+}
+
+// CustomStageParameters includes the custom fields.
+type CustomStageParameters struct {
+	// RestAPIID is the ID of the RestAPI the Stage belongs to.
+	// +immutable
+	// +crossplane:generate:reference:type=RestAPI
+	RestAPIID *string `json:"restApiId,omitempty"`
+
+	// RestAPIIDRef is a reference to a RestAPI used to set the RestAPIID.
+	// +optional
+	RestAPIIDRef *xpv1.Reference `json:"restApiIdRef,omitempty"`
+
+	// RestAPIIDSelector selects a reference to a RestAPI used to set the
+	// RestAPIID.
+	// +optional
+	RestAPIIDSelector *xpv1.Selector `json:"restApiIdSelector,omitempty"`
+
+	// DeploymentID is the ID of the Deployment the Stage points to.
+	// +crossplane:generate:reference:type=Deployment
+	DeploymentID *string `json:"deploymentId,omitempty"`
+
+	// DeploymentIDRef is a reference to a Deployment used to set the
+	// DeploymentID.
+	// +optional
+	DeploymentIDRef *xpv1.Reference `json:"deploymentIdRef,omitempty"`
+
+	// DeploymentIDSelector selects a reference to a Deployment used to set
+	// the DeploymentID.
+	// +optional
+	DeploymentIDSelector *xpv1.Selector `json:"deploymentIdSelector,omitempty"`
+}
+
+// CustomUsagePlanParameters includes the custom fields.
+type CustomUsagePlanParameters struct{}
+
+// CustomAPIKeyParameters includes the custom fields.
+type CustomAPIKeyParameters struct {
+	// UsagePlanIDs are the IDs of the UsagePlans the APIKey is added to.
+	// +optional
+	// +crossplane:generate:reference:type=UsagePlan
+	UsagePlanIDs []*string `json:"usagePlanIds,omitempty"`
+
+	// UsagePlanIDRefs are references to UsagePlans used to set the
+	// UsagePlanIDs.
+	// +optional
+	UsagePlanIDRefs []xpv1.Reference `json:"usagePlanIdRefs,omitempty"`
+
+	// UsagePlanIDSelector selects references to UsagePlans used to set the
+	// UsagePlanIDs.
+	// +optional
+	UsagePlanIDSelector *xpv1.Selector `json:"usagePlanIdSelector,omitempty"`
+}
@@ -114,7 +114,33 @@ type CustomDeploymentParameters struct {
 }
 
 // CustomDomainNameParameters includes the custom fields.
-type CustomDomainNameParameters struct{}
+type CustomDomainNameParameters struct {
+	// CertificateARNRef is a reference to an ACM Certificate used to set
+	// the CertificateARN of the first domain name configuration.
+	// +optional
+	CertificateARNRef *xpv1.Reference `json:"certificateARNRef,omitempty"`
+
+	// CertificateARNSelector selects references to an ACM Certificate used
+	// to set the CertificateARN of the first domain name configuration.
+	// +optional
+	CertificateARNSelector *xpv1.Selector `json:"certificateARNSelector,omitempty"`
+}
+
+// CustomDomainNameObservation includes the custom status fields of
+// DomainName.
+type CustomDomainNameObservation struct {
+	// RegionalDomainName is the domain name of the API Gateway endpoint that
+	// serves the custom domain name. Route53 alias records point to it.
+	RegionalDomainName *string `json:"regionalDomainName,omitempty"`
+
+	// RegionalHostedZoneID is the ID of the Route53 hosted zone of the
+	// regional domain name, to be used as the hosted zone of alias records.
+	RegionalHostedZoneID *string `json:"regionalHostedZoneID,omitempty"`
+
+	// TruststoreWarnings are the warnings API Gateway found while validating
+	// the mutual TLS truststore.
+	TruststoreWarnings []*string `json:"truststoreWarnings,omitempty"`
+}
 
 // ResponseParameters is a map of status codes and transform operations on each
 // of them.
@@ -246,6 +272,16 @@ type CustomRouteParameters struct {
 	// to set the AuthorizerID.
 	// +optional
 	AuthorizerIDSelector *xpv1.Selector `json:"authorizerIDSelector,omitempty"`
+
+	// TargetIntegrationRef is a reference to an Integration used to set
+	// the Target to integrations/{IntegrationID}.
+	// +optional
+	TargetIntegrationRef *xpv1.Reference `json:"targetIntegrationRef,omitempty"`
+
+	// TargetIntegrationSelector selects references to Integration used
+	// to set the Target to integrations/{IntegrationID}.
+	// +optional
+	TargetIntegrationSelector *xpv1.Selector `json:"targetIntegrationSelector,omitempty"`
 }
 
 // CustomRouteResponseParameters includes the custom fields.
@@ -323,4 +359,24 @@ type CustomStageParameters struct {
 	// to set the APIID.
 	// +optional
 	APIIDSelector *xpv1.Selector `json:"apiIdSelector,omitempty"`
+
+	// DeploymentIDRef is a reference to a Deployment used to set
+	// the DeploymentID.
+	// +optional
+	DeploymentIDRef *xpv1.Reference `json:"deploymentIdRef,omitempty"`
+
+	// DeploymentIDSelector selects references to Deployment used
+	// to set the DeploymentID.
+	// +optional
+	DeploymentIDSelector *xpv1.Selector `json:"deploymentIdSelector,omitempty"`
+
+	// AccessLogDestinationARNRef is a reference to a CloudWatch LogGroup used
+	// to set AccessLogSettings.DestinationARN.
+	// +optional
+	AccessLogDestinationARNRef *xpv1.Reference `json:"accessLogDestinationArnRef,omitempty"`
+
+	// AccessLogDestinationARNSelector selects a reference to a CloudWatch
+	// LogGroup used to set AccessLogSettings.DestinationARN.
+	// +optional
+	AccessLogDestinationARNSelector *xpv1.Selector `json:"accessLogDestinationArnSelector,omitempty"`
 }
@@ -19,13 +19,29 @@ package v1alpha1
 import (
 	"context"
 
+	acm "github.com/crossplane/provider-aws/apis/acm/v1beta1"
+	cwlogs "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// IntegrationTarget returns the Route target of an Integration, which is its
+// ID prefixed with "integrations/".
+func IntegrationTarget() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		id := meta.GetExternalName(mg)
+		if id == "" {
+			return ""
+		}
+		return "integrations/" + id
+	}
+}
+
 // ResolveReferences of this Stage
 func (mg *Stage) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -43,6 +59,65 @@ func (mg *Stage) ResolveReferences(ctx context.Context, c client.Reader) error {
 	}
 	mg.Spec.ForProvider.APIID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.APIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.deploymentId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DeploymentID),
+		Reference:    mg.Spec.ForProvider.DeploymentIDRef,
+		Selector:     mg.Spec.ForProvider.DeploymentIDSelector,
+		To:           reference.To{Managed: &Deployment{}, List: &DeploymentList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.deploymentId")
+	}
+	mg.Spec.ForProvider.DeploymentID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DeploymentIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.accessLogSettings.destinationARN
+	if mg.Spec.ForProvider.AccessLogDestinationARNRef != nil || mg.Spec.ForProvider.AccessLogDestinationARNSelector != nil {
+		if mg.Spec.ForProvider.AccessLogSettings == nil {
+			mg.Spec.ForProvider.AccessLogSettings = &AccessLogSettings{}
+		}
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AccessLogSettings.DestinationARN),
+			Reference:    mg.Spec.ForProvider.AccessLogDestinationARNRef,
+			Selector:     mg.Spec.ForProvider.AccessLogDestinationARNSelector,
+			To:           reference.To{Managed: &cwlogs.LogGroup{}, List: &cwlogs.LogGroupList{}},
+			Extract:      cwlogs.LogGroupARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.accessLogSettings.destinationARN")
+		}
+		mg.Spec.ForProvider.AccessLogSettings.DestinationARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.AccessLogDestinationARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this DomainName
+func (mg *DomainName) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.domainNameConfigurations[0].certificateARN
+	if mg.Spec.ForProvider.CertificateARNRef != nil || mg.Spec.ForProvider.CertificateARNSelector != nil {
+		if len(mg.Spec.ForProvider.DomainNameConfigurations) == 0 {
+			mg.Spec.ForProvider.DomainNameConfigurations = []*DomainNameConfiguration{{}}
+		}
+		cfg := mg.Spec.ForProvider.DomainNameConfigurations[0]
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(cfg.CertificateARN),
+			Reference:    mg.Spec.ForProvider.CertificateARNRef,
+			Selector:     mg.Spec.ForProvider.CertificateARNSelector,
+			To:           reference.To{Managed: &acm.Certificate{}, List: &acm.CertificateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.domainNameConfigurations[0].certificateARN")
+		}
+		cfg.CertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CertificateARNRef = rsp.ResolvedReference
+	}
 	return nil
 }
 
@@ -78,6 +153,20 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.AuthorizerID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.AuthorizerIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.target
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Target),
+		Reference:    mg.Spec.ForProvider.TargetIntegrationRef,
+		Selector:     mg.Spec.ForProvider.TargetIntegrationSelector,
+		To:           reference.To{Managed: &Integration{}, List: &IntegrationList{}},
+		Extract:      IntegrationTarget(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.target")
+	}
+	mg.Spec.ForProvider.Target = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetIntegrationRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -49,6 +49,8 @@ type DomainNameObservation struct {
 	APIMappingSelectionExpression *string `json:"apiMappingSelectionExpression,omitempty"`
 
 	DomainName *string `json:"domainName,omitempty"`
+
+	CustomDomainNameObservation `json:",inline"`
 }
 
 // DomainNameStatus defines the observed state of DomainName.
@@ -63,6 +65,8 @@ type DomainNameStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.regionalDomainName",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -1061,9 +1061,55 @@ func (in *CustomDeploymentParameters) DeepCopy() *CustomDeploymentParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDomainNameObservation) DeepCopyInto(out *CustomDomainNameObservation) {
+	*out = *in
+	if in.RegionalDomainName != nil {
+		in, out := &in.RegionalDomainName, &out.RegionalDomainName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegionalHostedZoneID != nil {
+		in, out := &in.RegionalHostedZoneID, &out.RegionalHostedZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TruststoreWarnings != nil {
+		in, out := &in.TruststoreWarnings, &out.TruststoreWarnings
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainNameObservation.
+func (in *CustomDomainNameObservation) DeepCopy() *CustomDomainNameObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainNameObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDomainNameParameters) DeepCopyInto(out *CustomDomainNameParameters) {
 	*out = *in
+	if in.CertificateARNRef != nil {
+		in, out := &in.CertificateARNRef, &out.CertificateARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CertificateARNSelector != nil {
+		in, out := &in.CertificateARNSelector, &out.CertificateARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainNameParameters.
@@ -1216,6 +1262,17 @@ func (in *CustomRouteParameters) DeepCopyInto(out *CustomRouteParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetIntegrationRef != nil {
+		in, out := &in.TargetIntegrationRef, &out.TargetIntegrationRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.TargetIntegrationSelector != nil {
+		in, out := &in.TargetIntegrationSelector, &out.TargetIntegrationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRouteParameters.
@@ -1291,6 +1348,27 @@ func (in *CustomStageParameters) DeepCopyInto(out *CustomStageParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeploymentIDRef != nil {
+		in, out := &in.DeploymentIDRef, &out.DeploymentIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DeploymentIDSelector != nil {
+		in, out := &in.DeploymentIDSelector, &out.DeploymentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+
+	if in.AccessLogDestinationARNRef != nil {
+		in, out := &in.AccessLogDestinationARNRef, &out.AccessLogDestinationARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.AccessLogDestinationARNSelector != nil {
+		in, out := &in.AccessLogDestinationARNSelector, &out.AccessLogDestinationARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomStageParameters.
@@ -1686,6 +1764,7 @@ func (in *DomainNameObservation) DeepCopyInto(out *DomainNameObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	in.CustomDomainNameObservation.DeepCopyInto(&out.CustomDomainNameObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNameObservation.
@@ -1732,7 +1811,7 @@ func (in *DomainNameParameters) DeepCopyInto(out *DomainNameParameters) {
 			(*out)[key] = outVal
 		}
 	}
-	out.CustomDomainNameParameters = in.CustomDomainNameParameters
+	in.CustomDomainNameParameters.DeepCopyInto(&out.CustomDomainNameParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNameParameters.
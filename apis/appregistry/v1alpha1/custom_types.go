@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomApplicationParameters includes custom additional fields for ApplicationParameters.
+type CustomApplicationParameters struct {
+	// The IDs of the attribute groups that are associated with the
+	// application.
+	// +optional
+	// +crossplane:generate:reference:type=AttributeGroup
+	// +crossplane:generate:reference:refFieldName=AttributeGroupRefs
+	// +crossplane:generate:reference:selectorFieldName=AttributeGroupSelector
+	AttributeGroups []*string `json:"attributeGroups,omitempty"`
+
+	// AttributeGroupRefs is a list of references to AttributeGroups used to
+	// set the AttributeGroups.
+	// +optional
+	AttributeGroupRefs []xpv1.Reference `json:"attributeGroupRefs,omitempty"`
+
+	// AttributeGroupSelector selects references to AttributeGroups used to
+	// set the AttributeGroups.
+	// +optional
+	AttributeGroupSelector *xpv1.Selector `json:"attributeGroupSelector,omitempty"`
+}
+
+// CustomAttributeGroupParameters includes custom additional fields for AttributeGroupParameters.
+type CustomAttributeGroupParameters struct{}
+
+// CustomResourceAssociationParameters includes custom additional fields for ResourceAssociationParameters.
+type CustomResourceAssociationParameters struct {
+	// The ID of the application the resource is associated with.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Application
+	Application *string `json:"application,omitempty"`
+
+	// ApplicationRef is a reference to an Application used to set the
+	// Application.
+	// +optional
+	ApplicationRef *xpv1.Reference `json:"applicationRef,omitempty"`
+
+	// ApplicationSelector selects references to an Application used to set
+	// the Application.
+	// +optional
+	ApplicationSelector *xpv1.Selector `json:"applicationSelector,omitempty"`
+}
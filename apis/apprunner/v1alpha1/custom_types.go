@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomAutoScalingConfigurationParameters includes custom additional fields for AutoScalingConfigurationParameters.
+type CustomAutoScalingConfigurationParameters struct{}
+
+// CustomServiceParameters includes custom additional fields for ServiceParameters.
+type CustomServiceParameters struct {
+	// The Amazon Resource Name (ARN) of the App Runner automatic scaling
+	// configuration that is associated with the service. App Runner uses its
+	// default configuration if none is set.
+	// +optional
+	// +crossplane:generate:reference:type=AutoScalingConfiguration
+	AutoScalingConfigurationARN *string `json:"autoScalingConfigurationARN,omitempty"`
+
+	// AutoScalingConfigurationARNRef is a reference to an
+	// AutoScalingConfiguration used to set the AutoScalingConfigurationARN.
+	// +optional
+	AutoScalingConfigurationARNRef *xpv1.Reference `json:"autoScalingConfigurationARNRef,omitempty"`
+
+	// AutoScalingConfigurationARNSelector selects a reference to an
+	// AutoScalingConfiguration used to set the AutoScalingConfigurationARN.
+	// +optional
+	AutoScalingConfigurationARNSelector *xpv1.Selector `json:"autoScalingConfigurationARNSelector,omitempty"`
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomFleetParameters includes custom additional fields for FleetParameters.
+type CustomFleetParameters struct {
+	// The VPC configuration for the fleet.
+	// +optional
+	VPCConfig *CustomVPCConfig `json:"vpcConfig,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to apply to the fleet.
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef is a reference to an IAM Role used to set
+	// the IAMRoleARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects references to IAM Role used
+	// to set the IAMRoleARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleArnSelector,omitempty"`
+}
+
+// CustomImageBuilderParameters includes custom additional fields for ImageBuilderParameters.
+type CustomImageBuilderParameters struct {
+	// The VPC configuration for the image builder. You can specify only one
+	// subnet.
+	// +immutable
+	// +optional
+	VPCConfig *CustomVPCConfig `json:"vpcConfig,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to apply to the image
+	// builder.
+	// +immutable
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef is a reference to an IAM Role used to set
+	// the IAMRoleARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects references to IAM Role used
+	// to set the IAMRoleARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleArnSelector,omitempty"`
+}
+
+// CustomStackParameters includes custom additional fields for StackParameters.
+type CustomStackParameters struct{}
+
+// CustomVPCConfig describes the VPC configuration for fleets and image
+// builders.
+type CustomVPCConfig struct {
+	// The identifiers of the subnets to which a network interface is
+	// attached from the fleet instance or image builder instance.
+	// +optional
+	SubnetIDs []*string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set
+	// the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set
+	// the SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// The identifiers of the security groups for the fleet or image builder.
+	// +optional
+	SecurityGroupIDs []*string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
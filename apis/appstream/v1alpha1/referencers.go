@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+)
+
+// ResolveReferences of this Fleet
+func (mg *Fleet) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.iamRoleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMRoleARN),
+		Reference:    mg.Spec.ForProvider.IAMRoleARNRef,
+		Selector:     mg.Spec.ForProvider.IAMRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.iamRoleArn")
+	}
+	mg.Spec.ForProvider.IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IAMRoleARNRef = rsp.ResolvedReference
+
+	return resolveVPCConfig(ctx, r, mg.Spec.ForProvider.VPCConfig)
+}
+
+// ResolveReferences of this ImageBuilder
+func (mg *ImageBuilder) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.iamRoleArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMRoleARN),
+		Reference:    mg.Spec.ForProvider.IAMRoleARNRef,
+		Selector:     mg.Spec.ForProvider.IAMRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.iamRoleArn")
+	}
+	mg.Spec.ForProvider.IAMRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IAMRoleARNRef = rsp.ResolvedReference
+
+	return resolveVPCConfig(ctx, r, mg.Spec.ForProvider.VPCConfig)
+}
+
+func resolveVPCConfig(ctx context.Context, r *reference.APIResolver, cfg *CustomVPCConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.vpcConfig.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(cfg.SubnetIDs),
+		References:    cfg.SubnetIDRefs,
+		Selector:      cfg.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.subnetIds")
+	}
+	cfg.SubnetIDs = reference.ToPtrValues(mrsp.ResolvedValues)
+	cfg.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.vpcConfig.securityGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(cfg.SecurityGroupIDs),
+		References:    cfg.SecurityGroupIDRefs,
+		Selector:      cfg.SecurityGroupIDSelector,
+		To:            reference.To{Managed: &ec2.SecurityGroup{}, List: &ec2.SecurityGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcConfig.securityGroupIds")
+	}
+	cfg.SecurityGroupIDs = reference.ToPtrValues(mrsp.ResolvedValues)
+	cfg.SecurityGroupIDRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// ResolveReferences of this StackFleetAssociation
+func (mg *StackFleetAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.fleetName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FleetName),
+		Reference:    mg.Spec.ForProvider.FleetNameRef,
+		Selector:     mg.Spec.ForProvider.FleetNameSelector,
+		To:           reference.To{Managed: &Fleet{}, List: &FleetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.fleetName")
+	}
+	mg.Spec.ForProvider.FleetName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FleetNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.stackName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.StackName),
+		Reference:    mg.Spec.ForProvider.StackNameRef,
+		Selector:     mg.Spec.ForProvider.StackNameSelector,
+		To:           reference.To{Managed: &Stack{}, List: &StackList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.stackName")
+	}
+	mg.Spec.ForProvider.StackName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.StackNameRef = rsp.ResolvedReference
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: StackFleetAssociation is not generated since the association is not
+// a resource in the AppStream API.
+
+// StackFleetAssociationParameters defines the desired state of
+// StackFleetAssociation
+type StackFleetAssociationParameters struct {
+	// Region is which region the StackFleetAssociation will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name of the fleet.
+	// +immutable
+	// +optional
+	FleetName *string `json:"fleetName,omitempty"`
+
+	// FleetNameRef is a reference to a Fleet used to set the FleetName.
+	// +optional
+	FleetNameRef *xpv1.Reference `json:"fleetNameRef,omitempty"`
+
+	// FleetNameSelector selects references to a Fleet used to set the
+	// FleetName.
+	// +optional
+	FleetNameSelector *xpv1.Selector `json:"fleetNameSelector,omitempty"`
+
+	// The name of the stack.
+	// +immutable
+	// +optional
+	StackName *string `json:"stackName,omitempty"`
+
+	// StackNameRef is a reference to a Stack used to set the StackName.
+	// +optional
+	StackNameRef *xpv1.Reference `json:"stackNameRef,omitempty"`
+
+	// StackNameSelector selects references to a Stack used to set the
+	// StackName.
+	// +optional
+	StackNameSelector *xpv1.Selector `json:"stackNameSelector,omitempty"`
+}
+
+// StackFleetAssociationSpec defines the desired state of StackFleetAssociation
+type StackFleetAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StackFleetAssociationParameters `json:"forProvider"`
+}
+
+// StackFleetAssociationObservation defines the observed state of
+// StackFleetAssociation
+type StackFleetAssociationObservation struct{}
+
+// StackFleetAssociationStatus defines the observed state of
+// StackFleetAssociation.
+type StackFleetAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StackFleetAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackFleetAssociation is the Schema for the StackFleetAssociations API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STACK",type="string",JSONPath=".spec.forProvider.stackName"
+// +kubebuilder:printcolumn:name="FLEET",type="string",JSONPath=".spec.forProvider.fleetName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type StackFleetAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              StackFleetAssociationSpec   `json:"spec"`
+	Status            StackFleetAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackFleetAssociationList contains a list of StackFleetAssociations
+type StackFleetAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StackFleetAssociation `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	StackFleetAssociationKind             = "StackFleetAssociation"
+	StackFleetAssociationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: StackFleetAssociationKind}.String()
+	StackFleetAssociationKindAPIVersion   = StackFleetAssociationKind + "." + GroupVersion.String()
+	StackFleetAssociationGroupVersionKind = GroupVersion.WithKind(StackFleetAssociationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&StackFleetAssociation{}, &StackFleetAssociationList{})
+}
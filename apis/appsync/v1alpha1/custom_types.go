@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// DefaultSchemaKey is the key of the schema in a ConfigMap unless another one
+// is given.
+const DefaultSchemaKey = "schema.graphql"
+
+// A SchemaConfigMapReference is a reference to the key of a ConfigMap that
+// holds a GraphQL schema.
+type SchemaConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key of the schema in the ConfigMap. Defaults to schema.graphql.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// CustomGraphQLAPIParameters includes the custom fields.
+type CustomGraphQLAPIParameters struct {
+	// SchemaConfigMapRef references the ConfigMap key that holds the schema
+	// of the GraphQLAPI in the GraphQL schema definition language. The
+	// schema is created again whenever it differs from the one AppSync
+	// reports through introspection.
+	// +optional
+	SchemaConfigMapRef *SchemaConfigMapReference `json:"schemaConfigMapRef,omitempty"`
+}
+
+// CustomDataSourceParameters includes the custom fields.
+type CustomDataSourceParameters struct {
+	// APIID is the ID of the GraphQLAPI the DataSource belongs to.
+	// +immutable
+	APIID *string `json:"apiId,omitempty"`
+
+	// APIIDRef is a reference to a GraphQLAPI used to set the APIID.
+	// +optional
+	APIIDRef *xpv1.Reference `json:"apiIdRef,omitempty"`
+
+	// APIIDSelector selects a reference to a GraphQLAPI used to set the
+	// APIID.
+	// +optional
+	APIIDSelector *xpv1.Selector `json:"apiIdSelector,omitempty"`
+
+	// ServiceRoleARN is the ARN of the IAM role AppSync assumes to access
+	// the DataSource.
+	// +optional
+	ServiceRoleARN *string `json:"serviceRoleARN,omitempty"`
+
+	// ServiceRoleARNRef is a reference to an IAM Role used to set the
+	// ServiceRoleARN.
+	// +optional
+	ServiceRoleARNRef *xpv1.Reference `json:"serviceRoleARNRef,omitempty"`
+
+	// ServiceRoleARNSelector selects a reference to an IAM Role used to set
+	// the ServiceRoleARN.
+	// +optional
+	ServiceRoleARNSelector *xpv1.Selector `json:"serviceRoleARNSelector,omitempty"`
+
+	// DynamoDBTableNameRef is a reference to a DynamoDB Table used to set
+	// dynamodbConfig.tableName.
+	// +optional
+	DynamoDBTableNameRef *xpv1.Reference `json:"dynamodbTableNameRef,omitempty"`
+
+	// DynamoDBTableNameSelector selects a reference to a DynamoDB Table used
+	// to set dynamodbConfig.tableName.
+	// +optional
+	DynamoDBTableNameSelector *xpv1.Selector `json:"dynamodbTableNameSelector,omitempty"`
+
+	// LambdaFunctionARNRef is a reference to a Lambda Function used to set
+	// lambdaConfig.lambdaFunctionARN.
+	// +optional
+	LambdaFunctionARNRef *xpv1.Reference `json:"lambdaFunctionARNRef,omitempty"`
+
+	// LambdaFunctionARNSelector selects a reference to a Lambda Function
+	// used to set lambdaConfig.lambdaFunctionARN.
+	// +optional
+	LambdaFunctionARNSelector *xpv1.Selector `json:"lambdaFunctionARNSelector,omitempty"`
+}
+
+// CustomResolverParameters includes the custom fields.
+type CustomResolverParameters struct {
+	// APIID is the ID of the GraphQLAPI the Resolver belongs to.
+	// +immutable
+	APIID *string `json:"apiId,omitempty"`
+
+	// APIIDRef is a reference to a GraphQLAPI used to set the APIID.
+	// +optional
+	APIIDRef *xpv1.Reference `json:"apiIdRef,omitempty"`
+
+	// APIIDSelector selects a reference to a GraphQLAPI used to set the
+	// APIID.
+	// +optional
+	APIIDSelector *xpv1.Selector `json:"apiIdSelector,omitempty"`
+
+	// DataSourceName is the name of the DataSource of a UNIT Resolver.
+	// +optional
+	DataSourceName *string `json:"dataSourceName,omitempty"`
+
+	// DataSourceNameRef is a reference to a DataSource used to set the
+	// DataSourceName.
+	// +optional
+	DataSourceNameRef *xpv1.Reference `json:"dataSourceNameRef,omitempty"`
+
+	// DataSourceNameSelector selects a reference to a DataSource used to set
+	// the DataSourceName.
+	// +optional
+	DataSourceNameSelector *xpv1.Selector `json:"dataSourceNameSelector,omitempty"`
+}
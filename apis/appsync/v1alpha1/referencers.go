@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+)
+
+// ResolveReferences of this DataSource.
+func (mg *DataSource) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.apiId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.APIID),
+		Reference:    mg.Spec.ForProvider.APIIDRef,
+		Selector:     mg.Spec.ForProvider.APIIDSelector,
+		To:           reference.To{Managed: &GraphQLAPI{}, List: &GraphQLAPIList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.apiId")
+	}
+	mg.Spec.ForProvider.APIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.serviceRoleARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceRoleARN),
+		Reference:    mg.Spec.ForProvider.ServiceRoleARNRef,
+		Selector:     mg.Spec.ForProvider.ServiceRoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceRoleARN")
+	}
+	mg.Spec.ForProvider.ServiceRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceRoleARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dynamodbConfig.tableName
+	if mg.Spec.ForProvider.DynamoDBTableNameRef != nil || mg.Spec.ForProvider.DynamoDBTableNameSelector != nil {
+		if mg.Spec.ForProvider.DynamodbConfig == nil {
+			mg.Spec.ForProvider.DynamodbConfig = &DynamodbDataSourceConfig{}
+		}
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DynamodbConfig.TableName),
+			Reference:    mg.Spec.ForProvider.DynamoDBTableNameRef,
+			Selector:     mg.Spec.ForProvider.DynamoDBTableNameSelector,
+			To:           reference.To{Managed: &dynamodbv1alpha1.Table{}, List: &dynamodbv1alpha1.TableList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.dynamodbConfig.tableName")
+		}
+		mg.Spec.ForProvider.DynamodbConfig.TableName = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.DynamoDBTableNameRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.lambdaConfig.lambdaFunctionARN
+	if mg.Spec.ForProvider.LambdaFunctionARNRef != nil || mg.Spec.ForProvider.LambdaFunctionARNSelector != nil {
+		if mg.Spec.ForProvider.LambdaConfig == nil {
+			mg.Spec.ForProvider.LambdaConfig = &LambdaDataSourceConfig{}
+		}
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LambdaConfig.LambdaFunctionARN),
+			Reference:    mg.Spec.ForProvider.LambdaFunctionARNRef,
+			Selector:     mg.Spec.ForProvider.LambdaFunctionARNSelector,
+			To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+			Extract:      lambdav1beta1.FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.lambdaConfig.lambdaFunctionARN")
+		}
+		mg.Spec.ForProvider.LambdaConfig.LambdaFunctionARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.LambdaFunctionARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Resolver.
+func (mg *Resolver) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.apiId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.APIID),
+		Reference:    mg.Spec.ForProvider.APIIDRef,
+		Selector:     mg.Spec.ForProvider.APIIDSelector,
+		To:           reference.To{Managed: &GraphQLAPI{}, List: &GraphQLAPIList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.apiId")
+	}
+	mg.Spec.ForProvider.APIID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.APIIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.dataSourceName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DataSourceName),
+		Reference:    mg.Spec.ForProvider.DataSourceNameRef,
+		Selector:     mg.Spec.ForProvider.DataSourceNameSelector,
+		To:           reference.To{Managed: &DataSource{}, List: &DataSourceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.dataSourceName")
+	}
+	mg.Spec.ForProvider.DataSourceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DataSourceNameRef = rsp.ResolvedReference
+
+	return nil
+}
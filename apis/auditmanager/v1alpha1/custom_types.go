@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomAssessmentParameters contains the additional fields for
+// AssessmentParameters.
+type CustomAssessmentParameters struct {
+	// The identifier for the framework that the assessment will be created
+	// from.
+	// +immutable
+	// +optional
+	FrameworkID *string `json:"frameworkID,omitempty"`
+
+	// FrameworkIDRef is a reference to an AssessmentFramework used to set the
+	// FrameworkID.
+	// +optional
+	FrameworkIDRef *xpv1.Reference `json:"frameworkIDRef,omitempty"`
+
+	// FrameworkIDSelector selects references to an AssessmentFramework used
+	// to set the FrameworkID.
+	// +optional
+	FrameworkIDSelector *xpv1.Selector `json:"frameworkIDSelector,omitempty"`
+
+	// The assessment report storage destination for the assessment that's
+	// being created.
+	// +kubebuilder:validation:Required
+	AssessmentReportsDestination *CustomAssessmentReportsDestination `json:"assessmentReportsDestination"`
+}
+
+// CustomAssessmentReportsDestination is the location in which Audit Manager
+// saves assessment reports.
+type CustomAssessmentReportsDestination struct {
+	// The destination type, such as Amazon S3.
+	// +kubebuilder:validation:Enum=S3
+	// +kubebuilder:default=S3
+	// +optional
+	DestinationType *string `json:"destinationType,omitempty"`
+
+	// The S3 URL of the assessment report destination, e.g.
+	// s3://my-bucket.
+	// +optional
+	Destination *string `json:"destination,omitempty"`
+
+	// DestinationRef is a reference to a Bucket used to set the Destination.
+	// +optional
+	DestinationRef *xpv1.Reference `json:"destinationRef,omitempty"`
+
+	// DestinationSelector selects references to a Bucket used to set the
+	// Destination.
+	// +optional
+	DestinationSelector *xpv1.Selector `json:"destinationSelector,omitempty"`
+}
+
+// CustomAssessmentFrameworkParameters contains the additional fields for
+// AssessmentFrameworkParameters.
+type CustomAssessmentFrameworkParameters struct{}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this Assessment
+func (mg *Assessment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.frameworkID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FrameworkID),
+		Reference:    mg.Spec.ForProvider.FrameworkIDRef,
+		Selector:     mg.Spec.ForProvider.FrameworkIDSelector,
+		To:           reference.To{Managed: &AssessmentFramework{}, List: &AssessmentFrameworkList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.frameworkID")
+	}
+	mg.Spec.ForProvider.FrameworkID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FrameworkIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.assessmentReportsDestination.destination
+	if d := mg.Spec.ForProvider.AssessmentReportsDestination; d != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(d.Destination),
+			Reference:    d.DestinationRef,
+			Selector:     d.DestinationSelector,
+			To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+			Extract:      BucketURL(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.assessmentReportsDestination.destination")
+		}
+		d.Destination = reference.ToPtrValue(rsp.ResolvedValue)
+		d.DestinationRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// BucketURL returns the S3 URL of a Bucket, e.g. s3://my-bucket.
+func BucketURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		if meta.GetExternalName(mg) == "" {
+			return ""
+		}
+		return "s3://" + meta.GetExternalName(mg)
+	}
+}
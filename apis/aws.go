@@ -24,16 +24,25 @@ import (
 	acmv1beta1 "github.com/crossplane/provider-aws/apis/acm/v1beta1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	acmpcav1beta1 "github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
+	apigatewayv1alpha1 "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
 	apigatewayv2v1alpha1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	apigatewayv2v1beta1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1beta1"
+	appregistryv1alpha1 "github.com/crossplane/provider-aws/apis/appregistry/v1alpha1"
+	apprunnerv1alpha1 "github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	appstreamv1alpha1 "github.com/crossplane/provider-aws/apis/appstream/v1alpha1"
+	appsyncv1alpha1 "github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
 	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	auditmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/auditmanager/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	cloudsearchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudsearch/v1alpha1"
 	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	cognitoidentityproviderv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	comprehendv1alpha1 "github.com/crossplane/provider-aws/apis/comprehend/v1alpha1"
+	connectv1alpha1 "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
 	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	daxv1alpha1 "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
 	docdbv1alpha1 "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
 	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	ec2manualv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
@@ -41,6 +50,7 @@ import (
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	ecrv1alpha1 "github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
 	ecrv1beta1 "github.com/crossplane/provider-aws/apis/ecr/v1beta1"
+	ecsv1alpha1 "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
 	efsv1alpha1 "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
 	eksmanualv1alpha1 "github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
@@ -48,36 +58,65 @@ import (
 	elasticachev1alpha1 "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	eventbridgev1alpha1 "github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	firehosev1alpha1 "github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+	fisv1alpha1 "github.com/crossplane/provider-aws/apis/fis/v1alpha1"
+	gameliftv1alpha1 "github.com/crossplane/provider-aws/apis/gamelift/v1alpha1"
+	glacierv1alpha1 "github.com/crossplane/provider-aws/apis/glacier/v1alpha1"
 	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	greengrassv2v1alpha1 "github.com/crossplane/provider-aws/apis/greengrassv2/v1alpha1"
+	groundstationv1alpha1 "github.com/crossplane/provider-aws/apis/groundstation/v1alpha1"
 	iamv1alpha1 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	identitystorev1alpha1 "github.com/crossplane/provider-aws/apis/identitystore/v1alpha1"
 	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
 	kafkav1alpha1 "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
 	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+	kinesisvideov1alpha1 "github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
 	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
 	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	locationv1alpha1 "github.com/crossplane/provider-aws/apis/location/v1alpha1"
+	mediaconvertv1alpha1 "github.com/crossplane/provider-aws/apis/mediaconvert/v1alpha1"
+	medialivev1alpha1 "github.com/crossplane/provider-aws/apis/medialive/v1alpha1"
+	mediapackagev1alpha1 "github.com/crossplane/provider-aws/apis/mediapackage/v1alpha1"
 	mqv1alpha1 "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
 	neptunev1alpha1 "github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
 	notificationv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	opensearchservicev1alpha1 "github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	personalizev1alpha1 "github.com/crossplane/provider-aws/apis/personalize/v1alpha1"
+	pollyv1alpha1 "github.com/crossplane/provider-aws/apis/polly/v1alpha1"
 	prometheusservice "github.com/crossplane/provider-aws/apis/prometheusservice/v1alpha1"
+	protonv1alpha1 "github.com/crossplane/provider-aws/apis/proton/v1alpha1"
 	ramv1alpha1 "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
 	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	rekognitionv1alpha1 "github.com/crossplane/provider-aws/apis/rekognition/v1alpha1"
 	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
 	route53resolvermanualv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/manualv1alpha1"
 	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
 	s3v1alpha2 "github.com/crossplane/provider-aws/apis/s3/v1alpha3"
 	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	sagemakerv1alpha1 "github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	schedulingv1alpha1 "github.com/crossplane/provider-aws/apis/scheduling/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
 	secretsmanagerv1beta1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1beta1"
 	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
 	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
 	snsv1beta1 "github.com/crossplane/provider-aws/apis/sns/v1beta1"
 	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	ssmv1alpha1 "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	ssmcontactsv1alpha1 "github.com/crossplane/provider-aws/apis/ssmcontacts/v1alpha1"
+	ssmincidentsv1alpha1 "github.com/crossplane/provider-aws/apis/ssmincidents/v1alpha1"
+	ssoadminv1alpha1 "github.com/crossplane/provider-aws/apis/ssoadmin/v1alpha1"
+	storagegatewayv1alpha1 "github.com/crossplane/provider-aws/apis/storagegateway/v1alpha1"
+	transcribev1alpha1 "github.com/crossplane/provider-aws/apis/transcribe/v1alpha1"
 	transferv1alpha1 "github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
+	translatev1alpha1 "github.com/crossplane/provider-aws/apis/translate/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	wellarchitectedv1alpha1 "github.com/crossplane/provider-aws/apis/wellarchitected/v1alpha1"
+	workspacesv1alpha1 "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
 )
 
 func init() {
@@ -115,6 +154,7 @@ func init() {
 		ecrv1beta1.SchemeBuilder.AddToScheme,
 		apigatewayv2v1alpha1.SchemeBuilder.AddToScheme,
 		apigatewayv2v1beta1.SchemeBuilder.AddToScheme,
+		apigatewayv1alpha1.SchemeBuilder.AddToScheme,
 		sfnv1alpha1.SchemeBuilder.AddToScheme,
 		dynamodbv1alpha1.SchemeBuilder.AddToScheme,
 		kmsv1alpha1.SchemeBuilder.AddToScheme,
@@ -129,7 +169,9 @@ func init() {
 		route53resolvermanualv1alpha1.SchemeBuilder.AddToScheme,
 		kafkav1alpha1.SchemeBuilder.AddToScheme,
 		transferv1alpha1.SchemeBuilder.AddToScheme,
+		storagegatewayv1alpha1.SchemeBuilder.AddToScheme,
 		gluev1alpha1.SchemeBuilder.AddToScheme,
+		glacierv1alpha1.SchemeBuilder.AddToScheme,
 		mqv1alpha1.SchemeBuilder.AddToScheme,
 		cloudwatchlogsv1alpha1.SchemeBuilder.AddToScheme,
 		iotv1alpha1.SchemeBuilder.AddToScheme,
@@ -141,6 +183,42 @@ func init() {
 		snsv1beta1.SchemeBuilder.AddToScheme,
 		prometheusservice.SchemeBuilder.AddToScheme,
 		cloudsearchv1alpha1.AddToScheme,
+		greengrassv2v1alpha1.SchemeBuilder.AddToScheme,
+		kinesisvideov1alpha1.SchemeBuilder.AddToScheme,
+		mediapackagev1alpha1.SchemeBuilder.AddToScheme,
+		medialivev1alpha1.SchemeBuilder.AddToScheme,
+		mediaconvertv1alpha1.SchemeBuilder.AddToScheme,
+		gameliftv1alpha1.SchemeBuilder.AddToScheme,
+		groundstationv1alpha1.SchemeBuilder.AddToScheme,
+		sagemakerv1alpha1.SchemeBuilder.AddToScheme,
+		ecsv1alpha1.SchemeBuilder.AddToScheme,
+		protonv1alpha1.SchemeBuilder.AddToScheme,
+		fisv1alpha1.SchemeBuilder.AddToScheme,
+		apprunnerv1alpha1.SchemeBuilder.AddToScheme,
+		wellarchitectedv1alpha1.SchemeBuilder.AddToScheme,
+		workspacesv1alpha1.SchemeBuilder.AddToScheme,
+		appstreamv1alpha1.SchemeBuilder.AddToScheme,
+		connectv1alpha1.SchemeBuilder.AddToScheme,
+		locationv1alpha1.SchemeBuilder.AddToScheme,
+		auditmanagerv1alpha1.SchemeBuilder.AddToScheme,
+		ssoadminv1alpha1.SchemeBuilder.AddToScheme,
+		identitystorev1alpha1.SchemeBuilder.AddToScheme,
+		daxv1alpha1.SchemeBuilder.AddToScheme,
+		eventbridgev1alpha1.SchemeBuilder.AddToScheme,
+		comprehendv1alpha1.SchemeBuilder.AddToScheme,
+		translatev1alpha1.SchemeBuilder.AddToScheme,
+		pollyv1alpha1.SchemeBuilder.AddToScheme,
+		transcribev1alpha1.SchemeBuilder.AddToScheme,
+		rekognitionv1alpha1.SchemeBuilder.AddToScheme,
+		personalizev1alpha1.SchemeBuilder.AddToScheme,
+		firehosev1alpha1.SchemeBuilder.AddToScheme,
+		ssmv1alpha1.SchemeBuilder.AddToScheme,
+		ssmcontactsv1alpha1.SchemeBuilder.AddToScheme,
+		ssmincidentsv1alpha1.SchemeBuilder.AddToScheme,
+		opensearchservicev1alpha1.SchemeBuilder.AddToScheme,
+		appregistryv1alpha1.SchemeBuilder.AddToScheme,
+		appsyncv1alpha1.SchemeBuilder.AddToScheme,
+		schedulingv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
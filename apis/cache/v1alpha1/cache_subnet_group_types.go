@@ -40,6 +40,11 @@ type CacheSubnetGroupParameters struct {
 	// SubnetIDSelector selects a set of references that each retrieve the subnetID from the referenced Subnet
 	// +optional
 	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// A list of cost allocation tags to be added to this resource.
+	// +optional
+	// +immutable
+	Tags []Tag `json:"tags,omitempty"`
 }
 
 // A CacheSubnetGroupSpec defines the desired state of a CacheSubnetGroup.
@@ -64,9 +69,10 @@ type CacheSubnetGroupStatus struct {
 // +kubebuilder:object:root=true
 
 // A CacheSubnetGroup is a managed resource that represents an AWS Subnet Group for ElasticCache.
-// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".status.atProvider.vpcId"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".status.atProvider.vpcId"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
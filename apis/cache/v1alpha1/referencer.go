@@ -81,3 +81,24 @@ func (mg *CacheCluster) ResolveReferences(ctx context.Context, c client.Reader)
 
 	return nil
 }
+
+// ResolveReferences of this Snapshot
+func (mg *Snapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.cacheClusterId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheClusterID),
+		Reference:    mg.Spec.ForProvider.CacheClusterIDRef,
+		Selector:     mg.Spec.ForProvider.CacheClusterIDSelector,
+		To:           reference.To{Managed: &CacheCluster{}, List: &CacheClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheClusterID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheClusterIDRef = rsp.ResolvedReference
+
+	return nil
+}
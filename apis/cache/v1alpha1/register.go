@@ -53,7 +53,16 @@ var (
 	CacheClusterGroupVersionKind = SchemeGroupVersion.WithKind(CacheClusterKind)
 )
 
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CacheCluster{}, &CacheClusterList{})
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
 }
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Snapshot states.
+const (
+	SnapshotStatusCreating  = "creating"
+	SnapshotStatusAvailable = "available"
+	SnapshotStatusCopying   = "copying"
+	SnapshotStatusDeleting  = "deleting"
+	SnapshotStatusRestoring = "restoring"
+)
+
+// SnapshotParameters define the desired state of an AWS ElastiCache Snapshot.
+// A snapshot is either taken of a replication group or cache cluster, or
+// copied from an existing snapshot.
+type SnapshotParameters struct {
+	// Region is the region you'd like your Snapshot to be created in.
+	Region string `json:"region"`
+
+	// The identifier of an existing replication group. The snapshot is
+	// created from this replication group.
+	// +immutable
+	// +optional
+	ReplicationGroupID *string `json:"replicationGroupId,omitempty"`
+
+	// The identifier of an existing cache cluster. The snapshot is created
+	// from this cache cluster.
+	// +immutable
+	// +optional
+	CacheClusterID *string `json:"cacheClusterId,omitempty"`
+
+	// CacheClusterIDRef is a reference to a CacheCluster used to set the
+	// CacheClusterID.
+	// +optional
+	CacheClusterIDRef *xpv1.Reference `json:"cacheClusterIdRef,omitempty"`
+
+	// CacheClusterIDSelector selects a reference to a CacheCluster used to
+	// set the CacheClusterID.
+	// +optional
+	CacheClusterIDSelector *xpv1.Selector `json:"cacheClusterIdSelector,omitempty"`
+
+	// The name of an existing snapshot. The snapshot is created as a copy of
+	// this snapshot, e.g. to keep a manual copy of an automatic snapshot.
+	// +immutable
+	// +optional
+	SourceSnapshotName *string `json:"sourceSnapshotName,omitempty"`
+
+	// The ID of the KMS key used to encrypt the snapshot.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// A list of cost allocation tags to be added to this resource.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotObservation keeps the state for the external resource
+type SnapshotObservation struct {
+	// The ARN (Amazon Resource Name) of the snapshot.
+	ARN string `json:"arn,omitempty"`
+
+	// The status of the snapshot. Valid values: creating | available |
+	// restoring | copying | deleting.
+	SnapshotStatus string `json:"snapshotStatus,omitempty"`
+
+	// Indicates whether the snapshot is from an automatic backup (automated)
+	// or was created manually (manual).
+	SnapshotSource string `json:"snapshotSource,omitempty"`
+
+	// The name of the cache engine used by the source cluster.
+	Engine string `json:"engine,omitempty"`
+
+	// The version of the cache engine version that is used by the source
+	// cluster.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// The name of the compute and memory capacity node type for the source
+	// cluster.
+	CacheNodeType string `json:"cacheNodeType,omitempty"`
+
+	// The number of node groups (shards) in the snapshot.
+	NumNodeGroups int32 `json:"numNodeGroups,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents an AWS ElastiCache
+// snapshot. Use it as snapshotNameRef of a ReplicationGroup to restore the
+// snapshot into a new replication group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.snapshotStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -98,5 +98,19 @@ func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Read
 	mg.Spec.ForProvider.CacheSecurityGroupNames = mrsp.ResolvedValues
 	mg.Spec.ForProvider.CacheSecurityGroupNameRefs = mrsp.ResolvedReferences
 
+	// Resolve spec.forProvider.snapshotName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SnapshotName),
+		Reference:    mg.Spec.ForProvider.SnapshotNameRef,
+		Selector:     mg.Spec.ForProvider.SnapshotNameSelector,
+		To:           reference.To{Managed: &v1alpha1.Snapshot{}, List: &v1alpha1.SnapshotList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.snapshotName")
+	}
+	mg.Spec.ForProvider.SnapshotName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SnapshotNameRef = rsp.ResolvedReference
+
 	return nil
 }
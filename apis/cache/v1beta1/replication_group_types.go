@@ -17,6 +17,9 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -32,6 +35,176 @@ const (
 	StatusSnapshotting = "snapshotting"
 )
 
+// Condition type and reasons used to report engine version upgrades of a
+// ReplicationGroup.
+const (
+	TypeEngineVersionUpgrade xpv1.ConditionType = "EngineVersionUpgrade"
+
+	ReasonUpgradeInProgress xpv1.ConditionReason = "UpgradeInProgress"
+	ReasonUpgradeSucceeded  xpv1.ConditionReason = "UpgradeSucceeded"
+	ReasonUpgradeFailed     xpv1.ConditionReason = "UpgradeFailed"
+	ReasonUpgradeRolledBack xpv1.ConditionReason = "UpgradeRolledBack"
+)
+
+// EngineVersionUpgradeInProgress returns a condition that indicates the
+// engine version of the replication group is being upgraded.
+func EngineVersionUpgradeInProgress(from, to string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeEngineVersionUpgrade,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeInProgress,
+		Message:            fmt.Sprintf("upgrading engine version from %s to %s", from, to),
+	}
+}
+
+// EngineVersionUpgradeSucceeded returns a condition that indicates the engine
+// version of the replication group has been upgraded.
+func EngineVersionUpgradeSucceeded(version string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeEngineVersionUpgrade,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeSucceeded,
+		Message:            fmt.Sprintf("running engine version %s", version),
+	}
+}
+
+// EngineVersionUpgradeFailed returns a condition that indicates the engine
+// version upgrade was rejected, e.g. by AWS validation.
+func EngineVersionUpgradeFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeEngineVersionUpgrade,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeFailed,
+		Message:            err.Error(),
+	}
+}
+
+// EngineVersionUpgradeRolledBack returns a condition that indicates AWS
+// finished the modification without applying the desired engine version.
+func EngineVersionUpgradeRolledBack(from, to string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeEngineVersionUpgrade,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpgradeRolledBack,
+		Message:            fmt.Sprintf("upgrade of engine version from %s to %s was rolled back", from, to),
+	}
+}
+
+// Condition type and reasons used to report auth token updates of a
+// ReplicationGroup.
+const (
+	TypeAuthTokenUpdate xpv1.ConditionType = "AuthTokenUpdate"
+
+	ReasonAuthTokenUpdateInProgress xpv1.ConditionReason = "AuthTokenUpdateInProgress"
+	ReasonAuthTokenUpdated          xpv1.ConditionReason = "AuthTokenUpdated"
+	ReasonAuthTokenUpdateFailed     xpv1.ConditionReason = "AuthTokenUpdateFailed"
+)
+
+// AuthTokenUpdateInProgress returns a condition that indicates the auth token
+// of the replication group is being updated using the supplied strategy.
+func AuthTokenUpdateInProgress(strategy string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAuthTokenUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAuthTokenUpdateInProgress,
+		Message:            fmt.Sprintf("updating auth token using strategy %s", strategy),
+	}
+}
+
+// AuthTokenUpdated returns a condition that indicates the auth token of the
+// replication group has been updated and published to the connection secret.
+func AuthTokenUpdated() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAuthTokenUpdate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAuthTokenUpdated,
+	}
+}
+
+// AuthTokenUpdateFailed returns a condition that indicates the auth token
+// update was rejected.
+func AuthTokenUpdateFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeAuthTokenUpdate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAuthTokenUpdateFailed,
+		Message:            err.Error(),
+	}
+}
+
+// AnnotationKeyTestFailover is the key in the annotations map of a
+// ReplicationGroup for a comma separated list of node group IDs to test
+// automatic failover on. Failover is tested on the node groups one after
+// another, once whenever the value of the annotation changes.
+const AnnotationKeyTestFailover = Group + "/test-failover"
+
+// AnnotationKeyLastTestFailover is the key in the annotations map of a
+// ReplicationGroup for the reference of the last failover test requested
+// through AnnotationKeyTestFailover, followed by the node groups failover was
+// tested on so far, e.g. "5f0c8a3e9b1d2c47:0001,0002". Removing it runs the
+// last requested failover test again.
+const AnnotationKeyLastTestFailover = Group + "/last-test-failover"
+
+// Condition type and reasons used to report failover tests of a
+// ReplicationGroup.
+const (
+	TypeTestFailover xpv1.ConditionType = "TestFailover"
+
+	ReasonTestFailoverInProgress xpv1.ConditionReason = "TestFailoverInProgress"
+	ReasonTestFailoverSucceeded  xpv1.ConditionReason = "TestFailoverSucceeded"
+	ReasonTestFailoverFailed     xpv1.ConditionReason = "TestFailoverFailed"
+)
+
+// TestFailoverInProgress returns a condition that indicates automatic failover
+// is being tested on the supplied node group of the replication group.
+func TestFailoverInProgress(nodeGroupID string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTestFailover,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTestFailoverInProgress,
+		Message:            fmt.Sprintf("testing failover of node group %s", nodeGroupID),
+	}
+}
+
+// TestFailoverSucceeded returns a condition that indicates automatic failover
+// was tested on the supplied node groups and the replication group is
+// available again.
+func TestFailoverSucceeded(nodeGroupIDs string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTestFailover,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTestFailoverSucceeded,
+		Message:            fmt.Sprintf("tested failover of node groups %s", nodeGroupIDs),
+	}
+}
+
+// TestFailoverFailed returns a condition that indicates the failover test of
+// the supplied node group was rejected.
+func TestFailoverFailed(nodeGroupID string, err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeTestFailover,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTestFailoverFailed,
+		Message:            fmt.Sprintf("cannot test failover of node group %s: %s", nodeGroupID, err),
+	}
+}
+
+// Supported auth token update strategies.
+const (
+	AuthTokenUpdateStrategyRotate = "ROTATE"
+	AuthTokenUpdateStrategySet    = "SET"
+)
+
 // Supported cache engines.
 const (
 	CacheEngineRedis     = "redis"
@@ -178,6 +351,18 @@ type ReplicationGroupObservation struct {
 	// endpoint to connect to this replication group.
 	ConfigurationEndpoint Endpoint `json:"configurationEndpoint,omitempty"`
 
+	// EngineVersion is the version of the cache engine the member clusters of
+	// this replication group are running.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// GlobalReplicationGroupID is the name of the Global datastore this
+	// replication group is a member of.
+	GlobalReplicationGroupID string `json:"globalReplicationGroupId,omitempty"`
+
+	// GlobalReplicationGroupMemberRole is the role of this replication group
+	// in its Global datastore, either PRIMARY or SECONDARY.
+	GlobalReplicationGroupMemberRole string `json:"globalReplicationGroupMemberRole,omitempty"`
+
 	// MemberClusters is the list of names of all the cache clusters that are
 	// part of this replication group.
 	MemberClusters []string `json:"memberClusters,omitempty"`
@@ -280,6 +465,23 @@ type ReplicationGroupParameters struct {
 	// +optional
 	AuthEnabled *bool `json:"authEnabled,omitempty"`
 
+	// AuthTokenSecretRef references the key of a Secret that contains the
+	// auth token to use instead of a generated one. Changing the token in the
+	// Secret updates the token of the replication group using the
+	// AuthTokenUpdateStrategy. The connection secret is updated once AWS has
+	// applied the new token, so changes are only detected for replication
+	// groups that write a connection secret.
+	// +optional
+	AuthTokenSecretRef *xpv1.SecretKeySelector `json:"authTokenSecretRef,omitempty"`
+
+	// AuthTokenUpdateStrategy specifies how the auth token is updated when
+	// the token in AuthTokenSecretRef changes. ROTATE adds the new token while
+	// the previous one stays valid, SET replaces all existing tokens. Defaults
+	// to ROTATE.
+	// +kubebuilder:validation:Enum=ROTATE;SET
+	// +optional
+	AuthTokenUpdateStrategy *string `json:"authTokenUpdateStrategy,omitempty"`
+
 	// AutomaticFailoverEnabled specifies whether a read-only replica is
 	// automatically promoted to read/write primary if the existing primary
 	// fails. If true, Multi-AZ is enabled for this replication group. If false,
@@ -383,9 +585,22 @@ type ReplicationGroupParameters struct {
 	// version. If you want to use an earlier engine version, you must delete the
 	// existing cluster or replication group and create it anew with the earlier
 	// engine version.
+	//
+	// Upgrades are applied in place and reported through the
+	// EngineVersionUpgrade condition. Downgrades are rejected.
 	// +optional
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
+	// GlobalReplicationGroupID is the name of the Global datastore this
+	// replication group joins as a secondary member when it is created. A
+	// secondary inherits its engine, engine version and node type from the
+	// primary replication group of the Global datastore.
+	//
+	// Removing this field from an existing secondary member detaches the
+	// replication group from the Global datastore.
+	// +optional
+	GlobalReplicationGroupID *string `json:"globalReplicationGroupId,omitempty"`
+
 	// NodeGroupConfigurationSpec specifies a list of node group (shard)
 	// configuration options.
 	//
@@ -429,10 +644,10 @@ type ReplicationGroupParameters struct {
 
 	// NumNodeGroups specifies the number of node groups (shards) for this Redis
 	// (cluster mode enabled) replication group. For Redis (cluster mode
-	// disabled) either omit this parameter or set it to 1.
+	// disabled) either omit this parameter or set it to 1. Changing this value
+	// adds or removes node groups from the replication group.
 	//
 	// Default: 1
-	// +immutable
 	// +optional
 	NumNodeGroups *int `json:"numNodeGroups,omitempty"`
 
@@ -480,8 +695,8 @@ type ReplicationGroupParameters struct {
 	PrimaryClusterID *string `json:"primaryClusterId,omitempty"`
 
 	// ReplicasPerNodeGroup specifies the number of replica nodes in each node
-	// group (shard). Valid values are 0 to 5.
-	// +immutable
+	// group (shard). Valid values are 0 to 5. Changing this value adds or
+	// removes replicas in every node group of the replication group.
 	// +optional
 	ReplicasPerNodeGroup *int `json:"replicasPerNodeGroup,omitempty"`
 
@@ -524,6 +739,17 @@ type ReplicationGroupParameters struct {
 	// +optional
 	SnapshotName *string `json:"snapshotName,omitempty"`
 
+	// SnapshotNameRef is a reference to a Snapshot used to set the
+	// SnapshotName.
+	// +immutable
+	// +optional
+	SnapshotNameRef *xpv1.Reference `json:"snapshotNameRef,omitempty"`
+
+	// SnapshotNameSelector selects a reference to a Snapshot used to set the
+	// SnapshotName.
+	// +optional
+	SnapshotNameSelector *xpv1.Selector `json:"snapshotNameSelector,omitempty"`
+
 	// SnapshotRetentionLimit specifies the number of days for which ElastiCache
 	// retains automatic snapshots before deleting them. For example, if you set
 	// SnapshotRetentionLimit to 5, a snapshot that was taken today is retained
@@ -596,9 +822,11 @@ type ReplicationGroupStatus struct {
 // Replication Group.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-//+kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.engineVersion"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+//+kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
 type ReplicationGroup struct {
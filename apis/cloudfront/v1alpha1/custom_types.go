@@ -16,8 +16,42 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AnnotationKeyInvalidatePaths is the key in the annotations map of a
+// Distribution for a comma separated list of paths to invalidate. The paths
+// are invalidated once whenever the value of the annotation changes.
+const AnnotationKeyInvalidatePaths = CRDGroup + "/invalidate-paths"
+
+// AnnotationKeyLastInvalidation is the key in the annotations map of a
+// Distribution for the caller reference of the last invalidation that was
+// requested through AnnotationKeyInvalidatePaths.
+const AnnotationKeyLastInvalidation = CRDGroup + "/last-invalidation"
+
 // CustomDistributionParameters includes the custom fields of Distribution.
-type CustomDistributionParameters struct{}
+type CustomDistributionParameters struct {
+	// ACMCertificateARNRef is a reference to an ACM Certificate used to set
+	// the ACMCertificateARN of the ViewerCertificate.
+	// +optional
+	ACMCertificateARNRef *xpv1.Reference `json:"acmCertificateARNRef,omitempty"`
+
+	// ACMCertificateARNSelector selects references to an ACM Certificate
+	// used to set the ACMCertificateARN of the ViewerCertificate.
+	// +optional
+	ACMCertificateARNSelector *xpv1.Selector `json:"acmCertificateARNSelector,omitempty"`
+
+	// DefaultCachePolicyIDRef is a reference to a CachePolicy used to set
+	// the CachePolicyID of the DefaultCacheBehavior.
+	// +optional
+	DefaultCachePolicyIDRef *xpv1.Reference `json:"defaultCachePolicyIDRef,omitempty"`
+
+	// DefaultCachePolicyIDSelector selects references to a CachePolicy used
+	// to set the CachePolicyID of the DefaultCacheBehavior.
+	// +optional
+	DefaultCachePolicyIDSelector *xpv1.Selector `json:"defaultCachePolicyIDSelector,omitempty"`
+}
 
 // CustomCachePolicyParameters includes the custom fields of CachePolicy.
 type CustomCachePolicyParameters struct{}
@@ -27,3 +61,85 @@ type CustomCloudFrontOriginAccessIdentityParameters struct{}
 
 // CustomResponseHeadersPolicyParameters includes metadata about the response headers policy, and a set of configurations
 type CustomResponseHeadersPolicyParameters struct{}
+
+// CustomKeyGroupParameters includes the custom fields of KeyGroup.
+type CustomKeyGroupParameters struct {
+	// A list of the identifiers of the public keys in the key group.
+	// +optional
+	PublicKeyIDs []string `json:"publicKeyIDs,omitempty"`
+
+	// PublicKeyIDRefs is a list of references to PublicKeys used to set
+	// the PublicKeyIDs.
+	// +optional
+	PublicKeyIDRefs []xpv1.Reference `json:"publicKeyIDRefs,omitempty"`
+
+	// PublicKeyIDSelector selects references to PublicKeys used to set
+	// the PublicKeyIDs.
+	// +optional
+	PublicKeyIDSelector *xpv1.Selector `json:"publicKeyIDSelector,omitempty"`
+}
+
+// CustomPublicKeyParameters includes the custom fields of PublicKey.
+type CustomPublicKeyParameters struct {
+	// EncodedKeySecretRef references the key of a secret that contains the
+	// PEM encoded public key that you can use with signed URLs and signed
+	// cookies. The key cannot be changed once the public key is created.
+	EncodedKeySecretRef xpv1.SecretKeySelector `json:"encodedKeySecretRef"`
+}
+
+// CustomRealtimeLogConfigParameters includes the custom fields of
+// RealtimeLogConfig.
+type CustomRealtimeLogConfigParameters struct {
+	// Contains information about the Amazon Kinesis data stream where you are
+	// sending real-time log data.
+	// +kubebuilder:validation:MinItems=1
+	EndPoints []CustomEndPoint `json:"endPoints"`
+}
+
+// CustomEndPoint contains information about the Amazon Kinesis data stream
+// where you are sending real-time log data.
+type CustomEndPoint struct {
+	// The type of data stream where you are sending real-time log data. The
+	// only valid value is Kinesis.
+	// +kubebuilder:default=Kinesis
+	// +kubebuilder:validation:Enum=Kinesis
+	// +optional
+	StreamType *string `json:"streamType,omitempty"`
+
+	// Contains information about the Amazon Kinesis data stream where you are
+	// sending real-time log data.
+	KinesisStreamConfig CustomKinesisStreamConfig `json:"kinesisStreamConfig"`
+}
+
+// CustomKinesisStreamConfig contains information about the Amazon Kinesis
+// data stream where you are sending real-time log data.
+type CustomKinesisStreamConfig struct {
+	// The ARN of an IAM role that CloudFront can use to send real-time log
+	// data to your Kinesis data stream.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// The ARN of the Kinesis data stream where you are sending real-time log
+	// data.
+	// +optional
+	StreamARN *string `json:"streamARN,omitempty"`
+
+	// StreamARNRef is a reference to a Kinesis Stream used to set the
+	// StreamARN.
+	// +optional
+	StreamARNRef *xpv1.Reference `json:"streamARNRef,omitempty"`
+
+	// StreamARNSelector selects references to a Kinesis Stream used to set
+	// the StreamARN.
+	// +optional
+	StreamARNSelector *xpv1.Selector `json:"streamARNSelector,omitempty"`
+}
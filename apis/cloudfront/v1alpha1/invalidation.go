@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: Invalidation is not generated since invalidations can neither be
+// updated nor deleted in the CloudFront API.
+
+// Invalidation states.
+const (
+	InvalidationStatusInProgress = "InProgress"
+	InvalidationStatusCompleted  = "Completed"
+)
+
+// InvalidationParameters defines the desired state of Invalidation
+type InvalidationParameters struct {
+	// Region is which region the Invalidation will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the distribution whose cached objects are invalidated.
+	// +immutable
+	// +optional
+	DistributionID *string `json:"distributionID,omitempty"`
+
+	// DistributionIDRef is a reference to a Distribution used to set the
+	// DistributionID.
+	// +optional
+	DistributionIDRef *xpv1.Reference `json:"distributionIDRef,omitempty"`
+
+	// DistributionIDSelector selects references to a Distribution used to
+	// set the DistributionID.
+	// +optional
+	DistributionIDSelector *xpv1.Selector `json:"distributionIDSelector,omitempty"`
+
+	// The paths of the objects to invalidate, for example /index.html or
+	// /images/*. The paths cannot be changed once the invalidation is
+	// created.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Paths []string `json:"paths"`
+}
+
+// InvalidationSpec defines the desired state of Invalidation
+type InvalidationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       InvalidationParameters `json:"forProvider"`
+}
+
+// InvalidationObservation defines the observed state of Invalidation
+type InvalidationObservation struct {
+	// The date and time the invalidation request was first made.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// The status of the invalidation request, either InProgress or
+	// Completed.
+	Status *string `json:"status,omitempty"`
+}
+
+// InvalidationStatus defines the observed state of Invalidation.
+type InvalidationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          InvalidationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Invalidation is the Schema for the Invalidations API. The external name of
+// an Invalidation is the ID CloudFront assigns to the invalidation request.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DISTRIBUTION",type="string",JSONPath=".spec.forProvider.distributionID"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Invalidation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              InvalidationSpec   `json:"spec"`
+	Status            InvalidationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InvalidationList contains a list of Invalidations
+type InvalidationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Invalidation `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	InvalidationKind             = "Invalidation"
+	InvalidationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: InvalidationKind}.String()
+	InvalidationKindAPIVersion   = InvalidationKind + "." + GroupVersion.String()
+	InvalidationGroupVersionKind = GroupVersion.WithKind(InvalidationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Invalidation{}, &InvalidationList{})
+}
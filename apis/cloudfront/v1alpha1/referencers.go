@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	acmv1beta1 "github.com/crossplane/provider-aws/apis/acm/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+)
+
+// ResolveReferences of this Distribution
+func (mg *Distribution) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	p := &mg.Spec.ForProvider
+	if p.DistributionConfig == nil {
+		return nil
+	}
+
+	// Resolve spec.forProvider.distributionConfig.viewerCertificate.aCMCertificateARN
+	if p.ACMCertificateARNRef != nil || p.ACMCertificateARNSelector != nil {
+		if p.DistributionConfig.ViewerCertificate == nil {
+			p.DistributionConfig.ViewerCertificate = &ViewerCertificate{}
+		}
+		vc := p.DistributionConfig.ViewerCertificate
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(vc.ACMCertificateARN),
+			Reference:    p.ACMCertificateARNRef,
+			Selector:     p.ACMCertificateARNSelector,
+			To:           reference.To{Managed: &acmv1beta1.Certificate{}, List: &acmv1beta1.CertificateList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.distributionConfig.viewerCertificate.aCMCertificateARN")
+		}
+		vc.ACMCertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.ACMCertificateARNRef = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.distributionConfig.defaultCacheBehavior.cachePolicyID
+	if p.DefaultCachePolicyIDRef != nil || p.DefaultCachePolicyIDSelector != nil {
+		if p.DistributionConfig.DefaultCacheBehavior == nil {
+			p.DistributionConfig.DefaultCacheBehavior = &DefaultCacheBehavior{}
+		}
+		dcb := p.DistributionConfig.DefaultCacheBehavior
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(dcb.CachePolicyID),
+			Reference:    p.DefaultCachePolicyIDRef,
+			Selector:     p.DefaultCachePolicyIDSelector,
+			To:           reference.To{Managed: &CachePolicy{}, List: &CachePolicyList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.distributionConfig.defaultCacheBehavior.cachePolicyID")
+		}
+		dcb.CachePolicyID = reference.ToPtrValue(rsp.ResolvedValue)
+		p.DefaultCachePolicyIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Invalidation
+func (mg *Invalidation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.distributionID
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DistributionID),
+		Reference:    mg.Spec.ForProvider.DistributionIDRef,
+		Selector:     mg.Spec.ForProvider.DistributionIDSelector,
+		To:           reference.To{Managed: &Distribution{}, List: &DistributionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.distributionID")
+	}
+	mg.Spec.ForProvider.DistributionID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DistributionIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this KeyGroup
+func (mg *KeyGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.publicKeyIDs
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PublicKeyIDs,
+		References:    mg.Spec.ForProvider.PublicKeyIDRefs,
+		Selector:      mg.Spec.ForProvider.PublicKeyIDSelector,
+		To:            reference.To{Managed: &PublicKey{}, List: &PublicKeyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.publicKeyIDs")
+	}
+	mg.Spec.ForProvider.PublicKeyIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PublicKeyIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this RealtimeLogConfig
+func (mg *RealtimeLogConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.EndPoints {
+		k := &mg.Spec.ForProvider.EndPoints[i].KinesisStreamConfig
+
+		// Resolve spec.forProvider.endPoints[i].kinesisStreamConfig.roleARN
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(k.RoleARN),
+			Reference:    k.RoleARNRef,
+			Selector:     k.RoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+			Extract:      iamv1beta1.RoleARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.endPoints[%d].kinesisStreamConfig.roleARN", i))
+		}
+		k.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		k.RoleARNRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.endPoints[i].kinesisStreamConfig.streamARN
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(k.StreamARN),
+			Reference:    k.StreamARNRef,
+			Selector:     k.StreamARNSelector,
+			To:           reference.To{Managed: &kinesisv1alpha1.Stream{}, List: &kinesisv1alpha1.StreamList{}},
+			Extract:      kinesisv1alpha1.StreamARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.endPoints[%d].kinesisStreamConfig.streamARN", i))
+		}
+		k.StreamARN = reference.ToPtrValue(rsp.ResolvedValue)
+		k.StreamARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -1058,6 +1059,26 @@ func (in *CustomCloudFrontOriginAccessIdentityParameters) DeepCopy() *CustomClou
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDistributionParameters) DeepCopyInto(out *CustomDistributionParameters) {
 	*out = *in
+	if in.ACMCertificateARNRef != nil {
+		in, out := &in.ACMCertificateARNRef, &out.ACMCertificateARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ACMCertificateARNSelector != nil {
+		in, out := &in.ACMCertificateARNSelector, &out.ACMCertificateARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultCachePolicyIDRef != nil {
+		in, out := &in.DefaultCachePolicyIDRef, &out.DefaultCachePolicyIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DefaultCachePolicyIDSelector != nil {
+		in, out := &in.DefaultCachePolicyIDSelector, &out.DefaultCachePolicyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDistributionParameters.
@@ -1070,6 +1091,27 @@ func (in *CustomDistributionParameters) DeepCopy() *CustomDistributionParameters
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomEndPoint) DeepCopyInto(out *CustomEndPoint) {
+	*out = *in
+	if in.StreamType != nil {
+		in, out := &in.StreamType, &out.StreamType
+		*out = new(string)
+		**out = **in
+	}
+	in.KinesisStreamConfig.DeepCopyInto(&out.KinesisStreamConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomEndPoint.
+func (in *CustomEndPoint) DeepCopy() *CustomEndPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomEndPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomErrorResponse) DeepCopyInto(out *CustomErrorResponse) {
 	*out = *in
@@ -1157,6 +1199,81 @@ func (in *CustomHeaders) DeepCopy() *CustomHeaders {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKeyGroupParameters) DeepCopyInto(out *CustomKeyGroupParameters) {
+	*out = *in
+	if in.PublicKeyIDs != nil {
+		in, out := &in.PublicKeyIDs, &out.PublicKeyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicKeyIDRefs != nil {
+		in, out := &in.PublicKeyIDRefs, &out.PublicKeyIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicKeyIDSelector != nil {
+		in, out := &in.PublicKeyIDSelector, &out.PublicKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyGroupParameters.
+func (in *CustomKeyGroupParameters) DeepCopy() *CustomKeyGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKeyGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomKinesisStreamConfig) DeepCopyInto(out *CustomKinesisStreamConfig) {
+	*out = *in
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARNRef != nil {
+		in, out := &in.RoleARNRef, &out.RoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RoleARNSelector != nil {
+		in, out := &in.RoleARNSelector, &out.RoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StreamARN != nil {
+		in, out := &in.StreamARN, &out.StreamARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.StreamARNRef != nil {
+		in, out := &in.StreamARNRef, &out.StreamARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.StreamARNSelector != nil {
+		in, out := &in.StreamARNSelector, &out.StreamARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKinesisStreamConfig.
+func (in *CustomKinesisStreamConfig) DeepCopy() *CustomKinesisStreamConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomKinesisStreamConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomOriginConfig) DeepCopyInto(out *CustomOriginConfig) {
 	*out = *in
@@ -1202,6 +1319,44 @@ func (in *CustomOriginConfig) DeepCopy() *CustomOriginConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPublicKeyParameters) DeepCopyInto(out *CustomPublicKeyParameters) {
+	*out = *in
+	out.EncodedKeySecretRef = in.EncodedKeySecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPublicKeyParameters.
+func (in *CustomPublicKeyParameters) DeepCopy() *CustomPublicKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPublicKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRealtimeLogConfigParameters) DeepCopyInto(out *CustomRealtimeLogConfigParameters) {
+	*out = *in
+	if in.EndPoints != nil {
+		in, out := &in.EndPoints, &out.EndPoints
+		*out = make([]CustomEndPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRealtimeLogConfigParameters.
+func (in *CustomRealtimeLogConfigParameters) DeepCopy() *CustomRealtimeLogConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRealtimeLogConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResponseHeadersPolicyParameters) DeepCopyInto(out *CustomResponseHeadersPolicyParameters) {
 	*out = *in
@@ -1625,7 +1780,7 @@ func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
 		*out = new(DistributionConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	out.CustomDistributionParameters = in.CustomDistributionParameters
+	in.CustomDistributionParameters.DeepCopyInto(&out.CustomDistributionParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
@@ -1910,6 +2065,11 @@ func (in *EncryptionEntity) DeepCopy() *EncryptionEntity {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndPoint) DeepCopyInto(out *EndPoint) {
 	*out = *in
+	if in.KinesisStreamConfig != nil {
+		in, out := &in.KinesisStreamConfig, &out.KinesisStreamConfig
+		*out = new(KinesisStreamConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StreamType != nil {
 		in, out := &in.StreamType, &out.StreamType
 		*out = new(string)
@@ -2422,20 +2582,10 @@ func (in *Headers) DeepCopy() *Headers {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Invalidation) DeepCopyInto(out *Invalidation) {
 	*out = *in
-	if in.CreateTime != nil {
-		in, out := &in.CreateTime, &out.CreateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Invalidation.
@@ -2448,6 +2598,14 @@ func (in *Invalidation) DeepCopy() *Invalidation {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Invalidation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InvalidationBatch) DeepCopyInto(out *InvalidationBatch) {
 	*out = *in
@@ -2470,6 +2628,38 @@ func (in *InvalidationBatch) DeepCopy() *InvalidationBatch {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InvalidationList) DeepCopyInto(out *InvalidationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Invalidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList.
+func (in *InvalidationList) DeepCopy() *InvalidationList {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InvalidationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationList_SDK) DeepCopyInto(out *InvalidationList_SDK) {
 	*out = *in
 	if in.IsTruncated != nil {
 		in, out := &in.IsTruncated, &out.IsTruncated
@@ -2498,28 +2688,23 @@ func (in *InvalidationList) DeepCopyInto(out *InvalidationList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList.
-func (in *InvalidationList) DeepCopy() *InvalidationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationList_SDK.
+func (in *InvalidationList_SDK) DeepCopy() *InvalidationList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(InvalidationList)
+	out := new(InvalidationList_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InvalidationSummary) DeepCopyInto(out *InvalidationSummary) {
+func (in *InvalidationObservation) DeepCopyInto(out *InvalidationObservation) {
 	*out = *in
 	if in.CreateTime != nil {
 		in, out := &in.CreateTime, &out.CreateTime
 		*out = (*in).DeepCopy()
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -2527,134 +2712,410 @@ func (in *InvalidationSummary) DeepCopyInto(out *InvalidationSummary) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSummary.
-func (in *InvalidationSummary) DeepCopy() *InvalidationSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationObservation.
+func (in *InvalidationObservation) DeepCopy() *InvalidationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InvalidationSummary)
+	out := new(InvalidationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KGKeyPairIDs) DeepCopyInto(out *KGKeyPairIDs) {
+func (in *InvalidationParameters) DeepCopyInto(out *InvalidationParameters) {
 	*out = *in
-	if in.KeyGroupID != nil {
-		in, out := &in.KeyGroupID, &out.KeyGroupID
+	if in.DistributionID != nil {
+		in, out := &in.DistributionID, &out.DistributionID
 		*out = new(string)
 		**out = **in
 	}
-	if in.KeyPairIDs != nil {
-		in, out := &in.KeyPairIDs, &out.KeyPairIDs
-		*out = new(KeyPairIDs)
+	if in.DistributionIDRef != nil {
+		in, out := &in.DistributionIDRef, &out.DistributionIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DistributionIDSelector != nil {
+		in, out := &in.DistributionIDSelector, &out.DistributionIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KGKeyPairIDs.
-func (in *KGKeyPairIDs) DeepCopy() *KGKeyPairIDs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationParameters.
+func (in *InvalidationParameters) DeepCopy() *InvalidationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KGKeyPairIDs)
+	out := new(InvalidationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
+func (in *InvalidationSpec) DeepCopyInto(out *InvalidationSpec) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSpec.
+func (in *InvalidationSpec) DeepCopy() *InvalidationSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(InvalidationSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup.
-func (in *KeyGroup) DeepCopy() *KeyGroup {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidationStatus) DeepCopyInto(out *InvalidationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationStatus.
+func (in *InvalidationStatus) DeepCopy() *InvalidationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroup)
+	out := new(InvalidationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
+func (in *InvalidationSummary) DeepCopyInto(out *InvalidationSummary) {
 	*out = *in
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupConfig.
-func (in *KeyGroupConfig) DeepCopy() *KeyGroupConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidationSummary.
+func (in *InvalidationSummary) DeepCopy() *InvalidationSummary {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroupConfig)
+	out := new(InvalidationSummary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
+func (in *Invalidation_SDK) DeepCopyInto(out *Invalidation_SDK) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
-func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Invalidation_SDK.
+func (in *Invalidation_SDK) DeepCopy() *Invalidation_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroupList)
+	out := new(Invalidation_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeyPairIDs) DeepCopyInto(out *KeyPairIDs) {
+func (in *KGKeyPairIDs) DeepCopyInto(out *KGKeyPairIDs) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]*string, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
-			}
-		}
-	}
+	if in.KeyGroupID != nil {
+		in, out := &in.KeyGroupID, &out.KeyGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyPairIDs != nil {
+		in, out := &in.KeyPairIDs, &out.KeyPairIDs
+		*out = new(KeyPairIDs)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KGKeyPairIDs.
+func (in *KGKeyPairIDs) DeepCopy() *KGKeyPairIDs {
+	if in == nil {
+		return nil
+	}
+	out := new(KGKeyPairIDs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup.
+func (in *KeyGroup) DeepCopy() *KeyGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupConfig.
+func (in *KeyGroupConfig) DeepCopy() *KeyGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
+func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupList_SDK) DeepCopyInto(out *KeyGroupList_SDK) {
+	*out = *in
+	if in.MaxItems != nil {
+		in, out := &in.MaxItems, &out.MaxItems
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NextMarker != nil {
+		in, out := &in.NextMarker, &out.NextMarker
+		*out = new(string)
+		**out = **in
+	}
+	if in.Quantity != nil {
+		in, out := &in.Quantity, &out.Quantity
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList_SDK.
+func (in *KeyGroupList_SDK) DeepCopy() *KeyGroupList_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupObservation) DeepCopyInto(out *KeyGroupObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyGroup != nil {
+		in, out := &in.KeyGroup, &out.KeyGroup
+		*out = new(KeyGroup_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupObservation.
+func (in *KeyGroupObservation) DeepCopy() *KeyGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupParameters) DeepCopyInto(out *KeyGroupParameters) {
+	*out = *in
+	if in.KeyGroupConfig != nil {
+		in, out := &in.KeyGroupConfig, &out.KeyGroupConfig
+		*out = new(KeyGroupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.CustomKeyGroupParameters.DeepCopyInto(&out.CustomKeyGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupParameters.
+func (in *KeyGroupParameters) DeepCopy() *KeyGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupSpec) DeepCopyInto(out *KeyGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupSpec.
+func (in *KeyGroupSpec) DeepCopy() *KeyGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupStatus) DeepCopyInto(out *KeyGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupStatus.
+func (in *KeyGroupStatus) DeepCopy() *KeyGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroup_SDK) DeepCopyInto(out *KeyGroup_SDK) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyGroupConfig != nil {
+		in, out := &in.KeyGroupConfig, &out.KeyGroupConfig
+		*out = new(KeyGroupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastModifiedTime != nil {
+		in, out := &in.LastModifiedTime, &out.LastModifiedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup_SDK.
+func (in *KeyGroup_SDK) DeepCopy() *KeyGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairIDs) DeepCopyInto(out *KeyPairIDs) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Quantity != nil {
 		in, out := &in.Quantity, &out.Quantity
 		*out = new(int64)
@@ -3410,15 +3871,10 @@ func (in *Paths) DeepCopy() *Paths {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKey) DeepCopyInto(out *PublicKey) {
 	*out = *in
-	if in.CreatedTime != nil {
-		in, out := &in.CreatedTime, &out.CreatedTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKey.
@@ -3431,24 +3887,22 @@ func (in *PublicKey) DeepCopy() *PublicKey {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyConfig) DeepCopyInto(out *PublicKeyConfig) {
 	*out = *in
-	if in.CallerReference != nil {
-		in, out := &in.CallerReference, &out.CallerReference
-		*out = new(string)
-		**out = **in
-	}
 	if in.Comment != nil {
 		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.EncodedKey != nil {
-		in, out := &in.EncodedKey, &out.EncodedKey
-		*out = new(string)
-		**out = **in
-	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -3468,6 +3922,38 @@ func (in *PublicKeyConfig) DeepCopy() *PublicKeyConfig {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyList) DeepCopyInto(out *PublicKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
+func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyList_SDK) DeepCopyInto(out *PublicKeyList_SDK) {
 	*out = *in
 	if in.MaxItems != nil {
 		in, out := &in.MaxItems, &out.MaxItems
@@ -3486,12 +3972,97 @@ func (in *PublicKeyList) DeepCopyInto(out *PublicKeyList) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
-func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList_SDK.
+func (in *PublicKeyList_SDK) DeepCopy() *PublicKeyList_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicKeyList)
+	out := new(PublicKeyList_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyObservation) DeepCopyInto(out *PublicKeyObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = new(PublicKey_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyObservation.
+func (in *PublicKeyObservation) DeepCopy() *PublicKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyParameters) DeepCopyInto(out *PublicKeyParameters) {
+	*out = *in
+	if in.PublicKeyConfig != nil {
+		in, out := &in.PublicKeyConfig, &out.PublicKeyConfig
+		*out = new(PublicKeyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	out.CustomPublicKeyParameters = in.CustomPublicKeyParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyParameters.
+func (in *PublicKeyParameters) DeepCopy() *PublicKeyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeySpec) DeepCopyInto(out *PublicKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeySpec.
+func (in *PublicKeySpec) DeepCopy() *PublicKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyStatus) DeepCopyInto(out *PublicKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyStatus.
+func (in *PublicKeyStatus) DeepCopy() *PublicKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3535,6 +4106,35 @@ func (in *PublicKeySummary) DeepCopy() *PublicKeySummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKey_SDK) DeepCopyInto(out *PublicKey_SDK) {
+	*out = *in
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeyConfig != nil {
+		in, out := &in.PublicKeyConfig, &out.PublicKeyConfig
+		*out = new(PublicKeyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKey_SDK.
+func (in *PublicKey_SDK) DeepCopy() *PublicKey_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKey_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueryArgProfile) DeepCopyInto(out *QueryArgProfile) {
 	*out = *in
@@ -3659,12 +4259,179 @@ func (in *QueryStringNames) DeepCopy() *QueryStringNames {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RealtimeLogConfig) DeepCopyInto(out *RealtimeLogConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfig.
+func (in *RealtimeLogConfig) DeepCopy() *RealtimeLogConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RealtimeLogConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RealtimeLogConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealtimeLogConfigList) DeepCopyInto(out *RealtimeLogConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RealtimeLogConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfigList.
+func (in *RealtimeLogConfigList) DeepCopy() *RealtimeLogConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(RealtimeLogConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RealtimeLogConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealtimeLogConfigObservation) DeepCopyInto(out *RealtimeLogConfigObservation) {
+	*out = *in
+	if in.RealtimeLogConfig != nil {
+		in, out := &in.RealtimeLogConfig, &out.RealtimeLogConfig
+		*out = new(RealtimeLogConfig_SDK)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfigObservation.
+func (in *RealtimeLogConfigObservation) DeepCopy() *RealtimeLogConfigObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RealtimeLogConfigObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealtimeLogConfigParameters) DeepCopyInto(out *RealtimeLogConfigParameters) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SamplingRate != nil {
+		in, out := &in.SamplingRate, &out.SamplingRate
+		*out = new(int64)
+		**out = **in
+	}
+	in.CustomRealtimeLogConfigParameters.DeepCopyInto(&out.CustomRealtimeLogConfigParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfigParameters.
+func (in *RealtimeLogConfigParameters) DeepCopy() *RealtimeLogConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RealtimeLogConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealtimeLogConfigSpec) DeepCopyInto(out *RealtimeLogConfigSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfigSpec.
+func (in *RealtimeLogConfigSpec) DeepCopy() *RealtimeLogConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RealtimeLogConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealtimeLogConfigStatus) DeepCopyInto(out *RealtimeLogConfigStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfigStatus.
+func (in *RealtimeLogConfigStatus) DeepCopy() *RealtimeLogConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RealtimeLogConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RealtimeLogConfig_SDK) DeepCopyInto(out *RealtimeLogConfig_SDK) {
 	*out = *in
 	if in.ARN != nil {
 		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.EndPoints != nil {
+		in, out := &in.EndPoints, &out.EndPoints
+		*out = make([]*EndPoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(EndPoint)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -3677,12 +4444,12 @@ func (in *RealtimeLogConfig) DeepCopyInto(out *RealtimeLogConfig) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfig.
-func (in *RealtimeLogConfig) DeepCopy() *RealtimeLogConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RealtimeLogConfig_SDK.
+func (in *RealtimeLogConfig_SDK) DeepCopy() *RealtimeLogConfig_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(RealtimeLogConfig)
+	out := new(RealtimeLogConfig_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -698,6 +698,10 @@ type EncryptionEntity struct {
 
 // +kubebuilder:skipversion
 type EndPoint struct {
+	// Contains information about the Amazon Kinesis data stream where you are
+	// sending real-time log data.
+	KinesisStreamConfig *KinesisStreamConfig `json:"kinesisStreamConfig,omitempty"`
+
 	StreamType *string `json:"streamType,omitempty"`
 }
 
@@ -862,22 +866,13 @@ type Headers struct {
 	Items []*string `json:"items,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type Invalidation struct {
-	CreateTime *metav1.Time `json:"createTime,omitempty"`
-
-	ID *string `json:"id,omitempty"`
-
-	Status *string `json:"status,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type InvalidationBatch struct {
 	CallerReference *string `json:"callerReference,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type InvalidationList struct {
+type InvalidationList_SDK struct {
 	IsTruncated *bool `json:"isTruncated,omitempty"`
 
 	Marker *string `json:"marker,omitempty"`
@@ -898,6 +893,15 @@ type InvalidationSummary struct {
 	Status *string `json:"status,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type Invalidation_SDK struct {
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	ID *string `json:"id,omitempty"`
+
+	Status *string `json:"status,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type KGKeyPairIDs struct {
 	KeyGroupID *string `json:"keyGroupID,omitempty"`
@@ -906,8 +910,13 @@ type KGKeyPairIDs struct {
 }
 
 // +kubebuilder:skipversion
-type KeyGroup struct {
+type KeyGroup_SDK struct {
 	ID *string `json:"id,omitempty"`
+	// A key group configuration.
+	//
+	// A key group contains a list of public keys that you can use with CloudFront
+	// signed URLs and signed cookies (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/PrivateContent.html).
+	KeyGroupConfig *KeyGroupConfig `json:"keyGroupConfig,omitempty"`
 
 	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
 }
@@ -920,7 +929,7 @@ type KeyGroupConfig struct {
 }
 
 // +kubebuilder:skipversion
-type KeyGroupList struct {
+type KeyGroupList_SDK struct {
 	MaxItems *int64 `json:"maxItems,omitempty"`
 
 	NextMarker *string `json:"nextMarker,omitempty"`
@@ -1166,25 +1175,25 @@ type Paths struct {
 }
 
 // +kubebuilder:skipversion
-type PublicKey struct {
+type PublicKey_SDK struct {
 	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
 
 	ID *string `json:"id,omitempty"`
+	// Configuration information about a public key that you can use with signed
+	// URLs and signed cookies (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/PrivateContent.html),
+	// or with field-level encryption (https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/field-level-encryption.html).
+	PublicKeyConfig *PublicKeyConfig `json:"publicKeyConfig,omitempty"`
 }
 
 // +kubebuilder:skipversion
 type PublicKeyConfig struct {
-	CallerReference *string `json:"callerReference,omitempty"`
-
 	Comment *string `json:"comment,omitempty"`
 
-	EncodedKey *string `json:"encodedKey,omitempty"`
-
 	Name *string `json:"name,omitempty"`
 }
 
 // +kubebuilder:skipversion
-type PublicKeyList struct {
+type PublicKeyList_SDK struct {
 	MaxItems *int64 `json:"maxItems,omitempty"`
 
 	NextMarker *string `json:"nextMarker,omitempty"`
@@ -1235,9 +1244,13 @@ type QueryStringNames struct {
 }
 
 // +kubebuilder:skipversion
-type RealtimeLogConfig struct {
+type RealtimeLogConfig_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
+	EndPoints []*EndPoint `json:"endPoints,omitempty"`
+
+	Fields []*string `json:"fields,omitempty"`
+
 	Name *string `json:"name,omitempty"`
 
 	SamplingRate *int64 `json:"samplingRate,omitempty"`
@@ -248,6 +248,11 @@ func (in *LogGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogGroupObservation) DeepCopyInto(out *LogGroupObservation) {
 	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogGroupObservation.
@@ -317,7 +322,7 @@ func (in *LogGroupSpec) DeepCopy() *LogGroupSpec {
 func (in *LogGroupStatus) DeepCopyInto(out *LogGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogGroupStatus.
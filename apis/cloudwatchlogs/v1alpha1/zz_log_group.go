@@ -50,6 +50,7 @@ type LogGroupSpec struct {
 
 // LogGroupObservation defines the observed state of LogGroup
 type LogGroupObservation struct {
+	ARN *string `json:"arn,omitempty"`
 }
 
 // LogGroupStatus defines the observed state of LogGroup.
@@ -64,6 +65,7 @@ type LogGroupStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomDocumentClassifierParameters contains the additional fields for
+// DocumentClassifierParameters.
+type CustomDocumentClassifierParameters struct {
+	// The Amazon Resource Name (ARN) of the AWS Identity and Management (IAM)
+	// role that grants Amazon Comprehend read access to your input data.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	DataAccessRoleARN *string `json:"dataAccessRoleARN,omitempty"`
+
+	// DataAccessRoleARNRef is a reference to an IAM Role used to set the
+	// DataAccessRoleARN.
+	// +optional
+	DataAccessRoleARNRef *xpv1.Reference `json:"dataAccessRoleARNRef,omitempty"`
+
+	// DataAccessRoleARNSelector selects references to an IAM Role used to set
+	// the DataAccessRoleARN.
+	// +optional
+	DataAccessRoleARNSelector *xpv1.Selector `json:"dataAccessRoleARNSelector,omitempty"`
+}
+
+// CustomEndpointParameters contains the additional fields for
+// EndpointParameters.
+type CustomEndpointParameters struct {
+	// The Amazon Resource Number (ARN) of the model to which the endpoint will
+	// be attached.
+	// +optional
+	// +crossplane:generate:reference:type=DocumentClassifier
+	// +crossplane:generate:reference:extractor=DocumentClassifierARN()
+	ModelARN *string `json:"modelARN,omitempty"`
+
+	// ModelARNRef is a reference to a DocumentClassifier used to set the
+	// ModelARN.
+	// +optional
+	ModelARNRef *xpv1.Reference `json:"modelARNRef,omitempty"`
+
+	// ModelARNSelector selects references to a DocumentClassifier used to set
+	// the ModelARN.
+	// +optional
+	ModelARNSelector *xpv1.Selector `json:"modelARNSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the AWS identity and Access Management
+	// (IAM) role that grants Amazon Comprehend read access to trained custom
+	// models encrypted with a customer managed key (ModelKmsKeyId).
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	DataAccessRoleARN *string `json:"dataAccessRoleARN,omitempty"`
+
+	// DataAccessRoleARNRef is a reference to an IAM Role used to set the
+	// DataAccessRoleARN.
+	// +optional
+	DataAccessRoleARNRef *xpv1.Reference `json:"dataAccessRoleARNRef,omitempty"`
+
+	// DataAccessRoleARNSelector selects references to an IAM Role used to set
+	// the DataAccessRoleARN.
+	// +optional
+	DataAccessRoleARNSelector *xpv1.Selector `json:"dataAccessRoleARNSelector,omitempty"`
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: ContactFlow is not generated since the Amazon Connect API does not
+// provide a way to delete contact flows.
+
+// ContactFlowParameters defines the desired state of ContactFlow
+type ContactFlowParameters struct {
+	// Region is which region the ContactFlow will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The identifier of the Amazon Connect instance.
+	// +immutable
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef is a reference to an Instance used to set
+	// the InstanceID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects references to Instance used
+	// to set the InstanceID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// The name of the contact flow.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+
+	// The type of the contact flow. For descriptions of the available types, see
+	// Choose a Contact Flow Type (https://docs.aws.amazon.com/connect/latest/adminguide/create-contact-flow.html#contact-flow-types)
+	// in the Amazon Connect Administrator Guide.
+	// +immutable
+	// +kubebuilder:validation:Required
+	Type *string `json:"type"`
+
+	// The description of the contact flow.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The content of the contact flow in the Amazon Connect Flow language.
+	// It is compared semantically, so formatting and key order do not matter.
+	// +kubebuilder:validation:Required
+	Content *string `json:"content"`
+
+	// One or more tags.
+	// +immutable
+	// +optional
+	Tags map[string]*string `json:"tags,omitempty"`
+}
+
+// ContactFlowSpec defines the desired state of ContactFlow
+type ContactFlowSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ContactFlowParameters `json:"forProvider"`
+}
+
+// ContactFlowObservation defines the observed state of ContactFlow
+type ContactFlowObservation struct {
+	// The Amazon Resource Name (ARN) of the contact flow.
+	ARN *string `json:"arn,omitempty"`
+
+	// The identifier of the contact flow.
+	ID *string `json:"id,omitempty"`
+}
+
+// ContactFlowStatus defines the observed state of ContactFlow.
+type ContactFlowStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ContactFlowObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContactFlow is the Schema for the ContactFlows API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ContactFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ContactFlowSpec   `json:"spec"`
+	Status            ContactFlowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContactFlowList contains a list of ContactFlows
+type ContactFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContactFlow `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	ContactFlowKind             = "ContactFlow"
+	ContactFlowGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ContactFlowKind}.String()
+	ContactFlowKindAPIVersion   = ContactFlowKind + "." + GroupVersion.String()
+	ContactFlowGroupVersionKind = GroupVersion.WithKind(ContactFlowKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ContactFlow{}, &ContactFlowList{})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomInstanceParameters includes custom additional fields for InstanceParameters.
+type CustomInstanceParameters struct{}
+
+// CustomHoursOfOperationParameters includes custom additional fields for HoursOfOperationParameters.
+type CustomHoursOfOperationParameters struct {
+	// The identifier of the Amazon Connect instance.
+	// +immutable
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef is a reference to an Instance used to set
+	// the InstanceID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects references to Instance used
+	// to set the InstanceID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: Queue is not generated since the Amazon Connect API does not provide
+// a way to delete queues and updates them through several operations.
+
+// QueueParameters defines the desired state of Queue
+type QueueParameters struct {
+	// Region is which region the Queue will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The identifier of the Amazon Connect instance.
+	// +immutable
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef is a reference to an Instance used to set
+	// the InstanceID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects references to Instance used
+	// to set the InstanceID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// The name of the queue.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+
+	// The description of the queue.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The identifier for the hours of operation.
+	// +optional
+	HoursOfOperationID *string `json:"hoursOfOperationId,omitempty"`
+
+	// HoursOfOperationIDRef is a reference to an HoursOfOperation used to set
+	// the HoursOfOperationID.
+	// +optional
+	HoursOfOperationIDRef *xpv1.Reference `json:"hoursOfOperationIdRef,omitempty"`
+
+	// HoursOfOperationIDSelector selects references to HoursOfOperation used
+	// to set the HoursOfOperationID.
+	// +optional
+	HoursOfOperationIDSelector *xpv1.Selector `json:"hoursOfOperationIdSelector,omitempty"`
+
+	// The maximum number of contacts that can be in the queue before it is
+	// considered full.
+	// +optional
+	MaxContacts *int64 `json:"maxContacts,omitempty"`
+
+	// The outbound caller ID name, number, and outbound whisper flow.
+	// +optional
+	OutboundCallerConfig *OutboundCallerConfig `json:"outboundCallerConfig,omitempty"`
+
+	// The quick connects available to agents who are working the queue.
+	// +immutable
+	// +optional
+	QuickConnectIDs []*string `json:"quickConnectIds,omitempty"`
+
+	// The status of the queue. Valid values are ENABLED and DISABLED.
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// The tags used to organize, track, or control access for this resource.
+	// +immutable
+	// +optional
+	Tags map[string]*string `json:"tags,omitempty"`
+}
+
+// QueueSpec defines the desired state of Queue
+type QueueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       QueueParameters `json:"forProvider"`
+}
+
+// QueueObservation defines the observed state of Queue
+type QueueObservation struct {
+	// The Amazon Resource Name (ARN) for the queue.
+	QueueARN *string `json:"queueARN,omitempty"`
+
+	// The identifier for the queue.
+	QueueID *string `json:"queueID,omitempty"`
+}
+
+// QueueStatus defines the observed state of Queue.
+type QueueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          QueueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Queue is the Schema for the Queues API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Queue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              QueueSpec   `json:"spec"`
+	Status            QueueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QueueList contains a list of Queues
+type QueueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Queue `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	QueueKind             = "Queue"
+	QueueGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: QueueKind}.String()
+	QueueKindAPIVersion   = QueueKind + "." + GroupVersion.String()
+	QueueGroupVersionKind = GroupVersion.WithKind(QueueKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Queue{}, &QueueList{})
+}
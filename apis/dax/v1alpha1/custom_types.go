@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomClusterParameters contains the additional fields for
+// ClusterParameters.
+type CustomClusterParameters struct {
+	// A valid Amazon Resource Name (ARN) that identifies an IAM role. At runtime,
+	// DAX will assume this role and use the role's permissions to access DynamoDB
+	// on your behalf.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	IAMRoleARN *string `json:"iamRoleARN,omitempty"`
+
+	// IAMRoleARNRef is a reference to an IAM Role used to set the IAMRoleARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleARNRef,omitempty"`
+
+	// IAMRoleARNSelector selects references to an IAM Role used to set the
+	// IAMRoleARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleARNSelector,omitempty"`
+
+	// The name of the parameter group to be associated with the DAX cluster.
+	// +optional
+	// +crossplane:generate:reference:type=ParameterGroup
+	ParameterGroupName *string `json:"parameterGroupName,omitempty"`
+
+	// ParameterGroupNameRef is a reference to a ParameterGroup used to set the
+	// ParameterGroupName.
+	// +optional
+	ParameterGroupNameRef *xpv1.Reference `json:"parameterGroupNameRef,omitempty"`
+
+	// ParameterGroupNameSelector selects references to a ParameterGroup used to
+	// set the ParameterGroupName.
+	// +optional
+	ParameterGroupNameSelector *xpv1.Selector `json:"parameterGroupNameSelector,omitempty"`
+
+	// The name of the subnet group to be used for the replication group.
+	//
+	// DAX clusters can only run in an Amazon VPC environment. All of the subnets
+	// that you specify in a subnet group must exist in the same VPC.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=SubnetGroup
+	SubnetGroupName *string `json:"subnetGroupName,omitempty"`
+
+	// SubnetGroupNameRef is a reference to a SubnetGroup used to set the
+	// SubnetGroupName.
+	// +optional
+	SubnetGroupNameRef *xpv1.Reference `json:"subnetGroupNameRef,omitempty"`
+
+	// SubnetGroupNameSelector selects references to a SubnetGroup used to set
+	// the SubnetGroupName.
+	// +optional
+	SubnetGroupNameSelector *xpv1.Selector `json:"subnetGroupNameSelector,omitempty"`
+
+	// A list of security group IDs to be assigned to each node in the DAX cluster.
+	// If this parameter is not specified, DAX assigns the default VPC security
+	// group to each node.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	SecurityGroupIDs []*string `json:"securityGroupIDs,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIDRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIDSelector,omitempty"`
+}
+
+// CustomParameterGroupParameters contains the additional fields for
+// ParameterGroupParameters.
+type CustomParameterGroupParameters struct {
+	// An array of name-value pairs for the parameters in the group. Each element
+	// in the array represents a single parameter.
+	//
+	// record-ttl-millis and query-ttl-millis are the only supported parameter
+	// names.
+	// +optional
+	ParameterNameValues []*ParameterNameValue `json:"parameterNameValues,omitempty"`
+}
+
+// CustomSubnetGroupParameters contains the additional fields for
+// SubnetGroupParameters.
+type CustomSubnetGroupParameters struct {
+	// A list of VPC subnet IDs for the subnet group.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetIDSelector
+	SubnetIDs []*string `json:"subnetIDs,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIDRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+}
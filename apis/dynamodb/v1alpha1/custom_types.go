@@ -16,7 +16,31 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ReasonUpdatingIndex is the reason of the Ready condition of a Table that is
+// active but still creating, deleting or backfilling a global secondary
+// index.
+const ReasonUpdatingIndex xpv1.ConditionReason = "UpdatingIndex"
+
+// UpdatingIndex returns a condition that indicates the Table is not ready yet
+// because the supplied global secondary index is being updated.
+func UpdatingIndex(name string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpdatingIndex,
+		Message:            fmt.Sprintf("updating global secondary index %s", name),
+	}
+}
 
 // CustomBackupParameters are custom parameters for Backup.
 type CustomBackupParameters struct {
@@ -32,7 +56,21 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// PointInTimeRecoveryEnabled indicates whether continuous backups with
+	// point in time recovery are enabled for the table. Point in time
+	// recovery is left untouched if this field is not set.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+
+	// AllowGlobalSecondaryIndexReplacement allows global secondary indexes
+	// whose key schema or projection changed to be replaced. AWS cannot
+	// update either of them in place, so the index is deleted and created
+	// again, and it cannot be queried until it has been backfilled. Such
+	// changes are ignored unless this field is set to true.
+	// +optional
+	AllowGlobalSecondaryIndexReplacement *bool `json:"allowGlobalSecondaryIndexReplacement,omitempty"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
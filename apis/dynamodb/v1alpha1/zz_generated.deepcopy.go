@@ -707,6 +707,16 @@ func (in *CustomGlobalTableParameters) DeepCopy() *CustomGlobalTableParameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTableParameters) DeepCopyInto(out *CustomTableParameters) {
 	*out = *in
+	if in.PointInTimeRecoveryEnabled != nil {
+		in, out := &in.PointInTimeRecoveryEnabled, &out.PointInTimeRecoveryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowGlobalSecondaryIndexReplacement != nil {
+		in, out := &in.AllowGlobalSecondaryIndexReplacement, &out.AllowGlobalSecondaryIndexReplacement
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomTableParameters.
@@ -2703,7 +2713,7 @@ func (in *TableParameters) DeepCopyInto(out *TableParameters) {
 			}
 		}
 	}
-	out.CustomTableParameters = in.CustomTableParameters
+	in.CustomTableParameters.DeepCopyInto(&out.CustomTableParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
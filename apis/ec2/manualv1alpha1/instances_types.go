@@ -21,6 +21,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Desired states of an Instance.
+const (
+	// InstanceDesiredStateRunning starts the instance if it is stopped.
+	InstanceDesiredStateRunning = "running"
+	// InstanceDesiredStateStopped stops the instance if it is running.
+	InstanceDesiredStateStopped = "stopped"
+	// InstanceDesiredStateHibernated hibernates the instance if it is running.
+	InstanceDesiredStateHibernated = "hibernated"
+)
+
 // InstanceParameters define the desired state of the Instances
 type InstanceParameters struct {
 	// The block device mapping entries.
@@ -66,6 +76,14 @@ type InstanceParameters struct {
 	// Alternatively, if you set InstanceInitiatedShutdownBehavior to terminate,
 	// you can terminate the instance by running the shutdown command from the instance.
 	//
+	// The state the instance should be kept in. A running instance is stopped
+	// or hibernated, and a stopped instance is started, until its state matches.
+	// Hibernation requires hibernationOptions.configured to be true at launch.
+	// The state of the instance is left alone when this is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=running;stopped;hibernated
+	DesiredState *string `json:"desiredState,omitempty"`
+
 	// Default: false
 	// +optional
 	DisableAPITermination *bool `json:"disableAPITermination,omitempty"`
@@ -393,7 +411,7 @@ type InstanceObservation struct {
 // Instance is a managed resource that represents a specified number of AWS EC2 Instance
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
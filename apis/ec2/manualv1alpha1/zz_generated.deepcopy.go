@@ -1081,6 +1081,11 @@ func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
 		*out = new(CreditSpecificationRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DesiredState != nil {
+		in, out := &in.DesiredState, &out.DesiredState
+		*out = new(string)
+		**out = **in
+	}
 	if in.DisableAPITermination != nil {
 		in, out := &in.DisableAPITermination, &out.DisableAPITermination
 		*out = new(bool)
@@ -56,7 +56,25 @@ var (
 	RepositoryPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryPolicyKind)
 )
 
+// ReplicationConfiguration type metadata.
+var (
+	ReplicationConfigurationKind             = reflect.TypeOf(ReplicationConfiguration{}).Name()
+	ReplicationConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: ReplicationConfigurationKind}.String()
+	ReplicationConfigurationKindAPIVersion   = ReplicationConfigurationKind + "." + SchemeGroupVersion.String()
+	ReplicationConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(ReplicationConfigurationKind)
+)
+
+// RegistryPolicy type metadata.
+var (
+	RegistryPolicyKind             = reflect.TypeOf(RegistryPolicy{}).Name()
+	RegistryPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: RegistryPolicyKind}.String()
+	RegistryPolicyKindAPIVersion   = RegistryPolicyKind + "." + SchemeGroupVersion.String()
+	RegistryPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RegistryPolicyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
 	SchemeBuilder.Register(&RepositoryPolicy{}, &RepositoryPolicyList{})
+	SchemeBuilder.Register(&ReplicationConfiguration{}, &ReplicationConfigurationList{})
+	SchemeBuilder.Register(&RegistryPolicy{}, &RegistryPolicyList{})
 }
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RegistryPolicyParameters define the desired state of the permissions
+// policy of an AWS Elastic Container Registry
+type RegistryPolicyParameters struct {
+
+	// Region is the region of the registry the policy is applied to.
+	Region string `json:"region"`
+
+	// Policy is the JSON text of the registry permissions policy. It grants
+	// other accounts permission to replicate to the registry or to create
+	// repositories in it.
+	Policy string `json:"policy"`
+}
+
+// A RegistryPolicySpec defines the desired state of a registry policy.
+type RegistryPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	ForProvider RegistryPolicyParameters `json:"forProvider"`
+}
+
+// RegistryPolicyObservation keeps the state for the external resource
+type RegistryPolicyObservation struct {
+	// The AWS account ID associated with the registry.
+	RegistryID string `json:"registryId,omitempty"`
+}
+
+// A RegistryPolicyStatus represents the observed state of a registry policy.
+type RegistryPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RegistryPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RegistryPolicy is a managed resource that represents the permissions
+// policy of an Elastic Container Registry. A registry has a single policy per
+// region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RegistryPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RegistryPolicySpec   `json:"spec"`
+	Status RegistryPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RegistryPolicyList contains a list of RegistryPolicies
+type RegistryPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RegistryPolicy `json:"items"`
+}
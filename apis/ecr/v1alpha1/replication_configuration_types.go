@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ReplicationConfigurationParameters define the desired state of the
+// replication configuration of an AWS Elastic Container Registry
+type ReplicationConfigurationParameters struct {
+
+	// Region is the region of the registry whose images are replicated.
+	Region string `json:"region"`
+
+	// The replication rules of the registry. A replication configuration may
+	// contain a maximum of 10 rules.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Rules []ReplicationRule `json:"rules"`
+}
+
+// ReplicationRule defines where images of a registry are replicated to.
+type ReplicationRule struct {
+
+	// The destinations the images are replicated to. A rule may contain a
+	// maximum of 25 destinations.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	Destinations []ReplicationDestination `json:"destinations"`
+
+	// The filters that select the repositories whose images are replicated.
+	// All repositories are replicated if no filter is set.
+	// +optional
+	RepositoryFilters []RepositoryFilter `json:"repositoryFilters,omitempty"`
+}
+
+// ReplicationDestination defines a registry images are replicated to.
+type ReplicationDestination struct {
+
+	// The region to replicate to.
+	Region string `json:"region"`
+
+	// The AWS account ID of the registry to replicate to. Use your own
+	// account ID for cross-region replication within your own registry.
+	RegistryID string `json:"registryId"`
+}
+
+// RepositoryFilter selects the repositories whose images are replicated.
+type RepositoryFilter struct {
+
+	// The repository name prefix of the repositories to replicate.
+	Filter string `json:"filter"`
+
+	// The repository filter type. The only supported value is PREFIX_MATCH.
+	// +kubebuilder:validation:Enum=PREFIX_MATCH
+	FilterType string `json:"filterType"`
+}
+
+// A ReplicationConfigurationSpec defines the desired state of a replication
+// configuration.
+type ReplicationConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	ForProvider ReplicationConfigurationParameters `json:"forProvider"`
+}
+
+// ReplicationConfigurationObservation keeps the state for the external resource
+type ReplicationConfigurationObservation struct {
+	// The AWS account ID associated with the registry.
+	RegistryID string `json:"registryId,omitempty"`
+}
+
+// A ReplicationConfigurationStatus represents the observed state of a
+// replication configuration.
+type ReplicationConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReplicationConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReplicationConfiguration is a managed resource that represents the
+// replication configuration of an Elastic Container Registry. A registry has
+// a single replication configuration per region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReplicationConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationConfigurationSpec   `json:"spec"`
+	Status ReplicationConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationConfigurationList contains a list of ReplicationConfigurations
+type ReplicationConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationConfiguration `json:"items"`
+}
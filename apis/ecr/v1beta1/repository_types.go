@@ -41,6 +41,11 @@ type RepositoryParameters struct {
 	// +kubebuilder:validation:Enum=MUTABLE;IMMUTABLE
 	ImageTagMutability *string `json:"imageTagMutability,omitempty"`
 
+	// The JSON text of the lifecycle policy of the repository. The lifecycle
+	// policy is left alone if it is not set.
+	// +optional
+	LifecyclePolicy *string `json:"lifecyclePolicy,omitempty"`
+
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -112,8 +117,9 @@ type RepositoryStatus struct {
 // A Repository is a managed resource that represents an Elastic Container Repository
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
-// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="URI",type="string",JSONPath=".status.atProvider.repositoryUri"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
 type Repository struct {
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomClusterParameters includes custom additional fields for ClusterParameters.
+type CustomClusterParameters struct{}
+
+// CustomServiceParameters includes custom additional fields for ServiceParameters.
+type CustomServiceParameters struct {
+	// The short name or full Amazon Resource Name (ARN) of the cluster that you
+	// run your service on.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Cluster
+	Cluster *string `json:"cluster,omitempty"`
+
+	// ClusterRef is a reference to a Cluster used to set the Cluster.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to a Cluster used to set the
+	// Cluster.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// The family and revision (family:revision) or full ARN of the task
+	// definition to run in your service.
+	// +optional
+	// +crossplane:generate:reference:type=TaskDefinition
+	TaskDefinition *string `json:"taskDefinition,omitempty"`
+
+	// TaskDefinitionRef is a reference to a TaskDefinition used to set the
+	// TaskDefinition.
+	// +optional
+	TaskDefinitionRef *xpv1.Reference `json:"taskDefinitionRef,omitempty"`
+
+	// TaskDefinitionSelector selects a reference to a TaskDefinition used to
+	// set the TaskDefinition.
+	// +optional
+	TaskDefinitionSelector *xpv1.Selector `json:"taskDefinitionSelector,omitempty"`
+
+	// The load balancers to use with your service. The load balancers cannot
+	// be changed after the service is created.
+	// +immutable
+	// +optional
+	LoadBalancers []CustomLoadBalancer `json:"loadBalancers,omitempty"`
+}
+
+// CustomLoadBalancer contains the additional fields for LoadBalancer.
+type CustomLoadBalancer struct {
+	// The name of the container (as it appears in a container definition) to
+	// associate with the load balancer.
+	// +optional
+	ContainerName *string `json:"containerName,omitempty"`
+
+	// The port on the container to associate with the load balancer.
+	// +optional
+	ContainerPort *int64 `json:"containerPort,omitempty"`
+
+	// The name of a Classic Load Balancer to associate with the service. It
+	// must be omitted when a target group is used.
+	// +optional
+	LoadBalancerName *string `json:"loadBalancerName,omitempty"`
+
+	// The full Amazon Resource Name (ARN) of the Elastic Load Balancing target
+	// group or groups associated with a service.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/elbv2/v1alpha1.TargetGroup
+	TargetGroupARN *string `json:"targetGroupARN,omitempty"`
+
+	// TargetGroupARNRef is a reference to a TargetGroup used to set the
+	// TargetGroupARN.
+	// +optional
+	TargetGroupARNRef *xpv1.Reference `json:"targetGroupARNRef,omitempty"`
+
+	// TargetGroupARNSelector selects a reference to a TargetGroup used to set
+	// the TargetGroupARN.
+	// +optional
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupARNSelector,omitempty"`
+}
+
+// CustomTaskDefinitionParameters includes custom additional fields for TaskDefinitionParameters.
+type CustomTaskDefinitionParameters struct {
+	// The Amazon Resource Name (ARN) of the task execution role that grants
+	// the Amazon ECS container agent permission to make Amazon Web Services
+	// API calls on your behalf, e.g. to pull images or to read the secrets of
+	// the container definitions.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	ExecutionRoleARN *string `json:"executionRoleARN,omitempty"`
+
+	// ExecutionRoleARNRef is a reference to an IAM Role used to set the
+	// ExecutionRoleARN.
+	// +optional
+	ExecutionRoleARNRef *xpv1.Reference `json:"executionRoleARNRef,omitempty"`
+
+	// ExecutionRoleARNSelector selects a reference to an IAM Role used to set
+	// the ExecutionRoleARN.
+	// +optional
+	ExecutionRoleARNSelector *xpv1.Selector `json:"executionRoleARNSelector,omitempty"`
+
+	// The short name or full Amazon Resource Name (ARN) of the IAM role that
+	// containers in this task can assume.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	TaskRoleARN *string `json:"taskRoleARN,omitempty"`
+
+	// TaskRoleARNRef is a reference to an IAM Role used to set the
+	// TaskRoleARN.
+	// +optional
+	TaskRoleARNRef *xpv1.Reference `json:"taskRoleARNRef,omitempty"`
+
+	// TaskRoleARNSelector selects a reference to an IAM Role used to set the
+	// TaskRoleARN.
+	// +optional
+	TaskRoleARNSelector *xpv1.Selector `json:"taskRoleARNSelector,omitempty"`
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IRSARoleParameters define the desired state of an IRSARole.
+type IRSARoleParameters struct {
+	// Region is the region of the EKS cluster whose service account assumes
+	// the role.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the cluster whose OIDC provider is trusted by the role.
+	//
+	// ClusterName is a required field
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/eks/v1beta1.Cluster
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set
+	// the ClusterName.
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used
+	// to set the ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// Namespace of the Kubernetes service account that assumes the role.
+	Namespace string `json:"namespace"`
+
+	// ServiceAccountName is the name of the Kubernetes service account that
+	// assumes the role.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// Description is a description of the role.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// MaxSessionDuration is the duration (in seconds) that you want to set for
+	// the specified role. The default maximum of one hour is applied.
+	// This setting can have a value from 1 hour to 12 hours.
+	// +optional
+	MaxSessionDuration *int32 `json:"maxSessionDuration,omitempty"`
+
+	// Path is the path to the role.
+	// +immutable
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// PermissionsBoundary is the ARN of the policy that is used to set the
+	// permissions boundary for the role.
+	// +immutable
+	// +optional
+	PermissionsBoundary *string `json:"permissionsBoundary,omitempty"`
+
+	// PolicyARNs are the ARNs of the IAM policies attached to the role.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Policy
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.PolicyARN()
+	// +crossplane:generate:reference:refFieldName=PolicyARNRefs
+	// +crossplane:generate:reference:selectorFieldName=PolicyARNSelector
+	PolicyARNs []string `json:"policyARNs,omitempty"`
+
+	// PolicyARNRefs are references to Policies used to set the PolicyARNs.
+	// +optional
+	PolicyARNRefs []xpv1.Reference `json:"policyARNRefs,omitempty"`
+
+	// PolicyARNSelector selects references to Policies used to set the
+	// PolicyARNs.
+	// +optional
+	PolicyARNSelector *xpv1.Selector `json:"policyARNSelector,omitempty"`
+}
+
+// An IRSARoleSpec defines the desired state of an IRSARole.
+type IRSARoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IRSARoleParameters `json:"forProvider"`
+}
+
+// IRSARoleObservation is the representation of the current state that is
+// observed.
+type IRSARoleObservation struct {
+	// ARN is the Amazon Resource Name (ARN) specifying the role.
+	ARN string `json:"arn,omitempty"`
+
+	// RoleID is the stable and unique string identifying the role.
+	RoleID string `json:"roleID,omitempty"`
+
+	// OIDCProviderARN is the ARN of the IAM OIDC provider of the cluster that
+	// is trusted by the role.
+	OIDCProviderARN string `json:"oidcProviderARN,omitempty"`
+}
+
+// An IRSARoleStatus represents the observed state of an IRSARole.
+type IRSARoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IRSARoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IRSARole is a managed resource that represents an IAM Role that can be
+// assumed by a Kubernetes service account of an EKS cluster through IAM Roles
+// for Service Accounts (IRSA). The trust policy of the role is derived from
+// the OIDC issuer of the cluster. The IAM OIDC provider of the cluster has to
+// exist already.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="SERVICEACCOUNT",type="string",JSONPath=".spec.forProvider.serviceAccountName"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IRSARole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IRSARoleSpec   `json:"spec"`
+	Status IRSARoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IRSARoleList contains a list of IRSARoles
+type IRSARoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IRSARole `json:"items"`
+}
@@ -180,6 +180,11 @@ type NodeGroupParameters struct {
 	// The Kubernetes taints to be applied to the nodes in the node group.
 	Taints []Taint `json:"taints,omitempty"`
 
+	// The node group update configuration used when nodes are rolled to a new
+	// Kubernetes version or launch template version.
+	// +optional
+	UpdateConfig *NodeGroupUpdateConfig `json:"updateConfig,omitempty"`
+
 	// The Kubernetes version to use for your managed nodes. By default, the Kubernetes
 	// version of the cluster is used, and this is the only accepted specified value.
 	// +optional
@@ -306,6 +311,24 @@ type NodeGroupScalingConfig struct {
 	MinSize *int32 `json:"minSize,omitempty"`
 }
 
+// NodeGroupUpdateConfig is the configuration used when a node group is
+// updated to a new version. Only one of the fields may be set.
+type NodeGroupUpdateConfig struct {
+	// The maximum number of nodes unavailable at once during a version update.
+	// Nodes will be updated in parallel. The maximum number is 100.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxUnavailable *int32 `json:"maxUnavailable,omitempty"`
+
+	// The maximum percentage of nodes unavailable during a version update. This
+	// percentage of nodes will be updated in parallel, up to 100 nodes at once.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxUnavailablePercentage *int32 `json:"maxUnavailablePercentage,omitempty"`
+}
+
 // NodeGroupScalingConfigStatus is the observed scaling configuration for a node group.
 type NodeGroupScalingConfigStatus struct {
 	// The current number of worker nodes for the managed node group.
@@ -444,7 +467,10 @@ type NodeGroupStatus struct {
 // Service NodeGroup.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
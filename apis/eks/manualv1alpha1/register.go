@@ -53,10 +53,16 @@ var (
 	IdentityProviderConfigGroupKind        = schema.GroupKind{Group: Group, Kind: IdentityProviderConfigKind}.String()
 	IdentityProviderConfigKindAPIVersion   = IdentityProviderConfigKind + "." + SchemeGroupVersion.String()
 	IdentityProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(IdentityProviderConfigKind)
+
+	IRSARoleKind             = reflect.TypeOf(IRSARole{}).Name()
+	IRSARoleGroupKind        = schema.GroupKind{Group: Group, Kind: IRSARoleKind}.String()
+	IRSARoleKindAPIVersion   = IRSARoleKind + "." + SchemeGroupVersion.String()
+	IRSARoleGroupVersionKind = SchemeGroupVersion.WithKind(IRSARoleKind)
 )
 
 func init() {
 	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
 	SchemeBuilder.Register(&FargateProfile{}, &FargateProfileList{})
 	SchemeBuilder.Register(&IdentityProviderConfig{}, &IdentityProviderConfigList{})
+	SchemeBuilder.Register(&IRSARole{}, &IRSARoleList{})
 }
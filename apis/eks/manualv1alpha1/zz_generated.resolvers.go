@@ -29,6 +29,49 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this IRSARole.
+func (mg *IRSARole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ClusterName,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.ClusterNameRef,
+		Selector:     mg.Spec.ForProvider.ClusterNameSelector,
+		To: reference.To{
+			List:    &v1beta1.ClusterList{},
+			Managed: &v1beta1.Cluster{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ClusterName")
+	}
+	mg.Spec.ForProvider.ClusterName = rsp.ResolvedValue
+	mg.Spec.ForProvider.ClusterNameRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PolicyARNs,
+		Extract:       v1beta11.PolicyARN(),
+		References:    mg.Spec.ForProvider.PolicyARNRefs,
+		Selector:      mg.Spec.ForProvider.PolicyARNSelector,
+		To: reference.To{
+			List:    &v1beta11.PolicyList{},
+			Managed: &v1beta11.Policy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.PolicyARNs")
+	}
+	mg.Spec.ForProvider.PolicyARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PolicyARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
 // ResolveReferences of this IdentityProviderConfig.
 func (mg *IdentityProviderConfig) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -39,18 +39,6 @@ type AddonParameters struct {
 	// How to resolve parameter value conflicts when migrating an existing add-on
 	// to an Amazon EKS add-on.
 	ResolveConflicts *string `json:"resolveConflicts,omitempty"`
-	// The Amazon Resource Name (ARN) of an existing IAM role to bind to the add-on's
-	// service account. The role must be assigned the IAM permissions required by
-	// the add-on. If you don't specify an existing IAM role, then the add-on uses
-	// the permissions assigned to the node IAM role. For more information, see
-	// Amazon EKS node IAM role (https://docs.aws.amazon.com/eks/latest/userguide/create-node-role.html)
-	// in the Amazon EKS User Guide.
-	//
-	// To specify an existing IAM role, you must have an IAM OpenID Connect (OIDC)
-	// provider created for your cluster. For more information, see Enabling IAM
-	// roles for service accounts on your cluster (https://docs.aws.amazon.com/eks/latest/userguide/enable-iam-roles-for-service-accounts.html)
-	// in the Amazon EKS User Guide.
-	ServiceAccountRoleARN *string `json:"serviceAccountRoleARN,omitempty"`
 	// The metadata to apply to the cluster to assist with categorization and organization.
 	// Each tag consists of a key and an optional value, both of which you define.
 	Tags                  map[string]*string `json:"tags,omitempty"`
@@ -75,6 +63,9 @@ type AddonObservation struct {
 	Health *AddonHealth `json:"health,omitempty"`
 	// The date and time that the add-on was last modified.
 	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+	// The Amazon Resource Name (ARN) of the IAM role that is bound to the Kubernetes
+	// service account used by the add-on.
+	ServiceAccountRoleARN *string `json:"serviceAccountRoleARN,omitempty"`
 	// The status of the add-on.
 	Status *string `json:"status,omitempty"`
 }
@@ -91,6 +82,9 @@ type AddonStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.addonVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
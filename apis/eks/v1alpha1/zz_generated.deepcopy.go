@@ -198,6 +198,11 @@ func (in *AddonObservation) DeepCopyInto(out *AddonObservation) {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.ServiceAccountRoleARN != nil {
+		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
+		*out = new(string)
+		**out = **in
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -233,11 +238,6 @@ func (in *AddonParameters) DeepCopyInto(out *AddonParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServiceAccountRoleARN != nil {
-		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
-		*out = new(string)
-		**out = **in
-	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]*string, len(*in))
@@ -557,6 +557,21 @@ func (in *CustomAddonParameters) DeepCopyInto(out *CustomAddonParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountRoleARN != nil {
+		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRoleARNRef != nil {
+		in, out := &in.ServiceAccountRoleARNRef, &out.ServiceAccountRoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServiceAccountRoleARNSelector != nil {
+		in, out := &in.ServiceAccountRoleARNSelector, &out.ServiceAccountRoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAddonParameters.
@@ -6,6 +6,7 @@ import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	v1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	v1beta11 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -33,5 +34,21 @@ func (mg *Addon) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.CustomAddonParameters.ClusterName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomAddonParameters.ClusterNameRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomAddonParameters.ServiceAccountRoleARN),
+		Extract:      v1beta11.RoleARN(),
+		Reference:    mg.Spec.ForProvider.CustomAddonParameters.ServiceAccountRoleARNRef,
+		Selector:     mg.Spec.ForProvider.CustomAddonParameters.ServiceAccountRoleARNSelector,
+		To: reference.To{
+			List:    &v1beta11.RoleList{},
+			Managed: &v1beta11.Role{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomAddonParameters.ServiceAccountRoleARN")
+	}
+	mg.Spec.ForProvider.CustomAddonParameters.ServiceAccountRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomAddonParameters.ServiceAccountRoleARNRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -118,6 +118,13 @@ type FargateProfileParameters struct {
 	// +immutable
 	Selectors []FargateProfileSelector `json:"selectors,omitempty"`
 
+	// RecreateOnSelectorChange allows the Fargate profile to be deleted and
+	// created again with the new selectors when the selectors are changed.
+	// Fargate profiles cannot be updated, so if this is not set a change of
+	// the selectors is reported as an error instead.
+	// +optional
+	RecreateOnSelectorChange *bool `json:"recreateOnSelectorChange,omitempty"`
+
 	// The IDs of subnets to launch your pods into. At this time, pods running on
 	// Fargate are not assigned public IP addresses, so only private subnets (with
 	// no direct route to an Internet Gateway) are accepted for this parameter.
@@ -165,6 +172,7 @@ type FargateProfileStatus struct {
 // Service FargateProfile.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
@@ -117,6 +117,58 @@ type ClusterParameters struct {
 	// Example: 1.15
 	// +optional
 	Version *string `json:"version,omitempty"`
+
+	// Kubeconfig configures how the kubeconfig that is written to the
+	// connection secret authenticates against the Kubernetes API server.
+	// +optional
+	Kubeconfig *KubeconfigOptions `json:"kubeconfig,omitempty"`
+}
+
+// KubeconfigAuthenticationMode is the way a kubeconfig authenticates against
+// the cluster.
+type KubeconfigAuthenticationMode string
+
+// Authentication modes of the kubeconfig in the connection secret.
+const (
+	// KubeconfigAuthenticationModeToken embeds a pre-signed token that is
+	// valid for 15 minutes. The token is renewed before it expires.
+	KubeconfigAuthenticationModeToken KubeconfigAuthenticationMode = "Token"
+	// KubeconfigAuthenticationModeExec configures an exec credential plugin so
+	// that the consumer of the kubeconfig requests tokens on its own.
+	KubeconfigAuthenticationModeExec KubeconfigAuthenticationMode = "Exec"
+)
+
+// KubeconfigOptions configure the kubeconfig in the connection secret.
+type KubeconfigOptions struct {
+	// AuthenticationMode of the kubeconfig. Token embeds a short lived token
+	// that is renewed by the provider, Exec makes the consumer run a command
+	// that requests a token. Defaults to Token.
+	// +kubebuilder:validation:Enum=Token;Exec
+	// +optional
+	AuthenticationMode *KubeconfigAuthenticationMode `json:"authenticationMode,omitempty"`
+
+	// Exec configures the credential plugin that is used when the
+	// AuthenticationMode is Exec.
+	// +optional
+	Exec *KubeconfigExec `json:"exec,omitempty"`
+}
+
+// KubeconfigExec configures the exec credential plugin of a kubeconfig.
+type KubeconfigExec struct {
+	// Command that produces the token, either the AWS CLI (aws eks get-token)
+	// or aws-iam-authenticator. Defaults to aws.
+	// +kubebuilder:validation:Enum=aws;aws-iam-authenticator
+	// +optional
+	Command *string `json:"command,omitempty"`
+
+	// RoleARN of an IAM role that is assumed to request the token.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// Env contains additional environment variables for the command, e.g.
+	// AWS_PROFILE.
+	// +optional
+	Env map[string]string `json:"env,omitempty"`
 }
 
 // EncryptionConfig is the encryption configuration for a cluster.
@@ -312,6 +364,10 @@ type ClusterStatus struct {
 // Service cluster.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.version"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
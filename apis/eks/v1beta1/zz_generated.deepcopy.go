@@ -149,6 +149,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(KubeconfigOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterParameters.
@@ -324,6 +329,11 @@ func (in *FargateProfileParameters) DeepCopyInto(out *FargateProfileParameters)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RecreateOnSelectorChange != nil {
+		in, out := &in.RecreateOnSelectorChange, &out.RecreateOnSelectorChange
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]string, len(*in))
@@ -435,6 +445,63 @@ func (in *Identity) DeepCopy() *Identity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigExec) DeepCopyInto(out *KubeconfigExec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigExec.
+func (in *KubeconfigExec) DeepCopy() *KubeconfigExec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigOptions) DeepCopyInto(out *KubeconfigOptions) {
+	*out = *in
+	if in.AuthenticationMode != nil {
+		in, out := &in.AuthenticationMode, &out.AuthenticationMode
+		*out = new(KubeconfigAuthenticationMode)
+		**out = **in
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(KubeconfigExec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigOptions.
+func (in *KubeconfigOptions) DeepCopy() *KubeconfigOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogSetup) DeepCopyInto(out *LogSetup) {
 	*out = *in
@@ -16,9 +16,41 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
 // CustomCacheParameterGroupParameters includes the custom fields.
 type CustomCacheParameterGroupParameters struct {
-	// A list of parameters to associate with this DB parameter group
+	// A list of parameters to associate with this cache parameter group.
+	// Parameters whose values drift from the desired ones are modified back.
+	// Parameters that are not listed keep their current value.
 	// +optional
 	ParameterNameValues []ParameterNameValue `json:"parameters,omitempty"`
 }
+
+// CustomGlobalReplicationGroupParameters includes the custom fields.
+type CustomGlobalReplicationGroupParameters struct {
+	// The name of the primary replication group of the Global datastore.
+	// +immutable
+	// +optional
+	PrimaryReplicationGroupID *string `json:"primaryReplicationGroupId,omitempty"`
+
+	// PrimaryReplicationGroupIDRef is a reference to a ReplicationGroup used
+	// to set PrimaryReplicationGroupID.
+	// +optional
+	PrimaryReplicationGroupIDRef *xpv1.Reference `json:"primaryReplicationGroupIdRef,omitempty"`
+
+	// PrimaryReplicationGroupIDSelector selects a reference to a
+	// ReplicationGroup used to set PrimaryReplicationGroupID.
+	// +optional
+	PrimaryReplicationGroupIDSelector *xpv1.Selector `json:"primaryReplicationGroupIdSelector,omitempty"`
+
+	// RetainPrimaryReplicationGroup keeps the primary replication group as a
+	// standalone replication group when the Global datastore is deleted.
+	// Secondary replication groups have to be detached before the Global
+	// datastore can be deleted.
+	// +kubebuilder:default=true
+	// +optional
+	RetainPrimaryReplicationGroup *bool `json:"retainPrimaryReplicationGroup,omitempty"`
+}
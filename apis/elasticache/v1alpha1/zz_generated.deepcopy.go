@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -707,6 +708,41 @@ func (in *CustomCacheParameterGroupParameters) DeepCopy() *CustomCacheParameterG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomGlobalReplicationGroupParameters) DeepCopyInto(out *CustomGlobalReplicationGroupParameters) {
+	*out = *in
+	if in.PrimaryReplicationGroupID != nil {
+		in, out := &in.PrimaryReplicationGroupID, &out.PrimaryReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryReplicationGroupIDRef != nil {
+		in, out := &in.PrimaryReplicationGroupIDRef, &out.PrimaryReplicationGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PrimaryReplicationGroupIDSelector != nil {
+		in, out := &in.PrimaryReplicationGroupIDSelector, &out.PrimaryReplicationGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetainPrimaryReplicationGroup != nil {
+		in, out := &in.RetainPrimaryReplicationGroup, &out.RetainPrimaryReplicationGroup
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomGlobalReplicationGroupParameters.
+func (in *CustomGlobalReplicationGroupParameters) DeepCopy() *CustomGlobalReplicationGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomGlobalReplicationGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomerNodeEndpoint) DeepCopyInto(out *CustomerNodeEndpoint) {
 	*out = *in
@@ -859,17 +895,156 @@ func (in *GlobalNodeGroup) DeepCopy() *GlobalNodeGroup {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroup) DeepCopyInto(out *GlobalReplicationGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup.
+func (in *GlobalReplicationGroup) DeepCopy() *GlobalReplicationGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupInfo) DeepCopyInto(out *GlobalReplicationGroupInfo) {
+	*out = *in
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupMemberRole != nil {
+		in, out := &in.GlobalReplicationGroupMemberRole, &out.GlobalReplicationGroupMemberRole
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupInfo.
+func (in *GlobalReplicationGroupInfo) DeepCopy() *GlobalReplicationGroupInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupList) DeepCopyInto(out *GlobalReplicationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalReplicationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupList.
+func (in *GlobalReplicationGroupList) DeepCopy() *GlobalReplicationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupMember) DeepCopyInto(out *GlobalReplicationGroupMember) {
+	*out = *in
+	if in.AutomaticFailover != nil {
+		in, out := &in.AutomaticFailover, &out.AutomaticFailover
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationGroupID != nil {
+		in, out := &in.ReplicationGroupID, &out.ReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicationGroupRegion != nil {
+		in, out := &in.ReplicationGroupRegion, &out.ReplicationGroupRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupMember.
+func (in *GlobalReplicationGroupMember) DeepCopy() *GlobalReplicationGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupObservation) DeepCopyInto(out *GlobalReplicationGroupObservation) {
 	*out = *in
 	if in.ARN != nil {
 		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.AtRestEncryptionEnabled != nil {
+		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthTokenEnabled != nil {
+		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CacheNodeType != nil {
 		in, out := &in.CacheNodeType, &out.CacheNodeType
 		*out = new(string)
 		**out = **in
 	}
+	if in.ClusterEnabled != nil {
+		in, out := &in.ClusterEnabled, &out.ClusterEnabled
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Engine != nil {
 		in, out := &in.Engine, &out.Engine
 		*out = new(string)
@@ -880,89 +1055,203 @@ func (in *GlobalReplicationGroup) DeepCopyInto(out *GlobalReplicationGroup) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalReplicationGroupDescription != nil {
-		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
-		*out = new(string)
-		**out = **in
+	if in.GlobalNodeGroups != nil {
+		in, out := &in.GlobalNodeGroups, &out.GlobalNodeGroups
+		*out = make([]*GlobalNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalNodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
 	}
 	if in.GlobalReplicationGroupID != nil {
 		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup.
-func (in *GlobalReplicationGroup) DeepCopy() *GlobalReplicationGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupObservation.
+func (in *GlobalReplicationGroupObservation) DeepCopy() *GlobalReplicationGroupObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalReplicationGroup)
+	out := new(GlobalReplicationGroupObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalReplicationGroupInfo) DeepCopyInto(out *GlobalReplicationGroupInfo) {
+func (in *GlobalReplicationGroupParameters) DeepCopyInto(out *GlobalReplicationGroupParameters) {
 	*out = *in
-	if in.GlobalReplicationGroupID != nil {
-		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
 		*out = new(string)
 		**out = **in
 	}
-	if in.GlobalReplicationGroupMemberRole != nil {
-		in, out := &in.GlobalReplicationGroupMemberRole, &out.GlobalReplicationGroupMemberRole
+	if in.GlobalReplicationGroupIDSuffix != nil {
+		in, out := &in.GlobalReplicationGroupIDSuffix, &out.GlobalReplicationGroupIDSuffix
 		*out = new(string)
 		**out = **in
 	}
+	in.CustomGlobalReplicationGroupParameters.DeepCopyInto(&out.CustomGlobalReplicationGroupParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupInfo.
-func (in *GlobalReplicationGroupInfo) DeepCopy() *GlobalReplicationGroupInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupParameters.
+func (in *GlobalReplicationGroupParameters) DeepCopy() *GlobalReplicationGroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalReplicationGroupInfo)
+	out := new(GlobalReplicationGroupParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GlobalReplicationGroupMember) DeepCopyInto(out *GlobalReplicationGroupMember) {
+func (in *GlobalReplicationGroupSpec) DeepCopyInto(out *GlobalReplicationGroupSpec) {
 	*out = *in
-	if in.ReplicationGroupID != nil {
-		in, out := &in.ReplicationGroupID, &out.ReplicationGroupID
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupSpec.
+func (in *GlobalReplicationGroupSpec) DeepCopy() *GlobalReplicationGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupStatus) DeepCopyInto(out *GlobalReplicationGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupStatus.
+func (in *GlobalReplicationGroupStatus) DeepCopy() *GlobalReplicationGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroup_SDK) DeepCopyInto(out *GlobalReplicationGroup_SDK) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.ReplicationGroupRegion != nil {
-		in, out := &in.ReplicationGroupRegion, &out.ReplicationGroupRegion
+	if in.AtRestEncryptionEnabled != nil {
+		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthTokenEnabled != nil {
+		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
 		*out = new(string)
 		**out = **in
 	}
-	if in.Role != nil {
-		in, out := &in.Role, &out.Role
+	if in.ClusterEnabled != nil {
+		in, out := &in.ClusterEnabled, &out.ClusterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalNodeGroups != nil {
+		in, out := &in.GlobalNodeGroups, &out.GlobalNodeGroups
+		*out = make([]*GlobalNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalNodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupMember.
-func (in *GlobalReplicationGroupMember) DeepCopy() *GlobalReplicationGroupMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup_SDK.
+func (in *GlobalReplicationGroup_SDK) DeepCopy() *GlobalReplicationGroup_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(GlobalReplicationGroupMember)
+	out := new(GlobalReplicationGroup_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
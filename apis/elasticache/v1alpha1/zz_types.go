@@ -228,20 +228,32 @@ type GlobalNodeGroup struct {
 }
 
 // +kubebuilder:skipversion
-type GlobalReplicationGroup struct {
+type GlobalReplicationGroup_SDK struct {
 	ARN *string `json:"arn,omitempty"`
 
+	AtRestEncryptionEnabled *bool `json:"atRestEncryptionEnabled,omitempty"`
+
+	AuthTokenEnabled *bool `json:"authTokenEnabled,omitempty"`
+
 	CacheNodeType *string `json:"cacheNodeType,omitempty"`
 
+	ClusterEnabled *bool `json:"clusterEnabled,omitempty"`
+
 	Engine *string `json:"engine,omitempty"`
 
 	EngineVersion *string `json:"engineVersion,omitempty"`
 
+	GlobalNodeGroups []*GlobalNodeGroup `json:"globalNodeGroups,omitempty"`
+
 	GlobalReplicationGroupDescription *string `json:"globalReplicationGroupDescription,omitempty"`
 
 	GlobalReplicationGroupID *string `json:"globalReplicationGroupID,omitempty"`
 
+	Members []*GlobalReplicationGroupMember `json:"members,omitempty"`
+
 	Status *string `json:"status,omitempty"`
+
+	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
 }
 
 // +kubebuilder:skipversion
@@ -253,6 +265,8 @@ type GlobalReplicationGroupInfo struct {
 
 // +kubebuilder:skipversion
 type GlobalReplicationGroupMember struct {
+	AutomaticFailover *string `json:"automaticFailover,omitempty"`
+
 	ReplicationGroupID *string `json:"replicationGroupID,omitempty"`
 
 	ReplicationGroupRegion *string `json:"replicationGroupRegion,omitempty"`
@@ -0,0 +1,224 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomArchiveParameters contains the additional fields for
+// ArchiveParameters.
+type CustomArchiveParameters struct {
+	// The ARN of the event bus that sends events to the archive.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=EventBus
+	// +crossplane:generate:reference:extractor=EventBusARN()
+	EventSourceARN *string `json:"eventSourceARN,omitempty"`
+
+	// EventSourceARNRef is a reference to an EventBus used to set the
+	// EventSourceARN.
+	// +optional
+	EventSourceARNRef *xpv1.Reference `json:"eventSourceARNRef,omitempty"`
+
+	// EventSourceARNSelector selects references to an EventBus used to set the
+	// EventSourceARN.
+	// +optional
+	EventSourceARNSelector *xpv1.Selector `json:"eventSourceARNSelector,omitempty"`
+}
+
+// CustomEventBusParameters contains the additional fields for
+// EventBusParameters.
+type CustomEventBusParameters struct{}
+
+// CustomReplayParameters contains the additional fields for
+// ReplayParameters.
+type CustomReplayParameters struct {
+	// The ARN of the archive to replay events from.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Archive
+	// +crossplane:generate:reference:extractor=ArchiveARN()
+	EventSourceARN *string `json:"eventSourceARN,omitempty"`
+
+	// EventSourceARNRef is a reference to an Archive used to set the
+	// EventSourceARN.
+	// +optional
+	EventSourceARNRef *xpv1.Reference `json:"eventSourceARNRef,omitempty"`
+
+	// EventSourceARNSelector selects references to an Archive used to set the
+	// EventSourceARN.
+	// +optional
+	EventSourceARNSelector *xpv1.Selector `json:"eventSourceARNSelector,omitempty"`
+}
+
+// CustomRuleParameters contains the additional fields for RuleParameters.
+type CustomRuleParameters struct {
+	// The name or ARN of the event bus to associate with this rule. If you omit
+	// this, the default event bus is used.
+	// +immutable
+	// +optional
+	EventBusName *string `json:"eventBusName,omitempty"`
+
+	// EventBusNameRef is a reference to an EventBus used to set the
+	// EventBusName.
+	// +optional
+	EventBusNameRef *xpv1.Reference `json:"eventBusNameRef,omitempty"`
+
+	// EventBusNameSelector selects references to an EventBus used to set the
+	// EventBusName.
+	// +optional
+	EventBusNameSelector *xpv1.Selector `json:"eventBusNameSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IAM role associated with the rule.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// The targets that are invoked when the rule is triggered. Targets that
+	// are not listed here are removed from the rule.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	Targets []RuleTarget `json:"targets,omitempty"`
+}
+
+// RuleTarget is a resource that is invoked when a rule is triggered.
+type RuleTarget struct {
+	// The ID of the target within the rule.
+	// +kubebuilder:validation:Required
+	ID string `json:"id"`
+
+	// The Amazon Resource Name (ARN) of the target. Exactly one of ARN or one
+	// of the references to a Lambda Function, SQS Queue or SNS Topic should be
+	// set.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// LambdaFunctionRef is a reference to a Lambda Function used to set the
+	// ARN.
+	// +optional
+	LambdaFunctionRef *xpv1.Reference `json:"lambdaFunctionRef,omitempty"`
+
+	// LambdaFunctionSelector selects references to a Lambda Function used to
+	// set the ARN.
+	// +optional
+	LambdaFunctionSelector *xpv1.Selector `json:"lambdaFunctionSelector,omitempty"`
+
+	// SQSQueueRef is a reference to an SQS Queue used to set the ARN.
+	// +optional
+	SQSQueueRef *xpv1.Reference `json:"sqsQueueRef,omitempty"`
+
+	// SQSQueueSelector selects references to an SQS Queue used to set the
+	// ARN.
+	// +optional
+	SQSQueueSelector *xpv1.Selector `json:"sqsQueueSelector,omitempty"`
+
+	// SNSTopicRef is a reference to an SNS Topic used to set the ARN.
+	// +optional
+	SNSTopicRef *xpv1.Reference `json:"snsTopicRef,omitempty"`
+
+	// SNSTopicSelector selects references to an SNS Topic used to set the
+	// ARN.
+	// +optional
+	SNSTopicSelector *xpv1.Selector `json:"snsTopicSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IAM role to be used for this target
+	// when the rule is triggered.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// Valid JSON text passed to the target. In this case, nothing from the event
+	// itself is passed to the target.
+	// +optional
+	Input *string `json:"input,omitempty"`
+
+	// The value of the JSONPath that is used for extracting part of the matched
+	// event when passing it to the target.
+	// +optional
+	InputPath *string `json:"inputPath,omitempty"`
+
+	// Settings to enable you to provide custom input to a target based on certain
+	// event data.
+	// +optional
+	InputTransformer *RuleTargetInputTransformer `json:"inputTransformer,omitempty"`
+
+	// The FIFO message group ID to use when the target is a FIFO SQS queue.
+	// +optional
+	SQSMessageGroupID *string `json:"sqsMessageGroupID,omitempty"`
+
+	// The ARN of the SQS queue specified as the target for the dead-letter
+	// queue.
+	// +optional
+	DeadLetterQueueARN *string `json:"deadLetterQueueARN,omitempty"`
+
+	// DeadLetterQueueARNRef is a reference to an SQS Queue used to set the
+	// DeadLetterQueueARN.
+	// +optional
+	DeadLetterQueueARNRef *xpv1.Reference `json:"deadLetterQueueARNRef,omitempty"`
+
+	// DeadLetterQueueARNSelector selects references to an SQS Queue used to
+	// set the DeadLetterQueueARN.
+	// +optional
+	DeadLetterQueueARNSelector *xpv1.Selector `json:"deadLetterQueueARNSelector,omitempty"`
+
+	// The retry policy configuration to use for the dead-letter queue.
+	// +optional
+	RetryPolicy *RuleTargetRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// RuleTargetInputTransformer contains the parameters needed for you to
+// provide custom input to a target based on one or more pieces of data
+// extracted from the event.
+type RuleTargetInputTransformer struct {
+	// Map of JSON paths to be extracted from the event.
+	// +optional
+	InputPathsMap map[string]string `json:"inputPathsMap,omitempty"`
+
+	// Input template where you specify placeholders that will be filled with
+	// the values of the keys from InputPathsMap to customize the data sent to
+	// the target.
+	// +kubebuilder:validation:Required
+	InputTemplate string `json:"inputTemplate"`
+}
+
+// RuleTargetRetryPolicy contains the retry policy configuration of a target.
+type RuleTargetRetryPolicy struct {
+	// The maximum amount of time, in seconds, to continue to make retry attempts.
+	// +optional
+	MaximumEventAgeInSeconds *int64 `json:"maximumEventAgeInSeconds,omitempty"`
+
+	// The maximum number of retry attempts to make before the request fails.
+	// +optional
+	MaximumRetryAttempts *int64 `json:"maximumRetryAttempts,omitempty"`
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	snsv1beta1 "github.com/crossplane/provider-aws/apis/sns/v1beta1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// EventBusARN returns the status.atProvider.eventBusARN of an EventBus.
+func EventBusARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*EventBus)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.EventBusARN)
+	}
+}
+
+// ArchiveARN returns the status.atProvider.archiveARN of an Archive.
+func ArchiveARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Archive)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.ArchiveARN)
+	}
+}
+
+// ResolveReferences of this Rule.
+func (mg *Rule) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.eventBusName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.EventBusName),
+		Reference:    mg.Spec.ForProvider.EventBusNameRef,
+		Selector:     mg.Spec.ForProvider.EventBusNameSelector,
+		To:           reference.To{Managed: &EventBus{}, List: &EventBusList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventBusName")
+	}
+	mg.Spec.ForProvider.EventBusName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.EventBusNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.roleARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleARN")
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.Targets {
+		t := &mg.Spec.ForProvider.Targets[i]
+
+		// Resolve spec.forProvider.targets[i].arn from a Lambda Function
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.ARN),
+			Reference:    t.LambdaFunctionRef,
+			Selector:     t.LambdaFunctionSelector,
+			To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+			Extract:      lambdav1beta1.FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.targets[%d].arn", i))
+		}
+		t.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		t.LambdaFunctionRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.targets[i].arn from an SQS Queue
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.ARN),
+			Reference:    t.SQSQueueRef,
+			Selector:     t.SQSQueueSelector,
+			To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			Extract:      sqsv1beta1.QueueARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.targets[%d].arn", i))
+		}
+		t.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		t.SQSQueueRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.targets[i].arn from an SNS Topic
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.ARN),
+			Reference:    t.SNSTopicRef,
+			Selector:     t.SNSTopicSelector,
+			To:           reference.To{Managed: &snsv1beta1.Topic{}, List: &snsv1beta1.TopicList{}},
+			Extract:      snsv1beta1.SNSTopicARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.targets[%d].arn", i))
+		}
+		t.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		t.SNSTopicRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.targets[i].roleARN
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.RoleARN),
+			Reference:    t.RoleARNRef,
+			Selector:     t.RoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+			Extract:      iamv1beta1.RoleARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.targets[%d].roleARN", i))
+		}
+		t.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		t.RoleARNRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.targets[i].deadLetterQueueARN
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(t.DeadLetterQueueARN),
+			Reference:    t.DeadLetterQueueARNRef,
+			Selector:     t.DeadLetterQueueARNSelector,
+			To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			Extract:      sqsv1beta1.QueueARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.targets[%d].deadLetterQueueARN", i))
+		}
+		t.DeadLetterQueueARN = reference.ToPtrValue(rsp.ResolvedValue)
+		t.DeadLetterQueueARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
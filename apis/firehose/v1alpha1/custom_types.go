@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomDeliveryStreamParameters includes custom additional fields for DeliveryStreamParameters.
+type CustomDeliveryStreamParameters struct {
+	// The destination in Amazon S3. You can specify only one destination.
+	// +optional
+	S3DestinationConfiguration *CustomS3DestinationConfiguration `json:"s3DestinationConfiguration,omitempty"`
+
+	// The destination in Amazon S3 with support for data processing, format
+	// conversion and dynamic partitioning. You can specify only one
+	// destination.
+	// +optional
+	ExtendedS3DestinationConfiguration *CustomExtendedS3DestinationConfiguration `json:"extendedS3DestinationConfiguration,omitempty"`
+
+	// Enables configuring Kinesis Firehose to deliver data to any HTTP
+	// endpoint destination. You can specify only one destination.
+	// +optional
+	HTTPEndpointDestinationConfiguration *CustomHTTPEndpointDestinationConfiguration `json:"httpEndpointDestinationConfiguration,omitempty"`
+}
+
+// CustomS3DestinationConfiguration describes the configuration of a
+// destination in Amazon S3.
+type CustomS3DestinationConfiguration struct {
+	// The ARN of the S3 bucket.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/s3/v1beta1.Bucket
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/s3/v1beta1.BucketARN()
+	BucketARN *string `json:"bucketARN,omitempty"`
+
+	// BucketARNRef is a reference to a Bucket used to set the BucketARN.
+	// +optional
+	BucketARNRef *xpv1.Reference `json:"bucketARNRef,omitempty"`
+
+	// BucketARNSelector selects references to a Bucket used to set the
+	// BucketARN.
+	// +optional
+	BucketARNSelector *xpv1.Selector `json:"bucketARNSelector,omitempty"`
+
+	// The buffering option. If no value is specified, BufferingHints object
+	// default values are used.
+	// +optional
+	BufferingHints *BufferingHints `json:"bufferingHints,omitempty"`
+
+	// The CloudWatch logging options for your delivery stream.
+	// +optional
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `json:"cloudWatchLoggingOptions,omitempty"`
+
+	// The compression format. If no value is specified, the default is
+	// UNCOMPRESSED.
+	// +optional
+	CompressionFormat *string `json:"compressionFormat,omitempty"`
+
+	// The encryption configuration. If no value is specified, the default is
+	// no encryption.
+	// +optional
+	EncryptionConfiguration *EncryptionConfiguration `json:"encryptionConfiguration,omitempty"`
+
+	// A prefix that Kinesis Data Firehose evaluates and adds to failed records
+	// before writing them to S3.
+	// +optional
+	ErrorOutputPrefix *string `json:"errorOutputPrefix,omitempty"`
+
+	// The prefix added to delivered Amazon S3 objects. The "YYYY/MM/DD/HH"
+	// time format prefix is used if none is given.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The ARN of the IAM role Kinesis Data Firehose assumes to write to the
+	// bucket.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+}
+
+// CustomExtendedS3DestinationConfiguration describes the configuration of an
+// extended destination in Amazon S3.
+type CustomExtendedS3DestinationConfiguration struct {
+	CustomS3DestinationConfiguration `json:",inline"`
+
+	// The serializer, deserializer, and schema for converting data from the
+	// JSON format to the Parquet or ORC format before writing it to Amazon S3.
+	// +optional
+	DataFormatConversionConfiguration *DataFormatConversionConfiguration `json:"dataFormatConversionConfiguration,omitempty"`
+
+	// The configuration of the dynamic partitioning mechanism that creates
+	// smaller data sets from the streaming data by partitioning it based on
+	// partition keys.
+	// +optional
+	DynamicPartitioningConfiguration *DynamicPartitioningConfiguration `json:"dynamicPartitioningConfiguration,omitempty"`
+
+	// The data processing configuration.
+	// +optional
+	ProcessingConfiguration *ProcessingConfiguration `json:"processingConfiguration,omitempty"`
+
+	// The configuration for backup in Amazon S3.
+	// +optional
+	S3BackupConfiguration *CustomS3DestinationConfiguration `json:"s3BackupConfiguration,omitempty"`
+
+	// The Amazon S3 backup mode. After you create a delivery stream, you can
+	// update it to enable Amazon S3 backup if it is disabled. If backup is
+	// enabled, you can't update the delivery stream to disable it.
+	// +optional
+	S3BackupMode *string `json:"s3BackupMode,omitempty"`
+}
+
+// CustomHTTPEndpointDestinationConfiguration describes the configuration of
+// an HTTP endpoint destination.
+type CustomHTTPEndpointDestinationConfiguration struct {
+	// The buffering options that can be used before data is delivered to the
+	// specified destination.
+	// +optional
+	BufferingHints *HTTPEndpointBufferingHints `json:"bufferingHints,omitempty"`
+
+	// Describes the Amazon CloudWatch logging options for your delivery
+	// stream.
+	// +optional
+	CloudWatchLoggingOptions *CloudWatchLoggingOptions `json:"cloudWatchLoggingOptions,omitempty"`
+
+	// The configuration of the HTTP endpoint selected as the destination.
+	// +kubebuilder:validation:Required
+	EndpointConfiguration CustomHTTPEndpointConfiguration `json:"endpointConfiguration"`
+
+	// Describes a data processing configuration.
+	// +optional
+	ProcessingConfiguration *ProcessingConfiguration `json:"processingConfiguration,omitempty"`
+
+	// The configuration of the request sent to the HTTP endpoint specified as
+	// the destination.
+	// +optional
+	RequestConfiguration *HTTPEndpointRequestConfiguration `json:"requestConfiguration,omitempty"`
+
+	// Describes the retry behavior in case Kinesis Data Firehose is unable to
+	// deliver data to the specified HTTP endpoint destination.
+	// +optional
+	RetryOptions *HTTPEndpointRetryOptions `json:"retryOptions,omitempty"`
+
+	// Kinesis Data Firehose uses this IAM role for all the permissions that
+	// the delivery stream needs.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// Describes the S3 bucket backup options for the data that Kinesis Data
+	// Firehose delivers to the HTTP endpoint destination. You can back up all
+	// documents (AllData) or only the documents that Kinesis Data Firehose
+	// could not deliver (FailedDataOnly).
+	// +optional
+	S3BackupMode *string `json:"s3BackupMode,omitempty"`
+
+	// Describes the configuration of a destination in Amazon S3.
+	// +kubebuilder:validation:Required
+	S3Configuration CustomS3DestinationConfiguration `json:"s3Configuration"`
+}
+
+// CustomHTTPEndpointConfiguration describes the configuration of the HTTP
+// endpoint to which Kinesis Firehose delivers data.
+type CustomHTTPEndpointConfiguration struct {
+	// The name of the HTTP endpoint selected as the destination.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The URL of the HTTP endpoint selected as the destination.
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// AccessKeySecretRef references the key of a Secret holding the access key
+	// required for Kinesis Firehose to authenticate with the HTTP endpoint.
+	// +optional
+	AccessKeySecretRef *xpv1.SecretKeySelector `json:"accessKeySecretRef,omitempty"`
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomExperimentTemplateParameters includes custom additional fields for ExperimentTemplateParameters.
+type CustomExperimentTemplateParameters struct {
+	// The Amazon Resource Name (ARN) of an IAM role that grants the AWS FIS
+	// service permission to perform service actions on your behalf.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+}
+
+// CustomExperimentParameters includes custom additional fields for ExperimentParameters.
+type CustomExperimentParameters struct {
+	// The ID of the experiment template the experiment is started from.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=ExperimentTemplate
+	ExperimentTemplateID *string `json:"experimentTemplateID,omitempty"`
+
+	// ExperimentTemplateIDRef is a reference to an ExperimentTemplate used to
+	// set the ExperimentTemplateID.
+	// +optional
+	ExperimentTemplateIDRef *xpv1.Reference `json:"experimentTemplateIDRef,omitempty"`
+
+	// ExperimentTemplateIDSelector selects a reference to an
+	// ExperimentTemplate used to set the ExperimentTemplateID.
+	// +optional
+	ExperimentTemplateIDSelector *xpv1.Selector `json:"experimentTemplateIDSelector,omitempty"`
+}
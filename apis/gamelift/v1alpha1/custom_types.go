@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomAliasParameters includes custom additional fields for AliasParameters.
+type CustomAliasParameters struct {
+	// The routing configuration, including routing type and fleet target, for
+	// the alias.
+	// +kubebuilder:validation:Required
+	CustomRoutingStrategy *CustomRoutingStrategy `json:"routingStrategy"`
+}
+
+// CustomRoutingStrategy contains the additional fields for RoutingStrategy.
+type CustomRoutingStrategy struct {
+	// A unique identifier for the fleet that the alias points to. This value is
+	// the fleet ID, not the fleet ARN.
+	// +optional
+	// +crossplane:generate:reference:type=Fleet
+	FleetID *string `json:"fleetID,omitempty"`
+
+	// FleetIDRef is a reference to a Fleet used to set the FleetID.
+	// +optional
+	FleetIDRef *xpv1.Reference `json:"fleetIDRef,omitempty"`
+
+	// FleetIDSelector selects references to a Fleet used to set the FleetID.
+	// +optional
+	FleetIDSelector *xpv1.Selector `json:"fleetIDSelector,omitempty"`
+
+	// The message text to be used with a terminal routing strategy.
+	// +optional
+	Message *string `json:"message,omitempty"`
+
+	// The type of routing strategy for the alias. SIMPLE resolves to one
+	// specific fleet, TERMINAL does not resolve to a fleet but returns the
+	// message to the player instead.
+	// +kubebuilder:validation:Enum=SIMPLE;TERMINAL
+	Type *string `json:"type"`
+}
+
+// CustomFleetParameters includes custom additional fields for FleetParameters.
+type CustomFleetParameters struct {
+	// The ARN of an IAM role that the applications running on the instances of
+	// the fleet can assume. It cannot be changed after the fleet is created.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	InstanceRoleARN *string `json:"instanceRoleARN,omitempty"`
+
+	// InstanceRoleARNRef is a reference to an IAM Role used to set the
+	// InstanceRoleARN.
+	// +optional
+	InstanceRoleARNRef *xpv1.Reference `json:"instanceRoleARNRef,omitempty"`
+
+	// InstanceRoleARNSelector selects references to an IAM Role used to set
+	// the InstanceRoleARN.
+	// +optional
+	InstanceRoleARNSelector *xpv1.Selector `json:"instanceRoleARNSelector,omitempty"`
+}
+
+// CustomMatchmakingConfigurationParameters includes custom additional fields for MatchmakingConfigurationParameters.
+type CustomMatchmakingConfigurationParameters struct{}
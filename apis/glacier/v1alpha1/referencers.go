@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	snsv1beta1 "github.com/crossplane/provider-aws/apis/sns/v1beta1"
+)
+
+// ResolveReferences of this VaultNotification
+func (mg *VaultNotification) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vaultName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VaultName),
+		Reference:    mg.Spec.ForProvider.VaultNameRef,
+		Selector:     mg.Spec.ForProvider.VaultNameSelector,
+		To:           reference.To{Managed: &Vault{}, List: &VaultList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vaultName")
+	}
+	mg.Spec.ForProvider.VaultName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VaultNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.snsTopicARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SNSTopicARN),
+		Reference:    mg.Spec.ForProvider.SNSTopicARNRef,
+		Selector:     mg.Spec.ForProvider.SNSTopicARNSelector,
+		To:           reference.To{Managed: &snsv1beta1.Topic{}, List: &snsv1beta1.TopicList{}},
+		Extract:      snsv1beta1.SNSTopicARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.snsTopicARN")
+	}
+	mg.Spec.ForProvider.SNSTopicARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SNSTopicARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VaultLock
+func (mg *VaultLock) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.vaultName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VaultName),
+		Reference:    mg.Spec.ForProvider.VaultNameRef,
+		Selector:     mg.Spec.ForProvider.VaultNameSelector,
+		To:           reference.To{Managed: &Vault{}, List: &VaultList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vaultName")
+	}
+	mg.Spec.ForProvider.VaultName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VaultNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: VaultLock is not generated since the vault lock is a subresource of
+// a vault in the Glacier API that is locked in two phases.
+
+// Vault lock states.
+const (
+	VaultLockStateInProgress = "InProgress"
+	VaultLockStateLocked     = "Locked"
+)
+
+// VaultLockParameters defines the desired state of VaultLock
+type VaultLockParameters struct {
+	// Region is which region the VaultLock will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name of the vault.
+	// +immutable
+	// +optional
+	VaultName *string `json:"vaultName,omitempty"`
+
+	// VaultNameRef is a reference to a Vault used to set the VaultName.
+	// +optional
+	VaultNameRef *xpv1.Reference `json:"vaultNameRef,omitempty"`
+
+	// VaultNameSelector selects references to a Vault used to set the
+	// VaultName.
+	// +optional
+	VaultNameSelector *xpv1.Selector `json:"vaultNameSelector,omitempty"`
+
+	// The vault lock policy as a JSON string. The policy can be changed as
+	// long as the lock is in progress. It cannot be changed once the lock is
+	// completed.
+	// +kubebuilder:validation:Required
+	Policy string `json:"policy"`
+
+	// CompleteLock completes the vault lock once the policy was attached to
+	// the vault. Leave it false to test the policy during the 24 hours the
+	// initiated lock is in progress. A lock that expired before it was
+	// completed is initiated again.
+	// +optional
+	CompleteLock bool `json:"completeLock,omitempty"`
+}
+
+// VaultLockSpec defines the desired state of VaultLock
+type VaultLockSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VaultLockParameters `json:"forProvider"`
+}
+
+// VaultLockObservation defines the observed state of VaultLock
+type VaultLockObservation struct {
+	// The state of the vault lock, either InProgress or Locked.
+	State *string `json:"state,omitempty"`
+
+	// The UTC date and time at which the vault lock was put into the
+	// InProgress state.
+	CreationDate *string `json:"creationDate,omitempty"`
+
+	// The UTC date and time at which the lock ID expires. The value is only
+	// set while the vault lock is in progress.
+	ExpirationDate *string `json:"expirationDate,omitempty"`
+}
+
+// VaultLockStatus defines the observed state of VaultLock.
+type VaultLockStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VaultLockObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultLock is the Schema for the VaultLocks API. The external name of a
+// VaultLock is the lock ID returned when the lock is initiated.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VAULT",type="string",JSONPath=".spec.forProvider.vaultName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VaultLock struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultLockSpec   `json:"spec"`
+	Status            VaultLockStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultLockList contains a list of VaultLocks
+type VaultLockList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultLock `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	VaultLockKind             = "VaultLock"
+	VaultLockGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: VaultLockKind}.String()
+	VaultLockKindAPIVersion   = VaultLockKind + "." + GroupVersion.String()
+	VaultLockGroupVersionKind = GroupVersion.WithKind(VaultLockKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VaultLock{}, &VaultLockList{})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: VaultNotification is not generated since the notification
+// configuration is a subresource of a vault in the Glacier API.
+
+// VaultNotificationParameters defines the desired state of VaultNotification
+type VaultNotificationParameters struct {
+	// Region is which region the VaultNotification will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name of the vault.
+	// +immutable
+	// +optional
+	VaultName *string `json:"vaultName,omitempty"`
+
+	// VaultNameRef is a reference to a Vault used to set the VaultName.
+	// +optional
+	VaultNameRef *xpv1.Reference `json:"vaultNameRef,omitempty"`
+
+	// VaultNameSelector selects references to a Vault used to set the
+	// VaultName.
+	// +optional
+	VaultNameSelector *xpv1.Selector `json:"vaultNameSelector,omitempty"`
+
+	// The Amazon Simple Notification Service (Amazon SNS) topic Amazon
+	// Resource Name (ARN).
+	// +optional
+	SNSTopicARN *string `json:"snsTopicARN,omitempty"`
+
+	// SNSTopicARNRef is a reference to an SNS Topic used to set the
+	// SNSTopicARN.
+	// +optional
+	SNSTopicARNRef *xpv1.Reference `json:"snsTopicARNRef,omitempty"`
+
+	// SNSTopicARNSelector selects references to an SNS Topic used to set the
+	// SNSTopicARN.
+	// +optional
+	SNSTopicARNSelector *xpv1.Selector `json:"snsTopicARNSelector,omitempty"`
+
+	// A list of one or more events for which Amazon S3 Glacier will send a
+	// notification to the specified Amazon SNS topic.
+	// +kubebuilder:validation:MinItems=1
+	Events []*string `json:"events"`
+}
+
+// VaultNotificationSpec defines the desired state of VaultNotification
+type VaultNotificationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VaultNotificationParameters `json:"forProvider"`
+}
+
+// VaultNotificationObservation defines the observed state of
+// VaultNotification
+type VaultNotificationObservation struct{}
+
+// VaultNotificationStatus defines the observed state of VaultNotification.
+type VaultNotificationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VaultNotificationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultNotification is the Schema for the VaultNotifications API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VAULT",type="string",JSONPath=".spec.forProvider.vaultName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VaultNotification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultNotificationSpec   `json:"spec"`
+	Status            VaultNotificationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VaultNotificationList contains a list of VaultNotifications
+type VaultNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultNotification `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	VaultNotificationKind             = "VaultNotification"
+	VaultNotificationGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: VaultNotificationKind}.String()
+	VaultNotificationKindAPIVersion   = VaultNotificationKind + "." + GroupVersion.String()
+	VaultNotificationGroupVersionKind = GroupVersion.WithKind(VaultNotificationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&VaultNotification{}, &VaultNotificationList{})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomComponentVersionParameters includes custom additional fields for ComponentVersionParameters.
+type CustomComponentVersionParameters struct {
+	// InlineRecipe is the recipe of the component version in JSON or YAML
+	// format. Either InlineRecipe, RecipeConfigMapRef or LambdaFunction has
+	// to be given.
+	// +immutable
+	// +optional
+	InlineRecipe *string `json:"inlineRecipe,omitempty"`
+
+	// RecipeConfigMapRef references a key of a ConfigMap that contains the
+	// recipe of the component version in JSON or YAML format.
+	// +immutable
+	// +optional
+	RecipeConfigMapRef *ConfigMapKeySelector `json:"recipeConfigMapRef,omitempty"`
+
+	// Artifacts are S3 objects that are added to the artifacts of every
+	// manifest in the recipe.
+	// +immutable
+	// +optional
+	Artifacts []S3Artifact `json:"artifacts,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap that holds the recipe.
+	Key string `json:"key"`
+}
+
+// S3Artifact is an artifact of a component version that is stored in S3.
+type S3Artifact struct {
+	// BucketName is the name of the S3 bucket that holds the artifact.
+	// It has to be given directly or resolved using BucketNameRef or
+	// BucketNameSelector.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef is a reference to a Bucket used to set the BucketName.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket used to set the
+	// BucketName.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key of the artifact object in the bucket.
+	Key string `json:"key"`
+
+	// Unarchive is the archive type of the artifact. Greengrass unpacks the
+	// artifact on the core device if it is set.
+	// +kubebuilder:validation:Enum=NONE;ZIP
+	// +optional
+	Unarchive *string `json:"unarchive,omitempty"`
+
+	// Permission defines who can read and execute the artifact on the core
+	// device.
+	// +optional
+	Permission *ArtifactPermission `json:"permission,omitempty"`
+}
+
+// ArtifactPermission is the file permission of an artifact on a core device.
+type ArtifactPermission struct {
+	// Read is the permission to read the artifact.
+	// +kubebuilder:validation:Enum=NONE;OWNER;ALL
+	// +optional
+	Read *string `json:"read,omitempty"`
+
+	// Execute is the permission to execute the artifact.
+	// +kubebuilder:validation:Enum=NONE;OWNER;ALL
+	// +optional
+	Execute *string `json:"execute,omitempty"`
+}
+
+// CustomDeploymentParameters includes custom additional fields for DeploymentParameters.
+type CustomDeploymentParameters struct {
+	// TargetARN is the ARN of the IoT thing or thing group to deploy to.
+	// It has to be given directly or resolved using TargetARNRef or
+	// TargetARNSelector. Only references to things can be resolved.
+	// +immutable
+	// +optional
+	TargetARN *string `json:"targetArn,omitempty"`
+
+	// TargetARNRef is a reference to a Thing used to set the TargetARN.
+	// +optional
+	TargetARNRef *xpv1.Reference `json:"targetArnRef,omitempty"`
+
+	// TargetARNSelector selects a reference to a Thing used to set the
+	// TargetARN.
+	// +optional
+	TargetARNSelector *xpv1.Selector `json:"targetArnSelector,omitempty"`
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this ComponentVersion
+func (mg *ComponentVersion) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i, a := range mg.Spec.ForProvider.Artifacts {
+		// Resolve spec.forProvider.artifacts[*].bucketName
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(a.BucketName),
+			Reference:    a.BucketNameRef,
+			Selector:     a.BucketNameSelector,
+			To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.artifacts[%d].bucketName", i))
+		}
+		mg.Spec.ForProvider.Artifacts[i].BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Artifacts[i].BucketNameRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this Deployment
+func (mg *Deployment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.targetArn
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.TargetARN),
+		Reference:    mg.Spec.ForProvider.TargetARNRef,
+		Selector:     mg.Spec.ForProvider.TargetARNSelector,
+		To:           reference.To{Managed: &iotv1alpha1.Thing{}, List: &iotv1alpha1.ThingList{}},
+		Extract:      ThingARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetArn")
+	}
+	mg.Spec.ForProvider.TargetARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetARNRef = rsp.ResolvedReference
+	return nil
+}
+
+// ThingARN returns the status.atProvider.thingARN of a Thing.
+func ThingARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*iotv1alpha1.Thing)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.ThingARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.ThingARN
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomConfigParameters includes custom additional fields for ConfigParameters.
+type CustomConfigParameters struct{}
+
+// CustomDataflowEndpointGroupParameters includes custom additional fields for DataflowEndpointGroupParameters.
+type CustomDataflowEndpointGroupParameters struct{}
+
+// CustomMissionProfileParameters includes custom additional fields for MissionProfileParameters.
+type CustomMissionProfileParameters struct {
+	// ARN of a tracking Config.
+	// +optional
+	TrackingConfigARN *string `json:"trackingConfigARN,omitempty"`
+
+	// TrackingConfigARNRef is a reference to a Config used to set the
+	// TrackingConfigARN.
+	// +optional
+	TrackingConfigARNRef *xpv1.Reference `json:"trackingConfigARNRef,omitempty"`
+
+	// TrackingConfigARNSelector selects references to a Config used to set
+	// the TrackingConfigARN.
+	// +optional
+	TrackingConfigARNSelector *xpv1.Selector `json:"trackingConfigARNSelector,omitempty"`
+}
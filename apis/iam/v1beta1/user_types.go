@@ -62,10 +62,11 @@ type UserStatus struct {
 // +kubebuilder:object:root=true
 
 // An User is a managed resource that represents an AWS IAM User.
-// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
-// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.userId"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.userId"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
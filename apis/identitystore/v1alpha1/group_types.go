@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GroupParameters identify an existing group in an Identity Store.
+type GroupParameters struct {
+	// Region is the region of the Identity Store.
+	// +immutable
+	Region string `json:"region"`
+
+	// IdentityStoreID is the globally unique identifier of the Identity
+	// Store, such as d-1234567890.
+	// +immutable
+	IdentityStoreID string `json:"identityStoreID"`
+
+	// The display name of the group.
+	// +immutable
+	DisplayName string `json:"displayName"`
+}
+
+// A GroupSpec defines the desired state of a Group.
+type GroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupParameters `json:"forProvider"`
+}
+
+// GroupObservation is the representation of the current state that is
+// observed.
+type GroupObservation struct {
+	// GroupID is the identifier of the group in the Identity Store.
+	GroupID string `json:"groupID,omitempty"`
+}
+
+// A GroupStatus represents the observed state of a Group.
+type GroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Group looks up a group in an Identity Store. The Identity Store API only
+// supports reading groups, so a Group never creates, updates or deletes the
+// group it observes.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.groupID"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Group struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupSpec   `json:"spec"`
+	Status GroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupList contains a list of Groups
+type GroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Group `json:"items"`
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// GroupID returns the status.atProvider.groupID of a Group.
+func GroupID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Group)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.GroupID
+	}
+}
+
+// UserID returns the status.atProvider.userID of a User.
+func UserID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*User)
+		if !ok {
+			return ""
+		}
+		return r.Status.AtProvider.UserID
+	}
+}
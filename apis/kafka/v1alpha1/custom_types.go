@@ -31,6 +31,31 @@ type CustomClusterParameters struct {
 
 	// Represents the configuration that you want MSK to use for the cluster.
 	CustomConfigurationInfo *CustomConfigurationInfo `json:"configurationInfo,omitempty"`
+
+	// StorageAutoScaling configures Application Auto Scaling to expand the
+	// broker storage of the cluster when its utilization crosses the target.
+	// Note that MSK never shrinks broker storage. Existing auto scaling is
+	// left untouched if this field is not set.
+	// +optional
+	StorageAutoScaling *StorageAutoScaling `json:"storageAutoScaling,omitempty"`
+}
+
+// StorageAutoScaling holds the parameters of the broker storage auto scaling
+// policy of a cluster.
+type StorageAutoScaling struct {
+	// MaxCapacity is the maximum broker storage size in GiB that auto
+	// scaling may expand the brokers to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16384
+	MaxCapacity int64 `json:"maxCapacity"`
+
+	// TargetUtilization is the percentage of broker storage utilization at
+	// which auto scaling expands the storage.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=80
+	// +kubebuilder:default=60
+	// +optional
+	TargetUtilization int64 `json:"targetUtilization,omitempty"`
 }
 
 // CustomConfigurationInfo contains the additional fields for ConfigurationInfo.
@@ -69,6 +94,11 @@ type CustomBrokerNodeGroupInfo struct {
 	// +optional
 	ClientSubnetSelector *xpv1.Selector `json:"clientSubnetSelector,omitempty"`
 
+	// The distribution of broker nodes across Availability Zones. The only
+	// supported value is DEFAULT.
+	// +optional
+	BrokerAZDistribution *string `json:"brokerAZDistribution,omitempty"`
+
 	InstanceType *string `json:"instanceType,omitempty"`
 
 	// +optional
@@ -983,6 +983,11 @@ func (in *CustomBrokerNodeGroupInfo) DeepCopyInto(out *CustomBrokerNodeGroupInfo
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BrokerAZDistribution != nil {
+		in, out := &in.BrokerAZDistribution, &out.BrokerAZDistribution
+		*out = new(string)
+		**out = **in
+	}
 	if in.InstanceType != nil {
 		in, out := &in.InstanceType, &out.InstanceType
 		*out = new(string)
@@ -1039,6 +1044,11 @@ func (in *CustomClusterParameters) DeepCopyInto(out *CustomClusterParameters) {
 		*out = new(CustomConfigurationInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StorageAutoScaling != nil {
+		in, out := &in.StorageAutoScaling, &out.StorageAutoScaling
+		*out = new(StorageAutoScaling)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomClusterParameters.
@@ -1661,6 +1671,21 @@ func (in *StateInfo) DeepCopy() *StateInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageAutoScaling) DeepCopyInto(out *StorageAutoScaling) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAutoScaling.
+func (in *StorageAutoScaling) DeepCopy() *StorageAutoScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageAutoScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageInfo) DeepCopyInto(out *StorageInfo) {
 	*out = *in
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: Permission is not generated since a permission is a statement of the
+// resource-based policy of a function that is added and removed with
+// AddPermission and RemovePermission and observed through GetPolicy.
+
+// PermissionParameters defines the desired state of Permission
+type PermissionParameters struct {
+	// Region is which region the Permission will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name of the Lambda function, version, or alias.
+	// +immutable
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef is a reference to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects references to a Function used to set the
+	// FunctionName.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// Specify a version or alias to add permissions to a published version of
+	// the function.
+	// +immutable
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// The action that the principal can use on the function.
+	// +kubebuilder:default:="lambda:InvokeFunction"
+	// +optional
+	Action string `json:"action,omitempty"`
+
+	// The Amazon Web Services service or account that invokes the function.
+	// If you specify a service, use SourceARN or SourceAccount to limit who
+	// can invoke the function through that service.
+	// +kubebuilder:validation:Required
+	Principal string `json:"principal"`
+
+	// For Amazon Web Services services, the ARN of the Amazon Web Services
+	// resource that invokes the function. For example, an Amazon S3 bucket or
+	// Amazon SNS topic.
+	// +optional
+	SourceARN *string `json:"sourceARN,omitempty"`
+
+	// For Amazon S3, the ID of the account that owns the resource. Use this
+	// together with SourceARN to ensure that the resource is owned by the
+	// specified account.
+	// +optional
+	SourceAccount *string `json:"sourceAccount,omitempty"`
+
+	// For Alexa Smart Home functions, a token that must be supplied by the
+	// invoker.
+	// +optional
+	EventSourceToken *string `json:"eventSourceToken,omitempty"`
+}
+
+// PermissionSpec defines the desired state of Permission
+type PermissionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PermissionParameters `json:"forProvider"`
+}
+
+// PermissionObservation defines the observed state of Permission
+type PermissionObservation struct {
+	// The permission statement that was added to the function policy.
+	Statement *string `json:"statement,omitempty"`
+}
+
+// PermissionStatus defines the observed state of Permission.
+type PermissionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PermissionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Permission is the Schema for the Permissions API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Permission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PermissionSpec   `json:"spec"`
+	Status            PermissionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionList contains a list of Permissions
+type PermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Permission `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	PermissionKind             = "Permission"
+	PermissionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PermissionKind}.String()
+	PermissionKindAPIVersion   = PermissionKind + "." + GroupVersion.String()
+	PermissionGroupVersionKind = GroupVersion.WithKind(PermissionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Permission{}, &PermissionList{})
+}
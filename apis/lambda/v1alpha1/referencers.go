@@ -24,6 +24,7 @@ import (
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/pkg/errors"
@@ -109,3 +110,24 @@ func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// ResolveReferences of this Permission
+func (mg *Permission) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+
+	return nil
+}
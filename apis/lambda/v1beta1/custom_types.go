@@ -56,6 +56,29 @@ type CustomFunctionParameters struct {
 	// The code for the function.
 	// +kubebuilder:validation:Required
 	CustomFunctionCodeParameters CustomFunctionCodeParameters `json:"code"`
+
+	// Aliases that point to published versions of the function. Aliases
+	// are only managed if this field is set; in that case aliases of the
+	// function that are not listed here are deleted. Set it to an empty list
+	// to delete all aliases. Leave it unset to ignore existing aliases.
+	// +optional
+	Aliases []FunctionAlias `json:"aliases,omitempty"`
+}
+
+// FunctionAlias is an alias for a version of the function.
+type FunctionAlias struct {
+	// The name of the alias.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// A description of the alias.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The function version that the alias invokes. If it is not set, the alias
+	// follows the most recently published version of the function.
+	// +optional
+	FunctionVersion *string `json:"functionVersion,omitempty"`
 }
 
 // CustomFunctionCodeParameters includes custom fields for FunctionCode struct.
@@ -75,6 +98,14 @@ type CustomFunctionCodeParameters struct {
 	// S3BucketSelector selects references to an S3 Bucket.
 	// +optional
 	S3BucketSelector *xpv1.Selector `json:"s3BucketSelector,omitempty"`
+
+	// The base64-encoded SHA256 hash of the deployment package. Lambda does
+	// not report the S3 object a function was deployed from, so setting this
+	// is the only way to detect that the deployed code drifted from the
+	// package in S3. The code is redeployed whenever it differs from the
+	// CodeSHA256 reported by Lambda.
+	// +optional
+	CodeSHA256 *string `json:"codeSHA256,omitempty"`
 }
 
 // CustomFunctionVPCConfigParameters includes custom fields for FunctionVPCConfigParameters.
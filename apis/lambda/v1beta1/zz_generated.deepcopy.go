@@ -159,6 +159,11 @@ func (in *CustomFunctionCodeParameters) DeepCopyInto(out *CustomFunctionCodePara
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CodeSHA256 != nil {
+		in, out := &in.CodeSHA256, &out.CodeSHA256
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFunctionCodeParameters.
@@ -205,6 +210,13 @@ func (in *CustomFunctionParameters) DeepCopyInto(out *CustomFunctionParameters)
 		(*in).DeepCopyInto(*out)
 	}
 	in.CustomFunctionCodeParameters.DeepCopyInto(&out.CustomFunctionCodeParameters)
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]FunctionAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomFunctionParameters.
@@ -436,6 +448,31 @@ func (in *Function) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FunctionAlias) DeepCopyInto(out *FunctionAlias) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionVersion != nil {
+		in, out := &in.FunctionVersion, &out.FunctionVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionAlias.
+func (in *FunctionAlias) DeepCopy() *FunctionAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(FunctionAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FunctionCode) DeepCopyInto(out *FunctionCode) {
 	*out = *in
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomMapParameters contains the additional fields for MapParameters.
+type CustomMapParameters struct{}
+
+// CustomPlaceIndexParameters contains the additional fields for
+// PlaceIndexParameters.
+type CustomPlaceIndexParameters struct{}
+
+// CustomGeofenceCollectionParameters contains the additional fields for
+// GeofenceCollectionParameters.
+type CustomGeofenceCollectionParameters struct {
+	// A key identifier for an AWS KMS customer managed key
+	// (https://docs.aws.amazon.com/kms/latest/developerguide/create-keys.html).
+	// Enter a key ID, key ARN, alias name, or alias ARN.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects references to a Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+}
+
+// CustomTrackerParameters contains the additional fields for
+// TrackerParameters.
+type CustomTrackerParameters struct {
+	// A key identifier for an AWS KMS customer managed key
+	// (https://docs.aws.amazon.com/kms/latest/developerguide/create-keys.html).
+	// Enter a key ID, key ARN, alias name, or alias ARN.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects references to a Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+}
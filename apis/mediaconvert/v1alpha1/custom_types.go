@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomQueueParameters includes custom additional fields for QueueParameters.
+type CustomQueueParameters struct{}
+
+// CustomPresetParameters includes custom additional fields for PresetParameters.
+type CustomPresetParameters struct{}
+
+// CustomJobTemplateParameters includes custom additional fields for JobTemplateParameters.
+type CustomJobTemplateParameters struct {
+	// Queue is the name or ARN of the queue that jobs created from this
+	// template are assigned to. If it is not given, jobs go to the default
+	// queue. It can also be resolved using QueueRef or QueueSelector.
+	// +optional
+	Queue *string `json:"queue,omitempty"`
+
+	// QueueRef is a reference to a Queue used to set the Queue.
+	// +optional
+	QueueRef *xpv1.Reference `json:"queueRef,omitempty"`
+
+	// QueueSelector selects a reference to a Queue used to set the Queue.
+	// +optional
+	QueueSelector *xpv1.Selector `json:"queueSelector,omitempty"`
+}
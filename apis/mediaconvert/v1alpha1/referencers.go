@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// QueueARN returns the status.atProvider.arn of a Queue.
+func QueueARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Queue)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.ARN)
+	}
+}
+
+// ResolveReferences of this JobTemplate
+func (mg *JobTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.queue
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Queue),
+		Reference:    mg.Spec.ForProvider.QueueRef,
+		Selector:     mg.Spec.ForProvider.QueueSelector,
+		To:           reference.To{Managed: &Queue{}, List: &QueueList{}},
+		Extract:      QueueARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.queue")
+	}
+	mg.Spec.ForProvider.Queue = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.QueueRef = rsp.ResolvedReference
+	return nil
+}
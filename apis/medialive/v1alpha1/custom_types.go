@@ -0,0 +1,34 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomChannelParameters includes custom additional fields for ChannelParameters.
+type CustomChannelParameters struct {
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+}
+
+// CustomInputParameters includes custom additional fields for InputParameters.
+type CustomInputParameters struct{}
@@ -0,0 +1,41 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomChannelParameters includes custom additional fields for ChannelParameters.
+type CustomChannelParameters struct{}
+
+// CustomOriginEndpointParameters includes custom additional fields for OriginEndpointParameters.
+type CustomOriginEndpointParameters struct {
+	// ChannelID is the ID of the Channel the OriginEndpoint is associated
+	// with. It has to be given directly or resolved using ChannelIDRef or
+	// ChannelIDSelector.
+	// +immutable
+	// +optional
+	ChannelID *string `json:"channelId,omitempty"`
+
+	// ChannelIDRef is a reference to a Channel used to set the ChannelID.
+	// +optional
+	ChannelIDRef *xpv1.Reference `json:"channelIdRef,omitempty"`
+
+	// ChannelIDSelector selects a reference to a Channel used to set the
+	// ChannelID.
+	// +optional
+	ChannelIDSelector *xpv1.Selector `json:"channelIdSelector,omitempty"`
+}
@@ -51,6 +51,11 @@ type CustomBrokerParameters struct {
 	// +optional
 	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
 
+	// Users of the broker. The first user is written to the connection
+	// secret. For ActiveMQ brokers, users that are added to this list after
+	// creation are created on the broker and changes to their console access
+	// and groups are applied. Passwords are only set when a user is created,
+	// use the User resource to rotate them.
 	CustomUsers []*CustomUser `json:"users,omitempty"`
 }
 
@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomDomainParameters includes custom additional fields for DomainParameters.
+type CustomDomainParameters struct {
+	// Options for fine-grained access control.
+	// +optional
+	AdvancedSecurityOptions *AdvancedSecurityOptionsParameters `json:"advancedSecurityOptions,omitempty"`
+}
+
+// AdvancedSecurityOptionsParameters are the options for fine-grained access
+// control. Fine-grained access control cannot be disabled once it has been
+// enabled.
+type AdvancedSecurityOptionsParameters struct {
+	// True to enable fine-grained access control.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// True to enable the internal user database.
+	// +optional
+	InternalUserDatabaseEnabled *bool `json:"internalUserDatabaseEnabled,omitempty"`
+
+	// Credentials for the master user, either an IAM ARN or a user name and
+	// password for the internal user database.
+	// +optional
+	MasterUserOptions *MasterUserOptionsParameters `json:"masterUserOptions,omitempty"`
+}
+
+// MasterUserOptionsParameters are the credentials of the master user.
+type MasterUserOptionsParameters struct {
+	// ARN of the master user. Only specify if the internal user database is
+	// disabled.
+	// +optional
+	MasterUserARN *string `json:"masterUserARN,omitempty"`
+
+	// User name of the master user. Only specify if the internal user
+	// database is enabled.
+	// +optional
+	MasterUserName *string `json:"masterUserName,omitempty"`
+
+	// MasterUserPasswordSecretRef references the key of a Secret that
+	// contains the password of the master user. Only specify if the internal
+	// user database is enabled.
+	// +optional
+	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomCampaignParameters includes custom additional fields for CampaignParameters.
+type CustomCampaignParameters struct {
+	// The Amazon Resource Name (ARN) of the solution version to deploy.
+	// +optional
+	// +crossplane:generate:reference:type=Solution
+	// +crossplane:generate:reference:extractor=LatestSolutionVersionARN()
+	SolutionVersionARN *string `json:"solutionVersionARN,omitempty"`
+
+	// SolutionVersionARNRef is a reference to a Solution whose latest
+	// solution version is used to set the SolutionVersionARN.
+	// +optional
+	SolutionVersionARNRef *xpv1.Reference `json:"solutionVersionARNRef,omitempty"`
+
+	// SolutionVersionARNSelector selects references to a Solution whose
+	// latest solution version is used to set the SolutionVersionARN.
+	// +optional
+	SolutionVersionARNSelector *xpv1.Selector `json:"solutionVersionARNSelector,omitempty"`
+}
+
+// CustomDatasetGroupParameters includes custom additional fields for DatasetGroupParameters.
+type CustomDatasetGroupParameters struct {
+	// The ARN of the IAM role that has permissions to access the KMS key.
+	// Supplying an IAM role is only valid when also specifying a KMS key.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// The ARN of a KMS key used to encrypt the datasets.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	KMSKeyARN *string `json:"kmsKeyARN,omitempty"`
+
+	// KMSKeyARNRef is a reference to a KMS Key used to set the KMSKeyARN.
+	// +optional
+	KMSKeyARNRef *xpv1.Reference `json:"kmsKeyARNRef,omitempty"`
+
+	// KMSKeyARNSelector selects references to a KMS Key used to set the
+	// KMSKeyARN.
+	// +optional
+	KMSKeyARNSelector *xpv1.Selector `json:"kmsKeyARNSelector,omitempty"`
+}
+
+// CustomSchemaParameters includes custom additional fields for SchemaParameters.
+type CustomSchemaParameters struct{}
+
+// CustomSolutionParameters includes custom additional fields for SolutionParameters.
+type CustomSolutionParameters struct {
+	// The Amazon Resource Name (ARN) of the dataset group that provides the
+	// training data.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=DatasetGroup
+	DatasetGroupARN *string `json:"datasetGroupARN,omitempty"`
+
+	// DatasetGroupARNRef is a reference to a DatasetGroup used to set the
+	// DatasetGroupARN.
+	// +optional
+	DatasetGroupARNRef *xpv1.Reference `json:"datasetGroupARNRef,omitempty"`
+
+	// DatasetGroupARNSelector selects references to a DatasetGroup used to
+	// set the DatasetGroupARN.
+	// +optional
+	DatasetGroupARNSelector *xpv1.Selector `json:"datasetGroupARNSelector,omitempty"`
+}
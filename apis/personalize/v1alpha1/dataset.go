@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NOTE: Dataset is not generated since the generated DatasetGroupKind would
+// clash with the Kind of DatasetGroup. Its GroupKind is derived from
+// DatasetGroupVersionKind instead.
+
+// DatasetParameters defines the desired state of Dataset
+type DatasetParameters struct {
+	// Region is which region the Dataset will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The name for the dataset.
+	// +immutable
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+
+	// The type of dataset.
+	// +immutable
+	// +kubebuilder:validation:Enum=Interactions;Items;Users
+	// +kubebuilder:validation:Required
+	DatasetType *string `json:"datasetType"`
+
+	// The Amazon Resource Name (ARN) of the dataset group to add the dataset
+	// to.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=DatasetGroup
+	DatasetGroupARN *string `json:"datasetGroupARN,omitempty"`
+
+	// DatasetGroupARNRef is a reference to a DatasetGroup used to set the
+	// DatasetGroupARN.
+	// +optional
+	DatasetGroupARNRef *xpv1.Reference `json:"datasetGroupARNRef,omitempty"`
+
+	// DatasetGroupARNSelector selects references to a DatasetGroup used to
+	// set the DatasetGroupARN.
+	// +optional
+	DatasetGroupARNSelector *xpv1.Selector `json:"datasetGroupARNSelector,omitempty"`
+
+	// The ARN of the schema to associate with the dataset. The schema defines
+	// the dataset fields.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Schema
+	SchemaARN *string `json:"schemaARN,omitempty"`
+
+	// SchemaARNRef is a reference to a Schema used to set the SchemaARN.
+	// +optional
+	SchemaARNRef *xpv1.Reference `json:"schemaARNRef,omitempty"`
+
+	// SchemaARNSelector selects references to a Schema used to set the
+	// SchemaARN.
+	// +optional
+	SchemaARNSelector *xpv1.Selector `json:"schemaARNSelector,omitempty"`
+}
+
+// DatasetSpec defines the desired state of Dataset
+type DatasetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DatasetParameters `json:"forProvider"`
+}
+
+// DatasetObservation defines the observed state of Dataset
+type DatasetObservation struct {
+	// The ARN of the dataset.
+	DatasetARN *string `json:"datasetARN,omitempty"`
+
+	// The status of the dataset, one of CREATE PENDING, CREATE IN_PROGRESS,
+	// ACTIVE, CREATE FAILED, DELETE PENDING or DELETE IN_PROGRESS.
+	Status *string `json:"status,omitempty"`
+}
+
+// DatasetStatus defines the observed state of Dataset.
+type DatasetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DatasetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Dataset is the Schema for the Datasets API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Dataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              DatasetSpec   `json:"spec"`
+	Status            DatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetList contains a list of Datasets
+type DatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dataset `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	DatasetKind             = "Dataset"
+	DatasetKindAPIVersion   = DatasetKind + "." + GroupVersion.String()
+	DatasetGroupVersionKind = GroupVersion.WithKind(DatasetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Dataset{}, &DatasetList{})
+}
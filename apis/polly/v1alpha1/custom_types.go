@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// CustomLexiconParameters includes custom additional fields for LexiconParameters.
+type CustomLexiconParameters struct {
+	// Content of the lexicon in PLS format. Either Content or
+	// ContentConfigMapRef has to be given.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentConfigMapRef references a key of a ConfigMap that contains the
+	// content of the lexicon in PLS format.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap that holds the lexicon content.
+	Key string `json:"key"`
+}
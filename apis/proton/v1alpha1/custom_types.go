@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomEnvironmentTemplateParameters includes custom additional fields for EnvironmentTemplateParameters.
+type CustomEnvironmentTemplateParameters struct{}
+
+// CustomServiceTemplateParameters includes custom additional fields for ServiceTemplateParameters.
+type CustomServiceTemplateParameters struct{}
+
+// CustomEnvironmentParameters includes custom additional fields for EnvironmentParameters.
+type CustomEnvironmentParameters struct {
+	// The name of the environment template the environment is created from.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=EnvironmentTemplate
+	TemplateName *string `json:"templateName,omitempty"`
+
+	// TemplateNameRef is a reference to an EnvironmentTemplate used to set
+	// the TemplateName.
+	// +optional
+	TemplateNameRef *xpv1.Reference `json:"templateNameRef,omitempty"`
+
+	// TemplateNameSelector selects a reference to an EnvironmentTemplate used
+	// to set the TemplateName.
+	// +optional
+	TemplateNameSelector *xpv1.Selector `json:"templateNameSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the AWS Proton service role that
+	// allows AWS Proton to make calls to other services on your behalf. Either
+	// this or the environmentAccountConnectionID has to be set.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	ProtonServiceRoleARN *string `json:"protonServiceRoleARN,omitempty"`
+
+	// ProtonServiceRoleARNRef is a reference to an IAM Role used to set the
+	// ProtonServiceRoleARN.
+	// +optional
+	ProtonServiceRoleARNRef *xpv1.Reference `json:"protonServiceRoleARNRef,omitempty"`
+
+	// ProtonServiceRoleARNSelector selects a reference to an IAM Role used to
+	// set the ProtonServiceRoleARN.
+	// +optional
+	ProtonServiceRoleARNSelector *xpv1.Selector `json:"protonServiceRoleARNSelector,omitempty"`
+}
+
+// CustomServiceParameters includes custom additional fields for ServiceParameters.
+type CustomServiceParameters struct {
+	// The name of the service template the service is created from.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=ServiceTemplate
+	TemplateName *string `json:"templateName,omitempty"`
+
+	// TemplateNameRef is a reference to a ServiceTemplate used to set the
+	// TemplateName.
+	// +optional
+	TemplateNameRef *xpv1.Reference `json:"templateNameRef,omitempty"`
+
+	// TemplateNameSelector selects a reference to a ServiceTemplate used to
+	// set the TemplateName.
+	// +optional
+	TemplateNameSelector *xpv1.Selector `json:"templateNameSelector,omitempty"`
+}
@@ -18,6 +18,43 @@ package v1alpha1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+// AnnotationKeyReboot is the key in the annotations map of a DBInstance for
+// an identifier of a requested reboot, e.g. the ID of a change. The
+// DBInstance is rebooted once whenever the value of the annotation changes.
+const AnnotationKeyReboot = CRDGroup + "/reboot"
+
+// AnnotationKeyRebootForceFailover is the key in the annotations map of a
+// DBInstance that makes a reboot requested through AnnotationKeyReboot fail
+// over to the standby of a Multi-AZ DBInstance if its value is "true".
+const AnnotationKeyRebootForceFailover = CRDGroup + "/reboot-force-failover"
+
+// AnnotationKeyLastReboot is the key in the annotations map of a DBInstance
+// for the identifier of the last reboot requested through AnnotationKeyReboot.
+const AnnotationKeyLastReboot = CRDGroup + "/last-reboot"
+
+// AnnotationKeyFailover is the key in the annotations map of a DBCluster for
+// an identifier of a requested failover, e.g. the ID of a change. The
+// DBCluster fails over once whenever the value of the annotation changes.
+const AnnotationKeyFailover = CRDGroup + "/failover"
+
+// AnnotationKeyFailoverTarget is the key in the annotations map of a
+// DBCluster for the identifier of the DB instance to promote to the primary
+// by a failover requested through AnnotationKeyFailover.
+const AnnotationKeyFailoverTarget = CRDGroup + "/failover-target"
+
+// AnnotationKeyLastFailover is the key in the annotations map of a DBCluster
+// for the identifier of the last failover requested through
+// AnnotationKeyFailover.
+const AnnotationKeyLastFailover = CRDGroup + "/last-failover"
+
+// Desired states of a DBInstance.
+const (
+	// DBInstanceStateAvailable starts the DB instance if it is stopped.
+	DBInstanceStateAvailable = "available"
+	// DBInstanceStateStopped stops the DB instance if it is available.
+	DBInstanceStateStopped = "stopped"
+)
+
 // CustomDBParameterGroupParameters are custom parameters for DBParameterGroup
 type CustomDBParameterGroupParameters struct {
 	// A list of parameters to associate with this DB parameter group
@@ -123,6 +160,18 @@ type CustomDBClusterParameters struct {
 	// +optional
 	DBClusterParameterGroupNameSelector *xpv1.Selector `json:"dbClusterParameterGroupNameSelector,omitempty"`
 
+	// GlobalClusterIdentifierRef is a reference to a GlobalCluster used to set
+	// GlobalClusterIdentifier.
+	// +immutable
+	// +optional
+	GlobalClusterIdentifierRef *xpv1.Reference `json:"globalClusterIdentifierRef,omitempty"`
+
+	// GlobalClusterIdentifierSelector selects a reference to a GlobalCluster
+	// used to set GlobalClusterIdentifier.
+	// +immutable
+	// +optional
+	GlobalClusterIdentifierSelector *xpv1.Selector `json:"globalClusterIdentifierSelector,omitempty"`
+
 	// A value that indicates whether the modifications in this request and any
 	// pending modifications are asynchronously applied as soon as possible, regardless
 	// of the PreferredMaintenanceWindow setting for the DB cluster. If this parameter
@@ -155,6 +204,36 @@ type CustomGlobalClusterParameters struct {
 	SourceDBClusterIdentifierSelector *xpv1.Selector `json:"sourceDBClusterIdentifierSelector,omitempty"`
 }
 
+// CustomGlobalClusterObservation includes the custom status fields of
+// GlobalCluster.
+type CustomGlobalClusterObservation struct {
+	// MemberEndpoints are the writer and reader endpoints of every regional
+	// DB cluster attached to the global cluster.
+	MemberEndpoints []GlobalClusterMemberEndpoints `json:"memberEndpoints,omitempty"`
+}
+
+// GlobalClusterMemberEndpoints are the endpoints of a regional DB cluster that
+// is a member of a GlobalCluster.
+type GlobalClusterMemberEndpoints struct {
+	// Region of the member DB cluster.
+	Region string `json:"region"`
+
+	// DBClusterARN is the ARN of the member DB cluster.
+	DBClusterARN string `json:"dbClusterARN"`
+
+	// IsWriter indicates whether the member is the primary cluster of the
+	// global cluster.
+	IsWriter bool `json:"isWriter"`
+
+	// Endpoint is the writer endpoint of the member DB cluster.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ReaderEndpoint is the reader endpoint of the member DB cluster.
+	// +optional
+	ReaderEndpoint string `json:"readerEndpoint,omitempty"`
+}
+
 // CustomDBInstanceParameters are custom parameters for the DBInstance
 type CustomDBInstanceParameters struct {
 	// AutogeneratePassword indicates whether the controller should generate
@@ -193,6 +272,15 @@ type CustomDBInstanceParameters struct {
 	// +optional
 	DBSubnetGroupNameSelector *xpv1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
 
+	// DesiredState is the state the DB instance should be kept in. An
+	// available DB instance is stopped, and a stopped one is started, until
+	// its status matches. Note that AWS starts a DB instance automatically
+	// after it has been stopped for seven days, in which case it is stopped
+	// again. The DB instance is left alone when this is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=available;stopped
+	DesiredState *string `json:"desiredState,omitempty"`
+
 	// DomainIAMRoleNameRef is a reference to an IAMRole used to set
 	// DomainIAMRoleName.
 	// +optional
@@ -222,6 +310,16 @@ type CustomDBInstanceParameters struct {
 	// +optional
 	FinalDBSnapshotIdentifier string `json:"finalDBSnapshotIdentifier,omitempty"`
 
+	// KMSKeyIDRef is a reference to a KMS Key used to set KMSKeyID.
+	// +immutable
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIDRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set KMSKeyID.
+	// +immutable
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIDSelector,omitempty"`
+
 	// The password for the master database user. This password can contain any
 	// printable ASCII character except "/", """, or "@".
 	//
@@ -241,6 +339,37 @@ type CustomDBInstanceParameters struct {
 	// +immutable
 	MonitoringRoleARNSelector *xpv1.Selector `json:"monitoringRoleArnSelector,omitempty"`
 
+	// PromoteReadReplica promotes the DB instance to a standalone DB instance
+	// if it is a read replica. Promotion can't be undone.
+	// +optional
+	PromoteReadReplica bool `json:"promoteReadReplica,omitempty"`
+
+	// The identifier of the DB instance that this DB instance is created as
+	// a read replica of. The identifier must be the ARN of the source DB
+	// instance if it is in a different region, in which case KMSKeyID must be
+	// a key of the region of this DB instance if the source is encrypted and
+	// OptionGroupName an option group of that region if the source doesn't
+	// use the default option group.
+	//
+	// MasterUsername, the master password and the allocated storage are
+	// inherited from the source DB instance when it is set. Engine must be
+	// the engine of the source DB instance.
+	// +immutable
+	// +optional
+	ReplicateSourceDBInstanceIdentifier *string `json:"replicateSourceDBInstanceIdentifier,omitempty"`
+
+	// ReplicateSourceDBInstanceIdentifierRef is a reference to a DBInstance
+	// used to set ReplicateSourceDBInstanceIdentifier to its ARN.
+	// +immutable
+	// +optional
+	ReplicateSourceDBInstanceIdentifierRef *xpv1.Reference `json:"replicateSourceDBInstanceIdentifierRef,omitempty"`
+
+	// ReplicateSourceDBInstanceIdentifierSelector selects a reference to a
+	// DBInstance used to set ReplicateSourceDBInstanceIdentifier to its ARN.
+	// +immutable
+	// +optional
+	ReplicateSourceDBInstanceIdentifierSelector *xpv1.Selector `json:"replicateSourceDBInstanceIdentifierSelector,omitempty"`
+
 	// A value that indicates whether to skip the creation of a final DB instance
 	// snapshot before the DB instance is deleted. If skip is specified, no DB instance
 	// snapshot is created. If skip isn't specified, a DB instance snapshot is created
@@ -103,6 +103,20 @@ func (mg *DBCluster) ResolveReferences(ctx context.Context, c client.Reader) err
 	mg.Spec.ForProvider.DBClusterParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DBClusterParameterGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.globalClusterIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.GlobalClusterIdentifier),
+		Reference:    mg.Spec.ForProvider.GlobalClusterIdentifierRef,
+		Selector:     mg.Spec.ForProvider.GlobalClusterIdentifierSelector,
+		To:           reference.To{Managed: &GlobalCluster{}, List: &GlobalClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.globalClusterIdentifier")
+	}
+	mg.Spec.ForProvider.GlobalClusterIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GlobalClusterIdentifierRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -141,6 +155,20 @@ func DBClusterARN() reference.ExtractValueFn {
 	}
 }
 
+// DBInstanceARN returns the status.atProvider.dbInstanceARN of a DBInstance.
+func DBInstanceARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*DBInstance)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.DBInstanceARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.DBInstanceARN
+	}
+}
+
 // ResolveReferences of this DBInstance
 func (mg *DBInstance) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -201,6 +229,34 @@ func (mg *DBInstance) ResolveReferences(ctx context.Context, c client.Reader) er
 	mg.Spec.ForProvider.MonitoringRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.MonitoringRoleARNRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.kmsKeyID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSKeyID),
+		Reference:    mg.Spec.ForProvider.KMSKeyIDRef,
+		Selector:     mg.Spec.ForProvider.KMSKeyIDSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsKeyID")
+	}
+	mg.Spec.ForProvider.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KMSKeyIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.replicateSourceDBInstanceIdentifier
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ReplicateSourceDBInstanceIdentifier),
+		Reference:    mg.Spec.ForProvider.ReplicateSourceDBInstanceIdentifierRef,
+		Selector:     mg.Spec.ForProvider.ReplicateSourceDBInstanceIdentifierSelector,
+		To:           reference.To{Managed: &DBInstance{}, List: &DBInstanceList{}},
+		Extract:      DBInstanceARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.replicateSourceDBInstanceIdentifier")
+	}
+	mg.Spec.ForProvider.ReplicateSourceDBInstanceIdentifier = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ReplicateSourceDBInstanceIdentifierRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBParameterGroupName),
 		Reference:    mg.Spec.ForProvider.DBParameterGroupNameRef,
@@ -453,6 +453,16 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GlobalClusterIdentifierRef != nil {
+		in, out := &in.GlobalClusterIdentifierRef, &out.GlobalClusterIdentifierRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.GlobalClusterIdentifierSelector != nil {
+		in, out := &in.GlobalClusterIdentifierSelector, &out.GlobalClusterIdentifierSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ApplyImmediately != nil {
 		in, out := &in.ApplyImmediately, &out.ApplyImmediately
 		*out = new(bool)
@@ -498,6 +508,11 @@ func (in *CustomDBInstanceParameters) DeepCopyInto(out *CustomDBInstanceParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DesiredState != nil {
+		in, out := &in.DesiredState, &out.DesiredState
+		*out = new(string)
+		**out = **in
+	}
 	if in.DomainIAMRoleNameRef != nil {
 		in, out := &in.DomainIAMRoleNameRef, &out.DomainIAMRoleNameRef
 		*out = new(v1.Reference)
@@ -508,6 +523,16 @@ func (in *CustomDBInstanceParameters) DeepCopyInto(out *CustomDBInstanceParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KMSKeyIDRef != nil {
+		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KMSKeyIDSelector != nil {
+		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MasterUserPasswordSecretRef != nil {
 		in, out := &in.MasterUserPasswordSecretRef, &out.MasterUserPasswordSecretRef
 		*out = new(v1.SecretKeySelector)
@@ -523,6 +548,21 @@ func (in *CustomDBInstanceParameters) DeepCopyInto(out *CustomDBInstanceParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicateSourceDBInstanceIdentifier != nil {
+		in, out := &in.ReplicateSourceDBInstanceIdentifier, &out.ReplicateSourceDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReplicateSourceDBInstanceIdentifierRef != nil {
+		in, out := &in.ReplicateSourceDBInstanceIdentifierRef, &out.ReplicateSourceDBInstanceIdentifierRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ReplicateSourceDBInstanceIdentifierSelector != nil {
+		in, out := &in.ReplicateSourceDBInstanceIdentifierSelector, &out.ReplicateSourceDBInstanceIdentifierSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VPCSecurityGroupIDs != nil {
 		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]string, len(*in))
@@ -632,6 +672,26 @@ func (in *CustomDBParameterGroupParameters) DeepCopy() *CustomDBParameterGroupPa
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomGlobalClusterObservation) DeepCopyInto(out *CustomGlobalClusterObservation) {
+	*out = *in
+	if in.MemberEndpoints != nil {
+		in, out := &in.MemberEndpoints, &out.MemberEndpoints
+		*out = make([]GlobalClusterMemberEndpoints, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomGlobalClusterObservation.
+func (in *CustomGlobalClusterObservation) DeepCopy() *CustomGlobalClusterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomGlobalClusterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomGlobalClusterParameters) DeepCopyInto(out *CustomGlobalClusterParameters) {
 	*out = *in
@@ -4973,6 +5033,21 @@ func (in *GlobalClusterMember) DeepCopy() *GlobalClusterMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalClusterMemberEndpoints) DeepCopyInto(out *GlobalClusterMemberEndpoints) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterMemberEndpoints.
+func (in *GlobalClusterMemberEndpoints) DeepCopy() *GlobalClusterMemberEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalClusterMemberEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalClusterObservation) DeepCopyInto(out *GlobalClusterObservation) {
 	*out = *in
@@ -5012,6 +5087,7 @@ func (in *GlobalClusterObservation) DeepCopyInto(out *GlobalClusterObservation)
 		*out = new(string)
 		**out = **in
 	}
+	in.CustomGlobalClusterObservation.DeepCopyInto(&out.CustomGlobalClusterObservation)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalClusterObservation.
@@ -75,7 +75,8 @@ type GlobalClusterObservation struct {
 	// accessed.
 	GlobalClusterResourceID *string `json:"globalClusterResourceID,omitempty"`
 	// Specifies the current state of this global database cluster.
-	Status *string `json:"status,omitempty"`
+	Status                         *string `json:"status,omitempty"`
+	CustomGlobalClusterObservation `json:",inline"`
 }
 
 // GlobalClusterStatus defines the observed state of GlobalCluster.
@@ -90,6 +91,7 @@ type GlobalClusterStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
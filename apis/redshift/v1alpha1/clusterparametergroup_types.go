@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterParameterGroupParameters define the desired state of an AWS
+// Redshift cluster parameter group.
+type ClusterParameterGroupParameters struct {
+	// Region is the region you'd like your ClusterParameterGroup to be
+	// created in.
+	Region string `json:"region"`
+
+	// A description of the parameter group.
+	// +immutable
+	Description string `json:"description"`
+
+	// The Amazon Redshift engine version to which the cluster parameter group
+	// applies, e.g. redshift-1.0.
+	// +immutable
+	ParameterGroupFamily string `json:"parameterGroupFamily"`
+
+	// The parameters to set in the parameter group. Parameters that are
+	// removed from this list are reset to their default values.
+	// +optional
+	Parameters []ClusterParameter `json:"parameters,omitempty"`
+
+	// A list of tags to be added to this resource.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ClusterParameter is a parameter of a cluster parameter group.
+type ClusterParameter struct {
+	// The name of the parameter.
+	ParameterName string `json:"parameterName"`
+
+	// The value of the parameter. The wlm_json_configuration parameter
+	// expects a JSON document.
+	ParameterValue string `json:"parameterValue"`
+}
+
+// A ClusterParameterGroupSpec defines the desired state of a
+// ClusterParameterGroup.
+type ClusterParameterGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ClusterParameterGroupParameters `json:"forProvider"`
+}
+
+// A ClusterParameterGroupResourceStatus represents the observed state of a
+// ClusterParameterGroup. It is not called ClusterParameterGroupStatus since
+// that name is taken by the parameter group status of a Cluster.
+type ClusterParameterGroupResourceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ClusterParameterGroup is a managed resource that represents an AWS
+// Redshift cluster parameter group. Use it as clusterParameterGroupNameRef of
+// a Cluster. Changes to static parameters are applied when the clusters
+// that use the group are rebooted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ClusterParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterParameterGroupSpec           `json:"spec"`
+	Status ClusterParameterGroupResourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterParameterGroupList contains a list of ClusterParameterGroup
+type ClusterParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterParameterGroup `json:"items"`
+}
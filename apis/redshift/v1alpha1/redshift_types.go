@@ -34,6 +34,12 @@ const (
 	StateModifying = "modifying"
 	// The cluster has failed and Amazon Redshift can't recover it. Perform a point-in-time restore to the latest restorable time of the Cluster to recover the data.
 	StateFailed = "failed"
+	// The cluster is being paused.
+	StatePausing = "pausing"
+	// The cluster is paused. Only its storage is billed while it is paused.
+	StatePaused = "paused"
+	// The cluster is being resumed.
+	StateResuming = "resuming"
 )
 
 // ClusterParameters define the parameters available for an AWS Redshift cluster
@@ -92,6 +98,16 @@ type ClusterParameters struct {
 	// +optional
 	ClusterParameterGroupName *string `json:"clusterParameterGroupName,omitempty"`
 
+	// ClusterParameterGroupNameRef is a reference to a ClusterParameterGroup
+	// used to set the ClusterParameterGroupName.
+	// +optional
+	ClusterParameterGroupNameRef *xpv1.Reference `json:"clusterParameterGroupNameRef,omitempty"`
+
+	// ClusterParameterGroupNameSelector selects a reference to a
+	// ClusterParameterGroup used to set the ClusterParameterGroupName.
+	// +optional
+	ClusterParameterGroupNameSelector *xpv1.Selector `json:"clusterParameterGroupNameSelector,omitempty"`
+
 	// SecurityGroups is a list of security groups to associate with this cluster.
 	// Default: The default cluster security group for Amazon Redshift.
 	// +optional
@@ -145,6 +161,13 @@ type ClusterParameters struct {
 	// +optional
 	DBName *string `json:"dbName,omitempty"`
 
+	// DesiredState is the state the cluster should be kept in. An available
+	// cluster is paused, and a paused one is resumed, until its status
+	// matches. The cluster is left alone when this is not set.
+	// +kubebuilder:validation:Enum=available;paused
+	// +optional
+	DesiredState *string `json:"desiredState,omitempty"`
+
 	// The Elastic IP (EIP) address for the cluster.
 	// Constraints: The cluster must be provisioned in EC2-VPC and publicly-accessible
 	// through an Internet gateway. For more information about provisioning clusters
@@ -208,13 +231,11 @@ type ClusterParameters struct {
 
 	// IAMRoleRefs are references to IAMRoles used to set
 	// the IAMRoles.
-	// +immutable
 	// +optional
 	IAMRoleRefs []xpv1.Reference `json:"iamRoleRefs,omitempty"`
 
 	// IAMRoleSelector selects references to IAMRoles used
 	// to set the IAMRoles.
-	// +immutable
 	// +optional
 	IAMRoleSelector *xpv1.Selector `json:"iamRoleSelector,omitempty"`
 
@@ -306,6 +327,16 @@ type ClusterParameters struct {
 	// +optional
 	SnapshotScheduleIdentifier *string `json:"snapshotScheduleIdentifier,omitempty"`
 
+	// SnapshotScheduleIdentifierRef is a reference to a SnapshotSchedule used
+	// to set the SnapshotScheduleIdentifier.
+	// +optional
+	SnapshotScheduleIdentifierRef *xpv1.Reference `json:"snapshotScheduleIdentifierRef,omitempty"`
+
+	// SnapshotScheduleIdentifierSelector selects a reference to a
+	// SnapshotSchedule used to set the SnapshotScheduleIdentifier.
+	// +optional
+	SnapshotScheduleIdentifierSelector *xpv1.Selector `json:"snapshotScheduleIdentifierSelector,omitempty"`
+
 	// Tags indicates a list of tags for the clusters.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -654,7 +685,9 @@ type ClusterStatus struct {
 // A Cluster is a managed resource that represents an AWS Redshift cluster.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.clusterStatus"
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint.address",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -52,7 +52,7 @@ func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error
 		References:    mg.Spec.ForProvider.IAMRoleRefs,
 		Selector:      mg.Spec.ForProvider.IAMRoleSelector,
 		To:            reference.To{Managed: &v1beta1.Role{}, List: &v1beta1.RoleList{}},
-		Extract:       reference.ExternalName(),
+		Extract:       v1beta1.RoleARN(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "spec.forProvider.IAMRoles")
@@ -74,5 +74,33 @@ func (mg *Cluster) ResolveReferences(ctx context.Context, c client.Reader) error
 	mg.Spec.ForProvider.ClusterSecurityGroups = msgrsp.ResolvedValues
 	mg.Spec.ForProvider.ClusterSecurityGroupRefs = msgrsp.ResolvedReferences
 
+	// Resolve spec.forProvider.clusterParameterGroupName
+	pgrsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ClusterParameterGroupName),
+		Reference:    mg.Spec.ForProvider.ClusterParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.ClusterParameterGroupNameSelector,
+		To:           reference.To{Managed: &ClusterParameterGroup{}, List: &ClusterParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.clusterParameterGroupName")
+	}
+	mg.Spec.ForProvider.ClusterParameterGroupName = reference.ToPtrValue(pgrsp.ResolvedValue)
+	mg.Spec.ForProvider.ClusterParameterGroupNameRef = pgrsp.ResolvedReference
+
+	// Resolve spec.forProvider.snapshotScheduleIdentifier
+	ssrsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SnapshotScheduleIdentifier),
+		Reference:    mg.Spec.ForProvider.SnapshotScheduleIdentifierRef,
+		Selector:     mg.Spec.ForProvider.SnapshotScheduleIdentifierSelector,
+		To:           reference.To{Managed: &SnapshotSchedule{}, List: &SnapshotScheduleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.snapshotScheduleIdentifier")
+	}
+	mg.Spec.ForProvider.SnapshotScheduleIdentifier = reference.ToPtrValue(ssrsp.ResolvedValue)
+	mg.Spec.ForProvider.SnapshotScheduleIdentifierRef = ssrsp.ResolvedReference
+
 	return nil
 }
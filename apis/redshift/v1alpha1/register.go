@@ -45,6 +45,24 @@ var (
 	ClusterGroupVersionKind = SchemeGroupVersion.WithKind(ClusterKind)
 )
 
+// ClusterParameterGroup type metadata.
+var (
+	ClusterParameterGroupKind             = reflect.TypeOf(ClusterParameterGroup{}).Name()
+	ClusterParameterGroupGroupKind        = schema.GroupKind{Group: Group, Kind: ClusterParameterGroupKind}.String()
+	ClusterParameterGroupKindAPIVersion   = ClusterParameterGroupKind + "." + SchemeGroupVersion.String()
+	ClusterParameterGroupGroupVersionKind = SchemeGroupVersion.WithKind(ClusterParameterGroupKind)
+)
+
+// SnapshotSchedule type metadata.
+var (
+	SnapshotScheduleKind             = reflect.TypeOf(SnapshotSchedule{}).Name()
+	SnapshotScheduleGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotScheduleKind}.String()
+	SnapshotScheduleKindAPIVersion   = SnapshotScheduleKind + "." + SchemeGroupVersion.String()
+	SnapshotScheduleGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotScheduleKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
+	SchemeBuilder.Register(&ClusterParameterGroup{}, &ClusterParameterGroupList{})
+	SchemeBuilder.Register(&SnapshotSchedule{}, &SnapshotScheduleList{})
 }
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnapshotScheduleParameters define the desired state of an AWS Redshift
+// snapshot schedule.
+type SnapshotScheduleParameters struct {
+	// Region is the region you'd like your SnapshotSchedule to be created in.
+	Region string `json:"region"`
+
+	// The definitions of the schedule, e.g. "rate(12 hours)" or
+	// "cron(0 3 * * ? *)". Snapshots are taken at most once per hour.
+	// +kubebuilder:validation:MinItems=1
+	Definitions []string `json:"definitions"`
+
+	// The description of the snapshot schedule.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// A list of tags to be added to this resource.
+	// +immutable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotScheduleSpec defines the desired state of a SnapshotSchedule.
+type SnapshotScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotScheduleParameters `json:"forProvider"`
+}
+
+// SnapshotScheduleObservation keeps the state for the external resource
+type SnapshotScheduleObservation struct {
+	// The number of clusters associated with the schedule.
+	AssociatedClusterCount int32 `json:"associatedClusterCount,omitempty"`
+
+	// The identifiers of the clusters associated with the schedule.
+	AssociatedClusters []string `json:"associatedClusters,omitempty"`
+
+	// The next times a snapshot is taken.
+	NextInvocations []metav1.Time `json:"nextInvocations,omitempty"`
+}
+
+// A SnapshotScheduleStatus represents the observed state of a
+// SnapshotSchedule.
+type SnapshotScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SnapshotSchedule is a managed resource that represents an AWS Redshift
+// snapshot schedule. Use it as snapshotScheduleIdentifierRef of a Cluster.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="CLUSTERS",type="integer",JSONPath=".status.atProvider.associatedClusterCount"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotScheduleSpec   `json:"spec"`
+	Status SnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotScheduleList contains a list of SnapshotSchedule
+type SnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnapshotSchedule `json:"items"`
+}
@@ -28,3 +28,21 @@ func (l *ClusterList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this ClusterParameterGroupList.
+func (l *ClusterParameterGroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this SnapshotScheduleList.
+func (l *SnapshotScheduleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
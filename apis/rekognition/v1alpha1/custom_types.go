@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomCollectionParameters includes custom additional fields for CollectionParameters.
+type CustomCollectionParameters struct{}
+
+// CustomProjectParameters includes custom additional fields for ProjectParameters.
+type CustomProjectParameters struct{}
+
+// CustomStreamProcessorParameters includes custom additional fields for StreamProcessorParameters.
+type CustomStreamProcessorParameters struct {
+	// The ARN of the Kinesis video stream that streams the source video into
+	// the stream processor.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1.Stream
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1.StreamARN()
+	KinesisVideoStreamARN *string `json:"kinesisVideoStreamARN,omitempty"`
+
+	// KinesisVideoStreamARNRef is a reference to a Kinesis video Stream used
+	// to set the KinesisVideoStreamARN.
+	// +optional
+	KinesisVideoStreamARNRef *xpv1.Reference `json:"kinesisVideoStreamARNRef,omitempty"`
+
+	// KinesisVideoStreamARNSelector selects references to a Kinesis video
+	// Stream used to set the KinesisVideoStreamARN.
+	// +optional
+	KinesisVideoStreamARNSelector *xpv1.Selector `json:"kinesisVideoStreamARNSelector,omitempty"`
+
+	// The ARN of the Kinesis data stream to which the stream processor
+	// streams the analysis results.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kinesis/v1alpha1.Stream
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kinesis/v1alpha1.StreamARN()
+	KinesisDataStreamARN *string `json:"kinesisDataStreamARN,omitempty"`
+
+	// KinesisDataStreamARNRef is a reference to a Kinesis data Stream used to
+	// set the KinesisDataStreamARN.
+	// +optional
+	KinesisDataStreamARNRef *xpv1.Reference `json:"kinesisDataStreamARNRef,omitempty"`
+
+	// KinesisDataStreamARNSelector selects references to a Kinesis data
+	// Stream used to set the KinesisDataStreamARN.
+	// +optional
+	KinesisDataStreamARNSelector *xpv1.Selector `json:"kinesisDataStreamARNSelector,omitempty"`
+
+	// The ARN of the IAM role that allows access to the stream processor.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects references to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// FaceSearch configures the stream processor to search for faces of the
+	// given collection in the streaming video.
+	// +immutable
+	// +kubebuilder:validation:Required
+	FaceSearch StreamProcessorFaceSearch `json:"faceSearch"`
+}
+
+// StreamProcessorFaceSearch holds the input face recognition parameters of
+// a stream processor.
+type StreamProcessorFaceSearch struct {
+	// The ID of a collection that contains faces that you want to search for.
+	// +optional
+	// +crossplane:generate:reference:type=Collection
+	CollectionID *string `json:"collectionID,omitempty"`
+
+	// CollectionIDRef is a reference to a Collection used to set the
+	// CollectionID.
+	// +optional
+	CollectionIDRef *xpv1.Reference `json:"collectionIDRef,omitempty"`
+
+	// CollectionIDSelector selects references to a Collection used to set the
+	// CollectionID.
+	// +optional
+	CollectionIDSelector *xpv1.Selector `json:"collectionIDSelector,omitempty"`
+
+	// Minimum face match confidence score that must be met to return a result
+	// for a recognized face. Default is 80. 0 is the lowest confidence. 100 is
+	// the highest confidence.
+	// +optional
+	FaceMatchThreshold *float64 `json:"faceMatchThreshold,omitempty"`
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// HealthCheckParameters define the desired state of an AWS Route53 Health Check.
+type HealthCheckParameters struct {
+	// The type of health check that you want to create, which indicates how Amazon
+	// Route 53 determines whether an endpoint is healthy.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;HTTP_STR_MATCH;HTTPS_STR_MATCH;TCP;CALCULATED;CLOUDWATCH_METRIC;RECOVERY_CONTROL
+	// +immutable
+	Type string `json:"type"`
+
+	// The IPv4 or IPv6 IP address of the endpoint that you want Amazon Route 53
+	// to perform health checks on. If you don't specify a value for IPAddress,
+	// Route 53 sends a DNS request to resolve the domain name that you specify
+	// in FullyQualifiedDomainName at the interval that you specify in
+	// RequestInterval.
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// The port on the endpoint that you want Amazon Route 53 to perform health
+	// checks on. Defaults to 80 for HTTP and 443 for HTTPS health checks.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// The path that you want Amazon Route 53 to request when performing health
+	// checks, for example the file /docs/route53-health-check.html.
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+
+	// The fully qualified domain name of the endpoint. If you specify IPAddress,
+	// it is passed in the Host header of HTTP and HTTPS health checks.
+	// +optional
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+
+	// If the value of Type is HTTP_STR_MATCH or HTTPS_STR_MATCH, the string that
+	// you want Amazon Route 53 to search for in the response body from the
+	// specified resource.
+	// +optional
+	SearchString *string `json:"searchString,omitempty"`
+
+	// The number of seconds between the time that Amazon Route 53 gets a response
+	// from your endpoint and the time that it sends the next health check request.
+	// Each Route 53 health checker makes requests at this interval.
+	// +kubebuilder:validation:Enum=10;30
+	// +immutable
+	// +optional
+	RequestInterval *int32 `json:"requestInterval,omitempty"`
+
+	// The number of consecutive health checks that an endpoint must pass or fail
+	// for Amazon Route 53 to change the current status of the endpoint from
+	// unhealthy to healthy or vice versa. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+
+	// Specify whether you want Amazon Route 53 to measure the latency between
+	// health checkers in multiple Amazon Web Services regions and your endpoint.
+	// +immutable
+	// +optional
+	MeasureLatency *bool `json:"measureLatency,omitempty"`
+
+	// Specify whether you want Amazon Route 53 to invert the status of a health
+	// check, for example, to consider a health check unhealthy when it otherwise
+	// would be considered healthy.
+	// +optional
+	Inverted *bool `json:"inverted,omitempty"`
+
+	// Stops Route 53 from performing health checks. A disabled health check is
+	// always considered healthy, unless Inverted is true.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// The number of child health checks that are associated with a CALCULATED
+	// health check that must be healthy for Amazon Route 53 to consider the
+	// calculated health check healthy.
+	// +optional
+	HealthThreshold *int32 `json:"healthThreshold,omitempty"`
+
+	// The IDs of the health checks that are associated with a CALCULATED health
+	// check.
+	// +optional
+	ChildHealthChecks []string `json:"childHealthChecks,omitempty"`
+
+	// ChildHealthCheckRefs references HealthChecks to retrieve their IDs for
+	// ChildHealthChecks.
+	// +optional
+	ChildHealthCheckRefs []xpv1.Reference `json:"childHealthCheckRefs,omitempty"`
+
+	// ChildHealthCheckSelector selects references to HealthChecks to retrieve
+	// their IDs for ChildHealthChecks.
+	// +optional
+	ChildHealthCheckSelector *xpv1.Selector `json:"childHealthCheckSelector,omitempty"`
+
+	// Specify whether you want Amazon Route 53 to send the value of
+	// FullyQualifiedDomainName to the endpoint in the client_hello message during
+	// TLS negotiation of HTTPS health checks.
+	// +optional
+	EnableSNI *bool `json:"enableSNI,omitempty"`
+
+	// The regions that you want Amazon Route 53 health checkers to check the
+	// specified endpoint from. At least three regions must be specified. If you
+	// don't specify any regions, Route 53 uses all the default regions.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	// The CloudWatch alarm that determines whether a CLOUDWATCH_METRIC health
+	// check is healthy.
+	// +optional
+	AlarmIdentifier *AlarmIdentifier `json:"alarmIdentifier,omitempty"`
+
+	// The status of a CLOUDWATCH_METRIC health check when CloudWatch has
+	// insufficient data to determine the state of the alarm.
+	// +kubebuilder:validation:Enum=Healthy;Unhealthy;LastKnownStatus
+	// +optional
+	InsufficientDataHealthStatus string `json:"insufficientDataHealthStatus,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the Route 53 Application Recovery
+	// Controller routing control of a RECOVERY_CONTROL health check.
+	// +immutable
+	// +optional
+	RoutingControlARN *string `json:"routingControlArn,omitempty"`
+}
+
+// AlarmIdentifier identifies the CloudWatch alarm of a health check.
+type AlarmIdentifier struct {
+	// The name of the CloudWatch alarm.
+	Name string `json:"name"`
+
+	// The region of the CloudWatch alarm.
+	Region string `json:"region"`
+}
+
+// HealthCheckObservation keeps the state for the external resource.
+type HealthCheckObservation struct {
+	// The version of the health check. It is incremented on every update.
+	HealthCheckVersion int64 `json:"healthCheckVersion,omitempty"`
+
+	// LinkedService is the service that created the health check.
+	LinkedService LinkedService `json:"linkedService,omitempty"`
+}
+
+// HealthCheckSpec defines the desired state of an AWS Route53 Health Check.
+type HealthCheckSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HealthCheckParameters `json:"forProvider"`
+}
+
+// HealthCheckStatus represents the observed state of a HealthCheck.
+type HealthCheckStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HealthCheckObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheck is a managed resource that represents an AWS Route53 Health Check.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type HealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HealthCheckSpec   `json:"spec"`
+	Status HealthCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheckList contains a list of HealthCheck.
+type HealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []HealthCheck `json:"items"`
+}
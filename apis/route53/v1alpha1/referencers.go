@@ -45,6 +45,41 @@ func (mg *ResourceRecordSet) ResolveReferences(ctx context.Context, c client.Rea
 	mg.Spec.ForProvider.ZoneID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.ZoneIDRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.healthCheckId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HealthCheckID),
+		Reference:    mg.Spec.ForProvider.HealthCheckIDRef,
+		Selector:     mg.Spec.ForProvider.HealthCheckIDSelector,
+		To:           reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.healthCheckId")
+	}
+	mg.Spec.ForProvider.HealthCheckID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HealthCheckIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this HealthCheck
+func (mg *HealthCheck) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.childHealthChecks
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ChildHealthChecks,
+		References:    mg.Spec.ForProvider.ChildHealthCheckRefs,
+		Selector:      mg.Spec.ForProvider.ChildHealthCheckSelector,
+		To:            reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.childHealthChecks")
+	}
+	mg.Spec.ForProvider.ChildHealthChecks = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ChildHealthCheckRefs = mrsp.ResolvedReferences
+
 	return nil
 }
 
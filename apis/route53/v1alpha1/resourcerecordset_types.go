@@ -224,6 +224,15 @@ type ResourceRecordSetParameters struct {
 	// +optional
 	HealthCheckID *string `json:"healthCheckId,omitempty"`
 
+	// HealthCheckIDRef references a HealthCheck to retrieve its ID.
+	// +optional
+	HealthCheckIDRef *xpv1.Reference `json:"healthCheckIdRef,omitempty"`
+
+	// HealthCheckIDSelector selects a reference to a HealthCheck to retrieve
+	// its ID.
+	// +optional
+	HealthCheckIDSelector *xpv1.Selector `json:"healthCheckIdSelector,omitempty"`
+
 	// Multivalue answer resource record sets only: To route traffic approximately
 	// randomly to multiple resources, such as web servers, create one multivalue
 	// answer record for each resource and specify true for MultiValueAnswer. Note
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+type ObjectParameters struct {
+	// Region is where the Bucket referenced by this Object resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// BucketName presents the name of the bucket.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key is the name of the object in the bucket.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the inline content of the object. Either content,
+	// contentConfigMapRef or contentSecretRef must be specified.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentConfigMapRef references a key of a ConfigMap that holds the
+	// content of the object.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// ContentSecretRef references a key of a Secret that holds the content
+	// of the object.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentType is a standard MIME type describing the format of the
+	// object data.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// CacheControl specifies caching behavior along the request/reply chain.
+	// +optional
+	CacheControl *string `json:"cacheControl,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption algorithm used when
+	// storing this object in Amazon S3.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms"
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ID of the symmetric customer managed key to use for
+	// object encryption, if serverSideEncryption is aws:kms.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKMSKeyID,omitempty"`
+}
+
+// ConfigMapKeySelector selects a key of a ConfigMap.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// Key within the ConfigMap that holds the content.
+	Key string `json:"key"`
+}
+
+// An ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// ObjectObservation is the representation of the current state that is
+// observed.
+type ObjectObservation struct {
+	// ETag is the entity tag of the object as last written by the
+	// controller.
+	ETag *string `json:"eTag,omitempty"`
+
+	// ContentMD5 is the hex encoded MD5 digest of the content last written
+	// by the controller.
+	ContentMD5 *string `json:"contentMD5,omitempty"`
+
+	// VersionID is the version of the object, if versioning is enabled on
+	// the bucket.
+	VersionID *string `json:"versionID,omitempty"`
+}
+
+// An ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents a small object in an AWS
+// S3 bucket.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
@@ -22,7 +22,9 @@ package v1beta1
 // Simple Storage Service Developer Guide.
 type CORSConfiguration struct {
 	// A set of origins and methods (cross-origin access that you want to allow).
-	// You can add up to 100 rules to the configuration.
+	// You can add up to 100 rules to the configuration. Amazon S3 uses the
+	// first rule that matches a request, so the order of the rules is
+	// preserved.
 	CORSRules []CORSRule `json:"corsRules"`
 }
 
@@ -48,6 +50,11 @@ type CORSRule struct {
 	// +optional
 	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
 
+	// Unique identifier for the rule. The value cannot be longer than 255
+	// characters.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
 	// The time in seconds that your browser is to cache the preflight response
 	// for the specified resource.
 	// +optional
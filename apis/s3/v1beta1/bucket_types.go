@@ -142,6 +142,41 @@ type BucketParameters struct {
 	// PublicAccessBlockConfiguration that you want to apply to this Amazon
 	// S3 bucket.
 	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// ReplicateTo pairs the bucket with a bucket in another region for
+	// disaster recovery. The paired Bucket is created with the name of this
+	// Bucket suffixed with the region and is deleted along with it. Unless
+	// they are specified, versioning is enabled and a replication
+	// configuration that replicates all objects to the paired bucket is set.
+	// +optional
+	ReplicateTo *ReplicateTo `json:"replicateTo,omitempty"`
+}
+
+// ReplicateTo configures the paired bucket of a Bucket.
+type ReplicateTo struct {
+	// Region of the paired bucket.
+	// +immutable
+	Region string `json:"region"`
+
+	// Role is the ARN of the IAM role that Amazon S3 assumes to replicate
+	// objects to the paired bucket.
+	// At least one of role, roleRef or roleSelector fields is required.
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// RoleRef references an IAM Role to retrieve its ARN.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an IAM Role to retrieve its ARN.
+	// +optional
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
+
+	// StorageClass of the replicated objects. Defaults to the storage class
+	// of the source objects.
+	// +kubebuilder:validation:Enum=STANDARD;GLACIER;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;DEEP_ARCHIVE
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
 }
 
 // BucketSpec represents the desired state of the Bucket.
@@ -156,6 +191,23 @@ type BucketExternalStatus struct {
 	// about ARNs and how to use them, see S3 Resources (https://docs.aws.amazon.com/AmazonS3/latest/dev/s3-arn-format.html)
 	// in the Amazon Simple Storage Service guide.
 	ARN string `json:"arn"`
+
+	// WebsiteEndpoint is the endpoint of the static website hosted by the
+	// bucket. It is only set if a website configuration is specified.
+	// +optional
+	WebsiteEndpoint string `json:"websiteEndpoint,omitempty"`
+
+	// WebsiteDomain is the domain of the S3 website endpoint of the bucket's
+	// region. Use it as the DNS name of Route53 alias records and of
+	// CloudFront custom origins.
+	// +optional
+	WebsiteDomain string `json:"websiteDomain,omitempty"`
+
+	// WebsiteHostedZoneID is the ID of the Route53 hosted zone of the S3
+	// website endpoint of the bucket's region. Use it as the hosted zone ID of
+	// Route53 alias records.
+	// +optional
+	WebsiteHostedZoneID string `json:"websiteHostedZoneId,omitempty"`
 }
 
 // BucketStatus represents the observed state of the Bucket.
@@ -169,6 +221,7 @@ type BucketStatus struct {
 // An Bucket is a managed resource that represents an AWS S3 Bucket.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
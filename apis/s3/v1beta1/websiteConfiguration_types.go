@@ -106,7 +106,15 @@ type Condition struct {
 type Redirect struct {
 	// The host name to use in the redirect request.
 	// +optional
-	HostName *string `json:"keyPrefixEquals,omitempty"`
+	HostName *string `json:"hostName,omitempty"`
+
+	// DeprecatedHostName is the host name to use in the redirect request.
+	// Earlier versions of this API serialized HostName under this key by
+	// mistake. It is only used if HostName is not set.
+	// Deprecated: Use HostName. This field will be removed in a future
+	// version.
+	// +optional
+	DeprecatedHostName *string `json:"keyPrefixEquals,omitempty"`
 
 	// The HTTP redirect code to use on the response. Not required if one of the
 	// siblings is present.
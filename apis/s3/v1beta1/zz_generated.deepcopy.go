@@ -265,6 +265,11 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(PublicAccessBlockConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplicateTo != nil {
+		in, out := &in.ReplicateTo, &out.ReplicateTo
+		*out = new(ReplicateTo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -356,6 +361,11 @@ func (in *CORSRule) DeepCopyInto(out *CORSRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CORSRule.
@@ -965,6 +975,11 @@ func (in *Redirect) DeepCopyInto(out *Redirect) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeprecatedHostName != nil {
+		in, out := &in.DeprecatedHostName, &out.DeprecatedHostName
+		*out = new(string)
+		**out = **in
+	}
 	if in.HTTPRedirectCode != nil {
 		in, out := &in.HTTPRedirectCode, &out.HTTPRedirectCode
 		*out = new(string)
@@ -1007,6 +1022,41 @@ func (in *RedirectAllRequestsTo) DeepCopy() *RedirectAllRequestsTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicateTo) DeepCopyInto(out *ReplicateTo) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.RoleSelector != nil {
+		in, out := &in.RoleSelector, &out.RoleSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClass != nil {
+		in, out := &in.StorageClass, &out.StorageClass
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicateTo.
+func (in *ReplicateTo) DeepCopy() *ReplicateTo {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicateTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationConfiguration) DeepCopyInto(out *ReplicationConfiguration) {
 	*out = *in
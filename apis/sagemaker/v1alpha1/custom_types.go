@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomNotebookInstanceParameters includes custom additional fields for NotebookInstanceParameters.
+type CustomNotebookInstanceParameters struct {
+	// The Amazon Resource Name (ARN) of a Key Management Service key that Amazon
+	// SageMaker uses to encrypt data on the storage volume attached to the
+	// notebook instance.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:refFieldName=KMSKeyIDRef
+	// +crossplane:generate:reference:selectorFieldName=KMSKeyIDSelector
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+
+	// KMSKeyIDRef is a reference to a KMS Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIDRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set the
+	// KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIDSelector,omitempty"`
+
+	// The name of a lifecycle configuration to associate with the notebook
+	// instance. Amazon Braket notebooks use a lifecycle configuration to install
+	// the Braket SDK and example notebooks when the instance starts.
+	// +optional
+	// +crossplane:generate:reference:type=NotebookInstanceLifecycleConfig
+	LifecycleConfigName *string `json:"lifecycleConfigName,omitempty"`
+
+	// LifecycleConfigNameRef is a reference to a
+	// NotebookInstanceLifecycleConfig used to set the LifecycleConfigName.
+	// +optional
+	LifecycleConfigNameRef *xpv1.Reference `json:"lifecycleConfigNameRef,omitempty"`
+
+	// LifecycleConfigNameSelector selects a reference to a
+	// NotebookInstanceLifecycleConfig used to set the LifecycleConfigName.
+	// +optional
+	LifecycleConfigNameSelector *xpv1.Selector `json:"lifecycleConfigNameSelector,omitempty"`
+
+	// The ARN of the IAM role that Amazon SageMaker assumes to perform tasks on
+	// your behalf. For Amazon Braket notebooks the role needs to grant access to
+	// Amazon Braket and to the S3 bucket that stores the quantum task results.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleARN,omitempty"`
+
+	// RoleARNRef is a reference to an IAM Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleARNRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleARNSelector,omitempty"`
+
+	// The VPC security group IDs. They must be for the same VPC as specified in
+	// the subnet.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	SecurityGroupIDs []*string `json:"securityGroupIDs,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIDRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIDSelector,omitempty"`
+
+	// The ID of the subnet in a VPC to which you would like to have a
+	// connectivity from your ML compute instance.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	SubnetID *string `json:"subnetID,omitempty"`
+
+	// SubnetIDRef is a reference to a Subnet used to set the SubnetID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIDRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet used to set the
+	// SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIDSelector,omitempty"`
+}
+
+// CustomNotebookInstanceLifecycleConfigParameters includes custom additional fields for NotebookInstanceLifecycleConfigParameters.
+type CustomNotebookInstanceLifecycleConfigParameters struct{}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ScheduleParameters define the desired state of a Schedule.
+type ScheduleParameters struct {
+	// Start is a cron expression with five fields (minute, hour, day of
+	// month, month and day of week) that matches the times at which a
+	// window starts. Fields accept numbers, ranges, steps and lists, e.g.
+	// "0 20 * * 1-5" for every weekday at 20:00.
+	// +kubebuilder:validation:Required
+	Start string `json:"start"`
+
+	// Duration is how long a window lasts after it started, e.g. "12h".
+	// Windows can last up to seven days.
+	// +kubebuilder:validation:Required
+	Duration metav1.Duration `json:"duration"`
+
+	// TimeZone is the IANA time zone the Start expression is evaluated in,
+	// e.g. "Europe/Berlin". Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Targets are the managed resources that are turned off during a
+	// window and turned back on after it ended. A managed resource must not
+	// be the target of more than one Schedule. A target that another
+	// Schedule turned off is neither turned off nor back on, and the
+	// Schedule reports an error during its windows.
+	// +kubebuilder:validation:MinItems=1
+	Targets []ScheduleTarget `json:"targets"`
+}
+
+// A ScheduleTarget refers to a managed resource that is turned off during the
+// windows of a Schedule. Supported kinds are
+//
+// - Instance.ec2.aws.crossplane.io, which is stopped.
+//
+// - NodeGroup.eks.aws.crossplane.io, which is scaled to zero nodes.
+//
+// - DBInstance.rds.aws.crossplane.io, which is stopped.
+//
+// - Cluster.redshift.aws.crossplane.io, which is paused.
+type ScheduleTarget struct {
+	// APIVersion of the referenced managed resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referenced managed resource.
+	Kind string `json:"kind"`
+
+	// Name of the referenced managed resource.
+	Name string `json:"name"`
+}
+
+// ScheduleObservation keeps the state for the external resource.
+type ScheduleObservation struct {
+	// Active is true while a window is going on.
+	Active bool `json:"active,omitempty"`
+
+	// WindowStart is the time the current window started at.
+	WindowStart *metav1.Time `json:"windowStart,omitempty"`
+
+	// WindowEnd is the time the current window ends at.
+	WindowEnd *metav1.Time `json:"windowEnd,omitempty"`
+}
+
+// A ScheduleSpec defines the desired state of a Schedule.
+type ScheduleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScheduleParameters `json:"forProvider"`
+}
+
+// A ScheduleStatus represents the observed state of a Schedule.
+type ScheduleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ScheduleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Schedule turns other managed resources off during recurring windows and
+// back on after them, e.g. to stop development databases over night. It
+// does not create anything in AWS itself.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACTIVE",type="boolean",JSONPath=".status.atProvider.active"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Schedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScheduleSpec   `json:"spec"`
+	Status ScheduleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScheduleList contains a list of Schedule
+type ScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Schedule `json:"items"`
+}
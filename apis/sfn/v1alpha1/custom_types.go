@@ -25,7 +25,6 @@ type CustomActivityParameters struct{}
 type CustomStateMachineParameters struct {
 	// RoleARN is the ARN for the IAMRole.
 	// It has to be given directly or resolved using RoleARNRef or RoleARNSelector.
-	// +immutable
 	// +optional
 	RoleARN *string `json:"roleArn,omitempty"`
 
@@ -24,6 +24,8 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	resource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 )
 
 // SNSTopicARN returns a function that returns the ARN of the given SNS Topic.
@@ -58,3 +60,42 @@ func (mg *Subscription) ResolveReferences(ctx context.Context, c client.Reader)
 
 	return nil
 }
+
+// ResolveReferences for SNS Topic managed type
+func (mg *Topic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.DeliveryStatusLogging {
+		l := &mg.Spec.ForProvider.DeliveryStatusLogging[i]
+
+		// Resolve spec.forProvider.deliveryStatusLogging[].successFeedbackRoleArn
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(l.SuccessFeedbackRoleARN),
+			Reference:    l.SuccessFeedbackRoleARNRef,
+			Selector:     l.SuccessFeedbackRoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+			Extract:      iamv1beta1.RoleARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.deliveryStatusLogging[%d].successFeedbackRoleArn", i)
+		}
+		l.SuccessFeedbackRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		l.SuccessFeedbackRoleARNRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.deliveryStatusLogging[].failureFeedbackRoleArn
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(l.FailureFeedbackRoleARN),
+			Reference:    l.FailureFeedbackRoleARNRef,
+			Selector:     l.FailureFeedbackRoleARNSelector,
+			To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+			Extract:      iamv1beta1.RoleARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.deliveryStatusLogging[%d].failureFeedbackRoleArn", i)
+		}
+		l.FailureFeedbackRoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+		l.FailureFeedbackRoleARNRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -59,6 +59,13 @@ type SubscriptionParameters struct {
 	// +optional
 	FilterPolicy *string `json:"filterPolicy,omitempty"`
 
+	//  FilterPolicyScope defines whether the filter policy is applied to
+	//  the message attributes or to the message body. Defaults to
+	//  MessageAttributes.
+	// +optional
+	// +kubebuilder:validation:Enum=MessageAttributes;MessageBody
+	FilterPolicyScope *string `json:"filterPolicyScope,omitempty"`
+
 	//  When set to true, enables raw message delivery
 	//  to Amazon SQS or HTTP/S endpoints. This eliminates the need for the endpoints
 	//  to process JSON formatting, which is otherwise created for Amazon SNS
@@ -121,6 +128,7 @@ type SubscriptionStatus struct {
 // a AWS SNS Subscription
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".spec.forProvider.endpoint"
 // +kubebuilder:printcolumn:name="PROTOCOL",type="string",JSONPath=".spec.forProvider.protocol"
 // +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
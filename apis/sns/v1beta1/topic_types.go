@@ -45,6 +45,52 @@ type Tag struct {
 	Value *string `json:"value,omitempty"`
 }
 
+// TopicDeliveryStatusLogging configures the logging of the delivery status
+// of messages sent to an endpoint protocol to CloudWatch Logs. For more
+// information, see Amazon SNS message delivery status
+// (https://docs.aws.amazon.com/sns/latest/dg/sns-topic-attributes.html)
+// in the SNS User Guide.
+type TopicDeliveryStatusLogging struct {
+	// Protocol is the endpoint protocol the delivery status is logged for.
+	// +kubebuilder:validation:Enum=HTTP;Application;Lambda;SQS;Firehose
+	Protocol string `json:"protocol"`
+
+	// SuccessFeedbackRoleARN is the ARN of the IAM role that SNS uses to
+	// write successful deliveries to CloudWatch Logs.
+	// +optional
+	SuccessFeedbackRoleARN *string `json:"successFeedbackRoleArn,omitempty"`
+
+	// SuccessFeedbackRoleARNRef references an IAM Role to retrieve its ARN.
+	// +optional
+	SuccessFeedbackRoleARNRef *xpv1.Reference `json:"successFeedbackRoleArnRef,omitempty"`
+
+	// SuccessFeedbackRoleARNSelector selects a reference to an IAM Role to
+	// retrieve its ARN.
+	// +optional
+	SuccessFeedbackRoleARNSelector *xpv1.Selector `json:"successFeedbackRoleArnSelector,omitempty"`
+
+	// FailureFeedbackRoleARN is the ARN of the IAM role that SNS uses to
+	// write failed deliveries to CloudWatch Logs.
+	// +optional
+	FailureFeedbackRoleARN *string `json:"failureFeedbackRoleArn,omitempty"`
+
+	// FailureFeedbackRoleARNRef references an IAM Role to retrieve its ARN.
+	// +optional
+	FailureFeedbackRoleARNRef *xpv1.Reference `json:"failureFeedbackRoleArnRef,omitempty"`
+
+	// FailureFeedbackRoleARNSelector selects a reference to an IAM Role to
+	// retrieve its ARN.
+	// +optional
+	FailureFeedbackRoleARNSelector *xpv1.Selector `json:"failureFeedbackRoleArnSelector,omitempty"`
+
+	// SuccessFeedbackSampleRate is the percentage of successful deliveries
+	// that are logged.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	SuccessFeedbackSampleRate *int32 `json:"successFeedbackSampleRate,omitempty"`
+}
+
 // TopicParameters define the desired state of a AWS SNS Topic
 type TopicParameters struct {
 	// Region is the region you'd like your Topic to be created in.
@@ -76,6 +122,11 @@ type TopicParameters struct {
 	// +optional
 	DeliveryPolicy *string `json:"deliveryPolicy,omitempty"`
 
+	// DeliveryStatusLogging configures the logging of message delivery
+	// status to CloudWatch Logs per endpoint protocol.
+	// +optional
+	DeliveryStatusLogging []TopicDeliveryStatusLogging `json:"deliveryStatusLogging,omitempty"`
+
 	// Tags represetnt a list of user-provided metadata that can be associated with a
 	// SNS Topic. For more information about tagging,
 	// see Tagging SNS Topics (https://docs.aws.amazon.com/sns/latest/dg/sns-tags.html)
@@ -124,6 +175,7 @@ type TopicStatus struct {
 // Topic defines a managed resource that represents state of a AWS Topic
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
 // +kubebuilder:printcolumn:name="TOPIC-NAME",type="string",JSONPath=".spec.forProvider.name"
 // +kubebuilder:printcolumn:name="DISPLAY-NAME",type="string",JSONPath=".spec.forProvider.displayName"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
@@ -138,6 +138,11 @@ func (in *SubscriptionParameters) DeepCopyInto(out *SubscriptionParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FilterPolicyScope != nil {
+		in, out := &in.FilterPolicyScope, &out.FilterPolicyScope
+		*out = new(string)
+		**out = **in
+	}
 	if in.RawMessageDelivery != nil {
 		in, out := &in.RawMessageDelivery, &out.RawMessageDelivery
 		*out = new(string)
@@ -241,6 +246,56 @@ func (in *Topic) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicDeliveryStatusLogging) DeepCopyInto(out *TopicDeliveryStatusLogging) {
+	*out = *in
+	if in.SuccessFeedbackRoleARN != nil {
+		in, out := &in.SuccessFeedbackRoleARN, &out.SuccessFeedbackRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SuccessFeedbackRoleARNRef != nil {
+		in, out := &in.SuccessFeedbackRoleARNRef, &out.SuccessFeedbackRoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SuccessFeedbackRoleARNSelector != nil {
+		in, out := &in.SuccessFeedbackRoleARNSelector, &out.SuccessFeedbackRoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureFeedbackRoleARN != nil {
+		in, out := &in.FailureFeedbackRoleARN, &out.FailureFeedbackRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureFeedbackRoleARNRef != nil {
+		in, out := &in.FailureFeedbackRoleARNRef, &out.FailureFeedbackRoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FailureFeedbackRoleARNSelector != nil {
+		in, out := &in.FailureFeedbackRoleARNSelector, &out.FailureFeedbackRoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SuccessFeedbackSampleRate != nil {
+		in, out := &in.SuccessFeedbackSampleRate, &out.SuccessFeedbackSampleRate
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicDeliveryStatusLogging.
+func (in *TopicDeliveryStatusLogging) DeepCopy() *TopicDeliveryStatusLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicDeliveryStatusLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopicList) DeepCopyInto(out *TopicList) {
 	*out = *in
@@ -331,6 +386,13 @@ func (in *TopicParameters) DeepCopyInto(out *TopicParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DeliveryStatusLogging != nil {
+		in, out := &in.DeliveryStatusLogging, &out.DeliveryStatusLogging
+		*out = make([]TopicDeliveryStatusLogging, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -42,6 +42,7 @@ const (
 	AttributeContentBasedDeduplication             string = "ContentBasedDeduplication"
 	AttributeKmsMasterKeyID                        string = "KmsMasterKeyId"
 	AttributeKmsDataKeyReusePeriodSeconds          string = "KmsDataKeyReusePeriodSeconds"
+	AttributeRedriveAllowPolicy                    string = "RedriveAllowPolicy"
 )
 
 // RedrivePolicy includes the parameters for the dead-letter queue functionality of the source queue.
@@ -65,6 +66,44 @@ type RedrivePolicy struct {
 	MaxReceiveCount int64 `json:"maxReceiveCount"`
 }
 
+// Enum values for the redrive permission of a RedriveAllowPolicy
+const (
+	RedrivePermissionAllowAll string = "allowAll"
+	RedrivePermissionDenyAll  string = "denyAll"
+	RedrivePermissionByQueue  string = "byQueue"
+)
+
+// RedriveAllowPolicy includes the parameters for the permissions for the
+// dead-letter queue redrive permission and which source queues can specify
+// dead-letter queues as the ARN of this queue.
+type RedriveAllowPolicy struct {
+	// RedrivePermission defines which source queues can specify the current
+	// queue as the dead-letter queue. allowAll permits all source queues
+	// in the same account and region, denyAll permits none and byQueue
+	// permits only the queues given in SourceQueueARNs.
+	// +kubebuilder:validation:Enum=allowAll;denyAll;byQueue
+	RedrivePermission string `json:"redrivePermission"`
+
+	// SourceQueueARNs are the ARNs of the source queues that can specify
+	// this queue as the dead-letter queue when RedrivePermission is byQueue.
+	// You can specify no more than 10 source queue ARNs.
+	// +optional
+	// +crossplane:generate:reference:type=Queue
+	// +crossplane:generate:reference:extractor=QueueARN()
+	// +crossplane:generate:reference:refFieldName=SourceQueueARNRefs
+	// +crossplane:generate:reference:selectorFieldName=SourceQueueARNSelector
+	SourceQueueARNs []string `json:"sourceQueueArns,omitempty"`
+
+	// SourceQueueARNRefs reference Queues to retrieve their ARNs.
+	// +optional
+	SourceQueueARNRefs []xpv1.Reference `json:"sourceQueueArnRefs,omitempty"`
+
+	// SourceQueueARNSelector selects references to Queues to retrieve their
+	// ARNs.
+	// +optional
+	SourceQueueARNSelector *xpv1.Selector `json:"sourceQueueArnSelector,omitempty"`
+}
+
 // QueueParameters define the desired state of an AWS Queue
 type QueueParameters struct {
 	// Region is the region you'd like your Queue to be created in.
@@ -108,6 +147,12 @@ type QueueParameters struct {
 	// +optional
 	RedrivePolicy *RedrivePolicy `json:"redrivePolicy,omitempty"`
 
+	// RedriveAllowPolicy includes the parameters for the permissions for
+	// the dead-letter queue redrive permission and which source queues can
+	// specify this queue as their dead-letter queue.
+	// +optional
+	RedriveAllowPolicy *RedriveAllowPolicy `json:"redriveAllowPolicy,omitempty"`
+
 	// VisibilityTimeout - The visibility timeout for the queue, in seconds.
 	// Valid values: an integer from 0 to 43,200 (12 hours). Default: 30. For
 	// more information about the visibility timeout, see Visibility Timeout
@@ -226,9 +271,11 @@ type QueueStatus struct {
 // +kubebuilder:object:root=true
 
 // A Queue is a managed resource that represents a AWS Simple Queue
-// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.url",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
@@ -141,6 +141,11 @@ func (in *QueueParameters) DeepCopyInto(out *QueueParameters) {
 		*out = new(RedrivePolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedriveAllowPolicy != nil {
+		in, out := &in.RedriveAllowPolicy, &out.RedriveAllowPolicy
+		*out = new(RedriveAllowPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VisibilityTimeout != nil {
 		in, out := &in.VisibilityTimeout, &out.VisibilityTimeout
 		*out = new(int64)
@@ -229,6 +234,36 @@ func (in *QueueStatus) DeepCopy() *QueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedriveAllowPolicy) DeepCopyInto(out *RedriveAllowPolicy) {
+	*out = *in
+	if in.SourceQueueARNs != nil {
+		in, out := &in.SourceQueueARNs, &out.SourceQueueARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceQueueARNRefs != nil {
+		in, out := &in.SourceQueueARNRefs, &out.SourceQueueARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceQueueARNSelector != nil {
+		in, out := &in.SourceQueueARNSelector, &out.SourceQueueARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedriveAllowPolicy.
+func (in *RedriveAllowPolicy) DeepCopy() *RedriveAllowPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RedriveAllowPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedrivePolicy) DeepCopyInto(out *RedrivePolicy) {
 	*out = *in
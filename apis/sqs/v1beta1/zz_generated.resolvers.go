@@ -31,6 +31,7 @@ func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
 
 	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
 	var err error
 
 	if mg.Spec.ForProvider.RedrivePolicy != nil {
@@ -50,6 +51,24 @@ func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN = reference.ToPtrValue(rsp.ResolvedValue)
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef = rsp.ResolvedReference
 
+	}
+	if mg.Spec.ForProvider.RedriveAllowPolicy != nil {
+		mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNs,
+			Extract:       QueueARN(),
+			References:    mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNRefs,
+			Selector:      mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNSelector,
+			To: reference.To{
+				List:    &QueueList{},
+				Managed: &Queue{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNs")
+		}
+		mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNs = mrsp.ResolvedValues
+		mg.Spec.ForProvider.RedriveAllowPolicy.SourceQueueARNRefs = mrsp.ResolvedReferences
+
 	}
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KMSMasterKeyID),
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomAssociationParameters includes custom additional fields for AssociationParameters.
+type CustomAssociationParameters struct{}
+
+// CustomMaintenanceWindowParameters includes custom additional fields for MaintenanceWindowParameters.
+type CustomMaintenanceWindowParameters struct{}
+
+// CustomMaintenanceWindowTargetParameters includes custom additional fields for MaintenanceWindowTargetParameters.
+type CustomMaintenanceWindowTargetParameters struct {
+	// The ID of the maintenance window the target should be registered with.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=MaintenanceWindow
+	WindowID *string `json:"windowID,omitempty"`
+
+	// WindowIDRef is a reference to a MaintenanceWindow used to set the
+	// WindowID.
+	// +optional
+	WindowIDRef *xpv1.Reference `json:"windowIDRef,omitempty"`
+
+	// WindowIDSelector selects references to a MaintenanceWindow used to set
+	// the WindowID.
+	// +optional
+	WindowIDSelector *xpv1.Selector `json:"windowIDSelector,omitempty"`
+}
+
+// CustomMaintenanceWindowTaskParameters includes custom additional fields for MaintenanceWindowTaskParameters.
+type CustomMaintenanceWindowTaskParameters struct {
+	// The ID of the maintenance window the task should be added to.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=MaintenanceWindow
+	WindowID *string `json:"windowID,omitempty"`
+
+	// WindowIDRef is a reference to a MaintenanceWindow used to set the
+	// WindowID.
+	// +optional
+	WindowIDRef *xpv1.Reference `json:"windowIDRef,omitempty"`
+
+	// WindowIDSelector selects references to a MaintenanceWindow used to set
+	// the WindowID.
+	// +optional
+	WindowIDSelector *xpv1.Selector `json:"windowIDSelector,omitempty"`
+
+	// The ARN of the IAM service role for Systems Manager to assume when
+	// running a maintenance window task. If not given, the service-linked
+	// role of Systems Manager is used.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	ServiceRoleARN *string `json:"serviceRoleARN,omitempty"`
+
+	// ServiceRoleARNRef is a reference to an IAM Role used to set the
+	// ServiceRoleARN.
+	// +optional
+	ServiceRoleARNRef *xpv1.Reference `json:"serviceRoleARNRef,omitempty"`
+
+	// ServiceRoleARNSelector selects references to an IAM Role used to set
+	// the ServiceRoleARN.
+	// +optional
+	ServiceRoleARNSelector *xpv1.Selector `json:"serviceRoleARNSelector,omitempty"`
+}
+
+// CustomPatchBaselineParameters includes custom additional fields for PatchBaselineParameters.
+type CustomPatchBaselineParameters struct{}
+
+// CustomPatchGroupParameters includes custom additional fields for PatchGroupParameters.
+type CustomPatchGroupParameters struct {
+	// The ID of the patch baseline to register the patch group with.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=PatchBaseline
+	BaselineID *string `json:"baselineID,omitempty"`
+
+	// BaselineIDRef is a reference to a PatchBaseline used to set the
+	// BaselineID.
+	// +optional
+	BaselineIDRef *xpv1.Reference `json:"baselineIDRef,omitempty"`
+
+	// BaselineIDSelector selects references to a PatchBaseline used to set
+	// the BaselineID.
+	// +optional
+	BaselineIDSelector *xpv1.Selector `json:"baselineIDSelector,omitempty"`
+
+	// The name of the patch group that should be registered with the patch
+	// baseline. Instances join a patch group through their "Patch Group" or
+	// "PatchGroup" tag.
+	// +immutable
+	// +kubebuilder:validation:Required
+	PatchGroup string `json:"patchGroup"`
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomContactParameters includes custom additional fields for ContactParameters.
+type CustomContactParameters struct{}
+
+// CustomEscalationPlanParameters includes custom additional fields for EscalationPlanParameters.
+type CustomEscalationPlanParameters struct{}
+
+// CustomContactChannelParameters includes custom additional fields for ContactChannelParameters.
+type CustomContactChannelParameters struct {
+	// The ARN of the contact the channel is added to.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Contact
+	ContactID *string `json:"contactID,omitempty"`
+
+	// ContactIDRef is a reference to a Contact used to set the ContactID.
+	// +optional
+	ContactIDRef *xpv1.Reference `json:"contactIDRef,omitempty"`
+
+	// ContactIDSelector selects references to a Contact used to set the
+	// ContactID.
+	// +optional
+	ContactIDSelector *xpv1.Selector `json:"contactIDSelector,omitempty"`
+
+	// DeliveryAddressSecretRef references the key of a Secret that contains
+	// the phone number or email address the engagements are sent to.
+	DeliveryAddressSecretRef xpv1.SecretKeySelector `json:"deliveryAddressSecretRef"`
+}
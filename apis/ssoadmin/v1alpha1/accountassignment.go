@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: AccountAssignment is not generated since the SSO Admin API has no
+// operation to describe a single account assignment.
+
+// AccountAssignmentParameters defines the desired state of AccountAssignment
+type AccountAssignmentParameters struct {
+	// Region is which region the AccountAssignment will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ARN of the SSO instance under which the operation will be executed.
+	// +immutable
+	// +kubebuilder:validation:Required
+	InstanceARN string `json:"instanceARN"`
+
+	// The ARN of the permission set that the admin wants to grant the
+	// principal access to.
+	// +immutable
+	// +optional
+	PermissionSetARN *string `json:"permissionSetARN,omitempty"`
+
+	// PermissionSetARNRef is a reference to a PermissionSet used to set the
+	// PermissionSetARN.
+	// +optional
+	PermissionSetARNRef *xpv1.Reference `json:"permissionSetARNRef,omitempty"`
+
+	// PermissionSetARNSelector selects references to a PermissionSet used to
+	// set the PermissionSetARN.
+	// +optional
+	PermissionSetARNSelector *xpv1.Selector `json:"permissionSetARNSelector,omitempty"`
+
+	// An identifier for an object in Amazon Web Services SSO, such as a user
+	// or group. PrincipalIds are GUIDs of the identity store. It has to be
+	// given directly or resolved from a Group or User of the identity store.
+	// +immutable
+	// +optional
+	PrincipalID string `json:"principalID,omitempty"`
+
+	// PrincipalIDGroupRef is a reference to an identity store Group used to
+	// set the PrincipalID.
+	// +optional
+	PrincipalIDGroupRef *xpv1.Reference `json:"principalIDGroupRef,omitempty"`
+
+	// PrincipalIDGroupSelector selects references to an identity store Group
+	// used to set the PrincipalID.
+	// +optional
+	PrincipalIDGroupSelector *xpv1.Selector `json:"principalIDGroupSelector,omitempty"`
+
+	// PrincipalIDUserRef is a reference to an identity store User used to set
+	// the PrincipalID.
+	// +optional
+	PrincipalIDUserRef *xpv1.Reference `json:"principalIDUserRef,omitempty"`
+
+	// PrincipalIDUserSelector selects references to an identity store User
+	// used to set the PrincipalID.
+	// +optional
+	PrincipalIDUserSelector *xpv1.Selector `json:"principalIDUserSelector,omitempty"`
+
+	// The entity type for which the assignment will be created.
+	// +immutable
+	// +kubebuilder:validation:Enum=USER;GROUP
+	// +kubebuilder:validation:Required
+	PrincipalType string `json:"principalType"`
+
+	// The identifier of the Amazon Web Services account to which the
+	// permission set is assigned.
+	// +immutable
+	// +kubebuilder:validation:Required
+	TargetID string `json:"targetID"`
+
+	// The entity type for which the assignment will be created.
+	// +immutable
+	// +kubebuilder:validation:Enum=AWS_ACCOUNT
+	// +kubebuilder:default=AWS_ACCOUNT
+	// +optional
+	TargetType *string `json:"targetType,omitempty"`
+}
+
+// AccountAssignmentSpec defines the desired state of AccountAssignment
+type AccountAssignmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountAssignmentParameters `json:"forProvider"`
+}
+
+// AccountAssignmentObservation defines the observed state of AccountAssignment
+type AccountAssignmentObservation struct {
+	// The identifier of the last creation or deletion request of the
+	// assignment.
+	RequestID *string `json:"requestID,omitempty"`
+
+	// The status of the last creation or deletion request of the assignment.
+	Status *string `json:"status,omitempty"`
+
+	// The reason the last creation or deletion request of the assignment
+	// failed.
+	FailureReason *string `json:"failureReason,omitempty"`
+}
+
+// AccountAssignmentStatus defines the observed state of AccountAssignment.
+type AccountAssignmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountAssignmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAssignment is the Schema for the AccountAssignments API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TARGET",type="string",JSONPath=".spec.forProvider.targetID"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principalID"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              AccountAssignmentSpec   `json:"spec"`
+	Status            AccountAssignmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAssignmentList contains a list of AccountAssignments
+type AccountAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountAssignment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	AccountAssignmentKind             = "AccountAssignment"
+	AccountAssignmentGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountAssignmentKind}.String()
+	AccountAssignmentKindAPIVersion   = AccountAssignmentKind + "." + GroupVersion.String()
+	AccountAssignmentGroupVersionKind = GroupVersion.WithKind(AccountAssignmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountAssignment{}, &AccountAssignmentList{})
+}
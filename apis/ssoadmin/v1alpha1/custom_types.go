@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// CustomPermissionSetParameters contains the additional fields for
+// PermissionSetParameters.
+type CustomPermissionSetParameters struct {
+	// The ARN of the SSO instance under which the operation will be executed.
+	// +immutable
+	// +kubebuilder:validation:Required
+	InstanceARN string `json:"instanceARN"`
+
+	// The ARNs of the IAM managed policies that are attached to the permission
+	// set.
+	// +optional
+	ManagedPolicyARNs []string `json:"managedPolicyARNs,omitempty"`
+
+	// The IAM inline policy that is attached to the permission set.
+	// +optional
+	InlinePolicy *string `json:"inlinePolicy,omitempty"`
+}
+
+// CustomInstanceAccessControlAttributeConfigurationParameters contains the
+// additional fields for InstanceAccessControlAttributeConfigurationParameters.
+type CustomInstanceAccessControlAttributeConfigurationParameters struct {
+	// The ARN of the SSO instance under which the operation will be executed.
+	// +immutable
+	// +kubebuilder:validation:Required
+	InstanceARN string `json:"instanceARN"`
+}
+
+// CustomInstanceAccessControlAttributeConfigurationObservation contains the
+// additional fields for InstanceAccessControlAttributeConfigurationObservation.
+type CustomInstanceAccessControlAttributeConfigurationObservation struct {
+	// The status of the attribute configuration process.
+	Status *string `json:"status,omitempty"`
+
+	// Provides more details about the current status of the specified
+	// attribute.
+	StatusReason *string `json:"statusReason,omitempty"`
+}
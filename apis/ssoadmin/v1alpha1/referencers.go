@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	identitystorev1alpha1 "github.com/crossplane/provider-aws/apis/identitystore/v1alpha1"
+)
+
+// ResolveReferences of this AccountAssignment
+func (mg *AccountAssignment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.permissionSetARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PermissionSetARN),
+		Reference:    mg.Spec.ForProvider.PermissionSetARNRef,
+		Selector:     mg.Spec.ForProvider.PermissionSetARNSelector,
+		To:           reference.To{Managed: &PermissionSet{}, List: &PermissionSetList{}},
+		Extract:      PermissionSetARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.permissionSetARN")
+	}
+	mg.Spec.ForProvider.PermissionSetARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PermissionSetARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.principalID
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrincipalID,
+		Reference:    mg.Spec.ForProvider.PrincipalIDGroupRef,
+		Selector:     mg.Spec.ForProvider.PrincipalIDGroupSelector,
+		To:           reference.To{Managed: &identitystorev1alpha1.Group{}, List: &identitystorev1alpha1.GroupList{}},
+		Extract:      identitystorev1alpha1.GroupID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.principalID")
+	}
+	mg.Spec.ForProvider.PrincipalID = rsp.ResolvedValue
+	mg.Spec.ForProvider.PrincipalIDGroupRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.PrincipalID,
+		Reference:    mg.Spec.ForProvider.PrincipalIDUserRef,
+		Selector:     mg.Spec.ForProvider.PrincipalIDUserSelector,
+		To:           reference.To{Managed: &identitystorev1alpha1.User{}, List: &identitystorev1alpha1.UserList{}},
+		Extract:      identitystorev1alpha1.UserID(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.principalID")
+	}
+	mg.Spec.ForProvider.PrincipalID = rsp.ResolvedValue
+	mg.Spec.ForProvider.PrincipalIDUserRef = rsp.ResolvedReference
+	return nil
+}
+
+// PermissionSetARN returns the status.atProvider.permissionSetARN of a
+// PermissionSet.
+func PermissionSetARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*PermissionSet)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.PermissionSetARN)
+	}
+}
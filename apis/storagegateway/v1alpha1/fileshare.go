@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// File share states.
+const (
+	FileShareStatusCreating    = "CREATING"
+	FileShareStatusUpdating    = "UPDATING"
+	FileShareStatusAvailable   = "AVAILABLE"
+	FileShareStatusDeleting    = "DELETING"
+	FileShareStatusForceDelete = "FORCE_DELETING"
+)
+
+// FileShareParameters are the parameters shared by NFS and SMB file shares.
+type FileShareParameters struct {
+	// The Amazon Resource Name (ARN) of the S3 File Gateway on which you want
+	// to create a file share.
+	// +immutable
+	// +optional
+	GatewayARN *string `json:"gatewayARN,omitempty"`
+
+	// GatewayARNRef is a reference to a Gateway used to set the GatewayARN.
+	// +optional
+	GatewayARNRef *xpv1.Reference `json:"gatewayARNRef,omitempty"`
+
+	// GatewayARNSelector selects references to a Gateway used to set the
+	// GatewayARN.
+	// +optional
+	GatewayARNSelector *xpv1.Selector `json:"gatewayARNSelector,omitempty"`
+
+	// The ARN of the backend storage used for storing file data. A prefix name
+	// can be added to the S3 bucket name. It must end with a "/".
+	// +immutable
+	// +optional
+	LocationARN *string `json:"locationARN,omitempty"`
+
+	// LocationARNRef is a reference to an S3 Bucket used to set the
+	// LocationARN.
+	// +optional
+	LocationARNRef *xpv1.Reference `json:"locationARNRef,omitempty"`
+
+	// LocationARNSelector selects references to an S3 Bucket used to set the
+	// LocationARN.
+	// +optional
+	LocationARNSelector *xpv1.Selector `json:"locationARNSelector,omitempty"`
+
+	// The ARN of the Identity and Access Management (IAM) role that an S3 File
+	// Gateway assumes when it accesses the underlying storage.
+	// +immutable
+	// +optional
+	Role *string `json:"role,omitempty"`
+
+	// RoleRef is a reference to an IAM Role used to set the Role.
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects references to an IAM Role used to set the Role.
+	// +optional
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
+
+	// Specifies the Region of the S3 bucket where the file share stores
+	// files. This parameter is required for file share creation using S3
+	// access points via VPC endpoints.
+	// +immutable
+	// +optional
+	BucketRegion *string `json:"bucketRegion,omitempty"`
+
+	// Specifies the DNS name for the VPC endpoint that the file share uses to
+	// connect to Amazon S3.
+	// +immutable
+	// +optional
+	VPCEndpointDNSName *string `json:"vpcEndpointDNSName,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the storage used for audit logs.
+	// +optional
+	AuditDestinationARN *string `json:"auditDestinationARN,omitempty"`
+
+	// Specifies refresh cache information for the file share.
+	// +optional
+	CacheAttributes *CacheAttributes `json:"cacheAttributes,omitempty"`
+
+	// The default storage class for objects put into an Amazon S3 bucket by
+	// the file gateway. The default value is S3_INTELLIGENT_TIERING.
+	// +optional
+	DefaultStorageClass *string `json:"defaultStorageClass,omitempty"`
+
+	// The name of the file share. The default value is the name of the S3
+	// bucket.
+	// +optional
+	FileShareName *string `json:"fileShareName,omitempty"`
+
+	// A value that enables guessing of the MIME type for uploaded objects
+	// based on file extensions.
+	// +optional
+	GuessMIMETypeEnabled *bool `json:"guessMIMETypeEnabled,omitempty"`
+
+	// Set to true to use Amazon S3 server-side encryption with your own KMS
+	// key, or false to use a key managed by Amazon S3.
+	// +optional
+	KMSEncrypted *bool `json:"kmsEncrypted,omitempty"`
+
+	// The Amazon Resource Name (ARN) of a symmetric customer master key (CMK)
+	// used for Amazon S3 server-side encryption. This value can only be set
+	// when KMSEncrypted is true.
+	// +optional
+	KMSKey *string `json:"kmsKey,omitempty"`
+
+	// KMSKeyRef is a reference to a KMS Key used to set the KMSKey.
+	// +optional
+	KMSKeyRef *xpv1.Reference `json:"kmsKeyRef,omitempty"`
+
+	// KMSKeySelector selects references to a KMS Key used to set the KMSKey.
+	// +optional
+	KMSKeySelector *xpv1.Selector `json:"kmsKeySelector,omitempty"`
+
+	// The notification policy of the file share, for example
+	// {"Upload": {"SettlingTimeInSeconds": 60}}.
+	// +optional
+	NotificationPolicy *string `json:"notificationPolicy,omitempty"`
+
+	// A value that sets the access control list (ACL) permission for objects
+	// in the S3 bucket that a S3 File Gateway puts objects into. The default
+	// value is private.
+	// +optional
+	ObjectACL *string `json:"objectACL,omitempty"`
+
+	// A value that sets the write status of a file share. Set this value to
+	// true to set the write status to read-only, otherwise set to false.
+	// +optional
+	ReadOnly *bool `json:"readOnly,omitempty"`
+
+	// A value that sets who pays the cost of the request and the cost
+	// associated with data download from the S3 bucket.
+	// +optional
+	RequesterPays *bool `json:"requesterPays,omitempty"`
+
+	// A list of up to 50 tags that can be assigned to the file share.
+	// +immutable
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// FileShareObservation is the observed state shared by NFS and SMB file
+// shares.
+type FileShareObservation struct {
+	// The Amazon Resource Name (ARN) of the file share.
+	FileShareARN *string `json:"fileShareARN,omitempty"`
+
+	// The ID of the file share.
+	FileShareID *string `json:"fileShareID,omitempty"`
+
+	// The status of the file share.
+	FileShareStatus *string `json:"fileShareStatus,omitempty"`
+
+	// The file share path used by the NFS or SMB client to identify the mount
+	// point.
+	Path *string `json:"path,omitempty"`
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: Gateway is not generated since a gateway is activated rather than
+// created in the Storage Gateway API.
+
+// Gateway states.
+const (
+	GatewayStateRunning  = "RUNNING"
+	GatewayStateShutdown = "SHUTDOWN"
+)
+
+// GatewayParameters defines the desired state of Gateway
+type GatewayParameters struct {
+	// Region is which region the Gateway will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Your gateway activation key. You can obtain the activation key by sending
+	// an HTTP GET request with redirects enabled to the gateway IP address (port
+	// 80). The redirect URL returned in the response provides you the activation
+	// key for your gateway in the query string parameter activationKey.
+	// +immutable
+	// +kubebuilder:validation:Required
+	ActivationKey string `json:"activationKey"`
+
+	// The name you configured for your gateway.
+	// +kubebuilder:validation:Required
+	GatewayName string `json:"gatewayName"`
+
+	// A value that indicates the time zone you want to set for the gateway, for
+	// example GMT-4:00.
+	// +kubebuilder:validation:Required
+	GatewayTimezone string `json:"gatewayTimezone"`
+
+	// A value that defines the type of gateway to activate. The type specified
+	// is critical to all later functions of the gateway and cannot be changed
+	// after activation. The default value is CACHED.
+	// +immutable
+	// +kubebuilder:validation:Enum=STORED;CACHED;VTL;VTL_SNOW;FILE_S3;FILE_FSX_SMB
+	// +optional
+	GatewayType *string `json:"gatewayType,omitempty"`
+
+	// The value that indicates the type of tape drive to use for tape gateway.
+	// +immutable
+	// +optional
+	TapeDriveType *string `json:"tapeDriveType,omitempty"`
+
+	// The value that indicates the type of medium changer to use for tape
+	// gateway.
+	// +immutable
+	// +optional
+	MediumChangerType *string `json:"mediumChangerType,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the Amazon CloudWatch Log Group that
+	// you want to use to monitor and log events in the gateway.
+	// +optional
+	CloudWatchLogGroupARN *string `json:"cloudWatchLogGroupARN,omitempty"`
+
+	// A list of up to 50 tags that you can assign to the gateway.
+	// +immutable
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// GatewaySpec defines the desired state of Gateway
+type GatewaySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GatewayParameters `json:"forProvider"`
+}
+
+// GatewayObservation defines the observed state of Gateway
+type GatewayObservation struct {
+	// The Amazon Resource Name (ARN) of the gateway.
+	GatewayARN *string `json:"gatewayARN,omitempty"`
+
+	// The unique identifier assigned to your gateway during activation.
+	GatewayID *string `json:"gatewayID,omitempty"`
+
+	// A value that indicates the operating state of the gateway.
+	GatewayState *string `json:"gatewayState,omitempty"`
+
+	// The type of endpoint for your gateway.
+	EndpointType *string `json:"endpointType,omitempty"`
+
+	// The type of hardware or software platform on which the gateway is
+	// running.
+	HostEnvironment *string `json:"hostEnvironment,omitempty"`
+
+	// The ID of the Amazon EC2 instance that was used to launch the gateway.
+	EC2InstanceID *string `json:"ec2InstanceID,omitempty"`
+}
+
+// GatewayStatus defines the observed state of Gateway.
+type GatewayStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GatewayObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Gateway is the Schema for the Gateways API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.gatewayState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GatewaySpec   `json:"spec"`
+	Status            GatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateways
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	GatewayKind             = "Gateway"
+	GatewayGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GatewayKind}.String()
+	GatewayKindAPIVersion   = GatewayKind + "." + GroupVersion.String()
+	GatewayGroupVersionKind = GroupVersion.WithKind(GatewayKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Gateway{}, &GatewayList{})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: NFSFileShare is not generated since NFS and SMB file shares have a
+// common delete operation in the Storage Gateway API.
+
+// NFSFileShareParameters defines the desired state of NFSFileShare
+type NFSFileShareParameters struct {
+	// Region is which region the NFSFileShare will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The list of clients that are allowed to access the S3 File Gateway. The
+	// list must contain either valid IP addresses or valid CIDR blocks.
+	// +optional
+	ClientList []*string `json:"clientList,omitempty"`
+
+	// File share default values.
+	// +optional
+	NFSFileShareDefaults *NFSFileShareDefaults `json:"nfsFileShareDefaults,omitempty"`
+
+	// A value that maps a user to anonymous user. Valid values are
+	// RootSquash, NoSquash and AllSquash.
+	// +kubebuilder:validation:Enum=RootSquash;NoSquash;AllSquash
+	// +optional
+	Squash *string `json:"squash,omitempty"`
+
+	FileShareParameters `json:",inline"`
+}
+
+// NFSFileShareSpec defines the desired state of NFSFileShare
+type NFSFileShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NFSFileShareParameters `json:"forProvider"`
+}
+
+// NFSFileShareObservation defines the observed state of NFSFileShare
+type NFSFileShareObservation struct {
+	FileShareObservation `json:",inline"`
+}
+
+// NFSFileShareStatus defines the observed state of NFSFileShare.
+type NFSFileShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NFSFileShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NFSFileShare is the Schema for the NFSFileShares API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.fileShareStatus"
+// +kubebuilder:printcolumn:name="PATH",type="string",JSONPath=".status.atProvider.path"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NFSFileShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              NFSFileShareSpec   `json:"spec"`
+	Status            NFSFileShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NFSFileShareList contains a list of NFSFileShares
+type NFSFileShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NFSFileShare `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	NFSFileShareKind             = "NFSFileShare"
+	NFSFileShareGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: NFSFileShareKind}.String()
+	NFSFileShareKindAPIVersion   = NFSFileShareKind + "." + GroupVersion.String()
+	NFSFileShareGroupVersionKind = GroupVersion.WithKind(NFSFileShareKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&NFSFileShare{}, &NFSFileShareList{})
+}
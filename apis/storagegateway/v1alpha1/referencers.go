@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// GatewayARN returns the status.atProvider.gatewayARN of a Gateway.
+func GatewayARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Gateway)
+		if !ok {
+			return ""
+		}
+		return reference.FromPtrValue(r.Status.AtProvider.GatewayARN)
+	}
+}
+
+// ResolveReferences of this NFSFileShare
+func (mg *NFSFileShare) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.ForProvider.FileShareParameters.resolveReferences(ctx, reference.NewAPIResolver(c, mg))
+}
+
+// ResolveReferences of this SMBFileShare
+func (mg *SMBFileShare) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.ForProvider.FileShareParameters.resolveReferences(ctx, reference.NewAPIResolver(c, mg))
+}
+
+func (p *FileShareParameters) resolveReferences(ctx context.Context, r *reference.APIResolver) error {
+	// Resolve spec.forProvider.gatewayARN
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.GatewayARN),
+		Reference:    p.GatewayARNRef,
+		Selector:     p.GatewayARNSelector,
+		To:           reference.To{Managed: &Gateway{}, List: &GatewayList{}},
+		Extract:      GatewayARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.gatewayARN")
+	}
+	p.GatewayARN = reference.ToPtrValue(rsp.ResolvedValue)
+	p.GatewayARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.locationARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.LocationARN),
+		Reference:    p.LocationARNRef,
+		Selector:     p.LocationARNSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      s3v1beta1.BucketARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.locationARN")
+	}
+	p.LocationARN = reference.ToPtrValue(rsp.ResolvedValue)
+	p.LocationARNRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.role
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.Role),
+		Reference:    p.RoleRef,
+		Selector:     p.RoleSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.role")
+	}
+	p.Role = reference.ToPtrValue(rsp.ResolvedValue)
+	p.RoleRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.kmsKey
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.KMSKey),
+		Reference:    p.KMSKeyRef,
+		Selector:     p.KMSKeySelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      kmsv1alpha1.KMSKeyARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.kmsKey")
+	}
+	p.KMSKey = reference.ToPtrValue(rsp.ResolvedValue)
+	p.KMSKeyRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: SMBFileShare is not generated since NFS and SMB file shares have a
+// common delete operation in the Storage Gateway API.
+
+// SMBFileShareParameters defines the desired state of SMBFileShare
+type SMBFileShareParameters struct {
+	// Region is which region the SMBFileShare will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The authentication method that users use to access the file share. The
+	// default is ActiveDirectory.
+	// +immutable
+	// +kubebuilder:validation:Enum=ActiveDirectory;GuestAccess
+	// +optional
+	Authentication *string `json:"authentication,omitempty"`
+
+	// The files and folders on this share will only be visible to users with
+	// read access.
+	// +optional
+	AccessBasedEnumeration *bool `json:"accessBasedEnumeration,omitempty"`
+
+	// A list of users or groups in the Active Directory that will be granted
+	// administrator privileges on the file share. These users can do all file
+	// operations as the super-user.
+	// +optional
+	AdminUserList []*string `json:"adminUserList,omitempty"`
+
+	// The case of an object name in an Amazon S3 bucket. For ClientSpecified,
+	// the client determines the case sensitivity. For CaseSensitive, the
+	// gateway determines the case sensitivity.
+	// +kubebuilder:validation:Enum=ClientSpecified;CaseSensitive
+	// +optional
+	CaseSensitivity *string `json:"caseSensitivity,omitempty"`
+
+	// A list of users or groups in the Active Directory that are not allowed
+	// to access the file share. Can only be set if Authentication is set to
+	// ActiveDirectory.
+	// +optional
+	InvalidUserList []*string `json:"invalidUserList,omitempty"`
+
+	// Specifies whether opportunistic locking is enabled for the SMB file
+	// share.
+	// +optional
+	OplocksEnabled *bool `json:"oplocksEnabled,omitempty"`
+
+	// Set this value to true to enable access control list (ACL) on the SMB
+	// file share. Set it to false to map file and directory permissions to the
+	// POSIX permissions.
+	// +optional
+	SMBACLEnabled *bool `json:"smbACLEnabled,omitempty"`
+
+	// A list of users or groups in the Active Directory that are allowed to
+	// access the file share. Can only be set if Authentication is set to
+	// ActiveDirectory.
+	// +optional
+	ValidUserList []*string `json:"validUserList,omitempty"`
+
+	FileShareParameters `json:",inline"`
+}
+
+// SMBFileShareSpec defines the desired state of SMBFileShare
+type SMBFileShareSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SMBFileShareParameters `json:"forProvider"`
+}
+
+// SMBFileShareObservation defines the observed state of SMBFileShare
+type SMBFileShareObservation struct {
+	FileShareObservation `json:",inline"`
+}
+
+// SMBFileShareStatus defines the observed state of SMBFileShare.
+type SMBFileShareStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SMBFileShareObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SMBFileShare is the Schema for the SMBFileShares API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.fileShareStatus"
+// +kubebuilder:printcolumn:name="PATH",type="string",JSONPath=".status.atProvider.path"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SMBFileShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SMBFileShareSpec   `json:"spec"`
+	Status            SMBFileShareStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SMBFileShareList contains a list of SMBFileShares
+type SMBFileShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SMBFileShare `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	SMBFileShareKind             = "SMBFileShare"
+	SMBFileShareGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SMBFileShareKind}.String()
+	SMBFileShareKindAPIVersion   = SMBFileShareKind + "." + GroupVersion.String()
+	SMBFileShareGroupVersionKind = GroupVersion.WithKind(SMBFileShareKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SMBFileShare{}, &SMBFileShareList{})
+}
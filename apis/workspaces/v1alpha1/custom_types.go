@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// CustomDirectoryParameters includes custom additional fields for DirectoryParameters.
+type CustomDirectoryParameters struct {
+	// DirectoryID is the identifier of the AWS Directory Service directory
+	// to register with WorkSpaces.
+	// +immutable
+	DirectoryID string `json:"directoryId"`
+
+	// SubnetIDs are the identifiers of the subnets for the WorkSpaces of
+	// the directory. The subnets have to be in different Availability Zones.
+	// +immutable
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set
+	// the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set
+	// the SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// IPGroupIDs are the identifiers of the IP access control groups that
+	// are associated with the directory.
+	// +optional
+	IPGroupIDs []string `json:"ipGroupIds,omitempty"`
+
+	// IPGroupIDRefs is a list of references to IPGroups used to set
+	// the IPGroupIDs.
+	// +optional
+	IPGroupIDRefs []xpv1.Reference `json:"ipGroupIdRefs,omitempty"`
+
+	// IPGroupIDSelector selects references to IPGroups used to set
+	// the IPGroupIDs.
+	// +optional
+	IPGroupIDSelector *xpv1.Selector `json:"ipGroupIdSelector,omitempty"`
+}
+
+// CustomIPGroupParameters includes custom additional fields for IPGroupParameters.
+type CustomIPGroupParameters struct{}
+
+// CustomWorkspaceBundleParameters includes custom additional fields for WorkspaceBundleParameters.
+type CustomWorkspaceBundleParameters struct{}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+)
+
+// ResolveReferences of this Directory
+func (mg *Directory) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2.Subnet{}, List: &ec2.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.ipGroupIds
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.IPGroupIDs,
+		References:    mg.Spec.ForProvider.IPGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.IPGroupIDSelector,
+		To:            reference.To{Managed: &IPGroup{}, List: &IPGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ipGroupIds")
+	}
+	mg.Spec.ForProvider.IPGroupIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.IPGroupIDRefs = mrsp.ResolvedReferences
+	return nil
+}
+
+// ResolveReferences of this Workspace
+func (mg *Workspace) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.directoryId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DirectoryID),
+		Reference:    mg.Spec.ForProvider.DirectoryIDRef,
+		Selector:     mg.Spec.ForProvider.DirectoryIDSelector,
+		To:           reference.To{Managed: &Directory{}, List: &DirectoryList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.directoryId")
+	}
+	mg.Spec.ForProvider.DirectoryID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DirectoryIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.bundleId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BundleID),
+		Reference:    mg.Spec.ForProvider.BundleIDRef,
+		Selector:     mg.Spec.ForProvider.BundleIDSelector,
+		To:           reference.To{Managed: &WorkspaceBundle{}, List: &WorkspaceBundleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bundleId")
+	}
+	mg.Spec.ForProvider.BundleID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BundleIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.volumeEncryptionKey
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VolumeEncryptionKey),
+		Reference:    mg.Spec.ForProvider.VolumeEncryptionKeyRef,
+		Selector:     mg.Spec.ForProvider.VolumeEncryptionKeySelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      kmsv1alpha1.KMSKeyARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.volumeEncryptionKey")
+	}
+	mg.Spec.ForProvider.VolumeEncryptionKey = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.VolumeEncryptionKeyRef = rsp.ResolvedReference
+	return nil
+}
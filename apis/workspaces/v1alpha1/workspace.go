@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NOTE: Workspace is not generated since WorkSpaces are created and
+// terminated with batch operations that do not map to a single resource.
+
+// WorkspaceParameters defines the desired state of Workspace
+type WorkspaceParameters struct {
+	// Region is which region the Workspace will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The identifier of the Directory for the WorkSpace. It has to be given
+	// directly or resolved using DirectoryIDRef or DirectoryIDSelector.
+	// +immutable
+	// +optional
+	DirectoryID *string `json:"directoryId,omitempty"`
+
+	// DirectoryIDRef is a reference to a Directory used to set DirectoryID.
+	// +optional
+	DirectoryIDRef *xpv1.Reference `json:"directoryIdRef,omitempty"`
+
+	// DirectoryIDSelector selects a reference to a Directory used to set
+	// DirectoryID.
+	// +optional
+	DirectoryIDSelector *xpv1.Selector `json:"directoryIdSelector,omitempty"`
+
+	// The user name of the user for the WorkSpace. This user name must exist
+	// in the Directory for the WorkSpace.
+	// +immutable
+	// +kubebuilder:validation:Required
+	UserName *string `json:"userName"`
+
+	// The identifier of the bundle for the WorkSpace. It has to be given
+	// directly or resolved using BundleIDRef or BundleIDSelector.
+	// +immutable
+	// +optional
+	BundleID *string `json:"bundleId,omitempty"`
+
+	// BundleIDRef is a reference to a WorkspaceBundle used to set BundleID.
+	// +optional
+	BundleIDRef *xpv1.Reference `json:"bundleIdRef,omitempty"`
+
+	// BundleIDSelector selects a reference to a WorkspaceBundle used to set
+	// BundleID.
+	// +optional
+	BundleIDSelector *xpv1.Selector `json:"bundleIdSelector,omitempty"`
+
+	// The symmetric KMS key used to encrypt data stored on your WorkSpace.
+	// +immutable
+	// +optional
+	VolumeEncryptionKey *string `json:"volumeEncryptionKey,omitempty"`
+
+	// VolumeEncryptionKeyRef is a reference to a KMS Key used to set
+	// VolumeEncryptionKey.
+	// +optional
+	VolumeEncryptionKeyRef *xpv1.Reference `json:"volumeEncryptionKeyRef,omitempty"`
+
+	// VolumeEncryptionKeySelector selects a reference to a KMS Key used to
+	// set VolumeEncryptionKey.
+	// +optional
+	VolumeEncryptionKeySelector *xpv1.Selector `json:"volumeEncryptionKeySelector,omitempty"`
+
+	// Indicates whether the data stored on the root volume is encrypted.
+	// +immutable
+	// +optional
+	RootVolumeEncryptionEnabled *bool `json:"rootVolumeEncryptionEnabled,omitempty"`
+
+	// Indicates whether the data stored on the user volume is encrypted.
+	// +immutable
+	// +optional
+	UserVolumeEncryptionEnabled *bool `json:"userVolumeEncryptionEnabled,omitempty"`
+
+	// The WorkSpace properties.
+	// +optional
+	WorkspaceProperties *WorkspaceProperties `json:"workspaceProperties,omitempty"`
+
+	// The tags for the WorkSpace.
+	// +immutable
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// WorkspaceSpec defines the desired state of Workspace
+type WorkspaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkspaceParameters `json:"forProvider"`
+}
+
+// WorkspaceObservation defines the observed state of Workspace
+type WorkspaceObservation struct {
+	// The name of the WorkSpace, as seen by the operating system.
+	ComputerName *string `json:"computerName,omitempty"`
+
+	// The error code that is returned if the WorkSpace cannot be created.
+	ErrorCode *string `json:"errorCode,omitempty"`
+
+	// The text of the error message that is returned if the WorkSpace cannot
+	// be created.
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// The IP address of the WorkSpace.
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// The operational state of the WorkSpace.
+	State *string `json:"state,omitempty"`
+
+	// The identifier of the subnet for the WorkSpace.
+	SubnetID *string `json:"subnetID,omitempty"`
+
+	// The identifier of the WorkSpace.
+	WorkspaceID *string `json:"workspaceID,omitempty"`
+}
+
+// WorkspaceStatus defines the observed state of Workspace.
+type WorkspaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkspaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Workspace is the Schema for the Workspaces API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Workspace struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              WorkspaceSpec   `json:"spec"`
+	Status            WorkspaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkspaceList contains a list of Workspaces
+type WorkspaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Workspace `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	WorkspaceKind             = "Workspace"
+	WorkspaceGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: WorkspaceKind}.String()
+	WorkspaceKindAPIVersion   = WorkspaceKind + "." + GroupVersion.String()
+	WorkspaceGroupVersionKind = GroupVersion.WithKind(WorkspaceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Workspace{}, &WorkspaceList{})
+}
@@ -26,11 +26,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 
 	"github.com/crossplane/provider-aws/apis"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/controller/computeoptimizer"
+	"github.com/crossplane/provider-aws/pkg/controller/health"
 )
 
 func main() {
@@ -41,6 +45,11 @@ func main() {
 		pollInterval     = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
 		leaderElection   = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		healthEvents     = app.Flag("enable-health-events", "Surface open AWS Health events as conditions of the managed resources they affect. The AWS Health API requires a Business or Enterprise support plan.").Default("false").Bool()
+		healthInterval   = app.Flag("health-poll", "Health poll interval controls how often AWS Health events are checked when they are enabled.").Default("5m").Duration()
+		rightsizing      = app.Flag("enable-compute-optimizer", "Surface AWS Compute Optimizer rightsizing recommendations as conditions of the EC2 and Lambda managed resources they concern. The accounts must be opted in to Compute Optimizer.").Default("false").Bool()
+		rightsizingPoll  = app.Flag("compute-optimizer-poll", "Compute Optimizer poll interval controls how often recommendations are fetched when they are enabled.").Default("1h").Duration()
+		apiEvents        = app.Flag("enable-api-events", "Record an event with the operation and the AWS request ID on a managed resource for every AWS API call that may change it.").Default("true").Bool()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -72,8 +81,18 @@ func main() {
 		GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
 	}
 
+	if *apiEvents {
+		awsclients.RecordAPICalls(event.NewAPIRecorder(mgr.GetEventRecorderFor("provider-aws")))
+	}
+
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
 	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup AWS controllers")
+	if *healthEvents {
+		kingpin.FatalIfError(health.Setup(mgr, log, *healthInterval), "Cannot setup AWS Health events")
+	}
+	if *rightsizing {
+		kingpin.FatalIfError(computeoptimizer.Setup(mgr, log, *rightsizingPoll), "Cannot setup Compute Optimizer recommendations")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
@@ -10,10 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// validationRecordTTL is the TTL of the DNS validation records.
+const validationRecordTTL = 300
+
 // Client defines the CertificateManager operations
 type Client interface {
 	DescribeCertificate(context.Context, *acm.DescribeCertificateInput, ...func(*acm.Options)) (*acm.DescribeCertificateOutput, error)
@@ -45,14 +49,16 @@ func GenerateCreateCertificateInput(p v1beta1.CertificateParameters) *acm.Reques
 
 	m.ValidationMethod = types.ValidationMethod(p.ValidationMethod)
 
-	if len(p.DomainValidationOptions) != 0 {
-		m.DomainValidationOptions = make([]types.DomainValidationOption, len(p.DomainValidationOptions))
-		for i, val := range p.DomainValidationOptions {
-			m.DomainValidationOptions[i] = types.DomainValidationOption{
-				DomainName:       aws.String(val.DomainName),
-				ValidationDomain: aws.String(val.ValidationDomain),
-			}
+	for _, val := range p.DomainValidationOptions {
+		// Options without validation domain only configure DNS validation
+		// records and aren't known to ACM.
+		if val.ValidationDomain == "" {
+			continue
 		}
+		m.DomainValidationOptions = append(m.DomainValidationOptions, types.DomainValidationOption{
+			DomainName:       aws.String(val.DomainName),
+			ValidationDomain: aws.String(val.ValidationDomain),
+		})
 	}
 
 	if p.SubjectAlternativeNames != nil {
@@ -74,28 +80,71 @@ func GenerateCreateCertificateInput(p v1beta1.CertificateParameters) *acm.Reques
 
 // GenerateCertificateStatus is used to produce CertificateExternalStatus from acm.certificateStatus
 func GenerateCertificateStatus(certificate types.CertificateDetail) v1beta1.CertificateExternalStatus {
-	if certificate.Type == acmtypes.CertificateTypeAmazonIssued && len(certificate.DomainValidationOptions) > 0 {
-		if certificate.DomainValidationOptions[0].ResourceRecord != nil {
-			return v1beta1.CertificateExternalStatus{
-				CertificateARN:     aws.ToString(certificate.CertificateArn),
-				RenewalEligibility: string(certificate.RenewalEligibility),
-				Status:             string(certificate.Status),
-				Type:               string(certificate.Type),
-				ResourceRecord: &v1beta1.ResourceRecord{
-					Name:  certificate.DomainValidationOptions[0].ResourceRecord.Name,
-					Value: certificate.DomainValidationOptions[0].ResourceRecord.Value,
-					Type:  (*string)(&certificate.DomainValidationOptions[0].ResourceRecord.Type),
-				},
-			}
-		}
-	}
-
-	return v1beta1.CertificateExternalStatus{
+	status := v1beta1.CertificateExternalStatus{
 		CertificateARN:     aws.ToString(certificate.CertificateArn),
 		RenewalEligibility: string(certificate.RenewalEligibility),
 		Status:             string(certificate.Status),
 		Type:               string(certificate.Type),
 	}
+	if certificate.Type == acmtypes.CertificateTypeAmazonIssued && len(certificate.DomainValidationOptions) > 0 {
+		status.ResourceRecord = generateResourceRecord(certificate.DomainValidationOptions[0].ResourceRecord)
+	}
+	for _, dv := range certificate.DomainValidationOptions {
+		status.DomainValidations = append(status.DomainValidations, v1beta1.DomainValidation{
+			DomainName:       aws.ToString(dv.DomainName),
+			ValidationStatus: string(dv.ValidationStatus),
+			ValidationMethod: string(dv.ValidationMethod),
+			ResourceRecord:   generateResourceRecord(dv.ResourceRecord),
+		})
+	}
+	return status
+}
+
+func generateResourceRecord(rr *types.ResourceRecord) *v1beta1.ResourceRecord {
+	if rr == nil {
+		return nil
+	}
+	return &v1beta1.ResourceRecord{
+		Name:  rr.Name,
+		Value: rr.Value,
+		Type:  (*string)(&rr.Type),
+	}
+}
+
+// GenerateValidationRecordChanges returns the changes that create the DNS
+// validation records of the pending domains that opted in to
+// createRoute53Records, keyed by the ID of their hosted zone.
+func GenerateValidationRecordChanges(p v1beta1.CertificateParameters, validations []v1beta1.DomainValidation) map[string][]route53types.Change {
+	zones := map[string]string{}
+	for _, o := range p.DomainValidationOptions {
+		if o.CreateRoute53Records && aws.ToString(o.HostedZoneID) != "" {
+			zones[o.DomainName] = aws.ToString(o.HostedZoneID)
+		}
+	}
+	changes := map[string][]route53types.Change{}
+	seen := map[string]bool{}
+	for _, dv := range validations {
+		zone, ok := zones[dv.DomainName]
+		if !ok || dv.ValidationStatus != string(acmtypes.DomainStatusPendingValidation) || dv.ResourceRecord == nil {
+			continue
+		}
+		// A domain and its wildcard share the same validation record.
+		key := zone + "/" + aws.ToString(dv.ResourceRecord.Name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		changes[zone] = append(changes[zone], route53types.Change{
+			Action: route53types.ChangeActionUpsert,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name:            dv.ResourceRecord.Name,
+				Type:            route53types.RRType(aws.ToString(dv.ResourceRecord.Type)),
+				TTL:             aws.Int64(validationRecordTTL),
+				ResourceRecords: []route53types.ResourceRecord{{Value: dv.ResourceRecord.Value}},
+			},
+		})
+	}
+	return changes
 }
 
 // LateInitializeCertificate fills the empty fields in *v1beta1.CertificateParameters with
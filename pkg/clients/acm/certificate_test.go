@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 
 	aws "github.com/crossplane/provider-aws/pkg/clients"
@@ -210,6 +211,14 @@ func TestGenerateCertificateStatus(t *testing.T) {
 					Value: &sValue,
 					Type:  &sType,
 				},
+				DomainValidations: []v1beta1.DomainValidation{{
+					DomainName: sName,
+					ResourceRecord: &v1beta1.ResourceRecord{
+						Name:  &sName,
+						Value: &sValue,
+						Type:  &sType,
+					},
+				}},
 			},
 		},
 	}
@@ -224,6 +233,90 @@ func TestGenerateCertificateStatus(t *testing.T) {
 	}
 }
 
+func TestGenerateValidationRecordChanges(t *testing.T) {
+	zoneID := "Z1D633PJN98FT9"
+	wildcard := "*." + domainName
+	rName := "_xyz." + domainName + "."
+	rValue := "_xxx.zzz.acm-validations.aws."
+
+	validation := func(domain string, status acmtypes.DomainStatus) v1beta1.DomainValidation {
+		return v1beta1.DomainValidation{
+			DomainName:       domain,
+			ValidationStatus: string(status),
+			ResourceRecord: &v1beta1.ResourceRecord{
+				Name:  aws.String(rName),
+				Type:  aws.String(string(acmtypes.RecordTypeCname)),
+				Value: aws.String(rValue),
+			},
+		}
+	}
+	change := route53types.Change{
+		Action: route53types.ChangeActionUpsert,
+		ResourceRecordSet: &route53types.ResourceRecordSet{
+			Name:            aws.String(rName),
+			Type:            route53types.RRTypeCname,
+			TTL:             aws.Int64(validationRecordTTL),
+			ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(rValue)}},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1beta1.CertificateParameters
+		dv   []v1beta1.DomainValidation
+		want map[string][]route53types.Change
+	}{
+		"Pending": {
+			p: v1beta1.CertificateParameters{
+				DomainValidationOptions: []*v1beta1.DomainValidationOption{
+					{DomainName: domainName, CreateRoute53Records: true, HostedZoneID: &zoneID},
+				},
+			},
+			dv:   []v1beta1.DomainValidation{validation(domainName, acmtypes.DomainStatusPendingValidation)},
+			want: map[string][]route53types.Change{zoneID: {change}},
+		},
+		"SharedWildcardRecord": {
+			p: v1beta1.CertificateParameters{
+				DomainValidationOptions: []*v1beta1.DomainValidationOption{
+					{DomainName: domainName, CreateRoute53Records: true, HostedZoneID: &zoneID},
+					{DomainName: wildcard, CreateRoute53Records: true, HostedZoneID: &zoneID},
+				},
+			},
+			dv: []v1beta1.DomainValidation{
+				validation(domainName, acmtypes.DomainStatusPendingValidation),
+				validation(wildcard, acmtypes.DomainStatusPendingValidation),
+			},
+			want: map[string][]route53types.Change{zoneID: {change}},
+		},
+		"Validated": {
+			p: v1beta1.CertificateParameters{
+				DomainValidationOptions: []*v1beta1.DomainValidationOption{
+					{DomainName: domainName, CreateRoute53Records: true, HostedZoneID: &zoneID},
+				},
+			},
+			dv:   []v1beta1.DomainValidation{validation(domainName, acmtypes.DomainStatusSuccess)},
+			want: map[string][]route53types.Change{},
+		},
+		"NotOptedIn": {
+			p: v1beta1.CertificateParameters{
+				DomainValidationOptions: []*v1beta1.DomainValidationOption{
+					{DomainName: domainName, HostedZoneID: &zoneID},
+				},
+			},
+			dv:   []v1beta1.DomainValidation{validation(domainName, acmtypes.DomainStatusPendingValidation)},
+			want: map[string][]route53types.Change{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateValidationRecordChanges(tc.p, tc.dv)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(route53types.Change{}, route53types.ResourceRecordSet{}, route53types.ResourceRecord{})); diff != "" {
+				t.Errorf("GenerateValidationRecordChanges(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsCertificateUpToDate(t *testing.T) {
 	certificateTransparencyLoggingPreference := string(acmtypes.CertificateTransparencyLoggingPreferenceDisabled)
 	type args struct {
@@ -19,9 +19,11 @@ package acmpca
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsarn "github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 
@@ -37,8 +39,21 @@ type Client interface {
 	ListTags(context.Context, *acmpca.ListTagsInput, ...func(*acmpca.Options)) (*acmpca.ListTagsOutput, error)
 	UntagCertificateAuthority(context.Context, *acmpca.UntagCertificateAuthorityInput, ...func(*acmpca.Options)) (*acmpca.UntagCertificateAuthorityOutput, error)
 	TagCertificateAuthority(context.Context, *acmpca.TagCertificateAuthorityInput, ...func(*acmpca.Options)) (*acmpca.TagCertificateAuthorityOutput, error)
+	GetCertificateAuthorityCsr(context.Context, *acmpca.GetCertificateAuthorityCsrInput, ...func(*acmpca.Options)) (*acmpca.GetCertificateAuthorityCsrOutput, error)
+	IssueCertificate(context.Context, *acmpca.IssueCertificateInput, ...func(*acmpca.Options)) (*acmpca.IssueCertificateOutput, error)
+	GetCertificate(context.Context, *acmpca.GetCertificateInput, ...func(*acmpca.Options)) (*acmpca.GetCertificateOutput, error)
+	ImportCertificateAuthorityCertificate(context.Context, *acmpca.ImportCertificateAuthorityCertificateInput, ...func(*acmpca.Options)) (*acmpca.ImportCertificateAuthorityCertificateOutput, error)
 }
 
+const (
+	// defaultCAValidityInYears is the validity of issued CA certificates if
+	// none is configured.
+	defaultCAValidityInYears = 10
+
+	templateRootCACertificate        = "RootCACertificate/V1"
+	templateSubordinateCACertificate = "SubordinateCACertificate_PathLen0/V1"
+)
+
 // NewClient returns a new client using AWS credentials as JSON encoded data.
 func NewClient(conf *aws.Config) Client {
 	return acmpca.NewFromConfig(*conf)
@@ -108,6 +123,55 @@ func GenerateRevocationConfiguration(p *v1beta1.RevocationConfiguration) *types.
 	return m
 }
 
+// GenerateIssueCACertificateInput returns the input to issue the CA
+// certificate for the supplied CSR of the certificate authority with the
+// supplied ARN. The certificate is issued by the configured parent
+// certificate authority, or by the certificate authority itself if there is
+// none.
+func GenerateIssueCACertificateInput(p *v1beta1.CertificateAuthorityParameters, arn string, csr []byte, token string) *acmpca.IssueCertificateInput {
+	a := p.Activation
+	m := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(arn),
+		Csr:                     csr,
+		SigningAlgorithm:        p.CertificateAuthorityConfiguration.SigningAlgorithm,
+		TemplateArn:             a.TemplateARN,
+		Validity: &types.Validity{
+			Type:  types.ValidityPeriodTypeYears,
+			Value: aws.Int64(defaultCAValidityInYears),
+		},
+	}
+	if a.ParentCertificateAuthorityARN != nil {
+		m.CertificateAuthorityArn = a.ParentCertificateAuthorityARN
+	}
+	if a.SigningAlgorithm != nil {
+		m.SigningAlgorithm = *a.SigningAlgorithm
+	}
+	if a.Validity != nil {
+		m.Validity = &types.Validity{Type: a.Validity.Type, Value: aws.Int64(a.Validity.Value)}
+	}
+	if m.TemplateArn == nil {
+		template := templateRootCACertificate
+		if p.Type == types.CertificateAuthorityTypeSubordinate {
+			template = templateSubordinateCACertificate
+		}
+		partition := "aws"
+		if parsed, err := awsarn.Parse(arn); err == nil {
+			partition = parsed.Partition
+		}
+		m.TemplateArn = aws.String(fmt.Sprintf("arn:%s:acm-pca:::template/%s", partition, template))
+	}
+	if token != "" {
+		m.IdempotencyToken = aws.String(token)
+	}
+	return m
+}
+
+// IsActivationPending returns true if the certificate authority is waiting
+// for the CA certificate configured to activate it.
+func IsActivationPending(p *v1beta1.CertificateAuthorityParameters, status string) bool {
+	return p.Activation != nil && status == string(types.CertificateAuthorityStatusPendingCertificate)
+}
+
 // LateInitializeCertificateAuthority fills the empty fields in *v1beta1.CertificateAuthorityParameters with
 // the values seen in acmpca.CertificateAuthority.
 func LateInitializeCertificateAuthority(in *v1beta1.CertificateAuthorityParameters, certificateAuthority *types.CertificateAuthority) { // nolint:gocyclo
@@ -196,6 +260,13 @@ func GenerateCertificateAuthorityExternalStatus(certificateAuthority types.Certi
 	}
 }
 
+// IsErrorRequestInProgress returns true if the error code indicates that the
+// requested certificate or CSR is not yet available
+func IsErrorRequestInProgress(err error) bool {
+	var ripe *types.RequestInProgressException
+	return errors.As(err, &ripe)
+}
+
 // IsErrorNotFound returns true if the error code indicates that the item was not found
 func IsErrorNotFound(err error) bool {
 	var ise *types.InvalidStateException
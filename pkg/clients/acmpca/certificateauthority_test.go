@@ -212,6 +212,79 @@ func TestGenerateRevocationConfiguration(t *testing.T) {
 	}
 }
 
+func TestGenerateIssueCACertificateInput(t *testing.T) {
+	arn := "arn:aws-us-gov:acm-pca:us-gov-west-1:123456789012:certificate-authority/ca"
+	parentARN := "arn:aws-us-gov:acm-pca:us-gov-west-1:123456789012:certificate-authority/parent"
+	csr := []byte("somecsr")
+	parentSigningAlgorithm := types.SigningAlgorithmSha512withrsa
+
+	cases := map[string]struct {
+		in    *v1beta1.CertificateAuthorityParameters
+		token string
+		out   *acmpca.IssueCertificateInput
+	}{
+		"Root": {
+			in: &v1beta1.CertificateAuthorityParameters{
+				Type: types.CertificateAuthorityTypeRoot,
+				CertificateAuthorityConfiguration: v1beta1.CertificateAuthorityConfiguration{
+					SigningAlgorithm: types.SigningAlgorithmSha256withrsa,
+				},
+				Activation: &v1beta1.Activation{},
+			},
+			token: "sometoken",
+			out: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: aws.String(arn),
+				Csr:                     csr,
+				SigningAlgorithm:        types.SigningAlgorithmSha256withrsa,
+				TemplateArn:             aws.String("arn:aws-us-gov:acm-pca:::template/RootCACertificate/V1"),
+				Validity:                &types.Validity{Type: types.ValidityPeriodTypeYears, Value: aws.Int64(10)},
+				IdempotencyToken:        aws.String("sometoken"),
+			},
+		},
+		"Subordinate": {
+			in: &v1beta1.CertificateAuthorityParameters{
+				Type: types.CertificateAuthorityTypeSubordinate,
+				CertificateAuthorityConfiguration: v1beta1.CertificateAuthorityConfiguration{
+					SigningAlgorithm: types.SigningAlgorithmSha256withecdsa,
+				},
+				Activation: &v1beta1.Activation{
+					ParentCertificateAuthorityARN: aws.String(parentARN),
+					SigningAlgorithm:              &parentSigningAlgorithm,
+					Validity:                      &v1beta1.Validity{Type: types.ValidityPeriodTypeDays, Value: 365},
+				},
+			},
+			out: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: aws.String(parentARN),
+				Csr:                     csr,
+				SigningAlgorithm:        types.SigningAlgorithmSha512withrsa,
+				TemplateArn:             aws.String("arn:aws-us-gov:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"),
+				Validity:                &types.Validity{Type: types.ValidityPeriodTypeDays, Value: aws.Int64(365)},
+			},
+		},
+		"Template": {
+			in: &v1beta1.CertificateAuthorityParameters{
+				Type:       types.CertificateAuthorityTypeRoot,
+				Activation: &v1beta1.Activation{TemplateARN: aws.String("sometemplate")},
+			},
+			out: &acmpca.IssueCertificateInput{
+				CertificateAuthorityArn: aws.String(arn),
+				Csr:                     csr,
+				TemplateArn:             aws.String("sometemplate"),
+				Validity:                &types.Validity{Type: types.ValidityPeriodTypeYears, Value: aws.Int64(10)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateIssueCACertificateInput(tc.in, arn, csr, tc.token)
+			if diff := cmp.Diff(tc.out, r, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("GenerateIssueCACertificateInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitializeCertificateAuthority(t *testing.T) {
 
 	status := "ACTIVE"
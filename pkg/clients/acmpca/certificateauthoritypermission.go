@@ -21,8 +21,17 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 )
 
+// DefaultPermissionActions are the actions ACM needs to renew the
+// certificates issued by a certificate authority automatically.
+var DefaultPermissionActions = []types.ActionType{
+	types.ActionTypeIssueCertificate,
+	types.ActionTypeGetCertificate,
+	types.ActionTypeListPermissions,
+}
+
 // CAPermissionClient defines the CertificateManager operations
 type CAPermissionClient interface {
 	CreatePermission(context.Context, *acmpca.CreatePermissionInput, ...func(*acmpca.Options)) (*acmpca.CreatePermissionOutput, error)
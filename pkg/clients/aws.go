@@ -84,14 +84,21 @@ const (
 // GetConfig constructs an *aws.Config that can be used to authenticate to AWS
 // API by the AWS clients.
 func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
+	var cfg *aws.Config
+	var err error
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg, region)
+		cfg, err = UseProviderConfig(ctx, c, mg, region)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg, region)
+		cfg, err = UseProvider(ctx, c, mg, region)
 	default:
 		return nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
+	if err != nil {
+		return nil, err
+	}
+	cfg.APIOptions = append(cfg.APIOptions, addAPICallRecorderV2(mg))
+	return cfg, nil
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
@@ -352,7 +359,7 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -365,6 +372,17 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+	sess, err := UseProviderConfigV1(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	addAPICallRecorder(sess, mg)
+	return sess, nil
+}
+
+// UseProviderConfigV1 constructs a session for the AWSv1 clients from the
+// credentials of the given ProviderConfig.
+func UseProviderConfigV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*session.Session, error) { // nolint:gocyclo
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil {
@@ -595,6 +613,66 @@ func CreateJSONPatch(source, destination interface{}) ([]byte, error) {
 	return patchJSON, nil
 }
 
+// IsJSONSubset reports whether every field that is set in the JSON
+// representation of desired has the same value in the JSON representation of
+// observed. It is useful for comparing parameters with nested fields that are
+// defaulted by AWS when they are not given.
+func IsJSONSubset(desired, observed interface{}) (bool, error) {
+	d, err := toJSONValue(desired)
+	if err != nil {
+		return false, err
+	}
+	o, err := toJSONValue(observed)
+	if err != nil {
+		return false, err
+	}
+	return isJSONSubset(d, o), nil
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	return res, json.Unmarshal(b, &res)
+}
+
+func isJSONSubset(desired, observed interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		// Fields without omitempty are marshalled as null when unset.
+		return true
+	case map[string]interface{}:
+		if len(d) == 0 {
+			return true
+		}
+		o, ok := observed.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range d {
+			if !isJSONSubset(v, o[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		o, ok := observed.([]interface{})
+		if !ok || len(d) != len(o) {
+			return false
+		}
+		for i := range d {
+			if !isJSONSubset(d[i], o[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return cmp.Equal(desired, observed)
+	}
+}
+
 // String converts the supplied string for use with the AWS Go SDK.
 func String(v string, o ...FieldOption) *string {
 	for _, fo := range o {
@@ -883,3 +883,60 @@ func TestLateInitInt64PtrSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestIsJSONSubset(t *testing.T) {
+	type nested struct {
+		A *string `json:"a,omitempty"`
+		B *int64  `json:"b,omitempty"`
+	}
+	type params struct {
+		Name   *string  `json:"name,omitempty"`
+		Nested *nested  `json:"nested,omitempty"`
+		List   []nested `json:"list,omitempty"`
+	}
+	observed := params{
+		Name:   aws.String("name"),
+		Nested: &nested{A: aws.String("a"), B: aws.Int64(1)},
+		List:   []nested{{A: aws.String("a"), B: aws.Int64(1)}},
+	}
+
+	cases := map[string]struct {
+		desired params
+		want    bool
+	}{
+		"Empty": {
+			want: true,
+		},
+		"NestedDefaultNotGiven": {
+			desired: params{
+				Nested: &nested{A: aws.String("a")},
+				List:   []nested{{B: aws.Int64(1)}},
+			},
+			want: true,
+		},
+		"DifferentValue": {
+			desired: params{Name: aws.String("other")},
+			want:    false,
+		},
+		"DifferentNestedValue": {
+			desired: params{Nested: &nested{B: aws.Int64(2)}},
+			want:    false,
+		},
+		"DifferentListLength": {
+			desired: params{List: []nested{{}, {}}},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsJSONSubset(tc.desired, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\nIsJSONSubset(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,8 @@ type InstanceClient interface {
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 }
 
 // NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
@@ -79,9 +81,39 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
 		return false
 	}
+	if !IsInstanceStateUpToDate(spec.DesiredState, instance.State) {
+		return false
+	}
 	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// IsInstanceStateUpToDate returns false if the instance has settled in a state
+// other than the desired one. Instances that are still transitioning between
+// states are considered up to date so that they are not started or stopped
+// again before they settle.
+func IsInstanceStateUpToDate(desired *string, state *types.InstanceState) bool {
+	if state == nil {
+		return true
+	}
+	switch awsclients.StringValue(desired) {
+	case manualv1alpha1.InstanceDesiredStateRunning:
+		return state.Name != types.InstanceStateNameStopped
+	case manualv1alpha1.InstanceDesiredStateStopped, manualv1alpha1.InstanceDesiredStateHibernated:
+		return state.Name != types.InstanceStateNameRunning
+	}
+	return true
+}
+
+// IsInstanceStopDesired returns true if the instance is supposed to be stopped
+// or hibernated.
+func IsInstanceStopDesired(desired *string) bool {
+	switch awsclients.StringValue(desired) {
+	case manualv1alpha1.InstanceDesiredStateStopped, manualv1alpha1.InstanceDesiredStateHibernated:
+		return true
+	}
+	return false
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObservation {
@@ -147,6 +147,51 @@ func TestGenerateInstanceConditions(t *testing.T) {
 	}
 }
 
+func TestIsInstanceStateUpToDate(t *testing.T) {
+	type args struct {
+		desired *string
+		state   types.InstanceStateName
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{state: types.InstanceStateNameStopped},
+			want: true,
+		},
+		"RunningAndStopped": {
+			args: args{desired: aws.String(manualv1alpha1.InstanceDesiredStateRunning), state: types.InstanceStateNameStopped},
+			want: false,
+		},
+		"RunningAndPending": {
+			args: args{desired: aws.String(manualv1alpha1.InstanceDesiredStateRunning), state: types.InstanceStateNamePending},
+			want: true,
+		},
+		"StoppedAndRunning": {
+			args: args{desired: aws.String(manualv1alpha1.InstanceDesiredStateStopped), state: types.InstanceStateNameRunning},
+			want: false,
+		},
+		"HibernatedAndStopping": {
+			args: args{desired: aws.String(manualv1alpha1.InstanceDesiredStateHibernated), state: types.InstanceStateNameStopping},
+			want: true,
+		},
+		"HibernatedAndStopped": {
+			args: args{desired: aws.String(manualv1alpha1.InstanceDesiredStateHibernated), state: types.InstanceStateNameStopped},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceStateUpToDate(tc.args.desired, &types.InstanceState{Name: tc.args.state})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDescribeInstancesByExternalTags(t *testing.T) {
 	type args struct {
 		extTags map[string]string
@@ -0,0 +1,50 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.RegistryPolicyClient = (*MockRegistryPolicyClient)(nil)
+
+// MockRegistryPolicyClient is a type that implements all the methods for RegistryPolicyClient interface
+type MockRegistryPolicyClient struct {
+	MockGet    func(ctx context.Context, input *ecr.GetRegistryPolicyInput, opts []func(*ecr.Options)) (*ecr.GetRegistryPolicyOutput, error)
+	MockPut    func(ctx context.Context, input *ecr.PutRegistryPolicyInput, opts []func(*ecr.Options)) (*ecr.PutRegistryPolicyOutput, error)
+	MockDelete func(ctx context.Context, input *ecr.DeleteRegistryPolicyInput, opts []func(*ecr.Options)) (*ecr.DeleteRegistryPolicyOutput, error)
+}
+
+// GetRegistryPolicy mocks GetRegistryPolicy method
+func (m *MockRegistryPolicyClient) GetRegistryPolicy(ctx context.Context, input *ecr.GetRegistryPolicyInput, opts ...func(*ecr.Options)) (*ecr.GetRegistryPolicyOutput, error) {
+	return m.MockGet(ctx, input, opts)
+}
+
+// PutRegistryPolicy mocks PutRegistryPolicy method
+func (m *MockRegistryPolicyClient) PutRegistryPolicy(ctx context.Context, input *ecr.PutRegistryPolicyInput, opts ...func(*ecr.Options)) (*ecr.PutRegistryPolicyOutput, error) {
+	return m.MockPut(ctx, input, opts)
+}
+
+// DeleteRegistryPolicy mocks DeleteRegistryPolicy method
+func (m *MockRegistryPolicyClient) DeleteRegistryPolicy(ctx context.Context, input *ecr.DeleteRegistryPolicyInput, opts ...func(*ecr.Options)) (*ecr.DeleteRegistryPolicyOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ecr"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ReplicationConfigurationClient = (*MockReplicationConfigurationClient)(nil)
+
+// MockReplicationConfigurationClient is a type that implements all the methods for ReplicationConfigurationClient interface
+type MockReplicationConfigurationClient struct {
+	MockDescribeRegistry            func(ctx context.Context, input *ecr.DescribeRegistryInput, opts []func(*ecr.Options)) (*ecr.DescribeRegistryOutput, error)
+	MockPutReplicationConfiguration func(ctx context.Context, input *ecr.PutReplicationConfigurationInput, opts []func(*ecr.Options)) (*ecr.PutReplicationConfigurationOutput, error)
+}
+
+// DescribeRegistry mocks DescribeRegistry method
+func (m *MockReplicationConfigurationClient) DescribeRegistry(ctx context.Context, input *ecr.DescribeRegistryInput, opts ...func(*ecr.Options)) (*ecr.DescribeRegistryOutput, error) {
+	return m.MockDescribeRegistry(ctx, input, opts)
+}
+
+// PutReplicationConfiguration mocks PutReplicationConfiguration method
+func (m *MockReplicationConfigurationClient) PutReplicationConfiguration(ctx context.Context, input *ecr.PutReplicationConfigurationInput, opts ...func(*ecr.Options)) (*ecr.PutReplicationConfigurationOutput, error) {
+	return m.MockPutReplicationConfiguration(ctx, input, opts)
+}
@@ -37,6 +37,8 @@ type MockRepositoryClient struct {
 	MockUntag                 func(ctx context.Context, input *ecr.UntagResourceInput, opts []func(*ecr.Options)) (*ecr.UntagResourceOutput, error)
 	MockPutImageScan          func(ctx context.Context, input *ecr.PutImageScanningConfigurationInput, opts []func(*ecr.Options)) (*ecr.PutImageScanningConfigurationOutput, error)
 	MockPutImageTagMutability func(ctx context.Context, input *ecr.PutImageTagMutabilityInput, opts []func(*ecr.Options)) (*ecr.PutImageTagMutabilityOutput, error)
+	MockGetLifecyclePolicy    func(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts []func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error)
+	MockPutLifecyclePolicy    func(ctx context.Context, input *ecr.PutLifecyclePolicyInput, opts []func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error)
 }
 
 // CreateRepository mocks CreateRepository method
@@ -78,3 +80,13 @@ func (m *MockRepositoryClient) PutImageTagMutability(ctx context.Context, input
 func (m *MockRepositoryClient) PutImageScanningConfiguration(ctx context.Context, input *ecr.PutImageScanningConfigurationInput, opts ...func(*ecr.Options)) (*ecr.PutImageScanningConfigurationOutput, error) {
 	return m.MockPutImageScan(ctx, input, opts)
 }
+
+// GetLifecyclePolicy mocks GetLifecyclePolicy method
+func (m *MockRepositoryClient) GetLifecyclePolicy(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error) {
+	return m.MockGetLifecyclePolicy(ctx, input, opts)
+}
+
+// PutLifecyclePolicy mocks PutLifecyclePolicy method
+func (m *MockRepositoryClient) PutLifecyclePolicy(ctx context.Context, input *ecr.PutLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error) {
+	return m.MockPutLifecyclePolicy(ctx, input, opts)
+}
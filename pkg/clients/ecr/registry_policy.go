@@ -0,0 +1,22 @@
+package ecr
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	awsecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+// RegistryPolicyClient is the external client used for Registry Policy Resource
+type RegistryPolicyClient interface {
+	GetRegistryPolicy(ctx context.Context, input *ecr.GetRegistryPolicyInput, opts ...func(*ecr.Options)) (*ecr.GetRegistryPolicyOutput, error)
+	PutRegistryPolicy(ctx context.Context, input *ecr.PutRegistryPolicyInput, opts ...func(*ecr.Options)) (*ecr.PutRegistryPolicyOutput, error)
+	DeleteRegistryPolicy(ctx context.Context, input *ecr.DeleteRegistryPolicyInput, opts ...func(*ecr.Options)) (*ecr.DeleteRegistryPolicyOutput, error)
+}
+
+// IsRegistryPolicyNotFoundErr returns true if the error code indicates that the registry policy was not found
+func IsRegistryPolicyNotFoundErr(err error) bool {
+	var notFoundError *awsecrtypes.RegistryPolicyNotFoundException
+	return errors.As(err, &notFoundError)
+}
@@ -0,0 +1,79 @@
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+)
+
+// ReplicationConfigurationClient is the external client used for Replication Configuration Resource
+type ReplicationConfigurationClient interface {
+	DescribeRegistry(ctx context.Context, input *ecr.DescribeRegistryInput, opts ...func(*ecr.Options)) (*ecr.DescribeRegistryOutput, error)
+	PutReplicationConfiguration(ctx context.Context, input *ecr.PutReplicationConfigurationInput, opts ...func(*ecr.Options)) (*ecr.PutReplicationConfigurationOutput, error)
+}
+
+// GeneratePutReplicationConfigurationInput generates the PutReplicationConfigurationInput from the ReplicationConfigurationParameters
+func GeneratePutReplicationConfigurationInput(params *v1alpha1.ReplicationConfigurationParameters) *ecr.PutReplicationConfigurationInput {
+	rules := make([]ecrtypes.ReplicationRule, len(params.Rules))
+	for i, r := range params.Rules {
+		rule := ecrtypes.ReplicationRule{
+			Destinations: make([]ecrtypes.ReplicationDestination, len(r.Destinations)),
+		}
+		for j, d := range r.Destinations {
+			rule.Destinations[j] = ecrtypes.ReplicationDestination{
+				Region:     aws.String(d.Region),
+				RegistryId: aws.String(d.RegistryID),
+			}
+		}
+		for _, f := range r.RepositoryFilters {
+			rule.RepositoryFilters = append(rule.RepositoryFilters, ecrtypes.RepositoryFilter{
+				Filter:     aws.String(f.Filter),
+				FilterType: ecrtypes.RepositoryFilterType(f.FilterType),
+			})
+		}
+		rules[i] = rule
+	}
+	return &ecr.PutReplicationConfigurationInput{
+		ReplicationConfiguration: &ecrtypes.ReplicationConfiguration{Rules: rules},
+	}
+}
+
+// GenerateReplicationRules converts the replication rules of a registry into
+// their v1alpha1 representation.
+func GenerateReplicationRules(c *ecrtypes.ReplicationConfiguration) []v1alpha1.ReplicationRule {
+	if c == nil {
+		return nil
+	}
+	rules := make([]v1alpha1.ReplicationRule, len(c.Rules))
+	for i, r := range c.Rules {
+		rule := v1alpha1.ReplicationRule{
+			Destinations: make([]v1alpha1.ReplicationDestination, len(r.Destinations)),
+		}
+		for j, d := range r.Destinations {
+			rule.Destinations[j] = v1alpha1.ReplicationDestination{
+				Region:     aws.ToString(d.Region),
+				RegistryID: aws.ToString(d.RegistryId),
+			}
+		}
+		for _, f := range r.RepositoryFilters {
+			rule.RepositoryFilters = append(rule.RepositoryFilters, v1alpha1.RepositoryFilter{
+				Filter:     aws.ToString(f.Filter),
+				FilterType: string(f.FilterType),
+			})
+		}
+		rules[i] = rule
+	}
+	return rules
+}
+
+// IsReplicationConfigurationUpToDate checks whether the replication rules of
+// the registry match the spec. Rules are compared in order.
+func IsReplicationConfigurationUpToDate(params *v1alpha1.ReplicationConfigurationParameters, c *ecrtypes.ReplicationConfiguration) bool {
+	return cmp.Equal(params.Rules, GenerateReplicationRules(c), cmpopts.EquateEmpty())
+}
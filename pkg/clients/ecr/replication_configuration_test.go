@@ -0,0 +1,116 @@
+package ecr
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	replicationParams = v1alpha1.ReplicationConfigurationParameters{
+		Rules: []v1alpha1.ReplicationRule{{
+			Destinations: []v1alpha1.ReplicationDestination{
+				{Region: "eu-west-1", RegistryID: registryID},
+				{Region: "us-west-2", RegistryID: registryID},
+			},
+			RepositoryFilters: []v1alpha1.RepositoryFilter{{
+				Filter:     "prod-",
+				FilterType: string(ecrtypes.RepositoryFilterTypePrefixMatch),
+			}},
+		}},
+	}
+	replicationConfig = ecrtypes.ReplicationConfiguration{
+		Rules: []ecrtypes.ReplicationRule{{
+			Destinations: []ecrtypes.ReplicationDestination{
+				{Region: aws.String("eu-west-1"), RegistryId: aws.String(registryID)},
+				{Region: aws.String("us-west-2"), RegistryId: aws.String(registryID)},
+			},
+			RepositoryFilters: []ecrtypes.RepositoryFilter{{
+				Filter:     aws.String("prod-"),
+				FilterType: ecrtypes.RepositoryFilterTypePrefixMatch,
+			}},
+		}},
+	}
+)
+
+func TestGeneratePutReplicationConfigurationInput(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.ReplicationConfigurationParameters
+		out ecr.PutReplicationConfigurationInput
+	}{
+		"FilledInput": {
+			in:  replicationParams,
+			out: ecr.PutReplicationConfigurationInput{ReplicationConfiguration: &replicationConfig},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GeneratePutReplicationConfigurationInput(&tc.in)
+			if diff := cmp.Diff(&tc.out, r, cmpopts.IgnoreUnexported(
+				ecr.PutReplicationConfigurationInput{},
+				ecrtypes.ReplicationConfiguration{},
+				ecrtypes.ReplicationRule{},
+				ecrtypes.ReplicationDestination{},
+				ecrtypes.RepositoryFilter{},
+			)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsReplicationConfigurationUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.ReplicationConfigurationParameters
+		config *ecrtypes.ReplicationConfiguration
+		want   bool
+	}{
+		"SameRules": {
+			params: replicationParams,
+			config: &replicationConfig,
+			want:   true,
+		},
+		"DifferentDestinationOrder": {
+			params: replicationParams,
+			config: &ecrtypes.ReplicationConfiguration{
+				Rules: []ecrtypes.ReplicationRule{{
+					Destinations: []ecrtypes.ReplicationDestination{
+						{Region: aws.String("us-west-2"), RegistryId: aws.String(registryID)},
+						{Region: aws.String("eu-west-1"), RegistryId: aws.String(registryID)},
+					},
+					RepositoryFilters: replicationConfig.Rules[0].RepositoryFilters,
+				}},
+			},
+			want: false,
+		},
+		"MissingFilter": {
+			params: replicationParams,
+			config: &ecrtypes.ReplicationConfiguration{
+				Rules: []ecrtypes.ReplicationRule{{
+					Destinations: replicationConfig.Rules[0].Destinations,
+				}},
+			},
+			want: false,
+		},
+		"NoConfiguration": {
+			params: replicationParams,
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsReplicationConfigurationUpToDate(&tc.params, tc.config)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
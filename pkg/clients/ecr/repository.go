@@ -37,6 +37,8 @@ type RepositoryClient interface {
 	PutImageTagMutability(ctx context.Context, input *ecr.PutImageTagMutabilityInput, opts ...func(*ecr.Options)) (*ecr.PutImageTagMutabilityOutput, error)
 	PutImageScanningConfiguration(ctx context.Context, input *ecr.PutImageScanningConfigurationInput, opts ...func(*ecr.Options)) (*ecr.PutImageScanningConfigurationOutput, error)
 	UntagResource(ctx context.Context, input *ecr.UntagResourceInput, opts ...func(*ecr.Options)) (*ecr.UntagResourceOutput, error)
+	GetLifecyclePolicy(ctx context.Context, input *ecr.GetLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error)
+	PutLifecyclePolicy(ctx context.Context, input *ecr.PutLifecyclePolicyInput, opts ...func(*ecr.Options)) (*ecr.PutLifecyclePolicyOutput, error)
 }
 
 // GenerateRepositoryObservation is used to produce v1alpha1.RepositoryObservation from
@@ -110,6 +112,23 @@ func IsRepoNotFoundErr(err error) bool {
 	return errors.As(err, &notFoundError)
 }
 
+// IsLifecyclePolicyNotFoundErr returns true if the error is because the
+// repository has no lifecycle policy
+func IsLifecyclePolicyNotFoundErr(err error) bool {
+	var notFoundError *ecrtypes.LifecyclePolicyNotFoundException
+	return errors.As(err, &notFoundError)
+}
+
+// IsLifecyclePolicyUpToDate checks whether the current lifecycle policy is
+// semantically equal to the one in the spec. The lifecycle policy is always
+// up to date if the spec does not set one.
+func IsLifecyclePolicyUpToDate(spec, current *string) bool {
+	if spec == nil {
+		return true
+	}
+	return awsclient.IsPolicyUpToDate(spec, current)
+}
+
 // GenerateCreateRepositoryInput Generates the CreateRepositoryInput from the RepositoryParameters
 func GenerateCreateRepositoryInput(name string, params *v1beta1.RepositoryParameters) *ecr.CreateRepositoryInput {
 	c := &ecr.CreateRepositoryInput{
@@ -123,6 +123,54 @@ func TestIsRepositoryUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsLifecyclePolicyUpToDate(t *testing.T) {
+	type args struct {
+		spec    *string
+		current *string
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSet": {
+			args: args{
+				current: aws.String(`{"rules":[]}`),
+			},
+			want: true,
+		},
+		"SamePolicy": {
+			args: args{
+				spec:    aws.String(`{"rules":[{"rulePriority":1,"selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":10},"action":{"type":"expire"}}]}`),
+				current: aws.String(`{"rules": [{"action": {"type": "expire"}, "rulePriority": 1, "selection": {"countNumber": 10, "countType": "imageCountMoreThan", "tagStatus": "any"}}]}`),
+			},
+			want: true,
+		},
+		"DifferentPolicy": {
+			args: args{
+				spec:    aws.String(`{"rules":[{"rulePriority":1,"selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":10},"action":{"type":"expire"}}]}`),
+				current: aws.String(`{"rules":[{"rulePriority":1,"selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":20},"action":{"type":"expire"}}]}`),
+			},
+			want: false,
+		},
+		"NoCurrentPolicy": {
+			args: args{
+				spec: aws.String(`{"rules":[]}`),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLifecyclePolicyUpToDate(tc.args.spec, tc.args.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateCreateRepositoryInput(t *testing.T) {
 	type args struct {
 		name string
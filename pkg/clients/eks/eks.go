@@ -23,6 +23,8 @@ import (
 	"errors"
 
 	"net"
+	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
@@ -49,6 +51,14 @@ const (
 	expireHeader     = "X-Amz-Expires"
 	expireHeaderTime = "60"
 	v1Prefix         = "k8s-aws-v1."
+
+	execAPIVersion           = "client.authentication.k8s.io/v1beta1"
+	execCommandAWS           = "aws"
+	execCommandAuthenticator = "aws-iam-authenticator"
+
+	// TokenRefreshInterval is the maximum interval in which an embedded token
+	// has to be renewed. Tokens are accepted by EKS for 15 minutes.
+	TokenRefreshInterval = 10 * time.Minute
 )
 
 // Client defines EKS Client operations
@@ -340,36 +350,26 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 	}
 	res := cmp.Equal(&v1beta1.ClusterParameters{}, patch, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}, []xpv1.Reference{}),
-		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region"),
+		cmpopts.IgnoreFields(v1beta1.ClusterParameters{}, "Region", "Kubeconfig"),
 		cmpopts.IgnoreFields(v1beta1.VpcConfigRequest{}, "PublicAccessCidrs", "SubnetIDs", "SecurityGroupIDs"))
 	return res, nil
 }
 
 // GetConnectionDetails extracts managed.ConnectionDetails out of ekstypes.Cluster.
-func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
+// The kubeconfig either embeds a token or configures an exec credential plugin,
+// depending on the kubeconfig options of the supplied ClusterParameters.
+func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient, p *v1beta1.ClusterParameters) managed.ConnectionDetails {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
 		return managed.ConnectionDetails{}
 	}
 
-	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
-		func(po *sts.PresignOptions) {
-			po.ClientOptions = []func(*sts.Options){
-				sts.WithAPIOptions(
-					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
-					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
-				),
-			}
-		},
-	)
-
-	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
-	// EKS cluster resource. Signing the URL means that anyone in possession of
-	// this Kubeconfig will now be able to access the EKS cluster until this URL
-	// expires. This is necessary for other systems, such as core Crossplane, to
-	// be able to schedule workloads to the cluster for now, but is not the most
-	// secure way of accessing the cluster.
-	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+	var authInfo *clientcmdapi.AuthInfo
+	if p != nil && p.Kubeconfig != nil && p.Kubeconfig.AuthenticationMode != nil &&
+		*p.Kubeconfig.AuthenticationMode == v1beta1.KubeconfigAuthenticationModeExec {
+		authInfo = &clientcmdapi.AuthInfo{Exec: generateExecConfig(*cluster.Name, p)}
+	} else {
+		authInfo = &clientcmdapi.AuthInfo{Token: getToken(ctx, *cluster.Name, stsClient)}
+	}
 
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
@@ -392,9 +392,7 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: authInfo,
 		},
 		CurrentContext: *cluster.Name,
 	}
@@ -409,3 +407,78 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 		xpv1.ResourceCredentialsSecretCAKey:         caData,
 	}
 }
+
+// getToken returns a token for the cluster with the given name that is signed
+// by the credentials of the STS client.
+func getToken(ctx context.Context, name string, stsClient STSClient) string {
+	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(po *sts.PresignOptions) {
+			po.ClientOptions = []func(*sts.Options){
+				sts.WithAPIOptions(
+					smithyhttp.AddHeaderValue(clusterIDHeader, name),
+					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
+				),
+			}
+		},
+	)
+
+	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
+	// EKS cluster resource. Signing the URL means that anyone in possession of
+	// this Kubeconfig will now be able to access the EKS cluster until this URL
+	// expires. This is necessary for other systems, such as core Crossplane, to
+	// be able to schedule workloads to the cluster for now, but is not the most
+	// secure way of accessing the cluster.
+	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+	return v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+}
+
+// generateExecConfig returns an exec credential plugin configuration that
+// requests a token for the cluster with the given name.
+func generateExecConfig(name string, p *v1beta1.ClusterParameters) *clientcmdapi.ExecConfig {
+	e := p.Kubeconfig.Exec
+	if e == nil {
+		e = &v1beta1.KubeconfigExec{}
+	}
+	cfg := &clientcmdapi.ExecConfig{
+		APIVersion:      execAPIVersion,
+		Command:         execCommandAWS,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+	if awsclients.StringValue(e.Command) == execCommandAuthenticator {
+		cfg.Command = execCommandAuthenticator
+		cfg.Args = []string{"token", "-i", name}
+		if e.RoleARN != nil {
+			cfg.Args = append(cfg.Args, "-r", *e.RoleARN)
+		}
+	} else {
+		if p.Region != nil {
+			cfg.Args = []string{"--region", *p.Region}
+		}
+		cfg.Args = append(cfg.Args, "eks", "get-token", "--cluster-name", name)
+		if e.RoleARN != nil {
+			cfg.Args = append(cfg.Args, "--role-arn", *e.RoleARN)
+		}
+	}
+	keys := make([]string, 0, len(e.Env))
+	for k := range e.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cfg.Env = append(cfg.Env, clientcmdapi.ExecEnvVar{Name: k, Value: e.Env[k]})
+	}
+	return cfg
+}
+
+// ClusterOperationDuration returns how long the operation a cluster in the
+// supplied status is performing typically takes, and whether it is performing
+// one at all.
+func ClusterOperationDuration(status v1beta1.ClusterStatusType) (time.Duration, bool) {
+	switch status { //nolint:exhaustive
+	case v1beta1.ClusterStatusCreating:
+		return 15 * time.Minute, true
+	case v1beta1.ClusterStatusUpdating:
+		return 25 * time.Minute, true
+	}
+	return 0, false
+}
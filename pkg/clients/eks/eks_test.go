@@ -27,6 +27,7 @@ import (
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
@@ -826,3 +827,74 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateExecConfig(t *testing.T) {
+	region := "us-east-1"
+	authenticator := execCommandAuthenticator
+	exec := v1beta1.KubeconfigAuthenticationModeExec
+	cases := map[string]struct {
+		p    *v1beta1.ClusterParameters
+		want *clientcmdapi.ExecConfig
+	}{
+		"AWSCLIByDefault": {
+			p: &v1beta1.ClusterParameters{
+				Region:     &region,
+				Kubeconfig: &v1beta1.KubeconfigOptions{AuthenticationMode: &exec},
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion:      execAPIVersion,
+				Command:         execCommandAWS,
+				Args:            []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName},
+				InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+			},
+		},
+		"AWSCLIWithRoleAndEnv": {
+			p: &v1beta1.ClusterParameters{
+				Kubeconfig: &v1beta1.KubeconfigOptions{
+					AuthenticationMode: &exec,
+					Exec: &v1beta1.KubeconfigExec{
+						RoleARN: &roleArn,
+						Env:     map[string]string{"AWS_PROFILE": "dev", "AWS_DEFAULT_OUTPUT": "json"},
+					},
+				},
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion: execAPIVersion,
+				Command:    execCommandAWS,
+				Args:       []string{"eks", "get-token", "--cluster-name", clusterName, "--role-arn", roleArn},
+				Env: []clientcmdapi.ExecEnvVar{
+					{Name: "AWS_DEFAULT_OUTPUT", Value: "json"},
+					{Name: "AWS_PROFILE", Value: "dev"},
+				},
+				InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+			},
+		},
+		"Authenticator": {
+			p: &v1beta1.ClusterParameters{
+				Region: &region,
+				Kubeconfig: &v1beta1.KubeconfigOptions{
+					AuthenticationMode: &exec,
+					Exec: &v1beta1.KubeconfigExec{
+						Command: &authenticator,
+						RoleARN: &roleArn,
+					},
+				},
+			},
+			want: &clientcmdapi.ExecConfig{
+				APIVersion:      execAPIVersion,
+				Command:         execCommandAuthenticator,
+				Args:            []string{"token", "-i", clusterName, "-r", roleArn},
+				InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := generateExecConfig(clusterName, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -82,8 +82,19 @@ func LateInitializeFargateProfile(in *v1beta1.FargateProfileParameters, fp *ekst
 	}
 }
 
-// IsFargateProfileUpToDate checks whether there is a change in the tags.
-// Any other field is immutable and can't be updated.
+// IsFargateProfileUpToDate checks whether there is a change in the tags or
+// the selectors. Any other field is immutable and can't be updated.
 func IsFargateProfileUpToDate(p v1beta1.FargateProfileParameters, fp *ekstypes.FargateProfile) bool { // nolint:gocyclo
-	return cmp.Equal(p.Tags, fp.Tags, cmpopts.EquateEmpty())
+	return cmp.Equal(p.Tags, fp.Tags, cmpopts.EquateEmpty()) && AreFargateProfileSelectorsUpToDate(p, fp)
+}
+
+// AreFargateProfileSelectorsUpToDate checks whether the selectors of the
+// Fargate profile match the desired ones, regardless of their order.
+func AreFargateProfileSelectorsUpToDate(p v1beta1.FargateProfileParameters, fp *ekstypes.FargateProfile) bool {
+	desired := GenerateCreateFargateProfileInput("", p).Selectors
+	return cmp.Equal(desired, fp.Selectors, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(ekstypes.FargateProfileSelector{}),
+		cmpopts.SortSlices(func(a, b ekstypes.FargateProfileSelector) bool {
+			return awsclients.StringValue(a.Namespace) < awsclients.StringValue(b.Namespace)
+		}))
 }
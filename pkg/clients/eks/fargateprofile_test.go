@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -189,6 +190,38 @@ func TestIsFargateProfileUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"SelectorsInDifferentOrder": {
+			args: args{
+				p: v1beta1.FargateProfileParameters{
+					Selectors: []v1beta1.FargateProfileSelector{
+						{Namespace: awsclients.String("kube-system")},
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "web"}},
+					},
+				},
+				n: &ekstypes.FargateProfile{
+					Selectors: []ekstypes.FargateProfileSelector{
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "web"}},
+						{Namespace: awsclients.String("kube-system")},
+					},
+				},
+			},
+			want: true,
+		},
+		"SelectorsChanged": {
+			args: args{
+				p: v1beta1.FargateProfileParameters{
+					Selectors: []v1beta1.FargateProfileSelector{
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "api"}},
+					},
+				},
+				n: &ekstypes.FargateProfile{
+					Selectors: []ekstypes.FargateProfileSelector{
+						{Namespace: awsclients.String("default"), Labels: map[string]string{"app": "web"}},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errNoOIDCIssuer   = "cluster has no OIDC issuer"
+	errParseARN       = "cannot parse cluster ARN"
+	errPolicyUnescape = "malformed AssumeRolePolicyDocument escaping"
+
+	irsaAudience = "sts.amazonaws.com"
+)
+
+// IRSARoleClient is the IAM client used for the IRSARole Custom Resource.
+type IRSARoleClient interface {
+	iam.RoleClient
+	iam.RolePolicyAttachmentClient
+}
+
+// NewIRSARoleClient returns a new IAM client given an aws config.
+func NewIRSARoleClient(cfg aws.Config) IRSARoleClient {
+	return awsiam.NewFromConfig(cfg)
+}
+
+// GenerateIRSATrustPolicy returns the ARN of the IAM OIDC provider of the
+// given cluster and a trust policy that allows the given service account to
+// assume a role through it.
+func GenerateIRSATrustPolicy(cluster *ekstypes.Cluster, namespace, serviceAccount string) (string, string, error) {
+	if cluster.Identity == nil || cluster.Identity.Oidc == nil || aws.ToString(cluster.Identity.Oidc.Issuer) == "" {
+		return "", "", errors.New(errNoOIDCIssuer)
+	}
+	a, err := arn.Parse(aws.ToString(cluster.Arn))
+	if err != nil {
+		return "", "", errors.Wrap(err, errParseARN)
+	}
+	issuer := strings.TrimPrefix(aws.ToString(cluster.Identity.Oidc.Issuer), "https://")
+	provider := fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", a.Partition, a.AccountID, issuer)
+	policy := map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []interface{}{
+			map[string]interface{}{
+				"Effect":    "Allow",
+				"Principal": map[string]interface{}{"Federated": provider},
+				"Action":    "sts:AssumeRoleWithWebIdentity",
+				"Condition": map[string]interface{}{
+					"StringEquals": map[string]interface{}{
+						issuer + ":sub": fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount),
+						issuer + ":aud": irsaAudience,
+					},
+				},
+			},
+		},
+	}
+	b, err := json.Marshal(policy)
+	return provider, string(b), err
+}
+
+// GenerateCreateIRSARoleInput returns the input to create the role of an
+// IRSARole with the given trust policy.
+func GenerateCreateIRSARoleInput(name string, p manualv1alpha1.IRSARoleParameters, trustPolicy string) *awsiam.CreateRoleInput {
+	return &awsiam.CreateRoleInput{
+		RoleName:                 aws.String(name),
+		AssumeRolePolicyDocument: aws.String(trustPolicy),
+		Description:              p.Description,
+		MaxSessionDuration:       p.MaxSessionDuration,
+		Path:                     p.Path,
+		PermissionsBoundary:      p.PermissionsBoundary,
+	}
+}
+
+// IsIRSARoleUpToDate returns whether the observed role matches the desired
+// parameters and trust policy.
+func IsIRSARoleUpToDate(p manualv1alpha1.IRSARoleParameters, role *iamtypes.Role, trustPolicy string) (bool, error) {
+	if p.Description != nil && aws.ToString(p.Description) != aws.ToString(role.Description) {
+		return false, nil
+	}
+	if p.MaxSessionDuration != nil && aws.ToInt32(p.MaxSessionDuration) != aws.ToInt32(role.MaxSessionDuration) {
+		return false, nil
+	}
+	observed, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return false, errors.Wrap(err, errPolicyUnescape)
+	}
+	return awsclients.IsPolicyUpToDate(&trustPolicy, &observed), nil
+}
+
+// GetAttachedPolicies returns all managed policies attached to the given
+// role, following the pagination of the IAM API.
+func GetAttachedPolicies(ctx context.Context, c iam.RolePolicyAttachmentClient, roleName string) ([]iamtypes.AttachedPolicy, error) {
+	var policies []iamtypes.AttachedPolicy
+	input := &awsiam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}
+	for {
+		rsp, err := c.ListAttachedRolePolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, rsp.AttachedPolicies...)
+		if aws.ToString(rsp.Marker) == "" {
+			return policies, nil
+		}
+		input.Marker = rsp.Marker
+	}
+}
+
+// DiffPolicyARNs returns the policies that have to be attached and detached
+// so that exactly the desired policies are attached.
+func DiffPolicyARNs(desired []string, attached []iamtypes.AttachedPolicy) (attach, detach []string) {
+	want := map[string]bool{}
+	for _, a := range desired {
+		want[a] = true
+	}
+	have := map[string]bool{}
+	for _, p := range attached {
+		a := aws.ToString(p.PolicyArn)
+		have[a] = true
+		if !want[a] {
+			detach = append(detach, a)
+		}
+	}
+	for _, a := range desired {
+		if !have[a] {
+			attach = append(attach, a)
+			have[a] = true
+		}
+	}
+	return attach, detach
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var (
+	clusterARN = "arn:aws:eks:us-east-1:123456789012:cluster/my-cluster"
+	issuer     = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+)
+
+func TestGenerateIRSATrustPolicy(t *testing.T) {
+	type want struct {
+		provider string
+		policy   string
+		err      error
+	}
+
+	cases := map[string]struct {
+		cluster *ekstypes.Cluster
+		want    want
+	}{
+		"NoIssuer": {
+			cluster: &ekstypes.Cluster{Arn: aws.String(clusterARN)},
+			want: want{
+				err: errors.New(errNoOIDCIssuer),
+			},
+		},
+		"Successful": {
+			cluster: &ekstypes.Cluster{
+				Arn:      aws.String(clusterARN),
+				Identity: &ekstypes.Identity{Oidc: &ekstypes.OIDC{Issuer: aws.String(issuer)}},
+			},
+			want: want{
+				provider: "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE",
+				policy:   `{"Statement":[{"Action":"sts:AssumeRoleWithWebIdentity","Condition":{"StringEquals":{"oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE:aud":"sts.amazonaws.com","oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE:sub":"system:serviceaccount:default:my-sa"}},"Effect":"Allow","Principal":{"Federated":"arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"}}],"Version":"2012-10-17"}`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			provider, policy, err := GenerateIRSATrustPolicy(tc.cluster, "default", "my-sa")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.provider, provider); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.policy, policy); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffPolicyARNs(t *testing.T) {
+	type want struct {
+		attach []string
+		detach []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		attached []iamtypes.AttachedPolicy
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []string{"a", "b"},
+			attached: []iamtypes.AttachedPolicy{{PolicyArn: aws.String("b")}, {PolicyArn: aws.String("a")}},
+			want:     want{},
+		},
+		"AttachAndDetach": {
+			desired:  []string{"a", "c"},
+			attached: []iamtypes.AttachedPolicy{{PolicyArn: aws.String("a")}, {PolicyArn: aws.String("b")}},
+			want: want{
+				attach: []string{"c"},
+				detach: []string{"b"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffPolicyARNs(tc.desired, tc.attached)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package eks
 
 import (
+	"strings"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -74,6 +77,9 @@ func GenerateCreateNodeGroupInput(name string, p *manualv1alpha1.NodeGroupParame
 			Version: p.LaunchTemplate.Version,
 		}
 	}
+	if p.UpdateConfig != nil {
+		c.UpdateConfig = generateNodeGroupUpdateConfig(p.UpdateConfig)
+	}
 	if len(p.Taints) != 0 {
 		c.Taints = make([]ekstypes.Taint, len(p.Taints))
 		for i, t := range p.Taints {
@@ -125,10 +131,39 @@ func GenerateUpdateNodeGroupConfigInput(name string, p *manualv1alpha1.NodeGroup
 			}
 		}
 	}
+	if p.UpdateConfig != nil {
+		u.UpdateConfig = generateNodeGroupUpdateConfig(p.UpdateConfig)
+	}
 	// TODO(muvaf): Add support for updating taints.
 	return u
 }
 
+// GenerateUpdateNodeGroupVersionInput from NodeGroupParameters. The launch
+// template is only included if a version of it is pinned, since that is the
+// only way a node group can drift from its launch template.
+func GenerateUpdateNodeGroupVersionInput(name string, p *manualv1alpha1.NodeGroupParameters) *eks.UpdateNodegroupVersionInput {
+	u := &eks.UpdateNodegroupVersionInput{
+		ClusterName:   &p.ClusterName,
+		NodegroupName: &name,
+		Version:       p.Version,
+	}
+	if p.LaunchTemplate != nil && p.LaunchTemplate.Version != nil {
+		u.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
+			Id:      p.LaunchTemplate.ID,
+			Name:    p.LaunchTemplate.Name,
+			Version: p.LaunchTemplate.Version,
+		}
+	}
+	return u
+}
+
+func generateNodeGroupUpdateConfig(in *manualv1alpha1.NodeGroupUpdateConfig) *ekstypes.NodegroupUpdateConfig {
+	return &ekstypes.NodegroupUpdateConfig{
+		MaxUnavailable:           in.MaxUnavailable,
+		MaxUnavailablePercentage: in.MaxUnavailablePercentage,
+	}
+}
+
 // GenerateNodeGroupObservation is used to produce manualv1alpha1.NodeGroupObservation
 // from eks.Nodegroup.
 func GenerateNodeGroupObservation(ng *ekstypes.Nodegroup) manualv1alpha1.NodeGroupObservation { // nolint:gocyclo
@@ -206,6 +241,12 @@ func LateInitializeNodeGroup(in *manualv1alpha1.NodeGroupParameters, ng *ekstype
 			MaxSize:     ng.ScalingConfig.MaxSize,
 		}
 	}
+	if in.UpdateConfig == nil && ng.UpdateConfig != nil {
+		in.UpdateConfig = &manualv1alpha1.NodeGroupUpdateConfig{
+			MaxUnavailable:           ng.UpdateConfig.MaxUnavailable,
+			MaxUnavailablePercentage: ng.UpdateConfig.MaxUnavailablePercentage,
+		}
+	}
 	in.ReleaseVersion = awsclient.LateInitializeStringPtr(in.ReleaseVersion, ng.ReleaseVersion)
 	in.Version = awsclient.LateInitializeStringPtr(in.Version, ng.Version)
 	// NOTE(hasheddan): we always will set the default Crossplane tags in
@@ -231,12 +272,17 @@ func IsNodeGroupUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nod
 	if !cmp.Equal(p.Tags, ng.Tags, cmpopts.EquateEmpty()) {
 		return false
 	}
-	if !cmp.Equal(p.Version, ng.Version) {
+	if !IsNodeGroupVersionUpToDate(p, ng) {
 		return false
 	}
 	if !cmp.Equal(p.Labels, ng.Labels, cmpopts.EquateEmpty()) {
 		return false
 	}
+	if p.UpdateConfig != nil && (ng.UpdateConfig == nil ||
+		!cmp.Equal(p.UpdateConfig.MaxUnavailable, ng.UpdateConfig.MaxUnavailable) ||
+		!cmp.Equal(p.UpdateConfig.MaxUnavailablePercentage, ng.UpdateConfig.MaxUnavailablePercentage)) {
+		return false
+	}
 	if p.ScalingConfig == nil && ng.ScalingConfig == nil {
 		return true
 	}
@@ -255,3 +301,34 @@ func IsNodeGroupUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nod
 	}
 	return false
 }
+
+// IsNodeGroupVersionUpToDate checks whether the node group runs the desired
+// Kubernetes version and launch template version. A change in either of them
+// requires the nodes to be rolled.
+func IsNodeGroupVersionUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool {
+	if !cmp.Equal(p.Version, ng.Version) {
+		return false
+	}
+	if p.LaunchTemplate == nil || p.LaunchTemplate.Version == nil || ng.LaunchTemplate == nil {
+		return true
+	}
+	// NOTE: $Latest and $Default are resolved to a version number by EKS, so
+	// they cannot be compared with the observed version.
+	if strings.HasPrefix(*p.LaunchTemplate.Version, "$") {
+		return true
+	}
+	return *p.LaunchTemplate.Version == aws.ToString(ng.LaunchTemplate.Version)
+}
+
+// NodeGroupOperationDuration returns how long the operation a node group in
+// the supplied status is performing typically takes, and whether it is
+// performing one at all. Updates replace the nodes one by one.
+func NodeGroupOperationDuration(status manualv1alpha1.NodeGroupStatusType) (time.Duration, bool) {
+	switch status { //nolint:exhaustive
+	case manualv1alpha1.NodeGroupStatusCreating:
+		return 5 * time.Minute, true
+	case manualv1alpha1.NodeGroupStatusUpdating:
+		return 20 * time.Minute, true
+	}
+	return 0, false
+}
@@ -375,6 +375,84 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 	}
 }
 
+func TestGenerateUpdateNodeGroupVersionInput(t *testing.T) {
+	ltName := "cool-template"
+	ltVersion := "3"
+
+	type args struct {
+		name string
+		p    *manualv1alpha1.NodeGroupParameters
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateNodegroupVersionInput
+	}{
+		"Version": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &version,
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &version,
+			},
+		},
+		"LaunchTemplateVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						Name:    &ltName,
+						Version: &ltVersion,
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &version,
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+					Name:    &ltName,
+					Version: &ltVersion,
+				},
+			},
+		},
+		"UnpinnedLaunchTemplate": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						Name: &ltName,
+					},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Version:       &version,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateNodeGroupVersionInput(tc.args.name, tc.args.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateUpdateNodeObservation(t *testing.T) {
 	ngArn := "cool:arn"
 	now := time.Now()
@@ -572,6 +650,7 @@ func TestLateInitializeNodeGroup(t *testing.T) {
 
 func TestIsNodeGroupUpToDate(t *testing.T) {
 	otherVersion := "1.17"
+	ltName := "cool-template"
 	otherSize := int32(100)
 
 	type args struct {
@@ -711,6 +790,67 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"UpdateLaunchTemplateVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Tags:    map[string]string{"cool": "tag"},
+					Version: &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						Name:    &ltName,
+						Version: awsclients.String("3"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					Tags:    map[string]string{"cool": "tag"},
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Name:    &ltName,
+						Version: awsclients.String("2"),
+					},
+				},
+			},
+			want: false,
+		},
+		"IgnoreLatestLaunchTemplateVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Tags:    map[string]string{"cool": "tag"},
+					Version: &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{
+						Name:    &ltName,
+						Version: awsclients.String("$Latest"),
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					Tags:    map[string]string{"cool": "tag"},
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+						Name:    &ltName,
+						Version: awsclients.String("2"),
+					},
+				},
+			},
+			want: true,
+		},
+		"UpdateMaxUnavailable": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Tags:    map[string]string{"cool": "tag"},
+					Version: &version,
+					UpdateConfig: &manualv1alpha1.NodeGroupUpdateConfig{
+						MaxUnavailable: &size,
+					},
+				},
+				n: &ekstypes.Nodegroup{
+					Version: &version,
+					Tags:    map[string]string{"cool": "tag"},
+					UpdateConfig: &ekstypes.NodegroupUpdateConfig{
+						MaxUnavailable: &maxSize,
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
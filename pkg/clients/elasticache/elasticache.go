@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/smithy-go/document"
 
@@ -43,6 +44,10 @@ import (
 
 const errCheckUpToDate = "unable to determine if external resource is up to date"
 
+// globalReplicationGroupRoleSecondary is the role of a replication group that
+// was added to a Global datastore as a secondary member.
+const globalReplicationGroupRoleSecondary = "SECONDARY"
+
 // A Client handles CRUD operations for ElastiCache resources.
 type Client interface {
 	DescribeReplicationGroups(context.Context, *elasticache.DescribeReplicationGroupsInput, ...func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error)
@@ -61,6 +66,16 @@ type Client interface {
 	ModifyCacheCluster(context.Context, *elasticache.ModifyCacheClusterInput, ...func(*elasticache.Options)) (*elasticache.ModifyCacheClusterOutput, error)
 
 	ModifyReplicationGroupShardConfiguration(context.Context, *elasticache.ModifyReplicationGroupShardConfigurationInput, ...func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupShardConfigurationOutput, error)
+	IncreaseReplicaCount(context.Context, *elasticache.IncreaseReplicaCountInput, ...func(*elasticache.Options)) (*elasticache.IncreaseReplicaCountOutput, error)
+	DecreaseReplicaCount(context.Context, *elasticache.DecreaseReplicaCountInput, ...func(*elasticache.Options)) (*elasticache.DecreaseReplicaCountOutput, error)
+
+	DisassociateGlobalReplicationGroup(context.Context, *elasticache.DisassociateGlobalReplicationGroupInput, ...func(*elasticache.Options)) (*elasticache.DisassociateGlobalReplicationGroupOutput, error)
+	TestFailover(context.Context, *elasticache.TestFailoverInput, ...func(*elasticache.Options)) (*elasticache.TestFailoverOutput, error)
+
+	DescribeSnapshots(context.Context, *elasticache.DescribeSnapshotsInput, ...func(*elasticache.Options)) (*elasticache.DescribeSnapshotsOutput, error)
+	CreateSnapshot(context.Context, *elasticache.CreateSnapshotInput, ...func(*elasticache.Options)) (*elasticache.CreateSnapshotOutput, error)
+	CopySnapshot(context.Context, *elasticache.CopySnapshotInput, ...func(*elasticache.Options)) (*elasticache.CopySnapshotOutput, error)
+	DeleteSnapshot(context.Context, *elasticache.DeleteSnapshotInput, ...func(*elasticache.Options)) (*elasticache.DeleteSnapshotOutput, error)
 }
 
 // NewClient returns a new ElastiCache client. Credentials must be passed as
@@ -88,6 +103,7 @@ func NewCreateReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 		CacheSecurityGroupNames:    g.CacheSecurityGroupNames,
 		CacheSubnetGroupName:       g.CacheSubnetGroupName,
 		EngineVersion:              g.EngineVersion,
+		GlobalReplicationGroupId:   g.GlobalReplicationGroupID,
 		NotificationTopicArn:       g.NotificationTopicARN,
 		NumCacheClusters:           clients.Int32Address(g.NumCacheClusters),
 		NumNodeGroups:              clients.Int32Address(g.NumNodeGroups),
@@ -149,6 +165,35 @@ func NewModifyReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 	}
 }
 
+// NewModifyReplicationGroupEngineVersionInput returns ElastiCache replication
+// group modification input that only upgrades the engine version. The cache
+// parameter group is included since major version upgrades may require one of
+// the new engine family.
+func NewModifyReplicationGroupEngineVersionInput(g v1beta1.ReplicationGroupParameters, id string) *elasticache.ModifyReplicationGroupInput {
+	return &elasticache.ModifyReplicationGroupInput{
+		ReplicationGroupId:      aws.String(id),
+		ApplyImmediately:        g.ApplyModificationsImmediately,
+		EngineVersion:           g.EngineVersion,
+		CacheParameterGroupName: g.CacheParameterGroupName,
+	}
+}
+
+// NewModifyReplicationGroupAuthTokenInput returns ElastiCache replication
+// group modification input that only updates the auth token. AWS requires
+// auth token updates to be applied immediately.
+func NewModifyReplicationGroupAuthTokenInput(g v1beta1.ReplicationGroupParameters, id, token string) *elasticache.ModifyReplicationGroupInput {
+	strategy := v1beta1.AuthTokenUpdateStrategyRotate
+	if g.AuthTokenUpdateStrategy != nil {
+		strategy = *g.AuthTokenUpdateStrategy
+	}
+	return &elasticache.ModifyReplicationGroupInput{
+		ReplicationGroupId:      aws.String(id),
+		ApplyImmediately:        true,
+		AuthToken:               aws.String(token),
+		AuthTokenUpdateStrategy: elasticachetypes.AuthTokenUpdateStrategyType(strategy),
+	}
+}
+
 // NewModifyReplicationGroupShardConfigurationInput returns ElastiCache replication group
 // shard configuration modification input suitable for use with the AWS API.
 func NewModifyReplicationGroupShardConfigurationInput(g v1beta1.ReplicationGroupParameters, id string, rg elasticachetypes.ReplicationGroup) *elasticache.ModifyReplicationGroupShardConfigurationInput {
@@ -169,12 +214,55 @@ func NewModifyReplicationGroupShardConfigurationInput(g v1beta1.ReplicationGroup
 	return input
 }
 
+// NewIncreaseReplicaCountInput returns ElastiCache replica count increase
+// input suitable for use with the AWS API.
+func NewIncreaseReplicaCountInput(g v1beta1.ReplicationGroupParameters, id string) *elasticache.IncreaseReplicaCountInput {
+	// NOTE: AWS rejects replica count changes that are not applied
+	// immediately.
+	return &elasticache.IncreaseReplicaCountInput{
+		ApplyImmediately:   true,
+		NewReplicaCount:    clients.Int32Address(g.ReplicasPerNodeGroup),
+		ReplicationGroupId: aws.String(id),
+	}
+}
+
+// NewDecreaseReplicaCountInput returns ElastiCache replica count decrease
+// input suitable for use with the AWS API.
+func NewDecreaseReplicaCountInput(g v1beta1.ReplicationGroupParameters, id string) *elasticache.DecreaseReplicaCountInput {
+	return &elasticache.DecreaseReplicaCountInput{
+		ApplyImmediately:   true,
+		NewReplicaCount:    clients.Int32Address(g.ReplicasPerNodeGroup),
+		ReplicationGroupId: aws.String(id),
+	}
+}
+
 // NewDeleteReplicationGroupInput returns ElastiCache replication group deletion
 // input suitable for use with the AWS API.
 func NewDeleteReplicationGroupInput(id string) *elasticache.DeleteReplicationGroupInput {
 	return &elasticache.DeleteReplicationGroupInput{ReplicationGroupId: &id}
 }
 
+// NewDisassociateGlobalReplicationGroupInput returns the input to detach the
+// secondary replication group with the supplied id and region from its Global
+// datastore.
+func NewDisassociateGlobalReplicationGroupInput(globalID, id, region string) *elasticache.DisassociateGlobalReplicationGroupInput {
+	return &elasticache.DisassociateGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: &globalID,
+		ReplicationGroupId:       &id,
+		ReplicationGroupRegion:   &region,
+	}
+}
+
+// NewTestFailoverInput returns the input to test automatic failover on the
+// node group with the supplied id of the replication group with the supplied
+// id.
+func NewTestFailoverInput(id, nodeGroupID string) *elasticache.TestFailoverInput {
+	return &elasticache.TestFailoverInput{
+		ReplicationGroupId: &id,
+		NodeGroupId:        &nodeGroupID,
+	}
+}
+
 // NewDescribeReplicationGroupsInput returns ElastiCache replication group describe
 // input suitable for use with the AWS API.
 func NewDescribeReplicationGroupsInput(id string) *elasticache.DescribeReplicationGroupsInput {
@@ -236,6 +324,26 @@ func ReplicationGroupShardConfigurationNeedsUpdate(kube v1beta1.ReplicationGroup
 	return kube.NumNodeGroups != nil && *kube.NumNodeGroups != len(rg.NodeGroups)
 }
 
+// ReplicationGroupReplicasNeedUpdate returns whether replicas have to be
+// added (increase) to or removed (decrease) from the node groups of the
+// supplied ReplicationGroup to match the desired ReplicasPerNodeGroup.
+func ReplicationGroupReplicasNeedUpdate(kube v1beta1.ReplicationGroupParameters, rg elasticachetypes.ReplicationGroup) (increase bool, decrease bool) {
+	if kube.ReplicasPerNodeGroup == nil {
+		return false, false
+	}
+	for _, ng := range rg.NodeGroups {
+		// Every node group has a single primary, the rest are replicas.
+		replicas := len(ng.NodeGroupMembers) - 1
+		switch {
+		case replicas < *kube.ReplicasPerNodeGroup:
+			increase = true
+		case replicas > *kube.ReplicasPerNodeGroup:
+			decrease = true
+		}
+	}
+	return increase, decrease
+}
+
 // ReplicationGroupNeedsUpdate returns true if the supplied ReplicationGroup and
 // the configuration of its member clusters differ from given desired state.
 func ReplicationGroupNeedsUpdate(kube v1beta1.ReplicationGroupParameters, rg elasticachetypes.ReplicationGroup, ccList []elasticachetypes.CacheCluster) bool {
@@ -257,6 +365,51 @@ func ReplicationGroupNeedsUpdate(kube v1beta1.ReplicationGroupParameters, rg ela
 	return false
 }
 
+// ReplicationGroupNeedsDisassociation returns true if the supplied replication
+// group is a secondary member of a Global datastore although no Global
+// datastore is desired.
+func ReplicationGroupNeedsDisassociation(kube v1beta1.ReplicationGroupParameters, rg elasticachetypes.ReplicationGroup) bool {
+	return kube.GlobalReplicationGroupID == nil && IsGlobalReplicationGroupSecondary(rg)
+}
+
+// IsGlobalReplicationGroupSecondary returns true if the supplied replication
+// group is a secondary member of a Global datastore.
+func IsGlobalReplicationGroupSecondary(rg elasticachetypes.ReplicationGroup) bool {
+	return rg.GlobalReplicationGroupInfo != nil &&
+		clients.StringValue(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupMemberRole) == globalReplicationGroupRoleSecondary
+}
+
+// ReplicationGroupEngineVersionNeedsUpgrade returns true if the engine version
+// of the supplied cache cluster differs from the desired one and no
+// modification to the desired version is pending.
+func ReplicationGroupEngineVersionNeedsUpgrade(kube v1beta1.ReplicationGroupParameters, cc elasticachetypes.CacheCluster) bool {
+	if kube.EngineVersion == nil || versionMatches(kube.EngineVersion, cc.EngineVersion) {
+		return false
+	}
+	return cc.PendingModifiedValues == nil || !versionMatches(kube.EngineVersion, cc.PendingModifiedValues.EngineVersion)
+}
+
+// IsEngineVersionDowngrade returns true if the desired engine version is
+// lower than the current one. Versions are compared component by component,
+// wildcard components such as the x in 6.x are ignored.
+func IsEngineVersionDowngrade(desired, current string) bool {
+	d, c := strings.Split(desired, "."), strings.Split(current, ".")
+	for i := 0; i < len(d) && i < len(c); i++ {
+		dv, err := strconv.Atoi(d[i])
+		if err != nil {
+			return false
+		}
+		cv, err := strconv.Atoi(c[i])
+		if err != nil {
+			return false
+		}
+		if dv != cv {
+			return dv < cv
+		}
+	}
+	return false
+}
+
 func automaticFailoverEnabled(af elasticachetypes.AutomaticFailoverStatus) *bool {
 	if af == "" {
 		return nil
@@ -280,7 +433,7 @@ func versionMatches(kubeVersion *string, awsVersion *string) bool {
 
 func cacheClusterNeedsUpdate(kube v1beta1.ReplicationGroupParameters, cc elasticachetypes.CacheCluster) bool { // nolint:gocyclo
 	// AWS will set and return a default version if we don't specify one.
-	if !versionMatches(kube.EngineVersion, cc.EngineVersion) {
+	if ReplicationGroupEngineVersionNeedsUpgrade(kube, cc) {
 		return true
 	}
 	if pg, name := cc.CacheParameterGroup, kube.CacheParameterGroupName; pg != nil && !reflect.DeepEqual(name, pg.CacheParameterGroupName) {
@@ -350,6 +503,10 @@ func GenerateObservation(rg elasticachetypes.ReplicationGroup) v1beta1.Replicati
 			o.NodeGroups[i] = generateNodeGroup(ng)
 		}
 	}
+	if rg.GlobalReplicationGroupInfo != nil {
+		o.GlobalReplicationGroupID = clients.StringValue(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+		o.GlobalReplicationGroupMemberRole = clients.StringValue(rg.GlobalReplicationGroupInfo.GlobalReplicationGroupMemberRole)
+	}
 	if rg.PendingModifiedValues != nil {
 		o.PendingModifiedValues = generateReplicationGroupPendingModifiedValues(*rg.PendingModifiedValues)
 	}
@@ -450,6 +607,13 @@ func IsSubnetGroupNotFound(err error) bool {
 	return errors.As(err, &gnf)
 }
 
+// IsSubnetGroupAlreadyExists returns true if the supplied error indicates a
+// Cache Subnet Group already exists.
+func IsSubnetGroupAlreadyExists(err error) bool {
+	var gae *elasticachetypes.CacheSubnetGroupAlreadyExistsFault
+	return errors.As(err, &gae)
+}
+
 // IsAlreadyExists returns true if the supplied error indicates a Replication Group
 // already exists.
 func IsAlreadyExists(err error) bool {
@@ -457,6 +621,26 @@ func IsAlreadyExists(err error) bool {
 	return errors.As(err, &gae)
 }
 
+// GenerateCreateCacheSubnetGroupInput returns Cache Subnet Group creation
+// input suitable for use with the AWS API.
+func GenerateCreateCacheSubnetGroupInput(p cachev1alpha1.CacheSubnetGroupParameters, name string) *elasticache.CreateCacheSubnetGroupInput {
+	c := &elasticache.CreateCacheSubnetGroupInput{
+		CacheSubnetGroupDescription: aws.String(p.Description),
+		CacheSubnetGroupName:        aws.String(name),
+		SubnetIds:                   p.SubnetIDs,
+	}
+	if len(p.Tags) != 0 {
+		c.Tags = make([]elasticachetypes.Tag, len(p.Tags))
+		for i, tag := range p.Tags {
+			c.Tags[i] = elasticachetypes.Tag{
+				Key:   clients.String(tag.Key),
+				Value: tag.Value,
+			}
+		}
+	}
+	return c
+}
+
 // IsSubnetGroupUpToDate checks if CacheSubnetGroupParameters are in sync with provider values
 func IsSubnetGroupUpToDate(p cachev1alpha1.CacheSubnetGroupParameters, sg elasticachetypes.CacheSubnetGroup) bool {
 	if p.Description != aws.ToString(sg.CacheSubnetGroupDescription) {
@@ -572,9 +756,41 @@ func GenerateClusterObservation(c elasticachetypes.CacheCluster) cachev1alpha1.C
 		}
 		o.CacheNodes = cacheNodes
 	}
+	if c.ConfigurationEndpoint != nil {
+		o.ConfigurationEndpoint = v1alpha1.Endpoint{
+			Address: aws.ToString(c.ConfigurationEndpoint.Address),
+			Port:    int(c.ConfigurationEndpoint.Port),
+		}
+	}
 	return o
 }
 
+// ClusterConnectionEndpoint returns the connection endpoint for a Cache
+// Cluster.
+// https://docs.aws.amazon.com/AmazonElastiCache/latest/mem-ug/Endpoints.html
+func ClusterConnectionEndpoint(c elasticachetypes.CacheCluster) managed.ConnectionDetails {
+	// Memcached clusters have a configuration endpoint that clients with
+	// Automatic Discovery use to find all nodes of the cluster.
+	if c.ConfigurationEndpoint != nil && c.ConfigurationEndpoint.Address != nil {
+		return managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.ToString(c.ConfigurationEndpoint.Address)),
+			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(c.ConfigurationEndpoint.Port))),
+		}
+	}
+
+	// Redis clusters consist of a single node, whose endpoint is only returned
+	// when the node info was requested.
+	if len(c.CacheNodes) > 0 &&
+		c.CacheNodes[0].Endpoint != nil &&
+		c.CacheNodes[0].Endpoint.Address != nil {
+		return managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.ToString(c.CacheNodes[0].Endpoint.Address)),
+			xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(c.CacheNodes[0].Endpoint.Port))),
+		}
+	}
+	return nil
+}
+
 // IsClusterNotFound returns true if the supplied error indicates a Cache Cluster
 // already exists.
 func IsClusterNotFound(err error) bool {
@@ -647,3 +863,76 @@ func IsClusterUpToDate(name string, in *cachev1alpha1.CacheClusterParameters, ob
 
 	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})), nil
 }
+
+// GenerateCreateSnapshotInput returns the input to create a snapshot of the
+// replication group or cache cluster in the supplied parameters.
+func GenerateCreateSnapshotInput(p cachev1alpha1.SnapshotParameters, name string) *elasticache.CreateSnapshotInput {
+	return &elasticache.CreateSnapshotInput{
+		SnapshotName:       clients.String(name),
+		CacheClusterId:     p.CacheClusterID,
+		KmsKeyId:           p.KMSKeyID,
+		ReplicationGroupId: p.ReplicationGroupID,
+		Tags:               generateTags(p.Tags),
+	}
+}
+
+// GenerateCopySnapshotInput returns the input to copy the source snapshot in
+// the supplied parameters.
+func GenerateCopySnapshotInput(p cachev1alpha1.SnapshotParameters, name string) *elasticache.CopySnapshotInput {
+	return &elasticache.CopySnapshotInput{
+		SourceSnapshotName: p.SourceSnapshotName,
+		TargetSnapshotName: clients.String(name),
+		KmsKeyId:           p.KMSKeyID,
+		Tags:               generateTags(p.Tags),
+	}
+}
+
+func generateTags(tags []cachev1alpha1.Tag) []elasticachetypes.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]elasticachetypes.Tag, len(tags))
+	for i, tag := range tags {
+		res[i] = elasticachetypes.Tag{
+			Key:   clients.String(tag.Key),
+			Value: tag.Value,
+		}
+	}
+	return res
+}
+
+// GenerateSnapshotObservation produces a SnapshotObservation object out of
+// received elasticache Snapshot object.
+func GenerateSnapshotObservation(s elasticachetypes.Snapshot) cachev1alpha1.SnapshotObservation {
+	return cachev1alpha1.SnapshotObservation{
+		ARN:            clients.StringValue(s.ARN),
+		SnapshotStatus: clients.StringValue(s.SnapshotStatus),
+		SnapshotSource: clients.StringValue(s.SnapshotSource),
+		Engine:         clients.StringValue(s.Engine),
+		EngineVersion:  clients.StringValue(s.EngineVersion),
+		CacheNodeType:  clients.StringValue(s.CacheNodeType),
+		NumNodeGroups:  aws.ToInt32(s.NumNodeGroups),
+	}
+}
+
+// IsSnapshotNotFound returns true if the supplied error indicates a Snapshot
+// was not found.
+func IsSnapshotNotFound(err error) bool {
+	var nf *elasticachetypes.SnapshotNotFoundFault
+	return errors.As(err, &nf)
+}
+
+// ReplicationGroupOperationDuration returns how long the operation a
+// replication group in the supplied status is performing typically takes, and
+// whether it is performing one at all.
+func ReplicationGroupOperationDuration(status string) (time.Duration, bool) {
+	switch status {
+	case v1beta1.StatusCreating:
+		return 10 * time.Minute, true
+	case v1beta1.StatusModifying:
+		return 15 * time.Minute, true
+	case v1beta1.StatusSnapshotting:
+		return 10 * time.Minute, true
+	}
+	return 0, false
+}
@@ -293,6 +293,46 @@ func TestNewModifyReplicationGroupInput(t *testing.T) {
 	}
 }
 
+func TestNewModifyReplicationGroupAuthTokenInput(t *testing.T) {
+	set := v1beta1.AuthTokenUpdateStrategySet
+	cases := []struct {
+		name   string
+		params v1beta1.ReplicationGroupParameters
+		want   *elasticache.ModifyReplicationGroupInput
+	}{
+		{
+			name:   "DefaultStrategy",
+			params: v1beta1.ReplicationGroupParameters{CacheNodeType: cacheNodeType},
+			want: &elasticache.ModifyReplicationGroupInput{
+				ReplicationGroupId:      aws.String(name, aws.FieldRequired),
+				ApplyImmediately:        true,
+				AuthToken:               aws.String("token"),
+				AuthTokenUpdateStrategy: elasticachetypes.AuthTokenUpdateStrategyTypeRotate,
+			},
+		},
+		{
+			name:   "SetStrategy",
+			params: v1beta1.ReplicationGroupParameters{AuthTokenUpdateStrategy: &set},
+			want: &elasticache.ModifyReplicationGroupInput{
+				ReplicationGroupId:      aws.String(name, aws.FieldRequired),
+				ApplyImmediately:        true,
+				AuthToken:               aws.String("token"),
+				AuthTokenUpdateStrategy: elasticachetypes.AuthTokenUpdateStrategyTypeSet,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewModifyReplicationGroupAuthTokenInput(tc.params, name, "token")
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("NewModifyReplicationGroupAuthTokenInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNewModifyReplicationGroupShardConfigurationInput(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -799,6 +839,188 @@ func TestReplicationGroupShardConfigurationNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestReplicationGroupReplicasNeedUpdate(t *testing.T) {
+	members := func(n int) []elasticachetypes.NodeGroupMember {
+		return make([]elasticachetypes.NodeGroupMember, n)
+	}
+	cases := []struct {
+		name         string
+		kube         v1beta1.ReplicationGroupParameters
+		rg           elasticachetypes.ReplicationGroup
+		wantIncrease bool
+		wantDecrease bool
+	}{
+		{
+			name: "NilReplicasPerNodeGroup",
+			kube: v1beta1.ReplicationGroupParameters{},
+			rg: elasticachetypes.ReplicationGroup{
+				NodeGroups: []elasticachetypes.NodeGroup{{NodeGroupMembers: members(3)}},
+			},
+		},
+		{
+			name: "UpToDate",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+			rg: elasticachetypes.ReplicationGroup{
+				NodeGroups: []elasticachetypes.NodeGroup{
+					{NodeGroupMembers: members(replicasPerNodeGroup + 1)},
+					{NodeGroupMembers: members(replicasPerNodeGroup + 1)},
+				},
+			},
+		},
+		{
+			name: "Increase",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+			rg: elasticachetypes.ReplicationGroup{
+				NodeGroups: []elasticachetypes.NodeGroup{
+					{NodeGroupMembers: members(replicasPerNodeGroup + 1)},
+					{NodeGroupMembers: members(replicasPerNodeGroup)},
+				},
+			},
+			wantIncrease: true,
+		},
+		{
+			name: "Decrease",
+			kube: v1beta1.ReplicationGroupParameters{ReplicasPerNodeGroup: &replicasPerNodeGroup},
+			rg: elasticachetypes.ReplicationGroup{
+				NodeGroups: []elasticachetypes.NodeGroup{
+					{NodeGroupMembers: members(replicasPerNodeGroup + 2)},
+				},
+			},
+			wantDecrease: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			increase, decrease := ReplicationGroupReplicasNeedUpdate(tc.kube, tc.rg)
+			if increase != tc.wantIncrease {
+				t.Errorf("ReplicationGroupReplicasNeedUpdate(...): want increase %t, got %t", tc.wantIncrease, increase)
+			}
+			if decrease != tc.wantDecrease {
+				t.Errorf("ReplicationGroupReplicasNeedUpdate(...): want decrease %t, got %t", tc.wantDecrease, decrease)
+			}
+		})
+	}
+}
+
+func TestReplicationGroupEngineVersionNeedsUpgrade(t *testing.T) {
+	cases := []struct {
+		name string
+		kube v1beta1.ReplicationGroupParameters
+		cc   elasticachetypes.CacheCluster
+		want bool
+	}{
+		{
+			name: "NilEngineVersion",
+			kube: v1beta1.ReplicationGroupParameters{},
+			cc:   elasticachetypes.CacheCluster{EngineVersion: aws.String("5.0.6")},
+		},
+		{
+			name: "UpToDate",
+			kube: v1beta1.ReplicationGroupParameters{EngineVersion: aws.String("6.x")},
+			cc:   elasticachetypes.CacheCluster{EngineVersion: aws.String("6.0.5")},
+		},
+		{
+			name: "UpgradePending",
+			kube: v1beta1.ReplicationGroupParameters{EngineVersion: aws.String("6.2")},
+			cc: elasticachetypes.CacheCluster{
+				EngineVersion:         aws.String("5.0.6"),
+				PendingModifiedValues: &elasticachetypes.PendingModifiedValues{EngineVersion: aws.String("6.2")},
+			},
+		},
+		{
+			name: "NeedsUpgrade",
+			kube: v1beta1.ReplicationGroupParameters{EngineVersion: aws.String("6.2")},
+			cc:   elasticachetypes.CacheCluster{EngineVersion: aws.String("5.0.6")},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ReplicationGroupEngineVersionNeedsUpgrade(tc.kube, tc.cc)
+			if got != tc.want {
+				t.Errorf("ReplicationGroupEngineVersionNeedsUpgrade(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReplicationGroupNeedsDisassociation(t *testing.T) {
+	secondary := elasticachetypes.ReplicationGroup{
+		GlobalReplicationGroupInfo: &elasticachetypes.GlobalReplicationGroupInfo{
+			GlobalReplicationGroupId:         aws.String("ldgnf-global"),
+			GlobalReplicationGroupMemberRole: aws.String("SECONDARY"),
+		},
+	}
+	cases := []struct {
+		name string
+		kube v1beta1.ReplicationGroupParameters
+		rg   elasticachetypes.ReplicationGroup
+		want bool
+	}{
+		{
+			name: "NotAMember",
+			kube: v1beta1.ReplicationGroupParameters{},
+			rg:   elasticachetypes.ReplicationGroup{},
+		},
+		{
+			name: "Primary",
+			kube: v1beta1.ReplicationGroupParameters{},
+			rg: elasticachetypes.ReplicationGroup{
+				GlobalReplicationGroupInfo: &elasticachetypes.GlobalReplicationGroupInfo{
+					GlobalReplicationGroupId:         aws.String("ldgnf-global"),
+					GlobalReplicationGroupMemberRole: aws.String("PRIMARY"),
+				},
+			},
+		},
+		{
+			name: "DesiredSecondary",
+			kube: v1beta1.ReplicationGroupParameters{GlobalReplicationGroupID: aws.String("ldgnf-global")},
+			rg:   secondary,
+		},
+		{
+			name: "RemovedSecondary",
+			kube: v1beta1.ReplicationGroupParameters{},
+			rg:   secondary,
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ReplicationGroupNeedsDisassociation(tc.kube, tc.rg)
+			if got != tc.want {
+				t.Errorf("ReplicationGroupNeedsDisassociation(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestIsEngineVersionDowngrade(t *testing.T) {
+	cases := map[string]struct {
+		desired string
+		current string
+		want    bool
+	}{
+		"Same":           {desired: "5.0.6", current: "5.0.6"},
+		"MinorUpgrade":   {desired: "5.0.6", current: "5.0.0"},
+		"MajorUpgrade":   {desired: "6.2", current: "5.0.6"},
+		"Downgrade":      {desired: "5.0.6", current: "6.0.5", want: true},
+		"MinorDowngrade": {desired: "6.0", current: "6.2.6", want: true},
+		"Wildcard":       {desired: "6.x", current: "6.2.6"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsEngineVersionDowngrade(tc.desired, tc.current)
+			if got != tc.want {
+				t.Errorf("IsEngineVersionDowngrade(%q, %q): want %t, got %t", tc.desired, tc.current, tc.want, got)
+			}
+		})
+	}
+}
+
 func TestCacheClusterNeedsUpdate(t *testing.T) {
 	cases := []struct {
 		name string
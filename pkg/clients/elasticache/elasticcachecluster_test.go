@@ -26,6 +26,9 @@ import (
 	awscachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/google/go-cmp/cmp"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -262,6 +265,17 @@ func TestGenerateClusterObservation(t *testing.T) {
 				}},
 			},
 		},
+		"ConfigurationEndpoint": {
+			in: *cluster(func(c *awscachetypes.CacheCluster) {
+				c.ConfigurationEndpoint = &awscachetypes.Endpoint{Address: aws.String("c.cfg.cache.amazonaws.com"), Port: 11211}
+			}),
+			out: v1alpha1.CacheClusterObservation{
+				AtRestEncryptionEnabled: boolTrue,
+				AuthTokenEnabled:        boolTrue,
+				CacheClusterStatus:      v1alpha1.StatusAvailable,
+				ConfigurationEndpoint:   v1alpha1.Endpoint{Address: "c.cfg.cache.amazonaws.com", Port: 11211},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -273,3 +287,46 @@ func TestGenerateClusterObservation(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterConnectionEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		in  awscachetypes.CacheCluster
+		out managed.ConnectionDetails
+	}{
+		"ConfigurationEndpoint": {
+			in: awscachetypes.CacheCluster{
+				ConfigurationEndpoint: &awscachetypes.Endpoint{Address: aws.String("c.cfg.cache.amazonaws.com"), Port: 11211},
+				CacheNodes: []awscachetypes.CacheNode{{
+					Endpoint: &awscachetypes.Endpoint{Address: aws.String("c.0001.cache.amazonaws.com"), Port: 11211},
+				}},
+			},
+			out: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("c.cfg.cache.amazonaws.com"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("11211"),
+			},
+		},
+		"NodeEndpoint": {
+			in: awscachetypes.CacheCluster{
+				CacheNodes: []awscachetypes.CacheNode{{
+					Endpoint: &awscachetypes.Endpoint{Address: aws.String("c.0001.cache.amazonaws.com"), Port: 6379},
+				}},
+			},
+			out: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("c.0001.cache.amazonaws.com"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("6379"),
+			},
+		},
+		"NoEndpoint": {
+			in: awscachetypes.CacheCluster{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := ClusterConnectionEndpoint(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("ClusterConnectionEndpoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -42,6 +42,16 @@ type MockClient struct {
 	MockModifyCacheCluster    func(context.Context, *elasticache.ModifyCacheClusterInput, []func(*elasticache.Options)) (*elasticache.ModifyCacheClusterOutput, error)
 
 	MockModifyReplicationGroupShardConfiguration func(context.Context, *elasticache.ModifyReplicationGroupShardConfigurationInput, []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupShardConfigurationOutput, error)
+	MockIncreaseReplicaCount                     func(context.Context, *elasticache.IncreaseReplicaCountInput, []func(*elasticache.Options)) (*elasticache.IncreaseReplicaCountOutput, error)
+	MockDecreaseReplicaCount                     func(context.Context, *elasticache.DecreaseReplicaCountInput, []func(*elasticache.Options)) (*elasticache.DecreaseReplicaCountOutput, error)
+
+	MockDisassociateGlobalReplicationGroup func(context.Context, *elasticache.DisassociateGlobalReplicationGroupInput, []func(*elasticache.Options)) (*elasticache.DisassociateGlobalReplicationGroupOutput, error)
+	MockTestFailover                       func(context.Context, *elasticache.TestFailoverInput, []func(*elasticache.Options)) (*elasticache.TestFailoverOutput, error)
+
+	MockDescribeSnapshots func(context.Context, *elasticache.DescribeSnapshotsInput, []func(*elasticache.Options)) (*elasticache.DescribeSnapshotsOutput, error)
+	MockCreateSnapshot    func(context.Context, *elasticache.CreateSnapshotInput, []func(*elasticache.Options)) (*elasticache.CreateSnapshotOutput, error)
+	MockCopySnapshot      func(context.Context, *elasticache.CopySnapshotInput, []func(*elasticache.Options)) (*elasticache.CopySnapshotOutput, error)
+	MockDeleteSnapshot    func(context.Context, *elasticache.DeleteSnapshotInput, []func(*elasticache.Options)) (*elasticache.DeleteSnapshotOutput, error)
 }
 
 // DescribeReplicationGroups calls the underlying
@@ -80,6 +90,27 @@ func (c *MockClient) ModifyReplicationGroupShardConfiguration(ctx context.Contex
 	return c.MockModifyReplicationGroupShardConfiguration(ctx, i, opts)
 }
 
+// IncreaseReplicaCount calls the underlying MockIncreaseReplicaCount method.
+func (c *MockClient) IncreaseReplicaCount(ctx context.Context, i *elasticache.IncreaseReplicaCountInput, opts ...func(*elasticache.Options)) (*elasticache.IncreaseReplicaCountOutput, error) {
+	return c.MockIncreaseReplicaCount(ctx, i, opts)
+}
+
+// DecreaseReplicaCount calls the underlying MockDecreaseReplicaCount method.
+func (c *MockClient) DecreaseReplicaCount(ctx context.Context, i *elasticache.DecreaseReplicaCountInput, opts ...func(*elasticache.Options)) (*elasticache.DecreaseReplicaCountOutput, error) {
+	return c.MockDecreaseReplicaCount(ctx, i, opts)
+}
+
+// DisassociateGlobalReplicationGroup calls the underlying
+// MockDisassociateGlobalReplicationGroup method.
+func (c *MockClient) DisassociateGlobalReplicationGroup(ctx context.Context, i *elasticache.DisassociateGlobalReplicationGroupInput, opts ...func(*elasticache.Options)) (*elasticache.DisassociateGlobalReplicationGroupOutput, error) {
+	return c.MockDisassociateGlobalReplicationGroup(ctx, i, opts)
+}
+
+// TestFailover calls the underlying MockTestFailover method.
+func (c *MockClient) TestFailover(ctx context.Context, i *elasticache.TestFailoverInput, opts ...func(*elasticache.Options)) (*elasticache.TestFailoverOutput, error) {
+	return c.MockTestFailover(ctx, i, opts)
+}
+
 // DescribeCacheSubnetGroups calls the underlying
 // MockDescribeCacheSubnetGroups method.
 func (c *MockClient) DescribeCacheSubnetGroups(ctx context.Context, i *elasticache.DescribeCacheSubnetGroupsInput, opts ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
@@ -121,3 +152,23 @@ func (c *MockClient) DeleteCacheCluster(ctx context.Context, i *elasticache.Dele
 func (c *MockClient) ModifyCacheCluster(ctx context.Context, i *elasticache.ModifyCacheClusterInput, opts ...func(*elasticache.Options)) (*elasticache.ModifyCacheClusterOutput, error) {
 	return c.MockModifyCacheCluster(ctx, i, opts)
 }
+
+// DescribeSnapshots calls the underlying MockDescribeSnapshots method.
+func (c *MockClient) DescribeSnapshots(ctx context.Context, i *elasticache.DescribeSnapshotsInput, opts ...func(*elasticache.Options)) (*elasticache.DescribeSnapshotsOutput, error) {
+	return c.MockDescribeSnapshots(ctx, i, opts)
+}
+
+// CreateSnapshot calls the underlying MockCreateSnapshot method.
+func (c *MockClient) CreateSnapshot(ctx context.Context, i *elasticache.CreateSnapshotInput, opts ...func(*elasticache.Options)) (*elasticache.CreateSnapshotOutput, error) {
+	return c.MockCreateSnapshot(ctx, i, opts)
+}
+
+// CopySnapshot calls the underlying MockCopySnapshot method.
+func (c *MockClient) CopySnapshot(ctx context.Context, i *elasticache.CopySnapshotInput, opts ...func(*elasticache.Options)) (*elasticache.CopySnapshotOutput, error) {
+	return c.MockCopySnapshot(ctx, i, opts)
+}
+
+// DeleteSnapshot calls the underlying MockDeleteSnapshot method.
+func (c *MockClient) DeleteSnapshot(ctx context.Context, i *elasticache.DeleteSnapshotInput, opts ...func(*elasticache.Options)) (*elasticache.DeleteSnapshotOutput, error) {
+	return c.MockDeleteSnapshot(ctx, i, opts)
+}
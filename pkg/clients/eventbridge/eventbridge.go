@@ -0,0 +1,31 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsEventPatternUpToDate reports whether the desired and the observed event
+// patterns are equal. EventBridge returns the pattern in its own formatting,
+// so both are compared as normalized JSON documents.
+func IsEventPatternUpToDate(desired, observed *string) bool {
+	if awsclients.StringValue(desired) == "" || awsclients.StringValue(observed) == "" {
+		return awsclients.StringValue(desired) == awsclients.StringValue(observed)
+	}
+	return awsclients.IsPolicyUpToDate(desired, observed)
+}
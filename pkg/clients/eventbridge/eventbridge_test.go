@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"testing"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsEventPatternUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *string
+		observed *string
+		want     bool
+	}{
+		"BothEmpty": {
+			want: true,
+		},
+		"OnlyDesired": {
+			desired: awsclients.String(`{"source":["aws.ec2"]}`),
+			want:    false,
+		},
+		"OnlyObserved": {
+			observed: awsclients.String(`{"source":["aws.ec2"]}`),
+			want:     false,
+		},
+		"DifferentFormatting": {
+			desired:  awsclients.String("{\n  \"source\": [\"aws.ec2\"],\n  \"detail-type\": [\"EC2 Instance State-change Notification\"]\n}"),
+			observed: awsclients.String(`{"detail-type":["EC2 Instance State-change Notification"],"source":["aws.ec2"]}`),
+			want:     true,
+		},
+		"DifferentValueOrder": {
+			desired:  awsclients.String(`{"source":["aws.ec2","aws.s3"]}`),
+			observed: awsclients.String(`{"source":["aws.s3","aws.ec2"]}`),
+			want:     true,
+		},
+		"DifferentPattern": {
+			desired:  awsclients.String(`{"source":["aws.ec2"]}`),
+			observed: awsclients.String(`{"source":["aws.s3"]}`),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsEventPatternUpToDate(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("IsEventPatternUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Event reasons of the calls to the AWS API.
+const (
+	ReasonCalledAPI       event.Reason = "CalledExternalAPI"
+	ReasonCannotCallAPI   event.Reason = "CannotCallExternalAPI"
+	annotationOperation                = "operation"
+	annotationRequestID                = "request-id"
+	recordAPICallsHandler              = "crossplane.RecordAPICalls"
+)
+
+// readOnlyPrefixes are the prefixes of the names of the operations that do
+// not change anything and are therefore not recorded.
+var readOnlyPrefixes = []string{"Describe", "Get", "List", "Head", "Lookup", "Search"}
+
+// apiRecorder records the calls of the clients that are configured for a
+// managed resource. Nothing is recorded until RecordAPICalls is called.
+var apiRecorder event.Recorder = event.NewNopRecorder()
+
+// RecordAPICalls makes the AWS clients of all managed resources record an
+// event for every call that may change an external resource. The events
+// contain the name of the operation and the AWS request ID, so that the
+// history of a managed resource can be matched with CloudTrail. It must be
+// called before the controllers are started.
+func RecordAPICalls(r event.Recorder) {
+	apiRecorder = r
+}
+
+// IsReadOnlyOperation returns true if the operation with the given name only
+// reads from the AWS API.
+func IsReadOnlyOperation(name string) bool {
+	for _, p := range readOnlyPrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordAPICall records that the given operation was called for mg.
+func recordAPICall(mg resource.Managed, service, operation, requestID string, err error) {
+	if IsReadOnlyOperation(operation) {
+		return
+	}
+	op := strings.TrimSpace(service + " " + operation)
+	annotations := []string{annotationOperation, op, annotationRequestID, requestID}
+	if err != nil {
+		msg := fmt.Sprintf("cannot call %s (request ID %s)", op, requestID)
+		apiRecorder.Event(mg, event.Warning(ReasonCannotCallAPI, Wrap(err, msg), annotations...))
+		return
+	}
+	apiRecorder.Event(mg, event.Normal(ReasonCalledAPI,
+		fmt.Sprintf("Called %s (request ID %s)", op, requestID), annotations...))
+}
+
+// addAPICallRecorder adds a handler that records the calls of the AWSv1
+// clients created from sess for mg.
+func addAPICallRecorder(sess *session.Session, mg resource.Managed) {
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: recordAPICallsHandler,
+		Fn: func(r *request.Request) {
+			recordAPICall(mg, r.ClientInfo.ServiceName, r.Operation.Name, r.RequestID, r.Error)
+		},
+	})
+}
+
+// apiCallRecorder is a middleware that records the calls of the AWSv2
+// clients for a managed resource.
+type apiCallRecorder struct {
+	mg resource.Managed
+}
+
+// ID of the middleware.
+func (*apiCallRecorder) ID() string {
+	return recordAPICallsHandler
+}
+
+// HandleInitialize records the call once it is complete, including all of
+// its retries.
+func (m *apiCallRecorder) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	out, md, err := next.HandleInitialize(ctx, in)
+	requestID, _ := awsmiddleware.GetRequestIDMetadata(md)
+	var re *awshttp.ResponseError
+	if requestID == "" && errors.As(err, &re) {
+		requestID = re.ServiceRequestID()
+	}
+	recordAPICall(m.mg, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), requestID, err)
+	return out, md, err
+}
+
+// addAPICallRecorderV2 returns an API option that records the calls of the
+// AWSv2 clients for mg.
+func addAPICallRecorderV2(mg resource.Managed) func(*middleware.Stack) error {
+	return func(s *middleware.Stack) error {
+		return s.Initialize.Add(&apiCallRecorder{mg: mg}, middleware.After)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func withRecorder(t *testing.T) *recorder {
+	r := &recorder{}
+	RecordAPICalls(r)
+	t.Cleanup(func() { RecordAPICalls(event.NewNopRecorder()) })
+	return r
+}
+
+func TestAddAPICallRecorder(t *testing.T) {
+	cases := map[string]struct {
+		operation string
+		err       error
+		want      []event.Event
+	}{
+		"ReadOnly": {
+			operation: "GetRestApi",
+		},
+		"Succeeded": {
+			operation: "CreateRestApi",
+			want: []event.Event{
+				event.Normal(ReasonCalledAPI, "Called apigateway CreateRestApi (request ID abc)",
+					annotationOperation, "apigateway CreateRestApi", annotationRequestID, "abc"),
+			},
+		},
+		"Failed": {
+			operation: "DeleteRestApi",
+			err:       awserr.NewRequestFailure(awserr.New("NotFoundException", "gone", nil), 404, "abc"),
+			want: []event.Event{
+				event.Warning(ReasonCannotCallAPI,
+					Wrap(awserr.NewRequestFailure(awserr.New("NotFoundException", "gone", nil), 404, "abc"),
+						"cannot call apigateway DeleteRestApi (request ID abc)"),
+					annotationOperation, "apigateway DeleteRestApi", annotationRequestID, "abc"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := withRecorder(t)
+			sess := session.Must(session.NewSession())
+			addAPICallRecorder(sess, &fake.Managed{})
+			sess.Handlers.Complete.Run(&request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceName: "apigateway"},
+				Operation:  &request.Operation{Name: tc.operation},
+				RequestID:  "abc",
+				Error:      tc.err,
+			})
+			if diff := cmp.Diff(tc.want, rec.events); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPICallRecorder(t *testing.T) {
+	rec := withRecorder(t)
+	s := middleware.NewStack("CreateDBInstance", smithyhttp.NewStackRequest)
+	_ = s.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{ServiceID: "RDS", OperationName: "CreateDBInstance"}, middleware.Before)
+	_ = addAPICallRecorderV2(&fake.Managed{})(s)
+	h := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, interface{}) (interface{}, middleware.Metadata, error) {
+		md := middleware.Metadata{}
+		awsmiddleware.SetRequestIDMetadata(&md, "abc")
+		return nil, md, nil
+	}), s)
+	if _, _, err := h.Handle(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	want := []event.Event{
+		event.Normal(ReasonCalledAPI, "Called RDS CreateDBInstance (request ID abc)",
+			annotationOperation, "RDS CreateDBInstance", annotationRequestID, "abc"),
+	}
+	if diff := cmp.Diff(want, rec.events); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
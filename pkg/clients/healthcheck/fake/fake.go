@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+)
+
+// MockHealthCheckClient is a type that implements all the methods for HealthCheck Client interface
+type MockHealthCheckClient struct {
+	MockCreateHealthCheck func(ctx context.Context, input *route53.CreateHealthCheckInput, opts []func(*route53.Options)) (*route53.CreateHealthCheckOutput, error)
+	MockDeleteHealthCheck func(ctx context.Context, input *route53.DeleteHealthCheckInput, opts []func(*route53.Options)) (*route53.DeleteHealthCheckOutput, error)
+	MockGetHealthCheck    func(ctx context.Context, input *route53.GetHealthCheckInput, opts []func(*route53.Options)) (*route53.GetHealthCheckOutput, error)
+	MockUpdateHealthCheck func(ctx context.Context, input *route53.UpdateHealthCheckInput, opts []func(*route53.Options)) (*route53.UpdateHealthCheckOutput, error)
+}
+
+// CreateHealthCheck mocks CreateHealthCheck method
+func (m *MockHealthCheckClient) CreateHealthCheck(ctx context.Context, input *route53.CreateHealthCheckInput, opts ...func(*route53.Options)) (*route53.CreateHealthCheckOutput, error) {
+	return m.MockCreateHealthCheck(ctx, input, opts)
+}
+
+// DeleteHealthCheck mocks DeleteHealthCheck method
+func (m *MockHealthCheckClient) DeleteHealthCheck(ctx context.Context, input *route53.DeleteHealthCheckInput, opts ...func(*route53.Options)) (*route53.DeleteHealthCheckOutput, error) {
+	return m.MockDeleteHealthCheck(ctx, input, opts)
+}
+
+// GetHealthCheck mocks GetHealthCheck method
+func (m *MockHealthCheckClient) GetHealthCheck(ctx context.Context, input *route53.GetHealthCheckInput, opts ...func(*route53.Options)) (*route53.GetHealthCheckOutput, error) {
+	return m.MockGetHealthCheck(ctx, input, opts)
+}
+
+// UpdateHealthCheck mocks UpdateHealthCheck method
+func (m *MockHealthCheckClient) UpdateHealthCheck(ctx context.Context, input *route53.UpdateHealthCheckInput, opts ...func(*route53.Options)) (*route53.UpdateHealthCheckOutput, error) {
+	return m.MockUpdateHealthCheck(ctx, input, opts)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines Route53 HealthCheck operations
+type Client interface {
+	CreateHealthCheck(ctx context.Context, input *route53.CreateHealthCheckInput, opts ...func(*route53.Options)) (*route53.CreateHealthCheckOutput, error)
+	DeleteHealthCheck(ctx context.Context, input *route53.DeleteHealthCheckInput, opts ...func(*route53.Options)) (*route53.DeleteHealthCheckOutput, error)
+	GetHealthCheck(ctx context.Context, input *route53.GetHealthCheckInput, opts ...func(*route53.Options)) (*route53.GetHealthCheckOutput, error)
+	UpdateHealthCheck(ctx context.Context, input *route53.UpdateHealthCheckInput, opts ...func(*route53.Options)) (*route53.UpdateHealthCheckOutput, error)
+}
+
+// NewClient creates new Route53 HealthCheck client with provided AWS Configurations/Credentials
+func NewClient(cfg aws.Config) Client {
+	return route53.NewFromConfig(cfg)
+}
+
+// IsNotFound returns true if the error code indicates that the requested HealthCheck was not found
+func IsNotFound(err error) bool {
+	var nshc *route53types.NoSuchHealthCheck
+	return errors.As(err, &nshc)
+}
+
+// GenerateCreateHealthCheckInput returns a route53 CreateHealthCheckInput using
+// which a route53 HealthCheck can be created.
+func GenerateCreateHealthCheckInput(cr *v1alpha1.HealthCheck) *route53.CreateHealthCheckInput {
+	return &route53.CreateHealthCheckInput{
+		CallerReference:   aws.String(string(cr.GetUID())),
+		HealthCheckConfig: GenerateHealthCheckConfig(cr.Spec.ForProvider),
+	}
+}
+
+// GenerateHealthCheckConfig returns the route53types.HealthCheckConfig of the
+// supplied v1alpha1.HealthCheckParameters.
+func GenerateHealthCheckConfig(p v1alpha1.HealthCheckParameters) *route53types.HealthCheckConfig {
+	c := &route53types.HealthCheckConfig{
+		Type:                         route53types.HealthCheckType(p.Type),
+		IPAddress:                    p.IPAddress,
+		Port:                         p.Port,
+		ResourcePath:                 p.ResourcePath,
+		FullyQualifiedDomainName:     p.FullyQualifiedDomainName,
+		SearchString:                 p.SearchString,
+		RequestInterval:              p.RequestInterval,
+		FailureThreshold:             p.FailureThreshold,
+		MeasureLatency:               p.MeasureLatency,
+		Inverted:                     p.Inverted,
+		Disabled:                     p.Disabled,
+		HealthThreshold:              p.HealthThreshold,
+		ChildHealthChecks:            p.ChildHealthChecks,
+		EnableSNI:                    p.EnableSNI,
+		InsufficientDataHealthStatus: route53types.InsufficientDataHealthStatus(p.InsufficientDataHealthStatus),
+		RoutingControlArn:            p.RoutingControlARN,
+	}
+	for _, r := range p.Regions {
+		c.Regions = append(c.Regions, route53types.HealthCheckRegion(r))
+	}
+	if p.AlarmIdentifier != nil {
+		c.AlarmIdentifier = &route53types.AlarmIdentifier{
+			Name:   aws.String(p.AlarmIdentifier.Name),
+			Region: route53types.CloudWatchRegion(p.AlarmIdentifier.Region),
+		}
+	}
+	return c
+}
+
+// GenerateHealthCheckParameters returns the v1alpha1.HealthCheckParameters
+// of the supplied route53types.HealthCheckConfig.
+func GenerateHealthCheckParameters(c *route53types.HealthCheckConfig) v1alpha1.HealthCheckParameters {
+	p := v1alpha1.HealthCheckParameters{
+		Type:                         string(c.Type),
+		IPAddress:                    c.IPAddress,
+		Port:                         c.Port,
+		ResourcePath:                 c.ResourcePath,
+		FullyQualifiedDomainName:     c.FullyQualifiedDomainName,
+		SearchString:                 c.SearchString,
+		RequestInterval:              c.RequestInterval,
+		FailureThreshold:             c.FailureThreshold,
+		MeasureLatency:               c.MeasureLatency,
+		Inverted:                     c.Inverted,
+		Disabled:                     c.Disabled,
+		HealthThreshold:              c.HealthThreshold,
+		ChildHealthChecks:            c.ChildHealthChecks,
+		EnableSNI:                    c.EnableSNI,
+		InsufficientDataHealthStatus: string(c.InsufficientDataHealthStatus),
+		RoutingControlARN:            c.RoutingControlArn,
+	}
+	for _, r := range c.Regions {
+		p.Regions = append(p.Regions, string(r))
+	}
+	if c.AlarmIdentifier != nil {
+		p.AlarmIdentifier = &v1alpha1.AlarmIdentifier{
+			Name:   aws.ToString(c.AlarmIdentifier.Name),
+			Region: string(c.AlarmIdentifier.Region),
+		}
+	}
+	return p
+}
+
+// GenerateUpdateHealthCheckInput returns a route53 UpdateHealthCheckInput
+// using which the supplied route53 HealthCheck can be updated to the
+// supplied v1alpha1.HealthCheckParameters.
+func GenerateUpdateHealthCheckInput(id string, p v1alpha1.HealthCheckParameters, obs route53types.HealthCheck) *route53.UpdateHealthCheckInput {
+	c := GenerateHealthCheckConfig(p)
+	in := &route53.UpdateHealthCheckInput{
+		HealthCheckId:                aws.String(id),
+		HealthCheckVersion:           obs.HealthCheckVersion,
+		IPAddress:                    c.IPAddress,
+		Port:                         c.Port,
+		ResourcePath:                 c.ResourcePath,
+		FullyQualifiedDomainName:     c.FullyQualifiedDomainName,
+		SearchString:                 c.SearchString,
+		FailureThreshold:             c.FailureThreshold,
+		Inverted:                     c.Inverted,
+		Disabled:                     c.Disabled,
+		HealthThreshold:              c.HealthThreshold,
+		ChildHealthChecks:            c.ChildHealthChecks,
+		EnableSNI:                    c.EnableSNI,
+		Regions:                      c.Regions,
+		AlarmIdentifier:              c.AlarmIdentifier,
+		InsufficientDataHealthStatus: c.InsufficientDataHealthStatus,
+	}
+
+	// Elements that are omitted from an update keep their value, so the
+	// ones that were removed from the spec have to be reset explicitly.
+	if obs.HealthCheckConfig != nil {
+		o := obs.HealthCheckConfig
+		if p.FullyQualifiedDomainName == nil && o.FullyQualifiedDomainName != nil {
+			in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameFullyQualifiedDomainName)
+		}
+		if p.ResourcePath == nil && o.ResourcePath != nil {
+			in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameResourcePath)
+		}
+		if len(p.Regions) == 0 && len(o.Regions) != 0 {
+			in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameRegions)
+		}
+		if len(p.ChildHealthChecks) == 0 && len(o.ChildHealthChecks) != 0 {
+			in.ResetElements = append(in.ResetElements, route53types.ResettableElementNameChildHealthChecks)
+		}
+	}
+	return in
+}
+
+// GenerateObservation generates and returns v1alpha1.HealthCheckObservation
+// which can be used as the status of the runtime object.
+func GenerateObservation(hc route53types.HealthCheck) v1alpha1.HealthCheckObservation {
+	o := v1alpha1.HealthCheckObservation{
+		HealthCheckVersion: aws.ToInt64(hc.HealthCheckVersion),
+	}
+	if hc.LinkedService != nil {
+		o.LinkedService = v1alpha1.LinkedService{
+			Description:      aws.ToString(hc.LinkedService.Description),
+			ServicePrincipal: aws.ToString(hc.LinkedService.ServicePrincipal),
+		}
+	}
+	return o
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.HealthCheckParameters
+// with the values seen in route53types.HealthCheckConfig.
+func LateInitialize(spec *v1alpha1.HealthCheckParameters, c *route53types.HealthCheckConfig) {
+	if spec == nil || c == nil {
+		return
+	}
+	spec.Port = awsclients.LateInitializeInt32Ptr(spec.Port, c.Port)
+	spec.RequestInterval = awsclients.LateInitializeInt32Ptr(spec.RequestInterval, c.RequestInterval)
+	spec.FailureThreshold = awsclients.LateInitializeInt32Ptr(spec.FailureThreshold, c.FailureThreshold)
+	spec.HealthThreshold = awsclients.LateInitializeInt32Ptr(spec.HealthThreshold, c.HealthThreshold)
+	spec.MeasureLatency = awsclients.LateInitializeBoolPtr(spec.MeasureLatency, c.MeasureLatency)
+	spec.Inverted = awsclients.LateInitializeBoolPtr(spec.Inverted, c.Inverted)
+	spec.Disabled = awsclients.LateInitializeBoolPtr(spec.Disabled, c.Disabled)
+	spec.EnableSNI = awsclients.LateInitializeBoolPtr(spec.EnableSNI, c.EnableSNI)
+	spec.InsufficientDataHealthStatus = awsclients.LateInitializeString(spec.InsufficientDataHealthStatus, (*string)(&c.InsufficientDataHealthStatus))
+	if len(spec.Regions) == 0 && len(c.Regions) != 0 {
+		spec.Regions = make([]string, len(c.Regions))
+		for i, r := range c.Regions {
+			spec.Regions[i] = string(r)
+		}
+	}
+}
+
+// IsUpToDate checks whether the HealthCheck in AWS is configured as the
+// supplied v1alpha1.HealthCheckParameters.
+func IsUpToDate(spec v1alpha1.HealthCheckParameters, c route53types.HealthCheckConfig) bool {
+	return cmp.Equal(spec, GenerateHealthCheckParameters(&c),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+		cmpopts.IgnoreTypes(&xpv1.Selector{}),
+		cmpopts.IgnoreFields(v1alpha1.HealthCheckParameters{}, "ChildHealthCheckRefs"))
+}
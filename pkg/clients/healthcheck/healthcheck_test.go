@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		spec v1alpha1.HealthCheckParameters
+		obs  route53types.HealthCheckConfig
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				spec: v1alpha1.HealthCheckParameters{
+					Type:                     "HTTPS",
+					FullyQualifiedDomainName: aws.String("example.com"),
+					Regions:                  []string{"us-west-2", "eu-west-1", "us-east-1"},
+				},
+				obs: route53types.HealthCheckConfig{
+					Type:                     route53types.HealthCheckTypeHttps,
+					FullyQualifiedDomainName: aws.String("example.com"),
+					Regions: []route53types.HealthCheckRegion{
+						route53types.HealthCheckRegionEuWest1,
+						route53types.HealthCheckRegionUsEast1,
+						route53types.HealthCheckRegionUsWest2,
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentResourcePath": {
+			args: args{
+				spec: v1alpha1.HealthCheckParameters{
+					Type:         "HTTP",
+					ResourcePath: aws.String("/healthz"),
+				},
+				obs: route53types.HealthCheckConfig{
+					Type:         route53types.HealthCheckTypeHttp,
+					ResourcePath: aws.String("/"),
+				},
+			},
+			want: false,
+		},
+		"DifferentChildHealthChecks": {
+			args: args{
+				spec: v1alpha1.HealthCheckParameters{
+					Type:              "CALCULATED",
+					HealthThreshold:   aws.Int32(1),
+					ChildHealthChecks: []string{"a", "b"},
+				},
+				obs: route53types.HealthCheckConfig{
+					Type:              route53types.HealthCheckTypeCalculated,
+					HealthThreshold:   aws.Int32(1),
+					ChildHealthChecks: []string{"a"},
+				},
+			},
+			want: false,
+		},
+		"DifferentAlarm": {
+			args: args{
+				spec: v1alpha1.HealthCheckParameters{
+					Type:            "CLOUDWATCH_METRIC",
+					AlarmIdentifier: &v1alpha1.AlarmIdentifier{Name: "new", Region: "us-east-1"},
+				},
+				obs: route53types.HealthCheckConfig{
+					Type:            route53types.HealthCheckTypeCloudwatchMetric,
+					AlarmIdentifier: &route53types.AlarmIdentifier{Name: aws.String("old"), Region: route53types.CloudWatchRegionUsEast1},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.args.spec, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateHealthCheckInput(t *testing.T) {
+	type want struct {
+		reset []route53types.ResettableElementName
+	}
+
+	cases := map[string]struct {
+		spec v1alpha1.HealthCheckParameters
+		obs  route53types.HealthCheckConfig
+		want want
+	}{
+		"NothingRemoved": {
+			spec: v1alpha1.HealthCheckParameters{
+				Type:         "HTTP",
+				ResourcePath: aws.String("/healthz"),
+			},
+			obs: route53types.HealthCheckConfig{
+				Type:         route53types.HealthCheckTypeHttp,
+				ResourcePath: aws.String("/"),
+			},
+		},
+		"Removed": {
+			spec: v1alpha1.HealthCheckParameters{
+				Type: "HTTP",
+			},
+			obs: route53types.HealthCheckConfig{
+				Type:                     route53types.HealthCheckTypeHttp,
+				ResourcePath:             aws.String("/"),
+				FullyQualifiedDomainName: aws.String("example.com"),
+				Regions:                  []route53types.HealthCheckRegion{route53types.HealthCheckRegionEuWest1},
+			},
+			want: want{
+				reset: []route53types.ResettableElementName{
+					route53types.ResettableElementNameFullyQualifiedDomainName,
+					route53types.ResettableElementNameResourcePath,
+					route53types.ResettableElementNameRegions,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateHealthCheckInput("id", tc.spec, route53types.HealthCheck{
+				HealthCheckVersion: aws.Int64(1),
+				HealthCheckConfig:  &tc.obs,
+			})
+			if diff := cmp.Diff(tc.want.reset, got.ResetElements); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package personalize
+
+import (
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Statuses of Personalize resources. The SDK does not define them.
+const (
+	StatusActive       = "ACTIVE"
+	StatusCreateFailed = "CREATE FAILED"
+
+	statusPrefixCreate = "CREATE"
+	statusPrefixUpdate = "UPDATE"
+	statusPrefixDelete = "DELETE"
+)
+
+// Condition returns the condition of a Personalize resource in the given
+// status. The failure reason is added to the condition of a resource whose
+// creation failed.
+func Condition(status, failureReason string) xpv1.Condition {
+	switch {
+	case status == StatusActive:
+		return xpv1.Available()
+	case status == StatusCreateFailed:
+		return xpv1.Unavailable().WithMessage(failureReason)
+	case strings.HasPrefix(status, statusPrefixCreate), strings.HasPrefix(status, statusPrefixUpdate):
+		return xpv1.Creating()
+	case strings.HasPrefix(status, statusPrefixDelete):
+		return xpv1.Deleting()
+	}
+	return xpv1.Unavailable()
+}
+
+// IsInProgress returns true if the given status is a pending or in progress
+// status of a create, update or delete.
+func IsInProgress(status string) bool {
+	return strings.HasSuffix(status, "PENDING") || strings.HasSuffix(status, "IN_PROGRESS")
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package personalize
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+func TestCondition(t *testing.T) {
+	cases := map[string]struct {
+		status        string
+		failureReason string
+		want          xpv1.Condition
+	}{
+		"Active": {
+			status: StatusActive,
+			want:   xpv1.Available(),
+		},
+		"CreatePending": {
+			status: "CREATE PENDING",
+			want:   xpv1.Creating(),
+		},
+		"CreateInProgress": {
+			status: "CREATE IN_PROGRESS",
+			want:   xpv1.Creating(),
+		},
+		"CreateFailed": {
+			status:        StatusCreateFailed,
+			failureReason: "invalid schema",
+			want:          xpv1.Unavailable().WithMessage("invalid schema"),
+		},
+		"DeleteInProgress": {
+			status: "DELETE IN_PROGRESS",
+			want:   xpv1.Deleting(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Condition(tc.status, tc.failureReason)
+			if diff := cmp.Diff(tc.want, got, cmp.Comparer(func(a, b xpv1.Condition) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("Condition(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
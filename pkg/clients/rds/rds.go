@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
@@ -644,3 +645,32 @@ func GetConnectionDetails(in v1beta1.RDSInstance) managed.ConnectionDetails {
 		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(in.Status.AtProvider.Endpoint.Port)),
 	}
 }
+
+// instanceOperationDurations are how long the operations of a DB instance
+// typically take, by the status it is in while they are in progress.
+var instanceOperationDurations = map[string]time.Duration{
+	v1beta1.RDSInstanceStateCreating:                      15 * time.Minute,
+	v1beta1.RDSInstanceStateModifying:                     10 * time.Minute,
+	v1beta1.RDSInstanceStateBackingUp:                     10 * time.Minute,
+	v1beta1.RDSInstanceStateConfiguringEnhancedMonitoring: 5 * time.Minute,
+	v1beta1.RDSInstanceStateStorageOptimization:           2 * time.Hour,
+	"configuring-iam-database-auth":                       5 * time.Minute,
+	"configuring-log-exports":                             5 * time.Minute,
+	"converting-to-vpc":                                   15 * time.Minute,
+	"maintenance":                                         15 * time.Minute,
+	"moving-to-vpc":                                       15 * time.Minute,
+	"rebooting":                                           3 * time.Minute,
+	"renaming":                                            5 * time.Minute,
+	"resetting-master-credentials":                        5 * time.Minute,
+	"starting":                                            5 * time.Minute,
+	"stopping":                                            5 * time.Minute,
+	"upgrading":                                           30 * time.Minute,
+}
+
+// InstanceOperationDuration returns how long the operation a DB instance in
+// the supplied status is performing typically takes, and whether it is
+// performing one at all.
+func InstanceOperationDuration(status string) (time.Duration, bool) {
+	d, ok := instanceOperationDurations[status]
+	return d, ok
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redshift
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	redshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+)
+
+const (
+	// parameterSourceUser is the source of parameters that have been set
+	// explicitly instead of being left at the engine default.
+	parameterSourceUser = "user"
+)
+
+// ClusterParameterGroupClient defines Redshift client operations for
+// cluster parameter groups.
+type ClusterParameterGroupClient interface {
+	CreateClusterParameterGroup(ctx context.Context, input *redshift.CreateClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.CreateClusterParameterGroupOutput, error)
+	DescribeClusterParameterGroups(ctx context.Context, input *redshift.DescribeClusterParameterGroupsInput, opts ...func(*redshift.Options)) (*redshift.DescribeClusterParameterGroupsOutput, error)
+	DescribeClusterParameters(ctx context.Context, input *redshift.DescribeClusterParametersInput, opts ...func(*redshift.Options)) (*redshift.DescribeClusterParametersOutput, error)
+	ModifyClusterParameterGroup(ctx context.Context, input *redshift.ModifyClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.ModifyClusterParameterGroupOutput, error)
+	ResetClusterParameterGroup(ctx context.Context, input *redshift.ResetClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.ResetClusterParameterGroupOutput, error)
+	DeleteClusterParameterGroup(ctx context.Context, input *redshift.DeleteClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.DeleteClusterParameterGroupOutput, error)
+}
+
+// NewClusterParameterGroupClient creates a new Redshift cluster parameter
+// group client with provided AWS Configurations/Credentials.
+func NewClusterParameterGroupClient(cfg aws.Config) ClusterParameterGroupClient {
+	return redshift.NewFromConfig(cfg)
+}
+
+// IsClusterParameterGroupNotFound returns true if the error is because the
+// cluster parameter group doesn't exist.
+func IsClusterParameterGroupNotFound(err error) bool {
+	var nf *redshifttypes.ClusterParameterGroupNotFoundFault
+	return errors.As(err, &nf)
+}
+
+// GetUserParameters returns all parameters of the given cluster parameter
+// group that differ from the engine defaults.
+func GetUserParameters(ctx context.Context, c ClusterParameterGroupClient, name string) ([]redshifttypes.Parameter, error) {
+	var params []redshifttypes.Parameter
+	input := &redshift.DescribeClusterParametersInput{
+		ParameterGroupName: aws.String(name),
+		Source:             aws.String(parameterSourceUser),
+	}
+	for {
+		rsp, err := c.DescribeClusterParameters(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, rsp.Parameters...)
+		if aws.ToString(rsp.Marker) == "" {
+			return params, nil
+		}
+		input.Marker = rsp.Marker
+	}
+}
+
+// GenerateCreateClusterParameterGroupInput returns the input to create the
+// given cluster parameter group.
+func GenerateCreateClusterParameterGroupInput(p v1alpha1.ClusterParameterGroupParameters, name string) *redshift.CreateClusterParameterGroupInput {
+	input := &redshift.CreateClusterParameterGroupInput{
+		Description:          aws.String(p.Description),
+		ParameterGroupFamily: aws.String(p.ParameterGroupFamily),
+		ParameterGroupName:   aws.String(name),
+	}
+	if len(p.Tags) != 0 {
+		input.Tags = make([]redshifttypes.Tag, len(p.Tags))
+		for i, val := range p.Tags {
+			input.Tags[i] = redshifttypes.Tag{Key: aws.String(val.Key), Value: aws.String(val.Value)}
+		}
+	}
+	return input
+}
+
+// DiffClusterParameters returns the parameters that need to be modified and
+// the parameters that need to be reset to their defaults so that the observed
+// user parameters match the desired ones.
+func DiffClusterParameters(desired []v1alpha1.ClusterParameter, observed []redshifttypes.Parameter) (modify, reset []redshifttypes.Parameter) {
+	current := map[string]string{}
+	for _, p := range observed {
+		current[aws.ToString(p.ParameterName)] = aws.ToString(p.ParameterValue)
+	}
+	want := map[string]struct{}{}
+	for _, p := range desired {
+		want[p.ParameterName] = struct{}{}
+		if v, ok := current[p.ParameterName]; ok && v == p.ParameterValue {
+			continue
+		}
+		modify = append(modify, redshifttypes.Parameter{
+			ParameterName:  aws.String(p.ParameterName),
+			ParameterValue: aws.String(p.ParameterValue),
+		})
+	}
+	for _, p := range observed {
+		if _, ok := want[aws.ToString(p.ParameterName)]; !ok {
+			reset = append(reset, redshifttypes.Parameter{ParameterName: p.ParameterName})
+		}
+	}
+	return modify, reset
+}
+
+// IsClusterParameterGroupUpToDate returns true if the observed user
+// parameters match the desired ones.
+func IsClusterParameterGroupUpToDate(p v1alpha1.ClusterParameterGroupParameters, observed []redshifttypes.Parameter) bool {
+	modify, reset := DiffClusterParameters(p.Parameters, observed)
+	return len(modify) == 0 && len(reset) == 0
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redshift
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	redshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+)
+
+func TestDiffClusterParameters(t *testing.T) {
+	type args struct {
+		desired  []v1alpha1.ClusterParameter
+		observed []redshifttypes.Parameter
+	}
+	type want struct {
+		modify []redshifttypes.Parameter
+		reset  []redshifttypes.Parameter
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoChange": {
+			args: args{
+				desired:  []v1alpha1.ClusterParameter{{ParameterName: "require_ssl", ParameterValue: "true"}},
+				observed: []redshifttypes.Parameter{{ParameterName: aws.String("require_ssl"), ParameterValue: aws.String("true")}},
+			},
+		},
+		"ModifyChangedAndNew": {
+			args: args{
+				desired: []v1alpha1.ClusterParameter{
+					{ParameterName: "require_ssl", ParameterValue: "true"},
+					{ParameterName: "enable_user_activity_logging", ParameterValue: "true"},
+				},
+				observed: []redshifttypes.Parameter{{ParameterName: aws.String("require_ssl"), ParameterValue: aws.String("false")}},
+			},
+			want: want{
+				modify: []redshifttypes.Parameter{
+					{ParameterName: aws.String("require_ssl"), ParameterValue: aws.String("true")},
+					{ParameterName: aws.String("enable_user_activity_logging"), ParameterValue: aws.String("true")},
+				},
+			},
+		},
+		"ResetRemoved": {
+			args: args{
+				observed: []redshifttypes.Parameter{{ParameterName: aws.String("require_ssl"), ParameterValue: aws.String("true")}},
+			},
+			want: want{
+				reset: []redshifttypes.Parameter{{ParameterName: aws.String("require_ssl")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := DiffClusterParameters(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
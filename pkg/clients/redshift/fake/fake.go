@@ -28,6 +28,10 @@ type MockRedshiftClient struct {
 	MockDescribe func(ctx context.Context, input *redshift.DescribeClustersInput, opts []func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
 	MockModify   func(ctx context.Context, input *redshift.ModifyClusterInput, opts []func(*redshift.Options)) (*redshift.ModifyClusterOutput, error)
 	MockDelete   func(ctx context.Context, input *redshift.DeleteClusterInput, opts []func(*redshift.Options)) (*redshift.DeleteClusterOutput, error)
+
+	MockModifyIamRoles func(ctx context.Context, input *redshift.ModifyClusterIamRolesInput, opts []func(*redshift.Options)) (*redshift.ModifyClusterIamRolesOutput, error)
+	MockPause          func(ctx context.Context, input *redshift.PauseClusterInput, opts []func(*redshift.Options)) (*redshift.PauseClusterOutput, error)
+	MockResume         func(ctx context.Context, input *redshift.ResumeClusterInput, opts []func(*redshift.Options)) (*redshift.ResumeClusterOutput, error)
 }
 
 // DescribeClusters finds Redshift Instance by name
@@ -49,3 +53,86 @@ func (m *MockRedshiftClient) ModifyCluster(ctx context.Context, input *redshift.
 func (m *MockRedshiftClient) DeleteCluster(ctx context.Context, input *redshift.DeleteClusterInput, opts ...func(*redshift.Options)) (*redshift.DeleteClusterOutput, error) {
 	return m.MockDelete(ctx, input, opts)
 }
+
+// ModifyClusterIamRoles associates and disassociates IAM roles of a Redshift Instance
+func (m *MockRedshiftClient) ModifyClusterIamRoles(ctx context.Context, input *redshift.ModifyClusterIamRolesInput, opts ...func(*redshift.Options)) (*redshift.ModifyClusterIamRolesOutput, error) {
+	return m.MockModifyIamRoles(ctx, input, opts)
+}
+
+// PauseCluster pauses a Redshift Instance
+func (m *MockRedshiftClient) PauseCluster(ctx context.Context, input *redshift.PauseClusterInput, opts ...func(*redshift.Options)) (*redshift.PauseClusterOutput, error) {
+	return m.MockPause(ctx, input, opts)
+}
+
+// ResumeCluster resumes a paused Redshift Instance
+func (m *MockRedshiftClient) ResumeCluster(ctx context.Context, input *redshift.ResumeClusterInput, opts ...func(*redshift.Options)) (*redshift.ResumeClusterOutput, error) {
+	return m.MockResume(ctx, input, opts)
+}
+
+// MockClusterParameterGroupClient for testing.
+type MockClusterParameterGroupClient struct {
+	MockCreate         func(ctx context.Context, input *redshift.CreateClusterParameterGroupInput, opts []func(*redshift.Options)) (*redshift.CreateClusterParameterGroupOutput, error)
+	MockDescribe       func(ctx context.Context, input *redshift.DescribeClusterParameterGroupsInput, opts []func(*redshift.Options)) (*redshift.DescribeClusterParameterGroupsOutput, error)
+	MockDescribeParams func(ctx context.Context, input *redshift.DescribeClusterParametersInput, opts []func(*redshift.Options)) (*redshift.DescribeClusterParametersOutput, error)
+	MockModify         func(ctx context.Context, input *redshift.ModifyClusterParameterGroupInput, opts []func(*redshift.Options)) (*redshift.ModifyClusterParameterGroupOutput, error)
+	MockReset          func(ctx context.Context, input *redshift.ResetClusterParameterGroupInput, opts []func(*redshift.Options)) (*redshift.ResetClusterParameterGroupOutput, error)
+	MockDelete         func(ctx context.Context, input *redshift.DeleteClusterParameterGroupInput, opts []func(*redshift.Options)) (*redshift.DeleteClusterParameterGroupOutput, error)
+}
+
+// CreateClusterParameterGroup mocks CreateClusterParameterGroup method
+func (m *MockClusterParameterGroupClient) CreateClusterParameterGroup(ctx context.Context, input *redshift.CreateClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.CreateClusterParameterGroupOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeClusterParameterGroups mocks DescribeClusterParameterGroups method
+func (m *MockClusterParameterGroupClient) DescribeClusterParameterGroups(ctx context.Context, input *redshift.DescribeClusterParameterGroupsInput, opts ...func(*redshift.Options)) (*redshift.DescribeClusterParameterGroupsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DescribeClusterParameters mocks DescribeClusterParameters method
+func (m *MockClusterParameterGroupClient) DescribeClusterParameters(ctx context.Context, input *redshift.DescribeClusterParametersInput, opts ...func(*redshift.Options)) (*redshift.DescribeClusterParametersOutput, error) {
+	return m.MockDescribeParams(ctx, input, opts)
+}
+
+// ModifyClusterParameterGroup mocks ModifyClusterParameterGroup method
+func (m *MockClusterParameterGroupClient) ModifyClusterParameterGroup(ctx context.Context, input *redshift.ModifyClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.ModifyClusterParameterGroupOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// ResetClusterParameterGroup mocks ResetClusterParameterGroup method
+func (m *MockClusterParameterGroupClient) ResetClusterParameterGroup(ctx context.Context, input *redshift.ResetClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.ResetClusterParameterGroupOutput, error) {
+	return m.MockReset(ctx, input, opts)
+}
+
+// DeleteClusterParameterGroup mocks DeleteClusterParameterGroup method
+func (m *MockClusterParameterGroupClient) DeleteClusterParameterGroup(ctx context.Context, input *redshift.DeleteClusterParameterGroupInput, opts ...func(*redshift.Options)) (*redshift.DeleteClusterParameterGroupOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// MockSnapshotScheduleClient for testing.
+type MockSnapshotScheduleClient struct {
+	MockCreate   func(ctx context.Context, input *redshift.CreateSnapshotScheduleInput, opts []func(*redshift.Options)) (*redshift.CreateSnapshotScheduleOutput, error)
+	MockDescribe func(ctx context.Context, input *redshift.DescribeSnapshotSchedulesInput, opts []func(*redshift.Options)) (*redshift.DescribeSnapshotSchedulesOutput, error)
+	MockModify   func(ctx context.Context, input *redshift.ModifySnapshotScheduleInput, opts []func(*redshift.Options)) (*redshift.ModifySnapshotScheduleOutput, error)
+	MockDelete   func(ctx context.Context, input *redshift.DeleteSnapshotScheduleInput, opts []func(*redshift.Options)) (*redshift.DeleteSnapshotScheduleOutput, error)
+}
+
+// CreateSnapshotSchedule mocks CreateSnapshotSchedule method
+func (m *MockSnapshotScheduleClient) CreateSnapshotSchedule(ctx context.Context, input *redshift.CreateSnapshotScheduleInput, opts ...func(*redshift.Options)) (*redshift.CreateSnapshotScheduleOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeSnapshotSchedules mocks DescribeSnapshotSchedules method
+func (m *MockSnapshotScheduleClient) DescribeSnapshotSchedules(ctx context.Context, input *redshift.DescribeSnapshotSchedulesInput, opts ...func(*redshift.Options)) (*redshift.DescribeSnapshotSchedulesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifySnapshotSchedule mocks ModifySnapshotSchedule method
+func (m *MockSnapshotScheduleClient) ModifySnapshotSchedule(ctx context.Context, input *redshift.ModifySnapshotScheduleInput, opts ...func(*redshift.Options)) (*redshift.ModifySnapshotScheduleOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteSnapshotSchedule mocks DeleteSnapshotSchedule method
+func (m *MockSnapshotScheduleClient) DeleteSnapshotSchedule(ctx context.Context, input *redshift.DeleteSnapshotScheduleInput, opts ...func(*redshift.Options)) (*redshift.DeleteSnapshotScheduleOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
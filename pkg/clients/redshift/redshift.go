@@ -37,12 +37,21 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	// iamRoleStatusRemoving is the apply status of an IAM role that is being
+	// disassociated from the cluster.
+	iamRoleStatusRemoving = "removing"
+)
+
 // Client defines Redshift client operations
 type Client interface {
 	DescribeClusters(ctx context.Context, input *redshift.DescribeClustersInput, opts ...func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
 	CreateCluster(ctx context.Context, input *redshift.CreateClusterInput, opts ...func(*redshift.Options)) (*redshift.CreateClusterOutput, error)
 	ModifyCluster(ctx context.Context, input *redshift.ModifyClusterInput, opts ...func(*redshift.Options)) (*redshift.ModifyClusterOutput, error)
 	DeleteCluster(ctx context.Context, input *redshift.DeleteClusterInput, opts ...func(*redshift.Options)) (*redshift.DeleteClusterOutput, error)
+	ModifyClusterIamRoles(ctx context.Context, input *redshift.ModifyClusterIamRolesInput, opts ...func(*redshift.Options)) (*redshift.ModifyClusterIamRolesOutput, error)
+	PauseCluster(ctx context.Context, input *redshift.PauseClusterInput, opts ...func(*redshift.Options)) (*redshift.PauseClusterOutput, error)
+	ResumeCluster(ctx context.Context, input *redshift.ResumeClusterInput, opts ...func(*redshift.Options)) (*redshift.ResumeClusterOutput, error)
 }
 
 // NewClient creates new Redshift Client with provided AWS Configurations/Credentials
@@ -102,12 +111,8 @@ func LateInitialize(in *v1alpha1.ClusterParameters, cl *redshifttypes.Cluster) {
 		}
 		in.ClusterSecurityGroups = s
 	}
-	if len(cl.IamRoles) != 0 {
-		s := make([]string, len(cl.IamRoles))
-		for i, v := range cl.IamRoles {
-			s[i] = aws.ToString(v.IamRoleArn)
-		}
-		in.IAMRoles = s
+	if len(in.IAMRoles) == 0 && len(cl.IamRoles) != 0 {
+		in.IAMRoles = associatedIAMRoles(cl.IamRoles)
 	}
 	if len(cl.Tags) != 0 {
 		s := make([]v1alpha1.Tag, len(cl.Tags))
@@ -133,6 +138,16 @@ func IsUpToDate(p v1alpha1.ClusterParameters, cl redshifttypes.Cluster) (bool, e
 	// We need to check it explicitly as redshift.Cluster can have multiple ClusterParameterGroups
 	found := isClusterParameterGroupNameUpdated(p.ClusterParameterGroupName, cl.ClusterParameterGroups)
 
+	// IAM roles are associated through a separate API call, so we compare
+	// them as a set instead of relying on the JSON patch below.
+	if add, remove := DiffIAMRoles(p.IAMRoles, cl.IamRoles); len(add) != 0 || len(remove) != 0 {
+		return false, nil
+	}
+
+	if !IsStateUpToDate(p.DesiredState, aws.ToString(cl.ClusterStatus)) {
+		return false, nil
+	}
+
 	// Check if it is a cluster rename request
 	if p.NewClusterIdentifier != nil && (aws.ToString(p.NewClusterIdentifier) != aws.ToString(cl.ClusterIdentifier)) {
 		return false, nil
@@ -152,7 +167,7 @@ func IsUpToDate(p v1alpha1.ClusterParameters, cl redshifttypes.Cluster) (bool, e
 	}
 	updated := cmp.Equal(&v1alpha1.ClusterParameters{}, patch,
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
-		cmpopts.IgnoreFields(v1alpha1.ClusterParameters{}, "Region"))
+		cmpopts.IgnoreFields(v1alpha1.ClusterParameters{}, "Region", "IAMRoles"))
 	return updated && found, nil
 }
 
@@ -163,6 +178,7 @@ func initializeModifyandDeleteParameters(orig *v1alpha1.ClusterParameters, new *
 	new.FinalClusterSnapshotRetentionPeriod = orig.FinalClusterSnapshotRetentionPeriod
 	new.NewClusterIdentifier = orig.NewClusterIdentifier
 	new.SkipFinalClusterSnapshot = orig.SkipFinalClusterSnapshot
+	new.DesiredState = orig.DesiredState
 	return new
 }
 
@@ -191,6 +207,19 @@ func IsNotFound(err error) bool {
 	return errors.As(err, &cnff)
 }
 
+// IsStateUpToDate returns false if the cluster has settled in a status other
+// than the desired state. Clusters that are still pausing or resuming are
+// considered up to date.
+func IsStateUpToDate(desired *string, status string) bool {
+	switch aws.ToString(desired) {
+	case v1alpha1.StateAvailable:
+		return status != v1alpha1.StatePaused
+	case v1alpha1.StatePaused:
+		return status != v1alpha1.StateAvailable
+	}
+	return true
+}
+
 // GenerateCreateClusterInput from RedshiftSpec
 func GenerateCreateClusterInput(p *v1alpha1.ClusterParameters, cid, pw *string) *redshift.CreateClusterInput {
 	var tags []redshifttypes.Tag
@@ -316,6 +345,57 @@ func GenerateModifyClusterInput(p *v1alpha1.ClusterParameters, cl redshifttypes.
 	return o
 }
 
+// GenerateModifyClusterIamRolesInput returns the input needed to bring the
+// IAM roles associated with the cluster in line with the desired ones. It
+// returns nil if there is nothing to associate or disassociate.
+func GenerateModifyClusterIamRolesInput(p *v1alpha1.ClusterParameters, cl redshifttypes.Cluster) *redshift.ModifyClusterIamRolesInput {
+	add, remove := DiffIAMRoles(p.IAMRoles, cl.IamRoles)
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	return &redshift.ModifyClusterIamRolesInput{
+		ClusterIdentifier: cl.ClusterIdentifier,
+		AddIamRoles:       add,
+		RemoveIamRoles:    remove,
+	}
+}
+
+// DiffIAMRoles returns the IAM role ARNs that need to be associated with and
+// disassociated from the cluster so that it matches the desired list. Roles
+// that are already being removed are not considered associated.
+func DiffIAMRoles(desired []string, observed []redshifttypes.ClusterIamRole) (add, remove []string) {
+	current := map[string]struct{}{}
+	for _, arn := range associatedIAMRoles(observed) {
+		current[arn] = struct{}{}
+	}
+	want := map[string]struct{}{}
+	for _, arn := range desired {
+		want[arn] = struct{}{}
+		if _, ok := current[arn]; !ok {
+			add = append(add, arn)
+		}
+	}
+	for _, arn := range associatedIAMRoles(observed) {
+		if _, ok := want[arn]; !ok {
+			remove = append(remove, arn)
+		}
+	}
+	return add, remove
+}
+
+// associatedIAMRoles returns the ARNs of the IAM roles that are associated, or
+// in the process of being associated, with the cluster.
+func associatedIAMRoles(in []redshifttypes.ClusterIamRole) []string {
+	var s []string
+	for _, v := range in {
+		if aws.ToString(v.ApplyStatus) == iamRoleStatusRemoving {
+			continue
+		}
+		s = append(s, aws.ToString(v.IamRoleArn))
+	}
+	return s
+}
+
 // GenerateDeleteClusterInput from RedshiftSpec
 func GenerateDeleteClusterInput(p *v1alpha1.ClusterParameters, cid *string) *redshift.DeleteClusterInput {
 	return &redshift.DeleteClusterInput{
@@ -216,6 +216,41 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"DifferentIAMRoles": {
+			args: args{
+				cl: redshifttypes.Cluster{
+					NodeType:          &nodeType,
+					ClusterIdentifier: aws.String(""),
+					NumberOfNodes:     1,
+					IamRoles:          []redshifttypes.ClusterIamRole{{IamRoleArn: aws.String("arn:role-a")}},
+				},
+				p: v1alpha1.ClusterParameters{
+					NodeType:    nodeType,
+					ClusterType: &singleNode,
+					IAMRoles:    []string{"arn:role-b"},
+				},
+			},
+			want: false,
+		},
+		"SameIAMRolesDifferentOrder": {
+			args: args{
+				cl: redshifttypes.Cluster{
+					NodeType:          &nodeType,
+					ClusterIdentifier: aws.String(""),
+					NumberOfNodes:     1,
+					IamRoles: []redshifttypes.ClusterIamRole{
+						{IamRoleArn: aws.String("arn:role-a")},
+						{IamRoleArn: aws.String("arn:role-b")},
+					},
+				},
+				p: v1alpha1.ClusterParameters{
+					NodeType:    nodeType,
+					ClusterType: &singleNode,
+					IAMRoles:    []string{"arn:role-b", "arn:role-a"},
+				},
+			},
+			want: true,
+		},
 		"IgnoresRefs": {
 			args: args{
 				cl: redshifttypes.Cluster{
@@ -241,6 +276,48 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestIsStateUpToDate(t *testing.T) {
+	type args struct {
+		desired *string
+		status  string
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{status: v1alpha1.StatePaused},
+			want: true,
+		},
+		"PauseDesired": {
+			args: args{desired: aws.String(v1alpha1.StatePaused), status: v1alpha1.StateAvailable},
+			want: false,
+		},
+		"Pausing": {
+			args: args{desired: aws.String(v1alpha1.StatePaused), status: v1alpha1.StatePausing},
+			want: true,
+		},
+		"ResumeDesired": {
+			args: args{desired: aws.String(v1alpha1.StateAvailable), status: v1alpha1.StatePaused},
+			want: false,
+		},
+		"Available": {
+			args: args{desired: aws.String(v1alpha1.StateAvailable), status: v1alpha1.StateAvailable},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsStateUpToDate(tc.args.desired, tc.args.status)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitialize(t *testing.T) {
 	type args struct {
 		in *v1alpha1.ClusterParameters
@@ -486,6 +563,60 @@ func TestGenerateModifyClusterInput(t *testing.T) {
 	}
 }
 
+func TestGenerateModifyClusterIamRolesInput(t *testing.T) {
+	type args struct {
+		in *v1alpha1.ClusterParameters
+		cl redshifttypes.Cluster
+	}
+	cases := map[string]struct {
+		args args
+		want *redshift.ModifyClusterIamRolesInput
+	}{
+		"NoChange": {
+			args: args{
+				in: &v1alpha1.ClusterParameters{IAMRoles: []string{"arn:role-a"}},
+				cl: *cluster(func(c *redshifttypes.Cluster) {
+					c.IamRoles = []redshifttypes.ClusterIamRole{{IamRoleArn: aws.String("arn:role-a"), ApplyStatus: aws.String("in-sync")}}
+				}),
+			},
+		},
+		"AddAndRemove": {
+			args: args{
+				in: &v1alpha1.ClusterParameters{IAMRoles: []string{"arn:role-a", "arn:role-c"}},
+				cl: *cluster(func(c *redshifttypes.Cluster) {
+					c.ClusterIdentifier = aws.String("test")
+					c.IamRoles = []redshifttypes.ClusterIamRole{
+						{IamRoleArn: aws.String("arn:role-a"), ApplyStatus: aws.String("in-sync")},
+						{IamRoleArn: aws.String("arn:role-b"), ApplyStatus: aws.String("in-sync")},
+					}
+				}),
+			},
+			want: &redshift.ModifyClusterIamRolesInput{
+				ClusterIdentifier: aws.String("test"),
+				AddIamRoles:       []string{"arn:role-c"},
+				RemoveIamRoles:    []string{"arn:role-b"},
+			},
+		},
+		"IgnoresRolesBeingRemoved": {
+			args: args{
+				in: &v1alpha1.ClusterParameters{},
+				cl: *cluster(func(c *redshifttypes.Cluster) {
+					c.IamRoles = []redshifttypes.ClusterIamRole{{IamRoleArn: aws.String("arn:role-a"), ApplyStatus: aws.String("removing")}}
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyClusterIamRolesInput(tc.args.in, tc.args.cl)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("GenerateModifyClusterIamRolesInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDeleteClusterInput(t *testing.T) {
 	cases := map[string]struct {
 		in  *v1alpha1.ClusterParameters
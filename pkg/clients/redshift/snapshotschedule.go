@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redshift
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	redshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+)
+
+// SnapshotScheduleClient defines Redshift client operations for snapshot
+// schedules.
+type SnapshotScheduleClient interface {
+	CreateSnapshotSchedule(ctx context.Context, input *redshift.CreateSnapshotScheduleInput, opts ...func(*redshift.Options)) (*redshift.CreateSnapshotScheduleOutput, error)
+	DescribeSnapshotSchedules(ctx context.Context, input *redshift.DescribeSnapshotSchedulesInput, opts ...func(*redshift.Options)) (*redshift.DescribeSnapshotSchedulesOutput, error)
+	ModifySnapshotSchedule(ctx context.Context, input *redshift.ModifySnapshotScheduleInput, opts ...func(*redshift.Options)) (*redshift.ModifySnapshotScheduleOutput, error)
+	DeleteSnapshotSchedule(ctx context.Context, input *redshift.DeleteSnapshotScheduleInput, opts ...func(*redshift.Options)) (*redshift.DeleteSnapshotScheduleOutput, error)
+}
+
+// NewSnapshotScheduleClient creates a new Redshift snapshot schedule client
+// with provided AWS Configurations/Credentials.
+func NewSnapshotScheduleClient(cfg aws.Config) SnapshotScheduleClient {
+	return redshift.NewFromConfig(cfg)
+}
+
+// IsSnapshotScheduleNotFound returns true if the error is because the
+// snapshot schedule doesn't exist.
+func IsSnapshotScheduleNotFound(err error) bool {
+	var nf *redshifttypes.SnapshotScheduleNotFoundFault
+	return errors.As(err, &nf)
+}
+
+// GenerateCreateSnapshotScheduleInput returns the input to create the given
+// snapshot schedule.
+func GenerateCreateSnapshotScheduleInput(p v1alpha1.SnapshotScheduleParameters, id string) *redshift.CreateSnapshotScheduleInput {
+	input := &redshift.CreateSnapshotScheduleInput{
+		ScheduleDefinitions: p.Definitions,
+		ScheduleDescription: p.Description,
+		ScheduleIdentifier:  aws.String(id),
+	}
+	if len(p.Tags) != 0 {
+		input.Tags = make([]redshifttypes.Tag, len(p.Tags))
+		for i, val := range p.Tags {
+			input.Tags[i] = redshifttypes.Tag{Key: aws.String(val.Key), Value: aws.String(val.Value)}
+		}
+	}
+	return input
+}
+
+// GenerateSnapshotScheduleObservation is used to produce
+// v1alpha1.SnapshotScheduleObservation from redshift.SnapshotSchedule.
+func GenerateSnapshotScheduleObservation(in redshifttypes.SnapshotSchedule) v1alpha1.SnapshotScheduleObservation {
+	o := v1alpha1.SnapshotScheduleObservation{
+		AssociatedClusterCount: aws.ToInt32(in.AssociatedClusterCount),
+	}
+	for _, c := range in.AssociatedClusters {
+		o.AssociatedClusters = append(o.AssociatedClusters, aws.ToString(c.ClusterIdentifier))
+	}
+	for _, t := range in.NextInvocations {
+		o.NextInvocations = append(o.NextInvocations, metav1.NewTime(t))
+	}
+	return o
+}
+
+// IsSnapshotScheduleUpToDate returns true if the observed schedule
+// definitions match the desired ones, regardless of their order.
+func IsSnapshotScheduleUpToDate(p v1alpha1.SnapshotScheduleParameters, in redshifttypes.SnapshotSchedule) bool {
+	if len(p.Definitions) != len(in.ScheduleDefinitions) {
+		return false
+	}
+	desired := append([]string{}, p.Definitions...)
+	observed := append([]string{}, in.ScheduleDefinitions...)
+	sort.Strings(desired)
+	sort.Strings(observed)
+	for i := range desired {
+		if desired[i] != observed[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redshift
+
+import (
+	"testing"
+
+	redshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+)
+
+func TestIsSnapshotScheduleUpToDate(t *testing.T) {
+	type args struct {
+		p  v1alpha1.SnapshotScheduleParameters
+		in redshifttypes.SnapshotSchedule
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameDefinitionsDifferentOrder": {
+			args: args{
+				p:  v1alpha1.SnapshotScheduleParameters{Definitions: []string{"rate(12 hours)", "cron(0 3 * * ? *)"}},
+				in: redshifttypes.SnapshotSchedule{ScheduleDefinitions: []string{"cron(0 3 * * ? *)", "rate(12 hours)"}},
+			},
+			want: true,
+		},
+		"ChangedDefinition": {
+			args: args{
+				p:  v1alpha1.SnapshotScheduleParameters{Definitions: []string{"rate(6 hours)"}},
+				in: redshifttypes.SnapshotSchedule{ScheduleDefinitions: []string{"rate(12 hours)"}},
+			},
+			want: false,
+		},
+		"AddedDefinition": {
+			args: args{
+				p:  v1alpha1.SnapshotScheduleParameters{Definitions: []string{"rate(12 hours)", "cron(0 3 * * ? *)"}},
+				in: redshifttypes.SnapshotSchedule{ScheduleDefinitions: []string{"rate(12 hours)"}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSnapshotScheduleUpToDate(tc.args.p, tc.args.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSnapshotScheduleUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
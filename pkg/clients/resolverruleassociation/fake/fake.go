@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+)
+
+// MockResolverRuleAssociationClient is a type that implements all the methods for ResolverRuleAssociation Client interface
+type MockResolverRuleAssociationClient struct {
+	MockAssociateResolverRule      func(ctx context.Context, input *route53resolver.AssociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.AssociateResolverRuleOutput, error)
+	MockDisassociateResolverRule   func(ctx context.Context, input *route53resolver.DisassociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.DisassociateResolverRuleOutput, error)
+	MockGetResolverRuleAssociation func(ctx context.Context, input *route53resolver.GetResolverRuleAssociationInput, opts []func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error)
+}
+
+// AssociateResolverRule mocks AssociateResolverRule method
+func (m *MockResolverRuleAssociationClient) AssociateResolverRule(ctx context.Context, input *route53resolver.AssociateResolverRuleInput, opts ...func(*route53resolver.Options)) (*route53resolver.AssociateResolverRuleOutput, error) {
+	return m.MockAssociateResolverRule(ctx, input, opts)
+}
+
+// DisassociateResolverRule mocks DisassociateResolverRule method
+func (m *MockResolverRuleAssociationClient) DisassociateResolverRule(ctx context.Context, input *route53resolver.DisassociateResolverRuleInput, opts ...func(*route53resolver.Options)) (*route53resolver.DisassociateResolverRuleOutput, error) {
+	return m.MockDisassociateResolverRule(ctx, input, opts)
+}
+
+// GetResolverRuleAssociation mocks GetResolverRuleAssociation method
+func (m *MockResolverRuleAssociationClient) GetResolverRuleAssociation(ctx context.Context, input *route53resolver.GetResolverRuleAssociationInput, opts ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error) {
+	return m.MockGetResolverRuleAssociation(ctx, input, opts)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcerecordset
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+const (
+	// DefaultBatchWindow is how long a Batcher waits for further changes to
+	// the same hosted zone before submitting them.
+	DefaultBatchWindow = 2 * time.Second
+
+	// maxBatchChanges is the number of changes Route53 accepts in a single
+	// ChangeResourceRecordSets call.
+	maxBatchChanges = 1000
+
+	batchTimeout = time.Minute
+)
+
+// A Batcher coalesces the changes that concurrently reconciled
+// ResourceRecordSets make to the same hosted zone into a single
+// ChangeResourceRecordSets call. Route53 rejects a change to a hosted zone
+// while a previous one is still being applied, so record sets that are
+// changed independently keep failing each other with
+// PriorRequestNotComplete.
+type Batcher struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*batch
+	zones   map[string]*sync.Mutex
+}
+
+type batch struct {
+	client  Client
+	zoneID  *string
+	changes []route53types.Change
+	errs    []error
+	done    chan struct{}
+}
+
+// NewBatcher returns a Batcher that collects changes for the supplied
+// window before submitting them.
+func NewBatcher(window time.Duration) *Batcher {
+	return &Batcher{
+		window:  window,
+		pending: map[string]*batch{},
+		zones:   map[string]*sync.Mutex{},
+	}
+}
+
+// Change submits the changes of the supplied input together with the other
+// changes made to the same hosted zone within the batch window, and returns
+// once they have been applied. Only changes made with the same account are
+// batched with each other, since the client of the first one is used to
+// submit the batch.
+func (b *Batcher) Change(ctx context.Context, account string, c Client, in *route53.ChangeResourceRecordSetsInput) error {
+	key := account + "/" + aws.ToString(in.HostedZoneId)
+
+	b.mu.Lock()
+	bt, ok := b.pending[key]
+	if !ok || len(bt.changes)+len(in.ChangeBatch.Changes) > maxBatchChanges {
+		bt = &batch{client: c, zoneID: in.HostedZoneId, done: make(chan struct{})}
+		b.pending[key] = bt
+		time.AfterFunc(b.window, func() { b.flush(key, bt) })
+	}
+	first := len(bt.changes)
+	bt.changes = append(bt.changes, in.ChangeBatch.Changes...)
+	last := len(bt.changes)
+	b.mu.Unlock()
+
+	select {
+	case <-bt.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	for _, err := range bt.errs[first:last] {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Batcher) flush(key string, bt *batch) {
+	b.mu.Lock()
+	if b.pending[key] == bt {
+		delete(b.pending, key)
+	}
+	zone, ok := b.zones[key]
+	if !ok {
+		zone = &sync.Mutex{}
+		b.zones[key] = zone
+	}
+	b.mu.Unlock()
+
+	// Batches for the same hosted zone are submitted one at a time.
+	zone.Lock()
+	defer zone.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchTimeout)
+	defer cancel()
+
+	bt.errs = make([]error, len(bt.changes))
+	err := bt.submit(ctx, bt.changes)
+	if err != nil && len(bt.changes) > 1 {
+		// A batch is applied atomically, so a single invalid change fails
+		// all others. Submit the changes one by one so that each of them
+		// succeeds or fails on its own.
+		for i := range bt.changes {
+			bt.errs[i] = bt.submit(ctx, bt.changes[i:i+1])
+		}
+	} else {
+		for i := range bt.errs {
+			bt.errs[i] = err
+		}
+	}
+	close(bt.done)
+}
+
+func (bt *batch) submit(ctx context.Context, changes []route53types.Change) error {
+	_, err := bt.client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: bt.zoneID,
+		ChangeBatch:  &route53types.ChangeBatch{Changes: changes},
+	})
+	return err
+}
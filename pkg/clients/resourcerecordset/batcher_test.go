@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcerecordset
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
+)
+
+func TestBatcherChange(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	input := func(zone string, names ...string) *route53.ChangeResourceRecordSetsInput {
+		in := &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zone),
+			ChangeBatch:  &route53types.ChangeBatch{},
+		}
+		for _, n := range names {
+			in.ChangeBatch.Changes = append(in.ChangeBatch.Changes, route53types.Change{
+				Action:            route53types.ChangeActionUpsert,
+				ResourceRecordSet: &route53types.ResourceRecordSet{Name: aws.String(n)},
+			})
+		}
+		return in
+	}
+
+	type want struct {
+		errs  []error
+		calls int
+	}
+
+	cases := map[string]struct {
+		inputs  []*route53.ChangeResourceRecordSetsInput
+		invalid string
+		want    want
+	}{
+		"SameZone": {
+			inputs: []*route53.ChangeResourceRecordSetsInput{input("a", "x"), input("a", "y"), input("a", "z")},
+			want: want{
+				errs:  []error{nil, nil, nil},
+				calls: 1,
+			},
+		},
+		"DifferentZones": {
+			inputs: []*route53.ChangeResourceRecordSetsInput{input("a", "x"), input("b", "y")},
+			want: want{
+				errs:  []error{nil, nil},
+				calls: 2,
+			},
+		},
+		"InvalidChange": {
+			inputs:  []*route53.ChangeResourceRecordSetsInput{input("a", "x"), input("a", "y")},
+			invalid: "y",
+			want: want{
+				errs: []error{nil, errBoom},
+				// The failed batch is followed by one call per change.
+				calls: 3,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mu := sync.Mutex{}
+			calls := 0
+			c := &fake.MockResourceRecordSetClient{
+				MockChangeResourceRecordSets: func(_ context.Context, in *route53.ChangeResourceRecordSetsInput, _ []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					calls++
+					for _, ch := range in.ChangeBatch.Changes {
+						if aws.ToString(ch.ResourceRecordSet.Name) == tc.invalid {
+							return nil, errBoom
+						}
+					}
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				},
+			}
+
+			b := NewBatcher(100 * time.Millisecond)
+			errs := make([]error, len(tc.inputs))
+			wg := sync.WaitGroup{}
+			for i, in := range tc.inputs {
+				wg.Add(1)
+				go func(i int, in *route53.ChangeResourceRecordSetsInput) {
+					defer wg.Done()
+					errs[i] = b.Change(context.Background(), "default", c, in)
+				}(i, in)
+			}
+			wg.Wait()
+
+			if diff := cmp.Diff(tc.want.errs, errs, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
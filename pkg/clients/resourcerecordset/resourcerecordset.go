@@ -69,19 +69,14 @@ func NewClient(cfg aws.Config) Client {
 // GetResourceRecordSet returns recordSet if present or err
 func GetResourceRecordSet(ctx context.Context, name string, params v1alpha1.ResourceRecordSetParameters, c Client) (*route53types.ResourceRecordSet, error) {
 	res, err := c.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
-		HostedZoneId:    params.ZoneID,
-		StartRecordName: &name,
-		StartRecordType: route53types.RRType(params.Type),
+		HostedZoneId:          params.ZoneID,
+		StartRecordName:       &name,
+		StartRecordType:       route53types.RRType(params.Type),
+		StartRecordIdentifier: params.SetIdentifier,
 	})
 	if err != nil {
 		return nil, err
 	}
-	appendDot := func(s string) string {
-		if !strings.HasSuffix(s, ".") {
-			return fmt.Sprintf("%s.", s)
-		}
-		return s
-	}
 	for _, rr := range res.ResourceRecordSets {
 		if appendDot(aws.ToString(rr.Name)) == appendDot(name) &&
 			string(rr.Type) == params.Type &&
@@ -92,6 +87,13 @@ func GetResourceRecordSet(ctx context.Context, name string, params v1alpha1.Reso
 	return nil, &NotFoundError{}
 }
 
+func appendDot(s string) string {
+	if !strings.HasSuffix(s, ".") {
+		return fmt.Sprintf("%s.", s)
+	}
+	return s
+}
+
 // GenerateChangeResourceRecordSetsInput prepares input for a ChangeResourceRecordSetsInput
 func GenerateChangeResourceRecordSetsInput(name string, p v1alpha1.ResourceRecordSetParameters, action route53types.ChangeAction) *route53.ChangeResourceRecordSetsInput {
 	r := &route53types.ResourceRecordSet{
@@ -146,8 +148,7 @@ func IsUpToDate(p v1alpha1.ResourceRecordSetParameters, rrset route53types.Resou
 		return false, err
 	}
 	return cmp.Equal(&v1alpha1.ResourceRecordSetParameters{}, patch,
-		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
-		cmpopts.IgnoreFields(v1alpha1.ResourceRecordSetParameters{}, "Region")), nil
+		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{})), nil
 }
 
 // LateInitialize fills the empty fields in *v1alpha1.ResourceRecordSetParameters with
@@ -176,6 +177,14 @@ func CreatePatch(in *route53types.ResourceRecordSet, target *v1alpha1.ResourceRe
 	currentParams := &v1alpha1.ResourceRecordSetParameters{}
 	LateInitialize(currentParams, in)
 
+	setRoutingPolicy(currentParams, in)
+
+	// Route53 returns fully qualified alias targets in lower case.
+	if currentParams.AliasTarget != nil && target.AliasTarget != nil &&
+		appendDot(strings.ToLower(currentParams.AliasTarget.DNSName)) == appendDot(strings.ToLower(target.AliasTarget.DNSName)) {
+		currentParams.AliasTarget.DNSName = target.AliasTarget.DNSName
+	}
+
 	// ZoneID doesn't exist in *route53types.ResourceRecordSet object, so, we have to
 	// skip its comparison.
 	currentParams.ZoneID = target.ZoneID
@@ -190,3 +199,34 @@ func CreatePatch(in *route53types.ResourceRecordSet, target *v1alpha1.ResourceRe
 	}
 	return patch, nil
 }
+
+// setRoutingPolicy fills the routing policy of the supplied
+// *route53types.ResourceRecordSet into *v1alpha1.ResourceRecordSetParameters.
+// They are compared rather than late initialized so that a routing policy
+// changed outside of Crossplane is reverted.
+func setRoutingPolicy(in *v1alpha1.ResourceRecordSetParameters, rrSet *route53types.ResourceRecordSet) {
+	if rrSet == nil || in == nil {
+		return
+	}
+	in.SetIdentifier = rrSet.SetIdentifier
+	in.Weight = rrSet.Weight
+	in.Region = string(rrSet.Region)
+	in.Failover = string(rrSet.Failover)
+	in.HealthCheckID = rrSet.HealthCheckId
+	in.MultiValueAnswer = rrSet.MultiValueAnswer
+	in.TrafficPolicyInstanceID = rrSet.TrafficPolicyInstanceId
+	if rrSet.GeoLocation != nil {
+		in.GeoLocation = &v1alpha1.GeoLocation{
+			ContinentCode:   rrSet.GeoLocation.ContinentCode,
+			CountryCode:     rrSet.GeoLocation.CountryCode,
+			SubdivisionCode: rrSet.GeoLocation.SubdivisionCode,
+		}
+	}
+	if rrSet.AliasTarget != nil {
+		in.AliasTarget = &v1alpha1.AliasTarget{
+			DNSName:              awsclients.StringValue(rrSet.AliasTarget.DNSName),
+			EvaluateTargetHealth: rrSet.AliasTarget.EvaluateTargetHealth,
+			HostedZoneID:         awsclients.StringValue(rrSet.AliasTarget.HostedZoneId),
+		}
+	}
+}
@@ -19,6 +19,7 @@ package resourcerecordset
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 
@@ -125,6 +126,102 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"DifferentWeight": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("blue"),
+					Weight:        aws.Int64(10),
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("blue"),
+					Weight:        aws.Int64(90),
+				},
+			},
+			want: false,
+		},
+		"SameLatencyRegion": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("eu"),
+					Region:        route53types.ResourceRecordSetRegionEuWest1,
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("eu"),
+					Region:        "eu-west-1",
+				},
+			},
+			want: true,
+		},
+		"DifferentFailover": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("primary"),
+					Failover:      route53types.ResourceRecordSetFailoverSecondary,
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("primary"),
+					Failover:      "PRIMARY",
+				},
+			},
+			want: false,
+		},
+		"DifferentGeoLocation": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					SetIdentifier: aws.String("de"),
+					GeoLocation:   &route53types.GeoLocation{CountryCode: aws.String("DE")},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					SetIdentifier: aws.String("de"),
+					GeoLocation:   &v1alpha1.GeoLocation{CountryCode: aws.String("FR")},
+				},
+			},
+			want: false,
+		},
+		"DifferentHealthCheck": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name:          &resourceRecordSetName,
+					TTL:           &ttl,
+					HealthCheckId: aws.String("abc"),
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					TTL:           &ttl,
+					HealthCheckID: aws.String("def"),
+				},
+			},
+			want: false,
+		},
+		"SameAliasTarget": {
+			args: args{
+				rrSet: route53types.ResourceRecordSet{
+					Name: &resourceRecordSetName,
+					AliasTarget: &route53types.AliasTarget{
+						DNSName:      aws.String("d111111abcdef8.cloudfront.net."),
+						HostedZoneId: aws.String("Z2FDTNDATAQYW2"),
+					},
+				},
+				p: v1alpha1.ResourceRecordSetParameters{
+					AliasTarget: &v1alpha1.AliasTarget{
+						DNSName:      "D111111abcdef8.cloudfront.net",
+						HostedZoneID: "Z2FDTNDATAQYW2",
+					},
+				},
+			},
+			want: true,
+		},
 		"IgnoresRefs": {
 			args: args{
 				rrSet: route53types.ResourceRecordSet{
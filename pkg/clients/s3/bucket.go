@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -154,6 +155,75 @@ func GenerateBucketObservation(name string) v1beta1.BucketExternalStatus {
 	}
 }
 
+// websiteHostedZoneIDs are the IDs of the Route53 hosted zones of the S3
+// website endpoints per region.
+// See https://docs.aws.amazon.com/general/latest/gr/s3.html#s3_website_region_endpoints
+var websiteHostedZoneIDs = map[string]string{
+	"af-south-1":     "Z83WF9RJE8B12",
+	"ap-east-1":      "ZNB98KWMFR0R6",
+	"ap-northeast-1": "Z2M4EHUR26P7ZW",
+	"ap-northeast-2": "Z3W03O7B5YMIYP",
+	"ap-northeast-3": "Z2YQB5RD63NC85",
+	"ap-south-1":     "Z11RGJOFQNVJUP",
+	"ap-southeast-1": "Z3O0J2DXBE1FTB",
+	"ap-southeast-2": "Z1WCIGYICN2BYD",
+	"ca-central-1":   "Z1QDHH18159H29",
+	"cn-northwest-1": "Z282HJ1KT0DH03",
+	"eu-central-1":   "Z21DNDUVLTQW6Q",
+	"eu-north-1":     "Z3BAZG2TWCNX0D",
+	"eu-south-1":     "Z30OZKI7KPW7MI",
+	"eu-west-1":      "Z1BKCTXD74EZPE",
+	"eu-west-2":      "Z3GKZC51ZF0DB4",
+	"eu-west-3":      "Z3R1K369G5AVDG",
+	"me-south-1":     "Z1MPMWCPA7YB62",
+	"sa-east-1":      "Z7KQH4QJS55SO",
+	"us-east-1":      "Z3AQBSTGFYJSTF",
+	"us-east-2":      "Z2O1EMRO9K5GLX",
+	"us-gov-east-1":  "Z2NIFVYYW2VKV1",
+	"us-gov-west-1":  "Z31GFT0UA1I2HV",
+	"us-west-1":      "Z2F56UZL2M1ACD",
+	"us-west-2":      "Z3BJ6K6RIION7M",
+}
+
+// legacyWebsiteRegions are the regions whose S3 website endpoints separate
+// the region with a dash instead of a dot.
+var legacyWebsiteRegions = map[string]bool{
+	"ap-northeast-1": true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"eu-west-1":      true,
+	"sa-east-1":      true,
+	"us-east-1":      true,
+	"us-gov-west-1":  true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+}
+
+// WebsiteDomain returns the domain of the S3 website endpoint of the given
+// region.
+func WebsiteDomain(region string) string {
+	suffix := "amazonaws.com"
+	if strings.HasPrefix(region, "cn-") {
+		suffix = "amazonaws.com.cn"
+	}
+	if legacyWebsiteRegions[region] {
+		return fmt.Sprintf("s3-website-%s.%s", region, suffix)
+	}
+	return fmt.Sprintf("s3-website.%s.%s", region, suffix)
+}
+
+// WebsiteEndpoint returns the endpoint of the static website hosted by the
+// bucket with the given name in the given region.
+func WebsiteEndpoint(name, region string) string {
+	return fmt.Sprintf("%s.%s", name, WebsiteDomain(region))
+}
+
+// WebsiteHostedZoneID returns the ID of the Route53 hosted zone of the S3
+// website endpoint of the given region, or an empty string if it is unknown.
+func WebsiteHostedZoneID(region string) string {
+	return websiteHostedZoneIDs[region]
+}
+
 // CORSConfigurationNotFound is parses the aws Error and validates if the cors configuration does not exist
 func CORSConfigurationNotFound(err error) bool {
 	var awsErr smithy.APIError
@@ -0,0 +1,49 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+)
+
+func TestWebsiteEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"DashRegion": {
+			region: "us-east-1",
+			want:   "bucket.s3-website-us-east-1.amazonaws.com",
+		},
+		"DotRegion": {
+			region: "eu-central-1",
+			want:   "bucket.s3-website.eu-central-1.amazonaws.com",
+		},
+		"ChinaRegion": {
+			region: "cn-northwest-1",
+			want:   "bucket.s3-website.cn-northwest-1.amazonaws.com.cn",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := WebsiteEndpoint("bucket", tc.region); got != tc.want {
+				t.Errorf("WebsiteEndpoint(...): want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
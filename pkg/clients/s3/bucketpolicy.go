@@ -18,6 +18,7 @@ package s3
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/aws/smithy-go"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 // BucketPolicyClient is the external client used for S3BucketPolicy Custom Resource
@@ -53,6 +55,55 @@ func IsErrorBucketNotFound(err error) bool {
 	return errors.As(err, &nsb)
 }
 
+// IsBucketPolicyUpToDate returns true if the local and remote policy documents
+// are semantically equal. Key ordering, whitespace and the difference between
+// a single value and an array holding only that value are ignored, since S3
+// may return the policy it stored in any of these forms.
+func IsBucketPolicyUpToDate(local, remote *string) bool {
+	l, err := normalizePolicy(local)
+	if err != nil {
+		return false
+	}
+	r, err := normalizePolicy(remote)
+	if err != nil {
+		return false
+	}
+	return awsclient.IsPolicyUpToDate(l, r)
+}
+
+// normalizePolicy returns the policy document with every single-element array
+// replaced by its element.
+func normalizePolicy(p *string) (*string, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(awsclient.StringValue(p)), &doc); err != nil {
+		return nil, err
+	}
+	out, err := json.Marshal(unwrapSingleElementArrays(doc))
+	if err != nil {
+		return nil, err
+	}
+	return awsclient.String(string(out)), nil
+}
+
+func unwrapSingleElementArrays(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = unwrapSingleElementArrays(e)
+		}
+		return t
+	case []interface{}:
+		if len(t) == 1 {
+			return unwrapSingleElementArrays(t[0])
+		}
+		for i, e := range t {
+			t[i] = unwrapSingleElementArrays(e)
+		}
+		return t
+	}
+	return v
+}
+
 // Serialize is the custom marshaller for the BucketPolicyParameters
 func Serialize(p *v1alpha3.BucketPolicyBody) (interface{}, error) {
 	m := make(map[string]interface{})
@@ -149,3 +149,63 @@ func TestSerializeBucketPolicyStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestIsBucketPolicyUpToDate(t *testing.T) {
+	type args struct {
+		local  string
+		remote string
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"KeyOrderAndWhitespace": {
+			args: args{
+				local: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+				remote: `{
+  "Statement": [{"Resource": "arn:aws:s3:::bucket/*", "Action": "s3:GetObject", "Principal": "*", "Effect": "Allow"}],
+  "Version": "2012-10-17"
+}`,
+			},
+			want: true,
+		},
+		"SingleElementArrays": {
+			args: args{
+				local:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket/*"]}]}`,
+				remote: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}}`,
+			},
+			want: true,
+		},
+		"ArrayOrder": {
+			args: args{
+				local:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject","s3:ListBucket"],"Resource":"arn:aws:s3:::bucket/*"}]}`,
+				remote: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:ListBucket","s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"}]}`,
+			},
+			want: true,
+		},
+		"DifferentAction": {
+			args: args{
+				local:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"}]}`,
+				remote: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::bucket/*"}]}`,
+			},
+			want: false,
+		},
+		"InvalidJSON": {
+			args: args{
+				local:  `{"Version":`,
+				remote: `{"Version":"2012-10-17"}`,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBucketPolicyUpToDate(&tc.args.local, &tc.args.remote)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsBucketPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"crypto/md5" // nolint:gosec // S3 uses MD5 for ETags.
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// ObjectClient is the external client used for S3 Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// IsErrorObjectNotFound returns true if the error code indicates that the
+// object was not found. HeadObject reports a missing object with a bare
+// NotFound since its response has no body.
+func IsErrorObjectNotFound(err error) bool {
+	var nf *s3types.NotFound
+	var nsk *s3types.NoSuchKey
+	return errors.As(err, &nf) || errors.As(err, &nsk)
+}
+
+// ContentMD5 returns the hex encoded MD5 digest of the given content.
+func ContentMD5(content string) string {
+	sum := md5.Sum([]byte(content)) // nolint:gosec
+	return hex.EncodeToString(sum[:])
+}
+
+// IsObjectUpToDate returns true if the observed object matches the desired
+// parameters and content.
+//
+// The ETag of an object that is neither encrypted with KMS nor uploaded in
+// parts is the MD5 digest of its content. The ETag and digest written last by
+// the controller are recorded in the observation, so that drift is detected
+// for KMS encrypted objects too: the object is up to date if S3 still reports
+// the recorded ETag and the desired content has the recorded digest.
+func IsObjectUpToDate(p v1alpha3.ObjectParameters, content string, obs v1alpha3.ObjectObservation, resp *s3.HeadObjectOutput) bool {
+	switch {
+	case p.ContentType != nil && awsclient.StringValue(p.ContentType) != awsclient.StringValue(resp.ContentType),
+		p.CacheControl != nil && awsclient.StringValue(p.CacheControl) != awsclient.StringValue(resp.CacheControl),
+		p.ServerSideEncryption != nil && awsclient.StringValue(p.ServerSideEncryption) != string(resp.ServerSideEncryption):
+		return false
+	}
+	digest := ContentMD5(content)
+	etag := strings.Trim(awsclient.StringValue(resp.ETag), `"`)
+	if awsclient.StringValue(obs.ContentMD5) == digest && awsclient.StringValue(obs.ETag) != "" {
+		return etag == strings.Trim(awsclient.StringValue(obs.ETag), `"`)
+	}
+	return etag == digest
+}
+
+// GeneratePutObjectInput returns the input to write the object with the given
+// content.
+func GeneratePutObjectInput(p v1alpha3.ObjectParameters, content string) *s3.PutObjectInput {
+	return &s3.PutObjectInput{
+		Bucket:               p.BucketName,
+		Key:                  awsclient.String(p.Key),
+		Body:                 strings.NewReader(content),
+		ContentType:          p.ContentType,
+		CacheControl:         p.CacheControl,
+		ServerSideEncryption: s3types.ServerSideEncryption(awsclient.StringValue(p.ServerSideEncryption)),
+		SSEKMSKeyId:          p.SSEKMSKeyID,
+	}
+}
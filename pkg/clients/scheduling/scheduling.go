@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	// The provider image does not ship a time zone database.
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxDuration is the longest a window may last. Finding the start of
+	// the current window walks back minute by minute, so it is bounded.
+	MaxDuration = 7 * 24 * time.Hour
+
+	errFields       = "cron expression must have five fields: minute, hour, day of month, month and day of week"
+	errFmtField     = "cannot parse %s field %q"
+	errFmtRange     = "value %d of %s field is not within %d-%d"
+	errFmtDuration  = "duration %s is not within 1m-%s"
+	errFmtParseCron = "cannot parse cron expression %q"
+)
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = [5]field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// Both 0 and 7 are Sunday.
+	{name: "day of week", min: 0, max: 7},
+}
+
+// A Cron is a parsed cron expression with the five standard fields. Each
+// field holds the set of values it matches as a bit mask.
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+
+	// Like in cron(8), a time matches if either the day of month or the
+	// day of week matches, unless one of them is a wildcard.
+	domStar, dowStar bool
+}
+
+// ParseCron parses a cron expression such as "0 20 * * 1-5". Every field is
+// a comma separated list of "*", a value or a range of values, each of them
+// optionally followed by a step such as "/15".
+func ParseCron(s string) (*Cron, error) {
+	f := strings.Fields(s)
+	if len(f) != len(fields) {
+		return nil, errors.New(errFields)
+	}
+	var masks [5]uint64
+	for i := range fields {
+		m, err := parseField(f[i], fields[i])
+		if err != nil {
+			return nil, err
+		}
+		masks[i] = m
+	}
+	// Fold Sunday given as 7 into 0.
+	if masks[4]&(1<<7) != 0 {
+		masks[4] = masks[4]&^(1<<7) | 1
+	}
+	return &Cron{
+		minute:  masks[0],
+		hour:    masks[1],
+		dom:     masks[2],
+		month:   masks[3],
+		dow:     masks[4],
+		domStar: strings.HasPrefix(f[2], "*"),
+		dowStar: strings.HasPrefix(f[4], "*"),
+	}, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var mask uint64
+	for _, part := range strings.Split(s, ",") {
+		expr, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, errors.Errorf(errFmtField, f.name, s)
+			}
+			expr, step = part[:i], n
+		}
+		lo, hi := f.min, f.max
+		switch {
+		case expr == "*":
+		case strings.Contains(expr, "-"):
+			bounds := strings.SplitN(expr, "-", 2)
+			var err error
+			if lo, err = parseValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, errors.Errorf(errFmtField, f.name, s)
+			}
+		default:
+			v, err := parseValue(expr, f)
+			if err != nil {
+				return 0, err
+			}
+			lo = v
+			// A single value with a step, such as "5/15", runs to the
+			// end of the range.
+			if step == 1 {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	return mask, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, errors.Errorf(errFmtField, f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, errors.Errorf(errFmtRange, v, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Matches returns true if the cron expression matches the minute of t, in
+// the location of t.
+func (c *Cron) Matches(t time.Time) bool {
+	if c.minute&(1<<uint(t.Minute())) == 0 || c.hour&(1<<uint(t.Hour())) == 0 || c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// A Window is a period of time that starts whenever a cron expression
+// matches and lasts for a fixed duration.
+type Window struct {
+	cron     *Cron
+	duration time.Duration
+	location *time.Location
+}
+
+// NewWindow returns the window that starts at the times matched by the given
+// cron expression in the given IANA time zone. The zone defaults to UTC.
+func NewWindow(start string, d time.Duration, tz string) (*Window, error) {
+	c, err := ParseCron(start)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtParseCron, start)
+	}
+	if d < time.Minute || d > MaxDuration {
+		return nil, errors.Errorf(errFmtDuration, d, MaxDuration)
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, err
+	}
+	return &Window{cron: c, duration: d, location: loc}, nil
+}
+
+// Active returns the start of the window that t falls into, and whether
+// there is one. If windows overlap, the latest start is returned.
+func (w *Window) Active(t time.Time) (time.Time, bool) {
+	t = t.In(w.location)
+	earliest := t.Add(-w.duration)
+	for s := t.Truncate(time.Minute); s.After(earliest); s = s.Add(-time.Minute) {
+		if w.cron.Matches(s) {
+			return s, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// End returns the time a window that started at s ends at.
+func (w *Window) End(s time.Time) time.Time {
+	return s.Add(w.duration)
+}
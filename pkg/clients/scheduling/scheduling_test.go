@@ -0,0 +1,174 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCronMatches(t *testing.T) {
+	type args struct {
+		expr string
+		t    time.Time
+	}
+	type want struct {
+		match bool
+		err   bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Wildcards": {
+			args: args{expr: "* * * * *", t: time.Date(2021, 3, 4, 5, 6, 0, 0, time.UTC)},
+			want: want{match: true},
+		},
+		"WeekdayEvening": {
+			args: args{expr: "0 20 * * 1-5", t: time.Date(2021, 3, 4, 20, 0, 0, 0, time.UTC)},
+			want: want{match: true},
+		},
+		"WeekendEvening": {
+			args: args{expr: "0 20 * * 1-5", t: time.Date(2021, 3, 6, 20, 0, 0, 0, time.UTC)},
+			want: want{match: false},
+		},
+		"SundayAsSeven": {
+			args: args{expr: "0 0 * * 7", t: time.Date(2021, 3, 7, 0, 0, 0, 0, time.UTC)},
+			want: want{match: true},
+		},
+		"Step": {
+			args: args{expr: "*/15 * * * *", t: time.Date(2021, 3, 4, 5, 45, 0, 0, time.UTC)},
+			want: want{match: true},
+		},
+		"StepMiss": {
+			args: args{expr: "*/15 * * * *", t: time.Date(2021, 3, 4, 5, 46, 0, 0, time.UTC)},
+			want: want{match: false},
+		},
+		"List": {
+			args: args{expr: "0 8,18 * * *", t: time.Date(2021, 3, 4, 18, 0, 0, 0, time.UTC)},
+			want: want{match: true},
+		},
+		"DayOfMonthOrDayOfWeek": {
+			args: args{expr: "0 0 1 * 1", t: time.Date(2021, 3, 8, 0, 0, 0, 0, time.UTC)},
+			want: want{match: true},
+		},
+		"TooFewFields": {
+			args: args{expr: "0 20 * *"},
+			want: want{err: true},
+		},
+		"OutOfRange": {
+			args: args{expr: "0 24 * * *"},
+			want: want{err: true},
+		},
+		"ReversedRange": {
+			args: args{expr: "0 0 * * 5-1"},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := ParseCron(tc.args.expr)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("err: -want, +got:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.match, c.Matches(tc.args.t)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWindowActive(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	type args struct {
+		start    string
+		duration time.Duration
+		tz       string
+		t        time.Time
+	}
+	type want struct {
+		start  time.Time
+		active bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"OverNight": {
+			args: args{start: "0 20 * * *", duration: 12 * time.Hour, t: time.Date(2021, 3, 5, 7, 59, 30, 0, time.UTC)},
+			want: want{start: time.Date(2021, 3, 4, 20, 0, 0, 0, time.UTC), active: true},
+		},
+		"Ended": {
+			args: args{start: "0 20 * * *", duration: 12 * time.Hour, t: time.Date(2021, 3, 5, 8, 0, 0, 0, time.UTC)},
+			want: want{},
+		},
+		"Started": {
+			args: args{start: "0 20 * * *", duration: 12 * time.Hour, t: time.Date(2021, 3, 5, 20, 0, 0, 0, time.UTC)},
+			want: want{start: time.Date(2021, 3, 5, 20, 0, 0, 0, time.UTC), active: true},
+		},
+		"TimeZone": {
+			args: args{start: "0 20 * * *", duration: time.Hour, tz: "Europe/Berlin", t: time.Date(2021, 3, 5, 19, 30, 0, 0, time.UTC)},
+			want: want{start: time.Date(2021, 3, 5, 20, 0, 0, 0, berlin), active: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w, err := NewWindow(tc.args.start, tc.args.duration, tc.args.tz)
+			if err != nil {
+				t.Fatal(err)
+			}
+			start, active := w.Active(tc.args.t)
+			if diff := cmp.Diff(tc.want.active, active); diff != "" {
+				t.Errorf("active: -want, +got:\n%s", diff)
+			}
+			if !start.Equal(tc.want.start) {
+				t.Errorf("start: want %s, got %s", tc.want.start, start)
+			}
+		})
+	}
+}
+
+func TestNewWindow(t *testing.T) {
+	cases := map[string]struct {
+		start    string
+		duration time.Duration
+		tz       string
+	}{
+		"TooShort":    {start: "0 20 * * *", duration: time.Second},
+		"TooLong":     {start: "0 20 * * *", duration: MaxDuration + time.Minute},
+		"BadTimeZone": {start: "0 20 * * *", duration: time.Hour, tz: "Nowhere/Special"},
+		"BadCron":     {start: "0 20 * *", duration: time.Hour},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewWindow(tc.start, tc.duration, tc.tz); err == nil {
+				t.Error("want error, got nil")
+			}
+		})
+	}
+}
@@ -258,6 +258,34 @@ func TestGetChangedSubAttributes(t *testing.T) {
 			},
 			want: subAttributes(),
 		},
+		"FilterPolicyFormatting": {
+			args: args{
+				p: v1beta1.SubscriptionParameters{
+					Protocol:     subEmailProtocol,
+					Endpoint:     subEmailEndpoint,
+					FilterPolicy: aws.String(`{"store":["a","b"]}`),
+				},
+				attr: subAttributes(
+					withSubFilterPolicy(aws.String("{\n  \"store\" : [ \"b\", \"a\" ]\n}")),
+				),
+			},
+			want: subAttributes(),
+		},
+		"FilterPolicyScopeChanged": {
+			args: args{
+				p: v1beta1.SubscriptionParameters{
+					Protocol:          subEmailProtocol,
+					Endpoint:          subEmailEndpoint,
+					FilterPolicyScope: aws.String("MessageBody"),
+				},
+				attr: &map[string]string{
+					SubscriptionFilterPolicyScope: "MessageAttributes",
+				},
+			},
+			want: &map[string]string{
+				SubscriptionFilterPolicyScope: "MessageBody",
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -54,6 +54,19 @@ const (
 	TopicARN TopicAttributes = "TopicArn"
 )
 
+// Suffixes of the delivery status logging attributes of a SNS Topic. The
+// attribute names are prefixed with the endpoint protocol, e.g.
+// SQSSuccessFeedbackRoleArn.
+const (
+	topicSuccessFeedbackRoleARN    = "SuccessFeedbackRoleArn"
+	topicFailureFeedbackRoleARN    = "FailureFeedbackRoleArn"
+	topicSuccessFeedbackSampleRate = "SuccessFeedbackSampleRate"
+)
+
+// deliveryStatusLoggingProtocols are the endpoint protocols whose delivery
+// status can be logged.
+var deliveryStatusLoggingProtocols = []string{"HTTP", "Application", "Lambda", "SQS", "Firehose"}
+
 // TopicClient is the external client used for AWS Topic
 type TopicClient interface {
 	CreateTopic(ctx context.Context, input *sns.CreateTopicInput, opts ...func(*sns.Options)) (*sns.CreateTopicOutput, error)
@@ -93,7 +106,32 @@ func LateInitializeTopicAttr(in *v1beta1.TopicParameters, attrs map[string]strin
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(attrs[string(TopicDeliveryPolicy)]))
 	in.KMSMasterKeyID = awsclients.LateInitializeStringPtr(in.KMSMasterKeyID, aws.String(attrs[string(TopicKmsMasterKeyID)]))
 	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	if in.DeliveryStatusLogging == nil {
+		in.DeliveryStatusLogging = generateDeliveryStatusLogging(attrs)
+	}
+}
 
+// generateDeliveryStatusLogging returns the delivery status logging
+// configured for each protocol in the given topic attributes.
+func generateDeliveryStatusLogging(attrs map[string]string) []v1beta1.TopicDeliveryStatusLogging {
+	var res []v1beta1.TopicDeliveryStatusLogging
+	for _, p := range deliveryStatusLoggingProtocols {
+		success := attrs[p+topicSuccessFeedbackRoleARN]
+		failure := attrs[p+topicFailureFeedbackRoleARN]
+		if success == "" && failure == "" {
+			continue
+		}
+		l := v1beta1.TopicDeliveryStatusLogging{
+			Protocol:               p,
+			SuccessFeedbackRoleARN: awsclients.String(success),
+			FailureFeedbackRoleARN: awsclients.String(failure),
+		}
+		if r, err := strconv.ParseInt(attrs[p+topicSuccessFeedbackSampleRate], 10, 32); err == nil {
+			l.SuccessFeedbackSampleRate = aws.Int32(int32(r))
+		}
+		res = append(res, l)
+	}
+	return res
 }
 
 // GetChangedAttributes will return the changed attributes for a topic in AWS side.
@@ -139,10 +177,7 @@ func GenerateTopicObservation(attr map[string]string) v1beta1.TopicObservation {
 
 // IsSNSTopicUpToDate checks if object is up to date
 func IsSNSTopicUpToDate(p v1beta1.TopicParameters, attr map[string]string) bool {
-	return aws.ToString(p.DeliveryPolicy) == attr[string(TopicDeliveryPolicy)] &&
-		aws.ToString(p.DisplayName) == attr[string(TopicDisplayName)] &&
-		aws.ToString(p.KMSMasterKeyID) == attr[string(TopicKmsMasterKeyID)] &&
-		aws.ToString(p.Policy) == attr[string(TopicPolicy)]
+	return len(GetChangedAttributes(p, attr)) == 0
 }
 
 func getTopicAttributes(p v1beta1.TopicParameters) map[string]string {
@@ -154,6 +189,20 @@ func getTopicAttributes(p v1beta1.TopicParameters) map[string]string {
 	topicAttr[string(TopicKmsMasterKeyID)] = aws.ToString(p.KMSMasterKeyID)
 	topicAttr[string(TopicPolicy)] = aws.ToString(p.Policy)
 
+	// Role ARNs of protocols that are not configured are set to empty so
+	// that removing a protocol from the spec disables its logging.
+	for _, proto := range deliveryStatusLoggingProtocols {
+		topicAttr[proto+topicSuccessFeedbackRoleARN] = ""
+		topicAttr[proto+topicFailureFeedbackRoleARN] = ""
+	}
+	for _, l := range p.DeliveryStatusLogging {
+		topicAttr[l.Protocol+topicSuccessFeedbackRoleARN] = aws.ToString(l.SuccessFeedbackRoleARN)
+		topicAttr[l.Protocol+topicFailureFeedbackRoleARN] = aws.ToString(l.FailureFeedbackRoleARN)
+		if l.SuccessFeedbackSampleRate != nil {
+			topicAttr[l.Protocol+topicSuccessFeedbackSampleRate] = strconv.Itoa(int(aws.ToInt32(l.SuccessFeedbackSampleRate)))
+		}
+	}
+
 	return topicAttr
 }
 
@@ -215,6 +215,28 @@ func TestGetChangedAttributes(t *testing.T) {
 				withAttrDisplayName(&topicDisplayName),
 			),
 		},
+		"DeliveryStatusLogging": {
+			args: args{
+				p: v1beta1.TopicParameters{
+					Name: topicName,
+					DeliveryStatusLogging: []v1beta1.TopicDeliveryStatusLogging{{
+						Protocol:                  "SQS",
+						SuccessFeedbackRoleARN:    aws.String("success"),
+						FailureFeedbackRoleARN:    aws.String("failure"),
+						SuccessFeedbackSampleRate: aws.Int32(50),
+					}},
+				},
+				attr: &map[string]string{
+					"LambdaFailureFeedbackRoleArn": "failure",
+					"SQSSuccessFeedbackRoleArn":    "success",
+				},
+			},
+			want: &map[string]string{
+				"LambdaFailureFeedbackRoleArn": "",
+				"SQSFailureFeedbackRoleArn":    "failure",
+				"SQSSuccessFeedbackSampleRate": "50",
+			},
+		},
 	}
 
 	for name, tc := range cases {
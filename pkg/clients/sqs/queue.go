@@ -30,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/smithy-go"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -105,6 +106,12 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //
 			m[v1beta1.AttributeRedrivePolicy] = string(val)
 		}
 	}
+	if p.RedriveAllowPolicy != nil {
+		val, err := json.Marshal(generateRedriveAllowPolicy(p.RedriveAllowPolicy))
+		if err == nil {
+			m[v1beta1.AttributeRedriveAllowPolicy] = string(val)
+		}
+	}
 	if p.VisibilityTimeout != nil {
 		m[v1beta1.AttributeVisibilityTimeout] = strconv.FormatInt(aws.ToInt64(p.VisibilityTimeout), 10)
 	}
@@ -223,9 +230,37 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 			}
 		}
 	}
+	if p.RedriveAllowPolicy != nil && !isRedriveAllowPolicyUpToDate(p.RedriveAllowPolicy, attributes[v1beta1.AttributeRedriveAllowPolicy]) {
+		return false
+	}
 	return true
 }
 
+// redriveAllowPolicy is the JSON representation of the RedriveAllowPolicy
+// queue attribute.
+type redriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueArns   []string `json:"sourceQueueArns,omitempty"`
+}
+
+func generateRedriveAllowPolicy(p *v1beta1.RedriveAllowPolicy) redriveAllowPolicy {
+	r := redriveAllowPolicy{RedrivePermission: p.RedrivePermission}
+	// SQS rejects source queues for any permission other than byQueue.
+	if p.RedrivePermission == v1beta1.RedrivePermissionByQueue {
+		r.SourceQueueArns = p.SourceQueueARNs
+	}
+	return r
+}
+
+func isRedriveAllowPolicyUpToDate(p *v1beta1.RedriveAllowPolicy, attribute string) bool {
+	observed := redriveAllowPolicy{}
+	if err := json.Unmarshal([]byte(attribute), &observed); err != nil {
+		return false
+	}
+	return cmp.Equal(generateRedriveAllowPolicy(p), observed,
+		cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
 // TagsDiff returns the tags added and removed from spec when compared to the AWS SQS tags.
 func TagsDiff(sqsTags map[string]string, newTags map[string]string) (removed, added map[string]string) {
 	removed = map[string]string{}
@@ -171,6 +171,33 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"RedriveAllowPolicySourceQueuesReordered": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					RedriveAllowPolicy: &v1beta1.RedriveAllowPolicy{
+						RedrivePermission: v1beta1.RedrivePermissionByQueue,
+						SourceQueueARNs:   []string{"arn1", "arn2"},
+					},
+				},
+				attributes: map[string]string{
+					v1beta1.AttributeRedriveAllowPolicy: `{"redrivePermission":"byQueue","sourceQueueArns":["arn2","arn1"]}`,
+				},
+			},
+			want: true,
+		},
+		"RedriveAllowPolicyChanged": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					RedriveAllowPolicy: &v1beta1.RedriveAllowPolicy{
+						RedrivePermission: v1beta1.RedrivePermissionDenyAll,
+					},
+				},
+				attributes: map[string]string{
+					v1beta1.AttributeRedriveAllowPolicy: `{"redrivePermission":"allowAll"}`,
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -208,6 +235,19 @@ func TestGenerateQueueAttributes(t *testing.T) {
 				v1beta1.AttributeKmsMasterKeyID: kmsMasterKeyID,
 			},
 		},
+		"RedriveAllowPolicy": {
+			in: *sqsParams(func(p *v1beta1.QueueParameters) {
+				p.RedriveAllowPolicy = &v1beta1.RedriveAllowPolicy{
+					RedrivePermission: v1beta1.RedrivePermissionByQueue,
+					SourceQueueARNs:   []string{arn},
+				}
+			}),
+			out: map[string]string{
+				v1beta1.AttributeDelaySeconds:       strconv.FormatInt(delaySeconds, 10),
+				v1beta1.AttributeRedriveAllowPolicy: `{"redrivePermission":"byQueue","sourceQueueArns":["arn"]}`,
+				v1beta1.AttributeKmsMasterKeyID:     kmsMasterKeyID,
+			},
+		},
 		"EmptyInput": {
 			in:  v1beta1.QueueParameters{},
 			out: nil,
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagegateway
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/storagegateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsGatewayNotFound returns true if the error indicates that the gateway does
+// not exist.
+func IsGatewayNotFound(err error) bool {
+	e, ok := err.(*svcsdk.InvalidGatewayRequestException)
+	return ok && e.Error_ != nil && awsclient.StringValue(e.Error_.ErrorCode) == svcsdk.ErrorCodeGatewayNotFound
+}
+
+// GetFileShare returns the file share with the given ARN of the given gateway,
+// or nil if the gateway has no such file share. The describe operations of
+// file shares fail for unknown ARNs without telling why, so the file shares
+// of the gateway are listed instead.
+func GetFileShare(ctx context.Context, client svcsdkapi.StorageGatewayAPI, gatewayARN, arn string) (*svcsdk.FileShareInfo, error) {
+	var res *svcsdk.FileShareInfo
+	err := client.ListFileSharesPagesWithContext(ctx, &svcsdk.ListFileSharesInput{GatewayARN: awsclient.String(gatewayARN)},
+		func(page *svcsdk.ListFileSharesOutput, _ bool) bool {
+			for _, fs := range page.FileShareInfoList {
+				if awsclient.StringValue(fs.FileShareARN) == arn {
+					res = fs
+					return false
+				}
+			}
+			return true
+		})
+	if IsGatewayNotFound(err) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GenerateTags returns the SDK representation of the given tags.
+func GenerateTags(tags []*v1alpha1.Tag) []*svcsdk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*svcsdk.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &svcsdk.Tag{Key: t.Key, Value: t.Value}
+	}
+	return res
+}
+
+// GenerateCacheAttributes returns the SDK representation of the given cache
+// attributes.
+func GenerateCacheAttributes(in *v1alpha1.CacheAttributes) *svcsdk.CacheAttributes {
+	if in == nil {
+		return nil
+	}
+	return &svcsdk.CacheAttributes{CacheStaleTimeoutInSeconds: in.CacheStaleTimeoutInSeconds}
+}
+
+// FileShareAttributes are the attributes of a file share that are shared by
+// NFS and SMB file shares.
+type FileShareAttributes struct {
+	AuditDestinationARN  *string
+	CacheAttributes      *svcsdk.CacheAttributes
+	DefaultStorageClass  *string
+	FileShareName        *string
+	GuessMIMETypeEnabled *bool
+	KMSEncrypted         *bool
+	KMSKey               *string
+	NotificationPolicy   *string
+	ObjectACL            *string
+	ReadOnly             *bool
+	RequesterPays        *bool
+}
+
+// LateInitializeFileShare fills the empty fields of the given parameters with
+// the values of the observed file share.
+func LateInitializeFileShare(p *v1alpha1.FileShareParameters, o FileShareAttributes) {
+	p.AuditDestinationARN = awsclient.LateInitializeStringPtr(p.AuditDestinationARN, o.AuditDestinationARN)
+	if p.CacheAttributes == nil && o.CacheAttributes != nil {
+		p.CacheAttributes = &v1alpha1.CacheAttributes{CacheStaleTimeoutInSeconds: o.CacheAttributes.CacheStaleTimeoutInSeconds}
+	}
+	p.DefaultStorageClass = awsclient.LateInitializeStringPtr(p.DefaultStorageClass, o.DefaultStorageClass)
+	p.FileShareName = awsclient.LateInitializeStringPtr(p.FileShareName, o.FileShareName)
+	p.GuessMIMETypeEnabled = awsclient.LateInitializeBoolPtr(p.GuessMIMETypeEnabled, o.GuessMIMETypeEnabled)
+	p.KMSEncrypted = awsclient.LateInitializeBoolPtr(p.KMSEncrypted, o.KMSEncrypted)
+	p.KMSKey = awsclient.LateInitializeStringPtr(p.KMSKey, o.KMSKey)
+	p.NotificationPolicy = awsclient.LateInitializeStringPtr(p.NotificationPolicy, o.NotificationPolicy)
+	p.ObjectACL = awsclient.LateInitializeStringPtr(p.ObjectACL, o.ObjectACL)
+	p.ReadOnly = awsclient.LateInitializeBoolPtr(p.ReadOnly, o.ReadOnly)
+	p.RequesterPays = awsclient.LateInitializeBoolPtr(p.RequesterPays, o.RequesterPays)
+}
+
+// GenerateFileShareAttributes returns the file share attributes desired by
+// the given parameters.
+func GenerateFileShareAttributes(p v1alpha1.FileShareParameters) FileShareAttributes {
+	return FileShareAttributes{
+		AuditDestinationARN:  p.AuditDestinationARN,
+		CacheAttributes:      GenerateCacheAttributes(p.CacheAttributes),
+		DefaultStorageClass:  p.DefaultStorageClass,
+		FileShareName:        p.FileShareName,
+		GuessMIMETypeEnabled: p.GuessMIMETypeEnabled,
+		KMSEncrypted:         p.KMSEncrypted,
+		KMSKey:               p.KMSKey,
+		NotificationPolicy:   p.NotificationPolicy,
+		ObjectACL:            p.ObjectACL,
+		ReadOnly:             p.ReadOnly,
+		RequesterPays:        p.RequesterPays,
+	}
+}
+
+// IsFileShareUpToDate returns true if the observed attributes of a file share
+// match the desired ones.
+func IsFileShareUpToDate(p v1alpha1.FileShareParameters, o FileShareAttributes) bool {
+	return cmp.Equal(GenerateFileShareAttributes(p), o, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(svcsdk.CacheAttributes{}))
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagegateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/storagegateway/v1alpha1"
+)
+
+const (
+	testGatewayARN   = "arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12A3456B"
+	testFileShareARN = "arn:aws:storagegateway:us-east-1:123456789012:share/share-XXXXXXX"
+)
+
+var errBoom = errors.New("boom")
+
+type mockClient struct {
+	svcsdkapi.StorageGatewayAPI
+
+	pages [][]*svcsdk.FileShareInfo
+	err   error
+}
+
+func (m *mockClient) ListFileSharesPagesWithContext(_ context.Context, _ *svcsdk.ListFileSharesInput, fn func(*svcsdk.ListFileSharesOutput, bool) bool, _ ...request.Option) error {
+	if m.err != nil {
+		return m.err
+	}
+	for i, p := range m.pages {
+		if !fn(&svcsdk.ListFileSharesOutput{FileShareInfoList: p}, i == len(m.pages)-1) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func TestGetFileShare(t *testing.T) {
+	share := &svcsdk.FileShareInfo{FileShareARN: aws.String(testFileShareARN)}
+	other := &svcsdk.FileShareInfo{FileShareARN: aws.String("other")}
+	type want struct {
+		share *svcsdk.FileShareInfo
+		err   error
+	}
+	cases := map[string]struct {
+		client *mockClient
+		want   want
+	}{
+		"FoundOnLaterPage": {
+			client: &mockClient{pages: [][]*svcsdk.FileShareInfo{{other}, {share}}},
+			want:   want{share: share},
+		},
+		"NotFound": {
+			client: &mockClient{pages: [][]*svcsdk.FileShareInfo{{other}}},
+		},
+		"GatewayNotFound": {
+			client: &mockClient{err: &svcsdk.InvalidGatewayRequestException{
+				Error_: &svcsdk.Error{ErrorCode: aws.String(svcsdk.ErrorCodeGatewayNotFound)},
+			}},
+		},
+		"Error": {
+			client: &mockClient{err: errBoom},
+			want:   want{err: errBoom},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := GetFileShare(context.Background(), tc.client, testGatewayARN, testFileShareARN)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.share, got, cmp.AllowUnexported(svcsdk.FileShareInfo{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFileShareUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.FileShareParameters
+		o    FileShareAttributes
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.FileShareParameters{
+				DefaultStorageClass: aws.String("S3_STANDARD"),
+				CacheAttributes:     &v1alpha1.CacheAttributes{CacheStaleTimeoutInSeconds: aws.Int64(300)},
+				ReadOnly:            aws.Bool(false),
+			},
+			o: FileShareAttributes{
+				DefaultStorageClass: aws.String("S3_STANDARD"),
+				CacheAttributes:     &svcsdk.CacheAttributes{CacheStaleTimeoutInSeconds: aws.Int64(300)},
+				ReadOnly:            aws.Bool(false),
+			},
+			want: true,
+		},
+		"ChangedCacheAttributes": {
+			p: v1alpha1.FileShareParameters{
+				CacheAttributes: &v1alpha1.CacheAttributes{CacheStaleTimeoutInSeconds: aws.Int64(600)},
+			},
+			o: FileShareAttributes{
+				CacheAttributes: &svcsdk.CacheAttributes{CacheStaleTimeoutInSeconds: aws.Int64(300)},
+			},
+		},
+		"ChangedReadOnly": {
+			p: v1alpha1.FileShareParameters{ReadOnly: aws.Bool(true)},
+			o: FileShareAttributes{ReadOnly: aws.Bool(false)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsFileShareUpToDate(tc.p, tc.o); got != tc.want {
+				t.Errorf("IsFileShareUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLateInitializeFileShare(t *testing.T) {
+	p := v1alpha1.FileShareParameters{ObjectACL: aws.String("private")}
+	LateInitializeFileShare(&p, FileShareAttributes{
+		ObjectACL:           aws.String("bucket-owner-full-control"),
+		DefaultStorageClass: aws.String("S3_INTELLIGENT_TIERING"),
+		CacheAttributes:     &svcsdk.CacheAttributes{CacheStaleTimeoutInSeconds: aws.Int64(300)},
+	})
+	want := v1alpha1.FileShareParameters{
+		ObjectACL:           aws.String("private"),
+		DefaultStorageClass: aws.String("S3_INTELLIGENT_TIERING"),
+		CacheAttributes:     &v1alpha1.CacheAttributes{CacheStaleTimeoutInSeconds: aws.Int64(300)},
+	}
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	awsroute53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -37,6 +39,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/acm/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acm"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
 )
 
 const (
@@ -52,6 +55,8 @@ const (
 	errAddTagsFailed    = "cannot add tags to Certificate"
 	errListTagsFailed   = "failed to list tags for Certificate"
 	errRemoveTagsFailed = "failed to remove tags for Certificate"
+
+	errCreateValidationRecords = "cannot create DNS validation records of Certificate"
 )
 
 // SetupCertificate adds a controller that reconciles Certificates.
@@ -64,7 +69,7 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 		For(&v1beta1.Certificate{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient, newRoute53ClientFn: resourcerecordset.NewClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
@@ -74,8 +79,9 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 }
 
 type connector struct {
-	client      client.Client
-	newClientFn func(aws.Config) acm.Client
+	client             client.Client
+	newClientFn        func(aws.Config) acm.Client
+	newRoute53ClientFn func(aws.Config) resourcerecordset.Client
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -87,12 +93,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.client}, nil
+	return &external{client: c.newClientFn(*cfg), route53: c.newRoute53ClientFn(*cfg), kube: c.client}, nil
 }
 
 type external struct {
-	client acm.Client
-	kube   client.Client
+	client  acm.Client
+	route53 resourcerecordset.Client
+	kube    client.Client
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -139,7 +146,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// certificate in connection details.
 
 	return managed.ExternalObservation{
-		ResourceUpToDate:        acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags),
+		ResourceUpToDate:        acm.IsCertificateUpToDate(cr.Spec.ForProvider, certificate, tags.Tags) && len(acm.GenerateValidationRecordChanges(cr.Spec.ForProvider, cr.Status.AtProvider.DomainValidations)) == 0,
 		ResourceExists:          true,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
@@ -201,7 +208,22 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+
+	return managed.ExternalUpdate{}, e.createValidationRecords(ctx, cr)
+}
+
+// createValidationRecords creates the DNS validation records of the domains
+// that are pending validation in their Route53 hosted zones.
+func (e *external) createValidationRecords(ctx context.Context, cr *v1beta1.Certificate) error {
+	for zone, changes := range acm.GenerateValidationRecordChanges(cr.Spec.ForProvider, cr.Status.AtProvider.DomainValidations) {
+		if _, err := e.route53.ChangeResourceRecordSets(ctx, &awsroute53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(zone),
+			ChangeBatch:  &awsroute53types.ChangeBatch{Changes: changes},
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateValidationRecords)
+		}
+	}
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
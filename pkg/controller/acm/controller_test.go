@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtype "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	awsroute53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
@@ -36,6 +38,8 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acm"
 	"github.com/crossplane/provider-aws/pkg/clients/acm/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
+	route53fake "github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
 )
 
 var (
@@ -43,13 +47,17 @@ var (
 	unexpectedItem resource.Managed
 	domainName     = "some.site"
 	certificateArn = "somearn"
+	zoneID         = "Z1D633PJN98FT9"
+	recordName     = "_xyz.some.site."
+	recordValue    = "_xxx.zzz.acm-validations.aws."
 
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	acm acm.Client
-	cr  resource.Managed
+	acm     acm.Client
+	route53 resourcerecordset.Client
+	cr      resource.Managed
 }
 
 type certificateModifier func(*v1beta1.Certificate)
@@ -104,6 +112,26 @@ func withStatus(status string) certificateModifier {
 	}
 }
 
+func withRoute53Validation(status awsacmtype.DomainStatus) certificateModifier {
+	return func(r *v1beta1.Certificate) {
+		r.Spec.ForProvider.DomainValidationOptions = []*v1beta1.DomainValidationOption{{
+			DomainName:           domainName,
+			CreateRoute53Records: true,
+			HostedZoneID:         aws.String(zoneID),
+		}}
+		r.Status.AtProvider.DomainValidations = []v1beta1.DomainValidation{{
+			DomainName:       domainName,
+			ValidationStatus: string(status),
+			ValidationMethod: string(awsacmtype.ValidationMethodDns),
+			ResourceRecord: &v1beta1.ResourceRecord{
+				Name:  aws.String(recordName),
+				Type:  aws.String(string(awsacmtype.RecordTypeCname)),
+				Value: aws.String(recordValue),
+			},
+		}}
+	}
+}
+
 func certificate(m ...certificateModifier) *v1beta1.Certificate {
 	cr := &v1beta1.Certificate{}
 	meta.SetExternalName(cr, certificateArn)
@@ -154,6 +182,43 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PendingValidationRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockDescribeCertificate: func(ctx context.Context, input *awsacm.DescribeCertificateInput, opts []func(*awsacm.Options)) (*awsacm.DescribeCertificateOutput, error) {
+						return &awsacm.DescribeCertificateOutput{
+							Certificate: &awsacmtype.CertificateDetail{
+								CertificateArn: aws.String(certificateArn),
+								Status:         awsacmtype.CertificateStatusPendingValidation,
+								DomainValidationOptions: []awsacmtype.DomainValidation{{
+									DomainName:       aws.String(domainName),
+									ValidationStatus: awsacmtype.DomainStatusPendingValidation,
+									ValidationMethod: awsacmtype.ValidationMethodDns,
+									ResourceRecord: &awsacmtype.ResourceRecord{
+										Name:  aws.String(recordName),
+										Type:  awsacmtype.RecordTypeCname,
+										Value: aws.String(recordValue),
+									},
+								}},
+							},
+						}, nil
+					},
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				cr: certificate(withRoute53Validation(awsacmtype.DomainStatusSuccess)),
+			},
+			want: want{
+				cr: certificate(withRoute53Validation(awsacmtype.DomainStatusPendingValidation),
+					withStatus(string(awsacmtype.CertificateStatusPendingValidation)),
+					func(r *v1beta1.Certificate) { r.Status.AtProvider.CertificateARN = certificateArn }),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -391,11 +456,52 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errAddTagsFailed),
 			},
 		},
+		"CreateValidationRecords": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				route53: &route53fake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(ctx context.Context, input *awsroute53.ChangeResourceRecordSetsInput, opts []func(*awsroute53.Options)) (*awsroute53.ChangeResourceRecordSetsOutput, error) {
+						if aws.ToString(input.HostedZoneId) != zoneID || len(input.ChangeBatch.Changes) != 1 ||
+							input.ChangeBatch.Changes[0].Action != awsroute53types.ChangeActionUpsert {
+							return nil, errors.New("unexpected change")
+						}
+						return &awsroute53.ChangeResourceRecordSetsOutput{}, nil
+					},
+				},
+				cr: certificate(withRoute53Validation(awsacmtype.DomainStatusPendingValidation)),
+			},
+			want: want{
+				cr: certificate(withRoute53Validation(awsacmtype.DomainStatusPendingValidation)),
+			},
+		},
+		"CreateValidationRecordsError": {
+			args: args{
+				acm: &fake.MockCertificateClient{
+					MockListTagsForCertificate: func(ctx context.Context, input *awsacm.ListTagsForCertificateInput, opts []func(*awsacm.Options)) (*awsacm.ListTagsForCertificateOutput, error) {
+						return &awsacm.ListTagsForCertificateOutput{}, nil
+					},
+				},
+				route53: &route53fake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(ctx context.Context, input *awsroute53.ChangeResourceRecordSetsInput, opts []func(*awsroute53.Options)) (*awsroute53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificate(withRoute53Validation(awsacmtype.DomainStatusPendingValidation)),
+			},
+			want: want{
+				cr:  certificate(withRoute53Validation(awsacmtype.DomainStatusPendingValidation)),
+				err: awsclient.Wrap(errBoom, errCreateValidationRecords),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acm}
+			e := &external{client: tc.acm, route53: tc.route53}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -24,6 +24,8 @@ import (
 	awsacmpcatypes "github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -52,6 +54,14 @@ const (
 	errListTagsFailed       = "failed to list tags for ACMPCA"
 	errRemoveTagsFailed     = "failed to remove tags for ACMPCA"
 	errCertificateAuthority = "failed to update the ACMPCA resource"
+
+	errGetCsr               = "cannot get the CSR of the ACMPCA"
+	errIssueCACertificate   = "cannot issue the CA certificate of the ACMPCA"
+	errGetCACertificate     = "cannot get the issued CA certificate of the ACMPCA"
+	errImportCACertificate  = "cannot import the CA certificate of the ACMPCA"
+	errGetCertificateSecret = "cannot get the CA certificate secret of the ACMPCA"
+
+	errFmtEmptyCertificate = "CA certificate in key %q of the CA certificate secret must not be empty"
 )
 
 // SetupCertificateAuthority adds a controller that reconciles ACMPCA.
@@ -68,6 +78,7 @@ func SetupCertificateAuthority(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -142,8 +153,9 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags),
+		ResourceExists: true,
+		ResourceUpToDate: acmpca.IsCertificateAuthorityUpToDate(cr, certificateAuthority, tags.Tags) &&
+			!acmpca.IsActivationPending(&cr.Spec.ForProvider, cr.Status.AtProvider.Status),
 	}, nil
 }
 
@@ -170,6 +182,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	// The certificate authority cannot be updated before it is activated.
+	if acmpca.IsActivationPending(&cr.Spec.ForProvider, cr.Status.AtProvider.Status) {
+		return managed.ExternalUpdate{}, e.activate(ctx, cr)
+	}
+
 	// Update the Certificate Authority tags
 	if len(cr.Spec.ForProvider.Tags) > 0 {
 		tags := make([]awsacmpcatypes.Tag, len(cr.Spec.ForProvider.Tags))
@@ -211,6 +228,83 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errCertificateAuthority)
 }
 
+// activate installs the CA certificate of the certificate authority, either
+// by importing the referenced one or by issuing a new one.
+func (e *external) activate(ctx context.Context, cr *v1beta1.CertificateAuthority) error {
+	var cert, chain []byte
+	var err error
+	if cr.Spec.ForProvider.Activation.CertificateSecretRef != nil {
+		cert, chain, err = e.getImportedCertificate(ctx, cr.Spec.ForProvider.Activation)
+	} else {
+		cert, chain, err = e.issueCertificate(ctx, cr)
+	}
+	if err != nil || cert == nil {
+		return err
+	}
+	_, err = e.client.ImportCertificateAuthorityCertificate(ctx, &awsacmpca.ImportCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(meta.GetExternalName(cr)),
+		Certificate:             cert,
+		CertificateChain:        chain,
+	})
+	return awsclient.Wrap(err, errImportCACertificate)
+}
+
+// issueCertificate issues the CA certificate for the CSR of the certificate
+// authority and returns it along with its chain. Nothing is returned while
+// the CSR or the certificate are not yet available. Issuing is idempotent
+// for a few minutes, which is enough to wait for the certificate.
+func (e *external) issueCertificate(ctx context.Context, cr *v1beta1.CertificateAuthority) ([]byte, []byte, error) {
+	arn := meta.GetExternalName(cr)
+	csr, err := e.client.GetCertificateAuthorityCsr(ctx, &awsacmpca.GetCertificateAuthorityCsrInput{
+		CertificateAuthorityArn: aws.String(arn),
+	})
+	if err != nil {
+		return nil, nil, awsclient.Wrap(resource.Ignore(acmpca.IsErrorRequestInProgress, err), errGetCsr)
+	}
+	input := acmpca.GenerateIssueCACertificateInput(&cr.Spec.ForProvider, arn, []byte(aws.ToString(csr.Csr)), string(cr.GetUID()))
+	issued, err := e.client.IssueCertificate(ctx, input)
+	if err != nil {
+		return nil, nil, awsclient.Wrap(err, errIssueCACertificate)
+	}
+	rsp, err := e.client.GetCertificate(ctx, &awsacmpca.GetCertificateInput{
+		CertificateArn:          issued.CertificateArn,
+		CertificateAuthorityArn: input.CertificateAuthorityArn,
+	})
+	if err != nil {
+		return nil, nil, awsclient.Wrap(resource.Ignore(acmpca.IsErrorRequestInProgress, err), errGetCACertificate)
+	}
+	var chain []byte
+	if rsp.CertificateChain != nil {
+		chain = []byte(aws.ToString(rsp.CertificateChain))
+	}
+	return []byte(aws.ToString(rsp.Certificate)), chain, nil
+}
+
+// getImportedCertificate returns the CA certificate and its chain from the
+// referenced secrets.
+func (e *external) getImportedCertificate(ctx context.Context, a *v1beta1.Activation) ([]byte, []byte, error) {
+	cert, err := e.getSecretValue(ctx, *a.CertificateSecretRef)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errGetCertificateSecret)
+	}
+	if len(cert) == 0 {
+		return nil, nil, errors.Errorf(errFmtEmptyCertificate, a.CertificateSecretRef.Key)
+	}
+	if a.CertificateChainSecretRef == nil {
+		return cert, nil, nil
+	}
+	chain, err := e.getSecretValue(ctx, *a.CertificateChainSecretRef)
+	return cert, chain, errors.Wrap(err, errGetCertificateSecret)
+}
+
+func (e *external) getSecretValue(ctx context.Context, ref xpv1.SecretKeySelector) ([]byte, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return nil, err
+	}
+	return s.Data[ref.Key], nil
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1beta1.CertificateAuthority)
 	if !ok {
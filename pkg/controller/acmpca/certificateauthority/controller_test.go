@@ -31,6 +31,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -64,6 +65,11 @@ var (
 
 	errBoom = errors.New("boom")
 
+	importedActivation = &v1beta1.Activation{
+		CertificateSecretRef:      &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "ca", Namespace: "default"}, Key: "tls.crt"},
+		CertificateChainSecretRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "ca", Namespace: "default"}, Key: "ca.crt"},
+	}
+
 	sortTags = cmpopts.SortSlices(func(a, b v1beta1.Tag) bool {
 		return a.Key > b.Key
 	})
@@ -71,6 +77,7 @@ var (
 
 type args struct {
 	acmpca acmpca.Client
+	kube   client.Client
 	cr     resource.Managed
 }
 
@@ -111,6 +118,13 @@ func withCertificateAuthorityStatus() certificateAuthorityModifier {
 	}
 }
 
+func withActivation(a *v1beta1.Activation) certificateAuthorityModifier {
+	return func(r *v1beta1.CertificateAuthority) {
+		r.Spec.ForProvider.Activation = a
+		r.Status.AtProvider.Status = string(awsacmpcatypes.CertificateAuthorityStatusPendingCertificate)
+	}
+}
+
 func withTags(tagMaps ...map[string]string) certificateAuthorityModifier {
 	var tagList []v1beta1.Tag
 	for _, tagMap := range tagMaps {
@@ -389,6 +403,112 @@ func TestUpdate(t *testing.T) {
 				cr: certificateAuthority(withCertificateAuthorityStatus()),
 			},
 		},
+		"ActivationIssued": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsr: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCsrInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCsrOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCsrOutput{Csr: aws.String("csr")}, nil
+					},
+					MockIssueCertificate: func(ctx context.Context, input *awsacmpca.IssueCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.IssueCertificateOutput, error) {
+						if diff := cmp.Diff(certificateAuthorityArn, aws.ToString(input.CertificateAuthorityArn)); diff != "" {
+							t.Errorf("IssueCertificate(...) signer: -want, +got:\n%s", diff)
+						}
+						return &awsacmpca.IssueCertificateOutput{CertificateArn: aws.String("certificate")}, nil
+					},
+					MockGetCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateOutput, error) {
+						return &awsacmpca.GetCertificateOutput{Certificate: aws.String("cert")}, nil
+					},
+					MockImportCertificateAuthorityCertificate: func(ctx context.Context, input *awsacmpca.ImportCertificateAuthorityCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ImportCertificateAuthorityCertificateOutput, error) {
+						if diff := cmp.Diff(&awsacmpca.ImportCertificateAuthorityCertificateInput{
+							CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+							Certificate:             []byte("cert"),
+						}, input, cmpopts.IgnoreUnexported(awsacmpca.ImportCertificateAuthorityCertificateInput{})); diff != "" {
+							t.Errorf("ImportCertificateAuthorityCertificate(...): -want, +got:\n%s", diff)
+						}
+						return &awsacmpca.ImportCertificateAuthorityCertificateOutput{}, nil
+					},
+				},
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(&v1beta1.Activation{})),
+			},
+			want: want{
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(&v1beta1.Activation{})),
+			},
+		},
+		"ActivationCertificateNotIssuedYet": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsr: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCsrInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCsrOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCsrOutput{Csr: aws.String("csr")}, nil
+					},
+					MockIssueCertificate: func(ctx context.Context, input *awsacmpca.IssueCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.IssueCertificateOutput, error) {
+						return &awsacmpca.IssueCertificateOutput{CertificateArn: aws.String("certificate")}, nil
+					},
+					MockGetCertificate: func(ctx context.Context, input *awsacmpca.GetCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateOutput, error) {
+						return nil, &awsacmpcatypes.RequestInProgressException{}
+					},
+				},
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(&v1beta1.Activation{})),
+			},
+			want: want{
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(&v1beta1.Activation{})),
+			},
+		},
+		"ActivationIssueError": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockGetCertificateAuthorityCsr: func(ctx context.Context, input *awsacmpca.GetCertificateAuthorityCsrInput, opts []func(*awsacmpca.Options)) (*awsacmpca.GetCertificateAuthorityCsrOutput, error) {
+						return &awsacmpca.GetCertificateAuthorityCsrOutput{Csr: aws.String("csr")}, nil
+					},
+					MockIssueCertificate: func(ctx context.Context, input *awsacmpca.IssueCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.IssueCertificateOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(&v1beta1.Activation{})),
+			},
+			want: want{
+				cr:  certificateAuthority(withCertificateAuthorityType(), withActivation(&v1beta1.Activation{})),
+				err: awsclient.Wrap(errBoom, errIssueCACertificate),
+			},
+		},
+		"ActivationImported": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{
+					MockImportCertificateAuthorityCertificate: func(ctx context.Context, input *awsacmpca.ImportCertificateAuthorityCertificateInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ImportCertificateAuthorityCertificateOutput, error) {
+						if diff := cmp.Diff(&awsacmpca.ImportCertificateAuthorityCertificateInput{
+							CertificateAuthorityArn: aws.String(certificateAuthorityArn),
+							Certificate:             []byte("cert"),
+							CertificateChain:        []byte("chain"),
+						}, input, cmpopts.IgnoreUnexported(awsacmpca.ImportCertificateAuthorityCertificateInput{})); diff != "" {
+							t.Errorf("ImportCertificateAuthorityCertificate(...): -want, +got:\n%s", diff)
+						}
+						return &awsacmpca.ImportCertificateAuthorityCertificateOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"tls.crt": []byte("cert"), "ca.crt": []byte("chain")}
+						return nil
+					},
+				},
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(importedActivation)),
+			},
+			want: want{
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(importedActivation)),
+			},
+		},
+		"ActivationImportedEmptyCertificate": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityClient{},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: certificateAuthority(withCertificateAuthorityType(), withActivation(importedActivation)),
+			},
+			want: want{
+				cr:  certificateAuthority(withCertificateAuthorityType(), withActivation(importedActivation)),
+				err: errors.Errorf(errFmtEmptyCertificate, "tls.crt"),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -434,7 +554,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.acmpca}
+			e := &external{client: tc.acmpca, kube: tc.kube}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -146,6 +146,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	for i := range cr.Spec.ForProvider.Actions {
 		in.Actions[i] = awsacmpcatypes.ActionType(cr.Spec.ForProvider.Actions[i])
 	}
+	if len(in.Actions) == 0 {
+		in.Actions = acmpca.DefaultPermissionActions
+	}
 
 	_, err := e.client.CreatePermission(ctx, in)
 	if err != nil {
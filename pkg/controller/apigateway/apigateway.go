@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/pkg/errors"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetResources = "cannot list resources of RestAPI"
+	errNoRoot       = "RestAPI has no root resource"
+	errTag          = "cannot tag resource"
+	errUntag        = "cannot untag resource"
+)
+
+// rootPath is the path of the root resource of every RestAPI.
+const rootPath = "/"
+
+// ARN returns the ARN of the API Gateway resource at the given path, such as
+// /restapis/a1b2c3 or /usageplans/d4e5f6. The partition is derived from the
+// region so that ARNs in the China and GovCloud regions are valid too.
+func ARN(region, path string) string {
+	partition := endpoints.AwsPartitionID
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		partition = p.ID()
+	}
+	return fmt.Sprintf("arn:%s:apigateway:%s::%s", partition, region, path)
+}
+
+// EscapePath escapes s so that it can be used as a single element of the
+// path of a patch operation.
+func EscapePath(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+// PatchOperations collects the operations of an API Gateway PATCH request
+// that turn the observed state of a resource into the desired one. Desired
+// values that are not set are not compared, so that the values API Gateway
+// chose for them are kept.
+type PatchOperations []*svcsdk.PatchOperation
+
+func (p *PatchOperations) add(op, path string, value *string) {
+	*p = append(*p, &svcsdk.PatchOperation{
+		Op:    awsclients.String(op),
+		Path:  awsclients.String(path),
+		Value: value,
+	})
+}
+
+// String replaces the value at path if the desired one differs.
+func (p *PatchOperations) String(path string, desired, observed *string) {
+	if desired != nil && awsclients.StringValue(desired) != awsclients.StringValue(observed) {
+		p.add(svcsdk.OpReplace, path, desired)
+	}
+}
+
+// Bool replaces the value at path if the desired one differs.
+func (p *PatchOperations) Bool(path string, desired, observed *bool) {
+	if desired != nil && awsclients.BoolValue(desired) != awsclients.BoolValue(observed) {
+		p.add(svcsdk.OpReplace, path, awsclients.String(strconv.FormatBool(*desired)))
+	}
+}
+
+// Int64 replaces the value at path if the desired one differs.
+func (p *PatchOperations) Int64(path string, desired, observed *int64) {
+	if desired != nil && awsclients.Int64Value(desired) != awsclients.Int64Value(observed) {
+		p.add(svcsdk.OpReplace, path, awsclients.String(strconv.FormatInt(*desired, 10)))
+	}
+}
+
+// Float64 replaces the value at path if the desired one differs.
+func (p *PatchOperations) Float64(path string, desired, observed *float64) {
+	if desired != nil && (observed == nil || *desired != *observed) {
+		p.add(svcsdk.OpReplace, path, awsclients.String(strconv.FormatFloat(*desired, 'f', -1, 64)))
+	}
+}
+
+// Members adds the desired members that were not observed and removes the
+// observed members that are not desired. Members are addressed as elements
+// of path, like /binaryMediaTypes/image~1png.
+func (p *PatchOperations) Members(path string, desired, observed []*string) {
+	if desired == nil {
+		return
+	}
+	add, remove := diff(desired, observed)
+	for _, m := range remove {
+		p.add(svcsdk.OpRemove, path+"/"+EscapePath(m), nil)
+	}
+	for _, m := range add {
+		p.add(svcsdk.OpAdd, path+"/"+EscapePath(m), nil)
+	}
+}
+
+// Values adds the desired values that were not observed and removes the
+// observed values that are not desired. Values are passed as the value of
+// operations on path, like /apiStages with a1b2c3:prod.
+func (p *PatchOperations) Values(path string, desired, observed []*string) {
+	if desired == nil {
+		return
+	}
+	add, remove := diff(desired, observed)
+	for _, v := range remove {
+		p.add(svcsdk.OpRemove, path, awsclients.String(v))
+	}
+	for _, v := range add {
+		p.add(svcsdk.OpAdd, path, awsclients.String(v))
+	}
+}
+
+// Map replaces the entries of path whose desired value differs and removes
+// the observed entries that are not desired.
+func (p *PatchOperations) Map(path string, desired, observed map[string]*string) {
+	if desired == nil {
+		return
+	}
+	for _, k := range sortedKeys(observed) {
+		if _, ok := desired[k]; !ok {
+			p.add(svcsdk.OpRemove, path+"/"+EscapePath(k), nil)
+		}
+	}
+	for _, k := range sortedKeys(desired) {
+		if v, ok := observed[k]; !ok || awsclients.StringValue(v) != awsclients.StringValue(desired[k]) {
+			p.add(svcsdk.OpReplace, path+"/"+EscapePath(k), desired[k])
+		}
+	}
+}
+
+// Entries works like Map but adds the desired entries that were not
+// observed, for the maps of resources like Method that do not accept
+// replacing an entry that does not exist yet.
+func (p *PatchOperations) Entries(path string, desired, observed map[string]*string) {
+	if desired == nil {
+		return
+	}
+	for _, k := range sortedKeys(observed) {
+		if _, ok := desired[k]; !ok {
+			p.add(svcsdk.OpRemove, path+"/"+EscapePath(k), nil)
+		}
+	}
+	for _, k := range sortedKeys(desired) {
+		v, ok := observed[k]
+		switch {
+		case !ok:
+			p.add(svcsdk.OpAdd, path+"/"+EscapePath(k), desired[k])
+		case awsclients.StringValue(v) != awsclients.StringValue(desired[k]):
+			p.add(svcsdk.OpReplace, path+"/"+EscapePath(k), desired[k])
+		}
+	}
+}
+
+func diff(desired, observed []*string) (add, remove []string) {
+	d := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		d[awsclients.StringValue(v)] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, v := range observed {
+		o[awsclients.StringValue(v)] = true
+		if !d[awsclients.StringValue(v)] {
+			remove = append(remove, awsclients.StringValue(v))
+		}
+	}
+	for _, v := range desired {
+		if !o[awsclients.StringValue(v)] {
+			add = append(add, awsclients.StringValue(v))
+			o[awsclients.StringValue(v)] = true
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
+
+func sortedKeys(m map[string]*string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RootResourceID returns the ID of the root resource of the given RestAPI.
+func RootResourceID(ctx context.Context, client svcsdkapi.APIGatewayAPI, restAPIID *string) (*string, error) {
+	var root *string
+	err := client.GetResourcesPagesWithContext(ctx, &svcsdk.GetResourcesInput{RestApiId: restAPIID},
+		func(page *svcsdk.GetResourcesOutput, _ bool) bool {
+			for _, r := range page.Items {
+				if awsclients.StringValue(r.Path) == rootPath {
+					root = r.Id
+					return false
+				}
+			}
+			return true
+		})
+	if err != nil {
+		return nil, awsclients.Wrap(err, errGetResources)
+	}
+	if root == nil {
+		return nil, errors.New(errNoRoot)
+	}
+	return root, nil
+}
+
+// AreTagsUpToDate reports whether the observed tags are the desired ones.
+func AreTagsUpToDate(desired, observed map[string]*string) bool {
+	add, remove := awsclients.DiffTagsMapPtr(desired, observed)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// UpdateTags tags and untags the resource with the given ARN so that its
+// observed tags become the desired ones.
+func UpdateTags(ctx context.Context, client svcsdkapi.APIGatewayAPI, arn string, desired, observed map[string]*string) error {
+	add, remove := awsclients.DiffTagsMapPtr(desired, observed)
+	if len(remove) > 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceArn: awsclients.String(arn),
+			TagKeys:     remove,
+		}); err != nil {
+			return awsclients.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceArn: awsclients.String(arn),
+			Tags:        add,
+		}); err != nil {
+			return awsclients.Wrap(err, errTag)
+		}
+	}
+	return nil
+}
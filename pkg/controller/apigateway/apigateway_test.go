@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func op(op, path string, value *string) *svcsdk.PatchOperation {
+	return &svcsdk.PatchOperation{Op: awsclients.String(op), Path: awsclients.String(path), Value: value}
+}
+
+func TestPatchOperations(t *testing.T) {
+	cases := map[string]struct {
+		build func(p *PatchOperations)
+		want  PatchOperations
+	}{
+		"UnsetDesiredValues": {
+			build: func(p *PatchOperations) {
+				p.String("/name", nil, awsclients.String("observed"))
+				p.Bool("/enabled", nil, aws.Bool(true))
+				p.Members("/binaryMediaTypes", nil, []*string{awsclients.String("image/png")})
+				p.Map("/variables", nil, map[string]*string{"a": awsclients.String("b")})
+			},
+			want: PatchOperations{},
+		},
+		"UpToDate": {
+			build: func(p *PatchOperations) {
+				p.String("/name", awsclients.String("name"), awsclients.String("name"))
+				p.Int64("/quota/limit", awsclients.Int64(10), awsclients.Int64(10))
+				p.Float64("/throttle/rateLimit", aws.Float64(1.5), aws.Float64(1.5))
+				p.Values("/apiStages", []*string{awsclients.String("a:prod")}, []*string{awsclients.String("a:prod")})
+			},
+			want: PatchOperations{},
+		},
+		"Scalars": {
+			build: func(p *PatchOperations) {
+				p.String("/name", awsclients.String("desired"), awsclients.String("observed"))
+				p.Bool("/enabled", aws.Bool(false), aws.Bool(true))
+				p.Int64("/quota/limit", awsclients.Int64(10), nil)
+				p.Float64("/throttle/rateLimit", aws.Float64(1.5), aws.Float64(2))
+			},
+			want: PatchOperations{
+				op(svcsdk.OpReplace, "/name", awsclients.String("desired")),
+				op(svcsdk.OpReplace, "/enabled", awsclients.String("false")),
+				op(svcsdk.OpReplace, "/quota/limit", awsclients.String("10")),
+				op(svcsdk.OpReplace, "/throttle/rateLimit", awsclients.String("1.5")),
+			},
+		},
+		"Members": {
+			build: func(p *PatchOperations) {
+				p.Members("/binaryMediaTypes",
+					[]*string{awsclients.String("image/png"), awsclients.String("image/gif")},
+					[]*string{awsclients.String("image/png"), awsclients.String("image~jpeg")})
+			},
+			want: PatchOperations{
+				op(svcsdk.OpRemove, "/binaryMediaTypes/image~0jpeg", nil),
+				op(svcsdk.OpAdd, "/binaryMediaTypes/image~1gif", nil),
+			},
+		},
+		"Values": {
+			build: func(p *PatchOperations) {
+				p.Values("/apiStages",
+					[]*string{awsclients.String("b:prod"), awsclients.String("a:prod")},
+					[]*string{awsclients.String("a:test")})
+			},
+			want: PatchOperations{
+				op(svcsdk.OpRemove, "/apiStages", awsclients.String("a:test")),
+				op(svcsdk.OpAdd, "/apiStages", awsclients.String("a:prod")),
+				op(svcsdk.OpAdd, "/apiStages", awsclients.String("b:prod")),
+			},
+		},
+		"Map": {
+			build: func(p *PatchOperations) {
+				p.Map("/variables",
+					map[string]*string{"a": awsclients.String("1"), "b": awsclients.String("2")},
+					map[string]*string{"a": awsclients.String("0"), "c": awsclients.String("3")})
+			},
+			want: PatchOperations{
+				op(svcsdk.OpRemove, "/variables/c", nil),
+				op(svcsdk.OpReplace, "/variables/a", awsclients.String("1")),
+				op(svcsdk.OpReplace, "/variables/b", awsclients.String("2")),
+			},
+		},
+		"Entries": {
+			build: func(p *PatchOperations) {
+				p.Entries("/requestModels",
+					map[string]*string{"application/json": awsclients.String("Pet"), "text/xml": awsclients.String("Xml")},
+					map[string]*string{"application/json": awsclients.String("Empty"), "text/plain": awsclients.String("Text")})
+			},
+			want: PatchOperations{
+				op(svcsdk.OpRemove, "/requestModels/text~1plain", nil),
+				op(svcsdk.OpReplace, "/requestModels/application~1json", awsclients.String("Pet")),
+				op(svcsdk.OpAdd, "/requestModels/text~1xml", awsclients.String("Xml")),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PatchOperations{}
+			tc.build(&got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestARN(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"Standard": {
+			region: "us-east-1",
+			want:   "arn:aws:apigateway:us-east-1::/restapis/a1b2c3",
+		},
+		"China": {
+			region: "cn-north-1",
+			want:   "arn:aws-cn:apigateway:cn-north-1::/restapis/a1b2c3",
+		},
+		"GovCloud": {
+			region: "us-gov-west-1",
+			want:   "arn:aws-us-gov:apigateway:us-gov-west-1::/restapis/a1b2c3",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ARN(tc.region, "/restapis/a1b2c3")
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const (
+	// ConnectionDetailsAPIKey is the key of the value of the APIKey in the
+	// connection secret.
+	ConnectionDetailsAPIKey = "apiKey"
+)
+
+const (
+	errGetAPIKey          = "cannot get APIKey"
+	errGetUsagePlans      = "cannot list usage plans of APIKey"
+	errCreateUsagePlanKey = "cannot add APIKey to usage plan"
+	errDeleteUsagePlanKey = "cannot remove APIKey from usage plan"
+	errUpdateTags         = "cannot update tags of APIKey"
+)
+
+// SetupAPIKey adds a controller that reconciles APIKey.
+func SetupAPIKey(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.APIKeyGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.postCreate = h.postCreate
+			e.preUpdate = h.preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.APIKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.APIKeyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.APIKey, obj *svcsdk.GetApiKeyInput) error {
+	obj.ApiKey = awsclients.String(meta.GetExternalName(cr))
+	obj.IncludeValue = awsclients.Bool(true)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.APIKey, resp *svcsdk.ApiKey, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		ConnectionDetailsAPIKey: []byte(awsclients.StringValue(resp.Value)),
+	}
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.APIKey, resp *svcsdk.ApiKey) (bool, error) {
+	if len(patchOperations(cr.Spec.ForProvider, resp)) != 0 ||
+		!apigateway.AreTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags) {
+		return false, nil
+	}
+	add, remove, err := h.diffUsagePlans(context.TODO(), cr)
+	return len(add) == 0 && len(remove) == 0, err
+}
+
+// patchOperations returns the operations that update the observed APIKey to
+// the desired parameters.
+func patchOperations(p svcapitypes.APIKeyParameters, obs *svcsdk.ApiKey) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.String("/name", p.Name, obs.Name)
+	ops.String("/description", p.Description, obs.Description)
+	ops.Bool("/enabled", p.Enabled, obs.Enabled)
+	ops.String("/customerId", p.CustomerID, obs.CustomerId)
+	return ops
+}
+
+// diffUsagePlans returns the IDs of the usage plans the APIKey has to be
+// added to and removed from. Usage plans are not compared unless some are
+// desired.
+func (h *hooks) diffUsagePlans(ctx context.Context, cr *svcapitypes.APIKey) (add, remove []string, err error) {
+	if cr.Spec.ForProvider.UsagePlanIDs == nil {
+		return nil, nil, nil
+	}
+	observed := map[string]bool{}
+	err = h.client.GetUsagePlansPagesWithContext(ctx, &svcsdk.GetUsagePlansInput{KeyId: awsclients.String(meta.GetExternalName(cr))},
+		func(page *svcsdk.GetUsagePlansOutput, _ bool) bool {
+			for _, p := range page.Items {
+				observed[awsclients.StringValue(p.Id)] = true
+			}
+			return true
+		})
+	if err != nil {
+		return nil, nil, awsclients.Wrap(err, errGetUsagePlans)
+	}
+	desired := map[string]bool{}
+	for _, id := range cr.Spec.ForProvider.UsagePlanIDs {
+		desired[awsclients.StringValue(id)] = true
+		if !observed[awsclients.StringValue(id)] {
+			add = append(add, awsclients.StringValue(id))
+		}
+	}
+	for id := range observed {
+		if !desired[id] {
+			remove = append(remove, id)
+		}
+	}
+	sort.Strings(remove)
+	return add, remove, nil
+}
+
+// updateUsagePlans adds the APIKey to and removes it from usage plans so
+// that it belongs to the desired ones.
+func (h *hooks) updateUsagePlans(ctx context.Context, cr *svcapitypes.APIKey) error {
+	add, remove, err := h.diffUsagePlans(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for _, id := range remove {
+		if _, err := h.client.DeleteUsagePlanKeyWithContext(ctx, &svcsdk.DeleteUsagePlanKeyInput{
+			UsagePlanId: awsclients.String(id),
+			KeyId:       awsclients.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return awsclients.Wrap(err, errDeleteUsagePlanKey)
+		}
+	}
+	for _, id := range add {
+		if _, err := h.client.CreateUsagePlanKeyWithContext(ctx, &svcsdk.CreateUsagePlanKeyInput{
+			UsagePlanId: awsclients.String(id),
+			KeyId:       awsclients.String(meta.GetExternalName(cr)),
+			KeyType:     awsclients.String("API_KEY"),
+		}); err != nil {
+			return awsclients.Wrap(err, errCreateUsagePlanKey)
+		}
+	}
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.APIKey, resp *svcsdk.ApiKey, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Id))
+	cre.ConnectionDetails = managed.ConnectionDetails{
+		ConnectionDetailsAPIKey: []byte(awsclients.StringValue(resp.Value)),
+	}
+	return cre, h.updateUsagePlans(ctx, cr)
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.APIKey, obj *svcsdk.UpdateApiKeyInput) error {
+	obj.ApiKey = awsclients.String(meta.GetExternalName(cr))
+	observed, err := h.client.GetApiKeyWithContext(ctx, &svcsdk.GetApiKeyInput{ApiKey: obj.ApiKey})
+	if err != nil {
+		return awsclients.Wrap(err, errGetAPIKey)
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.APIKey, resp *svcsdk.ApiKey, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	arn := apigateway.ARN(cr.Spec.ForProvider.Region, "/apikeys/"+meta.GetExternalName(cr))
+	if err := apigateway.UpdateTags(ctx, h.client, arn, cr.Spec.ForProvider.Tags, resp.Tags); err != nil {
+		return upd, errors.Wrap(err, errUpdateTags)
+	}
+	return upd, h.updateUsagePlans(ctx, cr)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.APIKey, obj *svcsdk.DeleteApiKeyInput) (bool, error) {
+	obj.ApiKey = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
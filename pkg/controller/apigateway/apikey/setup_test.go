@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+type mockClient struct {
+	svcsdkapi.APIGatewayAPI
+	usagePlans []*svcsdk.UsagePlan
+}
+
+func (m *mockClient) GetUsagePlansPagesWithContext(_ context.Context, _ *svcsdk.GetUsagePlansInput, fn func(*svcsdk.GetUsagePlansOutput, bool) bool, _ ...request.Option) error {
+	fn(&svcsdk.GetUsagePlansOutput{Items: m.usagePlans}, true)
+	return nil
+}
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.ApiKey{
+		Name:        aws.String("key"),
+		Description: aws.String("Key of the petstore"),
+		Enabled:     aws.Bool(true),
+	}
+	cases := map[string]struct {
+		desired svcapitypes.APIKeyParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.APIKeyParameters{
+				Name:    aws.String("key"),
+				Enabled: aws.Bool(true),
+			},
+			want: apigateway.PatchOperations{},
+		},
+		"Disabled": {
+			desired: svcapitypes.APIKeyParameters{
+				Name:       aws.String("key"),
+				Enabled:    aws.Bool(false),
+				CustomerID: aws.String("customer"),
+			},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/enabled"), Value: aws.String("false")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/customerId"), Value: aws.String("customer")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.ApiKey{
+		Name: aws.String("key"),
+		Tags: map[string]*string{"team": aws.String("pets")},
+	}
+	cases := map[string]struct {
+		desired    svcapitypes.APIKeyParameters
+		usagePlans []*svcsdk.UsagePlan
+		want       bool
+	}{
+		"UpToDate": {
+			desired: svcapitypes.APIKeyParameters{
+				Name: aws.String("key"),
+				Tags: map[string]*string{"team": aws.String("pets")},
+			},
+			want: true,
+		},
+		"NameChanged": {
+			desired: svcapitypes.APIKeyParameters{
+				Name: aws.String("other"),
+				Tags: map[string]*string{"team": aws.String("pets")},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			desired: svcapitypes.APIKeyParameters{
+				Name: aws.String("key"),
+			},
+			want: false,
+		},
+		"UsagePlansUpToDate": {
+			desired: svcapitypes.APIKeyParameters{
+				Name: aws.String("key"),
+				Tags: map[string]*string{"team": aws.String("pets")},
+				CustomAPIKeyParameters: svcapitypes.CustomAPIKeyParameters{
+					UsagePlanIDs: []*string{aws.String("plan")},
+				},
+			},
+			usagePlans: []*svcsdk.UsagePlan{{Id: aws.String("plan")}},
+			want:       true,
+		},
+		"UsagePlanRemoved": {
+			desired: svcapitypes.APIKeyParameters{
+				Name: aws.String("key"),
+				Tags: map[string]*string{"team": aws.String("pets")},
+				CustomAPIKeyParameters: svcapitypes.CustomAPIKeyParameters{
+					UsagePlanIDs: []*string{},
+				},
+			},
+			usagePlans: []*svcsdk.UsagePlan{{Id: aws.String("plan")}},
+			want:       false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.APIKey{ObjectMeta: metav1.ObjectMeta{Name: "cr-name"}}
+			meta.SetExternalName(cr, "a1b2c3")
+			cr.Spec.ForProvider = tc.desired
+			h := &hooks{client: &mockClient{usagePlans: tc.usagePlans}}
+			got, err := h.isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const (
+	errGetDeployment = "cannot get Deployment"
+)
+
+// SetupDeployment adds a controller that reconciles Deployment.
+func SetupDeployment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DeploymentGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = h.preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Deployment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DeploymentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.GetDeploymentInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.DeploymentId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Deployment, _ *svcsdk.Deployment, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// The snapshot of a Deployment cannot change, so only its description is
+// kept up to date. A new Deployment is needed to publish changes of the
+// RestAPI.
+func isUpToDate(cr *svcapitypes.Deployment, resp *svcsdk.Deployment) (bool, error) {
+	return len(patchOperations(cr.Spec.ForProvider, resp)) == 0, nil
+}
+
+func patchOperations(p svcapitypes.DeploymentParameters, obs *svcsdk.Deployment) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.String("/description", p.Description, obs.Description)
+	return ops
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.CreateDeploymentInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Deployment, resp *svcsdk.Deployment, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Id))
+	return cre, nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Deployment, obj *svcsdk.UpdateDeploymentInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.DeploymentId = awsclients.String(meta.GetExternalName(cr))
+	observed, err := h.client.GetDeploymentWithContext(ctx, &svcsdk.GetDeploymentInput{
+		RestApiId:    obj.RestApiId,
+		DeploymentId: obj.DeploymentId,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errGetDeployment)
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.DeleteDeploymentInput) (bool, error) {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.DeploymentId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
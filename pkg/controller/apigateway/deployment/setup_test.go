@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.Deployment{Description: aws.String("v1")}
+	cases := map[string]struct {
+		desired svcapitypes.DeploymentParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.DeploymentParameters{Description: aws.String("v1")},
+			want:    apigateway.PatchOperations{},
+		},
+		"DescriptionChanged": {
+			desired: svcapitypes.DeploymentParameters{Description: aws.String("v2")},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/description"), Value: aws.String("v2")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.Deployment{Description: aws.String("v1")}
+	cases := map[string]struct {
+		desired svcapitypes.DeploymentParameters
+		want    bool
+	}{
+		"UpToDate": {
+			desired: svcapitypes.DeploymentParameters{Description: aws.String("v1")},
+			want:    true,
+		},
+		"StageDescriptionIgnored": {
+			desired: svcapitypes.DeploymentParameters{
+				Description:      aws.String("v1"),
+				StageDescription: aws.String("production"),
+			},
+			want: true,
+		},
+		"DescriptionChanged": {
+			desired: svcapitypes.DeploymentParameters{Description: aws.String("v2")},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Deployment{}
+			cr.Spec.ForProvider = tc.desired
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package method
+
+import (
+	"context"
+	"strconv"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const (
+	errGetMethod = "cannot get Method"
+)
+
+// SetupMethod adds a controller that reconciles Method.
+func SetupMethod(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MethodGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = h.preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = h.preCreate
+			e.postCreate = postCreate
+			e.preUpdate = h.preUpdate
+			e.preDelete = h.preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Method{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.MethodGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+}
+
+// resourceID returns the ID of the Resource of the Method, which is the root
+// resource of the RestAPI unless one is given.
+func (h *hooks) resourceID(ctx context.Context, cr *svcapitypes.Method) (*string, error) {
+	if cr.Spec.ForProvider.ResourceID != nil {
+		return cr.Spec.ForProvider.ResourceID, nil
+	}
+	return apigateway.RootResourceID(ctx, h.client, cr.Spec.ForProvider.RestAPIID)
+}
+
+func (h *hooks) preObserve(ctx context.Context, cr *svcapitypes.Method, obj *svcsdk.GetMethodInput) error {
+	id, err := h.resourceID(ctx, cr)
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = id
+	return err
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Method, _ *svcsdk.Method, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Method, resp *svcsdk.Method) (bool, error) {
+	return len(patchOperations(cr.Spec.ForProvider, resp)) == 0, nil
+}
+
+// patchOperations returns the operations that update the observed Method to
+// the desired parameters.
+func patchOperations(p svcapitypes.MethodParameters, obs *svcsdk.Method) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.Bool("/apiKeyRequired", p.APIKeyRequired, obs.ApiKeyRequired)
+	ops.String("/authorizationType", p.AuthorizationType, obs.AuthorizationType)
+	ops.String("/authorizerId", p.AuthorizerID, obs.AuthorizerId)
+	ops.String("/operationName", p.OperationName, obs.OperationName)
+	ops.String("/requestValidatorId", p.RequestValidatorID, obs.RequestValidatorId)
+	ops.Members("/authorizationScopes", p.AuthorizationScopes, obs.AuthorizationScopes)
+	ops.Entries("/requestModels", p.RequestModels, obs.RequestModels)
+	ops.Entries("/requestParameters", formatBools(p.RequestParameters), formatBools(obs.RequestParameters))
+	return ops
+}
+
+// formatBools returns the map of the given booleans as strings, which is
+// how API Gateway expects them in patch operations.
+func formatBools(m map[string]*bool) map[string]*string {
+	if m == nil {
+		return nil
+	}
+	r := make(map[string]*string, len(m))
+	for k, v := range m {
+		r[k] = awsclients.String(strconv.FormatBool(awsclients.BoolValue(v)))
+	}
+	return r
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.Method, obj *svcsdk.PutMethodInput) error {
+	id, err := h.resourceID(ctx, cr)
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = id
+	return err
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Method, resp *svcsdk.Method, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.HttpMethod))
+	return cre, nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Method, obj *svcsdk.UpdateMethodInput) error {
+	id, err := h.resourceID(ctx, cr)
+	if err != nil {
+		return err
+	}
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = id
+	observed, err := h.client.GetMethodWithContext(ctx, &svcsdk.GetMethodInput{
+		RestApiId:  obj.RestApiId,
+		ResourceId: obj.ResourceId,
+		HttpMethod: obj.HttpMethod,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errGetMethod)
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func (h *hooks) preDelete(ctx context.Context, cr *svcapitypes.Method, obj *svcsdk.DeleteMethodInput) (bool, error) {
+	id, err := h.resourceID(ctx, cr)
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = id
+	return false, err
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package method
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.Method{
+		AuthorizationType: aws.String("NONE"),
+		ApiKeyRequired:    aws.Bool(false),
+		RequestModels:     map[string]*string{"application/json": aws.String("Empty")},
+		RequestParameters: map[string]*bool{"method.request.path.petId": aws.Bool(true)},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.MethodParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.MethodParameters{
+				AuthorizationType: aws.String("NONE"),
+				RequestModels:     map[string]*string{"application/json": aws.String("Empty")},
+				RequestParameters: map[string]*bool{"method.request.path.petId": aws.Bool(true)},
+			},
+			want: apigateway.PatchOperations{},
+		},
+		"Changed": {
+			desired: svcapitypes.MethodParameters{
+				APIKeyRequired:    aws.Bool(true),
+				AuthorizationType: aws.String("AWS_IAM"),
+				RequestModels:     map[string]*string{"application/json": aws.String("Pet")},
+				RequestParameters: map[string]*bool{
+					"method.request.path.petId":      aws.Bool(false),
+					"method.request.querystring.tag": aws.Bool(false),
+				},
+			},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/apiKeyRequired"), Value: aws.String("true")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/authorizationType"), Value: aws.String("AWS_IAM")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/requestModels/application~1json"), Value: aws.String("Pet")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/requestParameters/method.request.path.petId"), Value: aws.String("false")},
+				{Op: aws.String(svcsdk.OpAdd), Path: aws.String("/requestParameters/method.request.querystring.tag"), Value: aws.String("false")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.Method{
+		AuthorizationType:   aws.String("COGNITO_USER_POOLS"),
+		AuthorizationScopes: []*string{aws.String("pets/read")},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.MethodParameters
+		want    bool
+	}{
+		"UpToDate": {
+			desired: svcapitypes.MethodParameters{
+				AuthorizationType:   aws.String("COGNITO_USER_POOLS"),
+				AuthorizationScopes: []*string{aws.String("pets/read")},
+			},
+			want: true,
+		},
+		"ScopeAdded": {
+			desired: svcapitypes.MethodParameters{
+				AuthorizationType:   aws.String("COGNITO_USER_POOLS"),
+				AuthorizationScopes: []*string{aws.String("pets/read"), aws.String("pets/write")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Method{}
+			cr.Spec.ForProvider = tc.desired
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
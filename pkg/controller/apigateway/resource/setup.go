@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const (
+	errGetResource = "cannot get Resource"
+)
+
+// SetupResource adds a controller that reconciles Resource.
+func SetupResource(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ResourceGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = h.preCreate
+			e.postCreate = postCreate
+			e.preUpdate = h.preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Resource{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ResourceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Resource, obj *svcsdk.GetResourceInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Resource, _ *svcsdk.Resource, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Resource, resp *svcsdk.Resource) (bool, error) {
+	return len(patchOperations(cr.Spec.ForProvider, resp)) == 0, nil
+}
+
+// patchOperations returns the operations that update the observed Resource to
+// the desired parameters. A Resource without a parent is kept wherever it
+// was created.
+func patchOperations(p svcapitypes.ResourceParameters, obs *svcsdk.Resource) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.String("/pathPart", p.PathPart, obs.PathPart)
+	ops.String("/parentId", p.ParentID, obs.ParentId)
+	return ops
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.Resource, obj *svcsdk.CreateResourceInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ParentId = cr.Spec.ForProvider.ParentID
+	if obj.ParentId != nil {
+		return nil
+	}
+	id, err := apigateway.RootResourceID(ctx, h.client, obj.RestApiId)
+	obj.ParentId = id
+	return err
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Resource, resp *svcsdk.Resource, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Id))
+	return cre, nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Resource, obj *svcsdk.UpdateResourceInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = awsclients.String(meta.GetExternalName(cr))
+	observed, err := h.client.GetResourceWithContext(ctx, &svcsdk.GetResourceInput{
+		RestApiId:  obj.RestApiId,
+		ResourceId: obj.ResourceId,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errGetResource)
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Resource, obj *svcsdk.DeleteResourceInput) (bool, error) {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.ResourceId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
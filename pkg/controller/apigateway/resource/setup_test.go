@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.Resource{
+		ParentId: aws.String("root"),
+		PathPart: aws.String("pets"),
+	}
+	cases := map[string]struct {
+		desired svcapitypes.ResourceParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.ResourceParameters{
+				PathPart:                 aws.String("pets"),
+				CustomResourceParameters: svcapitypes.CustomResourceParameters{ParentID: aws.String("root")},
+			},
+			want: apigateway.PatchOperations{},
+		},
+		"ParentUnset": {
+			desired: svcapitypes.ResourceParameters{PathPart: aws.String("pets")},
+			want:    apigateway.PatchOperations{},
+		},
+		"Moved": {
+			desired: svcapitypes.ResourceParameters{
+				PathPart:                 aws.String("dogs"),
+				CustomResourceParameters: svcapitypes.CustomResourceParameters{ParentID: aws.String("pets")},
+			},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/pathPart"), Value: aws.String("dogs")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/parentId"), Value: aws.String("pets")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.Resource{
+		ParentId: aws.String("root"),
+		PathPart: aws.String("pets"),
+	}
+	cases := map[string]struct {
+		desired svcapitypes.ResourceParameters
+		want    bool
+	}{
+		"UpToDate": {
+			desired: svcapitypes.ResourceParameters{PathPart: aws.String("pets")},
+			want:    true,
+		},
+		"PathPartChanged": {
+			desired: svcapitypes.ResourceParameters{PathPart: aws.String("dogs")},
+			want:    false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Resource{}
+			cr.Spec.ForProvider = tc.desired
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+// AnnotationKeyBodyChecksum is the SHA-256 checksum of the body that was last
+// put into the RestAPI. API Gateway does not return the definition it was
+// given, so the checksum is how changes to the body are detected.
+const AnnotationKeyBodyChecksum = "apigateway.aws.crossplane.io/body-checksum"
+
+const (
+	errGetRestAPI = "cannot get RestAPI"
+	errPutBody    = "cannot put body into RestAPI"
+	errKubeUpdate = "cannot update RestAPI custom resource"
+	errUpdateTags = "cannot update tags of RestAPI"
+)
+
+// SetupRestAPI adds a controller that reconciles RestAPI.
+func SetupRestAPI(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.RestAPIGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client, kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.postCreate = h.postCreate
+			e.preUpdate = h.preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.RestAPI{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.RestAPIGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+	kube   client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.RestAPI, obj *svcsdk.GetRestApiInput) error {
+	obj.RestApiId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.RestAPI, _ *svcsdk.RestApi, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func lateInitialize(spec *svcapitypes.RestAPIParameters, resp *svcsdk.RestApi) error {
+	spec.APIKeySource = awsclients.LateInitializeStringPtr(spec.APIKeySource, resp.ApiKeySource)
+	spec.DisableExecuteAPIEndpoint = awsclients.LateInitializeBoolPtr(spec.DisableExecuteAPIEndpoint, resp.DisableExecuteApiEndpoint)
+	if spec.EndpointConfiguration == nil && resp.EndpointConfiguration != nil {
+		spec.EndpointConfiguration = &svcapitypes.EndpointConfiguration{
+			Types: resp.EndpointConfiguration.Types,
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.RestAPI, resp *svcsdk.RestApi) (bool, error) {
+	return len(patchOperations(cr.Spec.ForProvider, resp)) == 0 &&
+		isBodyUpToDate(cr) &&
+		apigateway.AreTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+// patchOperations returns the operations that update the observed RestAPI to
+// the desired parameters.
+func patchOperations(p svcapitypes.RestAPIParameters, obs *svcsdk.RestApi) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.String("/name", p.Name, obs.Name)
+	ops.String("/description", p.Description, obs.Description)
+	ops.String("/apiKeySource", p.APIKeySource, obs.ApiKeySource)
+	ops.Bool("/disableExecuteApiEndpoint", p.DisableExecuteAPIEndpoint, obs.DisableExecuteApiEndpoint)
+	ops.Int64("/minimumCompressionSize", p.MinimumCompressionSize, obs.MinimumCompressionSize)
+	if p.Policy != nil && !isPolicyUpToDate(p.Policy, obs.Policy) {
+		ops.String("/policy", p.Policy, nil)
+	}
+	ops.Members("/binaryMediaTypes", p.BinaryMediaTypes, obs.BinaryMediaTypes)
+	if p.EndpointConfiguration != nil {
+		observed := &svcsdk.EndpointConfiguration{}
+		if obs.EndpointConfiguration != nil {
+			observed = obs.EndpointConfiguration
+		}
+		// A RestAPI has exactly one endpoint type, which is replaced in
+		// place.
+		if len(p.EndpointConfiguration.Types) > 0 && len(observed.Types) > 0 {
+			ops.String("/endpointConfiguration/types/"+awsclients.StringValue(observed.Types[0]),
+				p.EndpointConfiguration.Types[0], observed.Types[0])
+		}
+		ops.Values("/endpointConfiguration/vpcEndpointIds", p.EndpointConfiguration.VPCEndpointIDs, observed.VpcEndpointIds)
+	}
+	return ops
+}
+
+// isPolicyUpToDate compares the policies as JSON documents. API Gateway
+// returns the policy with its quotes escaped.
+func isPolicyUpToDate(desired, observed *string) bool {
+	if awsclients.IsPolicyUpToDate(desired, observed) {
+		return true
+	}
+	unescaped := strings.ReplaceAll(awsclients.StringValue(observed), `\"`, `"`)
+	return awsclients.IsPolicyUpToDate(desired, &unescaped)
+}
+
+// bodyChecksum returns the checksum of the body of the RestAPI, or an empty
+// string if it has none.
+func bodyChecksum(cr *svcapitypes.RestAPI) string {
+	if cr.Spec.ForProvider.Body == nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(*cr.Spec.ForProvider.Body))
+	return hex.EncodeToString(sum[:])
+}
+
+func isBodyUpToDate(cr *svcapitypes.RestAPI) bool {
+	return bodyChecksum(cr) == cr.GetAnnotations()[AnnotationKeyBodyChecksum]
+}
+
+// putBody imports the body of the RestAPI and records its checksum.
+func (h *hooks) putBody(ctx context.Context, cr *svcapitypes.RestAPI) (*svcsdk.RestApi, error) {
+	mode := cr.Spec.ForProvider.Mode
+	if mode == nil {
+		mode = awsclients.String(svcapitypes.RestAPIModeOverwrite)
+	}
+	resp, err := h.client.PutRestApiWithContext(ctx, &svcsdk.PutRestApiInput{
+		RestApiId:      awsclients.String(meta.GetExternalName(cr)),
+		Body:           []byte(awsclients.StringValue(cr.Spec.ForProvider.Body)),
+		Mode:           mode,
+		FailOnWarnings: cr.Spec.ForProvider.FailOnWarnings,
+		Parameters:     cr.Spec.ForProvider.Parameters,
+	})
+	if err != nil {
+		return nil, awsclients.Wrap(err, errPutBody)
+	}
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeyBodyChecksum: bodyChecksum(cr)})
+	return resp, nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.RestAPI, resp *svcsdk.RestApi, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Id))
+	if cr.Spec.ForProvider.Body == nil {
+		return cre, nil
+	}
+	// The annotations of the RestAPI are persisted after it was created,
+	// so the checksum does not need to be saved here.
+	_, err = h.putBody(ctx, cr)
+	return cre, err
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.RestAPI, obj *svcsdk.UpdateRestApiInput) error {
+	obj.RestApiId = awsclients.String(meta.GetExternalName(cr))
+	var observed *svcsdk.RestApi
+	var err error
+	if isBodyUpToDate(cr) {
+		observed, err = h.client.GetRestApiWithContext(ctx, &svcsdk.GetRestApiInput{RestApiId: obj.RestApiId})
+		if err != nil {
+			return awsclients.Wrap(err, errGetRestAPI)
+		}
+	} else {
+		// Putting the body may change the settings of the RestAPI, so it
+		// is put before the remaining differences are patched.
+		observed, err = h.putBody(ctx, cr)
+		if err != nil {
+			return err
+		}
+		if err := h.kube.Update(ctx, cr); err != nil {
+			return errors.Wrap(err, errKubeUpdate)
+		}
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.RestAPI, resp *svcsdk.RestApi, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	arn := apigateway.ARN(cr.Spec.ForProvider.Region, "/restapis/"+meta.GetExternalName(cr))
+	return upd, errors.Wrap(apigateway.UpdateTags(ctx, h.client, arn, cr.Spec.ForProvider.Tags, resp.Tags), errUpdateTags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.RestAPI, obj *svcsdk.DeleteRestApiInput) (bool, error) {
+	obj.RestApiId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restapi
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"execute-api:Invoke","Resource":"*"}]}`
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.RestApi{
+		Name:   aws.String("petstore"),
+		Policy: aws.String(`{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"execute-api:Invoke\",\"Resource\":\"*\"}]}`),
+		EndpointConfiguration: &svcsdk.EndpointConfiguration{
+			Types: []*string{aws.String(svcsdk.EndpointTypeEdge)},
+		},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.RestAPIParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.RestAPIParameters{
+				Name:   aws.String("petstore"),
+				Policy: aws.String(policy),
+			},
+			want: apigateway.PatchOperations{},
+		},
+		"EndpointTypeChanged": {
+			desired: svcapitypes.RestAPIParameters{
+				Name: aws.String("petstore"),
+				EndpointConfiguration: &svcapitypes.EndpointConfiguration{
+					Types: []*string{aws.String(svcsdk.EndpointTypeRegional)},
+				},
+			},
+			want: apigateway.PatchOperations{
+				{
+					Op:    aws.String(svcsdk.OpReplace),
+					Path:  aws.String("/endpointConfiguration/types/EDGE"),
+					Value: aws.String(svcsdk.EndpointTypeRegional),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBodyUpToDate(t *testing.T) {
+	body := "openapi: 3.0.1\n"
+	applied := &svcapitypes.RestAPI{Spec: svcapitypes.RestAPISpec{ForProvider: svcapitypes.RestAPIParameters{
+		CustomRestAPIParameters: svcapitypes.CustomRestAPIParameters{Body: aws.String(body)},
+	}}}
+	applied.SetAnnotations(map[string]string{AnnotationKeyBodyChecksum: bodyChecksum(applied)})
+
+	cases := map[string]struct {
+		cr   *svcapitypes.RestAPI
+		want bool
+	}{
+		"NoBody": {
+			cr:   &svcapitypes.RestAPI{},
+			want: true,
+		},
+		"BodyNotPut": {
+			cr: &svcapitypes.RestAPI{Spec: svcapitypes.RestAPISpec{ForProvider: svcapitypes.RestAPIParameters{
+				CustomRestAPIParameters: svcapitypes.CustomRestAPIParameters{Body: aws.String(body)},
+			}}},
+			want: false,
+		},
+		"BodyPut": {
+			cr:   applied,
+			want: true,
+		},
+		"BodyChanged": {
+			cr: &svcapitypes.RestAPI{
+				ObjectMeta: metav1.ObjectMeta{Annotations: applied.GetAnnotations()},
+				Spec: svcapitypes.RestAPISpec{ForProvider: svcapitypes.RestAPIParameters{
+					CustomRestAPIParameters: svcapitypes.CustomRestAPIParameters{Body: aws.String("openapi: 3.0.2\n")},
+				}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isBodyUpToDate(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const (
+	errGetStage   = "cannot get Stage"
+	errUpdateTags = "cannot update tags of Stage"
+)
+
+// SetupStage adds a controller that reconciles Stage.
+func SetupStage(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.StageGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = h.preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Stage{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.StageGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.GetStageInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.StageName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Stage, _ *svcsdk.Stage, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Stage, resp *svcsdk.Stage) (bool, error) {
+	return len(patchOperations(cr.Spec.ForProvider, resp)) == 0 &&
+		apigateway.AreTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+// patchOperations returns the operations that update the observed Stage to
+// the desired parameters.
+func patchOperations(p svcapitypes.StageParameters, obs *svcsdk.Stage) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.String("/description", p.Description, obs.Description)
+	ops.String("/deploymentId", p.DeploymentID, obs.DeploymentId)
+	ops.Bool("/cacheClusterEnabled", p.CacheClusterEnabled, obs.CacheClusterEnabled)
+	ops.String("/cacheClusterSize", p.CacheClusterSize, obs.CacheClusterSize)
+	ops.Bool("/tracingEnabled", p.TracingEnabled, obs.TracingEnabled)
+	ops.String("/documentationVersion", p.DocumentationVersion, obs.DocumentationVersion)
+	ops.Map("/variables", p.Variables, obs.Variables)
+	return ops
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.CreateStageInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.DeploymentId = cr.Spec.ForProvider.DeploymentID
+	obj.StageName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Stage, obj *svcsdk.UpdateStageInput) error {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.StageName = awsclients.String(meta.GetExternalName(cr))
+	observed, err := h.client.GetStageWithContext(ctx, &svcsdk.GetStageInput{
+		RestApiId: obj.RestApiId,
+		StageName: obj.StageName,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errGetStage)
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Stage, resp *svcsdk.Stage, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	arn := apigateway.ARN(cr.Spec.ForProvider.Region,
+		"/restapis/"+awsclients.StringValue(cr.Spec.ForProvider.RestAPIID)+"/stages/"+meta.GetExternalName(cr))
+	return upd, errors.Wrap(apigateway.UpdateTags(ctx, h.client, arn, cr.Spec.ForProvider.Tags, resp.Tags), errUpdateTags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.DeleteStageInput) (bool, error) {
+	obj.RestApiId = cr.Spec.ForProvider.RestAPIID
+	obj.StageName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
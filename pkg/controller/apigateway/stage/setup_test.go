@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.Stage{
+		DeploymentId:   aws.String("d1"),
+		TracingEnabled: aws.Bool(false),
+		Variables:      map[string]*string{"lambdaAlias": aws.String("test")},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.StageParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.StageParameters{
+				Variables:             map[string]*string{"lambdaAlias": aws.String("test")},
+				CustomStageParameters: svcapitypes.CustomStageParameters{DeploymentID: aws.String("d1")},
+			},
+			want: apigateway.PatchOperations{},
+		},
+		"Redeployed": {
+			desired: svcapitypes.StageParameters{
+				TracingEnabled:        aws.Bool(true),
+				Variables:             map[string]*string{"lambdaAlias": aws.String("prod")},
+				CustomStageParameters: svcapitypes.CustomStageParameters{DeploymentID: aws.String("d2")},
+			},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/deploymentId"), Value: aws.String("d2")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/tracingEnabled"), Value: aws.String("true")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/variables/lambdaAlias"), Value: aws.String("prod")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.Stage{
+		Description: aws.String("production"),
+		Tags:        map[string]*string{"team": aws.String("pets")},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.StageParameters
+		want    bool
+	}{
+		"UpToDate": {
+			desired: svcapitypes.StageParameters{
+				Description: aws.String("production"),
+				Tags:        map[string]*string{"team": aws.String("pets")},
+			},
+			want: true,
+		},
+		"DescriptionChanged": {
+			desired: svcapitypes.StageParameters{
+				Description: aws.String("staging"),
+				Tags:        map[string]*string{"team": aws.String("pets")},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			desired: svcapitypes.StageParameters{
+				Description: aws.String("production"),
+				Tags:        map[string]*string{"team": aws.String("store")},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Stage{}
+			cr.Spec.ForProvider = tc.desired
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
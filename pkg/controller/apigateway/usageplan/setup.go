@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplan
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigateway/apigatewayiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+const (
+	errGetUsagePlan = "cannot get UsagePlan"
+	errUpdateTags   = "cannot update tags of UsagePlan"
+)
+
+// SetupUsagePlan adds a controller that reconciles UsagePlan.
+func SetupUsagePlan(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.UsagePlanGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = h.preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.UsagePlan{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.UsagePlanGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.APIGatewayAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.UsagePlan, obj *svcsdk.GetUsagePlanInput) error {
+	obj.UsagePlanId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.UsagePlan, _ *svcsdk.UsagePlan, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.UsagePlan, resp *svcsdk.UsagePlan) (bool, error) {
+	return len(patchOperations(cr.Spec.ForProvider, resp)) == 0 &&
+		apigateway.AreTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+// patchOperations returns the operations that update the observed UsagePlan
+// to the desired parameters.
+func patchOperations(p svcapitypes.UsagePlanParameters, obs *svcsdk.UsagePlan) apigateway.PatchOperations {
+	ops := apigateway.PatchOperations{}
+	ops.String("/name", p.Name, obs.Name)
+	ops.String("/description", p.Description, obs.Description)
+	if p.Throttle != nil {
+		observed := &svcsdk.ThrottleSettings{}
+		if obs.Throttle != nil {
+			observed = obs.Throttle
+		}
+		ops.Float64("/throttle/rateLimit", p.Throttle.RateLimit, observed.RateLimit)
+		ops.Int64("/throttle/burstLimit", p.Throttle.BurstLimit, observed.BurstLimit)
+	}
+	if p.Quota != nil {
+		observed := &svcsdk.QuotaSettings{}
+		if obs.Quota != nil {
+			observed = obs.Quota
+		}
+		ops.Int64("/quota/limit", p.Quota.Limit, observed.Limit)
+		ops.String("/quota/period", p.Quota.Period, observed.Period)
+		ops.Int64("/quota/offset", p.Quota.Offset, observed.Offset)
+	}
+	if p.APIStages != nil {
+		ops.Values("/apiStages", apiStages(p.APIStages), observedAPIStages(obs.ApiStages))
+	}
+	return ops
+}
+
+// apiStages returns the given stages the way they are addressed in patch
+// operations, like a1b2c3:prod.
+func apiStages(stages []*svcapitypes.APIStage) []*string {
+	r := make([]*string, 0, len(stages))
+	for _, s := range stages {
+		r = append(r, awsclients.String(awsclients.StringValue(s.APIID)+":"+awsclients.StringValue(s.Stage)))
+	}
+	return r
+}
+
+func observedAPIStages(stages []*svcsdk.ApiStage) []*string {
+	r := make([]*string, 0, len(stages))
+	for _, s := range stages {
+		r = append(r, awsclients.String(awsclients.StringValue(s.ApiId)+":"+awsclients.StringValue(s.Stage)))
+	}
+	return r
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.UsagePlan, resp *svcsdk.UsagePlan, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Id))
+	return cre, nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.UsagePlan, obj *svcsdk.UpdateUsagePlanInput) error {
+	obj.UsagePlanId = awsclients.String(meta.GetExternalName(cr))
+	observed, err := h.client.GetUsagePlanWithContext(ctx, &svcsdk.GetUsagePlanInput{UsagePlanId: obj.UsagePlanId})
+	if err != nil {
+		return awsclients.Wrap(err, errGetUsagePlan)
+	}
+	obj.PatchOperations = patchOperations(cr.Spec.ForProvider, observed)
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.UsagePlan, resp *svcsdk.UsagePlan, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	arn := apigateway.ARN(cr.Spec.ForProvider.Region, "/usageplans/"+meta.GetExternalName(cr))
+	return upd, errors.Wrap(apigateway.UpdateTags(ctx, h.client, arn, cr.Spec.ForProvider.Tags, resp.Tags), errUpdateTags)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.UsagePlan, obj *svcsdk.DeleteUsagePlanInput) (bool, error) {
+	obj.UsagePlanId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
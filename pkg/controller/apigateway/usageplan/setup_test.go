@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplan
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigateway"
+)
+
+func TestPatchOperations(t *testing.T) {
+	observed := &svcsdk.UsagePlan{
+		Name:      aws.String("basic"),
+		Throttle:  &svcsdk.ThrottleSettings{RateLimit: aws.Float64(10), BurstLimit: aws.Int64(20)},
+		ApiStages: []*svcsdk.ApiStage{{ApiId: aws.String("a1b2c3"), Stage: aws.String("test")}},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.UsagePlanParameters
+		want    apigateway.PatchOperations
+	}{
+		"UpToDate": {
+			desired: svcapitypes.UsagePlanParameters{
+				Name:      aws.String("basic"),
+				Throttle:  &svcapitypes.ThrottleSettings{RateLimit: aws.Float64(10), BurstLimit: aws.Int64(20)},
+				APIStages: []*svcapitypes.APIStage{{APIID: aws.String("a1b2c3"), Stage: aws.String("test")}},
+			},
+			want: apigateway.PatchOperations{},
+		},
+		"QuotaAdded": {
+			desired: svcapitypes.UsagePlanParameters{
+				Name:  aws.String("basic"),
+				Quota: &svcapitypes.QuotaSettings{Limit: aws.Int64(1000), Period: aws.String("DAY")},
+			},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/quota/limit"), Value: aws.String("1000")},
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/quota/period"), Value: aws.String("DAY")},
+			},
+		},
+		"ThrottleAndStagesChanged": {
+			desired: svcapitypes.UsagePlanParameters{
+				Name:      aws.String("basic"),
+				Throttle:  &svcapitypes.ThrottleSettings{RateLimit: aws.Float64(5.5)},
+				APIStages: []*svcapitypes.APIStage{{APIID: aws.String("a1b2c3"), Stage: aws.String("prod")}},
+			},
+			want: apigateway.PatchOperations{
+				{Op: aws.String(svcsdk.OpReplace), Path: aws.String("/throttle/rateLimit"), Value: aws.String("5.5")},
+				{Op: aws.String(svcsdk.OpRemove), Path: aws.String("/apiStages"), Value: aws.String("a1b2c3:test")},
+				{Op: aws.String(svcsdk.OpAdd), Path: aws.String("/apiStages"), Value: aws.String("a1b2c3:prod")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := patchOperations(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.UsagePlan{
+		Name: aws.String("basic"),
+		Tags: map[string]*string{"team": aws.String("pets")},
+	}
+	cases := map[string]struct {
+		desired svcapitypes.UsagePlanParameters
+		want    bool
+	}{
+		"UpToDate": {
+			desired: svcapitypes.UsagePlanParameters{
+				Name: aws.String("basic"),
+				Tags: map[string]*string{"team": aws.String("pets")},
+			},
+			want: true,
+		},
+		"NameChanged": {
+			desired: svcapitypes.UsagePlanParameters{
+				Name: aws.String("premium"),
+				Tags: map[string]*string{"team": aws.String("pets")},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			desired: svcapitypes.UsagePlanParameters{
+				Name: aws.String("basic"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.UsagePlan{}
+			cr.Spec.ForProvider = tc.desired
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
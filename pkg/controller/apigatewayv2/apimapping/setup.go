@@ -41,8 +41,10 @@ func SetupAPIMapping(mgr ctrl.Manager, o controller.Options) error {
 		func(e *external) {
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
 			e.preCreate = preCreate
 			e.postCreate = postCreate
+			e.preUpdate = preUpdate
 			e.preDelete = preDelete
 		},
 	}
@@ -73,6 +75,17 @@ func postObserve(_ context.Context, cr *svcapitypes.APIMapping, _ *svcsdk.GetApi
 	return obs, nil
 }
 
+func isUpToDate(cr *svcapitypes.APIMapping, resp *svcsdk.GetApiMappingOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case aws.StringValue(p.APIMappingKey) != aws.StringValue(resp.ApiMappingKey),
+		aws.StringValue(p.APIID) != aws.StringValue(resp.ApiId),
+		aws.StringValue(p.Stage) != aws.StringValue(resp.Stage):
+		return false, nil
+	}
+	return true, nil
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.APIMapping, obj *svcsdk.CreateApiMappingInput) error {
 	obj.ApiId = cr.Spec.ForProvider.APIID
 	obj.DomainName = cr.Spec.ForProvider.DomainName
@@ -88,6 +101,14 @@ func postCreate(_ context.Context, cr *svcapitypes.APIMapping, resp *svcsdk.Crea
 	return cre, nil
 }
 
+func preUpdate(_ context.Context, cr *svcapitypes.APIMapping, obj *svcsdk.UpdateApiMappingInput) error {
+	obj.ApiMappingId = aws.String(meta.GetExternalName(cr))
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.DomainName = cr.Spec.ForProvider.DomainName
+	obj.Stage = cr.Spec.ForProvider.Stage
+	return nil
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.APIMapping, obj *svcsdk.DeleteApiMappingInput) (bool, error) {
 	obj.ApiMappingId = aws.String(meta.GetExternalName(cr))
 	obj.DomainName = cr.Spec.ForProvider.DomainName
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apimapping
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func apiMapping(key string) *svcapitypes.APIMapping {
+	return &svcapitypes.APIMapping{
+		Spec: svcapitypes.APIMappingSpec{ForProvider: svcapitypes.APIMappingParameters{
+			Region:        "us-east-1",
+			APIMappingKey: aws.String(key),
+			CustomAPIMappingParameters: svcapitypes.CustomAPIMappingParameters{
+				APIID:      aws.String("api"),
+				Stage:      aws.String("prod"),
+				DomainName: aws.String("api.example.com"),
+			},
+		}},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.GetApiMappingOutput{
+		ApiId:         aws.String("api"),
+		ApiMappingId:  aws.String("abc"),
+		ApiMappingKey: aws.String("v1"),
+		Stage:         aws.String("prod"),
+	}
+	cases := map[string]struct {
+		cr   *svcapitypes.APIMapping
+		resp *svcsdk.GetApiMappingOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr:   apiMapping("v1"),
+			resp: observed,
+			want: true,
+		},
+		"KeyChanged": {
+			cr:   apiMapping("v2"),
+			resp: observed,
+			want: false,
+		},
+		"StageChanged": {
+			cr: func() *svcapitypes.APIMapping {
+				cr := apiMapping("v1")
+				cr.Spec.ForProvider.Stage = aws.String("dev")
+				return cr
+			}(),
+			resp: observed,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := isUpToDate(tc.cr, tc.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
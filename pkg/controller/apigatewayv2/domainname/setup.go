@@ -20,6 +20,7 @@ import (
 	"context"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -33,14 +34,22 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errGetDomainName = "cannot get DomainName"
+)
+
 // SetupDomainName adds a controller that reconciles DomainName.
 func SetupDomainName(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.DomainNameGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{client: e.client}
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
 			e.preCreate = preCreate
+			e.preUpdate = h.preUpdate
 			e.preDelete = preDelete
 		},
 	}
@@ -56,24 +65,118 @@ func SetupDomainName(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+type hooks struct {
+	client svcsdkapi.ApiGatewayV2API
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.DomainName, obj *svcsdk.GetDomainNameInput) error {
 	obj.DomainName = aws.String(meta.GetExternalName(cr))
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.DomainName, _ *svcsdk.GetDomainNameOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func postObserve(_ context.Context, cr *svcapitypes.DomainName, resp *svcsdk.GetDomainNameOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	cr.Status.AtProvider.CustomDomainNameObservation = svcapitypes.CustomDomainNameObservation{}
+	if len(resp.DomainNameConfigurations) > 0 {
+		cfg := resp.DomainNameConfigurations[0]
+		cr.Status.AtProvider.RegionalDomainName = cfg.ApiGatewayDomainName
+		cr.Status.AtProvider.RegionalHostedZoneID = cfg.HostedZoneId
+	}
+	if resp.MutualTlsAuthentication != nil {
+		cr.Status.AtProvider.TruststoreWarnings = resp.MutualTlsAuthentication.TruststoreWarnings
+	}
+	if isUpdating(resp) {
+		cr.SetConditions(xpv1.Unavailable())
+		return obs, nil
+	}
 	cr.SetConditions(xpv1.Available())
 	return obs, nil
 }
 
+// isUpdating returns true if any domain name configuration is being updated.
+// Such a domain name cannot be modified until the update is done.
+func isUpdating(resp *svcsdk.GetDomainNameOutput) bool {
+	for _, cfg := range resp.DomainNameConfigurations {
+		if aws.StringValue(cfg.DomainNameStatus) == svcsdk.DomainNameStatusUpdating {
+			return true
+		}
+	}
+	return false
+}
+
+func lateInitialize(p *svcapitypes.DomainNameParameters, resp *svcsdk.GetDomainNameOutput) error {
+	for i, cfg := range p.DomainNameConfigurations {
+		if cfg == nil || i >= len(resp.DomainNameConfigurations) {
+			continue
+		}
+		o := resp.DomainNameConfigurations[i]
+		cfg.EndpointType = aws.LateInitializeStringPtr(cfg.EndpointType, o.EndpointType)
+		cfg.SecurityPolicy = aws.LateInitializeStringPtr(cfg.SecurityPolicy, o.SecurityPolicy)
+	}
+	if p.MutualTLSAuthentication != nil && resp.MutualTlsAuthentication != nil {
+		p.MutualTLSAuthentication.TruststoreVersion = aws.LateInitializeStringPtr(p.MutualTLSAuthentication.TruststoreVersion, resp.MutualTlsAuthentication.TruststoreVersion)
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.DomainName, resp *svcsdk.GetDomainNameOutput) (bool, error) {
+	if isUpdating(resp) {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	if len(p.DomainNameConfigurations) != len(resp.DomainNameConfigurations) {
+		return false, nil
+	}
+	for i, cfg := range p.DomainNameConfigurations {
+		if cfg == nil {
+			continue
+		}
+		o := resp.DomainNameConfigurations[i]
+		switch {
+		case cfg.CertificateARN != nil && aws.StringValue(cfg.CertificateARN) != aws.StringValue(o.CertificateArn),
+			cfg.EndpointType != nil && aws.StringValue(cfg.EndpointType) != aws.StringValue(o.EndpointType),
+			cfg.SecurityPolicy != nil && aws.StringValue(cfg.SecurityPolicy) != aws.StringValue(o.SecurityPolicy),
+			cfg.OwnershipVerificationCertificateARN != nil && aws.StringValue(cfg.OwnershipVerificationCertificateARN) != aws.StringValue(o.OwnershipVerificationCertificateArn):
+			return false, nil
+		}
+	}
+	return isMutualTLSUpToDate(p.MutualTLSAuthentication, resp.MutualTlsAuthentication), nil
+}
+
+func isMutualTLSUpToDate(spec *svcapitypes.MutualTLSAuthenticationInput, o *svcsdk.MutualTlsAuthentication) bool {
+	if spec == nil || spec.TruststoreURI == nil {
+		return o == nil || o.TruststoreUri == nil
+	}
+	if o == nil || aws.StringValue(spec.TruststoreURI) != aws.StringValue(o.TruststoreUri) {
+		return false
+	}
+	return spec.TruststoreVersion == nil || aws.StringValue(spec.TruststoreVersion) == aws.StringValue(o.TruststoreVersion)
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.DomainName, obj *svcsdk.CreateDomainNameInput) error {
 	obj.DomainName = aws.String(meta.GetExternalName(cr))
 	return nil
 }
 
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.DomainName, obj *svcsdk.UpdateDomainNameInput) error {
+	obj.DomainName = aws.String(meta.GetExternalName(cr))
+	if obj.MutualTlsAuthentication != nil {
+		return nil
+	}
+	resp, err := h.client.GetDomainNameWithContext(ctx, &svcsdk.GetDomainNameInput{DomainName: obj.DomainName})
+	if err != nil {
+		return aws.Wrap(err, errGetDomainName)
+	}
+	// Mutual TLS is turned off by updating the domain name with an empty
+	// truststore URI.
+	if resp.MutualTlsAuthentication != nil && aws.StringValue(resp.MutualTlsAuthentication.TruststoreUri) != "" {
+		obj.MutualTlsAuthentication = &svcsdk.MutualTlsAuthenticationInput{TruststoreUri: aws.String("")}
+	}
+	return nil
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.DomainName, obj *svcsdk.DeleteDomainNameInput) (bool, error) {
 	obj.DomainName = aws.String(meta.GetExternalName(cr))
 	return false, nil
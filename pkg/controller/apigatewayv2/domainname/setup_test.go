@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainname
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	certificateARN = "arn:aws:acm:us-east-1:123456789012:certificate/abc"
+	truststoreURI  = "s3://truststore/ca.pem"
+)
+
+type mockClient struct {
+	svcsdkapi.ApiGatewayV2API
+
+	getDomainName func(*svcsdk.GetDomainNameInput) (*svcsdk.GetDomainNameOutput, error)
+}
+
+func (m *mockClient) GetDomainNameWithContext(_ context.Context, in *svcsdk.GetDomainNameInput, _ ...request.Option) (*svcsdk.GetDomainNameOutput, error) {
+	return m.getDomainName(in)
+}
+
+func domainName(m ...func(*svcapitypes.DomainNameParameters)) *svcapitypes.DomainName {
+	cr := &svcapitypes.DomainName{
+		Spec: svcapitypes.DomainNameSpec{ForProvider: svcapitypes.DomainNameParameters{
+			Region: "us-east-1",
+			DomainNameConfigurations: []*svcapitypes.DomainNameConfiguration{{
+				CertificateARN: aws.String(certificateARN),
+				EndpointType:   aws.String(svcsdk.EndpointTypeRegional),
+				SecurityPolicy: aws.String(svcsdk.SecurityPolicyTls12),
+			}},
+			MutualTLSAuthentication: &svcapitypes.MutualTLSAuthenticationInput{
+				TruststoreURI: aws.String(truststoreURI),
+			},
+		}},
+	}
+	meta.SetExternalName(cr, "api.example.com")
+	for _, f := range m {
+		f(&cr.Spec.ForProvider)
+	}
+	return cr
+}
+
+func observedDomainName(m ...func(*svcsdk.GetDomainNameOutput)) *svcsdk.GetDomainNameOutput {
+	o := &svcsdk.GetDomainNameOutput{
+		DomainName: aws.String("api.example.com"),
+		DomainNameConfigurations: []*svcsdk.DomainNameConfiguration{{
+			ApiGatewayDomainName: aws.String("d-abc.execute-api.us-east-1.amazonaws.com"),
+			CertificateArn:       aws.String(certificateARN),
+			DomainNameStatus:     aws.String(svcsdk.DomainNameStatusAvailable),
+			EndpointType:         aws.String(svcsdk.EndpointTypeRegional),
+			HostedZoneId:         aws.String("Z1UJRXOUMOOFQ8"),
+			SecurityPolicy:       aws.String(svcsdk.SecurityPolicyTls12),
+		}},
+		MutualTlsAuthentication: &svcsdk.MutualTlsAuthentication{
+			TruststoreUri:      aws.String(truststoreURI),
+			TruststoreVersion:  aws.String("1"),
+			TruststoreWarnings: []*string{aws.String("expired")},
+		},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestPostObserve(t *testing.T) {
+	type want struct {
+		obs managed.ExternalObservation
+		cr  *svcapitypes.DomainName
+	}
+	withStatus := func(c xpv1.Condition) *svcapitypes.DomainName {
+		cr := domainName()
+		cr.Status.AtProvider.CustomDomainNameObservation = svcapitypes.CustomDomainNameObservation{
+			RegionalDomainName:   aws.String("d-abc.execute-api.us-east-1.amazonaws.com"),
+			RegionalHostedZoneID: aws.String("Z1UJRXOUMOOFQ8"),
+			TruststoreWarnings:   []*string{aws.String("expired")},
+		}
+		cr.SetConditions(c)
+		return cr
+	}
+
+	cases := map[string]struct {
+		resp *svcsdk.GetDomainNameOutput
+		want want
+	}{
+		"Available": {
+			resp: observedDomainName(),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+				cr:  withStatus(xpv1.Available()),
+			},
+		},
+		"Updating": {
+			resp: observedDomainName(func(o *svcsdk.GetDomainNameOutput) {
+				o.DomainNameConfigurations[0].DomainNameStatus = aws.String(svcsdk.DomainNameStatusUpdating)
+			}),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+				cr:  withStatus(xpv1.Unavailable()),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := domainName()
+			obs, err := postObserve(context.Background(), cr, tc.resp, managed.ExternalObservation{ResourceExists: true}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("postObserve(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("postObserve(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.DomainName
+		resp *svcsdk.GetDomainNameOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr:   domainName(),
+			resp: observedDomainName(),
+			want: true,
+		},
+		"CertificateChanged": {
+			cr: domainName(func(p *svcapitypes.DomainNameParameters) {
+				p.DomainNameConfigurations[0].CertificateARN = aws.String("arn:aws:acm:us-east-1:123456789012:certificate/def")
+			}),
+			resp: observedDomainName(),
+			want: false,
+		},
+		"SecurityPolicyChanged": {
+			cr: domainName(func(p *svcapitypes.DomainNameParameters) {
+				p.DomainNameConfigurations[0].SecurityPolicy = aws.String(svcsdk.SecurityPolicyTls10)
+			}),
+			resp: observedDomainName(),
+			want: false,
+		},
+		"UpdatingIsUpToDate": {
+			cr: domainName(func(p *svcapitypes.DomainNameParameters) {
+				p.DomainNameConfigurations[0].SecurityPolicy = aws.String(svcsdk.SecurityPolicyTls10)
+			}),
+			resp: observedDomainName(func(o *svcsdk.GetDomainNameOutput) {
+				o.DomainNameConfigurations[0].DomainNameStatus = aws.String(svcsdk.DomainNameStatusUpdating)
+			}),
+			want: true,
+		},
+		"TruststoreVersionChanged": {
+			cr: domainName(func(p *svcapitypes.DomainNameParameters) {
+				p.MutualTLSAuthentication.TruststoreVersion = aws.String("2")
+			}),
+			resp: observedDomainName(),
+			want: false,
+		},
+		"MutualTLSRemoved": {
+			cr: domainName(func(p *svcapitypes.DomainNameParameters) {
+				p.MutualTLSAuthentication = nil
+			}),
+			resp: observedDomainName(),
+			want: false,
+		},
+		"MutualTLSAdded": {
+			cr: domainName(),
+			resp: observedDomainName(func(o *svcsdk.GetDomainNameOutput) {
+				o.MutualTlsAuthentication = nil
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := isUpToDate(tc.cr, tc.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type want struct {
+		obj *svcsdk.UpdateDomainNameInput
+		err error
+	}
+	cases := map[string]struct {
+		obj      *svcsdk.UpdateDomainNameInput
+		observed *svcsdk.GetDomainNameOutput
+		getErr   error
+		want     want
+	}{
+		"MutualTLSKept": {
+			obj: &svcsdk.UpdateDomainNameInput{
+				MutualTlsAuthentication: &svcsdk.MutualTlsAuthenticationInput{TruststoreUri: aws.String(truststoreURI)},
+			},
+			want: want{
+				obj: &svcsdk.UpdateDomainNameInput{
+					DomainName:              aws.String("api.example.com"),
+					MutualTlsAuthentication: &svcsdk.MutualTlsAuthenticationInput{TruststoreUri: aws.String(truststoreURI)},
+				},
+			},
+		},
+		"MutualTLSRemoved": {
+			obj:      &svcsdk.UpdateDomainNameInput{},
+			observed: observedDomainName(),
+			want: want{
+				obj: &svcsdk.UpdateDomainNameInput{
+					DomainName:              aws.String("api.example.com"),
+					MutualTlsAuthentication: &svcsdk.MutualTlsAuthenticationInput{TruststoreUri: aws.String("")},
+				},
+			},
+		},
+		"MutualTLSNeverSet": {
+			obj: &svcsdk.UpdateDomainNameInput{},
+			observed: observedDomainName(func(o *svcsdk.GetDomainNameOutput) {
+				o.MutualTlsAuthentication = nil
+			}),
+			want: want{
+				obj: &svcsdk.UpdateDomainNameInput{DomainName: aws.String("api.example.com")},
+			},
+		},
+		"GetDomainNameFailed": {
+			obj:    &svcsdk.UpdateDomainNameInput{},
+			getErr: errBoom,
+			want: want{
+				obj: &svcsdk.UpdateDomainNameInput{DomainName: aws.String("api.example.com")},
+				err: aws.Wrap(errBoom, errGetDomainName),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: &mockClient{
+				getDomainName: func(*svcsdk.GetDomainNameInput) (*svcsdk.GetDomainNameOutput, error) {
+					return tc.observed, tc.getErr
+				},
+			}}
+			err := h.preUpdate(context.Background(), domainName(), tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("preUpdate(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.obj); diff != "" {
+				t.Errorf("preUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
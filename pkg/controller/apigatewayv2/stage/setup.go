@@ -19,7 +19,9 @@ package stage
 import (
 	"context"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -33,14 +35,23 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errGetStage                = "cannot get Stage"
+	errDeleteAccessLogSettings = "cannot delete access log settings of Stage"
+)
+
 // SetupStage adds a controller that reconciles Stage.
 func SetupStage(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.StageGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{client: e.client}
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
 			e.preCreate = preCreate
+			e.preUpdate = h.preUpdate
 			e.preDelete = preDelete
 		},
 	}
@@ -56,6 +67,10 @@ func SetupStage(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+type hooks struct {
+	client svcsdkapi.ApiGatewayV2API
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.GetStageInput) error {
 	obj.ApiId = cr.Spec.ForProvider.APIID
 	obj.StageName = aws.String(meta.GetExternalName(cr))
@@ -70,12 +85,120 @@ func postObserve(_ context.Context, cr *svcapitypes.Stage, _ *svcsdk.GetStageOut
 	return obs, nil
 }
 
+func lateInitialize(p *svcapitypes.StageParameters, resp *svcsdk.GetStageOutput) error {
+	p.AutoDeploy = aws.LateInitializeBoolPtr(p.AutoDeploy, resp.AutoDeploy)
+	if resp.DefaultRouteSettings != nil {
+		if p.DefaultRouteSettings == nil {
+			p.DefaultRouteSettings = &svcapitypes.RouteSettings{}
+		}
+		rs, o := p.DefaultRouteSettings, resp.DefaultRouteSettings
+		rs.DataTraceEnabled = aws.LateInitializeBoolPtr(rs.DataTraceEnabled, o.DataTraceEnabled)
+		rs.DetailedMetricsEnabled = aws.LateInitializeBoolPtr(rs.DetailedMetricsEnabled, o.DetailedMetricsEnabled)
+		rs.LoggingLevel = aws.LateInitializeStringPtr(rs.LoggingLevel, o.LoggingLevel)
+		rs.ThrottlingBurstLimit = aws.LateInitializeInt64Ptr(rs.ThrottlingBurstLimit, o.ThrottlingBurstLimit)
+		if rs.ThrottlingRateLimit == nil {
+			rs.ThrottlingRateLimit = o.ThrottlingRateLimit
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Stage, resp *svcsdk.GetStageOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	if !isAccessLogSettingsUpToDate(p.AccessLogSettings, resp.AccessLogSettings) {
+		return false, nil
+	}
+	if aws.BoolValue(p.AutoDeploy) != aws.BoolValue(resp.AutoDeploy) {
+		return false, nil
+	}
+	// The deployment of an auto-deployed stage is managed by API Gateway.
+	if !aws.BoolValue(p.AutoDeploy) && p.DeploymentID != nil &&
+		aws.StringValue(p.DeploymentID) != aws.StringValue(resp.DeploymentId) {
+		return false, nil
+	}
+	if p.Description != nil && aws.StringValue(p.Description) != aws.StringValue(resp.Description) {
+		return false, nil
+	}
+	if p.DefaultRouteSettings != nil && !isRouteSettingsUpToDate(p.DefaultRouteSettings, resp.DefaultRouteSettings) {
+		return false, nil
+	}
+	return isStageVariablesUpToDate(p.StageVariables, resp.StageVariables), nil
+}
+
+func isAccessLogSettingsUpToDate(desired *svcapitypes.AccessLogSettings, observed *svcsdk.AccessLogSettings) bool {
+	if desired == nil || observed == nil {
+		return desired == nil && observed == nil
+	}
+	return aws.StringValue(desired.DestinationARN) == aws.StringValue(observed.DestinationArn) &&
+		aws.StringValue(desired.Format) == aws.StringValue(observed.Format)
+}
+
+func isRouteSettingsUpToDate(desired *svcapitypes.RouteSettings, observed *svcsdk.RouteSettings) bool {
+	if observed == nil {
+		observed = &svcsdk.RouteSettings{}
+	}
+	return aws.BoolValue(desired.DataTraceEnabled) == aws.BoolValue(observed.DataTraceEnabled) &&
+		aws.BoolValue(desired.DetailedMetricsEnabled) == aws.BoolValue(observed.DetailedMetricsEnabled) &&
+		aws.StringValue(desired.LoggingLevel) == aws.StringValue(observed.LoggingLevel) &&
+		aws.Int64Value(desired.ThrottlingBurstLimit) == aws.Int64Value(observed.ThrottlingBurstLimit) &&
+		awssdk.Float64Value(desired.ThrottlingRateLimit) == awssdk.Float64Value(observed.ThrottlingRateLimit)
+}
+
+func isStageVariablesUpToDate(desired, observed map[string]*string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	for k, v := range desired {
+		o, ok := observed[k]
+		if !ok || aws.StringValue(v) != aws.StringValue(o) {
+			return false
+		}
+	}
+	return true
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.CreateStageInput) error {
 	obj.ApiId = cr.Spec.ForProvider.APIID
 	obj.StageName = aws.String(meta.GetExternalName(cr))
 	return nil
 }
 
+// preUpdate fills in the parts of the update that cannot be expressed by
+// sending the desired state alone: access log settings are removed through a
+// separate call and stage variables are removed by setting them to an empty
+// value.
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Stage, obj *svcsdk.UpdateStageInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.StageName = aws.String(meta.GetExternalName(cr))
+	if aws.BoolValue(cr.Spec.ForProvider.AutoDeploy) {
+		obj.DeploymentId = nil
+	}
+	resp, err := h.client.GetStageWithContext(ctx, &svcsdk.GetStageInput{
+		ApiId:     obj.ApiId,
+		StageName: obj.StageName,
+	})
+	if err != nil {
+		return aws.Wrap(err, errGetStage)
+	}
+	if cr.Spec.ForProvider.AccessLogSettings == nil && resp.AccessLogSettings != nil {
+		if _, err := h.client.DeleteAccessLogSettingsWithContext(ctx, &svcsdk.DeleteAccessLogSettingsInput{
+			ApiId:     obj.ApiId,
+			StageName: obj.StageName,
+		}); err != nil {
+			return aws.Wrap(err, errDeleteAccessLogSettings)
+		}
+	}
+	for k := range resp.StageVariables {
+		if _, ok := cr.Spec.ForProvider.StageVariables[k]; !ok {
+			if obj.StageVariables == nil {
+				obj.StageVariables = map[string]*string{}
+			}
+			obj.StageVariables[k] = aws.String("")
+		}
+	}
+	return nil
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.Stage, obj *svcsdk.DeleteStageInput) (bool, error) {
 	obj.StageName = aws.String(meta.GetExternalName(cr))
 	obj.ApiId = cr.Spec.ForProvider.CustomStageParameters.APIID
@@ -0,0 +1,233 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/apigatewayv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apigatewayv2/apigatewayv2iface"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	logGroupARN = "arn:aws:logs:us-east-1:123456789012:log-group:access"
+)
+
+type mockClient struct {
+	svcsdkapi.ApiGatewayV2API
+
+	getStage                func(*svcsdk.GetStageInput) (*svcsdk.GetStageOutput, error)
+	deleteAccessLogSettings func(*svcsdk.DeleteAccessLogSettingsInput) (*svcsdk.DeleteAccessLogSettingsOutput, error)
+}
+
+func (m *mockClient) GetStageWithContext(_ context.Context, in *svcsdk.GetStageInput, _ ...request.Option) (*svcsdk.GetStageOutput, error) {
+	return m.getStage(in)
+}
+
+func (m *mockClient) DeleteAccessLogSettingsWithContext(_ context.Context, in *svcsdk.DeleteAccessLogSettingsInput, _ ...request.Option) (*svcsdk.DeleteAccessLogSettingsOutput, error) {
+	return m.deleteAccessLogSettings(in)
+}
+
+func stage(m ...func(*svcapitypes.StageParameters)) *svcapitypes.Stage {
+	cr := &svcapitypes.Stage{
+		Spec: svcapitypes.StageSpec{ForProvider: svcapitypes.StageParameters{
+			Region: "us-east-1",
+			AccessLogSettings: &svcapitypes.AccessLogSettings{
+				DestinationARN: aws.String(logGroupARN),
+				Format:         aws.String("$context.requestId"),
+			},
+			AutoDeploy: aws.Bool(true),
+			DefaultRouteSettings: &svcapitypes.RouteSettings{
+				ThrottlingBurstLimit: aws.Int64(100),
+				ThrottlingRateLimit:  awssdk.Float64(50),
+			},
+			StageVariables: map[string]*string{"env": aws.String("dev")},
+			CustomStageParameters: svcapitypes.CustomStageParameters{
+				APIID: aws.String("api"),
+			},
+		}},
+	}
+	meta.SetExternalName(cr, "dev")
+	for _, f := range m {
+		f(&cr.Spec.ForProvider)
+	}
+	return cr
+}
+
+func observedStage() *svcsdk.GetStageOutput {
+	return &svcsdk.GetStageOutput{
+		AccessLogSettings: &svcsdk.AccessLogSettings{
+			DestinationArn: aws.String(logGroupARN),
+			Format:         aws.String("$context.requestId"),
+		},
+		AutoDeploy:   aws.Bool(true),
+		DeploymentId: aws.String("auto"),
+		DefaultRouteSettings: &svcsdk.RouteSettings{
+			ThrottlingBurstLimit: aws.Int64(100),
+			ThrottlingRateLimit:  awssdk.Float64(50),
+		},
+		StageVariables: map[string]*string{"env": aws.String("dev")},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.Stage
+		want bool
+	}{
+		"UpToDate": {
+			cr:   stage(),
+			want: true,
+		},
+		"AccessLogFormatChanged": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.AccessLogSettings.Format = aws.String("$context.path")
+			}),
+			want: false,
+		},
+		"AccessLogSettingsRemoved": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.AccessLogSettings = nil
+			}),
+			want: false,
+		},
+		"AutoDeployDisabled": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.AutoDeploy = aws.Bool(false)
+			}),
+			want: false,
+		},
+		"DeploymentIgnoredWhenAutoDeployed": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.DeploymentID = aws.String("manual")
+			}),
+			want: true,
+		},
+		"ThrottlingChanged": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.DefaultRouteSettings.ThrottlingBurstLimit = aws.Int64(200)
+			}),
+			want: false,
+		},
+		"StageVariableRemoved": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.StageVariables = nil
+			}),
+			want: false,
+		},
+		"StageVariableChanged": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.StageVariables["env"] = aws.String("prod")
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, observedStage())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type want struct {
+		obj     *svcsdk.UpdateStageInput
+		deleted bool
+		err     error
+	}
+	cases := map[string]struct {
+		cr       *svcapitypes.Stage
+		observed *svcsdk.GetStageOutput
+		getErr   error
+		want     want
+	}{
+		"AutoDeployDropsDeployment": {
+			cr:       stage(),
+			observed: observedStage(),
+			want: want{
+				obj: &svcsdk.UpdateStageInput{ApiId: aws.String("api"), StageName: aws.String("dev")},
+			},
+		},
+		"RemoveAccessLogSettingsAndStageVariables": {
+			cr: stage(func(p *svcapitypes.StageParameters) {
+				p.AccessLogSettings = nil
+				p.StageVariables = nil
+			}),
+			observed: observedStage(),
+			want: want{
+				obj: &svcsdk.UpdateStageInput{
+					ApiId:          aws.String("api"),
+					StageName:      aws.String("dev"),
+					StageVariables: map[string]*string{"env": aws.String("")},
+				},
+				deleted: true,
+			},
+		},
+		"GetStageFailed": {
+			cr:     stage(),
+			getErr: errBoom,
+			want: want{
+				obj: &svcsdk.UpdateStageInput{ApiId: aws.String("api"), StageName: aws.String("dev")},
+				err: aws.Wrap(errBoom, errGetStage),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			h := &hooks{client: &mockClient{
+				getStage: func(*svcsdk.GetStageInput) (*svcsdk.GetStageOutput, error) {
+					return tc.observed, tc.getErr
+				},
+				deleteAccessLogSettings: func(*svcsdk.DeleteAccessLogSettingsInput) (*svcsdk.DeleteAccessLogSettingsOutput, error) {
+					deleted = true
+					return &svcsdk.DeleteAccessLogSettingsOutput{}, nil
+				},
+			}}
+			obj := &svcsdk.UpdateStageInput{DeploymentId: aws.String("auto")}
+			err := h.preUpdate(context.Background(), tc.cr, obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("preUpdate(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, obj); diff != "" {
+				t.Errorf("preUpdate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("DeleteAccessLogSettings called: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/appregistry"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/appregistry/appregistryiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appregistry/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListAttributeGroups        = "cannot list associated attribute groups"
+	errAssociateAttributeGroup    = "cannot associate attribute group"
+	errDisassociateAttributeGroup = "cannot disassociate attribute group"
+)
+
+// SetupApplication adds a controller that reconciles Application.
+func SetupApplication(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ApplicationGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Application{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ApplicationGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.AppRegistryAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Application, obj *svcsdk.GetApplicationInput) error {
+	obj.Application = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Application, _ *svcsdk.GetApplicationOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.Application, resp *svcsdk.GetApplicationOutput) (bool, error) {
+	observed := GenerateApplication(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomApplicationParameters = cr.Spec.ForProvider.CustomApplicationParameters
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	if cr.Spec.ForProvider.AttributeGroups == nil {
+		return true, nil
+	}
+	current, err := h.listAttributeGroups(context.TODO(), meta.GetExternalName(cr))
+	if err != nil {
+		return false, err
+	}
+	add, remove := DiffAttributeGroups(aws.StringValueSlice(cr.Spec.ForProvider.AttributeGroups), current)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Application, resp *svcsdk.CreateApplicationOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if resp.Application != nil {
+		meta.SetExternalName(cr, awsclients.StringValue(resp.Application.Id))
+	}
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Application, obj *svcsdk.UpdateApplicationInput) error {
+	obj.Application = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postUpdate associates the attribute groups of the spec with the
+// application and disassociates all others. Attribute groups are left alone
+// if the spec does not list any.
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Application, _ *svcsdk.UpdateApplicationOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil || cr.Spec.ForProvider.AttributeGroups == nil {
+		return upd, err
+	}
+	app := meta.GetExternalName(cr)
+	current, err := h.listAttributeGroups(ctx, app)
+	if err != nil {
+		return upd, err
+	}
+	add, remove := DiffAttributeGroups(aws.StringValueSlice(cr.Spec.ForProvider.AttributeGroups), current)
+	for _, id := range add {
+		if _, err := h.client.AssociateAttributeGroupWithContext(ctx, &svcsdk.AssociateAttributeGroupInput{
+			Application:    awsclients.String(app),
+			AttributeGroup: awsclients.String(id),
+		}); err != nil {
+			return upd, errors.Wrap(err, errAssociateAttributeGroup)
+		}
+	}
+	for _, id := range remove {
+		if _, err := h.client.DisassociateAttributeGroupWithContext(ctx, &svcsdk.DisassociateAttributeGroupInput{
+			Application:    awsclients.String(app),
+			AttributeGroup: awsclients.String(id),
+		}); err != nil {
+			return upd, errors.Wrap(err, errDisassociateAttributeGroup)
+		}
+	}
+	return upd, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Application, obj *svcsdk.DeleteApplicationInput) (bool, error) {
+	obj.Application = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func (h *hooks) listAttributeGroups(ctx context.Context, app string) ([]string, error) {
+	var ids []string
+	err := h.client.ListAssociatedAttributeGroupsPagesWithContext(ctx, &svcsdk.ListAssociatedAttributeGroupsInput{Application: awsclients.String(app)},
+		func(page *svcsdk.ListAssociatedAttributeGroupsOutput, _ bool) bool {
+			ids = append(ids, aws.StringValueSlice(page.AttributeGroups)...)
+			return true
+		})
+	return ids, errors.Wrap(err, errListAttributeGroups)
+}
+
+// DiffAttributeGroups returns the attribute groups that have to be
+// associated with and disassociated from the application.
+func DiffAttributeGroups(desired, current []string) (add, remove []string) {
+	c := make(map[string]bool, len(current))
+	for _, id := range current {
+		c[id] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		d[id] = true
+		if !c[id] {
+			add = append(add, id)
+		}
+	}
+	for _, id := range current {
+		if !d[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
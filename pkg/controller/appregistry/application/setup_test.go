@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package application
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffAttributeGroups(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+	cases := map[string]struct {
+		desired []string
+		current []string
+		want    want
+	}{
+		"InSync": {
+			desired: []string{"a", "b"},
+			current: []string{"b", "a"},
+			want:    want{},
+		},
+		"Associate": {
+			desired: []string{"a", "b"},
+			current: []string{"a"},
+			want:    want{add: []string{"b"}},
+		},
+		"Disassociate": {
+			desired: []string{},
+			current: []string{"a", "b"},
+			want:    want{remove: []string{"a", "b"}},
+		},
+		"Replace": {
+			desired: []string{"c"},
+			current: []string{"a"},
+			want:    want{add: []string{"c"}, remove: []string{"a"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffAttributeGroups(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
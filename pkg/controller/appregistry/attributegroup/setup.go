@@ -0,0 +1,119 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package attributegroup
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appregistry"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appregistry/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupAttributeGroup adds a controller that reconciles AttributeGroup.
+func SetupAttributeGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.AttributeGroupGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.AttributeGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.AttributeGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.AttributeGroup, obj *svcsdk.GetAttributeGroupInput) error {
+	obj.AttributeGroup = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.AttributeGroup, _ *svcsdk.GetAttributeGroupOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.AttributeGroup, resp *svcsdk.GetAttributeGroupOutput) (bool, error) {
+	if !isAttributesUpToDate(cr.Spec.ForProvider.Attributes, resp.Attributes) {
+		return false, nil
+	}
+	observed := GenerateAttributeGroup(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.Attributes = cr.Spec.ForProvider.Attributes
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+// isAttributesUpToDate compares the attributes as JSON documents so that
+// formatting and key order do not cause updates.
+func isAttributesUpToDate(local, remote *string) bool {
+	var l, r interface{}
+	if err := json.Unmarshal([]byte(awsclients.StringValue(local)), &l); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(awsclients.StringValue(remote)), &r); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(l, r)
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.AttributeGroup, resp *svcsdk.CreateAttributeGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if resp.AttributeGroup != nil {
+		meta.SetExternalName(cr, awsclients.StringValue(resp.AttributeGroup.Id))
+	}
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.AttributeGroup, obj *svcsdk.UpdateAttributeGroupInput) error {
+	obj.AttributeGroup = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.AttributeGroup, obj *svcsdk.DeleteAttributeGroupInput) (bool, error) {
+	obj.AttributeGroup = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
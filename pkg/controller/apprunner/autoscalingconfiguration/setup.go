@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalingconfiguration
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apprunner"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupAutoScalingConfiguration adds a controller that reconciles AutoScalingConfiguration.
+func SetupAutoScalingConfiguration(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.AutoScalingConfigurationGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.AutoScalingConfiguration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.AutoScalingConfigurationGroupVersionKind),
+			managed.WithInitializers(),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.AutoScalingConfiguration, obj *svcsdk.DescribeAutoScalingConfigurationInput) error {
+	obj.AutoScalingConfigurationArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postObserve reports deleted revisions as gone. App Runner keeps describing
+// them as INACTIVE for a while.
+func postObserve(_ context.Context, cr *svcapitypes.AutoScalingConfiguration, resp *svcsdk.DescribeAutoScalingConfigurationOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if awsclients.StringValue(resp.AutoScalingConfiguration.Status) == svcsdk.AutoScalingConfigurationStatusInactive {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.AutoScalingConfiguration, obj *svcsdk.CreateAutoScalingConfigurationInput) error {
+	obj.AutoScalingConfigurationName = awsclients.String(cr.GetName())
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.AutoScalingConfiguration, resp *svcsdk.CreateAutoScalingConfigurationOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.AutoScalingConfiguration.AutoScalingConfigurationArn))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.AutoScalingConfiguration, obj *svcsdk.DeleteAutoScalingConfigurationInput) (bool, error) {
+	obj.AutoScalingConfigurationArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apprunner"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/apprunner/apprunneriface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTagsForResource = "cannot list tags"
+	errTagResource         = "cannot tag resource"
+	errUntagResource       = "cannot untag resource"
+)
+
+// SetupService adds a controller that reconciles Service.
+func SetupService(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ServiceGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ServiceGroupVersionKind),
+			managed.WithInitializers(),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.AppRunnerAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.DescribeServiceInput) error {
+	obj.ServiceArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postObserve publishes the URL of the service as its endpoint. A service
+// that is being updated stays available.
+func postObserve(_ context.Context, cr *svcapitypes.Service, resp *svcsdk.DescribeServiceOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Service.Status) {
+	case svcsdk.ServiceStatusRunning:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ServiceStatusOperationInProgress:
+		if cr.GetCondition(xpv1.TypeReady).Reason != xpv1.ReasonAvailable {
+			cr.SetConditions(xpv1.Creating())
+		}
+	case svcsdk.ServiceStatusDeleted:
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(awsclients.StringValue(resp.Service.ServiceUrl)),
+	}
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.Service, resp *svcsdk.DescribeServiceOutput) (bool, error) {
+	// App Runner rejects updates while an operation is in progress.
+	if awsclients.StringValue(resp.Service.Status) == svcsdk.ServiceStatusOperationInProgress {
+		return true, nil
+	}
+	observed := GenerateService(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.EncryptionConfiguration = cr.Spec.ForProvider.EncryptionConfiguration
+	observed.CustomServiceParameters = cr.Spec.ForProvider.CustomServiceParameters
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	if arn := cr.Spec.ForProvider.AutoScalingConfigurationARN; arn != nil && resp.Service.AutoScalingConfigurationSummary != nil &&
+		awsclients.StringValue(arn) != awsclients.StringValue(resp.Service.AutoScalingConfigurationSummary.AutoScalingConfigurationArn) {
+		return false, nil
+	}
+	add, remove, err := diffTagsForResource(context.TODO(), h.client, cr.Spec.ForProvider.Tags, resp.Service.ServiceArn)
+	return len(add) == 0 && len(remove) == 0, err
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.CreateServiceInput) error {
+	obj.ServiceName = awsclients.String(cr.GetName())
+	obj.AutoScalingConfigurationArn = cr.Spec.ForProvider.AutoScalingConfigurationARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Service, resp *svcsdk.CreateServiceOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Service.ServiceArn))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.UpdateServiceInput) error {
+	obj.ServiceArn = awsclients.String(meta.GetExternalName(cr))
+	obj.AutoScalingConfigurationArn = cr.Spec.ForProvider.AutoScalingConfigurationARN
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Service, _ *svcsdk.UpdateServiceOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	arn := awsclients.String(meta.GetExternalName(cr))
+	add, remove, err := diffTagsForResource(ctx, h.client, cr.Spec.ForProvider.Tags, arn)
+	if err != nil {
+		return upd, err
+	}
+	if len(remove) != 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceArn: arn,
+			TagKeys:     remove,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceArn: arn,
+			Tags:        add,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errTagResource)
+		}
+	}
+	return upd, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.DeleteServiceInput) (bool, error) {
+	switch awsclients.StringValue(cr.Status.AtProvider.Status) {
+	case svcsdk.ServiceStatusOperationInProgress, svcsdk.ServiceStatusDeleted:
+		return true, nil
+	}
+	obj.ServiceArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+// diffTagsForResource returns the tags that have to be added to and the keys
+// of the tags that have to be removed from the resource with the given ARN.
+func diffTagsForResource(ctx context.Context, client svcsdkapi.AppRunnerAPI, spec []*svcapitypes.Tag, arn *string) ([]*svcsdk.Tag, []*string, error) {
+	resp, err := client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{ResourceArn: arn})
+	if err != nil {
+		return nil, nil, awsclients.Wrap(err, errListTagsForResource)
+	}
+	add, remove := diffTags(spec, resp.Tags)
+	return add, remove, nil
+}
+
+func diffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk.Tag, removeTags []*string) {
+	currentMap := make(map[string]string, len(current))
+	for _, t := range current {
+		currentMap[awsclients.StringValue(t.Key)] = awsclients.StringValue(t.Value)
+	}
+	specMap := make(map[string]struct{}, len(spec))
+	for _, t := range spec {
+		key := awsclients.StringValue(t.Key)
+		val := awsclients.StringValue(t.Value)
+		specMap[key] = struct{}{}
+		if currentVal, exists := currentMap[key]; !exists || currentVal != val {
+			addTags = append(addTags, &svcsdk.Tag{
+				Key:   awsclients.String(key),
+				Value: awsclients.String(val),
+			})
+		}
+	}
+	for _, t := range current {
+		key := awsclients.StringValue(t.Key)
+		if _, exists := specMap[key]; !exists {
+			removeTags = append(removeTags, awsclients.String(key))
+		}
+	}
+	return addTags, removeTags
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/apprunner/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const serviceURL = "abcdefghij.us-east-1.awsapprunner.com"
+
+func TestPostObserve(t *testing.T) {
+	type want struct {
+		obs        managed.ExternalObservation
+		conditions []xpv1.Condition
+	}
+	details := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(serviceURL),
+	}
+	cases := map[string]struct {
+		status     string
+		conditions []xpv1.Condition
+		want       want
+	}{
+		"Running": {
+			status: svcsdk.ServiceStatusRunning,
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ConnectionDetails: details},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"Creating": {
+			status: svcsdk.ServiceStatusOperationInProgress,
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ConnectionDetails: details},
+				conditions: []xpv1.Condition{xpv1.Creating()},
+			},
+		},
+		"Updating": {
+			status:     svcsdk.ServiceStatusOperationInProgress,
+			conditions: []xpv1.Condition{xpv1.Available()},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ConnectionDetails: details},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"CreateFailed": {
+			status: svcsdk.ServiceStatusCreateFailed,
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ConnectionDetails: details},
+				conditions: []xpv1.Condition{xpv1.Unavailable()},
+			},
+		},
+		"Deleted": {
+			status: svcsdk.ServiceStatusDeleted,
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Service{}
+			cr.SetConditions(tc.conditions...)
+			resp := &svcsdk.DescribeServiceOutput{Service: &svcsdk.Service{
+				Status:     awsclients.String(tc.status),
+				ServiceUrl: awsclients.String(serviceURL),
+			}}
+			obs, err := postObserve(context.Background(), cr, resp, managed.ExternalObservation{ResourceExists: true}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	spec := []*svcapitypes.Tag{
+		{Key: awsclients.String("team"), Value: awsclients.String("platform")},
+		{Key: awsclients.String("env"), Value: awsclients.String("prod")},
+	}
+	current := []*svcsdk.Tag{
+		{Key: awsclients.String("team"), Value: awsclients.String("web")},
+		{Key: awsclients.String("owner"), Value: awsclients.String("alice")},
+	}
+	add, remove := diffTags(spec, current)
+	wantAdd := []*svcsdk.Tag{
+		{Key: awsclients.String("team"), Value: awsclients.String("platform")},
+		{Key: awsclients.String("env"), Value: awsclients.String("prod")},
+	}
+	if diff := cmp.Diff(wantAdd, add); diff != "" {
+		t.Errorf("add: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]*string{awsclients.String("owner")}, remove); diff != "" {
+		t.Errorf("remove: -want, +got:\n%s", diff)
+	}
+}
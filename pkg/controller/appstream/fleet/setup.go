@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appstream"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appstream/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errStart = "cannot start Fleet"
+	errStop  = "cannot stop Fleet"
+)
+
+// SetupFleet adds a controller that reconciles Fleet.
+func SetupFleet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.FleetGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = h.postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = h.preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Fleet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.FleetGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.AppStreamAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.DescribeFleetsInput) error {
+	obj.Names = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func filterList(cr *svcapitypes.Fleet, list *svcsdk.DescribeFleetsOutput) *svcsdk.DescribeFleetsOutput {
+	res := &svcsdk.DescribeFleetsOutput{}
+	for _, f := range list.Fleets {
+		if awsclients.StringValue(f.Name) == meta.GetExternalName(cr) {
+			res.Fleets = append(res.Fleets, f)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Fleet, resp *svcsdk.DescribeFleetsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Fleets[0].State) {
+	case svcsdk.FleetStateRunning:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.FleetStateStarting:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.FleetStateStopping, svcsdk.FleetStateStopped:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Fleet, resp *svcsdk.DescribeFleetsOutput) (bool, error) {
+	f := resp.Fleets[0]
+	// Fleets can only be updated while they are running or stopped.
+	if s := awsclients.StringValue(f.State); s == svcsdk.FleetStateStarting || s == svcsdk.FleetStateStopping {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	if p.ComputeCapacity != nil && f.ComputeCapacityStatus != nil &&
+		awsclients.Int64Value(p.ComputeCapacity.DesiredInstances) != awsclients.Int64Value(f.ComputeCapacityStatus.Desired) {
+		return false, nil
+	}
+	if p.IAMRoleARN != nil && awsclients.StringValue(p.IAMRoleARN) != awsclients.StringValue(f.IamRoleArn) {
+		return false, nil
+	}
+	if p.VPCConfig != nil && !isVPCConfigUpToDate(p.VPCConfig, f.VpcConfig) {
+		return false, nil
+	}
+	observed := GenerateFleet(resp).Spec.ForProvider
+	observed.Region = p.Region
+	observed.Tags = p.Tags
+	observed.ComputeCapacity = p.ComputeCapacity
+	observed.CustomFleetParameters = p.CustomFleetParameters
+	return awsclients.IsJSONSubset(p, observed)
+}
+
+func isVPCConfigUpToDate(desired *svcapitypes.CustomVPCConfig, observed *svcsdk.VpcConfig) bool {
+	if observed == nil {
+		return len(desired.SubnetIDs) == 0 && len(desired.SecurityGroupIDs) == 0
+	}
+	return sameStrings(desired.SubnetIDs, observed.SubnetIds) &&
+		sameStrings(desired.SecurityGroupIDs, observed.SecurityGroupIds)
+}
+
+func sameStrings(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := map[string]bool{}
+	for _, s := range b {
+		set[awsclients.StringValue(s)] = true
+	}
+	for _, s := range a {
+		if !set[awsclients.StringValue(s)] {
+			return false
+		}
+	}
+	return true
+}
+
+func generateVPCConfig(cfg *svcapitypes.CustomVPCConfig) *svcsdk.VpcConfig {
+	if cfg == nil {
+		return nil
+	}
+	return &svcsdk.VpcConfig{
+		SubnetIds:        cfg.SubnetIDs,
+		SecurityGroupIds: cfg.SecurityGroupIDs,
+	}
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.CreateFleetInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.IamRoleArn = cr.Spec.ForProvider.IAMRoleARN
+	obj.VpcConfig = generateVPCConfig(cr.Spec.ForProvider.VPCConfig)
+	return nil
+}
+
+// postCreate starts the fleet since AppStream creates fleets in the stopped
+// state.
+func (e *hooks) postCreate(ctx context.Context, cr *svcapitypes.Fleet, _ *svcsdk.CreateFleetOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.StartFleetWithContext(ctx, &svcsdk.StartFleetInput{
+		Name: awsclients.String(meta.GetExternalName(cr)),
+	})
+	return cre, awsclients.Wrap(err, errStart)
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.UpdateFleetInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.IamRoleArn = cr.Spec.ForProvider.IAMRoleARN
+	obj.VpcConfig = generateVPCConfig(cr.Spec.ForProvider.VPCConfig)
+	return nil
+}
+
+// preDelete stops the fleet first since only stopped fleets can be deleted.
+func (e *hooks) preDelete(ctx context.Context, cr *svcapitypes.Fleet, obj *svcsdk.DeleteFleetInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	switch awsclients.StringValue(cr.Status.AtProvider.State) {
+	case svcsdk.FleetStateStopping:
+		return true, nil
+	case svcsdk.FleetStateRunning, svcsdk.FleetStateStarting:
+		_, err := e.client.StopFleetWithContext(ctx, &svcsdk.StopFleetInput{Name: obj.Name})
+		return true, awsclients.Wrap(err, errStop)
+	}
+	return false, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appstream/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.Fleet{
+		Name:                  awsclients.String("fleet"),
+		State:                 awsclients.String(svcsdk.FleetStateRunning),
+		InstanceType:          awsclients.String("stream.standard.medium"),
+		ComputeCapacityStatus: &svcsdk.ComputeCapacityStatus{Desired: awsclients.Int64(2)},
+		VpcConfig: &svcsdk.VpcConfig{
+			SubnetIds:        []*string{awsclients.String("subnet-1"), awsclients.String("subnet-2")},
+			SecurityGroupIds: []*string{awsclients.String("sg-1")},
+		},
+	}
+	desired := func(m ...func(*svcapitypes.FleetParameters)) *svcapitypes.Fleet {
+		p := svcapitypes.FleetParameters{
+			Region:          "us-east-1",
+			InstanceType:    awsclients.String("stream.standard.medium"),
+			ComputeCapacity: &svcapitypes.ComputeCapacity{DesiredInstances: awsclients.Int64(2)},
+			CustomFleetParameters: svcapitypes.CustomFleetParameters{
+				VPCConfig: &svcapitypes.CustomVPCConfig{
+					SubnetIDs:        []*string{awsclients.String("subnet-2"), awsclients.String("subnet-1")},
+					SecurityGroupIDs: []*string{awsclients.String("sg-1")},
+				},
+			},
+		}
+		for _, f := range m {
+			f(&p)
+		}
+		return &svcapitypes.Fleet{Spec: svcapitypes.FleetSpec{ForProvider: p}}
+	}
+	cases := map[string]struct {
+		cr   *svcapitypes.Fleet
+		want bool
+	}{
+		"UpToDate": {
+			cr:   desired(),
+			want: true,
+		},
+		"DifferentCapacity": {
+			cr: desired(func(p *svcapitypes.FleetParameters) {
+				p.ComputeCapacity.DesiredInstances = awsclients.Int64(3)
+			}),
+			want: false,
+		},
+		"DifferentSubnets": {
+			cr: desired(func(p *svcapitypes.FleetParameters) {
+				p.VPCConfig.SubnetIDs = []*string{awsclients.String("subnet-3")}
+			}),
+			want: false,
+		},
+		"DifferentInstanceType": {
+			cr: desired(func(p *svcapitypes.FleetParameters) {
+				p.InstanceType = awsclients.String("stream.standard.large")
+			}),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, &svcsdk.DescribeFleetsOutput{Fleets: []*svcsdk.Fleet{observed}})
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagebuilder
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appstream"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appstream/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupImageBuilder adds a controller that reconciles ImageBuilder.
+func SetupImageBuilder(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ImageBuilderGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ImageBuilder{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ImageBuilderGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ImageBuilder, obj *svcsdk.DescribeImageBuildersInput) error {
+	obj.Names = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func filterList(cr *svcapitypes.ImageBuilder, list *svcsdk.DescribeImageBuildersOutput) *svcsdk.DescribeImageBuildersOutput {
+	res := &svcsdk.DescribeImageBuildersOutput{}
+	for _, b := range list.ImageBuilders {
+		if awsclients.StringValue(b.Name) == meta.GetExternalName(cr) {
+			res.ImageBuilders = append(res.ImageBuilders, b)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ImageBuilder, resp *svcsdk.DescribeImageBuildersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	b := resp.ImageBuilders[0]
+	switch awsclients.StringValue(b.State) {
+	case svcsdk.ImageBuilderStateRunning, svcsdk.ImageBuilderStateStopped:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ImageBuilderStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ImageBuilderStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.ImageBuilderStateFailed:
+		msg := ""
+		if b.StateChangeReason != nil {
+			msg = awsclients.StringValue(b.StateChangeReason.Message)
+		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msg))
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.ImageBuilder, obj *svcsdk.CreateImageBuilderInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.IamRoleArn = cr.Spec.ForProvider.IAMRoleARN
+	if cfg := cr.Spec.ForProvider.VPCConfig; cfg != nil {
+		obj.VpcConfig = &svcsdk.VpcConfig{
+			SubnetIds:        cfg.SubnetIDs,
+			SecurityGroupIds: cfg.SecurityGroupIDs,
+		}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ImageBuilder, obj *svcsdk.DeleteImageBuilderInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return awsclients.StringValue(cr.Status.AtProvider.State) == svcsdk.ImageBuilderStateDeleting, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stack
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appstream"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appstream/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupStack adds a controller that reconciles Stack.
+func SetupStack(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.StackGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Stack{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.StackGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Stack, obj *svcsdk.DescribeStacksInput) error {
+	obj.Names = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func filterList(cr *svcapitypes.Stack, list *svcsdk.DescribeStacksOutput) *svcsdk.DescribeStacksOutput {
+	res := &svcsdk.DescribeStacksOutput{}
+	for _, s := range list.Stacks {
+		if awsclients.StringValue(s.Name) == meta.GetExternalName(cr) {
+			res.Stacks = append(res.Stacks, s)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Stack, _ *svcsdk.DescribeStacksOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Stack, resp *svcsdk.DescribeStacksOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	observed := GenerateStack(resp).Spec.ForProvider
+	observed.Region = p.Region
+	observed.Tags = p.Tags
+	return awsclients.IsJSONSubset(p, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Stack, obj *svcsdk.CreateStackInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Stack, obj *svcsdk.UpdateStackInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Stack, obj *svcsdk.DeleteStackInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
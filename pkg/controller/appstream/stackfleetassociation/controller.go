@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stackfleetassociation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/appstream"
+	svcsdk "github.com/aws/aws-sdk-go/service/appstream"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/appstream/appstreamiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appstream/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a StackFleetAssociation resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot associate Fleet with Stack in AWS"
+	errDescribe      = "failed to list Fleets associated with Stack"
+	errDelete        = "failed to disassociate Fleet from Stack"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.StackFleetAssociation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.AppStreamAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.StackFleetAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	input := &svcsdk.ListAssociatedFleetsInput{StackName: cr.Spec.ForProvider.StackName}
+	for {
+		resp, err := e.client.ListAssociatedFleetsWithContext(ctx, input)
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+		}
+		for _, n := range resp.Names {
+			if awsclient.StringValue(n) == awsclient.StringValue(cr.Spec.ForProvider.FleetName) {
+				cr.SetConditions(xpv1.Available())
+				return managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				}, nil
+			}
+		}
+		if resp.NextToken == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.StackFleetAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.client.AssociateFleetWithContext(ctx, &svcsdk.AssociateFleetInput{
+		FleetName: cr.Spec.ForProvider.FleetName,
+		StackName: cr.Spec.ForProvider.StackName,
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(_ context.Context, _ cpresource.Managed) (managed.ExternalUpdate, error) {
+	// All fields of a StackFleetAssociation are immutable.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.StackFleetAssociation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.DisassociateFleetWithContext(ctx, &svcsdk.DisassociateFleetInput{
+		FleetName: cr.Spec.ForProvider.FleetName,
+		StackName: cr.Spec.ForProvider.StackName,
+	})
+	return awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDelete)
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == "ResourceNotFoundException"
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphqlapi
+
+import (
+	"sort"
+	"strings"
+)
+
+// definitionKinds are the keywords that start a top-level definition of a
+// GraphQL schema.
+var definitionKinds = map[string]bool{
+	"schema":    true,
+	"scalar":    true,
+	"type":      true,
+	"interface": true,
+	"union":     true,
+	"enum":      true,
+	"input":     true,
+	"directive": true,
+	"extend":    true,
+}
+
+// implicitKinds are the kinds of definitions AppSync may add to the schema it
+// reports on its own, which are ignored unless they were given.
+var implicitKinds = map[string]bool{
+	"schema":    true,
+	"scalar":    true,
+	"directive": true,
+}
+
+// isSameSchema reports whether the observed schema in the GraphQL schema
+// definition language has the same definitions as the desired one. The order
+// of the definitions and their members, comments and descriptions are not
+// significant.
+func isSameSchema(desired, observed string) bool {
+	d := normalizeSchema(desired)
+	o := normalizeSchema(observed)
+	for k, v := range d {
+		if o[k] != v {
+			return false
+		}
+	}
+	for k := range o {
+		if _, ok := d[k]; !ok && !implicitKinds[strings.Fields(k)[0]] {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeSchema returns the definitions of the schema by their kind and
+// name, each with its members in a canonical order.
+func normalizeSchema(sdl string) map[string]string {
+	res := map[string]string{}
+	var def []string
+	add := func() {
+		if len(def) == 0 {
+			return
+		}
+		k, v := normalizeDefinition(def)
+		if prev, ok := res[k]; ok {
+			v = prev + " " + v
+		}
+		res[k] = v
+		def = nil
+	}
+	depth := 0
+	for _, t := range tokenize(sdl) {
+		switch t {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+		}
+		// extend is followed by the kind of the extended definition.
+		if depth == 0 && definitionKinds[t] && (len(def) == 0 || def[len(def)-1] != "extend") {
+			add()
+		}
+		def = append(def, t)
+	}
+	add()
+	return res
+}
+
+// normalizeDefinition returns the key of a definition and the definition with
+// the members of its block sorted.
+func normalizeDefinition(def []string) (string, string) {
+	n := 2
+	if def[0] == "extend" {
+		n = 3
+	}
+	if def[0] == "schema" {
+		n = 1
+	}
+	if len(def) < n {
+		n = len(def)
+	}
+	key := strings.Join(def[:n], " ")
+	open := -1
+	for i, t := range def {
+		if t == "{" {
+			open = i
+			break
+		}
+	}
+	if open < 0 || def[len(def)-1] != "}" {
+		return key, strings.Join(def, " ")
+	}
+	members := splitMembers(def[open+1 : len(def)-1])
+	sort.Strings(members)
+	return key, strings.Join(def[:open+1], " ") + " " + strings.Join(members, " ") + " }"
+}
+
+// splitMembers splits the tokens of a block into its fields, input values or
+// enum values. A member starts with a name that is neither nested nor part of
+// a type, a default value or a directive.
+func splitMembers(tokens []string) []string {
+	var members []string
+	var cur []string
+	depth := 0
+	for i, t := range tokens {
+		start := depth == 0 && isName(t)
+		if i > 0 {
+			switch tokens[i-1] {
+			case "@", ":", "=":
+				start = false
+			}
+		}
+		if start && len(cur) > 0 {
+			members = append(members, strings.Join(cur, " "))
+			cur = nil
+		}
+		switch t {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+		}
+		cur = append(cur, t)
+	}
+	if len(cur) > 0 {
+		members = append(members, strings.Join(cur, " "))
+	}
+	return members
+}
+
+func isName(t string) bool {
+	c := t[0]
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// tokenize splits the schema into its tokens. Commas, comments and
+// descriptions are dropped, string values are kept.
+func tokenize(sdl string) []string { // nolint:gocyclo
+	var tokens []string
+	lists := 0
+	for i := 0; i < len(sdl); {
+		c := sdl[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(sdl) && sdl[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(sdl[i:], `"""`):
+			// Block strings are only used for descriptions.
+			end := strings.Index(sdl[i+3:], `"""`)
+			if end < 0 {
+				return tokens
+			}
+			i += end + 6
+		case c == '"':
+			j := i + 1
+			for j < len(sdl) && sdl[j] != '"' && sdl[j] != '\n' {
+				if sdl[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j < len(sdl) {
+				j++
+			}
+			// A string is a value after a colon or within a list, otherwise
+			// it describes what follows.
+			if lists > 0 || (len(tokens) > 0 && (tokens[len(tokens)-1] == ":" || tokens[len(tokens)-1] == "=")) {
+				tokens = append(tokens, sdl[i:j])
+			}
+			i = j
+		case strings.HasPrefix(sdl[i:], "..."):
+			tokens = append(tokens, "...")
+			i += 3
+		case strings.ContainsRune("{}()[]:=@!|&$", rune(c)):
+			switch c {
+			case '[':
+				lists++
+			case ']':
+				lists--
+			}
+			tokens = append(tokens, string(c))
+			i++
+		default:
+			j := i
+			for j < len(sdl) && !strings.ContainsRune(" \t\n\r,#\"{}()[]:=@!|&$", rune(sdl[j])) {
+				j++
+			}
+			tokens = append(tokens, sdl[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphqlapi
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testSchema = `
+# The posts of the blog.
+type Post {
+  id: ID!
+  title: String @deprecated(reason: "use name")
+  tags(first: Int = 10, after: String): [String!]!
+}
+
+"""
+The entry point.
+"""
+type Query {
+  getPost(id: ID!): Post @aws_iam
+  "All posts."
+  listPosts: [Post]
+}
+
+enum Order { ASC DESC }
+
+union Item = Post
+
+schema {
+  query: Query
+}
+`
+
+func TestIsSameSchema(t *testing.T) {
+	type args struct {
+		desired  string
+		observed string
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Identical": {
+			args: args{
+				desired:  testSchema,
+				observed: testSchema,
+			},
+			want: true,
+		},
+		"Reordered": {
+			args: args{
+				desired: testSchema,
+				observed: `schema { query: Query }
+enum Order {
+  DESC
+  ASC
+}
+type Query {
+  listPosts: [Post]
+  getPost(id: ID!): Post @aws_iam
+}
+union Item = Post
+type Post {
+  tags(first: Int = 10 after: String): [String!]!
+  title: String @deprecated(reason: "use name")
+  id: ID!
+}`,
+			},
+			want: true,
+		},
+		"ImplicitDefinitions": {
+			args: args{
+				desired: "type Query { id: ID }",
+				observed: `directive @aws_iam on OBJECT | FIELD_DEFINITION
+scalar AWSDateTime
+schema { query: Query }
+type Query { id: ID }`,
+			},
+			want: true,
+		},
+		"ChangedField": {
+			args: args{
+				desired:  "type Query { id: ID }",
+				observed: "type Query { id: ID! }",
+			},
+			want: false,
+		},
+		"ChangedArgument": {
+			args: args{
+				desired:  `type Query { posts(first: Int = 10): [ID] }`,
+				observed: `type Query { posts(first: Int = 20): [ID] }`,
+			},
+			want: false,
+		},
+		"MissingType": {
+			args: args{
+				desired:  "type Query { id: ID } type Post { id: ID }",
+				observed: "type Query { id: ID }",
+			},
+			want: false,
+		},
+		"ExtraType": {
+			args: args{
+				desired:  "type Query { id: ID }",
+				observed: "type Query { id: ID } type Post { id: ID }",
+			},
+			want: false,
+		},
+		"ChangedDirectiveValue": {
+			args: args{
+				desired:  `type Subscription { onPost: ID @aws_subscribe(mutations: ["addPost"]) }`,
+				observed: `type Subscription { onPost: ID @aws_subscribe(mutations: ["updatePost"]) }`,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isSameSchema(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graphqlapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appsync"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/appsync/appsynciface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AnnotationKeySchemaChecksum is the SHA-256 checksum of the schema whose
+// creation was last started. It tells a failed creation of the current schema
+// apart from one of a previous schema.
+const AnnotationKeySchemaChecksum = "appsync.aws.crossplane.io/schema-checksum"
+
+// Keys of the connection details of a GraphQLAPI.
+const (
+	ConnectionDetailsAPIID       = "apiId"
+	ConnectionDetailsGraphQLURI  = "graphqlUri"
+	ConnectionDetailsRealtimeURI = "realtimeUri"
+)
+
+const (
+	errGetSchemaConfigMap    = "cannot get schema ConfigMap"
+	errSchemaKeyNotFound     = "schema key not found in ConfigMap"
+	errGetSchemaStatus       = "cannot get schema creation status of GraphQLAPI"
+	errGetIntrospection      = "cannot get introspection schema of GraphQLAPI"
+	errStartSchemaCreation   = "cannot start schema creation of GraphQLAPI"
+	errSchemaCreationFailed  = "schema creation of GraphQLAPI failed: %s"
+	errListTags              = "cannot list tags of GraphQLAPI"
+	errTagResource           = "cannot tag GraphQLAPI"
+	errUntagResource         = "cannot untag GraphQLAPI"
+	errKubeUpdate            = "cannot update GraphQLAPI custom resource"
+	errCompareParametersJSON = "cannot compare parameters of GraphQLAPI"
+)
+
+// SetupGraphQLAPI adds a controller that reconciles GraphQLAPI.
+func SetupGraphQLAPI(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.GraphQLAPIGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client, kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.postCreate = h.postCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.GraphQLAPI{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.GraphQLAPIGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.AppSyncAPI
+	kube   client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.GraphQLAPI, obj *svcsdk.GetGraphqlApiInput) error {
+	obj.ApiId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.GraphQLAPI, resp *svcsdk.GetGraphqlApiOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		ConnectionDetailsAPIID: []byte(meta.GetExternalName(cr)),
+	}
+	if uri, ok := resp.GraphqlApi.Uris["GRAPHQL"]; ok {
+		obs.ConnectionDetails[ConnectionDetailsGraphQLURI] = []byte(awsclients.StringValue(uri))
+	}
+	if uri, ok := resp.GraphqlApi.Uris["REALTIME"]; ok {
+		obs.ConnectionDetails[ConnectionDetailsRealtimeURI] = []byte(awsclients.StringValue(uri))
+	}
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.GraphQLAPI, resp *svcsdk.GetGraphqlApiOutput) (bool, error) {
+	desired := cr.Spec.ForProvider.DeepCopy()
+	desired.Region = ""
+	desired.Tags = nil
+	desired.CustomGraphQLAPIParameters = svcapitypes.CustomGraphQLAPIParameters{}
+	ok, err := awsclients.IsJSONSubset(desired, GenerateGraphQLAPI(resp).Spec.ForProvider)
+	if err != nil {
+		return false, errors.Wrap(err, errCompareParametersJSON)
+	}
+	if !ok {
+		return false, nil
+	}
+	add, remove := awsclients.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, resp.GraphqlApi.Tags)
+	if len(add) != 0 || len(remove) != 0 {
+		return false, nil
+	}
+	return h.isSchemaUpToDate(context.TODO(), cr)
+}
+
+// getSchema returns the schema referenced by the GraphQLAPI, or an empty
+// string if it references none.
+func (h *hooks) getSchema(ctx context.Context, cr *svcapitypes.GraphQLAPI) (string, error) {
+	ref := cr.Spec.ForProvider.SchemaConfigMapRef
+	if ref == nil {
+		return "", nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", errors.Wrap(err, errGetSchemaConfigMap)
+	}
+	key := svcapitypes.DefaultSchemaKey
+	if ref.Key != nil {
+		key = *ref.Key
+	}
+	schema, ok := cm.Data[key]
+	if !ok {
+		return "", errors.New(errSchemaKeyNotFound)
+	}
+	return schema, nil
+}
+
+func schemaChecksum(schema string) string {
+	sum := sha256.Sum256([]byte(schema))
+	return hex.EncodeToString(sum[:])
+}
+
+// isSchemaUpToDate compares the referenced schema with the one AppSync
+// reports through introspection. A schema that is still being created is
+// considered up to date, and a failed creation of the current schema is
+// returned as an error.
+func (h *hooks) isSchemaUpToDate(ctx context.Context, cr *svcapitypes.GraphQLAPI) (bool, error) {
+	schema, err := h.getSchema(ctx, cr)
+	if err != nil || schema == "" {
+		return true, err
+	}
+	id := awsclients.String(meta.GetExternalName(cr))
+	status, err := h.client.GetSchemaCreationStatusWithContext(ctx, &svcsdk.GetSchemaCreationStatusInput{ApiId: id})
+	if err != nil {
+		return false, awsclients.Wrap(err, errGetSchemaStatus)
+	}
+	switch awsclients.StringValue(status.Status) {
+	case svcsdk.SchemaStatusProcessing:
+		return true, nil
+	case svcsdk.SchemaStatusFailed:
+		if schemaChecksum(schema) == cr.GetAnnotations()[AnnotationKeySchemaChecksum] {
+			return false, errors.Errorf(errSchemaCreationFailed, awsclients.StringValue(status.Details))
+		}
+		return false, nil
+	}
+	resp, err := h.client.GetIntrospectionSchemaWithContext(ctx, &svcsdk.GetIntrospectionSchemaInput{
+		ApiId:             id,
+		Format:            awsclients.String(svcsdk.OutputTypeSdl),
+		IncludeDirectives: awsclients.Bool(true),
+	})
+	if err != nil {
+		return false, awsclients.Wrap(err, errGetIntrospection)
+	}
+	return isSameSchema(schema, string(resp.Schema)), nil
+}
+
+// startSchemaCreation starts the creation of the referenced schema and
+// records its checksum.
+func (h *hooks) startSchemaCreation(ctx context.Context, cr *svcapitypes.GraphQLAPI) error {
+	schema, err := h.getSchema(ctx, cr)
+	if err != nil || schema == "" {
+		return err
+	}
+	if _, err := h.client.StartSchemaCreationWithContext(ctx, &svcsdk.StartSchemaCreationInput{
+		ApiId:      awsclients.String(meta.GetExternalName(cr)),
+		Definition: []byte(schema),
+	}); err != nil {
+		return awsclients.Wrap(err, errStartSchemaCreation)
+	}
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeySchemaChecksum: schemaChecksum(schema)})
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.GraphQLAPI, resp *svcsdk.CreateGraphqlApiOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.GraphqlApi.ApiId))
+	// The annotations of the GraphQLAPI are persisted after it was created,
+	// so the checksum does not need to be saved here.
+	return cre, h.startSchemaCreation(ctx, cr)
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.GraphQLAPI, obj *svcsdk.UpdateGraphqlApiInput) error {
+	obj.ApiId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.GraphQLAPI, resp *svcsdk.UpdateGraphqlApiOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	if err := h.updateTags(ctx, cr, resp.GraphqlApi.Arn); err != nil {
+		return upd, err
+	}
+	ok, err := h.isSchemaUpToDate(ctx, cr)
+	if err != nil || ok {
+		return upd, err
+	}
+	if err := h.startSchemaCreation(ctx, cr); err != nil {
+		return upd, err
+	}
+	return upd, errors.Wrap(h.kube.Update(ctx, cr), errKubeUpdate)
+}
+
+func (h *hooks) updateTags(ctx context.Context, cr *svcapitypes.GraphQLAPI, arn *string) error {
+	resp, err := h.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{ResourceArn: arn})
+	if err != nil {
+		return awsclients.Wrap(err, errListTags)
+	}
+	add, remove := awsclients.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, resp.Tags)
+	// Tags whose value changed are in both, so they are removed first.
+	if len(remove) != 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{ResourceArn: arn, TagKeys: remove}); err != nil {
+			return awsclients.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{ResourceArn: arn, Tags: add}); err != nil {
+			return awsclients.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.GraphQLAPI, obj *svcsdk.DeleteGraphqlApiInput) (bool, error) {
+	obj.ApiId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
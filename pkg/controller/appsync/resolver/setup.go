@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolver
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/appsync/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errCompareParametersJSON = "cannot compare parameters of Resolver"
+)
+
+// SetupResolver adds a controller that reconciles Resolver.
+func SetupResolver(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ResolverGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Resolver{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ResolverGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Resolver, obj *svcsdk.GetResolverInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Resolver, _ *svcsdk.GetResolverOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Resolver, resp *svcsdk.GetResolverOutput) (bool, error) {
+	if awsclients.StringValue(cr.Spec.ForProvider.DataSourceName) != awsclients.StringValue(resp.Resolver.DataSourceName) {
+		return false, nil
+	}
+	desired := cr.Spec.ForProvider.DeepCopy()
+	desired.Region = ""
+	desired.CustomResolverParameters = svcapitypes.CustomResolverParameters{}
+	ok, err := awsclients.IsJSONSubset(desired, GenerateResolver(resp).Spec.ForProvider)
+	return ok, errors.Wrap(err, errCompareParametersJSON)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Resolver, obj *svcsdk.CreateResolverInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.DataSourceName = cr.Spec.ForProvider.DataSourceName
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Resolver, resp *svcsdk.CreateResolverOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	// A Resolver is identified by the type and the field it resolves.
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Resolver.TypeName)+"."+awsclients.StringValue(resp.Resolver.FieldName))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Resolver, obj *svcsdk.UpdateResolverInput) error {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	obj.DataSourceName = cr.Spec.ForProvider.DataSourceName
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Resolver, obj *svcsdk.DeleteResolverInput) (bool, error) {
+	obj.ApiId = cr.Spec.ForProvider.APIID
+	return false, nil
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assessment
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/auditmanager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/auditmanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupAssessment adds a controller that reconciles Assessment.
+func SetupAssessment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.AssessmentGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Assessment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.AssessmentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Assessment, obj *svcsdk.GetAssessmentInput) error {
+	obj.AssessmentId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Assessment, resp *svcsdk.GetAssessmentOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = generateObservation(resp.Assessment)
+	switch awsclients.StringValue(resp.Assessment.Metadata.Status) {
+	case svcsdk.AssessmentStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.AssessmentStatusInactive:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+// generateObservation returns the observation of an assessment. The scope,
+// roles and report destination are part of the spec, so they are not
+// repeated here.
+func generateObservation(a *svcsdk.Assessment) svcapitypes.AssessmentObservation {
+	obs := svcapitypes.AssessmentObservation{ARN: a.Arn}
+	if a.AwsAccount != nil {
+		obs.AWSAccount = &svcapitypes.AWSAccount{
+			EmailAddress: a.AwsAccount.EmailAddress,
+			ID:           a.AwsAccount.Id,
+			Name:         a.AwsAccount.Name,
+		}
+	}
+	if a.Framework != nil {
+		obs.Framework = &svcapitypes.AssessmentFramework_SDK{
+			ARN: a.Framework.Arn,
+			ID:  a.Framework.Id,
+		}
+		if a.Framework.Metadata != nil {
+			obs.Framework.Metadata = &svcapitypes.FrameworkMetadata{
+				ComplianceType: a.Framework.Metadata.ComplianceType,
+				Description:    a.Framework.Metadata.Description,
+				Logo:           a.Framework.Metadata.Logo,
+				Name:           a.Framework.Metadata.Name,
+			}
+		}
+	}
+	if a.Metadata != nil {
+		obs.Metadata = &svcapitypes.AssessmentMetadata{
+			ComplianceType: a.Metadata.ComplianceType,
+			Description:    a.Metadata.Description,
+			ID:             a.Metadata.Id,
+			Name:           a.Metadata.Name,
+			Status:         a.Metadata.Status,
+		}
+		if a.Metadata.CreationTime != nil {
+			obs.Metadata.CreationTime = &metav1.Time{Time: *a.Metadata.CreationTime}
+		}
+		if a.Metadata.LastUpdated != nil {
+			obs.Metadata.LastUpdated = &metav1.Time{Time: *a.Metadata.LastUpdated}
+		}
+	}
+	return obs
+}
+
+func isUpToDate(cr *svcapitypes.Assessment, resp *svcsdk.GetAssessmentOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	m := resp.Assessment.Metadata
+	if m == nil {
+		return true, nil
+	}
+	switch {
+	case awsclients.StringValue(p.Name) != awsclients.StringValue(m.Name),
+		p.Description != nil && awsclients.StringValue(p.Description) != awsclients.StringValue(m.Description):
+		return false, nil
+	case p.AssessmentReportsDestination != nil && m.AssessmentReportsDestination != nil &&
+		(awsclients.StringValue(p.AssessmentReportsDestination.Destination) != awsclients.StringValue(m.AssessmentReportsDestination.Destination) ||
+			p.AssessmentReportsDestination.DestinationType != nil && awsclients.StringValue(p.AssessmentReportsDestination.DestinationType) != awsclients.StringValue(m.AssessmentReportsDestination.DestinationType)):
+		return false, nil
+	}
+	var desiredRoles []string
+	for _, r := range p.Roles {
+		desiredRoles = append(desiredRoles, awsclients.StringValue(r.RoleType)+"/"+awsclients.StringValue(r.RoleARN))
+	}
+	var observedRoles []string
+	for _, r := range m.Roles {
+		observedRoles = append(observedRoles, awsclients.StringValue(r.RoleType)+"/"+awsclients.StringValue(r.RoleArn))
+	}
+	if !equalStrings(desiredRoles, observedRoles) {
+		return false, nil
+	}
+	return isScopeUpToDate(p.Scope, m.Scope), nil
+}
+
+func isScopeUpToDate(desired *svcapitypes.Scope, observed *svcsdk.Scope) bool {
+	if desired == nil {
+		return true
+	}
+	if observed == nil {
+		observed = &svcsdk.Scope{}
+	}
+	var desiredAccounts, observedAccounts []string
+	for _, a := range desired.AWSAccounts {
+		desiredAccounts = append(desiredAccounts, awsclients.StringValue(a.ID))
+	}
+	for _, a := range observed.AwsAccounts {
+		observedAccounts = append(observedAccounts, awsclients.StringValue(a.Id))
+	}
+	var desiredServices, observedServices []string
+	for _, s := range desired.AWSServices {
+		desiredServices = append(desiredServices, awsclients.StringValue(s.ServiceName))
+	}
+	for _, s := range observed.AwsServices {
+		observedServices = append(observedServices, awsclients.StringValue(s.ServiceName))
+	}
+	return equalStrings(desiredAccounts, observedAccounts) && equalStrings(desiredServices, observedServices)
+}
+
+// equalStrings returns true if both slices contain the same strings in any
+// order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sort.Strings(a)
+	sort.Strings(b)
+	return strings.Join(a, ",") == strings.Join(b, ",")
+}
+
+func generateReportsDestination(d *svcapitypes.CustomAssessmentReportsDestination) *svcsdk.AssessmentReportsDestination {
+	if d == nil {
+		return nil
+	}
+	return &svcsdk.AssessmentReportsDestination{
+		Destination:     d.Destination,
+		DestinationType: d.DestinationType,
+	}
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Assessment, obj *svcsdk.CreateAssessmentInput) error {
+	obj.FrameworkId = cr.Spec.ForProvider.FrameworkID
+	obj.AssessmentReportsDestination = generateReportsDestination(cr.Spec.ForProvider.AssessmentReportsDestination)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Assessment, resp *svcsdk.CreateAssessmentOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if resp.Assessment != nil && resp.Assessment.Metadata != nil {
+		meta.SetExternalName(cr, awsclients.StringValue(resp.Assessment.Metadata.Id))
+	}
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Assessment, obj *svcsdk.UpdateAssessmentInput) error {
+	obj.AssessmentId = awsclients.String(meta.GetExternalName(cr))
+	obj.AssessmentName = cr.Spec.ForProvider.Name
+	obj.AssessmentDescription = cr.Spec.ForProvider.Description
+	obj.AssessmentReportsDestination = generateReportsDestination(cr.Spec.ForProvider.AssessmentReportsDestination)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Assessment, obj *svcsdk.DeleteAssessmentInput) (bool, error) {
+	obj.AssessmentId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assessment
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/auditmanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	params := func() svcapitypes.AssessmentParameters {
+		return svcapitypes.AssessmentParameters{
+			Name: awsclients.String("soc2"),
+			Roles: []*svcapitypes.Role{
+				{RoleType: awsclients.String(svcsdk.RoleTypeProcessOwner), RoleARN: awsclients.String("arn:aws:iam::123456789012:role/auditor")},
+			},
+			Scope: &svcapitypes.Scope{
+				AWSAccounts: []*svcapitypes.AWSAccount{{ID: awsclients.String("123456789012")}, {ID: awsclients.String("210987654321")}},
+				AWSServices: []*svcapitypes.AWSService{{ServiceName: awsclients.String("S3")}},
+			},
+			CustomAssessmentParameters: svcapitypes.CustomAssessmentParameters{
+				AssessmentReportsDestination: &svcapitypes.CustomAssessmentReportsDestination{
+					Destination: awsclients.String("s3://reports"),
+				},
+			},
+		}
+	}
+	observed := func() *svcsdk.GetAssessmentOutput {
+		return &svcsdk.GetAssessmentOutput{Assessment: &svcsdk.Assessment{Metadata: &svcsdk.AssessmentMetadata{
+			Name: awsclients.String("soc2"),
+			AssessmentReportsDestination: &svcsdk.AssessmentReportsDestination{
+				Destination:     awsclients.String("s3://reports"),
+				DestinationType: awsclients.String(svcsdk.AssessmentReportDestinationTypeS3),
+			},
+			Roles: []*svcsdk.Role{
+				{RoleType: awsclients.String(svcsdk.RoleTypeProcessOwner), RoleArn: awsclients.String("arn:aws:iam::123456789012:role/auditor")},
+			},
+			Scope: &svcsdk.Scope{
+				AwsAccounts: []*svcsdk.AWSAccount{{Id: awsclients.String("210987654321")}, {Id: awsclients.String("123456789012")}},
+				AwsServices: []*svcsdk.AWSService{{ServiceName: awsclients.String("S3")}},
+			},
+		}}}
+	}
+
+	type args struct {
+		p    svcapitypes.AssessmentParameters
+		resp *svcsdk.GetAssessmentOutput
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDateInAnyOrder": {
+			args: args{p: params(), resp: observed()},
+			want: true,
+		},
+		"AccountAddedToScope": {
+			args: args{
+				p: func() svcapitypes.AssessmentParameters {
+					p := params()
+					p.Scope.AWSAccounts = append(p.Scope.AWSAccounts, &svcapitypes.AWSAccount{ID: awsclients.String("111111111111")})
+					return p
+				}(),
+				resp: observed(),
+			},
+			want: false,
+		},
+		"DestinationChanged": {
+			args: args{
+				p: func() svcapitypes.AssessmentParameters {
+					p := params()
+					p.AssessmentReportsDestination.Destination = awsclients.String("s3://other")
+					return p
+				}(),
+				resp: observed(),
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Assessment{Spec: svcapitypes.AssessmentSpec{ForProvider: tc.args.p}}
+			got, err := isUpToDate(cr, tc.args.resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
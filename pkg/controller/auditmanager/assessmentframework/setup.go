@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assessmentframework
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/auditmanager"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/auditmanager/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupAssessmentFramework adds a controller that reconciles
+// AssessmentFramework.
+func SetupAssessmentFramework(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.AssessmentFrameworkGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.AssessmentFramework{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.AssessmentFrameworkGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.AssessmentFramework, obj *svcsdk.GetAssessmentFrameworkInput) error {
+	obj.FrameworkId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.AssessmentFramework, _ *svcsdk.GetAssessmentFrameworkOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.AssessmentFramework, resp *svcsdk.GetAssessmentFrameworkOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	f := resp.Framework
+	if awsclients.StringValue(p.Name) != awsclients.StringValue(f.Name) ||
+		(p.Description != nil && awsclients.StringValue(p.Description) != awsclients.StringValue(f.Description)) ||
+		(p.ComplianceType != nil && awsclients.StringValue(p.ComplianceType) != awsclients.StringValue(f.ComplianceType)) {
+		return false, nil
+	}
+	desired := map[string]string{}
+	for _, cs := range p.ControlSets {
+		ids := make([]string, len(cs.Controls))
+		for i, c := range cs.Controls {
+			ids[i] = awsclients.StringValue(c.ID)
+		}
+		sort.Strings(ids)
+		desired[awsclients.StringValue(cs.Name)] = strings.Join(ids, ",")
+	}
+	observed := map[string]string{}
+	for _, cs := range f.ControlSets {
+		ids := make([]string, len(cs.Controls))
+		for i, c := range cs.Controls {
+			ids[i] = awsclients.StringValue(c.Id)
+		}
+		sort.Strings(ids)
+		observed[awsclients.StringValue(cs.Name)] = strings.Join(ids, ",")
+	}
+	if len(desired) != len(observed) {
+		return false, nil
+	}
+	for name, ids := range desired {
+		if o, ok := observed[name]; !ok || o != ids {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.AssessmentFramework, resp *svcsdk.CreateAssessmentFrameworkOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if resp.Framework != nil {
+		meta.SetExternalName(cr, awsclients.StringValue(resp.Framework.Id))
+	}
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.AssessmentFramework, obj *svcsdk.UpdateAssessmentFrameworkInput) error {
+	obj.FrameworkId = awsclients.String(meta.GetExternalName(cr))
+	obj.ControlSets = make([]*svcsdk.UpdateAssessmentFrameworkControlSet, len(cr.Spec.ForProvider.ControlSets))
+	for i, cs := range cr.Spec.ForProvider.ControlSets {
+		// The identifier of a control set is its name.
+		obj.ControlSets[i] = &svcsdk.UpdateAssessmentFrameworkControlSet{
+			Id:       cs.Name,
+			Name:     cs.Name,
+			Controls: make([]*svcsdk.CreateAssessmentFrameworkControl, len(cs.Controls)),
+		}
+		for j, c := range cs.Controls {
+			obj.ControlSets[i].Controls[j] = &svcsdk.CreateAssessmentFrameworkControl{Id: c.ID}
+		}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.AssessmentFramework, obj *svcsdk.DeleteAssessmentFrameworkInput) (bool, error) {
+	obj.FrameworkId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
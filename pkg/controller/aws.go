@@ -24,6 +24,13 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	apigatewayapikey "github.com/crossplane/provider-aws/pkg/controller/apigateway/apikey"
+	apigatewaydeployment "github.com/crossplane/provider-aws/pkg/controller/apigateway/deployment"
+	apigatewaymethod "github.com/crossplane/provider-aws/pkg/controller/apigateway/method"
+	apigatewayresource "github.com/crossplane/provider-aws/pkg/controller/apigateway/resource"
+	apigatewayrestapi "github.com/crossplane/provider-aws/pkg/controller/apigateway/restapi"
+	apigatewaystage "github.com/crossplane/provider-aws/pkg/controller/apigateway/stage"
+	apigatewayusageplan "github.com/crossplane/provider-aws/pkg/controller/apigateway/usageplan"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/apimapping"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/authorizer"
@@ -36,13 +43,32 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/routeresponse"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/stage"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/vpclink"
+	appregistryapplication "github.com/crossplane/provider-aws/pkg/controller/appregistry/application"
+	appregistryattributegroup "github.com/crossplane/provider-aws/pkg/controller/appregistry/attributegroup"
+	appregistryresourceassociation "github.com/crossplane/provider-aws/pkg/controller/appregistry/resourceassociation"
+	apprunnerautoscalingconfiguration "github.com/crossplane/provider-aws/pkg/controller/apprunner/autoscalingconfiguration"
+	apprunnerservice "github.com/crossplane/provider-aws/pkg/controller/apprunner/service"
+	appstreamfleet "github.com/crossplane/provider-aws/pkg/controller/appstream/fleet"
+	appstreamimagebuilder "github.com/crossplane/provider-aws/pkg/controller/appstream/imagebuilder"
+	appstreamstack "github.com/crossplane/provider-aws/pkg/controller/appstream/stack"
+	appstreamstackfleetassociation "github.com/crossplane/provider-aws/pkg/controller/appstream/stackfleetassociation"
+	appsyncdatasource "github.com/crossplane/provider-aws/pkg/controller/appsync/datasource"
+	appsyncgraphqlapi "github.com/crossplane/provider-aws/pkg/controller/appsync/graphqlapi"
+	appsyncresolver "github.com/crossplane/provider-aws/pkg/controller/appsync/resolver"
 	athenaworkgroup "github.com/crossplane/provider-aws/pkg/controller/athena/workgroup"
+	auditmanagerassessment "github.com/crossplane/provider-aws/pkg/controller/auditmanager/assessment"
+	auditmanagerassessmentframework "github.com/crossplane/provider-aws/pkg/controller/auditmanager/assessmentframework"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cluster"
+	cachesnapshot "github.com/crossplane/provider-aws/pkg/controller/cache/snapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/cachepolicy"
 	cloudfrontorginaccessidentity "github.com/crossplane/provider-aws/pkg/controller/cloudfront/cloudfrontoriginaccessidentity"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/distribution"
+	cloudfrontinvalidation "github.com/crossplane/provider-aws/pkg/controller/cloudfront/invalidation"
+	cloudfrontkeygroup "github.com/crossplane/provider-aws/pkg/controller/cloudfront/keygroup"
+	cloudfrontpublickey "github.com/crossplane/provider-aws/pkg/controller/cloudfront/publickey"
+	cloudfrontrealtimelogconfig "github.com/crossplane/provider-aws/pkg/controller/cloudfront/realtimelogconfig"
 	cloudfrontresponseheaderspolicy "github.com/crossplane/provider-aws/pkg/controller/cloudfront/responseheaderspolicy"
 	domain "github.com/crossplane/provider-aws/pkg/controller/cloudsearch/domain"
 	cwloggroup "github.com/crossplane/provider-aws/pkg/controller/cloudwatchlogs/loggroup"
@@ -51,9 +77,18 @@ import (
 	cognitouserpool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpool"
 	cognitouserpoolclient "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpoolclient"
 	cognitouserpooldomain "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpooldomain"
+	comprehenddocumentclassifier "github.com/crossplane/provider-aws/pkg/controller/comprehend/documentclassifier"
+	comprehendendpoint "github.com/crossplane/provider-aws/pkg/controller/comprehend/endpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
+	connectcontactflow "github.com/crossplane/provider-aws/pkg/controller/connect/contactflow"
+	connecthoursofoperation "github.com/crossplane/provider-aws/pkg/controller/connect/hoursofoperation"
+	connectinstance "github.com/crossplane/provider-aws/pkg/controller/connect/instance"
+	connectqueue "github.com/crossplane/provider-aws/pkg/controller/connect/queue"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
+	daxcluster "github.com/crossplane/provider-aws/pkg/controller/dax/cluster"
+	daxparametergroup "github.com/crossplane/provider-aws/pkg/controller/dax/parametergroup"
+	daxsubnetgroup "github.com/crossplane/provider-aws/pkg/controller/dax/subnetgroup"
 	docdbcluster "github.com/crossplane/provider-aws/pkg/controller/docdb/dbcluster"
 	docdbclusterparametergroup "github.com/crossplane/provider-aws/pkg/controller/docdb/dbclusterparametergroup"
 	docdbinstance "github.com/crossplane/provider-aws/pkg/controller/docdb/dbinstance"
@@ -81,27 +116,52 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/registrypolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/ecr/replicationconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repository"
 	"github.com/crossplane/provider-aws/pkg/controller/ecr/repositorypolicy"
+	ecscluster "github.com/crossplane/provider-aws/pkg/controller/ecs/cluster"
+	ecsservice "github.com/crossplane/provider-aws/pkg/controller/ecs/service"
+	ecstaskdefinition "github.com/crossplane/provider-aws/pkg/controller/ecs/taskdefinition"
 	"github.com/crossplane/provider-aws/pkg/controller/efs/filesystem"
 	efsmounttarget "github.com/crossplane/provider-aws/pkg/controller/efs/mounttarget"
 	"github.com/crossplane/provider-aws/pkg/controller/eks"
 	eksaddon "github.com/crossplane/provider-aws/pkg/controller/eks/addon"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/fargateprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/identityproviderconfig"
+	"github.com/crossplane/provider-aws/pkg/controller/eks/irsarole"
 	"github.com/crossplane/provider-aws/pkg/controller/eks/nodegroup"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticache/cacheparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/elasticache/globalreplicationgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elb"
 	"github.com/crossplane/provider-aws/pkg/controller/elasticloadbalancing/elbattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
+	eventbridgearchive "github.com/crossplane/provider-aws/pkg/controller/eventbridge/archive"
+	eventbridgeeventbus "github.com/crossplane/provider-aws/pkg/controller/eventbridge/eventbus"
+	eventbridgereplay "github.com/crossplane/provider-aws/pkg/controller/eventbridge/replay"
+	eventbridgerule "github.com/crossplane/provider-aws/pkg/controller/eventbridge/rule"
+	firehosedeliverystream "github.com/crossplane/provider-aws/pkg/controller/firehose/deliverystream"
+	fisexperiment "github.com/crossplane/provider-aws/pkg/controller/fis/experiment"
+	fisexperimenttemplate "github.com/crossplane/provider-aws/pkg/controller/fis/experimenttemplate"
+	gameliftalias "github.com/crossplane/provider-aws/pkg/controller/gamelift/alias"
+	gameliftfleet "github.com/crossplane/provider-aws/pkg/controller/gamelift/fleet"
+	gameliftmatchmakingconfiguration "github.com/crossplane/provider-aws/pkg/controller/gamelift/matchmakingconfiguration"
+	glaciervault "github.com/crossplane/provider-aws/pkg/controller/glacier/vault"
+	glaciervaultlock "github.com/crossplane/provider-aws/pkg/controller/glacier/vaultlock"
+	glaciervaultnotification "github.com/crossplane/provider-aws/pkg/controller/glacier/vaultnotification"
 	glueclassifier "github.com/crossplane/provider-aws/pkg/controller/glue/classifier"
 	glueconnection "github.com/crossplane/provider-aws/pkg/controller/glue/connection"
 	gluecrawler "github.com/crossplane/provider-aws/pkg/controller/glue/crawler"
 	glueDatabase "github.com/crossplane/provider-aws/pkg/controller/glue/database"
 	gluejob "github.com/crossplane/provider-aws/pkg/controller/glue/job"
 	gluesecurityconfiguration "github.com/crossplane/provider-aws/pkg/controller/glue/securityconfiguration"
+	greengrassv2componentversion "github.com/crossplane/provider-aws/pkg/controller/greengrassv2/componentversion"
+	greengrassv2deployment "github.com/crossplane/provider-aws/pkg/controller/greengrassv2/deployment"
+	groundstationconfig "github.com/crossplane/provider-aws/pkg/controller/groundstation/config"
+	groundstationdataflowendpointgroup "github.com/crossplane/provider-aws/pkg/controller/groundstation/dataflowendpointgroup"
+	groundstationmissionprofile "github.com/crossplane/provider-aws/pkg/controller/groundstation/missionprofile"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/accesskey"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/group"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/grouppolicyattachment"
@@ -113,20 +173,47 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/iam/rolepolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/user"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/userpolicyattachment"
+	identitystoregroup "github.com/crossplane/provider-aws/pkg/controller/identitystore/group"
+	identitystoreuser "github.com/crossplane/provider-aws/pkg/controller/identitystore/user"
 	iotpolicy "github.com/crossplane/provider-aws/pkg/controller/iot/policy"
 	"github.com/crossplane/provider-aws/pkg/controller/iot/thing"
 	kafkacluster "github.com/crossplane/provider-aws/pkg/controller/kafka/cluster"
 	kafkaconfiguration "github.com/crossplane/provider-aws/pkg/controller/kafka/configuration"
 	kinesisstream "github.com/crossplane/provider-aws/pkg/controller/kinesis/stream"
+	kinesisvideosignalingchannel "github.com/crossplane/provider-aws/pkg/controller/kinesisvideo/signalingchannel"
+	kinesisvideostream "github.com/crossplane/provider-aws/pkg/controller/kinesisvideo/stream"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/alias"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
 	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
+	lambdapermission "github.com/crossplane/provider-aws/pkg/controller/lambda/permission"
+	locationgeofencecollection "github.com/crossplane/provider-aws/pkg/controller/location/geofencecollection"
+	"github.com/crossplane/provider-aws/pkg/controller/location/locationmap"
+	locationplaceindex "github.com/crossplane/provider-aws/pkg/controller/location/placeindex"
+	locationtracker "github.com/crossplane/provider-aws/pkg/controller/location/tracker"
+	mediaconvertjobtemplate "github.com/crossplane/provider-aws/pkg/controller/mediaconvert/jobtemplate"
+	mediaconvertpreset "github.com/crossplane/provider-aws/pkg/controller/mediaconvert/preset"
+	mediaconvertqueue "github.com/crossplane/provider-aws/pkg/controller/mediaconvert/queue"
+	medialivechannel "github.com/crossplane/provider-aws/pkg/controller/medialive/channel"
+	medialiveinput "github.com/crossplane/provider-aws/pkg/controller/medialive/input"
+	mediapackagechannel "github.com/crossplane/provider-aws/pkg/controller/mediapackage/channel"
+	mediapackageoriginendpoint "github.com/crossplane/provider-aws/pkg/controller/mediapackage/originendpoint"
 	mqbroker "github.com/crossplane/provider-aws/pkg/controller/mq/broker"
 	mquser "github.com/crossplane/provider-aws/pkg/controller/mq/user"
 	neptunecluster "github.com/crossplane/provider-aws/pkg/controller/neptune/dbcluster"
 	notsubscription "github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	nottopic "github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
+	opensearchservicedomain "github.com/crossplane/provider-aws/pkg/controller/opensearchservice/domain"
+	personalizecampaign "github.com/crossplane/provider-aws/pkg/controller/personalize/campaign"
+	personalizedataset "github.com/crossplane/provider-aws/pkg/controller/personalize/dataset"
+	personalizedatasetgroup "github.com/crossplane/provider-aws/pkg/controller/personalize/datasetgroup"
+	personalizeschema "github.com/crossplane/provider-aws/pkg/controller/personalize/schema"
+	personalizesolution "github.com/crossplane/provider-aws/pkg/controller/personalize/solution"
+	pollylexicon "github.com/crossplane/provider-aws/pkg/controller/polly/lexicon"
 	prometheusserviceworkspace "github.com/crossplane/provider-aws/pkg/controller/prometheusservice/workspace"
+	protonenvironment "github.com/crossplane/provider-aws/pkg/controller/proton/environment"
+	protonenvironmenttemplate "github.com/crossplane/provider-aws/pkg/controller/proton/environmenttemplate"
+	protonservice "github.com/crossplane/provider-aws/pkg/controller/proton/service"
+	protonservicetemplate "github.com/crossplane/provider-aws/pkg/controller/proton/servicetemplate"
 	resourceshare "github.com/crossplane/provider-aws/pkg/controller/ram/resourceshare"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbcluster"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbclusterparametergroup"
@@ -135,6 +222,12 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/globalcluster"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
+	redshiftclusterparametergroup "github.com/crossplane/provider-aws/pkg/controller/redshift/clusterparametergroup"
+	redshiftsnapshotschedule "github.com/crossplane/provider-aws/pkg/controller/redshift/snapshotschedule"
+	rekognitioncollection "github.com/crossplane/provider-aws/pkg/controller/rekognition/collection"
+	rekognitionproject "github.com/crossplane/provider-aws/pkg/controller/rekognition/project"
+	rekognitionstreamprocessor "github.com/crossplane/provider-aws/pkg/controller/rekognition/streamprocessor"
+	"github.com/crossplane/provider-aws/pkg/controller/route53/healthcheck"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/hostedzone"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/resourcerecordset"
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
@@ -142,6 +235,10 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverruleassociation"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	s3object "github.com/crossplane/provider-aws/pkg/controller/s3/object"
+	sagemakernotebookinstance "github.com/crossplane/provider-aws/pkg/controller/sagemaker/notebookinstance"
+	sagemakernotebookinstancelifecycleconfig "github.com/crossplane/provider-aws/pkg/controller/sagemaker/notebookinstancelifecycleconfig"
+	"github.com/crossplane/provider-aws/pkg/controller/scheduling/schedule"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
@@ -151,8 +248,32 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/sns/subscription"
 	"github.com/crossplane/provider-aws/pkg/controller/sns/topic"
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
+	ssmassociation "github.com/crossplane/provider-aws/pkg/controller/ssm/association"
+	ssmmaintenancewindow "github.com/crossplane/provider-aws/pkg/controller/ssm/maintenancewindow"
+	ssmmaintenancewindowtarget "github.com/crossplane/provider-aws/pkg/controller/ssm/maintenancewindowtarget"
+	ssmmaintenancewindowtask "github.com/crossplane/provider-aws/pkg/controller/ssm/maintenancewindowtask"
+	ssmpatchbaseline "github.com/crossplane/provider-aws/pkg/controller/ssm/patchbaseline"
+	ssmpatchgroup "github.com/crossplane/provider-aws/pkg/controller/ssm/patchgroup"
+	ssmcontactscontact "github.com/crossplane/provider-aws/pkg/controller/ssmcontacts/contact"
+	ssmcontactscontactchannel "github.com/crossplane/provider-aws/pkg/controller/ssmcontacts/contactchannel"
+	ssmcontactsescalationplan "github.com/crossplane/provider-aws/pkg/controller/ssmcontacts/escalationplan"
+	ssmincidentsresponseplan "github.com/crossplane/provider-aws/pkg/controller/ssmincidents/responseplan"
+	ssoadminaccountassignment "github.com/crossplane/provider-aws/pkg/controller/ssoadmin/accountassignment"
+	ssoadmininstanceaccesscontrolattributeconfiguration "github.com/crossplane/provider-aws/pkg/controller/ssoadmin/instanceaccesscontrolattributeconfiguration"
+	ssoadminpermissionset "github.com/crossplane/provider-aws/pkg/controller/ssoadmin/permissionset"
+	storagegatewaygateway "github.com/crossplane/provider-aws/pkg/controller/storagegateway/gateway"
+	storagegatewaynfsfileshare "github.com/crossplane/provider-aws/pkg/controller/storagegateway/nfsfileshare"
+	storagegatewaysmbfileshare "github.com/crossplane/provider-aws/pkg/controller/storagegateway/smbfileshare"
+	transcribevocabulary "github.com/crossplane/provider-aws/pkg/controller/transcribe/vocabulary"
+	transcribevocabularyfilter "github.com/crossplane/provider-aws/pkg/controller/transcribe/vocabularyfilter"
 	transferserver "github.com/crossplane/provider-aws/pkg/controller/transfer/server"
 	transferuser "github.com/crossplane/provider-aws/pkg/controller/transfer/user"
+	translateparalleldata "github.com/crossplane/provider-aws/pkg/controller/translate/paralleldata"
+	wellarchitectedworkload "github.com/crossplane/provider-aws/pkg/controller/wellarchitected/workload"
+	workspacesdirectory "github.com/crossplane/provider-aws/pkg/controller/workspaces/directory"
+	workspacesipgroup "github.com/crossplane/provider-aws/pkg/controller/workspaces/ipgroup"
+	workspacesworkspace "github.com/crossplane/provider-aws/pkg/controller/workspaces/workspace"
+	workspacesworkspacebundle "github.com/crossplane/provider-aws/pkg/controller/workspaces/workspacebundle"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
@@ -162,7 +283,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
 		cacheparametergroup.SetupCacheParameterGroup,
+		globalreplicationgroup.SetupGlobalReplicationGroup,
 		cluster.SetupCacheCluster,
+		cachesnapshot.SetupSnapshot,
 		database.SetupRDSInstance,
 		domain.SetupDomain,
 		docdbinstance.SetupDBInstance,
@@ -172,12 +295,14 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		eks.SetupCluster,
 		eksaddon.SetupAddon,
 		identityproviderconfig.SetupIdentityProviderConfig,
+		irsarole.SetupIRSARole,
 		instanceprofile.SetupInstanceProfile,
 		elb.SetupELB,
 		elbattachment.SetupELBAttachment,
 		nodegroup.SetupNodeGroup,
 		s3.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
+		s3object.SetupObject,
 		accesskey.SetupAccessKey,
 		user.SetupUser,
 		group.SetupGroup,
@@ -206,9 +331,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		subscription.SetupSubscription,
 		queue.SetupQueue,
 		redshift.SetupCluster,
+		redshiftclusterparametergroup.SetupClusterParameterGroup,
+		redshiftsnapshotschedule.SetupSnapshotSchedule,
 		address.SetupAddress,
 		repository.SetupRepository,
 		repositorypolicy.SetupRepositoryPolicy,
+		replicationconfiguration.SetupReplicationConfiguration,
+		registrypolicy.SetupRegistryPolicy,
 		api.SetupAPI,
 		stage.SetupStage,
 		route.SetupRoute,
@@ -221,6 +350,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		apimapping.SetupAPIMapping,
 		routeresponse.SetupRouteResponse,
 		vpclink.SetupVPCLink,
+		apigatewayrestapi.SetupRestAPI,
+		apigatewayresource.SetupResource,
+		apigatewaymethod.SetupMethod,
+		apigatewaydeployment.SetupDeployment,
+		apigatewaystage.SetupStage,
+		apigatewayusageplan.SetupUsagePlan,
+		apigatewayapikey.SetupAPIKey,
 		fargateprofile.SetupFargateProfile,
 		activity.SetupActivity,
 		statemachine.SetupStateMachine,
@@ -246,6 +382,9 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cachepolicy.SetupCachePolicy,
 		cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity,
 		cloudfrontresponseheaderspolicy.SetupResponseHeadersPolicy,
+		cloudfrontkeygroup.SetupKeyGroup,
+		cloudfrontpublickey.SetupPublicKey,
+		cloudfrontrealtimelogconfig.SetupRealtimeLogConfig,
 		resolverendpoint.SetupResolverEndpoint,
 		resolverrule.SetupResolverRule,
 		vpcpeeringconnection.SetupVPCPeeringConnection,
@@ -261,6 +400,12 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		glueDatabase.SetupDatabase,
 		gluecrawler.SetupCrawler,
 		glueclassifier.SetupClassifier,
+		glaciervault.SetupVault,
+		glaciervaultnotification.SetupVaultNotification,
+		glaciervaultlock.SetupVaultLock,
+		storagegatewaygateway.SetupGateway,
+		storagegatewaynfsfileshare.SetupNFSFileShare,
+		storagegatewaysmbfileshare.SetupSMBFileShare,
 		mqbroker.SetupBroker,
 		mquser.SetupUser,
 		cwloggroup.SetupLogGroup,
@@ -292,6 +437,103 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		nottopic.SetupSNSTopic,
 		notsubscription.SetupSubscription,
 		prometheusserviceworkspace.SetupWorkspace,
+		greengrassv2componentversion.SetupComponentVersion,
+		greengrassv2deployment.SetupDeployment,
+		kinesisvideostream.SetupStream,
+		kinesisvideosignalingchannel.SetupSignalingChannel,
+		mediapackagechannel.SetupChannel,
+		mediapackageoriginendpoint.SetupOriginEndpoint,
+		medialiveinput.SetupInput,
+		medialivechannel.SetupChannel,
+		mediaconvertqueue.SetupQueue,
+		mediaconvertpreset.SetupPreset,
+		mediaconvertjobtemplate.SetupJobTemplate,
+		gameliftfleet.SetupFleet,
+		gameliftalias.SetupAlias,
+		gameliftmatchmakingconfiguration.SetupMatchmakingConfiguration,
+		groundstationconfig.SetupConfig,
+		groundstationdataflowendpointgroup.SetupDataflowEndpointGroup,
+		groundstationmissionprofile.SetupMissionProfile,
+		sagemakernotebookinstance.SetupNotebookInstance,
+		sagemakernotebookinstancelifecycleconfig.SetupNotebookInstanceLifecycleConfig,
+		schedule.SetupSchedule,
+		ecscluster.SetupCluster,
+		ecsservice.SetupService,
+		ecstaskdefinition.SetupTaskDefinition,
+		protonenvironmenttemplate.SetupEnvironmentTemplate,
+		protonservicetemplate.SetupServiceTemplate,
+		protonenvironment.SetupEnvironment,
+		protonservice.SetupService,
+		fisexperimenttemplate.SetupExperimentTemplate,
+		fisexperiment.SetupExperiment,
+		apprunnerautoscalingconfiguration.SetupAutoScalingConfiguration,
+		apprunnerservice.SetupService,
+		wellarchitectedworkload.SetupWorkload,
+		cloudfrontinvalidation.SetupInvalidation,
+		healthcheck.SetupHealthCheck,
+		workspacesdirectory.SetupDirectory,
+		workspacesipgroup.SetupIPGroup,
+		workspacesworkspacebundle.SetupWorkspaceBundle,
+		workspacesworkspace.SetupWorkspace,
+		appstreamfleet.SetupFleet,
+		appstreamstack.SetupStack,
+		appstreamstackfleetassociation.SetupStackFleetAssociation,
+		appstreamimagebuilder.SetupImageBuilder,
+		connectinstance.SetupInstance,
+		connecthoursofoperation.SetupHoursOfOperation,
+		connectcontactflow.SetupContactFlow,
+		connectqueue.SetupQueue,
+		locationmap.SetupMap,
+		locationplaceindex.SetupPlaceIndex,
+		locationgeofencecollection.SetupGeofenceCollection,
+		locationtracker.SetupTracker,
+		auditmanagerassessmentframework.SetupAssessmentFramework,
+		auditmanagerassessment.SetupAssessment,
+		ssoadminpermissionset.SetupPermissionSet,
+		ssoadminaccountassignment.SetupAccountAssignment,
+		ssoadmininstanceaccesscontrolattributeconfiguration.SetupInstanceAccessControlAttributeConfiguration,
+		identitystoregroup.SetupGroup,
+		identitystoreuser.SetupUser,
+		daxcluster.SetupCluster,
+		daxparametergroup.SetupParameterGroup,
+		daxsubnetgroup.SetupSubnetGroup,
+		eventbridgearchive.SetupArchive,
+		eventbridgeeventbus.SetupEventBus,
+		eventbridgereplay.SetupReplay,
+		eventbridgerule.SetupRule,
+		comprehenddocumentclassifier.SetupDocumentClassifier,
+		comprehendendpoint.SetupEndpoint,
+		translateparalleldata.SetupParallelData,
+		pollylexicon.SetupLexicon,
+		transcribevocabulary.SetupVocabulary,
+		transcribevocabularyfilter.SetupVocabularyFilter,
+		rekognitioncollection.SetupCollection,
+		rekognitionproject.SetupProject,
+		rekognitionstreamprocessor.SetupStreamProcessor,
+		lambdapermission.SetupPermission,
+		personalizedatasetgroup.SetupDatasetGroup,
+		personalizeschema.SetupSchema,
+		personalizedataset.SetupDataset,
+		personalizesolution.SetupSolution,
+		personalizecampaign.SetupCampaign,
+		firehosedeliverystream.SetupDeliveryStream,
+		ssmmaintenancewindow.SetupMaintenanceWindow,
+		ssmmaintenancewindowtarget.SetupMaintenanceWindowTarget,
+		ssmmaintenancewindowtask.SetupMaintenanceWindowTask,
+		ssmpatchbaseline.SetupPatchBaseline,
+		ssmpatchgroup.SetupPatchGroup,
+		ssmassociation.SetupAssociation,
+		ssmcontactscontact.SetupContact,
+		ssmcontactscontactchannel.SetupContactChannel,
+		ssmcontactsescalationplan.SetupEscalationPlan,
+		ssmincidentsresponseplan.SetupResponsePlan,
+		opensearchservicedomain.SetupDomain,
+		appregistryapplication.SetupApplication,
+		appregistryattributegroup.SetupAttributeGroup,
+		appregistryresourceassociation.SetupResourceAssociation,
+		appsyncgraphqlapi.SetupGraphQLAPI,
+		appsyncdatasource.SetupDataSource,
+		appsyncresolver.SetupResolver,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
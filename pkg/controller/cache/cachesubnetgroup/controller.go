@@ -120,13 +120,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	_, err := e.client.CreateCacheSubnetGroup(ctx, &awscache.CreateCacheSubnetGroupInput{
-		CacheSubnetGroupDescription: awsclient.String(cr.Spec.ForProvider.Description),
-		CacheSubnetGroupName:        awsclient.String(meta.GetExternalName(cr)),
-		SubnetIds:                   cr.Spec.ForProvider.SubnetIDs,
-	})
+	_, err := e.client.CreateCacheSubnetGroup(ctx, elasticache.GenerateCreateCacheSubnetGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	if err != nil {
-		return managed.ExternalCreation{}, awsclient.Wrap(resource.Ignore(elasticache.IsAlreadyExists, err), errCreateSubnetGroup)
+		return managed.ExternalCreation{}, awsclient.Wrap(resource.Ignore(elasticache.IsSubnetGroupAlreadyExists, err), errCreateSubnetGroup)
 	}
 
 	return managed.ExternalCreation{}, nil
@@ -159,5 +155,5 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 		CacheSubnetGroupName: awsclient.String(meta.GetExternalName(cr)),
 	})
 
-	return awsclient.Wrap(resource.Ignore(elasticache.IsNotFound, err), errDeleteSubnetGroup)
+	return awsclient.Wrap(resource.Ignore(elasticache.IsSubnetGroupNotFound, err), errDeleteSubnetGroup)
 }
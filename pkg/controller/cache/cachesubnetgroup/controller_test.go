@@ -334,6 +334,25 @@ func TestDelete(t *testing.T) {
 				})), withConditions(xpv1.Deleting())),
 			},
 		},
+		"AlreadyDeleted": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteCacheSubnetGroup: func(ctx context.Context, input *awscache.DeleteCacheSubnetGroupInput, opts []func(*awscache.Options)) (*awscache.DeleteCacheSubnetGroupOutput, error) {
+						return nil, &awscachetypes.CacheSubnetGroupNotFoundFault{}
+					},
+				},
+				cr: csg(withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				})),
+			},
+			want: want{
+				cr: csg((withSpec(v1alpha1.CacheSubnetGroupParameters{
+					SubnetIDs:   []string{subnetID},
+					Description: sgDescription,
+				})), withConditions(xpv1.Deleting())),
+			},
+		},
 		"DeleteFailed": {
 			args: args{
 				cache: &fake.MockClient{
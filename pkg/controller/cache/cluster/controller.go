@@ -95,7 +95,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotCacheCluster)
 	}
 
-	resp, err := e.client.DescribeCacheClusters(ctx, elasticache.NewDescribeCacheClustersInput(meta.GetExternalName(cr)))
+	input := elasticache.NewDescribeCacheClustersInput(meta.GetExternalName(cr))
+	// Node endpoints are only returned when explicitly asked for.
+	input.ShowCacheNodeInfo = aws.Bool(true)
+	resp, err := e.client.DescribeCacheClusters(ctx, input)
 	if err != nil {
 		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(resource.Ignore(elasticache.IsClusterNotFound, err), errDescribeCacheCluster)
 	}
@@ -128,8 +131,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: upToDate,
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: elasticache.ClusterConnectionEndpoint(cluster),
 	}, nil
 }
 
@@ -18,13 +18,19 @@ package cache
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awselasticache "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awselasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -39,6 +45,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
 )
 
 // Error strings.
@@ -52,30 +59,47 @@ const (
 	errModifyReplicationGroup   = "cannot modify ElastiCache replication group"
 	errDeleteReplicationGroup   = "cannot delete ElastiCache replication group"
 	errModifyReplicationGroupSC = "cannot modify ElastiCache replication group shard configuration"
+	errIncreaseReplicaCount     = "cannot increase ElastiCache replication group replica count"
+	errDecreaseReplicaCount     = "cannot decrease ElastiCache replication group replica count"
+	errUpgradeEngineVersion     = "cannot upgrade ElastiCache replication group engine version"
+	errUpdateAuthToken          = "cannot update ElastiCache replication group auth token"
+	errGetAuthTokenSecret       = "cannot get auth token secret"
+	errGetConnectionSecret      = "cannot get connection secret"
+	errDisassociateGlobalRG     = "cannot disassociate ElastiCache replication group from its Global datastore"
+	errTestFailover             = "cannot test failover of ElastiCache replication group"
+
+	errFmtEngineVersionDowngrade = "cannot downgrade ElastiCache replication group engine version from %s to %s"
+	errFmtEmptyAuthToken         = "auth token in key %q of the auth token secret must not be empty"
+
+	// globalReplicationGroupRoleSecondary is the role of a replication group
+	// that was added to a Global datastore as a secondary member.
+	globalReplicationGroupRoleSecondary = "SECONDARY"
 )
 
 // SetupReplicationGroup adds a controller that reconciles ReplicationGroups.
 func SetupReplicationGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.ReplicationGroupGroupKind)
+	hints := requeue.NewHints()
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.ReplicationGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(requeue.NewReconciler(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient, hints: hints}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		))
+		), hints))
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) elasticache.Client
+	hints       *requeue.Hints
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -87,12 +111,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, region: cfg.Region, hints: c.hints}, nil
 }
 
 type external struct {
 	client elasticache.Client
 	kube   client.Client
+	region string
+	hints  *requeue.Hints
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -138,11 +164,53 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if d, ok := elasticache.ReplicationGroupOperationDuration(cr.Status.AtProvider.Status); ok {
+		// Online resharding reports how many of the slots were migrated.
+		e.hints.InProgress(cr, requeue.Operation{
+			Name:     cr.Status.AtProvider.Status,
+			Expected: d,
+			Progress: float64(cr.Status.AtProvider.PendingModifiedValues.Resharding.SlotMigration.ProgressPercentage),
+		})
+	}
+	cr.Status.AtProvider.EngineVersion = aws.ToString(oneCC.EngineVersion)
+	observeEngineVersionUpgrade(cr, oneCC)
+	observeTestFailover(cr)
 
+	conn := elasticache.ConnectionEndpoint(rg)
+	token, tokenChanged, err := e.desiredAuthToken(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if tokenChanged && authTokenUpdateCompleted(cr, rg) {
+		// The new token is published only now since it is not accepted by
+		// the replication group before AWS finished the update.
+		if conn == nil {
+			conn = managed.ConnectionDetails{}
+		}
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(token)
+		cr.SetConditions(v1beta1.AuthTokenUpdated())
+		tokenChanged = false
+	}
+
+	// An engine version that cannot be applied must not keep the replication
+	// group from being up to date, otherwise other changes are never made.
+	params := cr.Spec.ForProvider
+	if blocked, err := engineVersionUpgradeBlocked(cr, oneCC); blocked {
+		if err != nil {
+			cr.SetConditions(v1beta1.EngineVersionUpgradeFailed(err))
+		}
+		params.EngineVersion = nil
+	}
+
+	increase, decrease := elasticache.ReplicationGroupReplicasNeedUpdate(cr.Spec.ForProvider, rg)
 	return managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList) && !elasticache.ReplicationGroupShardConfigurationNeedsUpdate(cr.Spec.ForProvider, rg),
-		ConnectionDetails: elasticache.ConnectionEndpoint(rg),
+		ResourceExists: true,
+		ResourceUpToDate: !elasticache.ReplicationGroupNeedsUpdate(params, rg, ccList) &&
+			!elasticache.ReplicationGroupShardConfigurationNeedsUpdate(cr.Spec.ForProvider, rg) &&
+			!increase && !decrease && !(tokenChanged && !authTokenUpdateInProgress(cr)) &&
+			!elasticache.ReplicationGroupNeedsDisassociation(cr.Spec.ForProvider, rg) &&
+			!testFailoverPending(cr),
+		ConnectionDetails: conn,
 	}, nil
 }
 
@@ -160,9 +228,9 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// is required.
 	var token *string
 	if aws.ToBool(cr.Spec.ForProvider.AuthEnabled) {
-		t, err := password.Generate()
+		t, err := e.authToken(ctx, cr)
 		if err != nil {
-			return managed.ExternalCreation{}, awsclient.Wrap(err, errGenerateAuthToken)
+			return managed.ExternalCreation{}, err
 		}
 		token = &t
 	}
@@ -191,12 +259,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
+	// AWS does not accept a failover test of a node group before the one of
+	// the previous node group completed, so we test one at a time.
+	if testFailoverPending(cr) {
+		return managed.ExternalUpdate{}, e.testFailover(ctx, cr)
+	}
+
 	rsp, err := e.client.DescribeReplicationGroups(ctx, elasticache.NewDescribeReplicationGroupsInput(meta.GetExternalName(cr)))
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeReplicationGroup)
 	}
 	rg := rsp.ReplicationGroups[0]
 
+	if elasticache.ReplicationGroupNeedsDisassociation(cr.Spec.ForProvider, rg) {
+		return managed.ExternalUpdate{}, e.disassociateGlobalReplicationGroup(ctx, cr)
+	}
+
 	if elasticache.ReplicationGroupShardConfigurationNeedsUpdate(cr.Spec.ForProvider, rg) {
 		_, err = e.client.ModifyReplicationGroupShardConfiguration(ctx, elasticache.NewModifyReplicationGroupShardConfigurationInput(cr.Spec.ForProvider, meta.GetExternalName(cr), rg))
 		if err != nil {
@@ -206,10 +284,259 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	_, err = e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	switch increase, decrease := elasticache.ReplicationGroupReplicasNeedUpdate(cr.Spec.ForProvider, rg); {
+	case increase:
+		_, err = e.client.IncreaseReplicaCount(ctx, elasticache.NewIncreaseReplicaCountInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errIncreaseReplicaCount)
+	case decrease:
+		_, err = e.client.DecreaseReplicaCount(ctx, elasticache.NewDecreaseReplicaCountInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDecreaseReplicaCount)
+	}
+
+	token, tokenChanged, err := e.desiredAuthToken(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if tokenChanged && !authTokenUpdateInProgress(cr) {
+		return managed.ExternalUpdate{}, e.updateAuthToken(ctx, cr, token)
+	}
+
+	ccList, err := getCacheClusterList(ctx, e.client, rg.MemberClusters)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetCacheClusterList)
+	}
+	params := cr.Spec.ForProvider
+	if len(ccList) > 0 && elasticache.ReplicationGroupEngineVersionNeedsUpgrade(params, ccList[0]) {
+		blocked, err := engineVersionUpgradeBlocked(cr, ccList[0])
+		if !blocked {
+			return managed.ExternalUpdate{}, e.upgradeEngineVersion(ctx, cr, ccList[0])
+		}
+		if err != nil {
+			cr.SetConditions(v1beta1.EngineVersionUpgradeFailed(err))
+		}
+		// Apply all other changes while leaving the engine version alone.
+		params.EngineVersion = nil
+	}
+
+	_, err = e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyReplicationGroupInput(params, meta.GetExternalName(cr)))
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyReplicationGroup)
 }
 
+// engineVersionUpgradeBlocked returns true if the desired engine version
+// cannot be applied to the replication group, either because AWS rolled back
+// our last attempt to upgrade to it or because it is a downgrade. We don't
+// retry until the desired version changes. The returned error explains a
+// downgrade.
+func engineVersionUpgradeBlocked(cr *v1beta1.ReplicationGroup, cc awselasticachetypes.CacheCluster) (bool, error) {
+	if !elasticache.ReplicationGroupEngineVersionNeedsUpgrade(cr.Spec.ForProvider, cc) {
+		return false, nil
+	}
+	from, to := aws.ToString(cc.EngineVersion), aws.ToString(cr.Spec.ForProvider.EngineVersion)
+	if cr.GetCondition(v1beta1.TypeEngineVersionUpgrade).Equal(v1beta1.EngineVersionUpgradeRolledBack(from, to)) {
+		return true, nil
+	}
+	if elasticache.IsEngineVersionDowngrade(to, from) {
+		return true, errors.Errorf(errFmtEngineVersionDowngrade, from, to)
+	}
+	return false, nil
+}
+
+// upgradeEngineVersion modifies the engine version of the replication group
+// on its own so that AWS validation errors are reported in the
+// EngineVersionUpgrade condition instead of failing the whole modification.
+func (e *external) upgradeEngineVersion(ctx context.Context, cr *v1beta1.ReplicationGroup, cc awselasticachetypes.CacheCluster) error {
+	from, to := aws.ToString(cc.EngineVersion), aws.ToString(cr.Spec.ForProvider.EngineVersion)
+	if _, err := e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyReplicationGroupEngineVersionInput(cr.Spec.ForProvider, meta.GetExternalName(cr))); err != nil {
+		err = awsclient.Wrap(err, errUpgradeEngineVersion)
+		cr.SetConditions(v1beta1.EngineVersionUpgradeFailed(err))
+		return err
+	}
+	// we can only do one change at a time, so we'll have to return early here
+	cr.SetConditions(v1beta1.EngineVersionUpgradeInProgress(from, to))
+	return nil
+}
+
+// updateAuthToken updates the auth token of the replication group using the
+// configured update strategy. The connection secret is updated by Observe once
+// AWS finished the update.
+func (e *external) updateAuthToken(ctx context.Context, cr *v1beta1.ReplicationGroup, token string) error {
+	input := elasticache.NewModifyReplicationGroupAuthTokenInput(cr.Spec.ForProvider, meta.GetExternalName(cr), token)
+	if _, err := e.client.ModifyReplicationGroup(ctx, input); err != nil {
+		err = awsclient.Wrap(err, errUpdateAuthToken)
+		cr.SetConditions(v1beta1.AuthTokenUpdateFailed(err))
+		return err
+	}
+	cr.SetConditions(v1beta1.AuthTokenUpdateInProgress(string(input.AuthTokenUpdateStrategy)))
+	return nil
+}
+
+// authToken returns the token referenced by AuthTokenSecretRef, or a
+// generated one if there is no reference.
+func (e *external) authToken(ctx context.Context, cr *v1beta1.ReplicationGroup) (string, error) {
+	ref := cr.Spec.ForProvider.AuthTokenSecretRef
+	if ref == nil {
+		t, err := password.Generate()
+		return t, awsclient.Wrap(err, errGenerateAuthToken)
+	}
+	t, err := e.getSecretValue(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, ref.Key)
+	if err != nil {
+		return "", errors.Wrap(err, errGetAuthTokenSecret)
+	}
+	if t == "" {
+		return "", errors.Errorf(errFmtEmptyAuthToken, ref.Key)
+	}
+	return t, nil
+}
+
+// desiredAuthToken returns the token referenced by AuthTokenSecretRef and
+// whether it differs from the one in the connection secret.
+func (e *external) desiredAuthToken(ctx context.Context, cr *v1beta1.ReplicationGroup) (string, bool, error) {
+	ref, cs := cr.Spec.ForProvider.AuthTokenSecretRef, cr.GetWriteConnectionSecretToReference()
+	if ref == nil || cs == nil {
+		return "", false, nil
+	}
+	desired, err := e.getSecretValue(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, ref.Key)
+	if err != nil {
+		return "", false, errors.Wrap(err, errGetAuthTokenSecret)
+	}
+	if desired == "" {
+		return "", false, errors.Errorf(errFmtEmptyAuthToken, ref.Key)
+	}
+	published, err := e.getSecretValue(ctx, types.NamespacedName{Name: cs.Name, Namespace: cs.Namespace}, xpv1.ResourceCredentialsSecretPasswordKey)
+	if kerrors.IsNotFound(err) {
+		// Nothing was published yet, so there is nothing to compare with.
+		return desired, false, nil
+	}
+	if err != nil {
+		return "", false, errors.Wrap(err, errGetConnectionSecret)
+	}
+	return desired, desired != published, nil
+}
+
+func (e *external) getSecretValue(ctx context.Context, nn types.NamespacedName, key string) (string, error) {
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, nn, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[key]), nil
+}
+
+func authTokenUpdateInProgress(cr *v1beta1.ReplicationGroup) bool {
+	return cr.GetCondition(v1beta1.TypeAuthTokenUpdate).Reason == v1beta1.ReasonAuthTokenUpdateInProgress
+}
+
+// authTokenUpdateCompleted returns true if AWS finished an auth token update
+// started by Update.
+func authTokenUpdateCompleted(cr *v1beta1.ReplicationGroup, rg awselasticachetypes.ReplicationGroup) bool {
+	return authTokenUpdateInProgress(cr) &&
+		cr.Status.AtProvider.Status == v1beta1.StatusAvailable &&
+		(rg.PendingModifiedValues == nil || rg.PendingModifiedValues.AuthTokenStatus == "")
+}
+
+// observeEngineVersionUpgrade resolves an in progress engine version upgrade
+// once the replication group is available again.
+func observeEngineVersionUpgrade(cr *v1beta1.ReplicationGroup, cc awselasticachetypes.CacheCluster) {
+	if cr.GetCondition(v1beta1.TypeEngineVersionUpgrade).Reason != v1beta1.ReasonUpgradeInProgress ||
+		cr.Status.AtProvider.Status != v1beta1.StatusAvailable {
+		return
+	}
+	if cc.PendingModifiedValues != nil && cc.PendingModifiedValues.EngineVersion != nil {
+		return
+	}
+	if elasticache.ReplicationGroupEngineVersionNeedsUpgrade(cr.Spec.ForProvider, cc) {
+		cr.SetConditions(v1beta1.EngineVersionUpgradeRolledBack(aws.ToString(cc.EngineVersion), aws.ToString(cr.Spec.ForProvider.EngineVersion)))
+		return
+	}
+	cr.SetConditions(v1beta1.EngineVersionUpgradeSucceeded(aws.ToString(cc.EngineVersion)))
+}
+
+// testFailover tests automatic failover on the next node group requested
+// through the AnnotationKeyTestFailover annotation and records it in the
+// AnnotationKeyLastTestFailover annotation.
+func (e *external) testFailover(ctx context.Context, cr *v1beta1.ReplicationGroup) error {
+	if !nodeGroupsAvailable(cr) {
+		return nil
+	}
+	ref, nodeGroups, tested := testFailoverRequest(cr)
+	next := nodeGroups[len(tested)]
+	if _, err := e.client.TestFailover(ctx, elasticache.NewTestFailoverInput(meta.GetExternalName(cr), next)); err != nil {
+		err = awsclient.Wrap(err, errTestFailover)
+		cr.SetConditions(v1beta1.TestFailoverFailed(next, err))
+		return err
+	}
+	meta.AddAnnotations(cr, map[string]string{
+		v1beta1.AnnotationKeyLastTestFailover: ref + ":" + strings.Join(append(tested, next), ","),
+	})
+	// Updates of the spec and metadata replace the status with the one that
+	// was last persisted, so we need to restore it.
+	status := cr.Status.DeepCopy()
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errUpdateReplicationGroupCR)
+	}
+	cr.Status = *status
+	cr.SetConditions(v1beta1.TestFailoverInProgress(next))
+	return nil
+}
+
+// testFailoverRequest returns the reference and the node groups of the
+// failover test requested through the AnnotationKeyTestFailover annotation,
+// and the node groups failover was already tested on. The reference is empty
+// if no node groups are requested.
+func testFailoverRequest(cr *v1beta1.ReplicationGroup) (string, []string, []string) {
+	var nodeGroups []string
+	for _, ng := range strings.Split(cr.GetAnnotations()[v1beta1.AnnotationKeyTestFailover], ",") {
+		if ng = strings.TrimSpace(ng); ng != "" {
+			nodeGroups = append(nodeGroups, ng)
+		}
+	}
+	if len(nodeGroups) == 0 {
+		return "", nil, nil
+	}
+	ref := testFailoverRef(strings.Join(nodeGroups, ","))
+	last := strings.SplitN(cr.GetAnnotations()[v1beta1.AnnotationKeyLastTestFailover], ":", 2)
+	if len(last) != 2 || last[0] != ref || last[1] == "" {
+		return ref, nodeGroups, nil
+	}
+	return ref, nodeGroups, strings.Split(last[1], ",")
+}
+
+// testFailoverRef returns the reference of a failover test of the supplied
+// comma separated node groups.
+func testFailoverRef(nodeGroups string) string {
+	sum := sha256.Sum256([]byte(nodeGroups))
+	return fmt.Sprintf("%x", sum[:8])
+}
+
+// testFailoverPending returns true if failover was not yet tested on all node
+// groups requested through the AnnotationKeyTestFailover annotation.
+func testFailoverPending(cr *v1beta1.ReplicationGroup) bool {
+	_, nodeGroups, tested := testFailoverRequest(cr)
+	return len(tested) < len(nodeGroups)
+}
+
+// observeTestFailover resolves an in progress failover test once failover was
+// tested on all requested node groups and the replication group is available
+// again.
+func observeTestFailover(cr *v1beta1.ReplicationGroup) {
+	if cr.GetCondition(v1beta1.TypeTestFailover).Reason != v1beta1.ReasonTestFailoverInProgress ||
+		cr.Status.AtProvider.Status != v1beta1.StatusAvailable || !nodeGroupsAvailable(cr) || testFailoverPending(cr) {
+		return
+	}
+	_, nodeGroups, _ := testFailoverRequest(cr)
+	cr.SetConditions(v1beta1.TestFailoverSucceeded(strings.Join(nodeGroups, ",")))
+}
+
+// nodeGroupsAvailable returns true if none of the node groups of the
+// replication group is being modified, e.g. by a failover test.
+func nodeGroupsAvailable(cr *v1beta1.ReplicationGroup) bool {
+	for _, ng := range cr.Status.AtProvider.NodeGroups {
+		if ng.Status != v1beta1.StatusAvailable {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.ReplicationGroup)
 	if !ok {
@@ -219,10 +546,26 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if cr.Status.AtProvider.Status == v1beta1.StatusDeleting {
 		return nil
 	}
+	// A secondary member of a Global datastore cannot be deleted before it is
+	// detached from it. The deletion is retried once AWS finished detaching.
+	if cr.Status.AtProvider.GlobalReplicationGroupMemberRole == globalReplicationGroupRoleSecondary {
+		return e.disassociateGlobalReplicationGroup(ctx, cr)
+	}
 	_, err := e.client.DeleteReplicationGroup(ctx, elasticache.NewDeleteReplicationGroupInput(meta.GetExternalName(cr)))
 	return awsclient.Wrap(resource.Ignore(elasticache.IsNotFound, err), errDeleteReplicationGroup)
 }
 
+// disassociateGlobalReplicationGroup detaches the replication group from the
+// Global datastore it is a secondary member of.
+func (e *external) disassociateGlobalReplicationGroup(ctx context.Context, cr *v1beta1.ReplicationGroup) error {
+	if cr.Status.AtProvider.Status != v1beta1.StatusAvailable {
+		return nil
+	}
+	_, err := e.client.DisassociateGlobalReplicationGroup(ctx, elasticache.NewDisassociateGlobalReplicationGroupInput(
+		cr.Status.AtProvider.GlobalReplicationGroupID, meta.GetExternalName(cr), e.region))
+	return awsclient.Wrap(err, errDisassociateGlobalRG)
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -58,6 +59,10 @@ var (
 
 	cacheClusterID = name + "-0001"
 
+	namespace        = "crossplane-system"
+	authTokenSecret  = "auth-token"
+	connectionSecret = "conn"
+
 	ctx       = context.Background()
 	errorBoom = errors.New("boom")
 
@@ -83,6 +88,13 @@ func withProviderStatus(s string) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.Status = s }
 }
 
+func withGlobalSecondary(id string) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Status.AtProvider.GlobalReplicationGroupID = id
+		r.Status.AtProvider.GlobalReplicationGroupMemberRole = "SECONDARY"
+	}
+}
+
 func withReplicationGroupID(n string) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { meta.SetExternalName(r, n) }
 }
@@ -103,6 +115,42 @@ func withMemberClusters(members []string) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.MemberClusters = members }
 }
 
+func withAuthTokenSecretRef() replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Spec.ForProvider.AuthTokenSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: authTokenSecret, Namespace: namespace},
+			Key:             "token",
+		}
+	}
+}
+
+func withConnectionSecretRef() replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: connectionSecret, Namespace: namespace}
+	}
+}
+
+// secrets returns a kube client that serves the auth token and connection
+// secrets with the supplied tokens.
+func secrets(desired, published string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			switch key.Name {
+			case authTokenSecret:
+				s.Data = map[string][]byte{"token": []byte(desired)}
+			case connectionSecret:
+				s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(published)}
+			}
+			return nil
+		},
+	}
+}
+
+func withEngineVersion(v string) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.EngineVersion = v }
+}
+
 func withClusterEnabled(e bool) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.ClusterEnabled = e }
 }
@@ -121,6 +169,10 @@ func withNumNodeGroups(n int) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.NumNodeGroups = &n }
 }
 
+func withReplicasPerNodeGroup(n int) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.ReplicasPerNodeGroup = &n }
+}
+
 func replicationGroup(rm ...replicationGroupModifier) *v1beta1.ReplicationGroup {
 	r := &v1beta1.ReplicationGroup{
 		ObjectMeta: objectMeta,
@@ -149,6 +201,372 @@ func replicationGroup(rm ...replicationGroupModifier) *v1beta1.ReplicationGroup
 var _ managed.ExternalClient = &external{}
 var _ managed.ExternalConnecter = &connector{}
 
+func TestObserveAuthToken(t *testing.T) {
+	type want struct {
+		cr        *v1beta1.ReplicationGroup
+		upToDate  bool
+		published []byte
+	}
+	describe := func(pending types.AuthTokenUpdateStatus) *fake.MockClient {
+		return &fake.MockClient{
+			MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+				rg := types.ReplicationGroup{Status: aws.String(v1beta1.StatusAvailable), CacheNodeType: aws.String("")}
+				if pending != "" {
+					rg.PendingModifiedValues = &types.ReplicationGroupPendingModifiedValues{AuthTokenStatus: pending}
+				}
+				return &elasticache.DescribeReplicationGroupsOutput{ReplicationGroups: []types.ReplicationGroup{rg}}, nil
+			},
+		}
+	}
+
+	// Use a replication group without parameters so that only the auth token
+	// affects whether it is up to date.
+	authReplicationGroup := func(rm ...replicationGroupModifier) *v1beta1.ReplicationGroup {
+		r := &v1beta1.ReplicationGroup{ObjectMeta: objectMeta}
+		for _, m := range rm {
+			m(r)
+		}
+		return r
+	}
+
+	cases := map[string]struct {
+		e    *external
+		cr   *v1beta1.ReplicationGroup
+		want want
+	}{
+		"TokenChanged": {
+			e:  &external{client: describe(""), kube: secrets("new", "old")},
+			cr: authReplicationGroup(withAuthTokenSecretRef(), withConnectionSecretRef()),
+			want: want{
+				cr: authReplicationGroup(withAuthTokenSecretRef(), withConnectionSecretRef(),
+					withProviderStatus(v1beta1.StatusAvailable), withConditions(xpv1.Available())),
+				upToDate: false,
+			},
+		},
+		"UpdateInProgress": {
+			e: &external{client: describe(types.AuthTokenUpdateStatusRotating), kube: secrets("new", "old")},
+			cr: authReplicationGroup(withAuthTokenSecretRef(), withConnectionSecretRef(),
+				withConditions(v1beta1.AuthTokenUpdateInProgress(v1beta1.AuthTokenUpdateStrategyRotate))),
+			want: want{
+				cr: authReplicationGroup(withAuthTokenSecretRef(), withConnectionSecretRef(),
+					withProviderStatus(v1beta1.StatusAvailable),
+					withConditions(v1beta1.AuthTokenUpdateInProgress(v1beta1.AuthTokenUpdateStrategyRotate), xpv1.Available())),
+				upToDate: true,
+			},
+		},
+		"UpdateCompleted": {
+			e: &external{client: describe(""), kube: secrets("new", "old")},
+			cr: authReplicationGroup(withAuthTokenSecretRef(), withConnectionSecretRef(),
+				withConditions(v1beta1.AuthTokenUpdateInProgress(v1beta1.AuthTokenUpdateStrategyRotate))),
+			want: want{
+				cr: authReplicationGroup(withAuthTokenSecretRef(), withConnectionSecretRef(),
+					withProviderStatus(v1beta1.StatusAvailable),
+					withConditions(v1beta1.AuthTokenUpdated(), xpv1.Available())),
+				upToDate:  true,
+				published: []byte("new"),
+			},
+		},
+		"NoConnectionSecret": {
+			e:  &external{client: describe(""), kube: secrets("new", "old")},
+			cr: authReplicationGroup(withAuthTokenSecretRef()),
+			want: want{
+				cr: authReplicationGroup(withAuthTokenSecretRef(),
+					withProviderStatus(v1beta1.StatusAvailable), withConditions(xpv1.Available())),
+				upToDate: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := tc.e.Observe(ctx, tc.cr)
+			if err != nil {
+				t.Fatalf("tc.e.Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if o.ResourceUpToDate != tc.want.upToDate {
+				t.Errorf("tc.e.Observe(...) ResourceUpToDate: want: %t got: %t", tc.want.upToDate, o.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tc.want.published, o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]); diff != "" {
+				t.Errorf("tc.e.Observe(...) password: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveBlockedEngineVersion(t *testing.T) {
+	describe := func(current string) *fake.MockClient {
+		return &fake.MockClient{
+			MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+				return &elasticache.DescribeReplicationGroupsOutput{
+					ReplicationGroups: []types.ReplicationGroup{{
+						Status:         aws.String(v1beta1.StatusAvailable),
+						CacheNodeType:  aws.String(""),
+						MemberClusters: []string{cacheClusterID},
+					}},
+				}, nil
+			},
+			MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+				return &elasticache.DescribeCacheClustersOutput{
+					CacheClusters: []types.CacheCluster{{EngineVersion: aws.String(current)}},
+				}, nil
+			},
+		}
+	}
+	versionedReplicationGroup := func(c ...xpv1.Condition) *v1beta1.ReplicationGroup {
+		r := &v1beta1.ReplicationGroup{ObjectMeta: objectMeta}
+		r.Spec.ForProvider.EngineVersion = aws.String(engineVersion)
+		r.SetConditions(c...)
+		return r
+	}
+
+	cases := map[string]struct {
+		client    *fake.MockClient
+		cr        *v1beta1.ReplicationGroup
+		upToDate  bool
+		condition xpv1.Condition
+	}{
+		"UpgradeNeeded": {
+			client:    describe("4.0.10"),
+			cr:        versionedReplicationGroup(),
+			upToDate:  false,
+			condition: xpv1.Condition{Type: v1beta1.TypeEngineVersionUpgrade, Status: corev1.ConditionUnknown},
+		},
+		"UpgradeRolledBack": {
+			client:    describe("4.0.10"),
+			cr:        versionedReplicationGroup(v1beta1.EngineVersionUpgradeRolledBack("4.0.10", engineVersion)),
+			upToDate:  true,
+			condition: v1beta1.EngineVersionUpgradeRolledBack("4.0.10", engineVersion),
+		},
+		"Downgrade": {
+			client:    describe("6.2.6"),
+			cr:        versionedReplicationGroup(),
+			upToDate:  true,
+			condition: v1beta1.EngineVersionUpgradeFailed(errors.Errorf(errFmtEngineVersionDowngrade, "6.2.6", engineVersion)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
+			o, err := e.Observe(ctx, tc.cr)
+			if err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if o.ResourceUpToDate != tc.upToDate {
+				t.Errorf("e.Observe(...) ResourceUpToDate: want: %t got: %t", tc.upToDate, o.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tc.condition, tc.cr.GetCondition(v1beta1.TypeEngineVersionUpgrade), test.EquateConditions()); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObserveTestFailover(t *testing.T) {
+	describe := func(ngStatus string) *fake.MockClient {
+		return &fake.MockClient{
+			MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+				return &elasticache.DescribeReplicationGroupsOutput{
+					ReplicationGroups: []types.ReplicationGroup{{
+						Status:        aws.String(v1beta1.StatusAvailable),
+						CacheNodeType: aws.String(""),
+						NodeGroups:    []types.NodeGroup{{NodeGroupId: aws.String("0001"), Status: aws.String(ngStatus)}},
+					}},
+				}, nil
+			},
+		}
+	}
+	failoverReplicationGroup := func(annotations map[string]string, c ...xpv1.Condition) *v1beta1.ReplicationGroup {
+		r := &v1beta1.ReplicationGroup{ObjectMeta: objectMeta}
+		r.SetAnnotations(annotations)
+		r.SetConditions(c...)
+		return r
+	}
+	requested := map[string]string{v1beta1.AnnotationKeyTestFailover: "0001"}
+	tested := map[string]string{
+		v1beta1.AnnotationKeyTestFailover:     "0001",
+		v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001") + ":0001",
+	}
+
+	cases := map[string]struct {
+		client    *fake.MockClient
+		cr        *v1beta1.ReplicationGroup
+		upToDate  bool
+		condition xpv1.Condition
+	}{
+		"TestFailoverRequested": {
+			client:    describe(v1beta1.StatusAvailable),
+			cr:        failoverReplicationGroup(requested),
+			upToDate:  false,
+			condition: xpv1.Condition{Type: v1beta1.TypeTestFailover, Status: corev1.ConditionUnknown},
+		},
+		"TestFailoverInProgress": {
+			client:    describe(v1beta1.StatusModifying),
+			cr:        failoverReplicationGroup(tested, v1beta1.TestFailoverInProgress("0001")),
+			upToDate:  true,
+			condition: v1beta1.TestFailoverInProgress("0001"),
+		},
+		"TestFailoverSucceeded": {
+			client:    describe(v1beta1.StatusAvailable),
+			cr:        failoverReplicationGroup(tested, v1beta1.TestFailoverInProgress("0001")),
+			upToDate:  true,
+			condition: v1beta1.TestFailoverSucceeded("0001"),
+		},
+		"TestFailoverRequestChanged": {
+			client:    describe(v1beta1.StatusAvailable),
+			cr:        failoverReplicationGroup(map[string]string{v1beta1.AnnotationKeyTestFailover: "0001,0002", v1beta1.AnnotationKeyLastTestFailover: tested[v1beta1.AnnotationKeyLastTestFailover]}, v1beta1.TestFailoverSucceeded("0001")),
+			upToDate:  false,
+			condition: v1beta1.TestFailoverSucceeded("0001"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
+			o, err := e.Observe(ctx, tc.cr)
+			if err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %v", err)
+			}
+			if o.ResourceUpToDate != tc.upToDate {
+				t.Errorf("e.Observe(...) ResourceUpToDate: want: %t got: %t", tc.upToDate, o.ResourceUpToDate)
+			}
+			if diff := cmp.Diff(tc.condition, tc.cr.GetCondition(v1beta1.TypeTestFailover), test.EquateConditions()); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateTestFailover(t *testing.T) {
+	type want struct {
+		nodeGroup   string
+		annotations map[string]string
+		condition   xpv1.Condition
+		err         error
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		nodeGroups  []v1beta1.NodeGroup
+		failover    error
+		kube        error
+		want        want
+	}{
+		"FirstNodeGroup": {
+			annotations: map[string]string{v1beta1.AnnotationKeyTestFailover: "0001, 0002"},
+			want: want{
+				nodeGroup: "0001",
+				annotations: map[string]string{
+					v1beta1.AnnotationKeyTestFailover:     "0001, 0002",
+					v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001,0002") + ":0001",
+				},
+				condition: v1beta1.TestFailoverInProgress("0001"),
+			},
+		},
+		"NextNodeGroup": {
+			annotations: map[string]string{
+				v1beta1.AnnotationKeyTestFailover:     "0001,0002",
+				v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001,0002") + ":0001",
+			},
+			want: want{
+				nodeGroup: "0002",
+				annotations: map[string]string{
+					v1beta1.AnnotationKeyTestFailover:     "0001,0002",
+					v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001,0002") + ":0001,0002",
+				},
+				condition: v1beta1.TestFailoverInProgress("0002"),
+			},
+		},
+		"RequestChanged": {
+			annotations: map[string]string{
+				v1beta1.AnnotationKeyTestFailover:     "0002",
+				v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001") + ":0001",
+			},
+			want: want{
+				nodeGroup: "0002",
+				annotations: map[string]string{
+					v1beta1.AnnotationKeyTestFailover:     "0002",
+					v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0002") + ":0002",
+				},
+				condition: v1beta1.TestFailoverInProgress("0002"),
+			},
+		},
+		"NodeGroupModifying": {
+			annotations: map[string]string{
+				v1beta1.AnnotationKeyTestFailover:     "0001,0002",
+				v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001,0002") + ":0001",
+			},
+			nodeGroups: []v1beta1.NodeGroup{{NodeGroupID: "0001", Status: v1beta1.StatusModifying}},
+			want: want{
+				annotations: map[string]string{
+					v1beta1.AnnotationKeyTestFailover:     "0001,0002",
+					v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001,0002") + ":0001",
+				},
+				condition: xpv1.Condition{Type: v1beta1.TypeTestFailover, Status: corev1.ConditionUnknown},
+			},
+		},
+		"TestFailoverFailed": {
+			annotations: map[string]string{v1beta1.AnnotationKeyTestFailover: "0001"},
+			failover:    errorBoom,
+			want: want{
+				nodeGroup:   "0001",
+				annotations: map[string]string{v1beta1.AnnotationKeyTestFailover: "0001"},
+				condition:   v1beta1.TestFailoverFailed("0001", awsclient.Wrap(errorBoom, errTestFailover)),
+				err:         awsclient.Wrap(errorBoom, errTestFailover),
+			},
+		},
+		"UpdateFailed": {
+			annotations: map[string]string{v1beta1.AnnotationKeyTestFailover: "0001"},
+			kube:        errorBoom,
+			want: want{
+				nodeGroup: "0001",
+				annotations: map[string]string{
+					v1beta1.AnnotationKeyTestFailover:     "0001",
+					v1beta1.AnnotationKeyLastTestFailover: testFailoverRef("0001") + ":0001",
+				},
+				condition: xpv1.Condition{Type: v1beta1.TypeTestFailover, Status: corev1.ConditionUnknown},
+				err:       errors.Wrap(errorBoom, errUpdateReplicationGroupCR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var nodeGroup string
+			e := &external{
+				client: &fake.MockClient{
+					MockTestFailover: func(_ context.Context, i *elasticache.TestFailoverInput, _ []func(*elasticache.Options)) (*elasticache.TestFailoverOutput, error) {
+						nodeGroup = aws.ToString(i.NodeGroupId)
+						return &elasticache.TestFailoverOutput{}, tc.failover
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(tc.kube)},
+			}
+			cr := replicationGroup(withProviderStatus(v1beta1.StatusAvailable))
+			cr.SetAnnotations(tc.annotations)
+			meta.SetExternalName(cr, name)
+			cr.Status.AtProvider.NodeGroups = tc.nodeGroups
+			_, err := e.Update(ctx, cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.nodeGroup, nodeGroup); diff != "" {
+				t.Errorf("TestFailover(...) node group: -want, +got:\n%s", diff)
+			}
+			tc.want.annotations[meta.AnnotationKeyExternalName] = name
+			if diff := cmp.Diff(tc.want.annotations, cr.GetAnnotations()); diff != "" {
+				t.Errorf("annotations: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, cr.GetCondition(v1beta1.TypeTestFailover), test.EquateConditions()); diff != "" {
+				t.Errorf("condition: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	cases := []testCase{
 		{
@@ -166,6 +584,47 @@ func TestCreate(t *testing.T) {
 			),
 			tokenCreated: true,
 		},
+		{
+			name: "SuccessfulCreateWithAuthTokenSecret",
+			e: &external{
+				client: &fake.MockClient{
+					MockCreateReplicationGroup: func(ctx context.Context, in *elasticache.CreateReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.CreateReplicationGroupOutput, error) {
+						if aws.ToString(in.AuthToken) != "secret-token" {
+							return nil, errorBoom
+						}
+						return &elasticache.CreateReplicationGroupOutput{}, nil
+					},
+				},
+				kube: secrets("secret-token", ""),
+			},
+			r: replicationGroup(withAuthEnabled(true), withAuthTokenSecretRef()),
+			want: replicationGroup(
+				withAuthEnabled(true),
+				withAuthTokenSecretRef(),
+				withConditions(xpv1.Creating()),
+				withReplicationGroupID(name),
+			),
+			tokenCreated: true,
+		},
+		{
+			name: "FailedCreateWithEmptyAuthTokenSecret",
+			e: &external{
+				client: &fake.MockClient{
+					MockCreateReplicationGroup: func(ctx context.Context, in *elasticache.CreateReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.CreateReplicationGroupOutput, error) {
+						return &elasticache.CreateReplicationGroupOutput{}, nil
+					},
+				},
+				kube: secrets("", ""),
+			},
+			r: replicationGroup(withAuthEnabled(true), withAuthTokenSecretRef()),
+			want: replicationGroup(
+				withAuthEnabled(true),
+				withAuthTokenSecretRef(),
+				withConditions(xpv1.Creating()),
+				withReplicationGroupID(name),
+			),
+			returnsErr: true,
+		},
 		{
 			name: "FailedCreate",
 			e: &external{client: &fake.MockClient{
@@ -308,6 +767,64 @@ func TestObserve(t *testing.T) {
 				withConditions(xpv1.Creating()),
 			),
 		},
+		{
+			name: "SuccessfulObserveEngineVersionUpgraded",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+						}},
+					}, nil
+				},
+				MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+					return &elasticache.DescribeCacheClustersOutput{
+						CacheClusters: []types.CacheCluster{{EngineVersion: aws.String("5.0.0")}},
+					}, nil
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withConditions(xpv1.Unavailable(), v1beta1.EngineVersionUpgradeInProgress("4.0.10", engineVersion)),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withMemberClusters([]string{cacheClusterID}),
+				withEngineVersion("5.0.0"),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeSucceeded(engineVersion)),
+			),
+		},
+		{
+			name: "SuccessfulObserveEngineVersionUpgradeRolledBack",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+						}},
+					}, nil
+				},
+				MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+					return &elasticache.DescribeCacheClustersOutput{
+						CacheClusters: []types.CacheCluster{{EngineVersion: aws.String("4.0.10")}},
+					}, nil
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withConditions(xpv1.Unavailable(), v1beta1.EngineVersionUpgradeInProgress("4.0.10", engineVersion)),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withMemberClusters([]string{cacheClusterID}),
+				withEngineVersion("4.0.10"),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeRolledBack("4.0.10", engineVersion)),
+			),
+		},
 		{
 			name: "FailedObserveLateInitializeError",
 			e: &external{
@@ -503,6 +1020,299 @@ func TestUpdate(t *testing.T) {
 			),
 			returnsErr: true,
 		},
+		{
+			name: "CallsIncreaseReplicaCount",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+							NodeGroups: []types.NodeGroup{{
+								NodeGroupId:      aws.String("ng-01"),
+								NodeGroupMembers: make([]types.NodeGroupMember, 2),
+							}},
+						}},
+					}, nil
+				},
+				MockIncreaseReplicaCount: func(ctx context.Context, in *elasticache.IncreaseReplicaCountInput, opts []func(*elasticache.Options)) (*elasticache.IncreaseReplicaCountOutput, error) {
+					if aws.ToInt32(in.NewReplicaCount) != 2 || !in.ApplyImmediately {
+						return nil, errorBoom
+					}
+					return &elasticache.IncreaseReplicaCountOutput{}, nil
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+				withReplicasPerNodeGroup(2),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+				withReplicasPerNodeGroup(2),
+			),
+			returnsErr: false,
+		},
+		{
+			name: "FailedDecreaseReplicaCount",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+							NodeGroups: []types.NodeGroup{{
+								NodeGroupId:      aws.String("ng-01"),
+								NodeGroupMembers: make([]types.NodeGroupMember, 3),
+							}},
+						}},
+					}, nil
+				},
+				MockDecreaseReplicaCount: func(ctx context.Context, _ *elasticache.DecreaseReplicaCountInput, opts []func(*elasticache.Options)) (*elasticache.DecreaseReplicaCountOutput, error) {
+					return nil, errorBoom
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+				withReplicasPerNodeGroup(1),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+				withReplicasPerNodeGroup(1),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "CallsAuthTokenUpdate",
+			e: &external{
+				client: &fake.MockClient{
+					MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+						return &elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []types.ReplicationGroup{{
+								Status:         aws.String(v1beta1.StatusAvailable),
+								MemberClusters: []string{cacheClusterID},
+							}},
+						}, nil
+					},
+					MockModifyReplicationGroup: func(ctx context.Context, in *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+						if aws.ToString(in.AuthToken) != "new" || in.AuthTokenUpdateStrategy != types.AuthTokenUpdateStrategyTypeRotate || !in.ApplyImmediately {
+							return nil, errorBoom
+						}
+						return &elasticache.ModifyReplicationGroupOutput{}, nil
+					},
+				},
+				kube: secrets("new", "old"),
+			},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+				withAuthTokenSecretRef(),
+				withConnectionSecretRef(),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.AuthTokenUpdateInProgress(v1beta1.AuthTokenUpdateStrategyRotate)),
+				withMemberClusters([]string{cacheClusterID}),
+				withAuthTokenSecretRef(),
+				withConnectionSecretRef(),
+			),
+			returnsErr: false,
+		},
+		{
+			name: "FailedAuthTokenUpdate",
+			e: &external{
+				client: &fake.MockClient{
+					MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+						return &elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []types.ReplicationGroup{{
+								Status:         aws.String(v1beta1.StatusAvailable),
+								MemberClusters: []string{cacheClusterID},
+							}},
+						}, nil
+					},
+					MockModifyReplicationGroup: func(ctx context.Context, in *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+						return nil, errorBoom
+					},
+				},
+				kube: secrets("new", "old"),
+			},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+				withAuthTokenSecretRef(),
+				withConnectionSecretRef(),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.AuthTokenUpdateFailed(awsclient.Wrap(errorBoom, errUpdateAuthToken))),
+				withMemberClusters([]string{cacheClusterID}),
+				withAuthTokenSecretRef(),
+				withConnectionSecretRef(),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "CallsEngineVersionUpgrade",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+						}},
+					}, nil
+				},
+				MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+					return &elasticache.DescribeCacheClustersOutput{
+						CacheClusters: []types.CacheCluster{{EngineVersion: aws.String("4.0.10")}},
+					}, nil
+				},
+				MockModifyReplicationGroup: func(ctx context.Context, in *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+					if aws.ToString(in.EngineVersion) != engineVersion || in.PreferredMaintenanceWindow != nil {
+						return nil, errorBoom
+					}
+					return &elasticache.ModifyReplicationGroupOutput{}, nil
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeInProgress("4.0.10", engineVersion)),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			returnsErr: false,
+		},
+		{
+			name: "RejectsEngineVersionDowngradeAppliesOtherChanges",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+						}},
+					}, nil
+				},
+				MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+					return &elasticache.DescribeCacheClustersOutput{
+						CacheClusters: []types.CacheCluster{{EngineVersion: aws.String("6.2.6")}},
+					}, nil
+				},
+				MockModifyReplicationGroup: func(ctx context.Context, in *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+					if in.EngineVersion != nil {
+						return nil, errors.New("engine version must not be modified")
+					}
+					return &elasticache.ModifyReplicationGroupOutput{}, nil
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeFailed(errors.Errorf(errFmtEngineVersionDowngrade, "6.2.6", engineVersion))),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			returnsErr: false,
+		},
+		{
+			name: "FailedEngineVersionUpgrade",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+						}},
+					}, nil
+				},
+				MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+					return &elasticache.DescribeCacheClustersOutput{
+						CacheClusters: []types.CacheCluster{{EngineVersion: aws.String("4.0.10")}},
+					}, nil
+				},
+				MockModifyReplicationGroup: func(ctx context.Context, _ *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+					return nil, errorBoom
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available()),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeFailed(awsclient.Wrap(errorBoom, errUpgradeEngineVersion))),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			returnsErr: true,
+		},
+		{
+			name: "SkipsRolledBackEngineVersionUpgradeAppliesOtherChanges",
+			e: &external{client: &fake.MockClient{
+				MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+					return &elasticache.DescribeReplicationGroupsOutput{
+						ReplicationGroups: []types.ReplicationGroup{{
+							Status:         aws.String(v1beta1.StatusAvailable),
+							MemberClusters: []string{cacheClusterID},
+						}},
+					}, nil
+				},
+				MockDescribeCacheClusters: func(ctx context.Context, _ *elasticache.DescribeCacheClustersInput, opts []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+					return &elasticache.DescribeCacheClustersOutput{
+						CacheClusters: []types.CacheCluster{{EngineVersion: aws.String("4.0.10")}},
+					}, nil
+				},
+				MockModifyReplicationGroup: func(ctx context.Context, in *elasticache.ModifyReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+					if in.EngineVersion != nil {
+						return nil, errors.New("engine version must not be modified")
+					}
+					return &elasticache.ModifyReplicationGroupOutput{}, nil
+				},
+			}},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeRolledBack("4.0.10", engineVersion)),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(xpv1.Available(), v1beta1.EngineVersionUpgradeRolledBack("4.0.10", engineVersion)),
+				withMemberClusters([]string{cacheClusterID}),
+			),
+			returnsErr: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -550,6 +1360,23 @@ func TestDelete(t *testing.T) {
 			want:       replicationGroup(withConditions(xpv1.Deleting())),
 			returnsErr: false,
 		},
+		{
+			name: "SecondaryMember",
+			e: &external{client: &fake.MockClient{
+				MockDisassociateGlobalReplicationGroup: func(ctx context.Context, i *elasticache.DisassociateGlobalReplicationGroupInput, opts []func(*elasticache.Options)) (*elasticache.DisassociateGlobalReplicationGroupOutput, error) {
+					if aws.ToString(i.GlobalReplicationGroupId) != "ldgnf-global" || aws.ToString(i.ReplicationGroupRegion) != "us-west-2" {
+						return nil, errorBoom
+					}
+					return &elasticache.DisassociateGlobalReplicationGroupOutput{}, nil
+				},
+			}, region: "us-west-2"},
+			r: replicationGroup(withProviderStatus(v1beta1.StatusAvailable), withGlobalSecondary("ldgnf-global")),
+			want: replicationGroup(
+				withProviderStatus(v1beta1.StatusAvailable),
+				withGlobalSecondary("ldgnf-global"),
+				withConditions(xpv1.Deleting())),
+			returnsErr: false,
+		},
 		{
 			name: "AlreadyDeletingState",
 			e:    &external{},
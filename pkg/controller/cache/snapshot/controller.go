@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elasticacheservice "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+)
+
+// Error strings.
+const (
+	errNotSnapshot      = "managed resource is not a Snapshot"
+	errDescribeSnapshot = "cannot describe Snapshot"
+	errCreateSnapshot   = "cannot create Snapshot"
+	errCopySnapshot     = "cannot copy Snapshot"
+	errDeleteSnapshot   = "cannot delete Snapshot"
+)
+
+// SetupSnapshot adds a controller that reconciles Snapshot.
+func SetupSnapshot(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Snapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SnapshotGroupVersionKind),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elasticache.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return nil, errors.New(errNotSnapshot)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client elasticache.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshot)
+	}
+
+	resp, err := e.client.DescribeSnapshots(ctx, &elasticacheservice.DescribeSnapshotsInput{
+		SnapshotName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(elasticache.IsSnapshotNotFound, err), errDescribeSnapshot)
+	}
+	if len(resp.Snapshots) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = elasticache.GenerateSnapshotObservation(resp.Snapshots[0])
+
+	switch cr.Status.AtProvider.SnapshotStatus {
+	case v1alpha1.SnapshotStatusAvailable:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.SnapshotStatusCreating, v1alpha1.SnapshotStatusCopying:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.SnapshotStatusDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// Snapshots cannot be modified once taken.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSnapshot)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	if cr.Spec.ForProvider.SourceSnapshotName != nil {
+		_, err := e.client.CopySnapshot(ctx, elasticache.GenerateCopySnapshotInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCopySnapshot)
+	}
+	_, err := e.client.CreateSnapshot(ctx, elasticache.GenerateCreateSnapshotInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateSnapshot)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return errors.New(errNotSnapshot)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.SnapshotStatus == v1alpha1.SnapshotStatusDeleting {
+		return nil
+	}
+
+	_, err := e.client.DeleteSnapshot(ctx, &elasticacheservice.DeleteSnapshotInput{
+		SnapshotName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(elasticache.IsSnapshotNotFound, err), errDeleteSnapshot)
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awscachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var (
+	externalName = "somesnapshot"
+	sourceName   = "sourcesnapshot"
+	groupID      = "somegroup"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cache elasticache.Client
+	cr    *v1alpha1.Snapshot
+}
+
+type snapshotModifier func(*v1alpha1.Snapshot)
+
+func withExternalName() snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { meta.SetExternalName(s, externalName) }
+}
+
+func withConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { s.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.SnapshotParameters) snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { s.Spec.ForProvider = p }
+}
+
+func withStatus(o v1alpha1.SnapshotObservation) snapshotModifier {
+	return func(s *v1alpha1.Snapshot) { s.Status.AtProvider = o }
+}
+
+func snapshot(m ...snapshotModifier) *v1alpha1.Snapshot {
+	cr := &v1alpha1.Snapshot{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.Snapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshots: func(ctx context.Context, input *awscache.DescribeSnapshotsInput, opts []func(*awscache.Options)) (*awscache.DescribeSnapshotsOutput, error) {
+						return &awscache.DescribeSnapshotsOutput{
+							Snapshots: []awscachetypes.Snapshot{{
+								SnapshotStatus: aws.String(v1alpha1.SnapshotStatusAvailable),
+							}},
+						}, nil
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr: snapshot(withExternalName(),
+					withConditions(xpv1.Available()),
+					withStatus(v1alpha1.SnapshotObservation{
+						SnapshotStatus: v1alpha1.SnapshotStatusAvailable,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Copying": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshots: func(ctx context.Context, input *awscache.DescribeSnapshotsInput, opts []func(*awscache.Options)) (*awscache.DescribeSnapshotsOutput, error) {
+						return &awscache.DescribeSnapshotsOutput{
+							Snapshots: []awscachetypes.Snapshot{{
+								SnapshotStatus: aws.String(v1alpha1.SnapshotStatusCopying),
+							}},
+						}, nil
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr: snapshot(withExternalName(),
+					withConditions(xpv1.Creating()),
+					withStatus(v1alpha1.SnapshotObservation{
+						SnapshotStatus: v1alpha1.SnapshotStatusCopying,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshots: func(ctx context.Context, input *awscache.DescribeSnapshotsInput, opts []func(*awscache.Options)) (*awscache.DescribeSnapshotsOutput, error) {
+						return nil, &awscachetypes.SnapshotNotFoundFault{}
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr: snapshot(withExternalName()),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeSnapshots: func(ctx context.Context, input *awscache.DescribeSnapshotsInput, opts []func(*awscache.Options)) (*awscache.DescribeSnapshotsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr:  snapshot(withExternalName()),
+				err: awsclient.Wrap(errBoom, errDescribeSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreateFromReplicationGroup": {
+			args: args{
+				cache: &fake.MockClient{
+					MockCreateSnapshot: func(ctx context.Context, input *awscache.CreateSnapshotInput, opts []func(*awscache.Options)) (*awscache.CreateSnapshotOutput, error) {
+						if diff := cmp.Diff(&awscache.CreateSnapshotInput{
+							SnapshotName:       aws.String(externalName),
+							ReplicationGroupId: aws.String(groupID),
+						}, input, cmp.AllowUnexported(awscache.CreateSnapshotInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awscache.CreateSnapshotOutput{}, nil
+					},
+				},
+				cr: snapshot(withExternalName(),
+					withSpec(v1alpha1.SnapshotParameters{ReplicationGroupID: aws.String(groupID)})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(),
+					withSpec(v1alpha1.SnapshotParameters{ReplicationGroupID: aws.String(groupID)}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"CopyFromSource": {
+			args: args{
+				cache: &fake.MockClient{
+					MockCopySnapshot: func(ctx context.Context, input *awscache.CopySnapshotInput, opts []func(*awscache.Options)) (*awscache.CopySnapshotOutput, error) {
+						if diff := cmp.Diff(&awscache.CopySnapshotInput{
+							SourceSnapshotName: aws.String(sourceName),
+							TargetSnapshotName: aws.String(externalName),
+						}, input, cmp.AllowUnexported(awscache.CopySnapshotInput{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awscache.CopySnapshotOutput{}, nil
+					},
+				},
+				cr: snapshot(withExternalName(),
+					withSpec(v1alpha1.SnapshotParameters{SourceSnapshotName: aws.String(sourceName)})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(),
+					withSpec(v1alpha1.SnapshotParameters{SourceSnapshotName: aws.String(sourceName)}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockCreateSnapshot: func(ctx context.Context, input *awscache.CreateSnapshotInput, opts []func(*awscache.Options)) (*awscache.CreateSnapshotOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteSnapshot: func(ctx context.Context, input *awscache.DeleteSnapshotInput, opts []func(*awscache.Options)) (*awscache.DeleteSnapshotOutput, error) {
+						return &awscache.DeleteSnapshotOutput{}, nil
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr: snapshot(withExternalName(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cache: &fake.MockClient{},
+				cr: snapshot(withExternalName(),
+					withStatus(v1alpha1.SnapshotObservation{SnapshotStatus: v1alpha1.SnapshotStatusDeleting})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(),
+					withStatus(v1alpha1.SnapshotObservation{SnapshotStatus: v1alpha1.SnapshotStatusDeleting}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteSnapshot: func(ctx context.Context, input *awscache.DeleteSnapshotInput, opts []func(*awscache.Options)) (*awscache.DeleteSnapshotOutput, error) {
+						return nil, &awscachetypes.SnapshotNotFoundFault{}
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr: snapshot(withExternalName(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDeleteSnapshot: func(ctx context.Context, input *awscache.DeleteSnapshotInput, opts []func(*awscache.Options)) (*awscache.DeleteSnapshotOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName()),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cache}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
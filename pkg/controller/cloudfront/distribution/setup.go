@@ -19,12 +19,16 @@ package distribution
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
@@ -35,6 +39,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront"
 )
 
 // TODO: Aren't these defined as an API constant somewhere in aws-sdk-go?
@@ -43,6 +48,10 @@ const (
 	stateDeployed = "Deployed"
 )
 
+const (
+	errCreateInvalidation = "cannot create invalidation"
+)
+
 // SetupDistribution adds a controller that reconciles Distribution.
 func SetupDistribution(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.DistributionGroupKind)
@@ -60,7 +69,8 @@ func SetupDistribution(mgr ctrl.Manager, o controller.Options) error {
 						e.postCreate = postCreate
 						e.lateInitialize = lateInitialize
 						e.preObserve = preObserve
-						e.postObserve = postObserve
+						i := &invalidator{client: e.client}
+						e.postObserve = i.postObserve
 						e.isUpToDate = isUpToDate
 						e.preUpdate = preUpdate
 						d := &deleter{external: e}
@@ -265,6 +275,9 @@ func isUpToDate(cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput)
 		// We don't late init region - it's not in the output.
 		cmpopts.IgnoreFields(svcapitypes.DistributionParameters{}, "Region"),
 
+		// The references are resolved into the distribution config.
+		cmpopts.IgnoreFields(svcapitypes.DistributionParameters{}, "CustomDistributionParameters"),
+
 		// This appears to always be nil in GetDistributionOutput, which
 		// causes false positives for IsUpToDate.
 		cmpopts.IgnoreFields(svcapitypes.ViewerCertificate{}, "CloudFrontDefaultCertificate"),
@@ -300,6 +313,52 @@ func postObserve(_ context.Context, cr *svcapitypes.Distribution, gdo *svcsdk.Ge
 	return eo, nil
 }
 
+type invalidator struct {
+	client svcsdkapi.CloudFrontAPI
+}
+
+// postObserve invalidates the paths requested through the
+// AnnotationKeyInvalidatePaths annotation once. The caller reference of the
+// invalidation is recorded in an annotation that is persisted as if it was
+// late initialized.
+func (i *invalidator) postObserve(ctx context.Context, cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput,
+	eo managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	eo, err = postObserve(ctx, cr, gdo, eo, err)
+	if err != nil {
+		return eo, err
+	}
+	ref, paths := invalidationRequest(cr)
+	if ref == "" || cr.GetAnnotations()[svcapitypes.AnnotationKeyLastInvalidation] == ref {
+		return eo, nil
+	}
+	if _, err := i.client.CreateInvalidationWithContext(ctx, &svcsdk.CreateInvalidationInput{
+		DistributionId:    awsclients.String(meta.GetExternalName(cr)),
+		InvalidationBatch: cloudfront.GenerateInvalidationBatch(ref, paths),
+	}); err != nil {
+		return eo, awsclients.Wrap(err, errCreateInvalidation)
+	}
+	meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyLastInvalidation: ref})
+	eo.ResourceLateInitialized = true
+	return eo, nil
+}
+
+// invalidationRequest returns the caller reference and the paths of the
+// invalidation requested through the AnnotationKeyInvalidatePaths
+// annotation. The caller reference is empty if no paths are requested.
+func invalidationRequest(cr *svcapitypes.Distribution) (string, []string) {
+	var paths []string
+	for _, p := range strings.Split(cr.GetAnnotations()[svcapitypes.AnnotationKeyInvalidatePaths], ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 0 {
+		return "", nil
+	}
+	sum := sha256.Sum256([]byte(strings.Join(paths, ",")))
+	return fmt.Sprintf("%s-%x", cr.UID, sum[:8]), paths
+}
+
 func postUpdate(_ context.Context, cr *svcapitypes.Distribution, resp *svcsdk.UpdateDistributionOutput,
 	upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
 	if err != nil {
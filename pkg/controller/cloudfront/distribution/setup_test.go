@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package distribution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	testUID = "b6d9d3c5-4d6b-4b6e-9d1f-0c3c5e1f0a11"
+
+	// testReference is the caller reference of an invalidation of
+	// /index.html and /images/* of the distribution with testUID.
+	testReference = testUID + "-52f24a553957f6b0"
+)
+
+type mockClient struct {
+	svcsdkapi.CloudFrontAPI
+
+	created []*svcsdk.CreateInvalidationInput
+}
+
+func (m *mockClient) CreateInvalidationWithContext(_ context.Context, in *svcsdk.CreateInvalidationInput, _ ...request.Option) (*svcsdk.CreateInvalidationOutput, error) {
+	m.created = append(m.created, in)
+	return &svcsdk.CreateInvalidationOutput{}, nil
+}
+
+func TestInvalidatorPostObserve(t *testing.T) {
+	type want struct {
+		obs         managed.ExternalObservation
+		invalidated bool
+		reference   string
+	}
+	cases := map[string]struct {
+		annotations map[string]string
+		want        want
+	}{
+		"NoPaths": {
+			want: want{obs: managed.ExternalObservation{ResourceExists: true}},
+		},
+		"NewPaths": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyInvalidatePaths: "/index.html, /images/*",
+			},
+			want: want{
+				obs:         managed.ExternalObservation{ResourceExists: true, ResourceLateInitialized: true},
+				invalidated: true,
+				reference:   testReference,
+			},
+		},
+		"AlreadyInvalidated": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyInvalidatePaths:  "/index.html,/images/*",
+				svcapitypes.AnnotationKeyLastInvalidation: testReference,
+			},
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true},
+				reference: testReference,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Distribution{}
+			cr.UID = testUID
+			cr.SetAnnotations(tc.annotations)
+			meta.SetExternalName(cr, "E2QWRUHEXAMPLE")
+			m := &mockClient{}
+			i := &invalidator{client: m}
+			gdo := &svcsdk.GetDistributionOutput{Distribution: &svcsdk.Distribution{
+				Status:             awsclients.String(stateDeployed),
+				DistributionConfig: &svcsdk.DistributionConfig{Enabled: awsclients.Bool(true)},
+			}}
+			obs, err := i.postObserve(context.Background(), cr, gdo, managed.ExternalObservation{ResourceExists: true}, nil)
+			if err != nil {
+				t.Fatalf("postObserve(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.invalidated, len(m.created) == 1); diff != "" {
+				t.Errorf("invalidated: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reference, cr.GetAnnotations()[svcapitypes.AnnotationKeyLastInvalidation]); diff != "" {
+				t.Errorf("reference: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateInvalidationBatch returns the batch that invalidates the given
+// paths. CloudFront does not create a second invalidation for a batch with
+// a caller reference it has already seen.
+func GenerateInvalidationBatch(callerReference string, paths []string) *svcsdk.InvalidationBatch {
+	return &svcsdk.InvalidationBatch{
+		CallerReference: awsclients.String(callerReference),
+		Paths: &svcsdk.Paths{
+			Items:    aws.StringSlice(paths),
+			Quantity: awsclients.Int64(len(paths)),
+		},
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invalidation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Invalidation resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create Invalidation in AWS"
+	errDescribe      = "failed to get Invalidation"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Invalidation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.CloudFrontAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Invalidation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	// Invalidations cannot be deleted. We let go of them once the
+	// Invalidation is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	resp, err := e.client.GetInvalidationWithContext(ctx, &svcsdk.GetInvalidationInput{
+		DistributionId: cr.Spec.ForProvider.DistributionID,
+		Id:             awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = generateObservation(resp.Invalidation)
+
+	if awsclient.StringValue(resp.Invalidation.Status) == svcapitypes.InvalidationStatusCompleted {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Creating())
+	}
+	// The paths of an invalidation cannot be changed.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Invalidation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateInvalidationWithContext(ctx, &svcsdk.CreateInvalidationInput{
+		DistributionId:    cr.Spec.ForProvider.DistributionID,
+		InvalidationBatch: cloudfront.GenerateInvalidationBatch(string(cr.UID), cr.Spec.ForProvider.Paths),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.Invalidation.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(_ context.Context, _ cpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Invalidation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+func generateObservation(in *svcsdk.Invalidation) svcapitypes.InvalidationObservation {
+	o := svcapitypes.InvalidationObservation{
+		Status: in.Status,
+	}
+	if in.CreateTime != nil {
+		t := metav1.NewTime(*in.CreateTime)
+		o.CreateTime = &t
+	}
+	return o
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeNoSuchInvalidation
+}
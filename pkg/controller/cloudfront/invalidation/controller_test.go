@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invalidation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudfront/cloudfrontiface"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+const (
+	testDistributionID = "E2QWRUHEXAMPLE"
+	testInvalidationID = "I2J0I21PCUYOIK"
+	testUID            = "b6d9d3c5-4d6b-4b6e-9d1f-0c3c5e1f0a11"
+)
+
+type mockClient struct {
+	svcsdkapi.CloudFrontAPI
+
+	invalidation *svcsdk.Invalidation
+	created      *svcsdk.CreateInvalidationInput
+}
+
+func (m *mockClient) GetInvalidationWithContext(_ context.Context, _ *svcsdk.GetInvalidationInput, _ ...request.Option) (*svcsdk.GetInvalidationOutput, error) {
+	return &svcsdk.GetInvalidationOutput{Invalidation: m.invalidation}, nil
+}
+
+func (m *mockClient) CreateInvalidationWithContext(_ context.Context, in *svcsdk.CreateInvalidationInput, _ ...request.Option) (*svcsdk.CreateInvalidationOutput, error) {
+	m.created = in
+	return &svcsdk.CreateInvalidationOutput{Invalidation: &svcsdk.Invalidation{Id: aws.String(testInvalidationID)}}, nil
+}
+
+type invalidationModifier func(*svcapitypes.Invalidation)
+
+func invalidation(m ...invalidationModifier) *svcapitypes.Invalidation {
+	cr := &svcapitypes.Invalidation{}
+	cr.UID = testUID
+	cr.Spec.ForProvider.DistributionID = aws.String(testDistributionID)
+	cr.Spec.ForProvider.Paths = []string{"/index.html", "/images/*"}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withID(id string) invalidationModifier {
+	return func(cr *svcapitypes.Invalidation) { meta.SetExternalName(cr, id) }
+}
+
+func withDeletionTimestamp() invalidationModifier {
+	return func(cr *svcapitypes.Invalidation) {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		obs        managed.ExternalObservation
+		conditions []xpv1.Condition
+	}
+	cases := map[string]struct {
+		cr           *svcapitypes.Invalidation
+		invalidation *svcsdk.Invalidation
+		want         want
+	}{
+		"NotCreated": {
+			cr:   invalidation(),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+		"InProgress": {
+			cr:           invalidation(withID(testInvalidationID)),
+			invalidation: &svcsdk.Invalidation{Status: aws.String(svcapitypes.InvalidationStatusInProgress)},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{xpv1.Creating()},
+			},
+		},
+		"Completed": {
+			cr:           invalidation(withID(testInvalidationID)),
+			invalidation: &svcsdk.Invalidation{Status: aws.String(svcapitypes.InvalidationStatusCompleted)},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"Deleted": {
+			cr:   invalidation(withID(testInvalidationID), withDeletionTimestamp()),
+			want: want{obs: managed.ExternalObservation{ResourceExists: false}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &mockClient{invalidation: tc.invalidation}}
+			obs, err := e.Observe(context.Background(), tc.cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("conditions: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cr := invalidation()
+	m := &mockClient{}
+	e := &external{client: m}
+	cre, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(testInvalidationID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("external name: -want, +got:\n%s", diff)
+	}
+	want := &svcsdk.CreateInvalidationInput{
+		DistributionId: aws.String(testDistributionID),
+		InvalidationBatch: &svcsdk.InvalidationBatch{
+			CallerReference: aws.String(testUID),
+			Paths: &svcsdk.Paths{
+				Items:    aws.StringSlice([]string{"/index.html", "/images/*"}),
+				Quantity: aws.Int64(2),
+			},
+		},
+	}
+	if diff := cmp.Diff(want, m.created); diff != "" {
+		t.Errorf("input: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keygroup
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupKeyGroup adds a controller that reconciles KeyGroup.
+func SetupKeyGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.KeyGroupGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.KeyGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.KeyGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{
+				kube: mgr.GetClient(),
+				opts: []option{
+					func(e *external) {
+						e.preObserve = preObserve
+						e.postObserve = postObserve
+						e.preCreate = preCreate
+						e.postCreate = postCreate
+						e.preUpdate = preUpdate
+						e.isUpToDate = isUpToDate
+						e.preDelete = preDelete
+					},
+				},
+			}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.KeyGroup, gki *svcsdk.GetKeyGroupInput) error {
+	gki.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.KeyGroup, _ *svcsdk.GetKeyGroupOutput,
+	eo managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return eo, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.KeyGroup, cki *svcsdk.CreateKeyGroupInput) error {
+	cki.KeyGroupConfig.Items = aws.StringSlice(cr.Spec.ForProvider.PublicKeyIDs)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.KeyGroup, cko *svcsdk.CreateKeyGroupOutput,
+	ec managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(cko.KeyGroup.Id))
+	return ec, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.KeyGroup, uki *svcsdk.UpdateKeyGroupInput) error {
+	uki.Id = awsclients.String(meta.GetExternalName(cr))
+	uki.SetIfMatch(awsclients.StringValue(cr.Status.AtProvider.ETag))
+	uki.KeyGroupConfig.Items = aws.StringSlice(cr.Spec.ForProvider.PublicKeyIDs)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.KeyGroup, dki *svcsdk.DeleteKeyGroupInput) (bool, error) {
+	dki.Id = awsclients.String(meta.GetExternalName(cr))
+	dki.SetIfMatch(awsclients.StringValue(cr.Status.AtProvider.ETag))
+	return false, nil
+}
+
+func isUpToDate(cr *svcapitypes.KeyGroup, resp *svcsdk.GetKeyGroupOutput) (bool, error) {
+	in := cr.Spec.ForProvider.KeyGroupConfig
+	out := resp.KeyGroup.KeyGroupConfig
+	if awsclients.StringValue(in.Name) != awsclients.StringValue(out.Name) ||
+		awsclients.StringValue(in.Comment) != awsclients.StringValue(out.Comment) {
+		return false, nil
+	}
+	return cmp.Equal(sortedIDs(cr.Spec.ForProvider.PublicKeyIDs), sortedIDs(aws.StringValueSlice(out.Items))), nil
+}
+
+// sortedIDs returns a sorted copy of the supplied IDs since AWS does not
+// preserve the order of the public keys in a key group.
+func sortedIDs(ids []string) []string {
+	s := make([]string, len(ids))
+	copy(s, ids)
+	sort.Strings(s)
+	return s
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keygroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.KeyGroup
+		resp *svcsdk.GetKeyGroupOutput
+	}
+
+	keyGroup := func(comment string, ids ...string) *svcapitypes.KeyGroup {
+		return &svcapitypes.KeyGroup{
+			Spec: svcapitypes.KeyGroupSpec{
+				ForProvider: svcapitypes.KeyGroupParameters{
+					KeyGroupConfig: &svcapitypes.KeyGroupConfig{
+						Name:    aws.String("signers"),
+						Comment: aws.String(comment),
+					},
+					CustomKeyGroupParameters: svcapitypes.CustomKeyGroupParameters{PublicKeyIDs: ids},
+				},
+			},
+		}
+	}
+	output := func(comment string, ids ...string) *svcsdk.GetKeyGroupOutput {
+		return &svcsdk.GetKeyGroupOutput{
+			KeyGroup: &svcsdk.KeyGroup{
+				KeyGroupConfig: &svcsdk.KeyGroupConfig{
+					Name:    aws.String("signers"),
+					Comment: aws.String(comment),
+					Items:   aws.StringSlice(ids),
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				cr:   keyGroup("c", "K1", "K2"),
+				resp: output("c", "K1", "K2"),
+			},
+			want: true,
+		},
+		"DifferentOrder": {
+			args: args{
+				cr:   keyGroup("c", "K2", "K1"),
+				resp: output("c", "K1", "K2"),
+			},
+			want: true,
+		},
+		"PublicKeyAdded": {
+			args: args{
+				cr:   keyGroup("c", "K1", "K2", "K3"),
+				resp: output("c", "K1", "K2"),
+			},
+			want: false,
+		},
+		"CommentChanged": {
+			args: args{
+				cr:   keyGroup("new", "K1"),
+				resp: output("old", "K1"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.args.cr, tc.args.resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
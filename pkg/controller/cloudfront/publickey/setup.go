@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publickey
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetEncodedKeySecret = "cannot get encoded key secret"
+	errEmptyEncodedKey     = "encoded key secret does not contain a public key"
+)
+
+// SetupPublicKey adds a controller that reconciles PublicKey.
+func SetupPublicKey(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.PublicKeyGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.PublicKey{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.PublicKeyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: []option{setupExternal}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func setupExternal(e *external) {
+	h := &hooks{kube: e.kube}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.preCreate = h.preCreate
+	e.postCreate = postCreate
+	e.preUpdate = h.preUpdate
+	e.isUpToDate = isUpToDate
+	e.preDelete = preDelete
+}
+
+type hooks struct {
+	kube client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.PublicKey, gpi *svcsdk.GetPublicKeyInput) error {
+	gpi.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.PublicKey, _ *svcsdk.GetPublicKeyOutput,
+	eo managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return eo, nil
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.PublicKey, cpi *svcsdk.CreatePublicKeyInput) error {
+	key, err := h.getEncodedKey(ctx, cr.Spec.ForProvider.EncodedKeySecretRef)
+	if err != nil {
+		return err
+	}
+	cpi.PublicKeyConfig.CallerReference = awsclients.String(string(cr.UID))
+	cpi.PublicKeyConfig.EncodedKey = awsclients.String(key)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.PublicKey, cpo *svcsdk.CreatePublicKeyOutput,
+	ec managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(cpo.PublicKey.Id))
+	return ec, nil
+}
+
+// NOTE: Only the comment of a public key can be updated, but the update
+// request has to repeat the caller reference and the encoded key.
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.PublicKey, upi *svcsdk.UpdatePublicKeyInput) error {
+	key, err := h.getEncodedKey(ctx, cr.Spec.ForProvider.EncodedKeySecretRef)
+	if err != nil {
+		return err
+	}
+	upi.Id = awsclients.String(meta.GetExternalName(cr))
+	upi.SetIfMatch(awsclients.StringValue(cr.Status.AtProvider.ETag))
+	upi.PublicKeyConfig.CallerReference = awsclients.String(string(cr.UID))
+	upi.PublicKeyConfig.EncodedKey = awsclients.String(key)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.PublicKey, dpi *svcsdk.DeletePublicKeyInput) (bool, error) {
+	dpi.Id = awsclients.String(meta.GetExternalName(cr))
+	dpi.SetIfMatch(awsclients.StringValue(cr.Status.AtProvider.ETag))
+	return false, nil
+}
+
+func isUpToDate(cr *svcapitypes.PublicKey, resp *svcsdk.GetPublicKeyOutput) (bool, error) {
+	return awsclients.StringValue(cr.Spec.ForProvider.PublicKeyConfig.Comment) ==
+		awsclients.StringValue(resp.PublicKey.PublicKeyConfig.Comment), nil
+}
+
+func (h *hooks) getEncodedKey(ctx context.Context, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetEncodedKeySecret)
+	}
+	key := string(s.Data[ref.Key])
+	if key == "" {
+		return "", errors.New(errEmptyEncodedKey)
+	}
+	return key, nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package realtimelogconfig
+
+import (
+	"context"
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// streamTypeKinesis is the only stream type CloudFront supports for
+// real-time logs.
+const streamTypeKinesis = "Kinesis"
+
+// SetupRealtimeLogConfig adds a controller that reconciles RealtimeLogConfig.
+func SetupRealtimeLogConfig(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.RealtimeLogConfigGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.RealtimeLogConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.RealtimeLogConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: []option{setupExternal}}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func setupExternal(e *external) {
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.preCreate = preCreate
+	e.preUpdate = preUpdate
+	e.isUpToDate = isUpToDate
+	e.preDelete = preDelete
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.RealtimeLogConfig, gi *svcsdk.GetRealtimeLogConfigInput) error {
+	gi.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.RealtimeLogConfig, _ *svcsdk.GetRealtimeLogConfigOutput,
+	eo managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return eo, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.RealtimeLogConfig, ci *svcsdk.CreateRealtimeLogConfigInput) error {
+	ci.Name = awsclients.String(meta.GetExternalName(cr))
+	ci.EndPoints = generateEndPoints(cr.Spec.ForProvider.EndPoints)
+	return nil
+}
+
+// NOTE: The update request replaces the whole configuration, so it has to
+// repeat the end points.
+func preUpdate(_ context.Context, cr *svcapitypes.RealtimeLogConfig, ui *svcsdk.UpdateRealtimeLogConfigInput) error {
+	ui.Name = awsclients.String(meta.GetExternalName(cr))
+	ui.EndPoints = generateEndPoints(cr.Spec.ForProvider.EndPoints)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.RealtimeLogConfig, di *svcsdk.DeleteRealtimeLogConfigInput) (bool, error) {
+	di.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func isUpToDate(cr *svcapitypes.RealtimeLogConfig, resp *svcsdk.GetRealtimeLogConfigOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	c := resp.RealtimeLogConfig
+	if c == nil {
+		return true, nil
+	}
+	if awsclients.Int64Value(p.SamplingRate) != awsclients.Int64Value(c.SamplingRate) {
+		return false, nil
+	}
+	if !equalFields(p.Fields, c.Fields) {
+		return false, nil
+	}
+	return equalEndPoints(generateEndPoints(p.EndPoints), c.EndPoints), nil
+}
+
+func generateEndPoints(in []svcapitypes.CustomEndPoint) []*svcsdk.EndPoint {
+	res := make([]*svcsdk.EndPoint, len(in))
+	for i, ep := range in {
+		streamType := streamTypeKinesis
+		if ep.StreamType != nil {
+			streamType = *ep.StreamType
+		}
+		res[i] = &svcsdk.EndPoint{
+			StreamType: awsclients.String(streamType),
+			KinesisStreamConfig: &svcsdk.KinesisStreamConfig{
+				RoleARN:   ep.KinesisStreamConfig.RoleARN,
+				StreamARN: ep.KinesisStreamConfig.StreamARN,
+			},
+		}
+	}
+	return res
+}
+
+// equalFields returns true if both lists contain the same fields, regardless
+// of their order.
+func equalFields(desired, observed []*string) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	d := make([]string, len(desired))
+	for i := range desired {
+		d[i] = awsclients.StringValue(desired[i])
+	}
+	o := make([]string, len(observed))
+	for i := range observed {
+		o[i] = awsclients.StringValue(observed[i])
+	}
+	sort.Strings(d)
+	sort.Strings(o)
+	for i := range d {
+		if d[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalEndPoints(desired, observed []*svcsdk.EndPoint) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	for i := range desired {
+		if awsclients.StringValue(desired[i].StreamType) != awsclients.StringValue(observed[i].StreamType) {
+			return false
+		}
+		d, o := desired[i].KinesisStreamConfig, observed[i].KinesisStreamConfig
+		if o == nil {
+			return false
+		}
+		if awsclients.StringValue(d.RoleARN) != awsclients.StringValue(o.RoleARN) ||
+			awsclients.StringValue(d.StreamARN) != awsclients.StringValue(o.StreamARN) {
+			return false
+		}
+	}
+	return true
+}
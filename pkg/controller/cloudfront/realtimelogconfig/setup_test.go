@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package realtimelogconfig
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+)
+
+const (
+	roleARN   = "arn:aws:iam::123456789012:role/cloudfront-realtime-logs"
+	streamARN = "arn:aws:kinesis:us-east-1:123456789012:stream/edge-logs"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.RealtimeLogConfig
+		resp *svcsdk.GetRealtimeLogConfigOutput
+	}
+
+	config := func(rate int64, stream string, fields ...string) *svcapitypes.RealtimeLogConfig {
+		return &svcapitypes.RealtimeLogConfig{
+			Spec: svcapitypes.RealtimeLogConfigSpec{
+				ForProvider: svcapitypes.RealtimeLogConfigParameters{
+					Fields:       aws.StringSlice(fields),
+					SamplingRate: aws.Int64(rate),
+					CustomRealtimeLogConfigParameters: svcapitypes.CustomRealtimeLogConfigParameters{
+						EndPoints: []svcapitypes.CustomEndPoint{{
+							KinesisStreamConfig: svcapitypes.CustomKinesisStreamConfig{
+								RoleARN:   aws.String(roleARN),
+								StreamARN: aws.String(stream),
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+	output := func(rate int64, stream string, fields ...string) *svcsdk.GetRealtimeLogConfigOutput {
+		return &svcsdk.GetRealtimeLogConfigOutput{
+			RealtimeLogConfig: &svcsdk.RealtimeLogConfig{
+				Fields:       aws.StringSlice(fields),
+				SamplingRate: aws.Int64(rate),
+				EndPoints: []*svcsdk.EndPoint{{
+					StreamType: aws.String(streamTypeKinesis),
+					KinesisStreamConfig: &svcsdk.KinesisStreamConfig{
+						RoleARN:   aws.String(roleARN),
+						StreamARN: aws.String(stream),
+					},
+				}},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				cr:   config(10, streamARN, "timestamp", "c-ip"),
+				resp: output(10, streamARN, "timestamp", "c-ip"),
+			},
+			want: true,
+		},
+		"DifferentFieldOrder": {
+			args: args{
+				cr:   config(10, streamARN, "c-ip", "timestamp"),
+				resp: output(10, streamARN, "timestamp", "c-ip"),
+			},
+			want: true,
+		},
+		"FieldAdded": {
+			args: args{
+				cr:   config(10, streamARN, "timestamp", "c-ip", "sc-status"),
+				resp: output(10, streamARN, "timestamp", "c-ip"),
+			},
+			want: false,
+		},
+		"SamplingRateChanged": {
+			args: args{
+				cr:   config(50, streamARN, "timestamp"),
+				resp: output(10, streamARN, "timestamp"),
+			},
+			want: false,
+		},
+		"StreamChanged": {
+			args: args{
+				cr:   config(10, streamARN+"-new", "timestamp"),
+				resp: output(10, streamARN, "timestamp"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.args.cr, tc.args.resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -42,6 +42,11 @@ func GenerateLogGroup(resp *svcsdk.DescribeLogGroupsOutput) *svcapitypes.LogGrou
 
 	found := false
 	for _, elem := range resp.LogGroups {
+		if elem.Arn != nil {
+			cr.Status.AtProvider.ARN = elem.Arn
+		} else {
+			cr.Status.AtProvider.ARN = nil
+		}
 		if elem.LogGroupName != nil {
 			cr.Spec.ForProvider.LogGroupName = elem.LogGroupName
 		} else {
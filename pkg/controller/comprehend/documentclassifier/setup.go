@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package documentclassifier
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/comprehend"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/comprehend/comprehendiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/comprehend/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errStopTraining = "cannot stop training of DocumentClassifier in AWS"
+)
+
+// SetupDocumentClassifier adds a controller that reconciles
+// DocumentClassifier.
+func SetupDocumentClassifier(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DocumentClassifierGroupKind)
+	opts := []option{setupHooks}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.DocumentClassifier{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DocumentClassifierGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func setupHooks(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.preCreate = preCreate
+	e.postCreate = postCreate
+	e.preDelete = h.preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.ComprehendAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.DocumentClassifier, obj *svcsdk.DescribeDocumentClassifierInput) error {
+	obj.DocumentClassifierArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.DocumentClassifier, resp *svcsdk.DescribeDocumentClassifierOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.DocumentClassifierProperties.Status) {
+	case svcsdk.ModelStatusTrained:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ModelStatusSubmitted, svcsdk.ModelStatusTraining:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ModelStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.ModelStatusStopRequested, svcsdk.ModelStatusStopped, svcsdk.ModelStatusInError:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.DocumentClassifier, obj *svcsdk.CreateDocumentClassifierInput) error {
+	obj.DataAccessRoleArn = cr.Spec.ForProvider.DataAccessRoleARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.DocumentClassifier, resp *svcsdk.CreateDocumentClassifierOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.DocumentClassifierArn))
+	return cre, nil
+}
+
+// A classifier can not be deleted while it is being trained, so the training
+// is stopped first and the deletion is retried on the next reconcile.
+func (h *hooks) preDelete(ctx context.Context, cr *svcapitypes.DocumentClassifier, obj *svcsdk.DeleteDocumentClassifierInput) (bool, error) {
+	obj.DocumentClassifierArn = awsclients.String(meta.GetExternalName(cr))
+	switch awsclients.StringValue(cr.Status.AtProvider.Status) {
+	case svcsdk.ModelStatusSubmitted, svcsdk.ModelStatusTraining:
+		_, err := h.client.StopTrainingDocumentClassifierWithContext(ctx, &svcsdk.StopTrainingDocumentClassifierInput{
+			DocumentClassifierArn: obj.DocumentClassifierArn,
+		})
+		return true, awsclients.Wrap(err, errStopTraining)
+	case svcsdk.ModelStatusStopRequested, svcsdk.ModelStatusDeleting:
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoint
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/comprehend"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/comprehend/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupEndpoint adds a controller that reconciles Endpoint.
+func SetupEndpoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.EndpointGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Endpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.EndpointGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Endpoint, obj *svcsdk.DescribeEndpointInput) error {
+	obj.EndpointArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Endpoint, resp *svcsdk.DescribeEndpointOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.EndpointProperties.Status) {
+	case svcsdk.EndpointStatusInService:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.EndpointStatusCreating, svcsdk.EndpointStatusUpdating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.EndpointStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.EndpointStatusFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+// isUpToDate compares the desired state of the endpoint, because the model
+// and the inference units only change once an update has finished.
+func isUpToDate(cr *svcapitypes.Endpoint, resp *svcsdk.DescribeEndpointOutput) (bool, error) {
+	props := resp.EndpointProperties
+	if awsclients.StringValue(props.Status) != svcsdk.EndpointStatusInService {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	switch {
+	case awsclients.Int64Value(p.DesiredInferenceUnits) != awsclients.Int64Value(props.DesiredInferenceUnits),
+		awsclients.StringValue(p.ModelARN) != awsclients.StringValue(props.DesiredModelArn),
+		awsclients.StringValue(p.DataAccessRoleARN) != awsclients.StringValue(props.DesiredDataAccessRoleArn):
+		return false, nil
+	}
+	return true, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Endpoint, obj *svcsdk.CreateEndpointInput) error {
+	obj.ModelArn = cr.Spec.ForProvider.ModelARN
+	obj.DataAccessRoleArn = cr.Spec.ForProvider.DataAccessRoleARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Endpoint, resp *svcsdk.CreateEndpointOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.EndpointArn))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Endpoint, obj *svcsdk.UpdateEndpointInput) error {
+	obj.EndpointArn = awsclients.String(meta.GetExternalName(cr))
+	obj.DesiredModelArn = cr.Spec.ForProvider.ModelARN
+	obj.DesiredDataAccessRoleArn = cr.Spec.ForProvider.DataAccessRoleARN
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Endpoint, obj *svcsdk.DeleteEndpointInput) (bool, error) {
+	obj.EndpointArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
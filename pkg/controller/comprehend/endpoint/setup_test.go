@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoint
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/comprehend"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/comprehend/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	modelARN = "arn:aws:comprehend:us-east-1:123456789012:document-classifier/example"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	endpoint := func(units int, model string) *svcapitypes.Endpoint {
+		cr := &svcapitypes.Endpoint{}
+		cr.Spec.ForProvider.DesiredInferenceUnits = awsclients.Int64(units)
+		cr.Spec.ForProvider.ModelARN = awsclients.String(model)
+		return cr
+	}
+	observed := func(status string) *svcsdk.DescribeEndpointOutput {
+		return &svcsdk.DescribeEndpointOutput{EndpointProperties: &svcsdk.EndpointProperties{
+			Status:                awsclients.String(status),
+			DesiredInferenceUnits: awsclients.Int64(1),
+			DesiredModelArn:       awsclients.String(modelARN),
+		}}
+	}
+
+	cases := map[string]struct {
+		cr   *svcapitypes.Endpoint
+		resp *svcsdk.DescribeEndpointOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr:   endpoint(1, modelARN),
+			resp: observed(svcsdk.EndpointStatusInService),
+			want: true,
+		},
+		"InferenceUnitsChanged": {
+			cr:   endpoint(2, modelARN),
+			resp: observed(svcsdk.EndpointStatusInService),
+			want: false,
+		},
+		"ModelChanged": {
+			cr:   endpoint(1, modelARN+"-v2"),
+			resp: observed(svcsdk.EndpointStatusInService),
+			want: false,
+		},
+		"Updating": {
+			cr:   endpoint(2, modelARN),
+			resp: observed(svcsdk.EndpointStatusUpdating),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, tc.resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("isUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
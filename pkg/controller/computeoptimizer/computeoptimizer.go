@@ -0,0 +1,443 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package computeoptimizer surfaces AWS Compute Optimizer rightsizing
+// recommendations as conditions of the managed resources they concern.
+package computeoptimizer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/computeoptimizer"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/computeoptimizer/computeoptimizeriface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8serrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TypeRightsizing resources have a Compute Optimizer recommendation to
+	// change their size.
+	TypeRightsizing xpv1.ConditionType = "Rightsizing"
+
+	// ReasonOptimized resources are no longer recommended to be resized.
+	ReasonOptimized xpv1.ConditionReason = "Optimized"
+
+	// Services whose resources Compute Optimizer has recommendations for.
+	// They are the prefixes of the API groups of the managed resources.
+	serviceEC2         = "ec2"
+	serviceAutoScaling = "autoscaling"
+	serviceLambda      = "lambda"
+
+	groupSuffix = "aws.crossplane.io"
+
+	findingOptimized = "Optimized"
+
+	errGetProviderConfig = "cannot get ProviderConfig"
+	errCreateSession     = "cannot create a new session"
+	errGetRecommendation = "cannot get Compute Optimizer recommendations"
+	errListManaged       = "cannot list managed resources"
+	errPatchStatus       = "cannot patch status of managed resource"
+)
+
+// A Recommendation is the Compute Optimizer finding for a resource and the
+// top ranked configuration it recommends instead of the current one.
+type Recommendation struct {
+	Finding     string
+	Current     string
+	Recommended string
+}
+
+// Setup adds a runnable to the manager that fetches the Compute Optimizer
+// recommendations for the managed resources at the given interval.
+func Setup(mgr ctrl.Manager, l logging.Logger, interval time.Duration) error {
+	p := &poller{
+		kube:     mgr.GetClient(),
+		scheme:   mgr.GetScheme(),
+		log:      l.WithValues("subsystem", "computeoptimizer"),
+		interval: interval,
+		newClient: func(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig, region string) (svcsdkapi.ComputeOptimizerAPI, error) {
+			sess, err := awsclient.UseProviderConfigV1(ctx, kube, pc, region)
+			if err != nil {
+				return nil, errors.Wrap(err, errCreateSession)
+			}
+			return svcsdk.New(sess), nil
+		},
+	}
+	return mgr.Add(manager.RunnableFunc(p.Start))
+}
+
+type poller struct {
+	kube      client.Client
+	scheme    *runtime.Scheme
+	log       logging.Logger
+	interval  time.Duration
+	newClient func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (svcsdkapi.ComputeOptimizerAPI, error)
+}
+
+// A target is an account and region whose recommendations are fetched.
+type target struct {
+	providerConfig string
+	region         string
+}
+
+// A managedResource is a managed resource that Compute Optimizer may have a
+// recommendation for.
+type managedResource struct {
+	service string
+	mg      resource.Managed
+}
+
+// Start polls until the context is done. Errors are logged, polling goes on.
+func (p *poller) Start(ctx context.Context) error {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	for {
+		if err := p.poll(ctx); err != nil {
+			p.log.Info("Cannot surface Compute Optimizer recommendations", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+func (p *poller) poll(ctx context.Context) error {
+	targets, err := p.list(ctx)
+	if err != nil {
+		return err
+	}
+	// Resources that cannot be patched do not stop the others.
+	var errs []error
+	for t, mrs := range targets {
+		services := map[string]bool{}
+		for _, mr := range mrs {
+			services[mr.service] = true
+		}
+		recs, err := p.recommendations(ctx, t, services)
+		// Accounts that did not opt in to Compute Optimizer cannot get
+		// recommendations. They must not stop the others.
+		if err != nil {
+			p.log.Debug("Cannot get Compute Optimizer recommendations", "providerConfig", t.providerConfig, "region", t.region, "error", err)
+			continue
+		}
+		for _, mr := range mrs {
+			c, ok := Condition(mr.mg, Match(mr.service, mr.mg, recs))
+			if !ok {
+				continue
+			}
+			orig := mr.mg.DeepCopyObject().(client.Object)
+			mr.mg.SetConditions(c)
+			if err := p.kube.Status().Patch(ctx, mr.mg, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+				p.log.Debug("Cannot set Rightsizing condition", "name", mr.mg.GetName(), "error", err)
+				errs = append(errs, errors.Wrap(err, errPatchStatus))
+			}
+		}
+	}
+	return k8serrors.NewAggregate(errs)
+}
+
+func (p *poller) recommendations(ctx context.Context, t target, services map[string]bool) (map[string]Recommendation, error) {
+	pc := &v1beta1.ProviderConfig{}
+	if err := p.kube.Get(ctx, types.NamespacedName{Name: t.providerConfig}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	co, err := p.newClient(ctx, p.kube, pc, t.region)
+	if err != nil {
+		return nil, err
+	}
+	recs := map[string]Recommendation{}
+	return recs, Recommendations(ctx, co, services, recs)
+}
+
+// list returns the managed resources of the services Compute Optimizer has
+// recommendations for, keyed by the account and region they are in.
+func (p *poller) list(ctx context.Context) (map[target][]managedResource, error) {
+	targets := map[target][]managedResource{}
+	for gvk := range p.scheme.AllKnownTypes() {
+		service := strings.SplitN(gvk.Group, ".", 2)[0]
+		if !strings.HasSuffix(gvk.Group, groupSuffix) || !strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		if service != serviceEC2 && service != serviceAutoScaling && service != serviceLambda {
+			continue
+		}
+		obj, err := p.scheme.New(gvk)
+		if err != nil {
+			continue
+		}
+		l, ok := obj.(resource.ManagedList)
+		if !ok {
+			continue
+		}
+		if err := p.kube.List(ctx, l); err != nil {
+			return nil, errors.Wrap(err, errListManaged)
+		}
+		for _, mg := range l.GetItems() {
+			ref := mg.GetProviderConfigReference()
+			region := region(mg)
+			if ref == nil || region == "" {
+				continue
+			}
+			t := target{providerConfig: ref.Name, region: region}
+			targets[t] = append(targets[t], managedResource{service: service, mg: mg})
+		}
+	}
+	return targets, nil
+}
+
+// region returns the region in the parameters of the managed resource.
+func region(mg resource.Managed) string {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ""
+	}
+	r, _, _ := unstructured.NestedString(u, "spec", "forProvider", "region")
+	return r
+}
+
+// Recommendations adds the Compute Optimizer recommendations for the
+// resources of the given services to the given map. They are keyed by the
+// service and the ARN, name or ID of the resource, e.g. ec2/i-0123456789.
+func Recommendations(ctx context.Context, co svcsdkapi.ComputeOptimizerAPI, services map[string]bool, recs map[string]Recommendation) error { // nolint:gocyclo
+	if services[serviceEC2] {
+		in := &svcsdk.GetEC2InstanceRecommendationsInput{}
+		for {
+			out, err := co.GetEC2InstanceRecommendationsWithContext(ctx, in)
+			if err != nil {
+				return awsclient.Wrap(err, errGetRecommendation)
+			}
+			for _, r := range out.InstanceRecommendations {
+				rec := Recommendation{
+					Finding: awsclient.StringValue(r.Finding),
+					Current: awsclient.StringValue(r.CurrentInstanceType),
+				}
+				var rank *int64
+				for _, o := range r.RecommendationOptions {
+					if better(o.Rank, rank) {
+						rank = o.Rank
+						rec.Recommended = awsclient.StringValue(o.InstanceType)
+					}
+				}
+				add(recs, serviceEC2, awsclient.StringValue(r.InstanceArn), rec)
+			}
+			if in.NextToken = out.NextToken; in.NextToken == nil {
+				break
+			}
+		}
+		vin := &svcsdk.GetEBSVolumeRecommendationsInput{}
+		for {
+			out, err := co.GetEBSVolumeRecommendationsWithContext(ctx, vin)
+			if err != nil {
+				return awsclient.Wrap(err, errGetRecommendation)
+			}
+			for _, r := range out.VolumeRecommendations {
+				rec := Recommendation{
+					Finding: awsclient.StringValue(r.Finding),
+					Current: volume(r.CurrentConfiguration),
+				}
+				var rank *int64
+				for _, o := range r.VolumeRecommendationOptions {
+					if better(o.Rank, rank) {
+						rank = o.Rank
+						rec.Recommended = volume(o.Configuration)
+					}
+				}
+				add(recs, serviceEC2, awsclient.StringValue(r.VolumeArn), rec)
+			}
+			if vin.NextToken = out.NextToken; vin.NextToken == nil {
+				break
+			}
+		}
+	}
+	if services[serviceAutoScaling] {
+		in := &svcsdk.GetAutoScalingGroupRecommendationsInput{}
+		for {
+			out, err := co.GetAutoScalingGroupRecommendationsWithContext(ctx, in)
+			if err != nil {
+				return awsclient.Wrap(err, errGetRecommendation)
+			}
+			for _, r := range out.AutoScalingGroupRecommendations {
+				rec := Recommendation{Finding: awsclient.StringValue(r.Finding)}
+				if r.CurrentConfiguration != nil {
+					rec.Current = awsclient.StringValue(r.CurrentConfiguration.InstanceType)
+				}
+				var rank *int64
+				for _, o := range r.RecommendationOptions {
+					if better(o.Rank, rank) && o.Configuration != nil {
+						rank = o.Rank
+						rec.Recommended = awsclient.StringValue(o.Configuration.InstanceType)
+					}
+				}
+				add(recs, serviceAutoScaling, awsclient.StringValue(r.AutoScalingGroupArn), rec)
+			}
+			if in.NextToken = out.NextToken; in.NextToken == nil {
+				break
+			}
+		}
+	}
+	if services[serviceLambda] {
+		in := &svcsdk.GetLambdaFunctionRecommendationsInput{}
+		for {
+			out, err := co.GetLambdaFunctionRecommendationsWithContext(ctx, in)
+			if err != nil {
+				return awsclient.Wrap(err, errGetRecommendation)
+			}
+			for _, r := range out.LambdaFunctionRecommendations {
+				rec := Recommendation{
+					Finding: awsclient.StringValue(r.Finding),
+					Current: memory(r.CurrentMemorySize),
+				}
+				var rank *int64
+				for _, o := range r.MemorySizeRecommendationOptions {
+					if better(o.Rank, rank) {
+						rank = o.Rank
+						rec.Recommended = memory(o.MemorySize)
+					}
+				}
+				// Recommendations are made for a version of the function.
+				arn := strings.TrimSuffix(awsclient.StringValue(r.FunctionArn), ":"+awsclient.StringValue(r.FunctionVersion))
+				add(recs, serviceLambda, arn, rec)
+			}
+			if in.NextToken = out.NextToken; in.NextToken == nil {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// better returns true if rank a is set and ranks higher than rank b.
+func better(a, b *int64) bool {
+	return a != nil && (b == nil || *a < *b)
+}
+
+// add adds the recommendation keyed by the ARN and by the name or ID it
+// ends with, i.e. the part after the last slash or, if there is none, after
+// the last colon.
+func add(recs map[string]Recommendation, service, arn string, rec Recommendation) {
+	if arn == "" {
+		return
+	}
+	recs[service+"/"+arn] = rec
+	id := arn
+	if i := strings.LastIndex(arn, "/"); i >= 0 {
+		id = arn[i+1:]
+	} else if i := strings.LastIndex(arn, ":"); i >= 0 {
+		id = arn[i+1:]
+	}
+	recs[service+"/"+id] = rec
+}
+
+func volume(c *svcsdk.VolumeConfiguration) string {
+	if c == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %dGiB", awsclient.StringValue(c.VolumeType), awsclient.Int64Value(c.VolumeSize))
+}
+
+func memory(size *int64) string {
+	if size == nil {
+		return ""
+	}
+	return fmt.Sprintf("%dMB memory", *size)
+}
+
+// Match returns the recommendation for the given managed resource of the
+// given service, if any. A resource is matched by its external name or by
+// any ARN in its observed state.
+func Match(service string, mg resource.Managed, recs map[string]Recommendation) *Recommendation {
+	for _, id := range identifiers(mg) {
+		if r, ok := recs[service+"/"+id]; ok {
+			return &r
+		}
+	}
+	return nil
+}
+
+// identifiers returns the external name of the managed resource and the
+// string values of the top-level fields of its observed state whose name
+// ends with ARN, sorted.
+func identifiers(mg resource.Managed) []string {
+	ids := []string{}
+	if en := meta.GetExternalName(mg); en != "" {
+		ids = append(ids, en)
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ids
+	}
+	atProvider, _, _ := unstructured.NestedMap(u, "status", "atProvider")
+	arns := []string{}
+	for k, v := range atProvider {
+		if s, ok := v.(string); ok && s != "" && strings.HasSuffix(strings.ToLower(k), "arn") {
+			arns = append(arns, s)
+		}
+	}
+	sort.Strings(arns)
+	return append(ids, arns...)
+}
+
+// Condition returns the Rightsizing condition of a managed resource with the
+// given recommendation. It returns false if the condition does not have to be
+// changed: resources that were never recommended to be resized do not get
+// one.
+func Condition(mg resource.Managed, rec *Recommendation) (xpv1.Condition, bool) {
+	current := mg.GetCondition(TypeRightsizing)
+	if rec == nil || rec.Finding == findingOptimized || rec.Recommended == "" {
+		if current.Status != corev1.ConditionTrue {
+			return xpv1.Condition{}, false
+		}
+		return xpv1.Condition{
+			Type:               TypeRightsizing,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonOptimized,
+		}, true
+	}
+	c := xpv1.Condition{
+		Type:               TypeRightsizing,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             xpv1.ConditionReason(rec.Finding),
+		Message:            fmt.Sprintf("Compute Optimizer recommends %s instead of %s", rec.Recommended, rec.Current),
+	}
+	if current.Equal(c) {
+		return xpv1.Condition{}, false
+	}
+	return c, true
+}
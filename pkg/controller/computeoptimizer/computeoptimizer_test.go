@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package computeoptimizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/computeoptimizer"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/computeoptimizer/computeoptimizeriface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8serrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	testVolumeARN   = "arn:aws:ec2:us-east-1:123456789012:volume/vol-0123456789"
+	testVolumeID    = "vol-0123456789"
+	testFunctionARN = "arn:aws:lambda:us-east-1:123456789012:function:example"
+)
+
+var (
+	testVolumeRecommendation = Recommendation{
+		Finding:     "NotOptimized",
+		Current:     "gp2 100GiB",
+		Recommended: "gp3 100GiB",
+	}
+	testFunctionRecommendation = Recommendation{
+		Finding:     "NotOptimized",
+		Current:     "1024MB memory",
+		Recommended: "512MB memory",
+	}
+)
+
+type mockClient struct {
+	svcsdkapi.ComputeOptimizerAPI
+}
+
+func (m *mockClient) GetEC2InstanceRecommendationsWithContext(_ context.Context, _ *svcsdk.GetEC2InstanceRecommendationsInput, _ ...request.Option) (*svcsdk.GetEC2InstanceRecommendationsOutput, error) {
+	return &svcsdk.GetEC2InstanceRecommendationsOutput{}, nil
+}
+
+func (m *mockClient) GetEBSVolumeRecommendationsWithContext(_ context.Context, in *svcsdk.GetEBSVolumeRecommendationsInput, _ ...request.Option) (*svcsdk.GetEBSVolumeRecommendationsOutput, error) {
+	// The recommendation is on the second page.
+	if in.NextToken == nil {
+		return &svcsdk.GetEBSVolumeRecommendationsOutput{NextToken: aws.String("next")}, nil
+	}
+	return &svcsdk.GetEBSVolumeRecommendationsOutput{VolumeRecommendations: []*svcsdk.VolumeRecommendation{{
+		VolumeArn:            aws.String(testVolumeARN),
+		Finding:              aws.String("NotOptimized"),
+		CurrentConfiguration: &svcsdk.VolumeConfiguration{VolumeType: aws.String("gp2"), VolumeSize: aws.Int64(100)},
+		VolumeRecommendationOptions: []*svcsdk.VolumeRecommendationOption{
+			{Rank: aws.Int64(2), Configuration: &svcsdk.VolumeConfiguration{VolumeType: aws.String("io1"), VolumeSize: aws.Int64(100)}},
+			{Rank: aws.Int64(1), Configuration: &svcsdk.VolumeConfiguration{VolumeType: aws.String("gp3"), VolumeSize: aws.Int64(100)}},
+		},
+	}}}, nil
+}
+
+func (m *mockClient) GetLambdaFunctionRecommendationsWithContext(_ context.Context, _ *svcsdk.GetLambdaFunctionRecommendationsInput, _ ...request.Option) (*svcsdk.GetLambdaFunctionRecommendationsOutput, error) {
+	return &svcsdk.GetLambdaFunctionRecommendationsOutput{LambdaFunctionRecommendations: []*svcsdk.LambdaFunctionRecommendation{{
+		FunctionArn:       aws.String(testFunctionARN + ":$LATEST"),
+		FunctionVersion:   aws.String("$LATEST"),
+		Finding:           aws.String("NotOptimized"),
+		CurrentMemorySize: aws.Int64(1024),
+		MemorySizeRecommendationOptions: []*svcsdk.LambdaFunctionMemoryRecommendationOption{
+			{Rank: aws.Int64(1), MemorySize: aws.Int64(512)},
+		},
+	}}}, nil
+}
+
+func TestRecommendations(t *testing.T) {
+	cases := map[string]struct {
+		services map[string]bool
+		want     map[string]Recommendation
+	}{
+		"EC2": {
+			services: map[string]bool{serviceEC2: true},
+			want: map[string]Recommendation{
+				"ec2/" + testVolumeARN: testVolumeRecommendation,
+				"ec2/" + testVolumeID:  testVolumeRecommendation,
+			},
+		},
+		"Lambda": {
+			services: map[string]bool{serviceLambda: true},
+			want: map[string]Recommendation{
+				"lambda/" + testFunctionARN: testFunctionRecommendation,
+				"lambda/example":            testFunctionRecommendation,
+			},
+		},
+		"NoServices": {
+			want: map[string]Recommendation{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := map[string]Recommendation{}
+			if err := Recommendations(context.Background(), &mockClient{}, tc.services, got); err != nil {
+				t.Fatalf("Recommendations(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	recs := map[string]Recommendation{
+		"ec2/" + testVolumeID:       testVolumeRecommendation,
+		"lambda/" + testFunctionARN: testFunctionRecommendation,
+	}
+	cases := map[string]struct {
+		service string
+		mg      func() resource.Managed
+		want    *Recommendation
+	}{
+		"MatchedByExternalName": {
+			service: serviceEC2,
+			mg: func() resource.Managed {
+				v := &ec2v1alpha1.Volume{}
+				meta.SetExternalName(v, testVolumeID)
+				return v
+			},
+			want: &testVolumeRecommendation,
+		},
+		"MatchedByObservedARN": {
+			service: serviceLambda,
+			mg: func() resource.Managed {
+				f := &lambdav1beta1.Function{}
+				meta.SetExternalName(f, "example")
+				f.Status.AtProvider.FunctionARN = aws.String(testFunctionARN)
+				return f
+			},
+			want: &testFunctionRecommendation,
+		},
+		"OtherService": {
+			service: serviceLambda,
+			mg: func() resource.Managed {
+				v := &ec2v1alpha1.Volume{}
+				meta.SetExternalName(v, testVolumeID)
+				return v
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Match(tc.service, tc.mg(), recs)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	type want struct {
+		c  xpv1.Condition
+		ok bool
+	}
+	rightsizingCondition := xpv1.Condition{
+		Type:    TypeRightsizing,
+		Status:  corev1.ConditionTrue,
+		Reason:  "NotOptimized",
+		Message: "Compute Optimizer recommends gp3 100GiB instead of gp2 100GiB",
+	}
+	cases := map[string]struct {
+		current []xpv1.Condition
+		rec     *Recommendation
+		want    want
+	}{
+		"NeverRecommended": {
+			want: want{},
+		},
+		"Optimized": {
+			rec:  &Recommendation{Finding: findingOptimized, Current: "gp3 100GiB"},
+			want: want{},
+		},
+		"Recommended": {
+			rec:  &testVolumeRecommendation,
+			want: want{c: rightsizingCondition, ok: true},
+		},
+		"StillRecommended": {
+			current: []xpv1.Condition{rightsizingCondition},
+			rec:     &testVolumeRecommendation,
+			want:    want{},
+		},
+		"NoLongerRecommended": {
+			current: []xpv1.Condition{rightsizingCondition},
+			rec:     &Recommendation{Finding: findingOptimized, Current: "gp3 100GiB"},
+			want: want{
+				c:  xpv1.Condition{Type: TypeRightsizing, Status: corev1.ConditionFalse, Reason: ReasonOptimized},
+				ok: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := &ec2v1alpha1.Volume{}
+			v.SetConditions(tc.current...)
+			c, ok := Condition(v, tc.rec)
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("ok: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, c, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPoll(t *testing.T) {
+	errBoom := errors.New("boom")
+	function := func(name string) lambdav1beta1.Function {
+		f := lambdav1beta1.Function{}
+		f.SetName(name)
+		f.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+		f.Spec.ForProvider.Region = "us-east-1"
+		meta.SetExternalName(&f, "example")
+		return f
+	}
+	type want struct {
+		patched []string
+		err     error
+	}
+	cases := map[string]struct {
+		patchErr error
+		want     want
+	}{
+		"Patched": {
+			want: want{patched: []string{"a", "b"}},
+		},
+		"ContinuePastPatchErrors": {
+			patchErr: errBoom,
+			want: want{
+				patched: []string{"a", "b"},
+				err:     k8serrors.NewAggregate([]error{errors.Wrap(errBoom, errPatchStatus), errors.Wrap(errBoom, errPatchStatus)}),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := lambdav1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			var patched []string
+			kube := &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+				MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+					if l, ok := obj.(*lambdav1beta1.FunctionList); ok {
+						l.Items = []lambdav1beta1.Function{function("a"), function("b")}
+					}
+					return nil
+				},
+				MockStatusPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					patched = append(patched, obj.GetName())
+					return tc.patchErr
+				},
+			}
+			p := &poller{
+				kube:   kube,
+				scheme: s,
+				log:    logging.NewNopLogger(),
+				newClient: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig, _ string) (svcsdkapi.ComputeOptimizerAPI, error) {
+					return &mockClient{}, nil
+				},
+			}
+			err := p.poll(context.Background())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("poll(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("poll(...): -want patched, +got patched:\n%s", diff)
+			}
+		})
+	}
+}
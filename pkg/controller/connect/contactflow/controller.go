@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contactflow
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/connect"
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/connect/connectiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ContactFlow resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create ContactFlow in AWS"
+	errDescribe      = "failed to describe ContactFlow"
+	errUpToDate      = "cannot check whether ContactFlow is up to date"
+	errUpdateName    = "cannot update ContactFlow name in AWS"
+	errUpdateContent = "cannot update ContactFlow content in AWS"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.ContactFlow)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.ConnectAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.ContactFlow)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// Amazon Connect does not provide a way to delete contact flows, so a
+	// deleted ContactFlow is orphaned and reported as gone.
+	if meta.GetExternalName(cr) == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	resp, err := e.client.DescribeContactFlowWithContext(ctx, &svcsdk.DescribeContactFlowInput{
+		InstanceId:    cr.Spec.ForProvider.InstanceID,
+		ContactFlowId: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+	}
+	cf := resp.ContactFlow
+	cr.Status.AtProvider.ARN = cf.Arn
+	cr.Status.AtProvider.ID = cf.Id
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := IsUpToDate(cr.Spec.ForProvider, cf)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.ContactFlow)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	resp, err := e.client.CreateContactFlowWithContext(ctx, &svcsdk.CreateContactFlowInput{
+		InstanceId:  p.InstanceID,
+		Name:        p.Name,
+		Type:        p.Type,
+		Description: p.Description,
+		Content:     p.Content,
+		Tags:        p.Tags,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.ContactFlowId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.ContactFlow)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+	// Both calls are idempotent, so we do not need to find out which of
+	// them is required.
+	if _, err := e.client.UpdateContactFlowNameWithContext(ctx, &svcsdk.UpdateContactFlowNameInput{
+		InstanceId:    p.InstanceID,
+		ContactFlowId: awsclient.String(meta.GetExternalName(cr)),
+		Name:          p.Name,
+		Description:   p.Description,
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateName)
+	}
+	_, err := e.client.UpdateContactFlowContentWithContext(ctx, &svcsdk.UpdateContactFlowContentInput{
+		InstanceId:    p.InstanceID,
+		ContactFlowId: awsclient.String(meta.GetExternalName(cr)),
+		Content:       p.Content,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateContent)
+}
+
+func (e *external) Delete(_ context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.ContactFlow)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	// Amazon Connect does not provide a way to delete contact flows.
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == "ResourceNotFoundException"
+}
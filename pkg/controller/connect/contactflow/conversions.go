@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contactflow
+
+import (
+	"encoding/json"
+	"reflect"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnmarshalContent = "cannot unmarshal contact flow content"
+)
+
+// IsUpToDate checks whether the observed contact flow matches the desired
+// parameters. The content is compared semantically.
+func IsUpToDate(p svcapitypes.ContactFlowParameters, cf *svcsdk.ContactFlow) (bool, error) {
+	if awsclient.StringValue(p.Name) != awsclient.StringValue(cf.Name) ||
+		(p.Description != nil && awsclient.StringValue(p.Description) != awsclient.StringValue(cf.Description)) {
+		return false, nil
+	}
+	return IsContentEqual(awsclient.StringValue(p.Content), awsclient.StringValue(cf.Content))
+}
+
+// IsContentEqual returns whether the two given contact flow documents are
+// semantically equal, ignoring formatting and key order.
+func IsContentEqual(desired, observed string) (bool, error) {
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return false, errors.Wrap(err, errUnmarshalContent)
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false, errors.Wrap(err, errUnmarshalContent)
+	}
+	return reflect.DeepEqual(d, o), nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contactflow
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p  svcapitypes.ContactFlowParameters
+		cf *svcsdk.ContactFlow
+	}
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"SameContentDifferentFormatting": {
+			args: args{
+				p: svcapitypes.ContactFlowParameters{
+					Name:    awsclients.String("flow"),
+					Content: awsclients.String(`{"Version": "2019-10-30", "StartAction": "a", "Actions": []}`),
+				},
+				cf: &svcsdk.ContactFlow{
+					Name:        awsclients.String("flow"),
+					Description: awsclients.String("set by AWS"),
+					Content:     awsclients.String(`{"Actions":[],"StartAction":"a","Version":"2019-10-30"}`),
+				},
+			},
+			want: want{upToDate: true},
+		},
+		"DifferentContent": {
+			args: args{
+				p: svcapitypes.ContactFlowParameters{
+					Name:    awsclients.String("flow"),
+					Content: awsclients.String(`{"StartAction": "a"}`),
+				},
+				cf: &svcsdk.ContactFlow{
+					Name:    awsclients.String("flow"),
+					Content: awsclients.String(`{"StartAction": "b"}`),
+				},
+			},
+			want: want{upToDate: false},
+		},
+		"DifferentName": {
+			args: args{
+				p: svcapitypes.ContactFlowParameters{
+					Name:    awsclients.String("flow"),
+					Content: awsclients.String(`{}`),
+				},
+				cf: &svcsdk.ContactFlow{
+					Name:    awsclients.String("other"),
+					Content: awsclients.String(`{}`),
+				},
+			},
+			want: want{upToDate: false},
+		},
+		"InvalidContent": {
+			args: args{
+				p: svcapitypes.ContactFlowParameters{
+					Name:    awsclients.String("flow"),
+					Content: awsclients.String(`{`),
+				},
+				cf: &svcsdk.ContactFlow{
+					Name:    awsclients.String("flow"),
+					Content: awsclients.String(`{}`),
+				},
+			},
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.args.p, tc.args.cf)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("IsUpToDate(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hoursofoperation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupHoursOfOperation adds a controller that reconciles HoursOfOperation.
+func SetupHoursOfOperation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.HoursOfOperationGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.HoursOfOperation{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.HoursOfOperationGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.HoursOfOperation, obj *svcsdk.DescribeHoursOfOperationInput) error {
+	obj.InstanceId = cr.Spec.ForProvider.InstanceID
+	obj.HoursOfOperationId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.HoursOfOperation, resp *svcsdk.DescribeHoursOfOperationOutput) (bool, error) {
+	p := cr.Spec.ForProvider.DeepCopy()
+	observed := GenerateHoursOfOperation(resp).Spec.ForProvider
+	observed.Region = p.Region
+	observed.Tags = p.Tags
+	observed.InstanceID = p.InstanceID
+	// AWS does not preserve the order of the configured time ranges.
+	sortConfig(p.Config)
+	sortConfig(observed.Config)
+	return awsclients.IsJSONSubset(p, observed)
+}
+
+func sortConfig(config []*svcapitypes.HoursOfOperationConfig) {
+	key := func(c *svcapitypes.HoursOfOperationConfig) string {
+		var h, m int64
+		if c.StartTime != nil {
+			h, m = awsclients.Int64Value(c.StartTime.Hours), awsclients.Int64Value(c.StartTime.Minutes)
+		}
+		return fmt.Sprintf("%s/%02d:%02d", awsclients.StringValue(c.Day), h, m)
+	}
+	sort.SliceStable(config, func(i, j int) bool {
+		return key(config[i]) < key(config[j])
+	})
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.HoursOfOperation, obj *svcsdk.CreateHoursOfOperationInput) error {
+	obj.InstanceId = cr.Spec.ForProvider.InstanceID
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.HoursOfOperation, resp *svcsdk.CreateHoursOfOperationOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.HoursOfOperationId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.HoursOfOperation, obj *svcsdk.UpdateHoursOfOperationInput) error {
+	obj.InstanceId = cr.Spec.ForProvider.InstanceID
+	obj.HoursOfOperationId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.HoursOfOperation, obj *svcsdk.DeleteHoursOfOperationInput) (bool, error) {
+	obj.InstanceId = cr.Spec.ForProvider.InstanceID
+	obj.HoursOfOperationId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instance
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupInstance adds a controller that reconciles Instance.
+func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.InstanceGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Instance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.InstanceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Instance, obj *svcsdk.DescribeInstanceInput) error {
+	obj.InstanceId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Instance, _ *svcsdk.DescribeInstanceOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(cr.Status.AtProvider.InstanceStatus) {
+	case svcsdk.InstanceStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.InstanceStatusCreationInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.InstanceStatusCreationFailed:
+		c := xpv1.Unavailable()
+		if cr.Status.AtProvider.StatusReason != nil {
+			c.Message = awsclients.StringValue(cr.Status.AtProvider.StatusReason.Message)
+		}
+		cr.SetConditions(c)
+	}
+	return obs, nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Instance, resp *svcsdk.CreateInstanceOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Id))
+	return cre, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Instance, obj *svcsdk.DeleteInstanceInput) (bool, error) {
+	obj.InstanceId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/connect"
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/connect/connectiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Queue resource"
+
+	errCreateSession              = "cannot create a new session"
+	errCreate                     = "cannot create Queue in AWS"
+	errDescribe                   = "failed to describe Queue"
+	errUpdateName                 = "cannot update Queue name in AWS"
+	errUpdateHoursOfOperation     = "cannot update Queue hours of operation in AWS"
+	errUpdateMaxContacts          = "cannot update Queue max contacts in AWS"
+	errUpdateOutboundCallerConfig = "cannot update Queue outbound caller config in AWS"
+	errUpdateStatus               = "cannot update Queue status in AWS"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Queue)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.ConnectAPI
+}
+
+func (e *external) describe(ctx context.Context, cr *svcapitypes.Queue) (*svcsdk.Queue, error) {
+	resp, err := e.client.DescribeQueueWithContext(ctx, &svcsdk.DescribeQueueInput{
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		QueueId:    awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Queue, nil
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Queue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// Amazon Connect does not provide a way to delete queues, so a deleted
+	// Queue is orphaned and reported as gone.
+	if meta.GetExternalName(cr) == "" || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	q, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	LateInitialize(&cr.Spec.ForProvider, q)
+	cr.Status.AtProvider.QueueARN = q.QueueArn
+	cr.Status.AtProvider.QueueID = q.QueueId
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !NeedsUpdate(cr.Spec.ForProvider, q).Any(),
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Queue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateQueueWithContext(ctx, GenerateCreateQueueInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.QueueId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.Queue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	q, err := e.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	p := cr.Spec.ForProvider
+	id := awsclient.String(meta.GetExternalName(cr))
+	u := NeedsUpdate(p, q)
+	if u.Name {
+		if _, err := e.client.UpdateQueueNameWithContext(ctx, &svcsdk.UpdateQueueNameInput{
+			InstanceId:  p.InstanceID,
+			QueueId:     id,
+			Name:        p.Name,
+			Description: p.Description,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateName)
+		}
+	}
+	if u.HoursOfOperation {
+		if _, err := e.client.UpdateQueueHoursOfOperationWithContext(ctx, &svcsdk.UpdateQueueHoursOfOperationInput{
+			InstanceId:         p.InstanceID,
+			QueueId:            id,
+			HoursOfOperationId: p.HoursOfOperationID,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateHoursOfOperation)
+		}
+	}
+	if u.MaxContacts {
+		if _, err := e.client.UpdateQueueMaxContactsWithContext(ctx, &svcsdk.UpdateQueueMaxContactsInput{
+			InstanceId:  p.InstanceID,
+			QueueId:     id,
+			MaxContacts: p.MaxContacts,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateMaxContacts)
+		}
+	}
+	if u.OutboundCallerConfig {
+		if _, err := e.client.UpdateQueueOutboundCallerConfigWithContext(ctx, &svcsdk.UpdateQueueOutboundCallerConfigInput{
+			InstanceId:           p.InstanceID,
+			QueueId:              id,
+			OutboundCallerConfig: GenerateOutboundCallerConfig(p.OutboundCallerConfig),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateOutboundCallerConfig)
+		}
+	}
+	if u.Status {
+		if _, err := e.client.UpdateQueueStatusWithContext(ctx, &svcsdk.UpdateQueueStatusInput{
+			InstanceId: p.InstanceID,
+			QueueId:    id,
+			Status:     p.Status,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateStatus)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Queue)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	// Amazon Connect does not provide a way to delete queues.
+	cr.Status.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == "ResourceNotFoundException"
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateCreateQueueInput returns a create input.
+func GenerateCreateQueueInput(p svcapitypes.QueueParameters) *svcsdk.CreateQueueInput {
+	return &svcsdk.CreateQueueInput{
+		InstanceId:           p.InstanceID,
+		Name:                 p.Name,
+		Description:          p.Description,
+		HoursOfOperationId:   p.HoursOfOperationID,
+		MaxContacts:          p.MaxContacts,
+		OutboundCallerConfig: GenerateOutboundCallerConfig(p.OutboundCallerConfig),
+		QuickConnectIds:      p.QuickConnectIDs,
+		Tags:                 p.Tags,
+	}
+}
+
+// GenerateOutboundCallerConfig returns the SDK representation of the given
+// outbound caller config.
+func GenerateOutboundCallerConfig(c *svcapitypes.OutboundCallerConfig) *svcsdk.OutboundCallerConfig {
+	if c == nil {
+		return nil
+	}
+	return &svcsdk.OutboundCallerConfig{
+		OutboundCallerIdName:     c.OutboundCallerIDName,
+		OutboundCallerIdNumberId: c.OutboundCallerIDNumberID,
+		OutboundFlowId:           c.OutboundFlowID,
+	}
+}
+
+// LateInitialize fills the empty fields of the given parameters with the
+// values of the observed queue.
+func LateInitialize(p *svcapitypes.QueueParameters, q *svcsdk.Queue) {
+	p.Description = awsclient.LateInitializeStringPtr(p.Description, q.Description)
+	p.MaxContacts = awsclient.LateInitializeInt64Ptr(p.MaxContacts, q.MaxContacts)
+	p.Status = awsclient.LateInitializeStringPtr(p.Status, q.Status)
+}
+
+// Updates lists the parts of a queue that are updated through separate
+// operations.
+type Updates struct {
+	Name                 bool
+	HoursOfOperation     bool
+	MaxContacts          bool
+	OutboundCallerConfig bool
+	Status               bool
+}
+
+// Any returns true if any part of the queue needs to be updated.
+func (u Updates) Any() bool {
+	return u.Name || u.HoursOfOperation || u.MaxContacts || u.OutboundCallerConfig || u.Status
+}
+
+// NeedsUpdate returns the parts of the observed queue that differ from the
+// desired parameters.
+func NeedsUpdate(p svcapitypes.QueueParameters, q *svcsdk.Queue) Updates {
+	u := Updates{
+		Name: awsclient.StringValue(p.Name) != awsclient.StringValue(q.Name),
+		HoursOfOperation: p.HoursOfOperationID != nil &&
+			awsclient.StringValue(p.HoursOfOperationID) != awsclient.StringValue(q.HoursOfOperationId),
+		MaxContacts: p.MaxContacts != nil &&
+			awsclient.Int64Value(p.MaxContacts) != awsclient.Int64Value(q.MaxContacts),
+		Status: p.Status != nil &&
+			awsclient.StringValue(p.Status) != awsclient.StringValue(q.Status),
+	}
+	if c := p.OutboundCallerConfig; c != nil {
+		o := q.OutboundCallerConfig
+		if o == nil {
+			o = &svcsdk.OutboundCallerConfig{}
+		}
+		u.OutboundCallerConfig = awsclient.StringValue(c.OutboundCallerIDName) != awsclient.StringValue(o.OutboundCallerIdName) ||
+			awsclient.StringValue(c.OutboundCallerIDNumberID) != awsclient.StringValue(o.OutboundCallerIdNumberId) ||
+			awsclient.StringValue(c.OutboundFlowID) != awsclient.StringValue(o.OutboundFlowId)
+	}
+	return u
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/connect"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/connect/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestNeedsUpdate(t *testing.T) {
+	type args struct {
+		p svcapitypes.QueueParameters
+		q *svcsdk.Queue
+	}
+	cases := map[string]struct {
+		args args
+		want Updates
+	}{
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.QueueParameters{
+					Name:        awsclients.String("queue"),
+					MaxContacts: awsclients.Int64(10),
+				},
+				q: &svcsdk.Queue{
+					Name:        awsclients.String("queue"),
+					MaxContacts: awsclients.Int64(10),
+					Status:      awsclients.String(svcsdk.QueueStatusEnabled),
+				},
+			},
+			want: Updates{},
+		},
+		"SeparateUpdates": {
+			args: args{
+				p: svcapitypes.QueueParameters{
+					Name:               awsclients.String("queue"),
+					HoursOfOperationID: awsclients.String("hoo-2"),
+					Status:             awsclients.String(svcsdk.QueueStatusDisabled),
+					OutboundCallerConfig: &svcapitypes.OutboundCallerConfig{
+						OutboundCallerIDName: awsclients.String("support"),
+					},
+				},
+				q: &svcsdk.Queue{
+					Name:               awsclients.String("queue"),
+					HoursOfOperationId: awsclients.String("hoo-1"),
+					Status:             awsclients.String(svcsdk.QueueStatusEnabled),
+				},
+			},
+			want: Updates{HoursOfOperation: true, OutboundCallerConfig: true, Status: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsUpdate(tc.args.p, tc.args.q)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
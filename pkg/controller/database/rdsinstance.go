@@ -39,6 +39,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
 )
 
 const (
@@ -60,24 +61,26 @@ const (
 // SetupRDSInstance adds a controller that reconciles RDSInstances.
 func SetupRDSInstance(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.RDSInstanceGroupKind)
+	hints := requeue.NewHints()
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.RDSInstance{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(requeue.NewReconciler(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient, hints: hints}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))), hints))
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(config *aws.Config) rds.Client
+	hints       *requeue.Hints
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -89,12 +92,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(cfg), c.kube}, nil
+	return &external{c.newClientFn(cfg), c.kube, c.hints}, nil
 }
 
 type external struct {
 	client rds.Client
 	kube   client.Client
+	hints  *requeue.Hints
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -129,6 +133,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if d, ok := rds.InstanceOperationDuration(cr.Status.AtProvider.DBInstanceStatus); ok {
+		e.hints.InProgress(cr, requeue.Operation{Name: cr.Status.AtProvider.DBInstanceStatus, Expected: d})
+	}
 	upToDate, err := rds.IsUpToDate(ctx, e.kube, cr, instance)
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDateFailed)
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"strconv"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/dax"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dax/daxiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errIncreaseReplicationFactor = "cannot increase replication factor of Cluster"
+	errDecreaseReplicationFactor = "cannot decrease replication factor of Cluster"
+
+	// ConnectionDetailsURL is the key of the cluster discovery URL in the
+	// connection secret.
+	ConnectionDetailsURL = "url"
+)
+
+// SetupCluster adds a controller that reconciles Cluster.
+func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ClusterGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Cluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ClusterGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.DAXAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClustersInput) error {
+	obj.ClusterNames = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Cluster, resp *svcsdk.DescribeClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(cr.Status.AtProvider.Status) {
+	case "available":
+		cr.SetConditions(xpv1.Available())
+	case "creating":
+		cr.SetConditions(xpv1.Creating())
+	case "deleting":
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	obs.ConnectionDetails = getConnectionDetails(resp.Clusters[0].ClusterDiscoveryEndpoint)
+	return obs, nil
+}
+
+// getConnectionDetails returns the cluster discovery endpoint that DAX
+// clients use to find the nodes of the cluster.
+func getConnectionDetails(e *svcsdk.Endpoint) managed.ConnectionDetails {
+	if e == nil || e.Address == nil {
+		return nil
+	}
+	cd := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(awsclients.StringValue(e.Address)),
+	}
+	if e.Port != nil {
+		cd[xpv1.ResourceCredentialsSecretPortKey] = []byte(strconv.FormatInt(awsclients.Int64Value(e.Port), 10))
+	}
+	if e.URL != nil {
+		cd[ConnectionDetailsURL] = []byte(awsclients.StringValue(e.URL))
+	}
+	return cd
+}
+
+func isUpToDate(cr *svcapitypes.Cluster, resp *svcsdk.DescribeClustersOutput) (bool, error) {
+	// DAX rejects updates while the cluster is not available.
+	if awsclients.StringValue(cr.Status.AtProvider.Status) != "available" {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	c := resp.Clusters[0]
+	switch {
+	case awsclients.Int64Value(p.ReplicationFactor) != awsclients.Int64Value(c.TotalNodes),
+		p.Description != nil && awsclients.StringValue(p.Description) != awsclients.StringValue(c.Description),
+		p.PreferredMaintenanceWindow != nil && awsclients.StringValue(p.PreferredMaintenanceWindow) != awsclients.StringValue(c.PreferredMaintenanceWindow),
+		p.NotificationTopicARN != nil && (c.NotificationConfiguration == nil || awsclients.StringValue(p.NotificationTopicARN) != awsclients.StringValue(c.NotificationConfiguration.TopicArn)),
+		p.ParameterGroupName != nil && (c.ParameterGroup == nil || awsclients.StringValue(p.ParameterGroupName) != awsclients.StringValue(c.ParameterGroup.ParameterGroupName)),
+		p.SecurityGroupIDs != nil && !areSecurityGroupsEqual(p.SecurityGroupIDs, c.SecurityGroups):
+		return false, nil
+	}
+	return true, nil
+}
+
+func areSecurityGroupsEqual(spec []*string, current []*svcsdk.SecurityGroupMembership) bool {
+	if len(spec) != len(current) {
+		return false
+	}
+	currentMap := make(map[string]bool, len(current))
+	for _, sg := range current {
+		currentMap[awsclients.StringValue(sg.SecurityGroupIdentifier)] = true
+	}
+	for _, id := range spec {
+		if !currentMap[awsclients.StringValue(id)] {
+			return false
+		}
+	}
+	return true
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateClusterInput) error {
+	obj.ClusterName = awsclients.String(meta.GetExternalName(cr))
+	obj.IamRoleArn = cr.Spec.ForProvider.IAMRoleARN
+	obj.ParameterGroupName = cr.Spec.ForProvider.ParameterGroupName
+	obj.SubnetGroupName = cr.Spec.ForProvider.SubnetGroupName
+	obj.SecurityGroupIds = cr.Spec.ForProvider.SecurityGroupIDs
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.UpdateClusterInput) error {
+	obj.ClusterName = awsclients.String(meta.GetExternalName(cr))
+	obj.ParameterGroupName = cr.Spec.ForProvider.ParameterGroupName
+	obj.SecurityGroupIds = cr.Spec.ForProvider.SecurityGroupIDs
+	return nil
+}
+
+// postUpdate changes the number of nodes of the cluster, which UpdateCluster
+// does not cover.
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Cluster, resp *svcsdk.UpdateClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil || resp.Cluster == nil {
+		return upd, err
+	}
+	desired := awsclients.Int64Value(cr.Spec.ForProvider.ReplicationFactor)
+	current := awsclients.Int64Value(resp.Cluster.TotalNodes)
+	switch {
+	case desired > current:
+		_, err = h.client.IncreaseReplicationFactorWithContext(ctx, &svcsdk.IncreaseReplicationFactorInput{
+			ClusterName:          awsclients.String(meta.GetExternalName(cr)),
+			NewReplicationFactor: cr.Spec.ForProvider.ReplicationFactor,
+			AvailabilityZones:    cr.Spec.ForProvider.AvailabilityZones,
+		})
+		return upd, awsclients.Wrap(err, errIncreaseReplicationFactor)
+	case desired < current:
+		_, err = h.client.DecreaseReplicationFactorWithContext(ctx, &svcsdk.DecreaseReplicationFactorInput{
+			ClusterName:          awsclients.String(meta.GetExternalName(cr)),
+			NewReplicationFactor: cr.Spec.ForProvider.ReplicationFactor,
+		})
+		return upd, awsclients.Wrap(err, errDecreaseReplicationFactor)
+	}
+	return upd, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DeleteClusterInput) (bool, error) {
+	obj.ClusterName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
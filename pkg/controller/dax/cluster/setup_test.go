@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/dax"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	cluster := func(status string, p svcapitypes.ClusterParameters) *svcapitypes.Cluster {
+		cr := &svcapitypes.Cluster{}
+		cr.Spec.ForProvider = p
+		cr.Status.AtProvider.Status = awsclients.String(status)
+		return cr
+	}
+	observed := &svcsdk.DescribeClustersOutput{Clusters: []*svcsdk.Cluster{{
+		TotalNodes:     awsclients.Int64(3),
+		Description:    awsclients.String("cache"),
+		ParameterGroup: &svcsdk.ParameterGroupStatus{ParameterGroupName: awsclients.String("default.dax1.0")},
+		SecurityGroups: []*svcsdk.SecurityGroupMembership{
+			{SecurityGroupIdentifier: awsclients.String("sg-2")},
+			{SecurityGroupIdentifier: awsclients.String("sg-1")},
+		},
+	}}}
+
+	cases := map[string]struct {
+		cr   *svcapitypes.Cluster
+		want bool
+	}{
+		"UpToDate": {
+			cr: cluster("available", svcapitypes.ClusterParameters{
+				ReplicationFactor: awsclients.Int64(3),
+				Description:       awsclients.String("cache"),
+				CustomClusterParameters: svcapitypes.CustomClusterParameters{
+					SecurityGroupIDs: []*string{awsclients.String("sg-1"), awsclients.String("sg-2")},
+				},
+			}),
+			want: true,
+		},
+		"ReplicationFactorChanged": {
+			cr:   cluster("available", svcapitypes.ClusterParameters{ReplicationFactor: awsclients.Int64(5)}),
+			want: false,
+		},
+		"ParameterGroupChanged": {
+			cr: cluster("available", svcapitypes.ClusterParameters{
+				ReplicationFactor: awsclients.Int64(3),
+				CustomClusterParameters: svcapitypes.CustomClusterParameters{
+					ParameterGroupName: awsclients.String("custom"),
+				},
+			}),
+			want: false,
+		},
+		"NotAvailable": {
+			cr:   cluster("modifying", svcapitypes.ClusterParameters{ReplicationFactor: awsclients.Int64(5)}),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, observed)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		endpoint *svcsdk.Endpoint
+		want     managed.ConnectionDetails
+	}{
+		"NoEndpoint": {},
+		"Endpoint": {
+			endpoint: &svcsdk.Endpoint{
+				Address: awsclients.String("my-cluster.abc123.dax-clusters.us-east-1.amazonaws.com"),
+				Port:    awsclients.Int64(8111),
+				URL:     awsclients.String("dax://my-cluster.abc123.dax-clusters.us-east-1.amazonaws.com"),
+			},
+			want: managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte("my-cluster.abc123.dax-clusters.us-east-1.amazonaws.com"),
+				xpv1.ResourceCredentialsSecretPortKey:     []byte("8111"),
+				ConnectionDetailsURL:                      []byte("dax://my-cluster.abc123.dax-clusters.us-east-1.amazonaws.com"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getConnectionDetails(tc.endpoint)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("getConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parametergroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/dax"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dax/daxiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errDescribeParameters = "cannot describe parameters of ParameterGroup"
+)
+
+// SetupParameterGroup adds a controller that reconciles ParameterGroup.
+func SetupParameterGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ParameterGroupGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = h.postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.DAXAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ParameterGroup, obj *svcsdk.DescribeParameterGroupsInput) error {
+	obj.ParameterGroupNames = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ParameterGroup, _ *svcsdk.DescribeParameterGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate compares the desired parameter values with the ones of the
+// parameter group. Parameters that are not in the spec are not compared.
+func (h *hooks) isUpToDate(cr *svcapitypes.ParameterGroup, _ *svcsdk.DescribeParameterGroupsOutput) (bool, error) {
+	if len(cr.Spec.ForProvider.ParameterNameValues) == 0 {
+		return true, nil
+	}
+	current := map[string]string{}
+	input := &svcsdk.DescribeParametersInput{ParameterGroupName: awsclients.String(meta.GetExternalName(cr))}
+	for {
+		page, err := h.client.DescribeParametersWithContext(context.TODO(), input)
+		if err != nil {
+			return false, awsclients.Wrap(err, errDescribeParameters)
+		}
+		for _, p := range page.Parameters {
+			current[awsclients.StringValue(p.ParameterName)] = awsclients.StringValue(p.ParameterValue)
+		}
+		if page.NextToken == nil {
+			break
+		}
+		input.NextToken = page.NextToken
+	}
+	for _, p := range cr.Spec.ForProvider.ParameterNameValues {
+		if current[awsclients.StringValue(p.ParameterName)] != awsclients.StringValue(p.ParameterValue) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.ParameterGroup, obj *svcsdk.CreateParameterGroupInput) error {
+	obj.ParameterGroupName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postCreate sets the parameter values right away since they cannot be
+// passed to CreateParameterGroup.
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.ParameterGroup, _ *svcsdk.CreateParameterGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil || len(cr.Spec.ForProvider.ParameterNameValues) == 0 {
+		return cre, err
+	}
+	obj := &svcsdk.UpdateParameterGroupInput{}
+	if err := preUpdate(ctx, cr, obj); err != nil {
+		return cre, err
+	}
+	_, err = h.client.UpdateParameterGroupWithContext(ctx, obj)
+	return cre, awsclients.Wrap(err, errUpdate)
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.ParameterGroup, obj *svcsdk.UpdateParameterGroupInput) error {
+	obj.ParameterGroupName = awsclients.String(meta.GetExternalName(cr))
+	obj.ParameterNameValues = make([]*svcsdk.ParameterNameValue, len(cr.Spec.ForProvider.ParameterNameValues))
+	for i, p := range cr.Spec.ForProvider.ParameterNameValues {
+		obj.ParameterNameValues[i] = &svcsdk.ParameterNameValue{
+			ParameterName:  p.ParameterName,
+			ParameterValue: p.ParameterValue,
+		}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ParameterGroup, obj *svcsdk.DeleteParameterGroupInput) (bool, error) {
+	obj.ParameterGroupName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnetgroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/dax"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/dax/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupSubnetGroup adds a controller that reconciles SubnetGroup.
+func SetupSubnetGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.SubnetGroupGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.SubnetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.SubnetGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.SubnetGroup, obj *svcsdk.DescribeSubnetGroupsInput) error {
+	obj.SubnetGroupNames = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.SubnetGroup, _ *svcsdk.DescribeSubnetGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.SubnetGroup, resp *svcsdk.DescribeSubnetGroupsOutput) (bool, error) {
+	group := resp.SubnetGroups[0]
+	if cr.Spec.ForProvider.Description != nil && awsclients.StringValue(cr.Spec.ForProvider.Description) != awsclients.StringValue(group.Description) {
+		return false, nil
+	}
+	return areSubnetsEqual(cr.Spec.ForProvider.SubnetIDs, group.Subnets), nil
+}
+
+func areSubnetsEqual(spec []*string, current []*svcsdk.Subnet) bool {
+	if len(spec) != len(current) {
+		return false
+	}
+	currentMap := make(map[string]bool, len(current))
+	for _, s := range current {
+		currentMap[awsclients.StringValue(s.SubnetIdentifier)] = true
+	}
+	for _, id := range spec {
+		if !currentMap[awsclients.StringValue(id)] {
+			return false
+		}
+	}
+	return true
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.SubnetGroup, obj *svcsdk.CreateSubnetGroupInput) error {
+	obj.SubnetGroupName = awsclients.String(meta.GetExternalName(cr))
+	obj.SubnetIds = cr.Spec.ForProvider.SubnetIDs
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.SubnetGroup, obj *svcsdk.UpdateSubnetGroupInput) error {
+	obj.SubnetGroupName = awsclients.String(meta.GetExternalName(cr))
+	obj.SubnetIds = cr.Spec.ForProvider.SubnetIDs
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.SubnetGroup, obj *svcsdk.DeleteSubnetGroupInput) (bool, error) {
+	obj.SubnetGroupName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
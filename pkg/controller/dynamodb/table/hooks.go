@@ -25,6 +25,7 @@ import (
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,20 +41,26 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errDescribeContinuousBackups = "cannot describe continuous backups of Table"
+	errUpdateContinuousBackups   = "cannot update continuous backups of Table"
+)
+
 // SetupTable adds a controller that reconciles Table.
 func SetupTable(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.TableGroupKind)
 	opts := []option{
 		func(e *external) {
+			u := &updateClient{client: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = u.postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.postDelete = postDelete
 			e.lateInitialize = lateInitialize
 			e.isUpToDate = isUpToDate
-			u := &updateClient{client: e.client}
 			e.preUpdate = u.preUpdate
+			e.postUpdate = postUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -109,7 +116,14 @@ func postObserve(_ context.Context, cr *svcapitypes.Table, resp *svcsdk.Describe
 	case string(svcapitypes.TableStatus_SDK_DELETING):
 		cr.SetConditions(xpv1.Deleting())
 	case string(svcapitypes.TableStatus_SDK_ACTIVE):
-		cr.SetConditions(xpv1.Available())
+		// The table stays active while its global secondary indexes are
+		// created, updated or deleted in the background, but it isn't ready
+		// until they have settled and been backfilled.
+		if name := inFlightGlobalSecondaryIndex(resp.Table.GlobalSecondaryIndexes); name != "" {
+			cr.SetConditions(svcapitypes.UpdatingIndex(name))
+		} else {
+			cr.SetConditions(xpv1.Available())
+		}
 	case string(svcapitypes.TableStatus_SDK_ARCHIVED), string(svcapitypes.TableStatus_SDK_INACCESSIBLE_ENCRYPTION_CREDENTIALS), string(svcapitypes.TableStatus_SDK_ARCHIVING):
 		cr.SetConditions(xpv1.Unavailable())
 	}
@@ -124,6 +138,17 @@ func postObserve(_ context.Context, cr *svcapitypes.Table, resp *svcsdk.Describe
 	return obs, nil
 }
 
+// isPointInTimeRecoveryUpToDate returns true if the desired point in time
+// recovery setting matches the observed continuous backups description.
+func isPointInTimeRecoveryUpToDate(desired *bool, obs *svcsdk.ContinuousBackupsDescription) bool {
+	if desired == nil {
+		return true
+	}
+	enabled := obs != nil && obs.PointInTimeRecoveryDescription != nil &&
+		aws.StringValue(obs.PointInTimeRecoveryDescription.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return aws.BoolValue(desired) == enabled
+}
+
 type tagger struct {
 	kube client.Client
 }
@@ -322,6 +347,13 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 		return true, nil
 	}
 
+	// AWS allows only a single global secondary index to be created or
+	// deleted at a time, so we wait for the index that is in flight to
+	// settle before diffing again.
+	if inFlightGlobalSecondaryIndex(resp.Table.GlobalSecondaryIndexes) != "" {
+		return true, nil
+	}
+
 	// Similarly, a table that's currently updating its SSE status can't be
 	// updated, so we temporarily consider it to be up-to-date.
 	if cr.Status.AtProvider.SSEDescription != nil && aws.StringValue(cr.Status.AtProvider.SSEDescription.Status) == string(svcapitypes.SSEStatus_UPDATING) {
@@ -354,7 +386,7 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 		return false, nil
 	case patch.StreamSpecification != nil:
 		return false, nil
-	case len(diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), resp.Table.GlobalSecondaryIndexes)) != 0:
+	case len(diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), resp.Table.GlobalSecondaryIndexes, aws.BoolValue(cr.Spec.ForProvider.AllowGlobalSecondaryIndexReplacement))) != 0:
 		return false, nil
 	}
 	return true, nil
@@ -364,6 +396,23 @@ type updateClient struct {
 	client svcsdkapi.DynamoDBAPI
 }
 
+func (e *updateClient) postObserve(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	obs, err = postObserve(ctx, cr, resp, obs, err)
+	if err != nil || !obs.ResourceUpToDate || cr.Spec.ForProvider.PointInTimeRecoveryEnabled == nil {
+		return obs, err
+	}
+	// Continuous backups can only be described once the table is active.
+	if aws.StringValue(resp.Table.TableStatus) != string(svcapitypes.TableStatus_SDK_ACTIVE) {
+		return obs, nil
+	}
+	out, err := e.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, aws.Wrap(err, errDescribeContinuousBackups)
+	}
+	obs.ResourceUpToDate = isPointInTimeRecoveryUpToDate(cr.Spec.ForProvider.PointInTimeRecoveryEnabled, out.ContinuousBackupsDescription)
+	return obs, nil
+}
+
 func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
 	filtered := &svcsdk.UpdateTableInput{
 		TableName:            aws.String(meta.GetExternalName(cr)),
@@ -387,11 +436,17 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		return aws.Wrap(err, errDescribe)
 	}
 
+	// Point in time recovery is configured through its own API, so it is
+	// updated here on its own before the table itself.
+	if err := e.updatePointInTimeRecovery(ctx, cr); err != nil {
+		return err
+	}
+
 	p, err := createPatch(out, &cr.Spec.ForProvider)
 	if err != nil {
 		return err
 	}
-	gsiUpdates := diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), out.Table.GlobalSecondaryIndexes)
+	gsiUpdates := diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), out.Table.GlobalSecondaryIndexes, aws.BoolValue(cr.Spec.ForProvider.AllowGlobalSecondaryIndexReplacement))
 	switch {
 	case p.BillingMode != nil:
 		filtered.BillingMode = u.BillingMode
@@ -427,7 +482,38 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 	return nil
 }
 
-func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription, obs []*svcsdk.GlobalSecondaryIndexDescription) []*svcsdk.GlobalSecondaryIndexUpdate { //nolint:gocyclo
+func (e *updateClient) updatePointInTimeRecovery(ctx context.Context, cr *svcapitypes.Table) error {
+	if cr.Spec.ForProvider.PointInTimeRecoveryEnabled == nil {
+		return nil
+	}
+	out, err := e.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return aws.Wrap(err, errDescribeContinuousBackups)
+	}
+	if isPointInTimeRecoveryUpToDate(cr.Spec.ForProvider.PointInTimeRecoveryEnabled, out.ContinuousBackupsDescription) {
+		return nil
+	}
+	_, err = e.client.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+		TableName: aws.String(meta.GetExternalName(cr)),
+		PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{
+			PointInTimeRecoveryEnabled: cr.Spec.ForProvider.PointInTimeRecoveryEnabled,
+		},
+	})
+	return aws.Wrap(err, errUpdateContinuousBackups)
+}
+
+func postUpdate(_ context.Context, _ *svcapitypes.Table, _ *svcsdk.UpdateTableOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	// When only point in time recovery needed an update there is nothing
+	// left for UpdateTable to do, and the API rejects the empty update. As
+	// in postDelete we're passed an error munged by aws.Wrap, so we fall
+	// back to string matching.
+	if err != nil && strings.Contains(err.Error(), "At least one of ProvisionedThroughput") {
+		return upd, nil
+	}
+	return upd, err
+}
+
+func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription, obs []*svcsdk.GlobalSecondaryIndexDescription, replace bool) []*svcsdk.GlobalSecondaryIndexUpdate { //nolint:gocyclo
 	// Linter is disabled because there isn't an easy good way to reduce the cyclo
 	// complexity here.
 	desired := map[string]*svcsdk.GlobalSecondaryIndexDescription{}
@@ -488,9 +574,11 @@ func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription,
 		return updates
 	}
 	// At this point, we handled all creations and updates. The last thing to check
-	// is whether there is a removal.
+	// is whether there is a removal. The key schema and projection of an index
+	// cannot be updated, so if replacement is allowed an index whose ones
+	// changed is removed here and created again in a later pass.
 	for _, k := range existingKeys {
-		if _, ok := desired[k]; !ok {
+		if d, ok := desired[k]; !ok || (replace && isGlobalSecondaryIndexReplaced(d, existing[k])) {
 			return []*svcsdk.GlobalSecondaryIndexUpdate{
 				{
 					Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{
@@ -503,6 +591,46 @@ func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription,
 	return nil
 }
 
+// inFlightGlobalSecondaryIndex returns the name of the first global secondary
+// index that is being created, updated, deleted or backfilled, if any.
+func inFlightGlobalSecondaryIndex(obs []*svcsdk.GlobalSecondaryIndexDescription) string {
+	for _, gsi := range obs {
+		s := aws.StringValue(gsi.IndexStatus)
+		if (s != "" && s != string(svcapitypes.IndexStatus_ACTIVE)) || aws.BoolValue(gsi.Backfilling) {
+			return aws.StringValue(gsi.IndexName)
+		}
+	}
+	return ""
+}
+
+// isGlobalSecondaryIndexReplaced returns true if the desired key schema or
+// projection of a global secondary index differs from the existing one.
+func isGlobalSecondaryIndexReplaced(desired, existing *svcsdk.GlobalSecondaryIndexDescription) bool {
+	if len(desired.KeySchema) != 0 {
+		if len(desired.KeySchema) != len(existing.KeySchema) {
+			return true
+		}
+		for i := range desired.KeySchema {
+			if aws.StringValue(desired.KeySchema[i].AttributeName) != aws.StringValue(existing.KeySchema[i].AttributeName) ||
+				aws.StringValue(desired.KeySchema[i].KeyType) != aws.StringValue(existing.KeySchema[i].KeyType) {
+				return true
+			}
+		}
+	}
+	if desired.Projection == nil {
+		return false
+	}
+	if existing.Projection == nil {
+		return true
+	}
+	if desired.Projection.ProjectionType != nil &&
+		aws.StringValue(desired.Projection.ProjectionType) != aws.StringValue(existing.Projection.ProjectionType) {
+		return true
+	}
+	return !cmp.Equal(desired.Projection.NonKeyAttributes, existing.Projection.NonKeyAttributes, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *string) bool { return aws.StringValue(a) < aws.StringValue(b) }))
+}
+
 // GenerateGlobalSecondaryIndexDescriptions generates an array of GlobalSecondaryIndexDescriptions.
 func GenerateGlobalSecondaryIndexDescriptions(p []*svcapitypes.GlobalSecondaryIndex) []*svcsdk.GlobalSecondaryIndexDescription { // nolint:gocyclo
 	// Linter is disabled because this is a copy-paste from generated code and
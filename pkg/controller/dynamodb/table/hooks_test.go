@@ -170,6 +170,33 @@ func TestIsUpToDate(t *testing.T) {
 				result: false,
 			},
 		},
+		"IndexInFlight": {
+			args: args{
+				t: svcsdk.DescribeTableOutput{
+					Table: &svcsdk.TableDescription{
+						GlobalSecondaryIndexes: []*svcsdk.GlobalSecondaryIndexDescription{
+							{
+								IndexName:   aws.String("one"),
+								IndexStatus: aws.String(string(v1alpha1.IndexStatus_CREATING)),
+							},
+						},
+					},
+				},
+				p: v1alpha1.Table{
+					Spec: v1alpha1.TableSpec{
+						ForProvider: v1alpha1.TableParameters{
+							GlobalSecondaryIndexes: []*v1alpha1.GlobalSecondaryIndex{
+								{IndexName: aws.String("one")},
+								{IndexName: aws.String("two")},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -408,8 +435,9 @@ func TestLateInitialize(t *testing.T) {
 
 func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 	type args struct {
-		spec []*svcsdk.GlobalSecondaryIndexDescription
-		obs  []*svcsdk.GlobalSecondaryIndexDescription
+		spec    []*svcsdk.GlobalSecondaryIndexDescription
+		obs     []*svcsdk.GlobalSecondaryIndexDescription
+		replace bool
 	}
 	type want struct {
 		result []*svcsdk.GlobalSecondaryIndexUpdate
@@ -585,6 +613,85 @@ func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 				},
 			},
 		},
+		"ReplaceChangedKeySchema": {
+			args: args{
+				replace: true,
+				spec: []*svcsdk.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("one"),
+						KeySchema: []*svcsdk.KeySchemaElement{
+							{AttributeName: aws.String("new"), KeyType: aws.String("HASH")},
+						},
+					},
+				},
+				obs: []*svcsdk.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("one"),
+						KeySchema: []*svcsdk.KeySchemaElement{
+							{AttributeName: aws.String("old"), KeyType: aws.String("HASH")},
+						},
+					},
+				},
+			},
+			want: want{
+				result: []*svcsdk.GlobalSecondaryIndexUpdate{
+					{
+						Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{
+							IndexName: aws.String("one"),
+						},
+					},
+				},
+			},
+		},
+		"ReplaceChangedProjection": {
+			args: args{
+				replace: true,
+				spec: []*svcsdk.GlobalSecondaryIndexDescription{
+					{
+						IndexName:  aws.String("one"),
+						Projection: &svcsdk.Projection{ProjectionType: aws.String("ALL")},
+					},
+				},
+				obs: []*svcsdk.GlobalSecondaryIndexDescription{
+					{
+						IndexName:  aws.String("one"),
+						Projection: &svcsdk.Projection{ProjectionType: aws.String("KEYS_ONLY")},
+					},
+				},
+			},
+			want: want{
+				result: []*svcsdk.GlobalSecondaryIndexUpdate{
+					{
+						Delete: &svcsdk.DeleteGlobalSecondaryIndexAction{
+							IndexName: aws.String("one"),
+						},
+					},
+				},
+			},
+		},
+		"IgnoreChangedKeySchema": {
+			args: args{
+				spec: []*svcsdk.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("one"),
+						KeySchema: []*svcsdk.KeySchemaElement{
+							{AttributeName: aws.String("new"), KeyType: aws.String("HASH")},
+						},
+					},
+				},
+				obs: []*svcsdk.GlobalSecondaryIndexDescription{
+					{
+						IndexName: aws.String("one"),
+						KeySchema: []*svcsdk.KeySchemaElement{
+							{AttributeName: aws.String("old"), KeyType: aws.String("HASH")},
+						},
+					},
+				},
+			},
+			want: want{
+				result: nil,
+			},
+		},
 		"DeleteOnlyOne": {
 			args: args{
 				spec: []*svcsdk.GlobalSecondaryIndexDescription{},
@@ -611,10 +718,82 @@ func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := diffGlobalSecondaryIndexes(tc.args.spec, tc.args.obs)
+			got := diffGlobalSecondaryIndexes(tc.args.spec, tc.args.obs, tc.args.replace)
 			if diff := cmp.Diff(got, tc.want.result); diff != "" {
 				t.Errorf("diffGlobalSecondaryIndexes(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestIsPointInTimeRecoveryUpToDate(t *testing.T) {
+	type args struct {
+		desired *bool
+		obs     *svcsdk.ContinuousBackupsDescription
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				obs: &svcsdk.ContinuousBackupsDescription{
+					PointInTimeRecoveryDescription: &svcsdk.PointInTimeRecoveryDescription{
+						PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled),
+					},
+				},
+			},
+			want: true,
+		},
+		"Enabled": {
+			args: args{
+				desired: aws.Bool(true),
+				obs: &svcsdk.ContinuousBackupsDescription{
+					PointInTimeRecoveryDescription: &svcsdk.PointInTimeRecoveryDescription{
+						PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled),
+					},
+				},
+			},
+			want: true,
+		},
+		"NeedsEnabling": {
+			args: args{
+				desired: aws.Bool(true),
+				obs: &svcsdk.ContinuousBackupsDescription{
+					PointInTimeRecoveryDescription: &svcsdk.PointInTimeRecoveryDescription{
+						PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled),
+					},
+				},
+			},
+			want: false,
+		},
+		"NeedsDisabling": {
+			args: args{
+				desired: aws.Bool(false),
+				obs: &svcsdk.ContinuousBackupsDescription{
+					PointInTimeRecoveryDescription: &svcsdk.PointInTimeRecoveryDescription{
+						PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled),
+					},
+				},
+			},
+			want: false,
+		},
+		"DisabledWithoutDescription": {
+			args: args{
+				desired: aws.Bool(false),
+				obs:     &svcsdk.ContinuousBackupsDescription{},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isPointInTimeRecoveryUpToDate(tc.args.desired, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
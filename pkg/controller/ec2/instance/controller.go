@@ -51,6 +51,8 @@ const (
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errStart                    = "failed to start the Instance resource"
+	errStop                     = "failed to stop the Instance resource"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -197,7 +199,15 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	case ec2.Available:
 		cr.SetConditions(xpv1.Available())
 	case ec2.Deleting:
-		cr.SetConditions(xpv1.Deleting())
+		switch {
+		case !ec2.IsInstanceStopDesired(cr.Spec.ForProvider.DesiredState):
+			cr.SetConditions(xpv1.Deleting())
+		case observation.State == string(types.InstanceStateNameStopped):
+			// The instance was stopped or hibernated on purpose.
+			cr.SetConditions(xpv1.Available())
+		default:
+			cr.SetConditions(xpv1.Unavailable())
+		}
 	case ec2.Deleted:
 		// Terminated instances remain visible on API calls for a time before
 		// being automatically deleted. Rather than having the delete command
@@ -320,12 +330,39 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+	if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
 		Resources: []string{meta.GetExternalName(cr)},
 		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	})
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{}, e.changeState(ctx, cr)
+}
+
+// changeState starts, stops or hibernates the instance if it has settled in a
+// state other than the desired one.
+func (e *external) changeState(ctx context.Context, cr *svcapitypes.Instance) error {
+	id := []string{meta.GetExternalName(cr)}
+	state := types.InstanceStateName(cr.Status.AtProvider.State)
+	switch awsclient.StringValue(cr.Spec.ForProvider.DesiredState) {
+	case svcapitypes.InstanceDesiredStateRunning:
+		if state != types.InstanceStateNameStopped {
+			return nil
+		}
+		_, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: id})
+		return awsclient.Wrap(err, errStart)
+	case svcapitypes.InstanceDesiredStateStopped, svcapitypes.InstanceDesiredStateHibernated:
+		if state != types.InstanceStateNameRunning {
+			return nil
+		}
+		_, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: id,
+			Hibernate:   aws.Bool(awsclient.StringValue(cr.Spec.ForProvider.DesiredState) == svcapitypes.InstanceDesiredStateHibernated),
+		})
+		return awsclient.Wrap(err, errStop)
+	}
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -401,6 +401,100 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
+		"StartStoppedInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						if diff := cmp.Diff([]string{instanceID}, input.InstanceIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopped)}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopped)}),
+				),
+			},
+		},
+		"HibernateRunningInstance": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if !aws.ToBool(input.Hibernate) {
+							t.Errorf("expected the instance to be hibernated")
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameRunning)}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameRunning)}),
+				),
+			},
+		},
+		"StopFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameRunning)}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameRunning)}),
+				),
+				err: awsclient.Wrap(errBoom, errStop),
+			},
+		},
+		"StoppingInstanceIsLeftAlone": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopping)}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: string(types.InstanceStateNameStopping)}),
+				),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registrypolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
+	awsecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ecr/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	registryID     = "123456789012"
+
+	policy          = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"ecr:ReplicateImage","Resource":"arn:aws:ecr:us-east-1:123456789012:repository/*"}]}`
+	reorderedPolicy = `{"Statement":[{"Action":"ecr:ReplicateImage","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Resource":"arn:aws:ecr:us-east-1:123456789012:repository/*"}],"Version":"2012-10-17"}`
+	outdatedPolicy  = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"ecr:CreateRepository","Resource":"arn:aws:ecr:us-east-1:123456789012:repository/*"}]}`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ecr ecr.RegistryPolicyClient
+	cr  resource.Managed
+}
+
+type registryPolicyModifier func(*v1alpha1.RegistryPolicy)
+
+func withConditions(c ...xpv1.Condition) registryPolicyModifier {
+	return func(r *v1alpha1.RegistryPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) registryPolicyModifier {
+	return func(r *v1alpha1.RegistryPolicy) { meta.SetExternalName(r, name) }
+}
+
+func withRegistryID(id string) registryPolicyModifier {
+	return func(r *v1alpha1.RegistryPolicy) { r.Status.AtProvider.RegistryID = id }
+}
+
+func registryPolicy(m ...registryPolicyModifier) *v1alpha1.RegistryPolicy {
+	cr := &v1alpha1.RegistryPolicy{
+		Spec: v1alpha1.RegistryPolicySpec{
+			ForProvider: v1alpha1.RegistryPolicyParameters{Policy: policy},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockGet: func(ctx context.Context, input *awsecr.GetRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.GetRegistryPolicyOutput, error) {
+						return &awsecr.GetRegistryPolicyOutput{
+							RegistryId: aws.String(registryID),
+							PolicyText: aws.String(reorderedPolicy),
+						}, nil
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr: registryPolicy(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockGet: func(ctx context.Context, input *awsecr.GetRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.GetRegistryPolicyOutput, error) {
+						return &awsecr.GetRegistryPolicyOutput{
+							RegistryId: aws.String(registryID),
+							PolicyText: aws.String(outdatedPolicy),
+						}, nil
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr: registryPolicy(withRegistryID(registryID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockGet: func(ctx context.Context, input *awsecr.GetRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.GetRegistryPolicyOutput, error) {
+						return nil, &awsecrtypes.RegistryPolicyNotFoundException{}
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr: registryPolicy(),
+				result: managed.ExternalObservation{
+					ResourceExists: false,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockGet: func(ctx context.Context, input *awsecr.GetRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.GetRegistryPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr:  registryPolicy(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockPut: func(ctx context.Context, input *awsecr.PutRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.PutRegistryPolicyOutput, error) {
+						return &awsecr.PutRegistryPolicyOutput{RegistryId: aws.String(registryID)}, nil
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr:     registryPolicy(withExternalName(registryID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockPut: func(ctx context.Context, input *awsecr.PutRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.PutRegistryPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr:  registryPolicy(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockDelete: func(ctx context.Context, input *awsecr.DeleteRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.DeleteRegistryPolicyOutput, error) {
+						return &awsecr.DeleteRegistryPolicyOutput{}, nil
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr: registryPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockDelete: func(ctx context.Context, input *awsecr.DeleteRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.DeleteRegistryPolicyOutput, error) {
+						return nil, &awsecrtypes.RegistryPolicyNotFoundException{}
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr: registryPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				ecr: &fake.MockRegistryPolicyClient{
+					MockDelete: func(ctx context.Context, input *awsecr.DeleteRegistryPolicyInput, opts []func(*awsecr.Options)) (*awsecr.DeleteRegistryPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: registryPolicy(),
+			},
+			want: want{
+				cr:  registryPolicy(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ecr}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -55,6 +55,8 @@ const (
 	errUpdateScan          = "failed to update scan config for repository resource"
 	errUpdateMutability    = "failed to update mutability for repository resource"
 	errPatchCreationFailed = "cannot create a patch object"
+	errGetLifecyclePolicy  = "failed to get lifecycle policy for repository resource"
+	errPutLifecyclePolicy  = "failed to put lifecycle policy for repository resource"
 )
 
 // SetupRepository adds a controller that reconciles ECR.
@@ -140,9 +142,18 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = ecr.GenerateRepositoryObservation(observed)
 
+	upToDate := ecr.IsRepositoryUpToDate(&cr.Spec.ForProvider, tagsResp.Tags, &observed)
+	if upToDate && cr.Spec.ForProvider.LifecyclePolicy != nil {
+		policy, err := e.getLifecyclePolicy(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		upToDate = ecr.IsLifecyclePolicyUpToDate(cr.Spec.ForProvider.LifecyclePolicy, policy)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: ecr.IsRepositoryUpToDate(&cr.Spec.ForProvider, tagsResp.Tags, &observed),
+		ResourceUpToDate: upToDate,
 	}, nil
 }
 
@@ -216,6 +227,16 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
+	if patch.LifecyclePolicy != nil {
+		_, err := e.client.PutLifecyclePolicy(ctx, &awsecr.PutLifecyclePolicyInput{
+			RepositoryName:      awsclient.String(meta.GetExternalName(cr)),
+			LifecyclePolicyText: patch.LifecyclePolicy,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(ecr.IsRepoNotFoundErr, err), errPutLifecyclePolicy)
+		}
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -277,3 +298,18 @@ func (e *external) updateTags(ctx context.Context, repo *v1beta1.Repository) err
 	}
 	return nil
 }
+
+// getLifecyclePolicy returns the lifecycle policy text of the repository or
+// nil if it has none.
+func (e *external) getLifecyclePolicy(ctx context.Context, name string) (*string, error) {
+	resp, err := e.client.GetLifecyclePolicy(ctx, &awsecr.GetLifecyclePolicyInput{
+		RepositoryName: awsclient.String(name),
+	})
+	if ecr.IsLifecyclePolicyNotFoundErr(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, awsclient.Wrap(err, errGetLifecyclePolicy)
+	}
+	return resp.LifecyclePolicyText, nil
+}
@@ -60,6 +60,7 @@ var (
 	awsImageScanConfigFalse = awsecrtypes.ImageScanningConfiguration{
 		ScanOnPush: imageScanConfigFalse.ScanOnPush,
 	}
+	lifecyclePolicy = `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`
 )
 
 type args struct {
@@ -169,6 +170,90 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"LifecyclePolicyUpToDate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:      &testARN,
+								RepositoryName:     &repoName,
+								ImageTagMutability: awsecrtypes.ImageTagMutabilityMutable,
+							}},
+						}, nil
+					},
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return &awsecr.ListTagsForResourceOutput{}, nil
+					},
+					MockGetLifecyclePolicy: func(ctx context.Context, input *awsecr.GetLifecyclePolicyInput, opts []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return &awsecr.GetLifecyclePolicyOutput{
+							LifecyclePolicyText: aws.String(`{"rules": [{"action": {"type": "expire"}, "rulePriority": 1, "selection": {"countNumber": 14, "countType": "sinceImagePushed", "countUnit": "days", "tagStatus": "untagged"}}]}`),
+						}, nil
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					LifecyclePolicy: aws.String(lifecyclePolicy),
+				}), withExternalName(repoName)),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					ImageTagMutability: aws.String(string(awsecrtypes.ImageTagMutabilityMutable)),
+					LifecyclePolicy:    aws.String(lifecyclePolicy),
+				}), withStatus(v1beta1.RepositoryObservation{
+					RepositoryName: repoName,
+					RepositoryArn:  testARN,
+				}), withExternalName(repoName),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LifecyclePolicyNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:      &testARN,
+								RepositoryName:     &repoName,
+								ImageTagMutability: awsecrtypes.ImageTagMutabilityMutable,
+							}},
+						}, nil
+					},
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return &awsecr.ListTagsForResourceOutput{}, nil
+					},
+					MockGetLifecyclePolicy: func(ctx context.Context, input *awsecr.GetLifecyclePolicyInput, opts []func(*awsecr.Options)) (*awsecr.GetLifecyclePolicyOutput, error) {
+						return nil, &awsecrtypes.LifecyclePolicyNotFoundException{}
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					LifecyclePolicy: aws.String(lifecyclePolicy),
+				}), withExternalName(repoName)),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					ImageTagMutability: aws.String(string(awsecrtypes.ImageTagMutabilityMutable)),
+					LifecyclePolicy:    aws.String(lifecyclePolicy),
+				}), withStatus(v1beta1.RepositoryObservation{
+					RepositoryName: repoName,
+					RepositoryArn:  testARN,
+				}), withExternalName(repoName),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"MultipleRepository": {
 			args: args{
 				kube: &test.MockClient{
@@ -416,6 +501,63 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreateTags),
 			},
 		},
+		"SuccessfulLifecyclePolicy": {
+			args: args{
+				repository: &fake.MockRepositoryClient{
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return &awsecr.ListTagsForResourceOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:  &testARN,
+								RepositoryName: &repoName,
+							}},
+						}, nil
+					},
+					MockPutLifecyclePolicy: func(ctx context.Context, input *awsecr.PutLifecyclePolicyInput, opts []func(*awsecr.Options)) (*awsecr.PutLifecyclePolicyOutput, error) {
+						return &awsecr.PutLifecyclePolicyOutput{}, nil
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					LifecyclePolicy: aws.String(lifecyclePolicy),
+				})),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					LifecyclePolicy: aws.String(lifecyclePolicy),
+				})),
+			},
+		},
+		"PutLifecyclePolicyFailed": {
+			args: args{
+				repository: &fake.MockRepositoryClient{
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return &awsecr.ListTagsForResourceOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:  &testARN,
+								RepositoryName: &repoName,
+							}},
+						}, nil
+					},
+					MockPutLifecyclePolicy: func(ctx context.Context, input *awsecr.PutLifecyclePolicyInput, opts []func(*awsecr.Options)) (*awsecr.PutLifecyclePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					LifecyclePolicy: aws.String(lifecyclePolicy),
+				})),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					LifecyclePolicy: aws.String(lifecyclePolicy),
+				})),
+				err: awsclient.Wrap(errBoom, errPutLifecyclePolicy),
+			},
+		},
 		"SuccessfulImageMutate": {
 			args: args{
 				repository: &fake.MockRepositoryClient{
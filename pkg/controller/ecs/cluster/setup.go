@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs"
+)
+
+const (
+	errPutCapacityProviders = "cannot put capacity providers of Cluster"
+
+	statusActive         = "ACTIVE"
+	statusProvisioning   = "PROVISIONING"
+	statusDeprovisioning = "DEPROVISIONING"
+	statusFailed         = "FAILED"
+	statusInactive       = "INACTIVE"
+)
+
+// SetupCluster adds a controller that reconciles Cluster.
+func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ClusterGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.filterList = filterList
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Cluster{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ClusterGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.ECSAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClustersInput) error {
+	obj.Clusters = []*string{awsclients.String(meta.GetExternalName(cr))}
+	obj.Include = aws.StringSlice([]string{
+		svcsdk.ClusterFieldConfigurations,
+		svcsdk.ClusterFieldSettings,
+		svcsdk.ClusterFieldTags,
+	})
+	return nil
+}
+
+// filterList drops inactive clusters since deleted clusters are still
+// described for a while.
+func filterList(cr *svcapitypes.Cluster, list *svcsdk.DescribeClustersOutput) *svcsdk.DescribeClustersOutput {
+	res := &svcsdk.DescribeClustersOutput{}
+	for _, c := range list.Clusters {
+		if awsclients.StringValue(c.ClusterName) == meta.GetExternalName(cr) &&
+			awsclients.StringValue(c.Status) != statusInactive {
+			res.Clusters = append(res.Clusters, c)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Cluster, resp *svcsdk.DescribeClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Clusters[0].Status) {
+	case statusActive:
+		cr.SetConditions(xpv1.Available())
+	case statusProvisioning:
+		cr.SetConditions(xpv1.Creating())
+	case statusDeprovisioning:
+		cr.SetConditions(xpv1.Deleting())
+	case statusFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Cluster, resp *svcsdk.DescribeClustersOutput) (bool, error) {
+	if !ecs.AreTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Clusters[0].Tags) {
+		return false, nil
+	}
+	observed := GenerateCluster(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateClusterInput) error {
+	obj.ClusterName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.UpdateClusterInput) error {
+	obj.Cluster = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postUpdate updates the capacity providers and the tags of the cluster,
+// which cannot be changed by UpdateCluster.
+func (e *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Cluster, _ *svcsdk.UpdateClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if cr.Spec.ForProvider.CapacityProviders != nil || cr.Spec.ForProvider.DefaultCapacityProviderStrategy != nil {
+		in := GenerateCreateClusterInput(cr)
+		if _, err := e.client.PutClusterCapacityProvidersWithContext(ctx, &svcsdk.PutClusterCapacityProvidersInput{
+			Cluster:                         awsclients.String(meta.GetExternalName(cr)),
+			CapacityProviders:               in.CapacityProviders,
+			DefaultCapacityProviderStrategy: in.DefaultCapacityProviderStrategy,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errPutCapacityProviders)
+		}
+	}
+	return upd, ecs.UpdateTagsForResource(ctx, e.client, cr.Spec.ForProvider.Tags, cr.Status.AtProvider.ClusterARN)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DeleteClusterInput) (bool, error) {
+	obj.Cluster = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
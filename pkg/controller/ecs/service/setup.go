@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs"
+)
+
+const (
+	statusActive   = "ACTIVE"
+	statusDraining = "DRAINING"
+	statusInactive = "INACTIVE"
+)
+
+// SetupService adds a controller that reconciles Service.
+func SetupService(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ServiceGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.filterList = filterList
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ServiceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.ECSAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.DescribeServicesInput) error {
+	obj.Cluster = cr.Spec.ForProvider.Cluster
+	obj.Services = []*string{awsclients.String(meta.GetExternalName(cr))}
+	obj.Include = aws.StringSlice([]string{svcsdk.ServiceFieldTags})
+	return nil
+}
+
+// filterList drops inactive services since deleted services are still
+// described for a while.
+func filterList(cr *svcapitypes.Service, list *svcsdk.DescribeServicesOutput) *svcsdk.DescribeServicesOutput {
+	res := &svcsdk.DescribeServicesOutput{}
+	for _, s := range list.Services {
+		if awsclients.StringValue(s.ServiceName) == meta.GetExternalName(cr) &&
+			awsclients.StringValue(s.Status) != statusInactive {
+			res.Services = append(res.Services, s)
+		}
+	}
+	return res
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Service, resp *svcsdk.DescribeServicesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Services[0].Status) {
+	case statusActive:
+		cr.SetConditions(xpv1.Available())
+	case statusDraining:
+		cr.SetConditions(xpv1.Deleting())
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Service, resp *svcsdk.DescribeServicesOutput) (bool, error) {
+	s := resp.Services[0]
+	if !ecs.AreTagsUpToDate(cr.Spec.ForProvider.Tags, s.Tags) {
+		return false, nil
+	}
+	if cr.Spec.ForProvider.TaskDefinition != nil &&
+		!isTaskDefinitionUpToDate(awsclients.StringValue(cr.Spec.ForProvider.TaskDefinition), awsclients.StringValue(s.TaskDefinition)) {
+		return false, nil
+	}
+	observed := GenerateService(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.ClientToken = cr.Spec.ForProvider.ClientToken
+	observed.Role = cr.Spec.ForProvider.Role
+	observed.CustomServiceParameters = cr.Spec.ForProvider.CustomServiceParameters
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+// isTaskDefinitionUpToDate reports whether the observed task definition ARN
+// matches the desired task definition, which may be given as full ARN, as
+// family:revision or as family only to use the latest active revision.
+func isTaskDefinitionUpToDate(desired, observed string) bool {
+	if desired == observed {
+		return true
+	}
+	familyRevision := observed[strings.LastIndex(observed, "/")+1:]
+	if desired == familyRevision {
+		return true
+	}
+	return !strings.Contains(desired, ":") && strings.HasPrefix(familyRevision, desired+":")
+}
+
+func generateLoadBalancers(lbs []svcapitypes.CustomLoadBalancer) []*svcsdk.LoadBalancer {
+	if lbs == nil {
+		return nil
+	}
+	res := make([]*svcsdk.LoadBalancer, len(lbs))
+	for i, lb := range lbs {
+		res[i] = &svcsdk.LoadBalancer{
+			ContainerName:    lb.ContainerName,
+			ContainerPort:    lb.ContainerPort,
+			LoadBalancerName: lb.LoadBalancerName,
+			TargetGroupArn:   lb.TargetGroupARN,
+		}
+	}
+	return res
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.CreateServiceInput) error {
+	obj.Cluster = cr.Spec.ForProvider.Cluster
+	obj.ServiceName = awsclients.String(meta.GetExternalName(cr))
+	obj.TaskDefinition = cr.Spec.ForProvider.TaskDefinition
+	obj.LoadBalancers = generateLoadBalancers(cr.Spec.ForProvider.LoadBalancers)
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.UpdateServiceInput) error {
+	obj.Cluster = cr.Spec.ForProvider.Cluster
+	obj.Service = awsclients.String(meta.GetExternalName(cr))
+	obj.TaskDefinition = cr.Spec.ForProvider.TaskDefinition
+	return nil
+}
+
+// postUpdate updates the tags of the service, which cannot be changed by
+// UpdateService.
+func (e *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Service, _ *svcsdk.UpdateServiceOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return upd, ecs.UpdateTagsForResource(ctx, e.client, cr.Spec.ForProvider.Tags, cr.Status.AtProvider.ServiceARN)
+}
+
+// preDelete forces the deletion so that a service that still runs tasks does
+// not have to be scaled down to zero first.
+func preDelete(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.DeleteServiceInput) (bool, error) {
+	if awsclients.StringValue(cr.Status.AtProvider.Status) == statusDraining {
+		return true, nil
+	}
+	obj.Cluster = cr.Spec.ForProvider.Cluster
+	obj.Service = awsclients.String(meta.GetExternalName(cr))
+	obj.Force = aws.Bool(true)
+	return false, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const taskDefinitionARN = "arn:aws:ecs:us-east-1:123456789012:task-definition/example-web:3"
+
+func TestIsTaskDefinitionUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired string
+		want    bool
+	}{
+		"ARN":                 {desired: taskDefinitionARN, want: true},
+		"FamilyRevision":      {desired: "example-web:3", want: true},
+		"Family":              {desired: "example-web", want: true},
+		"OtherRevision":       {desired: "example-web:4", want: false},
+		"OtherFamily":         {desired: "example", want: false},
+		"OtherFamilyRevision": {desired: "example:3", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTaskDefinitionUpToDate(tc.desired, taskDefinitionARN)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.DescribeServicesOutput{Services: []*svcsdk.Service{{
+		DesiredCount: awsclients.Int64(2),
+		DeploymentConfiguration: &svcsdk.DeploymentConfiguration{
+			MaximumPercent:        awsclients.Int64(200),
+			MinimumHealthyPercent: awsclients.Int64(100),
+		},
+		LaunchType:     awsclients.String(svcsdk.LaunchTypeFargate),
+		TaskDefinition: awsclients.String(taskDefinitionARN),
+		Tags:           []*svcsdk.Tag{{Key: awsclients.String("team"), Value: awsclients.String("platform")}},
+	}}}
+	tags := []*svcapitypes.Tag{{Key: awsclients.String("team"), Value: awsclients.String("platform")}}
+	cases := map[string]struct {
+		spec svcapitypes.ServiceParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: svcapitypes.ServiceParameters{
+				Region:       "us-east-1",
+				DesiredCount: awsclients.Int64(2),
+				Tags:         tags,
+				CustomServiceParameters: svcapitypes.CustomServiceParameters{
+					TaskDefinition: awsclients.String("example-web"),
+				},
+			},
+			want: true,
+		},
+		"DesiredCountChanged": {
+			spec: svcapitypes.ServiceParameters{
+				Region:       "us-east-1",
+				DesiredCount: awsclients.Int64(3),
+				Tags:         tags,
+			},
+			want: false,
+		},
+		"DeploymentConfigurationChanged": {
+			spec: svcapitypes.ServiceParameters{
+				Region: "us-east-1",
+				DeploymentConfiguration: &svcapitypes.DeploymentConfiguration{
+					MinimumHealthyPercent: awsclients.Int64(50),
+				},
+				Tags: tags,
+			},
+			want: false,
+		},
+		"TaskDefinitionChanged": {
+			spec: svcapitypes.ServiceParameters{
+				Region: "us-east-1",
+				Tags:   tags,
+				CustomServiceParameters: svcapitypes.CustomServiceParameters{
+					TaskDefinition: awsclients.String("example-web:4"),
+				},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			spec: svcapitypes.ServiceParameters{
+				Region: "us-east-1",
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Service{Spec: svcapitypes.ServiceSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
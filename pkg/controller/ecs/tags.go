@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTagsForResource = "cannot list tags"
+	errTagResource         = "cannot tag resource"
+	errUntagResource       = "cannot untag resource"
+)
+
+// AreTagsUpToDate reports whether the current tags of a resource match the
+// spec.
+func AreTagsUpToDate(spec []*svcapitypes.Tag, current []*svcsdk.Tag) bool {
+	add, remove := DiffTags(spec, current)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// UpdateTagsForResource adds and removes tags of the resource with the given
+// ARN until they match the spec.
+func UpdateTagsForResource(ctx context.Context, client ecsiface.ECSAPI, spec []*svcapitypes.Tag, arn *string) error {
+	resp, err := client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{ResourceArn: arn})
+	if err != nil {
+		return errors.Wrap(err, errListTagsForResource)
+	}
+	add, remove := DiffTags(spec, resp.Tags)
+	if len(remove) != 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceArn: arn,
+			TagKeys:     remove,
+		}); err != nil {
+			return errors.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceArn: arn,
+			Tags:        add,
+		}); err != nil {
+			return errors.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+// DiffTags returns the tags that have to be added to and the keys of the tags
+// that have to be removed from a resource. Tags whose value changed are only
+// added since tagging overwrites the value of an existing key.
+func DiffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk.Tag, removeTags []*string) {
+	currentMap := make(map[string]string, len(current))
+	for _, t := range current {
+		currentMap[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	specMap := make(map[string]struct{}, len(spec))
+	for _, t := range spec {
+		key := awsclient.StringValue(t.Key)
+		val := awsclient.StringValue(t.Value)
+		specMap[key] = struct{}{}
+		if currentVal, exists := currentMap[key]; !exists || currentVal != val {
+			addTags = append(addTags, &svcsdk.Tag{
+				Key:   awsclient.String(key),
+				Value: awsclient.String(val),
+			})
+		}
+	}
+	for _, t := range current {
+		key := awsclient.StringValue(t.Key)
+		if _, exists := specMap[key]; !exists {
+			removeTags = append(removeTags, awsclient.String(key))
+		}
+	}
+	return addTags, removeTags
+}
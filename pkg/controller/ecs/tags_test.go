@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecs
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*svcsdk.Tag
+		remove []*string
+	}
+	cases := map[string]struct {
+		spec    []*svcapitypes.Tag
+		current []*svcsdk.Tag
+		want    want
+	}{
+		"UpToDate": {
+			spec:    []*svcapitypes.Tag{{Key: awsclient.String("team"), Value: awsclient.String("platform")}},
+			current: []*svcsdk.Tag{{Key: awsclient.String("team"), Value: awsclient.String("platform")}},
+		},
+		"AddAndRemove": {
+			spec:    []*svcapitypes.Tag{{Key: awsclient.String("team"), Value: awsclient.String("platform")}},
+			current: []*svcsdk.Tag{{Key: awsclient.String("owner"), Value: awsclient.String("someone")}},
+			want: want{
+				add:    []*svcsdk.Tag{{Key: awsclient.String("team"), Value: awsclient.String("platform")}},
+				remove: []*string{awsclient.String("owner")},
+			},
+		},
+		"ChangedValue": {
+			spec:    []*svcapitypes.Tag{{Key: awsclient.String("team"), Value: awsclient.String("platform")}},
+			current: []*svcsdk.Tag{{Key: awsclient.String("team"), Value: awsclient.String("web")}},
+			want: want{
+				add: []*svcsdk.Tag{{Key: awsclient.String("team"), Value: awsclient.String("platform")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
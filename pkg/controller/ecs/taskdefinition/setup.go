@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taskdefinition
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ecs"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ecs/ecsiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ecs/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/ecs"
+)
+
+// SetupTaskDefinition adds a controller that reconciles TaskDefinition.
+func SetupTaskDefinition(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.TaskDefinitionGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.update = h.update
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.TaskDefinition{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.TaskDefinitionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.ECSAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.TaskDefinition, obj *svcsdk.DescribeTaskDefinitionInput) error {
+	obj.TaskDefinition = awsclients.String(meta.GetExternalName(cr))
+	obj.Include = aws.StringSlice([]string{svcsdk.TaskDefinitionFieldTags})
+	return nil
+}
+
+// postObserve reports deregistered revisions as missing since they are still
+// described but cannot be used anymore.
+func postObserve(_ context.Context, cr *svcapitypes.TaskDefinition, resp *svcsdk.DescribeTaskDefinitionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if awsclients.StringValue(resp.TaskDefinition.Status) == svcsdk.TaskDefinitionStatusInactive {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate only compares the tags since a task definition revision cannot
+// be changed after it is registered.
+func isUpToDate(cr *svcapitypes.TaskDefinition, resp *svcsdk.DescribeTaskDefinitionOutput) (bool, error) {
+	return ecs.AreTagsUpToDate(cr.Spec.ForProvider.Tags, resp.Tags), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.TaskDefinition, obj *svcsdk.RegisterTaskDefinitionInput) error {
+	obj.ExecutionRoleArn = cr.Spec.ForProvider.ExecutionRoleARN
+	obj.TaskRoleArn = cr.Spec.ForProvider.TaskRoleARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.TaskDefinition, obj *svcsdk.RegisterTaskDefinitionOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.TaskDefinition.TaskDefinitionArn))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.TaskDefinition)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, ecs.UpdateTagsForResource(ctx, e.client, cr.Spec.ForProvider.Tags, awsclients.String(meta.GetExternalName(cr)))
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.TaskDefinition, obj *svcsdk.DeregisterTaskDefinitionInput) (bool, error) {
+	obj.TaskDefinition = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
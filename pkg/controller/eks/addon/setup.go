@@ -130,6 +130,7 @@ func (h *hooks) isUpToDate(cr *v1alpha1.Addon, resp *awseks.DescribeAddonOutput)
 
 func preUpdate(_ context.Context, cr *v1alpha1.Addon, obj *awseks.UpdateAddonInput) error {
 	obj.ClusterName = cr.Spec.ForProvider.ClusterName
+	obj.ServiceAccountRoleArn = cr.Spec.ForProvider.ServiceAccountRoleARN
 	return nil
 }
 
@@ -172,6 +173,7 @@ func (h *hooks) postUpdate(ctx context.Context, cr *v1alpha1.Addon, resp *awseks
 
 func preCreate(_ context.Context, cr *v1alpha1.Addon, obj *awseks.CreateAddonInput) error {
 	obj.ClusterName = cr.Spec.ForProvider.ClusterName
+	obj.ServiceAccountRoleArn = cr.Spec.ForProvider.ServiceAccountRoleARN
 	return nil
 }
 
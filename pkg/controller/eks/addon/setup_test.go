@@ -184,11 +184,14 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Available()),
 					withSpec(
 						v1alpha1.AddonParameters{
-							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							CustomAddonParameters: v1alpha1.CustomAddonParameters{
+								ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							},
 						},
 					),
 					withStatus(v1alpha1.AddonObservation{
-						Status: awsclient.String(awseks.AddonStatusActive),
+						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						Status:                awsclient.String(awseks.AddonStatusActive),
 					}),
 				),
 				result: managed.ExternalObservation{
@@ -254,12 +257,12 @@ func TestCreate(t *testing.T) {
 				}),
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -268,16 +271,19 @@ func TestCreate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 					withStatus(
-						v1alpha1.AddonObservation{AddonARN: &testExternalName},
+						v1alpha1.AddonObservation{
+							AddonARN:              &testExternalName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						},
 					),
 					withConditions(xpv1.Creating()),
 				),
@@ -302,12 +308,12 @@ func TestCreate(t *testing.T) {
 				}),
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -315,12 +321,12 @@ func TestCreate(t *testing.T) {
 			want: want{
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 					withConditions(xpv1.Creating()),
@@ -411,12 +417,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -431,12 +437,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -468,12 +474,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -482,12 +488,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -522,12 +528,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -536,12 +542,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 					}),
 				),
@@ -592,12 +598,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -612,12 +618,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -682,12 +688,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -702,12 +708,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ClusterName:           &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
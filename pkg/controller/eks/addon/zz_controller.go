@@ -175,9 +175,9 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 		cr.Status.AtProvider.ModifiedAt = nil
 	}
 	if resp.Addon.ServiceAccountRoleArn != nil {
-		cr.Spec.ForProvider.ServiceAccountRoleARN = resp.Addon.ServiceAccountRoleArn
+		cr.Status.AtProvider.ServiceAccountRoleARN = resp.Addon.ServiceAccountRoleArn
 	} else {
-		cr.Spec.ForProvider.ServiceAccountRoleARN = nil
+		cr.Status.AtProvider.ServiceAccountRoleARN = nil
 	}
 	if resp.Addon.Status != nil {
 		cr.Status.AtProvider.Status = resp.Addon.Status
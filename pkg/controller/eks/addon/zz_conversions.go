@@ -105,9 +105,9 @@ func GenerateAddon(resp *svcsdk.DescribeAddonOutput) *svcapitypes.Addon {
 		cr.Status.AtProvider.ModifiedAt = nil
 	}
 	if resp.Addon.ServiceAccountRoleArn != nil {
-		cr.Spec.ForProvider.ServiceAccountRoleARN = resp.Addon.ServiceAccountRoleArn
+		cr.Status.AtProvider.ServiceAccountRoleARN = resp.Addon.ServiceAccountRoleArn
 	} else {
-		cr.Spec.ForProvider.ServiceAccountRoleARN = nil
+		cr.Status.AtProvider.ServiceAccountRoleARN = nil
 	}
 	if resp.Addon.Status != nil {
 		cr.Status.AtProvider.Status = resp.Addon.Status
@@ -142,17 +142,14 @@ func GenerateCreateAddonInput(cr *svcapitypes.Addon) *svcsdk.CreateAddonInput {
 	if cr.Spec.ForProvider.ResolveConflicts != nil {
 		res.SetResolveConflicts(*cr.Spec.ForProvider.ResolveConflicts)
 	}
-	if cr.Spec.ForProvider.ServiceAccountRoleARN != nil {
-		res.SetServiceAccountRoleArn(*cr.Spec.ForProvider.ServiceAccountRoleARN)
-	}
 	if cr.Spec.ForProvider.Tags != nil {
-		f4 := map[string]*string{}
-		for f4key, f4valiter := range cr.Spec.ForProvider.Tags {
-			var f4val string
-			f4val = *f4valiter
-			f4[f4key] = &f4val
+		f3 := map[string]*string{}
+		for f3key, f3valiter := range cr.Spec.ForProvider.Tags {
+			var f3val string
+			f3val = *f3valiter
+			f3[f3key] = &f3val
 		}
-		res.SetTags(f4)
+		res.SetTags(f3)
 	}
 
 	return res
@@ -171,8 +168,8 @@ func GenerateUpdateAddonInput(cr *svcapitypes.Addon) *svcsdk.UpdateAddonInput {
 	if cr.Spec.ForProvider.ResolveConflicts != nil {
 		res.SetResolveConflicts(*cr.Spec.ForProvider.ResolveConflicts)
 	}
-	if cr.Spec.ForProvider.ServiceAccountRoleARN != nil {
-		res.SetServiceAccountRoleArn(*cr.Spec.ForProvider.ServiceAccountRoleARN)
+	if cr.Status.AtProvider.ServiceAccountRoleARN != nil {
+		res.SetServiceAccountRoleArn(*cr.Status.AtProvider.ServiceAccountRoleARN)
 	}
 
 	return res
@@ -36,6 +36,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
 )
 
 const (
@@ -56,24 +57,34 @@ const (
 func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
 
+	// NOTE: The token embedded in the kubeconfig of the connection secret is
+	// only accepted for a limited time, so it has to be renewed more often than
+	// that regardless of the configured poll interval.
+	poll := o.PollInterval
+	if poll > eks.TokenRefreshInterval {
+		poll = eks.TokenRefreshInterval
+	}
+	hints := requeue.NewHints()
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.Cluster{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(requeue.NewReconciler(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient, hints: hints}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
+			managed.WithPollInterval(poll),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))), hints))
 }
 
 type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) eks.Client
 	newSTSClientFn func(config aws.Config) eks.STSClient
+	hints          *requeue.Hints
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -85,13 +96,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube, hints: c.hints}, nil
 }
 
 type external struct {
 	client eks.Client
 	sts    eks.STSClient
 	kube   client.Client
+	hints  *requeue.Hints
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -124,6 +136,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if d, ok := eks.ClusterOperationDuration(cr.Status.AtProvider.Status); ok {
+		e.hints.InProgress(cr, requeue.Operation{Name: string(cr.Status.AtProvider.Status), Expected: d})
+	}
 	upToDate, err := eks.IsUpToDate(&cr.Spec.ForProvider, rsp.Cluster)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
@@ -132,7 +147,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts),
+		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts, &cr.Spec.ForProvider),
 	}, nil
 }
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -45,6 +46,7 @@ const (
 	errAddTagsFailed        = "cannot add tags to EKS fargate profile"
 	errDeleteFailed         = "cannot delete EKS fargate profile"
 	errDescribeFailed       = "cannot describe EKS fargate profile"
+	errSelectorsImmutable   = "selectors of an EKS fargate profile cannot be changed, set recreateOnSelectorChange to replace the fargate profile"
 )
 
 // SetupFargateProfile adds a controller that reconciles FargateProfiles.
@@ -147,6 +149,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil || rsp.FargateProfile == nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeFailed)
 	}
+	if !eks.AreFargateProfileSelectorsUpToDate(cr.Spec.ForProvider, rsp.FargateProfile) {
+		return managed.ExternalUpdate{}, e.replace(ctx, cr, rsp.FargateProfile)
+	}
 	add, remove := awsclient.DiffTags(cr.Spec.ForProvider.Tags, rsp.FargateProfile.Tags)
 	if len(remove) != 0 {
 		if _, err := e.client.UntagResource(ctx, &awseks.UntagResourceInput{ResourceArn: rsp.FargateProfile.FargateProfileArn, TagKeys: remove}); err != nil {
@@ -161,6 +166,19 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalUpdate{}, nil
 }
 
+// replace deletes the fargate profile so that it is created again with the
+// desired selectors once the deletion is done.
+func (e *external) replace(ctx context.Context, cr *v1beta1.FargateProfile, fp *ekstypes.FargateProfile) error {
+	if !awsclient.BoolValue(cr.Spec.ForProvider.RecreateOnSelectorChange) {
+		return errors.New(errSelectorsImmutable)
+	}
+	if fp.Status == ekstypes.FargateProfileStatusDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteFargateProfile(ctx, &awseks.DeleteFargateProfileInput{FargateProfileName: awsclient.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
+	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.FargateProfile)
 	if !ok {
@@ -72,6 +72,18 @@ func withSubnets(s []string) fargateProfileModifier {
 	return func(r *v1beta1.FargateProfile) { r.Spec.ForProvider.Subnets = s }
 }
 
+func withSelectors(namespaces ...string) fargateProfileModifier {
+	return func(r *v1beta1.FargateProfile) {
+		for i := range namespaces {
+			r.Spec.ForProvider.Selectors = append(r.Spec.ForProvider.Selectors, v1beta1.FargateProfileSelector{Namespace: &namespaces[i]})
+		}
+	}
+}
+
+func withRecreateOnSelectorChange(b bool) fargateProfileModifier {
+	return func(r *v1beta1.FargateProfile) { r.Spec.ForProvider.RecreateOnSelectorChange = &b }
+}
+
 func withStatus(s v1beta1.FargateProfileStatusType) fargateProfileModifier {
 	return func(r *v1beta1.FargateProfile) { r.Status.AtProvider.Status = s }
 }
@@ -388,6 +400,62 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errAddTagsFailed),
 			},
 		},
+		"SelectorsChangedWithoutRecreate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						return &awseks.DescribeFargateProfileOutput{
+							FargateProfile: &awsekstypes.FargateProfile{
+								Selectors: []awsekstypes.FargateProfileSelector{{Namespace: awsclient.String("default")}},
+							},
+						}, nil
+					},
+				},
+				cr: fargateProfile(withSelectors("other")),
+			},
+			want: want{
+				cr:  fargateProfile(withSelectors("other")),
+				err: errors.New(errSelectorsImmutable),
+			},
+		},
+		"SelectorsChangedRecreate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						return &awseks.DescribeFargateProfileOutput{
+							FargateProfile: &awsekstypes.FargateProfile{
+								Selectors: []awsekstypes.FargateProfileSelector{{Namespace: awsclient.String("default")}},
+							},
+						}, nil
+					},
+					MockDeleteFargateProfile: func(ctx context.Context, input *awseks.DeleteFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DeleteFargateProfileOutput, error) {
+						return &awseks.DeleteFargateProfileOutput{}, nil
+					},
+				},
+				cr: fargateProfile(withSelectors("other"), withRecreateOnSelectorChange(true)),
+			},
+			want: want{
+				cr: fargateProfile(withSelectors("other"), withRecreateOnSelectorChange(true)),
+			},
+		},
+		"SelectorsChangedAlreadyDeleting": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						return &awseks.DescribeFargateProfileOutput{
+							FargateProfile: &awsekstypes.FargateProfile{
+								Selectors: []awsekstypes.FargateProfileSelector{{Namespace: awsclient.String("default")}},
+								Status:    awsekstypes.FargateProfileStatusDeleting,
+							},
+						}, nil
+					},
+				},
+				cr: fargateProfile(withSelectors("other"), withRecreateOnSelectorChange(true)),
+			},
+			want: want{
+				cr: fargateProfile(withSelectors("other"), withRecreateOnSelectorChange(true)),
+			},
+		},
 	}
 
 	for name, tc := range cases {
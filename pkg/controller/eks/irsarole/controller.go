@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package irsarole
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+const (
+	errNotIRSARole       = "managed resource is not an IRSARole custom resource"
+	errDescribeCluster   = "cannot describe EKS cluster"
+	errTrustPolicy       = "cannot generate trust policy"
+	errGetRole           = "cannot get IAM role"
+	errCreateRole        = "cannot create IAM role"
+	errUpdateRole        = "cannot update IAM role"
+	errUpdateTrustPolicy = "cannot update trust policy of IAM role"
+	errDeleteRole        = "cannot delete IAM role"
+	errUpToDate          = "cannot check whether IAM role is up to date"
+	errListPolicies      = "cannot list policies attached to IAM role"
+	errAttachPolicy      = "cannot attach policy to IAM role"
+	errDetachPolicy      = "cannot detach policy from IAM role"
+)
+
+// SetupIRSARole adds a controller that reconciles IRSARoles.
+func SetupIRSARole(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.IRSARoleKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.IRSARole{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.IRSARoleGroupVersionKind),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient, newIAMClientFn: eks.NewIRSARoleClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube           client.Client
+	newEKSClientFn func(config aws.Config) eks.Client
+	newIAMClientFn func(config aws.Config) eks.IRSARoleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.IRSARole)
+	if !ok {
+		return nil, errors.New(errNotIRSARole)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{eks: c.newEKSClientFn(*cfg), iam: c.newIAMClientFn(*cfg)}, nil
+}
+
+type external struct {
+	eks eks.Client
+	iam eks.IRSARoleClient
+}
+
+// trustPolicy returns the ARN of the OIDC provider of the cluster and the
+// trust policy the role should have.
+func (e *external) trustPolicy(ctx context.Context, cr *manualv1alpha1.IRSARole) (string, string, error) {
+	rsp, err := e.eks.DescribeCluster(ctx, &awseks.DescribeClusterInput{Name: aws.String(cr.Spec.ForProvider.ClusterName)})
+	if err != nil {
+		return "", "", awsclient.Wrap(err, errDescribeCluster)
+	}
+	if rsp.Cluster == nil {
+		rsp.Cluster = &ekstypes.Cluster{}
+	}
+	provider, policy, err := eks.GenerateIRSATrustPolicy(rsp.Cluster, cr.Spec.ForProvider.Namespace, cr.Spec.ForProvider.ServiceAccountName)
+	return provider, policy, errors.Wrap(err, errTrustPolicy)
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*manualv1alpha1.IRSARole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIRSARole)
+	}
+	rsp, err := e.iam.GetRole(ctx, &awsiam.GetRoleInput{RoleName: aws.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGetRole)
+	}
+	// The cluster may already be gone while the role is being deleted, so we
+	// don't look up its trust policy anymore.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+	provider, policy, err := e.trustPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = manualv1alpha1.IRSARoleObservation{
+		ARN:             aws.ToString(rsp.Role.Arn),
+		RoleID:          aws.ToString(rsp.Role.RoleId),
+		OIDCProviderARN: provider,
+	}
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := eks.IsIRSARoleUpToDate(cr.Spec.ForProvider, rsp.Role, policy)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
+	}
+	if upToDate {
+		attached, err := eks.GetAttachedPolicies(ctx, e.iam, meta.GetExternalName(cr))
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errListPolicies)
+		}
+		attach, detach := eks.DiffPolicyARNs(cr.Spec.ForProvider.PolicyARNs, attached)
+		upToDate = len(attach) == 0 && len(detach) == 0
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*manualv1alpha1.IRSARole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIRSARole)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, policy, err := e.trustPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.iam.CreateRole(ctx, eks.GenerateCreateIRSARoleInput(meta.GetExternalName(cr), cr.Spec.ForProvider, policy))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateRole)
+	}
+	return managed.ExternalCreation{}, e.syncPolicies(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*manualv1alpha1.IRSARole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIRSARole)
+	}
+	_, policy, err := e.trustPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if _, err := e.iam.UpdateRole(ctx, &awsiam.UpdateRoleInput{
+		RoleName:           aws.String(meta.GetExternalName(cr)),
+		Description:        cr.Spec.ForProvider.Description,
+		MaxSessionDuration: cr.Spec.ForProvider.MaxSessionDuration,
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateRole)
+	}
+	if _, err := e.iam.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
+		RoleName:       aws.String(meta.GetExternalName(cr)),
+		PolicyDocument: aws.String(policy),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateTrustPolicy)
+	}
+	return managed.ExternalUpdate{}, e.syncPolicies(ctx, cr)
+}
+
+// syncPolicies attaches the desired policies to the role and detaches all
+// others.
+func (e *external) syncPolicies(ctx context.Context, cr *manualv1alpha1.IRSARole) error {
+	attached, err := eks.GetAttachedPolicies(ctx, e.iam, meta.GetExternalName(cr))
+	if err != nil {
+		return awsclient.Wrap(err, errListPolicies)
+	}
+	attach, detach := eks.DiffPolicyARNs(cr.Spec.ForProvider.PolicyARNs, attached)
+	for _, a := range detach {
+		if _, err := e.iam.DetachRolePolicy(ctx, &awsiam.DetachRolePolicyInput{RoleName: aws.String(meta.GetExternalName(cr)), PolicyArn: aws.String(a)}); err != nil {
+			return awsclient.Wrap(err, errDetachPolicy)
+		}
+	}
+	for _, a := range attach {
+		if _, err := e.iam.AttachRolePolicy(ctx, &awsiam.AttachRolePolicyInput{RoleName: aws.String(meta.GetExternalName(cr)), PolicyArn: aws.String(a)}); err != nil {
+			return awsclient.Wrap(err, errAttachPolicy)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.IRSARole)
+	if !ok {
+		return errors.New(errNotIRSARole)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// A role can only be deleted once no policies are attached to it.
+	attached, err := eks.GetAttachedPolicies(ctx, e.iam, meta.GetExternalName(cr))
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errListPolicies)
+	}
+	for _, p := range attached {
+		if _, err := e.iam.DetachRolePolicy(ctx, &awsiam.DetachRolePolicyInput{RoleName: aws.String(meta.GetExternalName(cr)), PolicyArn: p.PolicyArn}); err != nil {
+			return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDetachPolicy)
+		}
+	}
+	_, err = e.iam.DeleteRole(ctx, &awsiam.DeleteRoleInput{RoleName: aws.String(meta.GetExternalName(cr))})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDeleteRole)
+}
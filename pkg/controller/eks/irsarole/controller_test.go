@@ -0,0 +1,605 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package irsarole
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/eks/fake"
+	iamfake "github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	roleName     = "some-role"
+	roleARN      = "arn:aws:iam::123456789012:role/some-role"
+	clusterName  = "some-cluster"
+	clusterARN   = "arn:aws:eks:us-east-1:123456789012:cluster/some-cluster"
+	issuer       = "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	providerARN  = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE"
+	policyARN    = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+	oldPolicyARN = "arn:aws:iam::aws:policy/AdministratorAccess"
+	errBoom      = errors.New("boom")
+
+	deletionTimestamp = metav1.NewTime(time.Unix(1, 0))
+)
+
+type mockIAMClient struct {
+	*iamfake.MockRoleClient
+	*iamfake.MockRolePolicyAttachmentClient
+}
+
+type args struct {
+	eks eks.Client
+	iam eks.IRSARoleClient
+	cr  *manualv1alpha1.IRSARole
+}
+
+type irsaRoleModifier func(*manualv1alpha1.IRSARole)
+
+func withConditions(c ...xpv1.Condition) irsaRoleModifier {
+	return func(r *manualv1alpha1.IRSARole) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPolicyARNs(a ...string) irsaRoleModifier {
+	return func(r *manualv1alpha1.IRSARole) { r.Spec.ForProvider.PolicyARNs = a }
+}
+
+func withObservation(o manualv1alpha1.IRSARoleObservation) irsaRoleModifier {
+	return func(r *manualv1alpha1.IRSARole) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() irsaRoleModifier {
+	return func(r *manualv1alpha1.IRSARole) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func irsaRole(m ...irsaRoleModifier) *manualv1alpha1.IRSARole {
+	cr := &manualv1alpha1.IRSARole{
+		Spec: manualv1alpha1.IRSARoleSpec{
+			ForProvider: manualv1alpha1.IRSARoleParameters{
+				ClusterName:        clusterName,
+				Namespace:          "default",
+				ServiceAccountName: "app",
+			},
+		},
+	}
+	meta.SetExternalName(cr, roleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func cluster() *awseks.DescribeClusterOutput {
+	return &awseks.DescribeClusterOutput{
+		Cluster: &awsekstypes.Cluster{
+			Arn: aws.String(clusterARN),
+			Identity: &awsekstypes.Identity{
+				Oidc: &awsekstypes.OIDC{Issuer: aws.String(issuer)},
+			},
+		},
+	}
+}
+
+func trustPolicy() string {
+	_, p, _ := eks.GenerateIRSATrustPolicy(cluster().Cluster, "default", "app")
+	return p
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IRSARole
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return nil, &awsiamtypes.NoSuchEntityException{}
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr: irsaRole(),
+			},
+		},
+		"FailedGetRole": {
+			args: args{
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr:  irsaRole(),
+				err: awsclient.Wrap(errBoom, errGetRole),
+			},
+		},
+		"FailedDescribeCluster": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{Role: &awsiamtypes.Role{}}, nil
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr:  irsaRole(),
+				err: awsclient.Wrap(errBoom, errDescribeCluster),
+			},
+		},
+		"DeletedWithoutCluster": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{Role: &awsiamtypes.Role{}}, nil
+						},
+					},
+				},
+				cr: irsaRole(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: irsaRole(withDeletionTimestamp()),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UpToDateAcrossPages": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return cluster(), nil
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{Role: &awsiamtypes.Role{
+								Arn:                      aws.String(roleARN),
+								RoleId:                   aws.String("id"),
+								AssumeRolePolicyDocument: aws.String(trustPolicy()),
+							}}, nil
+						},
+					},
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							if input.Marker == nil {
+								return &awsiam.ListAttachedRolePoliciesOutput{
+									AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(policyARN)}},
+									Marker:           aws.String("next"),
+								}, nil
+							}
+							return &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(oldPolicyARN)}},
+							}, nil
+						},
+					},
+				},
+				cr: irsaRole(withPolicyARNs(policyARN, oldPolicyARN)),
+			},
+			want: want{
+				cr: irsaRole(
+					withPolicyARNs(policyARN, oldPolicyARN),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.IRSARoleObservation{
+						ARN:             roleARN,
+						RoleID:          "id",
+						OIDCProviderARN: providerARN,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PolicyOnLaterPageNotDesired": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return cluster(), nil
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+							return &awsiam.GetRoleOutput{Role: &awsiamtypes.Role{
+								Arn:                      aws.String(roleARN),
+								RoleId:                   aws.String("id"),
+								AssumeRolePolicyDocument: aws.String(trustPolicy()),
+							}}, nil
+						},
+					},
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							if input.Marker == nil {
+								return &awsiam.ListAttachedRolePoliciesOutput{
+									AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(policyARN)}},
+									Marker:           aws.String("next"),
+								}, nil
+							}
+							return &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(oldPolicyARN)}},
+							}, nil
+						},
+					},
+				},
+				cr: irsaRole(withPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: irsaRole(
+					withPolicyARNs(policyARN),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.IRSARoleObservation{
+						ARN:             roleARN,
+						RoleID:          "id",
+						OIDCProviderARN: providerARN,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{eks: tc.eks, iam: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IRSARole
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return cluster(), nil
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockCreateRole: func(ctx context.Context, input *awsiam.CreateRoleInput, opts []func(*awsiam.Options)) (*awsiam.CreateRoleOutput, error) {
+							if diff := cmp.Diff(trustPolicy(), aws.ToString(input.AssumeRolePolicyDocument)); diff != "" {
+								t.Errorf("r: -want, +got:\n%s", diff)
+							}
+							return &awsiam.CreateRoleOutput{}, nil
+						},
+					},
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							return &awsiam.ListAttachedRolePoliciesOutput{}, nil
+						},
+						MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+							return &awsiam.AttachRolePolicyOutput{}, nil
+						},
+					},
+				},
+				cr: irsaRole(withPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: irsaRole(withPolicyARNs(policyARN), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedDescribeCluster": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr:  irsaRole(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errDescribeCluster),
+			},
+		},
+		"FailedCreate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return cluster(), nil
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockCreateRole: func(ctx context.Context, input *awsiam.CreateRoleInput, opts []func(*awsiam.Options)) (*awsiam.CreateRoleOutput, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr:  irsaRole(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{eks: tc.eks, iam: tc.iam}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IRSARole
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return cluster(), nil
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockUpdateRole: func(ctx context.Context, input *awsiam.UpdateRoleInput, opts []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+							return &awsiam.UpdateRoleOutput{}, nil
+						},
+						MockUpdateAssumeRolePolicy: func(ctx context.Context, input *awsiam.UpdateAssumeRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAssumeRolePolicyOutput, error) {
+							return &awsiam.UpdateAssumeRolePolicyOutput{}, nil
+						},
+					},
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							return &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(oldPolicyARN)}},
+							}, nil
+						},
+						MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+							if diff := cmp.Diff(oldPolicyARN, aws.ToString(input.PolicyArn)); diff != "" {
+								t.Errorf("r: -want, +got:\n%s", diff)
+							}
+							return &awsiam.DetachRolePolicyOutput{}, nil
+						},
+						MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+							if diff := cmp.Diff(policyARN, aws.ToString(input.PolicyArn)); diff != "" {
+								t.Errorf("r: -want, +got:\n%s", diff)
+							}
+							return &awsiam.AttachRolePolicyOutput{}, nil
+						},
+					},
+				},
+				cr: irsaRole(withPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: irsaRole(withPolicyARNs(policyARN)),
+			},
+		},
+		"FailedUpdateTrustPolicy": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return cluster(), nil
+					},
+				},
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockUpdateRole: func(ctx context.Context, input *awsiam.UpdateRoleInput, opts []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+							return &awsiam.UpdateRoleOutput{}, nil
+						},
+						MockUpdateAssumeRolePolicy: func(ctx context.Context, input *awsiam.UpdateAssumeRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAssumeRolePolicyOutput, error) {
+							return nil, errBoom
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr:  irsaRole(),
+				err: awsclient.Wrap(errBoom, errUpdateTrustPolicy),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{eks: tc.eks, iam: tc.iam}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IRSARole
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DetachesAllPagesWithoutCluster": {
+			args: args{
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockDeleteRole: func(ctx context.Context, input *awsiam.DeleteRoleInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRoleOutput, error) {
+							return &awsiam.DeleteRoleOutput{}, nil
+						},
+					},
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							if input.Marker == nil {
+								return &awsiam.ListAttachedRolePoliciesOutput{
+									AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(policyARN)}},
+									Marker:           aws.String("next"),
+								}, nil
+							}
+							return &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: aws.String(oldPolicyARN)}},
+							}, nil
+						},
+						MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+							return &awsiam.DetachRolePolicyOutput{}, nil
+						},
+					},
+				},
+				cr: irsaRole(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: irsaRole(withDeletionTimestamp(), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				iam: &mockIAMClient{
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							return nil, &awsiamtypes.NoSuchEntityException{}
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr: irsaRole(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedDelete": {
+			args: args{
+				iam: &mockIAMClient{
+					MockRoleClient: &iamfake.MockRoleClient{
+						MockDeleteRole: func(ctx context.Context, input *awsiam.DeleteRoleInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRoleOutput, error) {
+							return nil, errBoom
+						},
+					},
+					MockRolePolicyAttachmentClient: &iamfake.MockRolePolicyAttachmentClient{
+						MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+							return &awsiam.ListAttachedRolePoliciesOutput{}, nil
+						},
+					},
+				},
+				cr: irsaRole(),
+			},
+			want: want{
+				cr:  irsaRole(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{eks: tc.eks, iam: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
 )
 
 const (
@@ -53,24 +54,26 @@ const (
 // SetupNodeGroup adds a controller that reconciles NodeGroups.
 func SetupNodeGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(manualv1alpha1.NodeGroupKind)
+	hints := requeue.NewHints()
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&manualv1alpha1.NodeGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(requeue.NewReconciler(managed.NewReconciler(mgr,
 			resource.ManagedKind(manualv1alpha1.NodeGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient, hints: hints}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))), hints))
 }
 
 type connector struct {
 	kube           client.Client
 	newEKSClientFn func(config aws.Config) eks.Client
+	hints          *requeue.Hints
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -82,12 +85,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newEKSClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newEKSClientFn(*cfg), kube: c.kube, hints: c.hints}, nil
 }
 
 type external struct {
 	client eks.Client
 	kube   client.Client
+	hints  *requeue.Hints
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -122,6 +126,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
+	if d, ok := eks.NodeGroupOperationDuration(cr.Status.AtProvider.Status); ok {
+		e.hints.InProgress(cr, requeue.Operation{Name: string(cr.Status.AtProvider.Status), Expected: d})
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -169,11 +176,8 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errAddTagsFailed)
 		}
 	}
-	if !reflect.DeepEqual(rsp.Nodegroup.Version, cr.Spec.ForProvider.Version) {
-		_, err := e.client.UpdateNodegroupVersion(ctx, &awseks.UpdateNodegroupVersionInput{
-			ClusterName:   &cr.Spec.ForProvider.ClusterName,
-			NodegroupName: awsclient.String(meta.GetExternalName(cr)),
-			Version:       cr.Spec.ForProvider.Version})
+	if !eks.IsNodeGroupVersionUpToDate(&cr.Spec.ForProvider, rsp.Nodegroup) {
+		_, err := e.client.UpdateNodegroupVersion(ctx, eks.GenerateUpdateNodeGroupVersionInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
 	}
 	_, err = e.client.UpdateNodegroupConfig(ctx, eks.GenerateUpdateNodeGroupConfigInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, rsp.Nodegroup))
@@ -18,9 +18,8 @@ package cacheparametergroup
 
 import (
 	"context"
-
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
+	"sort"
+	"strings"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
@@ -41,7 +40,8 @@ import (
 // SetupCacheParameterGroup adds a controller that reconciles a CacheParameterGroup.
 func SetupCacheParameterGroup(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.CacheParameterGroupKind)
-	opts := []option{setupExternal}
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+	opts := []option{setupExternal(recorder)}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -52,23 +52,40 @@ func SetupCacheParameterGroup(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(recorder)))
 }
 
-func setupExternal(e *external) {
-	e.preObserve = preObserve
-	e.postObserve = postObserve
-	h := &hooks{client: e.client, kube: e.kube}
-	e.isUpToDate = h.isUpToDate
-	e.preUpdate = preUpdate
-	e.postUpdate = h.postUpdate
-	e.preCreate = preCreate
-	e.preDelete = preDelete
+func setupExternal(recorder event.Recorder) option {
+	return func(e *external) {
+		e.preObserve = preObserve
+		e.postObserve = postObserve
+		h := &hooks{client: e.client, kube: e.kube, recorder: recorder}
+		e.isUpToDate = h.isUpToDate
+		e.preUpdate = h.preUpdate
+		e.postUpdate = h.postUpdate
+		e.preCreate = preCreate
+		e.preDelete = preDelete
+	}
 }
 
+const (
+	// reasonCorrectedParameters is the event reason used when drifted
+	// parameter values have been corrected.
+	reasonCorrectedParameters event.Reason = "CorrectedParameters"
+
+	// maxParametersPerModify is the maximum number of parameters that can be
+	// modified with a single ModifyCacheParameterGroup call.
+	maxParametersPerModify = 20
+)
+
 type hooks struct {
-	client elasticacheiface.ElastiCacheAPI
-	kube   client.Client
+	client   elasticacheiface.ElastiCacheAPI
+	kube     client.Client
+	recorder event.Recorder
+
+	// corrected holds the names of the parameters modified by the current
+	// update so that they can be reported once it succeeded.
+	corrected []string
 }
 
 func preObserve(_ context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcsdk.DescribeCacheParameterGroupsInput) error {
@@ -86,49 +103,63 @@ func postObserve(_ context.Context, cr *svcapitypes.CacheParameterGroup, resp *s
 }
 
 func (e *hooks) isUpToDate(cr *svcapitypes.CacheParameterGroup, resp *svcsdk.DescribeCacheParameterGroupsOutput) (bool, error) {
-	ctx := context.TODO()
+	drifted, err := e.driftedParameters(context.TODO(), cr)
+	if err != nil {
+		return false, err
+	}
+	return len(drifted) == 0, nil
+}
 
+// driftedParameters returns the desired parameters whose values differ from
+// the ones reported by DescribeCacheParameters, sorted by name. Parameters
+// that are not part of the spec are not considered.
+func (e *hooks) driftedParameters(ctx context.Context, cr *svcapitypes.CacheParameterGroup) ([]*svcsdk.ParameterNameValue, error) {
 	input := &svcsdk.DescribeCacheParametersInput{
 		CacheParameterGroupName: awsclient.String(meta.GetExternalName(cr)),
 	}
-	var results []*svcsdk.Parameter
+	observed := map[string]string{}
 	err := e.client.DescribeCacheParametersPagesWithContext(ctx, input, func(page *svcsdk.DescribeCacheParametersOutput, lastPage bool) bool {
-		results = append(results, page.Parameters...)
+		for _, p := range page.Parameters {
+			observed[awsclient.StringValue(p.ParameterName)] = awsclient.StringValue(p.ParameterValue)
+		}
 		return !lastPage
 	})
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	var observed []svcapitypes.ParameterNameValue
-	for _, v := range results {
-		if svcapitypes.SourceType(awsclient.StringValue(v.Source)) == svcapitypes.SourceType_user {
-			observed = append(observed, svcapitypes.ParameterNameValue{
-				ParameterName:  v.ParameterName,
-				ParameterValue: v.ParameterValue,
-			})
+	var drifted []*svcsdk.ParameterNameValue
+	for _, p := range cr.Spec.ForProvider.ParameterNameValues {
+		if v, ok := observed[awsclient.StringValue(p.ParameterName)]; ok && v == awsclient.StringValue(p.ParameterValue) {
+			continue
 		}
+		drifted = append(drifted, &svcsdk.ParameterNameValue{
+			ParameterName:  p.ParameterName,
+			ParameterValue: p.ParameterValue,
+		})
 	}
-
-	diff := cmp.Diff(observed, cr.Spec.ForProvider.ParameterNameValues, cmpopts.SortSlices(func(a, b svcapitypes.ParameterNameValue) bool {
-		return awsclient.StringValue(a.ParameterName) < awsclient.StringValue(b.ParameterName)
-	}))
-
-	// TODO: We should be able to return the diff to crossplane-runtime here
-	return diff == "", nil
+	sort.Slice(drifted, func(i, j int) bool {
+		return awsclient.StringValue(drifted[i].ParameterName) < awsclient.StringValue(drifted[j].ParameterName)
+	})
+	return drifted, nil
 }
 
-func preUpdate(ctx context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcsdk.ModifyCacheParameterGroupInput) error {
+func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcsdk.ModifyCacheParameterGroupInput) error {
+	drifted, err := e.driftedParameters(ctx, cr)
+	if err != nil {
+		return err
+	}
+	// Any remaining parameters are modified by the following reconciles.
+	if len(drifted) > maxParametersPerModify {
+		drifted = drifted[:maxParametersPerModify]
+	}
 	obj.CacheParameterGroupName = awsclient.String(meta.GetExternalName(cr))
-	obj.ParameterNameValues = make([]*svcsdk.ParameterNameValue, len(cr.Spec.ForProvider.ParameterNameValues))
+	obj.ParameterNameValues = drifted
 
-	for i, v := range cr.Spec.ForProvider.ParameterNameValues {
-		obj.ParameterNameValues[i] = &svcsdk.ParameterNameValue{
-			ParameterName:  v.ParameterName,
-			ParameterValue: v.ParameterValue,
-		}
+	e.corrected = make([]string, len(drifted))
+	for i, p := range drifted {
+		e.corrected[i] = awsclient.StringValue(p.ParameterName)
 	}
-
 	return nil
 }
 
@@ -137,6 +168,9 @@ func (e *hooks) postUpdate(ctx context.Context, cr *svcapitypes.CacheParameterGr
 		return upd, err
 	}
 
+	if len(e.corrected) > 0 {
+		e.recorder.Event(cr, event.Normal(reasonCorrectedParameters, "Corrected drifted parameters: "+strings.Join(e.corrected, ", ")))
+	}
 	cr.Status.SetConditions(v1.Available())
 	return upd, nil
 }
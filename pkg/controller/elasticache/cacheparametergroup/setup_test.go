@@ -17,6 +17,7 @@ limitations under the License.
 package cacheparametergroup
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,7 +27,10 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
@@ -73,12 +77,37 @@ type mockElastiCacheClient struct {
 	elasticacheiface.ElastiCacheAPI
 
 	DescribeCacheParametersPagesWithContextFunc func(_ aws.Context, _ *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error
+	ModifyCacheParameterGroupWithContextFunc    func(_ aws.Context, _ *svcsdk.ModifyCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error)
 }
 
 func (m *mockElastiCacheClient) DescribeCacheParametersPagesWithContext(ctx aws.Context, in *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, opts ...request.Option) error {
 	return m.DescribeCacheParametersPagesWithContextFunc(ctx, in, cb, opts...)
 }
 
+func (m *mockElastiCacheClient) ModifyCacheParameterGroupWithContext(ctx aws.Context, in *svcsdk.ModifyCacheParameterGroupInput, opts ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+	return m.ModifyCacheParameterGroupWithContextFunc(ctx, in, opts...)
+}
+
+// recorder records the events it is given.
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func describeParameters(params ...*svcsdk.Parameter) func(aws.Context, *svcsdk.DescribeCacheParametersInput, func(*svcsdk.DescribeCacheParametersOutput, bool) bool, ...request.Option) error {
+	return func(_ aws.Context, _ *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error {
+		cb(&svcsdk.DescribeCacheParametersOutput{Parameters: params}, true)
+		return nil
+	}
+}
+
 func TestIsUpToDate(t *testing.T) {
 	type want struct {
 		upToDate bool
@@ -138,6 +167,37 @@ func TestIsUpToDate(t *testing.T) {
 				upToDate: true,
 			},
 		},
+		"upToDateIgnoresUnmanagedParameters": {
+			args: args{
+				elasticache: &mockElastiCacheClient{
+					DescribeCacheParametersPagesWithContextFunc: describeParameters(
+						&svcsdk.Parameter{
+							Source:         awsclient.String(svcsdk.SourceTypeUser),
+							ParameterName:  awsclient.String("a"),
+							ParameterValue: awsclient.String("val1"),
+						},
+						&svcsdk.Parameter{
+							Source:         awsclient.String(svcsdk.SourceTypeUser),
+							ParameterName:  awsclient.String("unmanaged"),
+							ParameterValue: awsclient.String("valx"),
+						},
+						&svcsdk.Parameter{
+							Source:         awsclient.String("system"),
+							ParameterName:  awsclient.String("b"),
+							ParameterValue: awsclient.String("default"),
+						},
+					),
+				},
+				cr: cacheParameterGroup(
+					withExternalName(testCacheParameterGroupName),
+					withParameter("a", "val1"),
+					withParameter("b", "default"),
+				),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
 		"upToDateDiff": {
 			args: args{
 				elasticache: &mockElastiCacheClient{
@@ -174,7 +234,7 @@ func TestIsUpToDate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			opts := []option{setupExternal}
+			opts := []option{setupExternal(event.NewNopRecorder())}
 			e := newExternal(nil, tc.args.elasticache, opts)
 			upToDate, err := e.isUpToDate(tc.args.cr, tc.args.resp)
 
@@ -188,3 +248,87 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		modified []*svcsdk.ParameterNameValue
+		events   []event.Event
+		err      error
+	}
+
+	type args struct {
+		modifyErr error
+		params    []*svcsdk.Parameter
+		cr        *svcapitypes.CacheParameterGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ModifiesOnlyDriftedParameters": {
+			args: args{
+				params: []*svcsdk.Parameter{
+					{ParameterName: awsclient.String("a"), ParameterValue: awsclient.String("valx")},
+					{ParameterName: awsclient.String("b"), ParameterValue: awsclient.String("val2")},
+				},
+				cr: cacheParameterGroup(
+					withExternalName(testCacheParameterGroupName),
+					withParameter("c", "val3"),
+					withParameter("b", "val2"),
+					withParameter("a", "val1"),
+				),
+			},
+			want: want{
+				modified: []*svcsdk.ParameterNameValue{
+					{ParameterName: awsclient.String("a"), ParameterValue: awsclient.String("val1")},
+					{ParameterName: awsclient.String("c"), ParameterValue: awsclient.String("val3")},
+				},
+				events: []event.Event{event.Normal(reasonCorrectedParameters, "Corrected drifted parameters: a, c")},
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				modifyErr: errBoom,
+				cr: cacheParameterGroup(
+					withExternalName(testCacheParameterGroupName),
+					withParameter("a", "val1"),
+				),
+			},
+			want: want{
+				modified: []*svcsdk.ParameterNameValue{
+					{ParameterName: awsclient.String("a"), ParameterValue: awsclient.String("val1")},
+				},
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified []*svcsdk.ParameterNameValue
+			client := &mockElastiCacheClient{
+				DescribeCacheParametersPagesWithContextFunc: describeParameters(tc.args.params...),
+				ModifyCacheParameterGroupWithContextFunc: func(_ aws.Context, in *svcsdk.ModifyCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+					modified = in.ParameterNameValues
+					return &svcsdk.CacheParameterGroupNameMessage{}, tc.args.modifyErr
+				},
+			}
+			r := &recorder{}
+			e := newExternal(nil, client, []option{setupExternal(r)})
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, errors.Cause(err), cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified, cmpopts.IgnoreUnexported(svcsdk.ParameterNameValue{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.events); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
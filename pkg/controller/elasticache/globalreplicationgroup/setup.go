@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	statusAvailable = "available"
+	statusCreating  = "creating"
+	statusDeleting  = "deleting"
+)
+
+// SetupGlobalReplicationGroup adds a controller that reconciles a
+// GlobalReplicationGroup.
+func SetupGlobalReplicationGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.GlobalReplicationGroupKind)
+	opts := []option{setupExternal}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&svcapitypes.GlobalReplicationGroup{}).
+		WithOptions(o.ForControllerRuntime()).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.GlobalReplicationGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = postCreate
+	e.preDelete = preDelete
+	e.update = h.update
+}
+
+type hooks struct {
+	client elasticacheiface.ElastiCacheAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.DescribeGlobalReplicationGroupsInput) error {
+	obj.GlobalReplicationGroupId = awsclient.String(meta.GetExternalName(cr))
+	// The members and their status are only returned on request.
+	obj.ShowMemberInfo = awsclient.Bool(true)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, _ *svcsdk.DescribeGlobalReplicationGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	switch awsclient.StringValue(cr.Status.AtProvider.Status) {
+	case statusAvailable:
+		cr.SetConditions(xpv1.Available())
+	case statusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case statusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.GlobalReplicationGroup, resp *svcsdk.DescribeGlobalReplicationGroupsOutput) (bool, error) {
+	g := resp.GlobalReplicationGroups[0]
+	return cr.Spec.ForProvider.GlobalReplicationGroupDescription == nil ||
+		awsclient.StringValue(cr.Spec.ForProvider.GlobalReplicationGroupDescription) == awsclient.StringValue(g.GlobalReplicationGroupDescription), nil
+}
+
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.GlobalReplicationGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// AWS rejects modifications while the Global datastore or one of its
+	// members is being changed.
+	if awsclient.StringValue(cr.Status.AtProvider.Status) != statusAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := h.client.ModifyGlobalReplicationGroupWithContext(ctx, &svcsdk.ModifyGlobalReplicationGroupInput{
+		ApplyImmediately:                  awsclient.Bool(true),
+		GlobalReplicationGroupDescription: cr.Spec.ForProvider.GlobalReplicationGroupDescription,
+		GlobalReplicationGroupId:          awsclient.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.CreateGlobalReplicationGroupInput) error {
+	obj.PrimaryReplicationGroupId = cr.Spec.ForProvider.PrimaryReplicationGroupID
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, resp *svcsdk.CreateGlobalReplicationGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	// The name of the Global datastore is the supplied suffix prefixed by
+	// AWS, so it is only known after creation.
+	meta.SetExternalName(cr, awsclient.StringValue(resp.GlobalReplicationGroup.GlobalReplicationGroupId))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.GlobalReplicationGroup, obj *svcsdk.DeleteGlobalReplicationGroupInput) (bool, error) {
+	if awsclient.StringValue(cr.Status.AtProvider.Status) == statusDeleting {
+		return true, nil
+	}
+	obj.GlobalReplicationGroupId = awsclient.String(meta.GetExternalName(cr))
+	obj.RetainPrimaryReplicationGroup = awsclient.Bool(true)
+	if cr.Spec.ForProvider.RetainPrimaryReplicationGroup != nil {
+		obj.RetainPrimaryReplicationGroup = cr.Spec.ForProvider.RetainPrimaryReplicationGroup
+	}
+	return false, nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalreplicationgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
+)
+
+const testGlobalReplicationGroupID = "ldgnf-global-cache"
+
+type globalReplicationGroupModifier func(*svcapitypes.GlobalReplicationGroup)
+
+func globalReplicationGroup(m ...globalReplicationGroupModifier) *svcapitypes.GlobalReplicationGroup {
+	cr := &svcapitypes.GlobalReplicationGroup{}
+	meta.SetExternalName(cr, testGlobalReplicationGroupID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withStatus(s string) globalReplicationGroupModifier {
+	return func(cr *svcapitypes.GlobalReplicationGroup) { cr.Status.AtProvider.Status = aws.String(s) }
+}
+
+func withRetainPrimary(b bool) globalReplicationGroupModifier {
+	return func(cr *svcapitypes.GlobalReplicationGroup) {
+		cr.Spec.ForProvider.RetainPrimaryReplicationGroup = aws.Bool(b)
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.GlobalReplicationGroup
+		want xpv1.Condition
+	}{
+		"Available": {
+			cr:   globalReplicationGroup(withStatus(statusAvailable)),
+			want: xpv1.Available(),
+		},
+		"Creating": {
+			cr:   globalReplicationGroup(withStatus(statusCreating)),
+			want: xpv1.Creating(),
+		},
+		"Modifying": {
+			cr:   globalReplicationGroup(withStatus("modifying")),
+			want: xpv1.Unavailable(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := postObserve(context.TODO(), tc.cr, nil, managed.ExternalObservation{}, nil)
+			if err != nil {
+				t.Fatalf("postObserve(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cr.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+				t.Errorf("postObserve(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreDelete(t *testing.T) {
+	type want struct {
+		ignore bool
+		input  *svcsdk.DeleteGlobalReplicationGroupInput
+	}
+	cases := map[string]struct {
+		cr   *svcapitypes.GlobalReplicationGroup
+		want want
+	}{
+		"RetainPrimaryByDefault": {
+			cr: globalReplicationGroup(withStatus(statusAvailable)),
+			want: want{input: &svcsdk.DeleteGlobalReplicationGroupInput{
+				GlobalReplicationGroupId:      aws.String(testGlobalReplicationGroupID),
+				RetainPrimaryReplicationGroup: aws.Bool(true),
+			}},
+		},
+		"DeletePrimary": {
+			cr: globalReplicationGroup(withStatus(statusAvailable), withRetainPrimary(false)),
+			want: want{input: &svcsdk.DeleteGlobalReplicationGroupInput{
+				GlobalReplicationGroupId:      aws.String(testGlobalReplicationGroupID),
+				RetainPrimaryReplicationGroup: aws.Bool(false),
+			}},
+		},
+		"AlreadyDeleting": {
+			cr: globalReplicationGroup(withStatus(statusDeleting)),
+			want: want{
+				ignore: true,
+				input:  &svcsdk.DeleteGlobalReplicationGroupInput{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			input := &svcsdk.DeleteGlobalReplicationGroupInput{}
+			ignore, err := preDelete(context.TODO(), tc.cr, input)
+			if err != nil {
+				t.Fatalf("preDelete(...): unexpected error: %v", err)
+			}
+			if ignore != tc.want.ignore {
+				t.Errorf("preDelete(...): want ignore %t, got %t", tc.want.ignore, ignore)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("preDelete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	ebclient "github.com/crossplane/provider-aws/pkg/clients/eventbridge"
+)
+
+// SetupArchive adds a controller that reconciles Archive.
+func SetupArchive(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ArchiveGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Archive{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ArchiveGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.DescribeArchiveInput) error {
+	obj.ArchiveName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Archive, resp *svcsdk.DescribeArchiveOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.State) {
+	case svcsdk.ArchiveStateEnabled:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ArchiveStateCreating, svcsdk.ArchiveStateUpdating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ArchiveStateDisabled, svcsdk.ArchiveStateCreateFailed, svcsdk.ArchiveStateUpdateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Archive, resp *svcsdk.DescribeArchiveOutput) (bool, error) {
+	switch {
+	case awsclients.StringValue(cr.Spec.ForProvider.Description) != awsclients.StringValue(resp.Description),
+		awsclients.Int64Value(cr.Spec.ForProvider.RetentionDays) != awsclients.Int64Value(resp.RetentionDays),
+		!ebclient.IsEventPatternUpToDate(cr.Spec.ForProvider.EventPattern, resp.EventPattern):
+		return false, nil
+	}
+	return true, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.CreateArchiveInput) error {
+	obj.ArchiveName = awsclients.String(meta.GetExternalName(cr))
+	obj.EventSourceArn = cr.Spec.ForProvider.EventSourceARN
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.UpdateArchiveInput) error {
+	obj.ArchiveName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Archive, obj *svcsdk.DeleteArchiveInput) (bool, error) {
+	obj.ArchiveName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
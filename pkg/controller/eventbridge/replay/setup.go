@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replay
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupReplay adds a controller that reconciles Replay.
+func SetupReplay(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ReplayGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Replay{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ReplayGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Replay, obj *svcsdk.DescribeReplayInput) error {
+	obj.ReplayName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Replay, resp *svcsdk.DescribeReplayOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.State) {
+	case svcsdk.ReplayStateRunning, svcsdk.ReplayStateCompleted:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ReplayStateStarting:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ReplayStateCancelling, svcsdk.ReplayStateCancelled, svcsdk.ReplayStateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Replay, obj *svcsdk.StartReplayInput) error {
+	obj.ReplayName = awsclients.String(meta.GetExternalName(cr))
+	obj.EventSourceArn = cr.Spec.ForProvider.EventSourceARN
+	return nil
+}
+
+// A replay can not be deleted, only cancelled while it is still in progress.
+// Replays that have already finished are left as they are.
+func preDelete(_ context.Context, cr *svcapitypes.Replay, obj *svcsdk.CancelReplayInput) (bool, error) {
+	obj.ReplayName = awsclients.String(meta.GetExternalName(cr))
+	switch awsclients.StringValue(cr.Status.AtProvider.State) {
+	case svcsdk.ReplayStateCompleted, svcsdk.ReplayStateCancelling, svcsdk.ReplayStateCancelled, svcsdk.ReplayStateFailed:
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"context"
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	ebclient "github.com/crossplane/provider-aws/pkg/clients/eventbridge"
+)
+
+const (
+	errListTargets     = "cannot list targets of Rule in AWS"
+	errPutTargets      = "cannot put targets of Rule in AWS"
+	errRemoveTargets   = "cannot remove targets of Rule in AWS"
+	errFailedPutTarget = "failed to put target"
+	errFailedRmTarget  = "failed to remove target"
+)
+
+// SetupRule adds a controller that reconciles Rule.
+func SetupRule(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.RuleGroupKind)
+	opts := []option{setupHooks}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Rule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.RuleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func setupHooks(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.postObserve = postObserve
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.postCreate = h.postCreate
+	e.preUpdate = preUpdate
+	e.postUpdate = h.postUpdate
+	e.preDelete = h.preDelete
+}
+
+type hooks struct {
+	client svcsdkapi.EventBridgeAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.DescribeRuleInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Rule, resp *svcsdk.DescribeRuleOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.RuleARN = resp.Arn
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.Rule, resp *svcsdk.DescribeRuleOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case awsclients.StringValue(p.Description) != awsclients.StringValue(resp.Description),
+		awsclients.StringValue(p.ScheduleExpression) != awsclients.StringValue(resp.ScheduleExpression),
+		awsclients.StringValue(p.RoleARN) != awsclients.StringValue(resp.RoleArn),
+		p.State != nil && awsclients.StringValue(p.State) != awsclients.StringValue(resp.State),
+		!ebclient.IsEventPatternUpToDate(p.EventPattern, resp.EventPattern):
+		return false, nil
+	}
+
+	observed, err := h.listTargets(context.TODO(), cr)
+	if err != nil {
+		return false, err
+	}
+	put, remove := DiffTargets(GenerateTargets(cr), observed)
+	return len(put) == 0 && len(remove) == 0, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.PutRuleInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.Rule, resp *svcsdk.PutRuleOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	return cre, h.syncTargets(ctx, cr)
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Rule, obj *svcsdk.PutRuleInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Rule, resp *svcsdk.PutRuleOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return upd, h.syncTargets(ctx, cr)
+}
+
+// A rule can only be deleted once all of its targets are removed.
+func (h *hooks) preDelete(ctx context.Context, cr *svcapitypes.Rule, obj *svcsdk.DeleteRuleInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.EventBusName = cr.Spec.ForProvider.EventBusName
+	observed, err := h.listTargets(ctx, cr)
+	if err != nil {
+		return false, resource.Ignore(IsNotFound, err)
+	}
+	_, remove := DiffTargets(nil, observed)
+	return false, h.removeTargets(ctx, cr, remove)
+}
+
+func (h *hooks) listTargets(ctx context.Context, cr *svcapitypes.Rule) ([]*svcsdk.Target, error) {
+	input := &svcsdk.ListTargetsByRuleInput{
+		Rule:         awsclients.String(meta.GetExternalName(cr)),
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+	}
+	var targets []*svcsdk.Target
+	for {
+		resp, err := h.client.ListTargetsByRuleWithContext(ctx, input)
+		if err != nil {
+			return nil, awsclients.Wrap(err, errListTargets)
+		}
+		targets = append(targets, resp.Targets...)
+		if resp.NextToken == nil {
+			return targets, nil
+		}
+		input.NextToken = resp.NextToken
+	}
+}
+
+func (h *hooks) syncTargets(ctx context.Context, cr *svcapitypes.Rule) error {
+	observed, err := h.listTargets(ctx, cr)
+	if err != nil {
+		return err
+	}
+	put, remove := DiffTargets(GenerateTargets(cr), observed)
+	if err := h.removeTargets(ctx, cr, remove); err != nil {
+		return err
+	}
+	if len(put) == 0 {
+		return nil
+	}
+	resp, err := h.client.PutTargetsWithContext(ctx, &svcsdk.PutTargetsInput{
+		Rule:         awsclients.String(meta.GetExternalName(cr)),
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+		Targets:      put,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errPutTargets)
+	}
+	if len(resp.FailedEntries) > 0 {
+		e := resp.FailedEntries[0]
+		return errors.Errorf("%s %s: %s", errFailedPutTarget, awsclients.StringValue(e.TargetId), awsclients.StringValue(e.ErrorMessage))
+	}
+	return nil
+}
+
+func (h *hooks) removeTargets(ctx context.Context, cr *svcapitypes.Rule, ids []*string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	resp, err := h.client.RemoveTargetsWithContext(ctx, &svcsdk.RemoveTargetsInput{
+		Rule:         awsclients.String(meta.GetExternalName(cr)),
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+		Ids:          ids,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errRemoveTargets)
+	}
+	if len(resp.FailedEntries) > 0 {
+		e := resp.FailedEntries[0]
+		return errors.Errorf("%s %s: %s", errFailedRmTarget, awsclients.StringValue(e.TargetId), awsclients.StringValue(e.ErrorMessage))
+	}
+	return nil
+}
+
+// GenerateTargets returns the targets of the given Rule in their SDK
+// representation.
+func GenerateTargets(cr *svcapitypes.Rule) []*svcsdk.Target {
+	targets := make([]*svcsdk.Target, len(cr.Spec.ForProvider.Targets))
+	for i, t := range cr.Spec.ForProvider.Targets {
+		target := &svcsdk.Target{
+			Id:        awsclients.String(t.ID),
+			Arn:       t.ARN,
+			RoleArn:   t.RoleARN,
+			Input:     t.Input,
+			InputPath: t.InputPath,
+		}
+		if t.InputTransformer != nil {
+			target.InputTransformer = &svcsdk.InputTransformer{
+				InputTemplate: awsclients.String(t.InputTransformer.InputTemplate),
+			}
+			if len(t.InputTransformer.InputPathsMap) > 0 {
+				target.InputTransformer.InputPathsMap = make(map[string]*string, len(t.InputTransformer.InputPathsMap))
+				for k, v := range t.InputTransformer.InputPathsMap {
+					target.InputTransformer.InputPathsMap[k] = awsclients.String(v)
+				}
+			}
+		}
+		if t.SQSMessageGroupID != nil {
+			target.SqsParameters = &svcsdk.SqsParameters{MessageGroupId: t.SQSMessageGroupID}
+		}
+		if t.DeadLetterQueueARN != nil {
+			target.DeadLetterConfig = &svcsdk.DeadLetterConfig{Arn: t.DeadLetterQueueARN}
+		}
+		if t.RetryPolicy != nil {
+			target.RetryPolicy = &svcsdk.RetryPolicy{
+				MaximumEventAgeInSeconds: t.RetryPolicy.MaximumEventAgeInSeconds,
+				MaximumRetryAttempts:     t.RetryPolicy.MaximumRetryAttempts,
+			}
+		}
+		targets[i] = target
+	}
+	return targets
+}
+
+// DiffTargets returns the desired targets that have to be put because they
+// are missing or differ from the observed ones, and the IDs of the observed
+// targets that have to be removed because they are not desired anymore.
+func DiffTargets(desired, observed []*svcsdk.Target) (put []*svcsdk.Target, remove []*string) {
+	observedByID := make(map[string]*svcsdk.Target, len(observed))
+	for _, t := range observed {
+		observedByID[awsclients.StringValue(t.Id)] = t
+	}
+	desiredIDs := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		id := awsclients.StringValue(t.Id)
+		desiredIDs[id] = true
+		if o, ok := observedByID[id]; !ok || !isTargetUpToDate(t, o) {
+			put = append(put, t)
+		}
+	}
+	for id := range observedByID {
+		if !desiredIDs[id] {
+			remove = append(remove, awsclients.String(id))
+		}
+	}
+	sort.Slice(remove, func(i, j int) bool {
+		return *remove[i] < *remove[j]
+	})
+	return put, remove
+}
+
+func isTargetUpToDate(desired, observed *svcsdk.Target) bool { // nolint:gocyclo
+	switch {
+	case awsclients.StringValue(desired.Arn) != awsclients.StringValue(observed.Arn),
+		awsclients.StringValue(desired.RoleArn) != awsclients.StringValue(observed.RoleArn),
+		awsclients.StringValue(desired.Input) != awsclients.StringValue(observed.Input),
+		awsclients.StringValue(desired.InputPath) != awsclients.StringValue(observed.InputPath):
+		return false
+	}
+
+	if (desired.InputTransformer == nil) != (observed.InputTransformer == nil) {
+		return false
+	}
+	if desired.InputTransformer != nil {
+		if awsclients.StringValue(desired.InputTransformer.InputTemplate) != awsclients.StringValue(observed.InputTransformer.InputTemplate) ||
+			len(desired.InputTransformer.InputPathsMap) != len(observed.InputTransformer.InputPathsMap) {
+			return false
+		}
+		for k, v := range desired.InputTransformer.InputPathsMap {
+			if awsclients.StringValue(v) != awsclients.StringValue(observed.InputTransformer.InputPathsMap[k]) {
+				return false
+			}
+		}
+	}
+
+	var desiredGroupID, observedGroupID *string
+	if desired.SqsParameters != nil {
+		desiredGroupID = desired.SqsParameters.MessageGroupId
+	}
+	if observed.SqsParameters != nil {
+		observedGroupID = observed.SqsParameters.MessageGroupId
+	}
+	if awsclients.StringValue(desiredGroupID) != awsclients.StringValue(observedGroupID) {
+		return false
+	}
+
+	var desiredDLQ, observedDLQ *string
+	if desired.DeadLetterConfig != nil {
+		desiredDLQ = desired.DeadLetterConfig.Arn
+	}
+	if observed.DeadLetterConfig != nil {
+		observedDLQ = observed.DeadLetterConfig.Arn
+	}
+	if awsclients.StringValue(desiredDLQ) != awsclients.StringValue(observedDLQ) {
+		return false
+	}
+
+	// EventBridge fills in a default retry policy, so it is only compared
+	// when one is configured.
+	if desired.RetryPolicy != nil {
+		if observed.RetryPolicy == nil {
+			return false
+		}
+		if desired.RetryPolicy.MaximumEventAgeInSeconds != nil &&
+			awsclients.Int64Value(desired.RetryPolicy.MaximumEventAgeInSeconds) != awsclients.Int64Value(observed.RetryPolicy.MaximumEventAgeInSeconds) {
+			return false
+		}
+		if desired.RetryPolicy.MaximumRetryAttempts != nil &&
+			awsclients.Int64Value(desired.RetryPolicy.MaximumRetryAttempts) != awsclients.Int64Value(observed.RetryPolicy.MaximumRetryAttempts) {
+			return false
+		}
+	}
+	return true
+}
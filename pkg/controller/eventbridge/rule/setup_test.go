@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestDiffTargets(t *testing.T) {
+	queue := func(id, arn string) *svcsdk.Target {
+		return &svcsdk.Target{Id: awsclients.String(id), Arn: awsclients.String(arn)}
+	}
+	withRetry := func(t *svcsdk.Target, attempts int) *svcsdk.Target {
+		t.RetryPolicy = &svcsdk.RetryPolicy{MaximumRetryAttempts: awsclients.Int64(attempts)}
+		return t
+	}
+
+	type want struct {
+		put    []*svcsdk.Target
+		remove []*string
+	}
+	cases := map[string]struct {
+		desired  []*svcsdk.Target
+		observed []*svcsdk.Target
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []*svcsdk.Target{queue("a", "arn:a")},
+			observed: []*svcsdk.Target{withRetry(queue("a", "arn:a"), 185)},
+		},
+		"Missing": {
+			desired:  []*svcsdk.Target{queue("a", "arn:a"), queue("b", "arn:b")},
+			observed: []*svcsdk.Target{queue("a", "arn:a")},
+			want:     want{put: []*svcsdk.Target{queue("b", "arn:b")}},
+		},
+		"Changed": {
+			desired:  []*svcsdk.Target{withRetry(queue("a", "arn:a"), 3)},
+			observed: []*svcsdk.Target{withRetry(queue("a", "arn:a"), 185)},
+			want:     want{put: []*svcsdk.Target{withRetry(queue("a", "arn:a"), 3)}},
+		},
+		"Removed": {
+			desired:  []*svcsdk.Target{queue("a", "arn:a")},
+			observed: []*svcsdk.Target{queue("c", "arn:c"), queue("a", "arn:a"), queue("b", "arn:b")},
+			want:     want{remove: []*string{awsclients.String("b"), awsclients.String("c")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, remove := DiffTargets(tc.desired, tc.observed)
+			opts := []cmp.Option{cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(svcsdk.Target{}, svcsdk.RetryPolicy{})}
+			if diff := cmp.Diff(tc.want.put, put, opts...); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, opts...); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTargets(t *testing.T) {
+	cr := &svcapitypes.Rule{}
+	cr.Spec.ForProvider.Targets = []svcapitypes.RuleTarget{{
+		ID:  "queue",
+		ARN: awsclients.String("arn:aws:sqs:us-east-1:123456789012:queue.fifo"),
+		InputTransformer: &svcapitypes.RuleTargetInputTransformer{
+			InputPathsMap: map[string]string{"instance": "$.detail.instance-id"},
+			InputTemplate: `{"instance": <instance>}`,
+		},
+		SQSMessageGroupID: awsclients.String("group"),
+	}}
+	want := []*svcsdk.Target{{
+		Id:  awsclients.String("queue"),
+		Arn: awsclients.String("arn:aws:sqs:us-east-1:123456789012:queue.fifo"),
+		InputTransformer: &svcsdk.InputTransformer{
+			InputPathsMap: map[string]*string{"instance": awsclients.String("$.detail.instance-id")},
+			InputTemplate: awsclients.String(`{"instance": <instance>}`),
+		},
+		SqsParameters: &svcsdk.SqsParameters{MessageGroupId: awsclients.String("group")},
+	}}
+	opts := cmpopts.IgnoreUnexported(svcsdk.Target{}, svcsdk.InputTransformer{}, svcsdk.SqsParameters{})
+	if diff := cmp.Diff(want, GenerateTargets(cr), opts); diff != "" {
+		t.Errorf("GenerateTargets(...): -want, +got:\n%s", diff)
+	}
+}
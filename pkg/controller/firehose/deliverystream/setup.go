@@ -0,0 +1,252 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deliverystream
+
+import (
+	"context"
+	"encoding/json"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/firehose"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/firehose/firehoseiface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errConvertDestination = "cannot convert destination configuration"
+	errGetAccessKeySecret = "cannot get access key secret"
+	errNoDestination      = "delivery stream has no destination"
+)
+
+// SetupDeliveryStream adds a controller that reconciles DeliveryStream.
+func SetupDeliveryStream(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DeliveryStreamGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{kube: e.kube, client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = h.preCreate
+			e.preDelete = preDelete
+			e.update = h.update
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.DeliveryStream{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DeliveryStreamGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	kube   client.Client
+	client svcsdkapi.FirehoseAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.DeliveryStream, obj *svcsdk.DescribeDeliveryStreamInput) error {
+	obj.DeliveryStreamName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.DeliveryStream, resp *svcsdk.DescribeDeliveryStreamOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	d := resp.DeliveryStreamDescription
+	switch awsclients.StringValue(d.DeliveryStreamStatus) {
+	case svcsdk.DeliveryStreamStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.DeliveryStreamStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.DeliveryStreamStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.DeliveryStreamStatusCreatingFailed, svcsdk.DeliveryStreamStatusDeletingFailed:
+		msg := ""
+		if d.FailureDescription != nil {
+			msg = awsclients.StringValue(d.FailureDescription.Details)
+		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msg))
+	}
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"arn":  []byte(awsclients.StringValue(d.DeliveryStreamARN)),
+		"name": []byte(meta.GetExternalName(cr)),
+	}
+	return obs, nil
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.DeliveryStream, obj *svcsdk.CreateDeliveryStreamInput) error {
+	p := cr.Spec.ForProvider
+	obj.DeliveryStreamName = awsclients.String(meta.GetExternalName(cr))
+	if p.S3DestinationConfiguration != nil {
+		obj.S3DestinationConfiguration = &svcsdk.S3DestinationConfiguration{}
+		if err := convert(p.S3DestinationConfiguration, obj.S3DestinationConfiguration); err != nil {
+			return err
+		}
+	}
+	if p.ExtendedS3DestinationConfiguration != nil {
+		obj.ExtendedS3DestinationConfiguration = &svcsdk.ExtendedS3DestinationConfiguration{}
+		if err := convert(p.ExtendedS3DestinationConfiguration, obj.ExtendedS3DestinationConfiguration); err != nil {
+			return err
+		}
+	}
+	if p.HTTPEndpointDestinationConfiguration != nil {
+		obj.HttpEndpointDestinationConfiguration = &svcsdk.HttpEndpointDestinationConfiguration{}
+		if err := convert(p.HTTPEndpointDestinationConfiguration, obj.HttpEndpointDestinationConfiguration); err != nil {
+			return err
+		}
+		if ref := p.HTTPEndpointDestinationConfiguration.EndpointConfiguration.AccessKeySecretRef; ref != nil {
+			key, err := h.getAccessKey(ctx, *ref)
+			if err != nil {
+				return err
+			}
+			obj.HttpEndpointDestinationConfiguration.EndpointConfiguration.AccessKey = awsclients.String(key)
+		}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.DeliveryStream, obj *svcsdk.DeleteDeliveryStreamInput) (bool, error) {
+	obj.DeliveryStreamName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+// NOTE: Only the buffering hints of the S3, extended S3 and HTTP endpoint
+// destinations are reconciled after creation.
+func isUpToDate(cr *svcapitypes.DeliveryStream, resp *svcsdk.DescribeDeliveryStreamOutput) (bool, error) {
+	d := resp.DeliveryStreamDescription
+	if len(d.Destinations) == 0 || awsclients.StringValue(d.DeliveryStreamStatus) != svcsdk.DeliveryStreamStatusActive {
+		return true, nil
+	}
+	return GenerateDestinationUpdate(cr.Spec.ForProvider, d.Destinations[0]) == nil, nil
+}
+
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.DeliveryStream)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := h.client.DescribeDeliveryStreamWithContext(ctx, &svcsdk.DescribeDeliveryStreamInput{
+		DeliveryStreamName: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribe)
+	}
+	d := resp.DeliveryStreamDescription
+	if len(d.Destinations) == 0 {
+		return managed.ExternalUpdate{}, errors.New(errNoDestination)
+	}
+	in := GenerateDestinationUpdate(cr.Spec.ForProvider, d.Destinations[0])
+	if in == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	in.DeliveryStreamName = awsclients.String(meta.GetExternalName(cr))
+	in.CurrentDeliveryStreamVersionId = d.VersionId
+	_, err = h.client.UpdateDestinationWithContext(ctx, in)
+	return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+}
+
+// GenerateDestinationUpdate returns the UpdateDestinationInput needed to bring
+// the buffering hints of the observed destination in line with the desired
+// ones, or nil if they are up to date. Unset buffering hints are left to the
+// AWS defaults.
+func GenerateDestinationUpdate(p svcapitypes.DeliveryStreamParameters, obs *svcsdk.DestinationDescription) *svcsdk.UpdateDestinationInput {
+	in := &svcsdk.UpdateDestinationInput{DestinationId: obs.DestinationId}
+	switch {
+	case p.ExtendedS3DestinationConfiguration != nil && obs.ExtendedS3DestinationDescription != nil:
+		hints := p.ExtendedS3DestinationConfiguration.BufferingHints
+		if hints == nil || isBufferingUpToDate(hints.IntervalInSeconds, hints.SizeInMBs, obs.ExtendedS3DestinationDescription.BufferingHints) {
+			return nil
+		}
+		in.ExtendedS3DestinationUpdate = &svcsdk.ExtendedS3DestinationUpdate{
+			BufferingHints: &svcsdk.BufferingHints{IntervalInSeconds: hints.IntervalInSeconds, SizeInMBs: hints.SizeInMBs},
+		}
+	case p.S3DestinationConfiguration != nil && obs.S3DestinationDescription != nil:
+		hints := p.S3DestinationConfiguration.BufferingHints
+		if hints == nil || isBufferingUpToDate(hints.IntervalInSeconds, hints.SizeInMBs, obs.S3DestinationDescription.BufferingHints) {
+			return nil
+		}
+		in.S3DestinationUpdate = &svcsdk.S3DestinationUpdate{
+			BufferingHints: &svcsdk.BufferingHints{IntervalInSeconds: hints.IntervalInSeconds, SizeInMBs: hints.SizeInMBs},
+		}
+	case p.HTTPEndpointDestinationConfiguration != nil && obs.HttpEndpointDestinationDescription != nil:
+		hints := p.HTTPEndpointDestinationConfiguration.BufferingHints
+		var current *svcsdk.BufferingHints
+		if b := obs.HttpEndpointDestinationDescription.BufferingHints; b != nil {
+			current = &svcsdk.BufferingHints{IntervalInSeconds: b.IntervalInSeconds, SizeInMBs: b.SizeInMBs}
+		}
+		if hints == nil || isBufferingUpToDate(hints.IntervalInSeconds, hints.SizeInMBs, current) {
+			return nil
+		}
+		in.HttpEndpointDestinationUpdate = &svcsdk.HttpEndpointDestinationUpdate{
+			BufferingHints: &svcsdk.HttpEndpointBufferingHints{IntervalInSeconds: hints.IntervalInSeconds, SizeInMBs: hints.SizeInMBs},
+		}
+	default:
+		return nil
+	}
+	return in
+}
+
+func isBufferingUpToDate(interval, size *int64, obs *svcsdk.BufferingHints) bool {
+	if obs == nil {
+		return interval == nil && size == nil
+	}
+	if interval != nil && awsclients.Int64Value(interval) != awsclients.Int64Value(obs.IntervalInSeconds) {
+		return false
+	}
+	return size == nil || awsclients.Int64Value(size) == awsclients.Int64Value(obs.SizeInMBs)
+}
+
+// convert copies a custom destination configuration into its SDK
+// counterpart. The custom types only differ from the SDK shapes in the case
+// of their field names and in their additional reference fields, which the
+// SDK shapes don't have and are thus dropped.
+func convert(in, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return errors.Wrap(err, errConvertDestination)
+	}
+	return errors.Wrap(json.Unmarshal(b, out), errConvertDestination)
+}
+
+func (h *hooks) getAccessKey(ctx context.Context, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetAccessKeySecret)
+	}
+	return string(s.Data[ref.Key]), nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deliverystream
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/firehose"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/firehose/v1alpha1"
+)
+
+func TestConvert(t *testing.T) {
+	in := &svcapitypes.CustomExtendedS3DestinationConfiguration{
+		CustomS3DestinationConfiguration: svcapitypes.CustomS3DestinationConfiguration{
+			BucketARN:      aws.String("arn:aws:s3:::logs"),
+			BucketARNRef:   &xpv1.Reference{Name: "logs"},
+			BufferingHints: &svcapitypes.BufferingHints{IntervalInSeconds: aws.Int64(60), SizeInMBs: aws.Int64(64)},
+			Prefix:         aws.String("data/!{partitionKeyFromQuery:app}/"),
+			RoleARN:        aws.String("arn:aws:iam::123456789012:role/firehose"),
+			EncryptionConfiguration: &svcapitypes.EncryptionConfiguration{
+				KMSEncryptionConfig: &svcapitypes.KMSEncryptionConfig{AWSKMSKeyARN: aws.String("arn:aws:kms:::key/k")},
+			},
+		},
+		DynamicPartitioningConfiguration: &svcapitypes.DynamicPartitioningConfiguration{
+			Enabled:      aws.Bool(true),
+			RetryOptions: &svcapitypes.RetryOptions{DurationInSeconds: aws.Int64(300)},
+		},
+		ProcessingConfiguration: &svcapitypes.ProcessingConfiguration{
+			Enabled: aws.Bool(true),
+			Processors: []*svcapitypes.Processor{{
+				Type: aws.String("MetadataExtraction"),
+				Parameters: []*svcapitypes.ProcessorParameter{{
+					ParameterName:  aws.String("MetadataExtractionQuery"),
+					ParameterValue: aws.String("{app:.app}"),
+				}},
+			}},
+		},
+	}
+	want := &svcsdk.ExtendedS3DestinationConfiguration{
+		BucketARN:      aws.String("arn:aws:s3:::logs"),
+		BufferingHints: &svcsdk.BufferingHints{IntervalInSeconds: aws.Int64(60), SizeInMBs: aws.Int64(64)},
+		Prefix:         aws.String("data/!{partitionKeyFromQuery:app}/"),
+		RoleARN:        aws.String("arn:aws:iam::123456789012:role/firehose"),
+		EncryptionConfiguration: &svcsdk.EncryptionConfiguration{
+			KMSEncryptionConfig: &svcsdk.KMSEncryptionConfig{AWSKMSKeyARN: aws.String("arn:aws:kms:::key/k")},
+		},
+		DynamicPartitioningConfiguration: &svcsdk.DynamicPartitioningConfiguration{
+			Enabled:      aws.Bool(true),
+			RetryOptions: &svcsdk.RetryOptions{DurationInSeconds: aws.Int64(300)},
+		},
+		ProcessingConfiguration: &svcsdk.ProcessingConfiguration{
+			Enabled: aws.Bool(true),
+			Processors: []*svcsdk.Processor{{
+				Type: aws.String("MetadataExtraction"),
+				Parameters: []*svcsdk.ProcessorParameter{{
+					ParameterName:  aws.String("MetadataExtractionQuery"),
+					ParameterValue: aws.String("{app:.app}"),
+				}},
+			}},
+		},
+	}
+
+	got := &svcsdk.ExtendedS3DestinationConfiguration{}
+	if err := convert(in, got); err != nil {
+		t.Fatalf("convert(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(svcsdk.ExtendedS3DestinationConfiguration{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateDestinationUpdate(t *testing.T) {
+	type args struct {
+		p   svcapitypes.DeliveryStreamParameters
+		obs *svcsdk.DestinationDescription
+	}
+
+	extendedS3 := func(hints *svcapitypes.BufferingHints) svcapitypes.DeliveryStreamParameters {
+		return svcapitypes.DeliveryStreamParameters{
+			CustomDeliveryStreamParameters: svcapitypes.CustomDeliveryStreamParameters{
+				ExtendedS3DestinationConfiguration: &svcapitypes.CustomExtendedS3DestinationConfiguration{
+					CustomS3DestinationConfiguration: svcapitypes.CustomS3DestinationConfiguration{BufferingHints: hints},
+				},
+			},
+		}
+	}
+	observed := &svcsdk.DestinationDescription{
+		DestinationId: aws.String("destinationId-000000000001"),
+		ExtendedS3DestinationDescription: &svcsdk.ExtendedS3DestinationDescription{
+			BufferingHints: &svcsdk.BufferingHints{IntervalInSeconds: aws.Int64(300), SizeInMBs: aws.Int64(5)},
+		},
+	}
+
+	cases := map[string]struct {
+		args
+		want *svcsdk.UpdateDestinationInput
+	}{
+		"NoBufferingHints": {
+			args: args{p: extendedS3(nil), obs: observed},
+		},
+		"UpToDate": {
+			args: args{
+				p:   extendedS3(&svcapitypes.BufferingHints{IntervalInSeconds: aws.Int64(300), SizeInMBs: aws.Int64(5)}),
+				obs: observed,
+			},
+		},
+		"SizeChanged": {
+			args: args{
+				p:   extendedS3(&svcapitypes.BufferingHints{IntervalInSeconds: aws.Int64(300), SizeInMBs: aws.Int64(64)}),
+				obs: observed,
+			},
+			want: &svcsdk.UpdateDestinationInput{
+				DestinationId: aws.String("destinationId-000000000001"),
+				ExtendedS3DestinationUpdate: &svcsdk.ExtendedS3DestinationUpdate{
+					BufferingHints: &svcsdk.BufferingHints{IntervalInSeconds: aws.Int64(300), SizeInMBs: aws.Int64(64)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDestinationUpdate(tc.args.p, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
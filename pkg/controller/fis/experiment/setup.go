@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/fis"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/fis/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupExperiment adds a controller that reconciles Experiment.
+func SetupExperiment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ExperimentGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Experiment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ExperimentGroupVersionKind),
+			managed.WithInitializers(),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Experiment, obj *svcsdk.GetExperimentInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postObserve reports a finished experiment as available. An experiment that
+// was stopped or failed is unavailable with the reason given by AWS FIS.
+func postObserve(_ context.Context, cr *svcapitypes.Experiment, resp *svcsdk.GetExperimentOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	state := resp.Experiment.State
+	if state == nil {
+		return obs, nil
+	}
+	// Experiments that ended can not be deleted, AWS FIS expires them on its
+	// own. We let go of them once the Experiment is deleted.
+	if !isRunning(state.Status) && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	switch awsclients.StringValue(state.Status) {
+	case svcsdk.ExperimentStatusPending, svcsdk.ExperimentStatusInitiating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ExperimentStatusRunning, svcsdk.ExperimentStatusCompleted:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ExperimentStatusStopping:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.ExperimentStatusStopped, svcsdk.ExperimentStatusFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(state.Reason)))
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Experiment, obj *svcsdk.StartExperimentInput) error {
+	obj.ExperimentTemplateId = cr.Spec.ForProvider.ExperimentTemplateID
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Experiment, resp *svcsdk.StartExperimentOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Experiment.Id))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+// preDelete stops the experiment if it is still running.
+func preDelete(_ context.Context, cr *svcapitypes.Experiment, obj *svcsdk.StopExperimentInput) (bool, error) {
+	if state := cr.Status.AtProvider.State; state == nil || !isRunning(state.Status) {
+		return true, nil
+	}
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func isRunning(status *string) bool {
+	switch awsclients.StringValue(status) {
+	case svcsdk.ExperimentStatusPending, svcsdk.ExperimentStatusInitiating, svcsdk.ExperimentStatusRunning:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experiment
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/fis"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/fis/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestPostObserve(t *testing.T) {
+	now := metav1.Now()
+	cases := map[string]struct {
+		status  string
+		deleted bool
+		want    managed.ExternalObservation
+	}{
+		"Running": {
+			status: svcsdk.ExperimentStatusRunning,
+			want:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"RunningDeleted": {
+			status:  svcsdk.ExperimentStatusRunning,
+			deleted: true,
+			want:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"CompletedDeleted": {
+			status:  svcsdk.ExperimentStatusCompleted,
+			deleted: true,
+			want:    managed.ExternalObservation{ResourceExists: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Experiment{}
+			if tc.deleted {
+				cr.SetDeletionTimestamp(&now)
+			}
+			resp := &svcsdk.GetExperimentOutput{Experiment: &svcsdk.Experiment{
+				State: &svcsdk.ExperimentState{Status: awsclients.String(tc.status)},
+			}}
+			got, err := postObserve(context.Background(), cr, resp, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
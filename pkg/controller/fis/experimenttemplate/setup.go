@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimenttemplate
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	svcsdk "github.com/aws/aws-sdk-go/service/fis"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/fis/fisiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/fis/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errTagResource   = "cannot tag experiment template"
+	errUntagResource = "cannot untag experiment template"
+	errTemplateARN   = "cannot build the ARN of the experiment template"
+)
+
+// SetupExperimentTemplate adds a controller that reconciles ExperimentTemplate.
+func SetupExperimentTemplate(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ExperimentTemplateGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ExperimentTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ExperimentTemplateGroupVersionKind),
+			managed.WithInitializers(),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.FISAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ExperimentTemplate, obj *svcsdk.GetExperimentTemplateInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ExperimentTemplate, _ *svcsdk.GetExperimentTemplateOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// lateInitialize fills in the selection mode of the targets, which AWS FIS
+// defaults to ALL but requires on every update.
+func lateInitialize(spec *svcapitypes.ExperimentTemplateParameters, resp *svcsdk.GetExperimentTemplateOutput) error {
+	for name, t := range spec.Targets {
+		if observed, ok := resp.ExperimentTemplate.Targets[name]; ok && t != nil {
+			t.SelectionMode = awsclients.LateInitializeStringPtr(t.SelectionMode, observed.SelectionMode)
+		}
+	}
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.ExperimentTemplate, resp *svcsdk.GetExperimentTemplateOutput) (bool, error) {
+	t := resp.ExperimentTemplate
+	observed := GenerateExperimentTemplate(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomExperimentTemplateParameters = cr.Spec.ForProvider.CustomExperimentTemplateParameters
+	observed.Actions = generateActions(t.Actions)
+	observed.StopConditions = generateStopConditions(t.StopConditions)
+	observed.Targets = generateTargets(t.Targets)
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	// A subset check does not notice removed actions, targets or stop
+	// conditions.
+	if len(cr.Spec.ForProvider.Actions) != len(t.Actions) ||
+		len(cr.Spec.ForProvider.Targets) != len(t.Targets) ||
+		len(cr.Spec.ForProvider.StopConditions) != len(t.StopConditions) {
+		return false, nil
+	}
+	if role := cr.Spec.ForProvider.RoleARN; role != nil && awsclients.StringValue(role) != awsclients.StringValue(t.RoleArn) {
+		return false, nil
+	}
+	add, remove := awsclients.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, t.Tags)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.ExperimentTemplate, obj *svcsdk.CreateExperimentTemplateInput) error {
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.ExperimentTemplate, resp *svcsdk.CreateExperimentTemplateOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.ExperimentTemplate.Id))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.ExperimentTemplate, obj *svcsdk.UpdateExperimentTemplateInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	in := GenerateCreateExperimentTemplateInput(cr)
+	obj.Actions = make(map[string]*svcsdk.UpdateExperimentTemplateActionInputItem, len(in.Actions))
+	for name, a := range in.Actions {
+		obj.Actions[name] = &svcsdk.UpdateExperimentTemplateActionInputItem{
+			ActionId:    a.ActionId,
+			Description: a.Description,
+			Parameters:  a.Parameters,
+			StartAfter:  a.StartAfter,
+			Targets:     a.Targets,
+		}
+	}
+	obj.Targets = make(map[string]*svcsdk.UpdateExperimentTemplateTargetInput, len(in.Targets))
+	for name, t := range in.Targets {
+		obj.Targets[name] = &svcsdk.UpdateExperimentTemplateTargetInput{
+			Filters:       t.Filters,
+			ResourceArns:  t.ResourceArns,
+			ResourceTags:  t.ResourceTags,
+			ResourceType:  t.ResourceType,
+			SelectionMode: t.SelectionMode,
+		}
+	}
+	obj.StopConditions = make([]*svcsdk.UpdateExperimentTemplateStopConditionInput, len(in.StopConditions))
+	for i, c := range in.StopConditions {
+		obj.StopConditions[i] = &svcsdk.UpdateExperimentTemplateStopConditionInput{
+			Source: c.Source,
+			Value:  c.Value,
+		}
+	}
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.ExperimentTemplate, resp *svcsdk.UpdateExperimentTemplateOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	add, remove := awsclients.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, resp.ExperimentTemplate.Tags)
+	if len(add) == 0 && len(remove) == 0 {
+		return upd, nil
+	}
+	templateARN, err := experimentTemplateARN(cr.Spec.ForProvider.Region, resp.ExperimentTemplate)
+	if err != nil {
+		return upd, errors.Wrap(err, errTemplateARN)
+	}
+	if len(remove) != 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceArn: awsclients.String(templateARN),
+			TagKeys:     remove,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceArn: awsclients.String(templateARN),
+			Tags:        add,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errTagResource)
+		}
+	}
+	return upd, nil
+}
+
+// experimentTemplateARN returns the ARN of the given experiment template in
+// the given region. AWS FIS does not return it, so it is built from the
+// partition and account of the role the template runs with.
+func experimentTemplateARN(region string, t *svcsdk.ExperimentTemplate) (string, error) {
+	role, err := arn.Parse(awsclients.StringValue(t.RoleArn))
+	if err != nil {
+		return "", err
+	}
+	return arn.ARN{
+		Partition: role.Partition,
+		Service:   svcsdk.ServiceName,
+		Region:    region,
+		AccountID: role.AccountID,
+		Resource:  "experiment-template/" + awsclients.StringValue(t.Id),
+	}.String(), nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ExperimentTemplate, obj *svcsdk.DeleteExperimentTemplateInput) (bool, error) {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func generateActions(actions map[string]*svcsdk.ExperimentTemplateAction) map[string]*svcapitypes.CreateExperimentTemplateActionInput {
+	res := make(map[string]*svcapitypes.CreateExperimentTemplateActionInput, len(actions))
+	for name, a := range actions {
+		res[name] = &svcapitypes.CreateExperimentTemplateActionInput{
+			ActionID:    a.ActionId,
+			Description: a.Description,
+			Parameters:  a.Parameters,
+			StartAfter:  a.StartAfter,
+			Targets:     a.Targets,
+		}
+	}
+	return res
+}
+
+func generateStopConditions(conditions []*svcsdk.ExperimentTemplateStopCondition) []*svcapitypes.CreateExperimentTemplateStopConditionInput {
+	res := make([]*svcapitypes.CreateExperimentTemplateStopConditionInput, len(conditions))
+	for i, c := range conditions {
+		res[i] = &svcapitypes.CreateExperimentTemplateStopConditionInput{
+			Source: c.Source,
+			Value:  c.Value,
+		}
+	}
+	return res
+}
+
+func generateTargets(targets map[string]*svcsdk.ExperimentTemplateTarget) map[string]*svcapitypes.CreateExperimentTemplateTargetInput {
+	res := make(map[string]*svcapitypes.CreateExperimentTemplateTargetInput, len(targets))
+	for name, t := range targets {
+		target := &svcapitypes.CreateExperimentTemplateTargetInput{
+			ResourceARNs:  t.ResourceArns,
+			ResourceTags:  t.ResourceTags,
+			ResourceType:  t.ResourceType,
+			SelectionMode: t.SelectionMode,
+		}
+		for _, f := range t.Filters {
+			target.Filters = append(target.Filters, &svcapitypes.ExperimentTemplateTargetInputFilter{
+				Path:   f.Path,
+				Values: f.Values,
+			})
+		}
+		res[name] = target
+	}
+	return res
+}
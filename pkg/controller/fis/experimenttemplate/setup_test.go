@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package experimenttemplate
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/fis"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/fis/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const roleARN = "arn:aws:iam::123456789012:role/fis"
+
+func template() *svcsdk.ExperimentTemplate {
+	return &svcsdk.ExperimentTemplate{
+		Id:          awsclients.String("EXT123"),
+		Description: awsclients.String("stop instances"),
+		RoleArn:     awsclients.String(roleARN),
+		Actions: map[string]*svcsdk.ExperimentTemplateAction{
+			"stop": {
+				ActionId: awsclients.String("aws:ec2:stop-instances"),
+				Targets:  map[string]*string{"Instances": awsclients.String("instances")},
+			},
+		},
+		Targets: map[string]*svcsdk.ExperimentTemplateTarget{
+			"instances": {
+				ResourceType:  awsclients.String("aws:ec2:instance"),
+				ResourceTags:  map[string]*string{"chaos-ready": awsclients.String("true")},
+				SelectionMode: awsclients.String("ALL"),
+			},
+		},
+		StopConditions: []*svcsdk.ExperimentTemplateStopCondition{
+			{Source: awsclients.String("none")},
+		},
+		Tags: map[string]*string{"team": awsclients.String("platform")},
+	}
+}
+
+func parameters() svcapitypes.ExperimentTemplateParameters {
+	return svcapitypes.ExperimentTemplateParameters{
+		Description: awsclients.String("stop instances"),
+		Actions: map[string]*svcapitypes.CreateExperimentTemplateActionInput{
+			"stop": {
+				ActionID: awsclients.String("aws:ec2:stop-instances"),
+				Targets:  map[string]*string{"Instances": awsclients.String("instances")},
+			},
+		},
+		Targets: map[string]*svcapitypes.CreateExperimentTemplateTargetInput{
+			"instances": {
+				ResourceType: awsclients.String("aws:ec2:instance"),
+				ResourceTags: map[string]*string{"chaos-ready": awsclients.String("true")},
+			},
+		},
+		StopConditions: []*svcapitypes.CreateExperimentTemplateStopConditionInput{
+			{Source: awsclients.String("none")},
+		},
+		Tags: map[string]*string{"team": awsclients.String("platform")},
+		CustomExperimentTemplateParameters: svcapitypes.CustomExperimentTemplateParameters{
+			RoleARN: awsclients.String(roleARN),
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		modify func(*svcapitypes.ExperimentTemplateParameters)
+		want   bool
+	}{
+		"UpToDate": {
+			modify: func(*svcapitypes.ExperimentTemplateParameters) {},
+			want:   true,
+		},
+		"ActionChanged": {
+			modify: func(p *svcapitypes.ExperimentTemplateParameters) {
+				p.Actions["stop"].ActionID = awsclients.String("aws:ec2:reboot-instances")
+			},
+			want: false,
+		},
+		"TargetRemoved": {
+			modify: func(p *svcapitypes.ExperimentTemplateParameters) {
+				p.Targets = nil
+			},
+			want: false,
+		},
+		"RoleChanged": {
+			modify: func(p *svcapitypes.ExperimentTemplateParameters) {
+				p.RoleARN = awsclients.String("arn:aws:iam::123456789012:role/other")
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			modify: func(p *svcapitypes.ExperimentTemplateParameters) {
+				p.Tags = map[string]*string{"team": awsclients.String("chaos")}
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.ExperimentTemplate{}
+			cr.Spec.ForProvider = parameters()
+			tc.modify(&cr.Spec.ForProvider)
+			got, err := isUpToDate(cr, &svcsdk.GetExperimentTemplateOutput{ExperimentTemplate: template()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExperimentTemplateARN(t *testing.T) {
+	got, err := experimentTemplateARN("us-east-1", template())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("arn:aws:fis:us-east-1:123456789012:experiment-template/EXT123", got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/gamelift"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/gamelift/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupAlias adds a controller that reconciles Alias.
+func SetupAlias(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.AliasGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Alias{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.AliasGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Alias, obj *svcsdk.DescribeAliasInput) error {
+	obj.AliasId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Alias, _ *svcsdk.DescribeAliasOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate reports whether the description, name and routing strategy of
+// the alias match the spec. Tags cannot be read back from DescribeAlias and
+// are therefore not compared.
+func isUpToDate(cr *svcapitypes.Alias, resp *svcsdk.DescribeAliasOutput) (bool, error) {
+	observed := GenerateAlias(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomAliasParameters = cr.Spec.ForProvider.CustomAliasParameters
+
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	return routingStrategyUpToDate(cr.Spec.ForProvider.CustomRoutingStrategy, resp.Alias.RoutingStrategy), nil
+}
+
+func routingStrategyUpToDate(spec *svcapitypes.CustomRoutingStrategy, observed *svcsdk.RoutingStrategy) bool {
+	if spec == nil {
+		return true
+	}
+	if observed == nil {
+		return false
+	}
+	return awsclients.StringValue(spec.Type) == awsclients.StringValue(observed.Type) &&
+		awsclients.StringValue(spec.FleetID) == awsclients.StringValue(observed.FleetId) &&
+		awsclients.StringValue(spec.Message) == awsclients.StringValue(observed.Message)
+}
+
+func generateRoutingStrategy(spec *svcapitypes.CustomRoutingStrategy) *svcsdk.RoutingStrategy {
+	if spec == nil {
+		return nil
+	}
+	return &svcsdk.RoutingStrategy{
+		FleetId: spec.FleetID,
+		Message: spec.Message,
+		Type:    spec.Type,
+	}
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Alias, obj *svcsdk.CreateAliasInput) error {
+	obj.RoutingStrategy = generateRoutingStrategy(cr.Spec.ForProvider.CustomRoutingStrategy)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Alias, obj *svcsdk.CreateAliasOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.Alias.AliasId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Alias, obj *svcsdk.UpdateAliasInput) error {
+	obj.AliasId = awsclients.String(meta.GetExternalName(cr))
+	obj.RoutingStrategy = generateRoutingStrategy(cr.Spec.ForProvider.CustomRoutingStrategy)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Alias, obj *svcsdk.DeleteAliasInput) (bool, error) {
+	obj.AliasId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
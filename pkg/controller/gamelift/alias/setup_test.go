@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alias
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/gamelift/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.DescribeAliasOutput{Alias: &svcsdk.Alias{
+		AliasId:     awsclients.String("alias-1"),
+		Description: awsclients.String("alias"),
+		Name:        awsclients.String("example"),
+		RoutingStrategy: &svcsdk.RoutingStrategy{
+			FleetId: awsclients.String("fleet-1"),
+			Type:    awsclients.String(svcsdk.RoutingStrategyTypeSimple),
+		},
+	}}
+	cases := map[string]struct {
+		spec svcapitypes.AliasParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: svcapitypes.AliasParameters{
+				Region:      "us-east-1",
+				Description: awsclients.String("alias"),
+				Name:        awsclients.String("example"),
+				CustomAliasParameters: svcapitypes.CustomAliasParameters{
+					CustomRoutingStrategy: &svcapitypes.CustomRoutingStrategy{
+						FleetID: awsclients.String("fleet-1"),
+						Type:    awsclients.String(svcsdk.RoutingStrategyTypeSimple),
+					},
+				},
+			},
+			want: true,
+		},
+		"FleetChanged": {
+			spec: svcapitypes.AliasParameters{
+				Region: "us-east-1",
+				Name:   awsclients.String("example"),
+				CustomAliasParameters: svcapitypes.CustomAliasParameters{
+					CustomRoutingStrategy: &svcapitypes.CustomRoutingStrategy{
+						FleetID: awsclients.String("fleet-2"),
+						Type:    awsclients.String(svcsdk.RoutingStrategyTypeSimple),
+					},
+				},
+			},
+			want: false,
+		},
+		"RoutingTypeChanged": {
+			spec: svcapitypes.AliasParameters{
+				Region: "us-east-1",
+				Name:   awsclients.String("example"),
+				CustomAliasParameters: svcapitypes.CustomAliasParameters{
+					CustomRoutingStrategy: &svcapitypes.CustomRoutingStrategy{
+						Message: awsclients.String("gone"),
+						Type:    awsclients.String(svcsdk.RoutingStrategyTypeTerminal),
+					},
+				},
+			},
+			want: false,
+		},
+		"DescriptionChanged": {
+			spec: svcapitypes.AliasParameters{
+				Region:      "us-east-1",
+				Description: awsclients.String("other"),
+				Name:        awsclients.String("example"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Alias{Spec: svcapitypes.AliasSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
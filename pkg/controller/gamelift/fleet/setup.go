@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/gamelift"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/gamelift/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupFleet adds a controller that reconciles Fleet.
+func SetupFleet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.FleetGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Fleet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.FleetGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.DescribeFleetAttributesInput) error {
+	obj.FleetIds = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Fleet, _ *svcsdk.DescribeFleetAttributesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(cr.Status.AtProvider.Status) {
+	case string(svcapitypes.FleetStatus_SDK_ACTIVE):
+		cr.SetConditions(xpv1.Available())
+	case string(svcapitypes.FleetStatus_SDK_NEW),
+		string(svcapitypes.FleetStatus_SDK_DOWNLOADING),
+		string(svcapitypes.FleetStatus_SDK_VALIDATING),
+		string(svcapitypes.FleetStatus_SDK_BUILDING),
+		string(svcapitypes.FleetStatus_SDK_ACTIVATING):
+		cr.SetConditions(xpv1.Creating())
+	case string(svcapitypes.FleetStatus_SDK_DELETING):
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+// isUpToDate only compares the fields that UpdateFleetAttributes is able to
+// change. Everything else is fixed once the fleet is created.
+func isUpToDate(cr *svcapitypes.Fleet, resp *svcsdk.DescribeFleetAttributesOutput) (bool, error) {
+	observed := GenerateFleet(resp).Spec.ForProvider
+	return awsclients.IsJSONSubset(updatableParameters(cr.Spec.ForProvider), updatableParameters(observed))
+}
+
+func updatableParameters(p svcapitypes.FleetParameters) svcapitypes.FleetParameters {
+	return svcapitypes.FleetParameters{
+		Description:                    p.Description,
+		MetricGroups:                   p.MetricGroups,
+		Name:                           p.Name,
+		NewGameSessionProtectionPolicy: p.NewGameSessionProtectionPolicy,
+		ResourceCreationLimitPolicy:    p.ResourceCreationLimitPolicy,
+	}
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.CreateFleetInput) error {
+	obj.InstanceRoleArn = cr.Spec.ForProvider.InstanceRoleARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.CreateFleetOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.FleetAttributes.FleetId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.UpdateFleetAttributesInput) error {
+	obj.FleetId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Fleet, obj *svcsdk.DeleteFleetInput) (bool, error) {
+	// Deletion of a fleet takes a while, there is no need to ask for it again.
+	if awsclients.StringValue(cr.Status.AtProvider.Status) == string(svcapitypes.FleetStatus_SDK_DELETING) {
+		return true, nil
+	}
+	obj.FleetId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/gamelift"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/gamelift/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.DescribeFleetAttributesOutput{FleetAttributes: []*svcsdk.FleetAttributes{{
+		BuildId:                        awsclients.String("build-1"),
+		Description:                    awsclients.String("fleet"),
+		InstanceType:                   awsclients.String(svcsdk.EC2InstanceTypeC5Large),
+		MetricGroups:                   []*string{awsclients.String("default")},
+		Name:                           awsclients.String("example"),
+		NewGameSessionProtectionPolicy: awsclients.String(svcsdk.ProtectionPolicyNoProtection),
+	}}}
+	cases := map[string]struct {
+		spec svcapitypes.FleetParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: svcapitypes.FleetParameters{
+				Region:          "us-east-1",
+				BuildID:         awsclients.String("build-1"),
+				Description:     awsclients.String("fleet"),
+				EC2InstanceType: awsclients.String(svcsdk.EC2InstanceTypeC5Large),
+				Name:            awsclients.String("example"),
+			},
+			want: true,
+		},
+		"NotUpdatableFieldIgnored": {
+			spec: svcapitypes.FleetParameters{
+				Region:          "us-east-1",
+				EC2InstanceType: awsclients.String(svcsdk.EC2InstanceTypeC5Xlarge),
+				Name:            awsclients.String("example"),
+			},
+			want: true,
+		},
+		"NameChanged": {
+			spec: svcapitypes.FleetParameters{
+				Region: "us-east-1",
+				Name:   awsclients.String("other"),
+			},
+			want: false,
+		},
+		"ProtectionPolicyChanged": {
+			spec: svcapitypes.FleetParameters{
+				Region:                         "us-east-1",
+				Name:                           awsclients.String("example"),
+				NewGameSessionProtectionPolicy: awsclients.String(svcsdk.ProtectionPolicyFullProtection),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Fleet{Spec: svcapitypes.FleetSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	cases := map[string]struct {
+		status string
+		want   xpv1.Condition
+	}{
+		"Active": {
+			status: svcsdk.FleetStatusActive,
+			want:   xpv1.Available(),
+		},
+		"Activating": {
+			status: svcsdk.FleetStatusActivating,
+			want:   xpv1.Creating(),
+		},
+		"Deleting": {
+			status: svcsdk.FleetStatusDeleting,
+			want:   xpv1.Deleting(),
+		},
+		"Error": {
+			status: svcsdk.FleetStatusError,
+			want:   xpv1.Unavailable(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Fleet{}
+			cr.Status.AtProvider.Status = awsclients.String(tc.status)
+			if _, err := postObserve(context.Background(), cr, nil, managed.ExternalObservation{}, nil); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, cr.GetCondition(xpv1.TypeReady)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package matchmakingconfiguration
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/gamelift"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/gamelift/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupMatchmakingConfiguration adds a controller that reconciles
+// MatchmakingConfiguration.
+func SetupMatchmakingConfiguration(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MatchmakingConfigurationGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.MatchmakingConfiguration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.MatchmakingConfigurationGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.MatchmakingConfiguration, obj *svcsdk.DescribeMatchmakingConfigurationsInput) error {
+	// Names and RuleSetName are alternative filters, we only look for the
+	// configuration itself.
+	obj.RuleSetName = nil
+	obj.Names = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.MatchmakingConfiguration, _ *svcsdk.DescribeMatchmakingConfigurationsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate reports whether all fields given in the spec match the observed
+// configuration. Tags cannot be read back and are therefore not compared.
+func isUpToDate(cr *svcapitypes.MatchmakingConfiguration, resp *svcsdk.DescribeMatchmakingConfigurationsOutput) (bool, error) {
+	observed := GenerateMatchmakingConfiguration(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.MatchmakingConfiguration, obj *svcsdk.CreateMatchmakingConfigurationInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.MatchmakingConfiguration, obj *svcsdk.UpdateMatchmakingConfigurationInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.MatchmakingConfiguration, obj *svcsdk.DeleteMatchmakingConfigurationInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
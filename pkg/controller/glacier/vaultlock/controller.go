@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultlock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/glacier"
+	svcsdk "github.com/aws/aws-sdk-go/service/glacier"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/glacier/glacieriface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/glacier/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a VaultLock resource"
+
+	errCreateSession = "cannot create a new session"
+	errInitiate      = "cannot initiate VaultLock in AWS"
+	errComplete      = "cannot complete VaultLock in AWS"
+	errAbort         = "cannot abort VaultLock in AWS"
+	errDescribe      = "failed to get VaultLock"
+	errLocked        = "the policy of a completed VaultLock cannot be changed"
+
+	// currentAccount makes Glacier use the account ID of the credentials
+	// that are used to sign the request.
+	currentAccount = "-"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.VaultLock)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.GlacierAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.VaultLock)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetVaultLockWithContext(ctx, &svcsdk.GetVaultLockInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = svcapitypes.VaultLockObservation{
+		State:          resp.State,
+		CreationDate:   resp.CreationDate,
+		ExpirationDate: resp.ExpirationDate,
+	}
+
+	locked := awsclient.StringValue(resp.State) == svcapitypes.VaultLockStateLocked
+	// A completed vault lock cannot be removed, it is deleted together with
+	// its vault. We let go of it once the VaultLock is deleted.
+	if locked && meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The policy of a vault lock in progress is already in effect.
+	if locked || !cr.Spec.ForProvider.CompleteLock {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr, resp),
+	}, nil
+}
+
+// isUpToDate returns true if the vault lock has the desired policy and has
+// been completed if desired. A vault lock in progress whose lock ID is not
+// known can not be completed and is never up to date.
+func isUpToDate(cr *svcapitypes.VaultLock, resp *svcsdk.GetVaultLockOutput) bool {
+	if !awsclient.IsPolicyUpToDate(&cr.Spec.ForProvider.Policy, resp.Policy) {
+		return false
+	}
+	if awsclient.StringValue(resp.State) == svcapitypes.VaultLockStateLocked {
+		return true
+	}
+	return meta.GetExternalName(cr) != "" && !cr.Spec.ForProvider.CompleteLock
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.VaultLock)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	resp, err := e.client.InitiateVaultLockWithContext(ctx, &svcsdk.InitiateVaultLockInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+		Policy:    &svcsdk.VaultLockPolicy{Policy: awsclient.String(cr.Spec.ForProvider.Policy)},
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errInitiate)
+	}
+	// The lock ID is required to complete the lock and is only returned
+	// when it is initiated.
+	meta.SetExternalName(cr, awsclient.StringValue(resp.LockId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.VaultLock)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if awsclient.StringValue(cr.Status.AtProvider.State) == svcapitypes.VaultLockStateLocked {
+		return managed.ExternalUpdate{}, errors.New(errLocked)
+	}
+
+	resp, err := e.client.GetVaultLockWithContext(ctx, &svcsdk.GetVaultLockInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	// The policy of a vault lock in progress can only be changed by aborting
+	// the lock. It is initiated again with the desired policy afterwards.
+	if meta.GetExternalName(cr) == "" || !awsclient.IsPolicyUpToDate(&cr.Spec.ForProvider.Policy, resp.Policy) {
+		return managed.ExternalUpdate{}, e.abort(ctx, cr)
+	}
+
+	_, err = e.client.CompleteVaultLockWithContext(ctx, &svcsdk.CompleteVaultLockInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+		LockId:    awsclient.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errComplete)
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.VaultLock)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	return e.abort(ctx, cr)
+}
+
+func (e *external) abort(ctx context.Context, cr *svcapitypes.VaultLock) error {
+	_, err := e.client.AbortVaultLockWithContext(ctx, &svcsdk.AbortVaultLockInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+	})
+	return awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errAbort)
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == "ResourceNotFoundException"
+}
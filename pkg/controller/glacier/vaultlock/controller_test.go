@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultlock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/glacier"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/glacier/glacieriface"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/glacier/v1alpha1"
+)
+
+const (
+	testPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"glacier:DeleteArchive","Resource":"*"}]}`
+	testLockID = "lock-id"
+)
+
+type mockClient struct {
+	svcsdkapi.GlacierAPI
+
+	lock *svcsdk.GetVaultLockOutput
+
+	completed bool
+	aborted   bool
+}
+
+func (m *mockClient) GetVaultLockWithContext(_ context.Context, _ *svcsdk.GetVaultLockInput, _ ...request.Option) (*svcsdk.GetVaultLockOutput, error) {
+	return m.lock, nil
+}
+
+func (m *mockClient) CompleteVaultLockWithContext(_ context.Context, in *svcsdk.CompleteVaultLockInput, _ ...request.Option) (*svcsdk.CompleteVaultLockOutput, error) {
+	m.completed = aws.StringValue(in.LockId) == testLockID
+	return &svcsdk.CompleteVaultLockOutput{}, nil
+}
+
+func (m *mockClient) AbortVaultLockWithContext(_ context.Context, _ *svcsdk.AbortVaultLockInput, _ ...request.Option) (*svcsdk.AbortVaultLockOutput, error) {
+	m.aborted = true
+	return &svcsdk.AbortVaultLockOutput{}, nil
+}
+
+type vaultLockModifier func(*svcapitypes.VaultLock)
+
+func vaultLock(m ...vaultLockModifier) *svcapitypes.VaultLock {
+	cr := &svcapitypes.VaultLock{}
+	cr.Spec.ForProvider.VaultName = aws.String("vault")
+	cr.Spec.ForProvider.Policy = testPolicy
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withLockID(id string) vaultLockModifier {
+	return func(cr *svcapitypes.VaultLock) { meta.SetExternalName(cr, id) }
+}
+
+func withCompleteLock() vaultLockModifier {
+	return func(cr *svcapitypes.VaultLock) { cr.Spec.ForProvider.CompleteLock = true }
+}
+
+func withPolicy(p string) vaultLockModifier {
+	return func(cr *svcapitypes.VaultLock) { cr.Spec.ForProvider.Policy = p }
+}
+
+func lockOutput(state, policy string) *svcsdk.GetVaultLockOutput {
+	return &svcsdk.GetVaultLockOutput{State: aws.String(state), Policy: aws.String(policy)}
+}
+
+func TestObserve(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.VaultLock
+		lock *svcsdk.GetVaultLockOutput
+		want managed.ExternalObservation
+	}{
+		"InProgressNotToBeCompleted": {
+			cr:   vaultLock(withLockID(testLockID)),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+		"InProgressToBeCompleted": {
+			cr:   vaultLock(withLockID(testLockID), withCompleteLock()),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: managed.ExternalObservation{ResourceExists: true},
+		},
+		"InProgressWithUnknownLockID": {
+			cr:   vaultLock(),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: managed.ExternalObservation{ResourceExists: true},
+		},
+		"InProgressPolicyChanged": {
+			cr:   vaultLock(withLockID(testLockID), withPolicy(`{"Version":"2012-10-17","Statement":[]}`)),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: managed.ExternalObservation{ResourceExists: true},
+		},
+		"Locked": {
+			cr:   vaultLock(withLockID(testLockID), withCompleteLock()),
+			lock: lockOutput(svcapitypes.VaultLockStateLocked, testPolicy),
+			want: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &mockClient{lock: tc.lock}}
+			got, err := e.Observe(context.TODO(), tc.cr)
+			if err != nil {
+				t.Fatalf("Observe(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		completed bool
+		aborted   bool
+		err       bool
+	}
+	cases := map[string]struct {
+		cr   *svcapitypes.VaultLock
+		lock *svcsdk.GetVaultLockOutput
+		want want
+	}{
+		"Complete": {
+			cr:   vaultLock(withLockID(testLockID), withCompleteLock()),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: want{completed: true},
+		},
+		"AbortOnPolicyChange": {
+			cr:   vaultLock(withLockID(testLockID), withPolicy(`{"Version":"2012-10-17","Statement":[]}`)),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: want{aborted: true},
+		},
+		"AbortOnUnknownLockID": {
+			cr:   vaultLock(withCompleteLock()),
+			lock: lockOutput(svcapitypes.VaultLockStateInProgress, testPolicy),
+			want: want{aborted: true},
+		},
+		"Locked": {
+			cr: vaultLock(withLockID(testLockID), withPolicy(`{"Version":"2012-10-17","Statement":[]}`), func(cr *svcapitypes.VaultLock) {
+				cr.Status.AtProvider.State = aws.String(svcapitypes.VaultLockStateLocked)
+			}),
+			lock: lockOutput(svcapitypes.VaultLockStateLocked, testPolicy),
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &mockClient{lock: tc.lock}
+			e := &external{client: c}
+			_, err := e.Update(context.TODO(), tc.cr)
+			got := want{completed: c.completed, aborted: c.aborted, err: err != nil}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
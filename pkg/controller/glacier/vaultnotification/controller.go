@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultnotification
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/glacier"
+	svcsdk "github.com/aws/aws-sdk-go/service/glacier"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/glacier/glacieriface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/glacier/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a VaultNotification resource"
+
+	errCreateSession = "cannot create a new session"
+	errSet           = "cannot set VaultNotification in AWS"
+	errDescribe      = "failed to get VaultNotification"
+	errDelete        = "failed to delete VaultNotification"
+
+	// currentAccount makes Glacier use the account ID of the credentials
+	// that are used to sign the request.
+	currentAccount = "-"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.VaultNotification)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.GlacierAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.VaultNotification)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetVaultNotificationsWithContext(ctx, &svcsdk.GetVaultNotificationsInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, resp.VaultNotificationConfig),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.VaultNotification)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.set(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.VaultNotification)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// The notification configuration is replaced as a whole.
+	return managed.ExternalUpdate{}, e.set(ctx, cr)
+}
+
+func (e *external) set(ctx context.Context, cr *svcapitypes.VaultNotification) error {
+	_, err := e.client.SetVaultNotificationsWithContext(ctx, &svcsdk.SetVaultNotificationsInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+		VaultNotificationConfig: &svcsdk.VaultNotificationConfig{
+			Events:   cr.Spec.ForProvider.Events,
+			SNSTopic: cr.Spec.ForProvider.SNSTopicARN,
+		},
+	})
+	return awsclient.Wrap(err, errSet)
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.VaultNotification)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteVaultNotificationsWithContext(ctx, &svcsdk.DeleteVaultNotificationsInput{
+		AccountId: awsclient.String(currentAccount),
+		VaultName: cr.Spec.ForProvider.VaultName,
+	})
+	return awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDelete)
+}
+
+func isUpToDate(p svcapitypes.VaultNotificationParameters, cfg *svcsdk.VaultNotificationConfig) bool {
+	if cfg == nil {
+		return false
+	}
+	if awsclient.StringValue(p.SNSTopicARN) != awsclient.StringValue(cfg.SNSTopic) {
+		return false
+	}
+	return cmp.Equal(sortedEvents(p.Events), sortedEvents(cfg.Events))
+}
+
+func sortedEvents(events []*string) []string {
+	s := make([]string, len(events))
+	for i, e := range events {
+		s[i] = awsclient.StringValue(e)
+	}
+	sort.Strings(s)
+	return s
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == "ResourceNotFoundException"
+}
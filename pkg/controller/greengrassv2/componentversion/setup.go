@@ -0,0 +1,210 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package componentversion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/greengrassv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetRecipeConfigMap = "cannot get recipe ConfigMap"
+	errRecipeKeyNotFound  = "recipe key not found in ConfigMap"
+	errBuildRecipe        = "cannot build recipe"
+	errNoRecipe           = "either inlineRecipe, recipeConfigMapRef or lambdaFunction has to be given"
+
+	recipeManifests = "Manifests"
+	recipeArtifacts = "Artifacts"
+)
+
+// SetupComponentVersion adds a controller that reconciles ComponentVersion.
+func SetupComponentVersion(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ComponentVersionGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = h.preCreate
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ComponentVersion{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ComponentVersionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	kube client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ComponentVersion, obj *svcsdk.DescribeComponentInput) error {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ComponentVersion, resp *svcsdk.DescribeComponentOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if resp.Status != nil {
+		switch awsclients.StringValue(resp.Status.ComponentState) {
+		case string(svcapitypes.CloudComponentState_DEPLOYABLE):
+			cr.SetConditions(xpv1.Available())
+		case string(svcapitypes.CloudComponentState_REQUESTED), string(svcapitypes.CloudComponentState_INITIATED):
+			cr.SetConditions(xpv1.Creating())
+		case string(svcapitypes.CloudComponentState_FAILED):
+			cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(resp.Status.Message)))
+		}
+	}
+	return obs, nil
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.ComponentVersion, obj *svcsdk.CreateComponentVersionInput) error {
+	recipe, err := h.getRecipe(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if recipe == "" {
+		if obj.LambdaFunction == nil {
+			return errors.New(errNoRecipe)
+		}
+		return nil
+	}
+	b, err := buildRecipe(recipe, cr.Spec.ForProvider.Artifacts)
+	if err != nil {
+		return errors.Wrap(err, errBuildRecipe)
+	}
+	obj.InlineRecipe = b
+	return nil
+}
+
+// getRecipe returns the recipe given either inline or through a ConfigMap.
+func (h *hooks) getRecipe(ctx context.Context, cr *svcapitypes.ComponentVersion) (string, error) {
+	if cr.Spec.ForProvider.InlineRecipe != nil {
+		return *cr.Spec.ForProvider.InlineRecipe, nil
+	}
+	ref := cr.Spec.ForProvider.RecipeConfigMapRef
+	if ref == nil {
+		return "", nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", errors.Wrap(err, errGetRecipeConfigMap)
+	}
+	recipe, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.New(errRecipeKeyNotFound)
+	}
+	return recipe, nil
+}
+
+// buildRecipe converts the given JSON or YAML recipe into JSON and adds the
+// given S3 artifacts to every manifest of the recipe.
+func buildRecipe(recipe string, artifacts []svcapitypes.S3Artifact) ([]byte, error) {
+	if len(artifacts) == 0 {
+		return []byte(recipe), nil
+	}
+	r := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(recipe), &r); err != nil {
+		return nil, err
+	}
+	manifests, _ := r[recipeManifests].([]interface{})
+	if len(manifests) == 0 {
+		manifests = []interface{}{map[string]interface{}{}}
+	}
+	for _, m := range manifests {
+		manifest, ok := m.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("manifest is not an object")
+		}
+		existing, _ := manifest[recipeArtifacts].([]interface{})
+		for _, a := range artifacts {
+			existing = appendArtifact(existing, a)
+		}
+		manifest[recipeArtifacts] = existing
+	}
+	r[recipeManifests] = manifests
+	return json.Marshal(r)
+}
+
+// appendArtifact adds the artifact to the list unless an artifact with the
+// same URI is already there.
+func appendArtifact(list []interface{}, a svcapitypes.S3Artifact) []interface{} {
+	uri := fmt.Sprintf("s3://%s/%s", awsclients.StringValue(a.BucketName), a.Key)
+	for _, e := range list {
+		if m, ok := e.(map[string]interface{}); ok && m["URI"] == uri {
+			return list
+		}
+	}
+	artifact := map[string]interface{}{"URI": uri}
+	if a.Unarchive != nil {
+		artifact["Unarchive"] = *a.Unarchive
+	}
+	if a.Permission != nil {
+		p := map[string]interface{}{}
+		if a.Permission.Read != nil {
+			p["Read"] = *a.Permission.Read
+		}
+		if a.Permission.Execute != nil {
+			p["Execute"] = *a.Permission.Execute
+		}
+		artifact["Permission"] = p
+	}
+	return append(list, artifact)
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.ComponentVersion, resp *svcsdk.CreateComponentVersionOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Arn))
+	return cre, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ComponentVersion, obj *svcsdk.DeleteComponentInput) (bool, error) {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package componentversion
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/greengrassv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestBuildRecipe(t *testing.T) {
+	type args struct {
+		recipe    string
+		artifacts []svcapitypes.S3Artifact
+	}
+	type want struct {
+		recipe string
+		err    error
+	}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoArtifacts": {
+			args: args{
+				recipe: "ComponentName: com.example.Hello",
+			},
+			want: want{
+				recipe: "ComponentName: com.example.Hello",
+			},
+		},
+		"YAMLRecipe": {
+			args: args{
+				recipe: "ComponentName: com.example.Hello\nManifests:\n- Platform:\n    os: linux\n",
+				artifacts: []svcapitypes.S3Artifact{
+					{BucketName: awsclients.String("bucket"), Key: "hello.py"},
+				},
+			},
+			want: want{
+				recipe: `{"ComponentName":"com.example.Hello","Manifests":[{"Artifacts":[{"URI":"s3://bucket/hello.py"}],"Platform":{"os":"linux"}}]}`,
+			},
+		},
+		"ExistingArtifact": {
+			args: args{
+				recipe: `{"Manifests":[{"Artifacts":[{"URI":"s3://bucket/hello.py"}]}]}`,
+				artifacts: []svcapitypes.S3Artifact{
+					{BucketName: awsclients.String("bucket"), Key: "hello.py"},
+					{
+						BucketName: awsclients.String("bucket"),
+						Key:        "lib.zip",
+						Unarchive:  awsclients.String("ZIP"),
+						Permission: &svcapitypes.ArtifactPermission{Read: awsclients.String("ALL")},
+					},
+				},
+			},
+			want: want{
+				recipe: `{"Manifests":[{"Artifacts":[{"URI":"s3://bucket/hello.py"},{"Permission":{"Read":"ALL"},"URI":"s3://bucket/lib.zip","Unarchive":"ZIP"}]}]}`,
+			},
+		},
+		"NoManifests": {
+			args: args{
+				recipe: `{"ComponentName":"com.example.Hello"}`,
+				artifacts: []svcapitypes.S3Artifact{
+					{BucketName: awsclients.String("bucket"), Key: "hello.py"},
+				},
+			},
+			want: want{
+				recipe: `{"ComponentName":"com.example.Hello","Manifests":[{"Artifacts":[{"URI":"s3://bucket/hello.py"}]}]}`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := buildRecipe(tc.args.recipe, tc.args.artifacts)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.recipe, string(got)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
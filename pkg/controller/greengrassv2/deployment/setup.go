@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/greengrassv2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/greengrassv2/greengrassv2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/greengrassv2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errNotDeployment    = "managed resource is not a Deployment custom resource"
+	errKubeUpdateFailed = "cannot update Deployment custom resource"
+)
+
+// SetupDeployment adds a controller that reconciles Deployment.
+func SetupDeployment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DeploymentGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{kube: e.kube, client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.update = h.update
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Deployment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DeploymentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	kube   client.Client
+	client svcsdkapi.GreengrassV2API
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.GetDeploymentInput) error {
+	obj.DeploymentId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Deployment, resp *svcsdk.GetDeploymentOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	status := awsclients.StringValue(resp.DeploymentStatus)
+	// Deployments that are not active anymore can still be described but
+	// there is nothing left to cancel.
+	if meta.WasDeleted(cr) && status != string(svcapitypes.DeploymentStatus_SDK_ACTIVE) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	switch status {
+	case string(svcapitypes.DeploymentStatus_SDK_ACTIVE), string(svcapitypes.DeploymentStatus_SDK_COMPLETED):
+		cr.SetConditions(xpv1.Available())
+	case string(svcapitypes.DeploymentStatus_SDK_CANCELED), string(svcapitypes.DeploymentStatus_SDK_FAILED), string(svcapitypes.DeploymentStatus_SDK_INACTIVE):
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+// isUpToDate reports whether the observed deployment is still the latest
+// revision for its target and deploys the desired components.
+func isUpToDate(cr *svcapitypes.Deployment, resp *svcsdk.GetDeploymentOutput) (bool, error) {
+	if !awsclients.BoolValue(resp.IsLatestForTarget) {
+		return false, nil
+	}
+	observed := GenerateDeployment(resp).Spec.ForProvider
+	desired := cr.Spec.ForProvider
+	return cmp.Equal(desired.Components, observed.Components, cmpopts.EquateEmpty()) &&
+		cmp.Equal(desired.DeploymentPolicies, observed.DeploymentPolicies, cmpopts.EquateEmpty()) &&
+		cmp.Equal(desired.IotJobConfiguration, observed.IotJobConfiguration, cmpopts.EquateEmpty()), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.CreateDeploymentInput) error {
+	obj.TargetArn = cr.Spec.ForProvider.TargetARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Deployment, resp *svcsdk.CreateDeploymentOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.DeploymentId))
+	return cre, nil
+}
+
+// update creates a new revision of the deployment for the same target, which
+// is how Greengrass applies changes to a deployment.
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Deployment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDeployment)
+	}
+	input := GenerateCreateDeploymentInput(cr)
+	input.TargetArn = cr.Spec.ForProvider.TargetARN
+	resp, err := h.client.CreateDeploymentWithContext(ctx, input)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.DeploymentId))
+	return managed.ExternalUpdate{}, errors.Wrap(h.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Deployment, obj *svcsdk.CancelDeploymentInput) (bool, error) {
+	if s := cr.Status.AtProvider.DeploymentStatus; s != nil && *s != string(svcapitypes.DeploymentStatus_SDK_ACTIVE) {
+		return true, nil
+	}
+	obj.DeploymentId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
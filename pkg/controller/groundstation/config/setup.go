@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/groundstation"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/groundstation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupConfig adds a controller that reconciles Config.
+func SetupConfig(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ConfigGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Config{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// configType returns the type of the config, which is determined by the
+// member of the config data that is set.
+func configType(cr *svcapitypes.Config) *string {
+	d := cr.Spec.ForProvider.ConfigData
+	switch {
+	case d == nil:
+		return cr.Status.AtProvider.ConfigType
+	case d.AntennaDownlinkConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeAntennaDownlink)
+	case d.AntennaDownlinkDemodDecodeConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeAntennaDownlinkDemodDecode)
+	case d.AntennaUplinkConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeAntennaUplink)
+	case d.DataflowEndpointConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeDataflowEndpoint)
+	case d.S3RecordingConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeS3Recording)
+	case d.TrackingConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeTracking)
+	case d.UplinkEchoConfig != nil:
+		return awsclients.String(svcsdk.ConfigCapabilityTypeUplinkEcho)
+	}
+	return cr.Status.AtProvider.ConfigType
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Config, obj *svcsdk.GetConfigInput) error {
+	obj.ConfigId = awsclients.String(meta.GetExternalName(cr))
+	obj.ConfigType = configType(cr)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Config, _ *svcsdk.GetConfigOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate reports whether the name and the config data match the observed
+// config. Tags are not changed by UpdateConfig and are therefore not compared.
+func isUpToDate(cr *svcapitypes.Config, resp *svcsdk.GetConfigOutput) (bool, error) {
+	observed := GenerateConfig(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Config, obj *svcsdk.CreateConfigOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.ConfigId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Config, obj *svcsdk.UpdateConfigInput) error {
+	obj.ConfigId = awsclients.String(meta.GetExternalName(cr))
+	obj.ConfigType = configType(cr)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Config, obj *svcsdk.DeleteConfigInput) (bool, error) {
+	obj.ConfigId = awsclients.String(meta.GetExternalName(cr))
+	obj.ConfigType = configType(cr)
+	return false, nil
+}
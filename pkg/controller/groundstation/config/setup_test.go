@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/groundstation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestConfigType(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.Config
+		want *string
+	}{
+		"Tracking": {
+			cr: &svcapitypes.Config{Spec: svcapitypes.ConfigSpec{ForProvider: svcapitypes.ConfigParameters{
+				ConfigData: &svcapitypes.ConfigTypeData{
+					TrackingConfig: &svcapitypes.TrackingConfig{Autotrack: awsclients.String(svcsdk.CriticalityRequired)},
+				},
+			}}},
+			want: awsclients.String(svcsdk.ConfigCapabilityTypeTracking),
+		},
+		"S3Recording": {
+			cr: &svcapitypes.Config{Spec: svcapitypes.ConfigSpec{ForProvider: svcapitypes.ConfigParameters{
+				ConfigData: &svcapitypes.ConfigTypeData{
+					S3RecordingConfig: &svcapitypes.S3RecordingConfig{BucketARN: awsclients.String("arn:aws:s3:::example")},
+				},
+			}}},
+			want: awsclients.String(svcsdk.ConfigCapabilityTypeS3Recording),
+		},
+		"FromStatus": {
+			cr: &svcapitypes.Config{Status: svcapitypes.ConfigStatus{AtProvider: svcapitypes.ConfigObservation{
+				ConfigType: awsclients.String(svcsdk.ConfigCapabilityTypeUplinkEcho),
+			}}},
+			want: awsclients.String(svcsdk.ConfigCapabilityTypeUplinkEcho),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := configType(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
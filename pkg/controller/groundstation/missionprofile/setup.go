@@ -0,0 +1,113 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package missionprofile
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/groundstation"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/groundstation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupMissionProfile adds a controller that reconciles MissionProfile.
+func SetupMissionProfile(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MissionProfileGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.MissionProfile{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.MissionProfileGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.MissionProfile, obj *svcsdk.GetMissionProfileInput) error {
+	obj.MissionProfileId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.MissionProfile, _ *svcsdk.GetMissionProfileOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate reports whether all fields given in the spec match the observed
+// mission profile. Tags are not changed by UpdateMissionProfile and are
+// therefore not compared.
+func isUpToDate(cr *svcapitypes.MissionProfile, resp *svcsdk.GetMissionProfileOutput) (bool, error) {
+	if cr.Spec.ForProvider.TrackingConfigARN != nil &&
+		awsclients.StringValue(cr.Spec.ForProvider.TrackingConfigARN) != awsclients.StringValue(resp.TrackingConfigArn) {
+		return false, nil
+	}
+	observed := GenerateMissionProfile(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomMissionProfileParameters = cr.Spec.ForProvider.CustomMissionProfileParameters
+
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.MissionProfile, obj *svcsdk.CreateMissionProfileInput) error {
+	obj.TrackingConfigArn = cr.Spec.ForProvider.TrackingConfigARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.MissionProfile, obj *svcsdk.CreateMissionProfileOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.MissionProfileId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.MissionProfile, obj *svcsdk.UpdateMissionProfileInput) error {
+	obj.MissionProfileId = awsclients.String(meta.GetExternalName(cr))
+	obj.TrackingConfigArn = cr.Spec.ForProvider.TrackingConfigARN
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.MissionProfile, obj *svcsdk.DeleteMissionProfileInput) (bool, error) {
+	obj.MissionProfileId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package missionprofile
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/groundstation"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/groundstation/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const trackingConfigARN = "arn:aws:groundstation:us-east-2:123456789012:config/tracking/example"
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.GetMissionProfileOutput{
+		MinimumViableContactDurationSeconds: awsclients.Int64(60),
+		Name:                                awsclients.String("example"),
+		Tags:                                map[string]*string{"team": awsclients.String("space")},
+		TrackingConfigArn:                   awsclients.String(trackingConfigARN),
+	}
+	cases := map[string]struct {
+		spec svcapitypes.MissionProfileParameters
+		want bool
+	}{
+		"UpToDate": {
+			spec: svcapitypes.MissionProfileParameters{
+				Region:                              "us-east-2",
+				MinimumViableContactDurationSeconds: awsclients.Int64(60),
+				Name:                                awsclients.String("example"),
+				CustomMissionProfileParameters: svcapitypes.CustomMissionProfileParameters{
+					TrackingConfigARN: awsclients.String(trackingConfigARN),
+				},
+			},
+			want: true,
+		},
+		"TrackingConfigChanged": {
+			spec: svcapitypes.MissionProfileParameters{
+				Region: "us-east-2",
+				Name:   awsclients.String("example"),
+				CustomMissionProfileParameters: svcapitypes.CustomMissionProfileParameters{
+					TrackingConfigARN: awsclients.String(trackingConfigARN + "-2"),
+				},
+			},
+			want: false,
+		},
+		"DurationChanged": {
+			spec: svcapitypes.MissionProfileParameters{
+				Region:                              "us-east-2",
+				MinimumViableContactDurationSeconds: awsclients.Int64(120),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.MissionProfile{Spec: svcapitypes.MissionProfileSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health surfaces open AWS Health events as conditions of the
+// managed resources whose AWS entities they affect.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/health"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/health/healthiface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8serrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// TypeAWSHealth resources are affected by open AWS Health events.
+	TypeAWSHealth xpv1.ConditionType = "AWSHealth"
+
+	// ReasonNoOpenEvents resources are no longer affected by open AWS
+	// Health events.
+	ReasonNoOpenEvents xpv1.ConditionReason = "NoOpenEvents"
+
+	// The AWS Health API is only served from us-east-1.
+	healthRegion = "us-east-1"
+
+	// DescribeAffectedEntities accepts at most 10 event ARNs.
+	maxEventARNs = 10
+
+	groupSuffix = "aws.crossplane.io"
+
+	errListProviderConfigs = "cannot list ProviderConfigs"
+	errCreateSession       = "cannot create a new session"
+	errDescribeEvents      = "cannot describe AWS Health events"
+	errDescribeEntities    = "cannot describe entities affected by AWS Health events"
+	errListManaged         = "cannot list managed resources"
+	errPatchStatus         = "cannot patch status of managed resource"
+)
+
+// An Event is an open AWS Health event.
+type Event struct {
+	ARN        string
+	TypeCode   string
+	Service    string
+	Region     string
+	StatusCode string
+}
+
+// Setup adds a runnable to the manager that polls the AWS Health API of the
+// accounts of all ProviderConfigs at the given interval.
+func Setup(mgr ctrl.Manager, l logging.Logger, interval time.Duration) error {
+	p := &poller{
+		kube:     mgr.GetClient(),
+		scheme:   mgr.GetScheme(),
+		log:      l.WithValues("subsystem", "health"),
+		interval: interval,
+		newClient: func(ctx context.Context, kube client.Client, pc *v1beta1.ProviderConfig) (svcsdkapi.HealthAPI, error) {
+			sess, err := awsclient.UseProviderConfigV1(ctx, kube, pc, healthRegion)
+			if err != nil {
+				return nil, errors.Wrap(err, errCreateSession)
+			}
+			return svcsdk.New(sess), nil
+		},
+	}
+	return mgr.Add(manager.RunnableFunc(p.Start))
+}
+
+type poller struct {
+	kube      client.Client
+	scheme    *runtime.Scheme
+	log       logging.Logger
+	interval  time.Duration
+	newClient func(context.Context, client.Client, *v1beta1.ProviderConfig) (svcsdkapi.HealthAPI, error)
+}
+
+// Start polls until the context is done. Errors are logged, polling goes on.
+func (p *poller) Start(ctx context.Context) error {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+	for {
+		if err := p.poll(ctx); err != nil {
+			p.log.Info("Cannot surface AWS Health events", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+func (p *poller) poll(ctx context.Context) error {
+	pcs := &v1beta1.ProviderConfigList{}
+	if err := p.kube.List(ctx, pcs); err != nil {
+		return errors.Wrap(err, errListProviderConfigs)
+	}
+	// Entities are only unique within an account, so they are matched with
+	// the resources of the ProviderConfig whose account they are in.
+	affected := map[string]map[string][]Event{}
+	for i := range pcs.Items {
+		pc := &pcs.Items[i]
+		events := map[string][]Event{}
+		hc, err := p.newClient(ctx, p.kube, pc)
+		if err == nil {
+			err = AffectedEntities(ctx, hc, events)
+		}
+		// Accounts without a Business or Enterprise support plan cannot
+		// use the AWS Health API. They must not stop the others, and the
+		// conditions of their resources are left as they are.
+		if err != nil {
+			p.log.Debug("Cannot get AWS Health events", "providerConfig", pc.GetName(), "error", err)
+			continue
+		}
+		affected[pc.GetName()] = events
+	}
+	return p.flag(ctx, affected)
+}
+
+// AffectedEntities adds the open AWS Health events to the given map, keyed
+// by the values and ARNs of the entities they impair.
+func AffectedEntities(ctx context.Context, hc svcsdkapi.HealthAPI, affected map[string][]Event) error {
+	events := map[string]Event{}
+	err := hc.DescribeEventsPagesWithContext(ctx, &svcsdk.DescribeEventsInput{
+		Filter: &svcsdk.EventFilter{
+			EventStatusCodes: []*string{
+				awsclient.String(svcsdk.EventStatusCodeOpen),
+				awsclient.String(svcsdk.EventStatusCodeUpcoming),
+			},
+		},
+	}, func(out *svcsdk.DescribeEventsOutput, _ bool) bool {
+		for _, e := range out.Events {
+			events[awsclient.StringValue(e.Arn)] = Event{
+				ARN:        awsclient.StringValue(e.Arn),
+				TypeCode:   awsclient.StringValue(e.EventTypeCode),
+				Service:    awsclient.StringValue(e.Service),
+				Region:     awsclient.StringValue(e.Region),
+				StatusCode: awsclient.StringValue(e.StatusCode),
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDescribeEvents)
+	}
+
+	arns := make([]*string, 0, len(events))
+	for arn := range events {
+		arns = append(arns, awsclient.String(arn))
+	}
+	for len(arns) > 0 {
+		n := len(arns)
+		if n > maxEventARNs {
+			n = maxEventARNs
+		}
+		batch := arns[:n]
+		arns = arns[n:]
+		err := hc.DescribeAffectedEntitiesPagesWithContext(ctx, &svcsdk.DescribeAffectedEntitiesInput{
+			Filter: &svcsdk.EntityFilter{EventArns: batch},
+		}, func(out *svcsdk.DescribeAffectedEntitiesOutput, _ bool) bool {
+			for _, e := range out.Entities {
+				if awsclient.StringValue(e.StatusCode) == svcsdk.EntityStatusCodeUnimpaired {
+					continue
+				}
+				ev, ok := events[awsclient.StringValue(e.EventArn)]
+				if !ok {
+					continue
+				}
+				for _, id := range []*string{e.EntityValue, e.EntityArn} {
+					if v := awsclient.StringValue(id); v != "" {
+						affected[v] = append(affected[v], ev)
+					}
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return awsclient.Wrap(err, errDescribeEntities)
+		}
+	}
+	return nil
+}
+
+// flag sets the AWSHealth condition of all managed resources that are
+// affected by open events, and clears it from the ones that no longer are.
+// The events are keyed by the ProviderConfig of the resources they may
+// affect. Resources that cannot be patched do not stop the others.
+func (p *poller) flag(ctx context.Context, affected map[string]map[string][]Event) error {
+	var errs []error
+	for gvk := range p.scheme.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, groupSuffix) || !strings.HasSuffix(gvk.Kind, "List") {
+			continue
+		}
+		obj, err := p.scheme.New(gvk)
+		if err != nil {
+			continue
+		}
+		l, ok := obj.(resource.ManagedList)
+		if !ok {
+			continue
+		}
+		if err := p.kube.List(ctx, l); err != nil {
+			errs = append(errs, errors.Wrap(err, errListManaged))
+			continue
+		}
+		for _, mg := range l.GetItems() {
+			ref := mg.GetProviderConfigReference()
+			if ref == nil {
+				continue
+			}
+			events, ok := affected[ref.Name]
+			if !ok {
+				continue
+			}
+			c, ok := Condition(mg, Events(mg, events))
+			if !ok {
+				continue
+			}
+			orig := mg.DeepCopyObject().(client.Object)
+			mg.SetConditions(c)
+			if err := p.kube.Status().Patch(ctx, mg, client.MergeFromWithOptions(orig, client.MergeFromWithOptimisticLock{})); err != nil {
+				p.log.Debug("Cannot set AWS Health condition", "name", mg.GetName(), "error", err)
+				errs = append(errs, errors.Wrap(err, errPatchStatus))
+			}
+		}
+	}
+	return k8serrors.NewAggregate(errs)
+}
+
+// Events returns the events that affect the given managed resource. An
+// entity is matched by the external name of the resource or by any ARN in
+// its observed state.
+func Events(mg resource.Managed, affected map[string][]Event) []Event {
+	var events []Event
+	seen := map[string]bool{}
+	for _, id := range identifiers(mg) {
+		for _, e := range affected[id] {
+			if !seen[e.ARN] {
+				seen[e.ARN] = true
+				events = append(events, e)
+			}
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].ARN < events[j].ARN })
+	return events
+}
+
+// identifiers returns the external name of the managed resource and the
+// string values of the top-level fields of its observed state whose name
+// ends with ARN.
+func identifiers(mg resource.Managed) []string {
+	ids := []string{}
+	if en := meta.GetExternalName(mg); en != "" {
+		ids = append(ids, en)
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return ids
+	}
+	atProvider, _, _ := unstructured.NestedMap(u, "status", "atProvider")
+	for k, v := range atProvider {
+		if s, ok := v.(string); ok && s != "" && strings.HasSuffix(strings.ToLower(k), "arn") {
+			ids = append(ids, s)
+		}
+	}
+	return ids
+}
+
+// Condition returns the AWSHealth condition of a managed resource that is
+// affected by the given events. It returns false if the condition does not
+// have to be changed: resources that were never affected do not get one.
+func Condition(mg resource.Managed, events []Event) (xpv1.Condition, bool) {
+	current := mg.GetCondition(TypeAWSHealth)
+	if len(events) == 0 {
+		if current.Status != corev1.ConditionTrue {
+			return xpv1.Condition{}, false
+		}
+		return xpv1.Condition{
+			Type:               TypeAWSHealth,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonNoOpenEvents,
+		}, true
+	}
+	msgs := make([]string, len(events))
+	for i, e := range events {
+		msgs[i] = fmt.Sprintf("%s %s event %s in %s", e.StatusCode, e.Service, e.ARN, e.Region)
+	}
+	c := xpv1.Condition{
+		Type:               TypeAWSHealth,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             xpv1.ConditionReason(events[0].TypeCode),
+		Message:            strings.Join(msgs, "; "),
+	}
+	if current.Equal(c) {
+		return xpv1.Condition{}, false
+	}
+	return c, true
+}
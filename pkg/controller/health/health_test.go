@@ -0,0 +1,277 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/health"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/health/healthiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8serrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+const (
+	testEventARN  = "arn:aws:health:us-east-1::event/SQS/AWS_SQS_OPERATIONAL_ISSUE/AWS_SQS_OPERATIONAL_ISSUE_EXAMPLE"
+	testQueueARN  = "arn:aws:sqs:us-east-1:123456789012:example"
+	testQueueURL  = "https://sqs.us-east-1.amazonaws.com/123456789012/example"
+	testEventType = "AWS_SQS_OPERATIONAL_ISSUE"
+)
+
+var testEvent = Event{
+	ARN:        testEventARN,
+	TypeCode:   testEventType,
+	Service:    "SQS",
+	Region:     "us-east-1",
+	StatusCode: svcsdk.EventStatusCodeOpen,
+}
+
+type mockClient struct {
+	svcsdkapi.HealthAPI
+
+	entities []*svcsdk.AffectedEntity
+}
+
+func (m *mockClient) DescribeEventsPagesWithContext(_ context.Context, _ *svcsdk.DescribeEventsInput, fn func(*svcsdk.DescribeEventsOutput, bool) bool, _ ...request.Option) error {
+	fn(&svcsdk.DescribeEventsOutput{Events: []*svcsdk.Event{{
+		Arn:           aws.String(testEvent.ARN),
+		EventTypeCode: aws.String(testEvent.TypeCode),
+		Service:       aws.String(testEvent.Service),
+		Region:        aws.String(testEvent.Region),
+		StatusCode:    aws.String(testEvent.StatusCode),
+	}}}, true)
+	return nil
+}
+
+func (m *mockClient) DescribeAffectedEntitiesPagesWithContext(_ context.Context, _ *svcsdk.DescribeAffectedEntitiesInput, fn func(*svcsdk.DescribeAffectedEntitiesOutput, bool) bool, _ ...request.Option) error {
+	fn(&svcsdk.DescribeAffectedEntitiesOutput{Entities: m.entities}, true)
+	return nil
+}
+
+func TestAffectedEntities(t *testing.T) {
+	cases := map[string]struct {
+		entities []*svcsdk.AffectedEntity
+		want     map[string][]Event
+	}{
+		"Impaired": {
+			entities: []*svcsdk.AffectedEntity{{
+				EventArn:    aws.String(testEventARN),
+				EntityValue: aws.String(testQueueARN),
+				StatusCode:  aws.String(svcsdk.EntityStatusCodeImpaired),
+			}},
+			want: map[string][]Event{testQueueARN: {testEvent}},
+		},
+		"Unimpaired": {
+			entities: []*svcsdk.AffectedEntity{{
+				EventArn:    aws.String(testEventARN),
+				EntityValue: aws.String(testQueueARN),
+				StatusCode:  aws.String(svcsdk.EntityStatusCodeUnimpaired),
+			}},
+			want: map[string][]Event{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := map[string][]Event{}
+			if err := AffectedEntities(context.Background(), &mockClient{entities: tc.entities}, got); err != nil {
+				t.Fatalf("AffectedEntities(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	affected := map[string][]Event{testQueueARN: {testEvent}}
+	cases := map[string]struct {
+		mg   *sqsv1beta1.Queue
+		want []Event
+	}{
+		"MatchedByObservedARN": {
+			mg: func() *sqsv1beta1.Queue {
+				q := &sqsv1beta1.Queue{}
+				meta.SetExternalName(q, testQueueURL)
+				q.Status.AtProvider.ARN = testQueueARN
+				return q
+			}(),
+			want: []Event{testEvent},
+		},
+		"MatchedByExternalName": {
+			mg: func() *sqsv1beta1.Queue {
+				q := &sqsv1beta1.Queue{}
+				meta.SetExternalName(q, testQueueARN)
+				return q
+			}(),
+			want: []Event{testEvent},
+		},
+		"NotAffected": {
+			mg: func() *sqsv1beta1.Queue {
+				q := &sqsv1beta1.Queue{}
+				meta.SetExternalName(q, testQueueURL)
+				return q
+			}(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Events(tc.mg, affected)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	type want struct {
+		c  xpv1.Condition
+		ok bool
+	}
+	affectedCondition := xpv1.Condition{
+		Type:    TypeAWSHealth,
+		Status:  corev1.ConditionTrue,
+		Reason:  testEventType,
+		Message: "open SQS event " + testEventARN + " in us-east-1",
+	}
+	cases := map[string]struct {
+		current []xpv1.Condition
+		events  []Event
+		want    want
+	}{
+		"NeverAffected": {
+			want: want{},
+		},
+		"Affected": {
+			events: []Event{testEvent},
+			want:   want{c: affectedCondition, ok: true},
+		},
+		"StillAffected": {
+			current: []xpv1.Condition{affectedCondition},
+			events:  []Event{testEvent},
+			want:    want{},
+		},
+		"NoLongerAffected": {
+			current: []xpv1.Condition{affectedCondition},
+			want: want{
+				c:  xpv1.Condition{Type: TypeAWSHealth, Status: corev1.ConditionFalse, Reason: ReasonNoOpenEvents},
+				ok: true,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := &sqsv1beta1.Queue{}
+			q.SetConditions(tc.current...)
+			c, ok := Condition(q, tc.events)
+			if diff := cmp.Diff(tc.want.ok, ok); diff != "" {
+				t.Errorf("ok: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.c, c, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlag(t *testing.T) {
+	errBoom := errors.New("boom")
+	queue := func(name, pc string) sqsv1beta1.Queue {
+		q := sqsv1beta1.Queue{}
+		q.SetName(name)
+		q.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+		meta.SetExternalName(&q, testQueueURL)
+		return q
+	}
+	type want struct {
+		patched []string
+		err     error
+	}
+	cases := map[string]struct {
+		affected map[string]map[string][]Event
+		patchErr error
+		want     want
+	}{
+		"KeyedByProviderConfig": {
+			affected: map[string]map[string][]Event{
+				"a": {testQueueURL: {testEvent}},
+				"b": {},
+			},
+			want: want{patched: []string{"a"}},
+		},
+		"UnknownProviderConfig": {
+			affected: map[string]map[string][]Event{
+				"a": {testQueueURL: {testEvent}},
+			},
+			want: want{patched: []string{"a"}},
+		},
+		"ContinuePastPatchErrors": {
+			affected: map[string]map[string][]Event{
+				"a": {testQueueURL: {testEvent}},
+				"b": {testQueueURL: {testEvent}},
+			},
+			patchErr: errBoom,
+			want: want{
+				patched: []string{"a", "b"},
+				err:     k8serrors.NewAggregate([]error{errors.Wrap(errBoom, errPatchStatus), errors.Wrap(errBoom, errPatchStatus)}),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			if err := sqsv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+				t.Fatal(err)
+			}
+			var patched []string
+			kube := &test.MockClient{
+				MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+					if l, ok := obj.(*sqsv1beta1.QueueList); ok {
+						l.Items = []sqsv1beta1.Queue{queue("a", "a"), queue("b", "b")}
+					}
+					return nil
+				},
+				MockStatusPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					patched = append(patched, obj.GetName())
+					return tc.patchErr
+				},
+			}
+			p := &poller{kube: kube, scheme: s, log: logging.NewNopLogger()}
+			err := p.flag(context.Background(), tc.affected)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("flag(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("flag(...): -want patched, +got patched:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/identitystore/identitystoreiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/identitystore/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+type mockIdentityStoreClient struct {
+	identitystoreiface.IdentityStoreAPI
+
+	ListGroupsPagesWithContextFunc func(_ aws.Context, _ *svcsdk.ListGroupsInput, cb func(*svcsdk.ListGroupsOutput, bool) bool, _ ...request.Option) error
+}
+
+func (m *mockIdentityStoreClient) ListGroupsPagesWithContext(ctx aws.Context, in *svcsdk.ListGroupsInput, cb func(*svcsdk.ListGroupsOutput, bool) bool, opts ...request.Option) error {
+	return m.ListGroupsPagesWithContextFunc(ctx, in, cb, opts...)
+}
+
+type groupModifier func(*svcapitypes.Group)
+
+func withGroupID(id string) groupModifier {
+	return func(g *svcapitypes.Group) { g.Status.AtProvider.GroupID = id }
+}
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(g *svcapitypes.Group) { g.Status.ConditionedStatus.Conditions = c }
+}
+
+func group(m ...groupModifier) *svcapitypes.Group {
+	cr := &svcapitypes.Group{
+		Spec: svcapitypes.GroupSpec{
+			ForProvider: svcapitypes.GroupParameters{
+				IdentityStoreID: "d-1234567890",
+				DisplayName:     "admins",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.Group
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *mockIdentityStoreClient
+		cr     *svcapitypes.Group
+		want   want
+	}{
+		"Found": {
+			client: &mockIdentityStoreClient{
+				ListGroupsPagesWithContextFunc: func(_ aws.Context, in *svcsdk.ListGroupsInput, cb func(*svcsdk.ListGroupsOutput, bool) bool, _ ...request.Option) error {
+					if awsclient.StringValue(in.Filters[0].AttributeValue) != "admins" {
+						return errors.New("unexpected filter")
+					}
+					cb(&svcsdk.ListGroupsOutput{Groups: []*svcsdk.Group{{
+						DisplayName: awsclient.String("admins"),
+						GroupId:     awsclient.String("1234"),
+					}}}, true)
+					return nil
+				},
+			},
+			cr: group(),
+			want: want{
+				cr:     group(withGroupID("1234"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			client: &mockIdentityStoreClient{
+				ListGroupsPagesWithContextFunc: func(_ aws.Context, _ *svcsdk.ListGroupsInput, cb func(*svcsdk.ListGroupsOutput, bool) bool, _ ...request.Option) error {
+					cb(&svcsdk.ListGroupsOutput{}, true)
+					return nil
+				},
+			},
+			cr: group(),
+			want: want{
+				cr: group(),
+			},
+		},
+		"ListError": {
+			client: &mockIdentityStoreClient{
+				ListGroupsPagesWithContextFunc: func(_ aws.Context, _ *svcsdk.ListGroupsInput, _ func(*svcsdk.ListGroupsOutput, bool) bool, _ ...request.Option) error {
+					return errBoom
+				},
+			},
+			cr: group(),
+			want: want{
+				cr:  group(),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
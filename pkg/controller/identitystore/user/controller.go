@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+
+	svcapi "github.com/aws/aws-sdk-go/service/identitystore"
+	svcsdk "github.com/aws/aws-sdk-go/service/identitystore"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/identitystore/identitystoreiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/identitystore/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a User resource"
+
+	errCreateSession = "cannot create a new session"
+	errList          = "failed to list Users"
+	errNotFound      = "user does not exist in the Identity Store, users can only be looked up"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.User)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.IdentityStoreAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	var found *svcsdk.User
+	err := e.client.ListUsersPagesWithContext(ctx, &svcsdk.ListUsersInput{
+		IdentityStoreId: awsclient.String(cr.Spec.ForProvider.IdentityStoreID),
+		Filters: []*svcsdk.Filter{{
+			AttributePath:  awsclient.String("UserName"),
+			AttributeValue: awsclient.String(cr.Spec.ForProvider.UserName),
+		}},
+	}, func(page *svcsdk.ListUsersOutput, _ bool) bool {
+		if len(page.Users) > 0 {
+			found = page.Users[0]
+		}
+		return found == nil
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errList)
+	}
+	if found == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.Status.AtProvider.UserID = awsclient.StringValue(found.UserId)
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
+// Create fails since the Identity Store API does not support creating
+// users. They have to be created in the identity source of the SSO instance.
+func (e *external) Create(_ context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mg.(*svcapitypes.User); !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalCreation{}, errors.New(errNotFound)
+}
+
+func (e *external) Update(_ context.Context, _ cpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Delete leaves the user in place since it was not created by this
+// controller.
+func (e *external) Delete(_ context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.User)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
@@ -15,10 +15,17 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
 	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -31,13 +38,26 @@ import (
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errGetBootstrapBrokers = "cannot get bootstrap brokers"
+	errCreateAutoScaling   = "cannot create application auto scaling client"
+	errDescribeAutoScaling = "cannot describe broker storage auto scaling"
+	errRegisterAutoScaling = "cannot register broker storage auto scaling"
+
+	// allBrokers addresses every broker of a cluster in UpdateBrokerStorage.
+	allBrokers = "All"
+)
+
 // SetupCluster adds a controller that reconciles Cluster.
 func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.ClusterGroupKind)
 	opts := []option{
 		func(e *external) {
+			h := &hooks{kube: e.kube, client: e.client, newAutoScalingClientFn: newAutoScalingClient}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.update = h.update
 			e.preDelete = preDelete
 			e.postDelete = postDelete
 			e.preCreate = preCreate
@@ -78,7 +98,7 @@ func preObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.Describe
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -94,33 +114,60 @@ func postObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.Describ
 	}
 
 	obs.ConnectionDetails = managed.ConnectionDetails{
-		// see: https://docs.aws.amazon.com/msk/latest/developerguide/client-access.html
-		// no endpoint informations available in DescribeClusterOutput only endpoints for zookeeperPlain/Tls
 		"zookeeperEndpointPlain": []byte(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString)),
 		"zookeeperEndpointTls":   []byte(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectStringTls)),
-		"clusterEndpointPlain":   []byte(strings.ReplaceAll(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString), "2181", "9092")),
-		"clusterEndpointTls":     []byte(strings.ReplaceAll(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString), "2181", "9094")),
-		"clusterEndpointIAM":     []byte(strings.ReplaceAll(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString), "2181", "9098")),
 	}
 
+	// The bootstrap brokers are only available once the cluster is active.
+	if awsclients.StringValue(obj.ClusterInfo.State) != svcsdk.ClusterStateActive {
+		return obs, nil
+	}
+	brokers, err := h.client.GetBootstrapBrokersWithContext(ctx, &svcsdk.GetBootstrapBrokersInput{
+		ClusterArn: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetBootstrapBrokers)
+	}
+	// see: https://docs.aws.amazon.com/msk/latest/developerguide/client-access.html
+	obs.ConnectionDetails["clusterEndpointPlain"] = []byte(awsclients.StringValue(brokers.BootstrapBrokerString))
+	obs.ConnectionDetails["clusterEndpointTls"] = []byte(awsclients.StringValue(brokers.BootstrapBrokerStringTls))
+	obs.ConnectionDetails["clusterEndpointIAM"] = []byte(awsclients.StringValue(brokers.BootstrapBrokerStringSaslIam))
+	obs.ConnectionDetails["clusterEndpointSaslScram"] = []byte(awsclients.StringValue(brokers.BootstrapBrokerStringSaslScram))
+
+	// Broker storage auto scaling is left untouched unless it is configured.
+	if !obs.ResourceUpToDate || cr.Spec.ForProvider.StorageAutoScaling == nil {
+		return obs, nil
+	}
+	as, err := h.newAutoScalingClientFn(ctx, h.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCreateAutoScaling)
+	}
+	target, policy, err := describeStorageAutoScaling(ctx, as, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obs.ResourceUpToDate = IsStorageAutoScalingUpToDate(cr.Spec.ForProvider.StorageAutoScaling, target, policy)
 	return obs, nil
 }
 
 func preCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateClusterInput) error {
 	obj.ClusterName = awsclients.String(meta.GetExternalName(cr))
 	obj.BrokerNodeGroupInfo = &svcsdk.BrokerNodeGroupInfo{
-		ClientSubnets:  cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.ClientSubnets,
-		InstanceType:   cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.InstanceType,
-		SecurityGroups: cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.SecurityGroups,
-		StorageInfo: &svcsdk.StorageInfo{
-			EbsStorageInfo: &svcsdk.EBSStorageInfo{
-				VolumeSize: cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.StorageInfo.EBSStorageInfo.VolumeSize,
-			},
-		},
+		BrokerAZDistribution: cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.BrokerAZDistribution,
+		ClientSubnets:        cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.ClientSubnets,
+		InstanceType:         cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.InstanceType,
+		SecurityGroups:       cr.Spec.ForProvider.CustomBrokerNodeGroupInfo.SecurityGroups,
 	}
-	obj.ConfigurationInfo = &svcsdk.ConfigurationInfo{
-		Arn:      cr.Spec.ForProvider.CustomConfigurationInfo.ARN,
-		Revision: cr.Spec.ForProvider.CustomConfigurationInfo.Revision,
+	if size := desiredVolumeSize(cr.Spec.ForProvider); size != nil {
+		obj.BrokerNodeGroupInfo.StorageInfo = &svcsdk.StorageInfo{
+			EbsStorageInfo: &svcsdk.EBSStorageInfo{VolumeSize: size},
+		}
+	}
+	if cr.Spec.ForProvider.CustomConfigurationInfo != nil {
+		obj.ConfigurationInfo = &svcsdk.ConfigurationInfo{
+			Arn:      cr.Spec.ForProvider.CustomConfigurationInfo.ARN,
+			Revision: cr.Spec.ForProvider.CustomConfigurationInfo.Revision,
+		}
 	}
 	return nil
 }
@@ -173,3 +220,206 @@ func LateInitialize(cr *svcapitypes.ClusterParameters, obj *svcsdk.DescribeClust
 
 	return nil
 }
+
+type hooks struct {
+	kube                   client.Client
+	client                 svcsdkapi.KafkaAPI
+	newAutoScalingClientFn func(ctx context.Context, kube client.Client, cr *svcapitypes.Cluster) (applicationautoscalingiface.ApplicationAutoScalingAPI, error)
+}
+
+func newAutoScalingClient(ctx context.Context, kube client.Client, cr *svcapitypes.Cluster) (applicationautoscalingiface.ApplicationAutoScalingAPI, error) {
+	sess, err := awsclients.GetConfigV1(ctx, kube, cr, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return applicationautoscaling.New(sess), nil
+}
+
+// NOTE: Client authentication and encryption settings are only applied on
+// creation. Broker storage auto scaling is checked in postObserve, which has
+// access to the reconcile context.
+func isUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) (bool, error) {
+	// MSK rejects any update unless the cluster is active.
+	if awsclients.StringValue(obj.ClusterInfo.State) != svcsdk.ClusterStateActive {
+		return true, nil
+	}
+	return GenerateClusterUpdate(cr.Spec.ForProvider, obj.ClusterInfo) == nil, nil
+}
+
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	arn := awsclients.String(meta.GetExternalName(cr))
+	resp, err := h.client.DescribeClusterWithContext(ctx, &svcsdk.DescribeClusterInput{ClusterArn: arn})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribe)
+	}
+	info := resp.ClusterInfo
+
+	// MSK only accepts a single update operation at a time, the remaining
+	// ones are applied in the following reconciles once the cluster is
+	// active again.
+	switch u := GenerateClusterUpdate(cr.Spec.ForProvider, info).(type) {
+	case *svcsdk.UpdateBrokerCountInput:
+		u.ClusterArn, u.CurrentVersion = arn, info.CurrentVersion
+		_, err = h.client.UpdateBrokerCountWithContext(ctx, u)
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+	case *svcsdk.UpdateBrokerStorageInput:
+		u.ClusterArn, u.CurrentVersion = arn, info.CurrentVersion
+		_, err = h.client.UpdateBrokerStorageWithContext(ctx, u)
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+	case *svcsdk.UpdateBrokerTypeInput:
+		u.ClusterArn, u.CurrentVersion = arn, info.CurrentVersion
+		_, err = h.client.UpdateBrokerTypeWithContext(ctx, u)
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+	case *svcsdk.UpdateMonitoringInput:
+		u.ClusterArn, u.CurrentVersion = arn, info.CurrentVersion
+		_, err = h.client.UpdateMonitoringWithContext(ctx, u)
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+	}
+
+	if cr.Spec.ForProvider.StorageAutoScaling == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	as, err := h.newAutoScalingClientFn(ctx, h.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCreateAutoScaling)
+	}
+	return managed.ExternalUpdate{}, updateStorageAutoScaling(ctx, as, cr)
+}
+
+// GenerateClusterUpdate returns the first update input needed to bring the
+// brokers and the monitoring of the observed cluster in line with the desired
+// parameters, or nil if they are up to date. The cluster ARN and the current
+// version are left to the caller.
+func GenerateClusterUpdate(p svcapitypes.ClusterParameters, info *svcsdk.ClusterInfo) interface{} { // nolint:gocyclo
+	if p.NumberOfBrokerNodes != nil && awsclients.Int64Value(p.NumberOfBrokerNodes) != awsclients.Int64Value(info.NumberOfBrokerNodes) {
+		return &svcsdk.UpdateBrokerCountInput{TargetNumberOfBrokerNodes: p.NumberOfBrokerNodes}
+	}
+	if info.BrokerNodeGroupInfo != nil {
+		// Storage can only grow, and may already have been expanded beyond
+		// the desired size by storage auto scaling.
+		if size := desiredVolumeSize(p); size != nil && awsclients.Int64Value(size) > observedVolumeSize(info.BrokerNodeGroupInfo) {
+			return &svcsdk.UpdateBrokerStorageInput{
+				TargetBrokerEBSVolumeInfo: []*svcsdk.BrokerEBSVolumeInfo{{
+					KafkaBrokerNodeId: awsclients.String(allBrokers),
+					VolumeSizeGB:      size,
+				}},
+			}
+		}
+		if p.CustomBrokerNodeGroupInfo != nil && p.CustomBrokerNodeGroupInfo.InstanceType != nil &&
+			awsclients.StringValue(p.CustomBrokerNodeGroupInfo.InstanceType) != awsclients.StringValue(info.BrokerNodeGroupInfo.InstanceType) {
+			return &svcsdk.UpdateBrokerTypeInput{TargetInstanceType: p.CustomBrokerNodeGroupInfo.InstanceType}
+		}
+	}
+	if p.EnhancedMonitoring != nil && awsclients.StringValue(p.EnhancedMonitoring) != awsclients.StringValue(info.EnhancedMonitoring) {
+		return &svcsdk.UpdateMonitoringInput{EnhancedMonitoring: p.EnhancedMonitoring}
+	}
+	return nil
+}
+
+func desiredVolumeSize(p svcapitypes.ClusterParameters) *int64 {
+	if p.CustomBrokerNodeGroupInfo == nil || p.CustomBrokerNodeGroupInfo.StorageInfo == nil ||
+		p.CustomBrokerNodeGroupInfo.StorageInfo.EBSStorageInfo == nil {
+		return nil
+	}
+	return p.CustomBrokerNodeGroupInfo.StorageInfo.EBSStorageInfo.VolumeSize
+}
+
+func observedVolumeSize(info *svcsdk.BrokerNodeGroupInfo) int64 {
+	if info.StorageInfo == nil || info.StorageInfo.EbsStorageInfo == nil {
+		return 0
+	}
+	return awsclients.Int64Value(info.StorageInfo.EbsStorageInfo.VolumeSize)
+}
+
+// storageScalingPolicyName returns the name of the broker storage scaling
+// policy of the cluster with the given ARN.
+func storageScalingPolicyName(arn string) string {
+	// arn:aws:kafka:<region>:<account>:cluster/<name>/<uuid>
+	parts := strings.Split(arn, "/")
+	name := arn
+	if len(parts) >= 2 {
+		name = parts[1]
+	}
+	return fmt.Sprintf("%s-broker-storage-scaling", name)
+}
+
+func describeStorageAutoScaling(ctx context.Context, as applicationautoscalingiface.ApplicationAutoScalingAPI, arn string) (*applicationautoscaling.ScalableTarget, *applicationautoscaling.ScalingPolicy, error) {
+	targets, err := as.DescribeScalableTargetsWithContext(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
+		ServiceNamespace:  awsclients.String(applicationautoscaling.ServiceNamespaceKafka),
+		ScalableDimension: awsclients.String(applicationautoscaling.ScalableDimensionKafkaBrokerStorageVolumeSize),
+		ResourceIds:       []*string{awsclients.String(arn)},
+	})
+	if err != nil {
+		return nil, nil, awsclients.Wrap(err, errDescribeAutoScaling)
+	}
+	if len(targets.ScalableTargets) == 0 {
+		return nil, nil, nil
+	}
+	policies, err := as.DescribeScalingPoliciesWithContext(ctx, &applicationautoscaling.DescribeScalingPoliciesInput{
+		ServiceNamespace:  awsclients.String(applicationautoscaling.ServiceNamespaceKafka),
+		ScalableDimension: awsclients.String(applicationautoscaling.ScalableDimensionKafkaBrokerStorageVolumeSize),
+		ResourceId:        awsclients.String(arn),
+		PolicyNames:       []*string{awsclients.String(storageScalingPolicyName(arn))},
+	})
+	if err != nil {
+		return nil, nil, awsclients.Wrap(err, errDescribeAutoScaling)
+	}
+	if len(policies.ScalingPolicies) == 0 {
+		return targets.ScalableTargets[0], nil, nil
+	}
+	return targets.ScalableTargets[0], policies.ScalingPolicies[0], nil
+}
+
+// IsStorageAutoScalingUpToDate returns whether the observed scalable target
+// and scaling policy of the broker storage match the desired auto scaling.
+func IsStorageAutoScalingUpToDate(desired *svcapitypes.StorageAutoScaling, target *applicationautoscaling.ScalableTarget, policy *applicationautoscaling.ScalingPolicy) bool {
+	if desired == nil {
+		return target == nil
+	}
+	if target == nil || policy == nil || policy.TargetTrackingScalingPolicyConfiguration == nil {
+		return false
+	}
+	return awsclients.Int64Value(target.MaxCapacity) == desired.MaxCapacity &&
+		aws.Float64Value(policy.TargetTrackingScalingPolicyConfiguration.TargetValue) == float64(desired.TargetUtilization)
+}
+
+func updateStorageAutoScaling(ctx context.Context, as applicationautoscalingiface.ApplicationAutoScalingAPI, cr *svcapitypes.Cluster) error {
+	arn := meta.GetExternalName(cr)
+	desired := cr.Spec.ForProvider.StorageAutoScaling
+	target, policy, err := describeStorageAutoScaling(ctx, as, arn)
+	if err != nil {
+		return err
+	}
+	if IsStorageAutoScalingUpToDate(desired, target, policy) {
+		return nil
+	}
+	if _, err := as.RegisterScalableTargetWithContext(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  awsclients.String(applicationautoscaling.ServiceNamespaceKafka),
+		ScalableDimension: awsclients.String(applicationautoscaling.ScalableDimensionKafkaBrokerStorageVolumeSize),
+		ResourceId:        awsclients.String(arn),
+		MinCapacity:       awsclients.Int64(1),
+		MaxCapacity:       awsclients.Int64(int(desired.MaxCapacity)),
+	}); err != nil {
+		return awsclients.Wrap(err, errRegisterAutoScaling)
+	}
+	_, err = as.PutScalingPolicyWithContext(ctx, &applicationautoscaling.PutScalingPolicyInput{
+		ServiceNamespace:  awsclients.String(applicationautoscaling.ServiceNamespaceKafka),
+		ScalableDimension: awsclients.String(applicationautoscaling.ScalableDimensionKafkaBrokerStorageVolumeSize),
+		ResourceId:        awsclients.String(arn),
+		PolicyName:        awsclients.String(storageScalingPolicyName(arn)),
+		PolicyType:        awsclients.String(applicationautoscaling.PolicyTypeTargetTrackingScaling),
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			PredefinedMetricSpecification: &applicationautoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: awsclients.String(applicationautoscaling.MetricTypeKafkaBrokerStorageUtilization),
+			},
+			TargetValue: aws.Float64(float64(desired.TargetUtilization)),
+			// MSK does not support shrinking broker storage.
+			DisableScaleIn: awsclients.Bool(true),
+		},
+	})
+	return awsclients.Wrap(err, errRegisterAutoScaling)
+}
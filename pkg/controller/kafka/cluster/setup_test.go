@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling/applicationautoscalingiface"
+	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+)
+
+type mockKafkaClient struct {
+	kafkaiface.KafkaAPI
+}
+
+func (m *mockKafkaClient) GetBootstrapBrokersWithContext(_ context.Context, _ *svcsdk.GetBootstrapBrokersInput, _ ...request.Option) (*svcsdk.GetBootstrapBrokersOutput, error) {
+	return &svcsdk.GetBootstrapBrokersOutput{}, nil
+}
+
+type mockAutoScalingClient struct {
+	applicationautoscalingiface.ApplicationAutoScalingAPI
+}
+
+func (m *mockAutoScalingClient) DescribeScalableTargetsWithContext(_ context.Context, _ *applicationautoscaling.DescribeScalableTargetsInput, _ ...request.Option) (*applicationautoscaling.DescribeScalableTargetsOutput, error) {
+	return &applicationautoscaling.DescribeScalableTargetsOutput{}, nil
+}
+
+func TestPostObserve(t *testing.T) {
+	type args struct {
+		autoScaling *svcapitypes.StorageAutoScaling
+	}
+
+	cases := map[string]struct {
+		args
+		wantUpToDate bool
+		wantLookup   bool
+	}{
+		"AutoScalingNotConfigured": {
+			wantUpToDate: true,
+		},
+		"AutoScalingNotRegistered": {
+			args:         args{autoScaling: &svcapitypes.StorageAutoScaling{MaxCapacity: 1000, TargetUtilization: 60}},
+			wantUpToDate: false,
+			wantLookup:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			looked := false
+			h := &hooks{
+				client: &mockKafkaClient{},
+				newAutoScalingClientFn: func(_ context.Context, _ client.Client, _ *svcapitypes.Cluster) (applicationautoscalingiface.ApplicationAutoScalingAPI, error) {
+					looked = true
+					return &mockAutoScalingClient{}, nil
+				},
+			}
+			cr := &svcapitypes.Cluster{}
+			cr.Spec.ForProvider.StorageAutoScaling = tc.args.autoScaling
+			obj := &svcsdk.DescribeClusterOutput{ClusterInfo: &svcsdk.ClusterInfo{State: aws.String(svcsdk.ClusterStateActive)}}
+
+			obs, err := h.postObserve(context.Background(), cr, obj, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil)
+			if err != nil {
+				t.Fatalf("postObserve(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantUpToDate, obs.ResourceUpToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantLookup, looked); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateClusterUpdate(t *testing.T) {
+	params := func(brokers, volume int64, instanceType string) svcapitypes.ClusterParameters {
+		return svcapitypes.ClusterParameters{
+			NumberOfBrokerNodes: aws.Int64(brokers),
+			CustomClusterParameters: svcapitypes.CustomClusterParameters{
+				CustomBrokerNodeGroupInfo: &svcapitypes.CustomBrokerNodeGroupInfo{
+					InstanceType: aws.String(instanceType),
+					StorageInfo: &svcapitypes.StorageInfo{
+						EBSStorageInfo: &svcapitypes.EBSStorageInfo{VolumeSize: aws.Int64(volume)},
+					},
+				},
+			},
+		}
+	}
+	info := &svcsdk.ClusterInfo{
+		NumberOfBrokerNodes: aws.Int64(3),
+		BrokerNodeGroupInfo: &svcsdk.BrokerNodeGroupInfo{
+			InstanceType: aws.String("kafka.m5.large"),
+			StorageInfo: &svcsdk.StorageInfo{
+				EbsStorageInfo: &svcsdk.EBSStorageInfo{VolumeSize: aws.Int64(100)},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		p    svcapitypes.ClusterParameters
+		want interface{}
+	}{
+		"UpToDate": {
+			p: params(3, 100, "kafka.m5.large"),
+		},
+		"StorageExpandedByAutoScaling": {
+			p: params(3, 50, "kafka.m5.large"),
+		},
+		"BrokerCount": {
+			p:    params(6, 100, "kafka.m5.large"),
+			want: &svcsdk.UpdateBrokerCountInput{TargetNumberOfBrokerNodes: aws.Int64(6)},
+		},
+		"BrokerStorage": {
+			p: params(3, 200, "kafka.m5.large"),
+			want: &svcsdk.UpdateBrokerStorageInput{
+				TargetBrokerEBSVolumeInfo: []*svcsdk.BrokerEBSVolumeInfo{{
+					KafkaBrokerNodeId: aws.String(allBrokers),
+					VolumeSizeGB:      aws.Int64(200),
+				}},
+			},
+		},
+		"BrokerType": {
+			p:    params(3, 100, "kafka.m5.xlarge"),
+			want: &svcsdk.UpdateBrokerTypeInput{TargetInstanceType: aws.String("kafka.m5.xlarge")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateClusterUpdate(tc.p, info)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsStorageAutoScalingUpToDate(t *testing.T) {
+	type args struct {
+		desired *svcapitypes.StorageAutoScaling
+		target  *applicationautoscaling.ScalableTarget
+		policy  *applicationautoscaling.ScalingPolicy
+	}
+
+	target := &applicationautoscaling.ScalableTarget{MaxCapacity: aws.Int64(1000)}
+	policy := &applicationautoscaling.ScalingPolicy{
+		TargetTrackingScalingPolicyConfiguration: &applicationautoscaling.TargetTrackingScalingPolicyConfiguration{
+			TargetValue: aws.Float64(60),
+		},
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"NotDesiredNotRegistered": {
+			want: true,
+		},
+		"NotDesiredButRegistered": {
+			args: args{target: target, policy: policy},
+			want: false,
+		},
+		"DesiredNotRegistered": {
+			args: args{desired: &svcapitypes.StorageAutoScaling{MaxCapacity: 1000, TargetUtilization: 60}},
+			want: false,
+		},
+		"UpToDate": {
+			args: args{desired: &svcapitypes.StorageAutoScaling{MaxCapacity: 1000, TargetUtilization: 60}, target: target, policy: policy},
+			want: true,
+		},
+		"MaxCapacityChanged": {
+			args: args{desired: &svcapitypes.StorageAutoScaling{MaxCapacity: 2000, TargetUtilization: 60}, target: target, policy: policy},
+			want: false,
+		},
+		"TargetUtilizationChanged": {
+			args: args{desired: &svcapitypes.StorageAutoScaling{MaxCapacity: 1000, TargetUtilization: 70}, target: target, policy: policy},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsStorageAutoScalingUpToDate(tc.args.desired, tc.args.target, tc.args.policy)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
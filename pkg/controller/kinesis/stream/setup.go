@@ -133,7 +133,7 @@ func (u *updater) isUpToDate(cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamO
 			return false, nil
 		}
 
-		createKey, deleteKey := DifferenceShardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics, obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics)
+		createKey, deleteKey := DifferenceShardLevelMetrics(shardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics), shardLevelMonitoring(obj.StreamDescription.EnhancedMonitoring))
 		if len(createKey) != 0 || len(deleteKey) != 0 {
 			return false, nil
 		}
@@ -162,7 +162,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 
 	// we need information from stream for decisions
 	obj, err := u.client.DescribeStreamWithContext(ctx, &svcsdk.DescribeStreamInput{
-		StreamName: &cr.Name,
+		StreamName: awsclients.String(meta.GetExternalName(cr)),
 	})
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclients.Wrap(err, errCreate)
@@ -248,12 +248,12 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		return managed.ExternalUpdate{}, nil
 	}
 
-	enableMetrics, disableMetrics := DifferenceShardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics, obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics)
+	enableMetrics, disableMetrics := DifferenceShardLevelMetrics(shardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics), shardLevelMonitoring(obj.StreamDescription.EnhancedMonitoring))
 	if len(enableMetrics) != 0 &&
 		awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
 
 		if _, err := u.client.EnableEnhancedMonitoringWithContext(ctx, &svcsdk.EnableEnhancedMonitoringInput{
-			ShardLevelMetrics: cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics,
+			ShardLevelMetrics: enableMetrics,
 			StreamName:        awsclients.String(meta.GetExternalName(cr)),
 		}); err != nil {
 			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
@@ -266,7 +266,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
 
 		if _, err := u.client.DisableEnhancedMonitoringWithContext(ctx, &svcsdk.DisableEnhancedMonitoringInput{
-			ShardLevelMetrics: obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics,
+			ShardLevelMetrics: disableMetrics,
 			StreamName:        awsclients.String(meta.GetExternalName(cr)),
 		}); err != nil {
 			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
@@ -342,27 +342,59 @@ func DifferenceShardLevelMetrics(local []*string, remote []*string) ([]*string,
 func (u *updater) ActiveShards(cr *svcapitypes.Stream) (int64, error) {
 	var count int64
 
-	shards, err := u.client.ListShards(&svcsdk.ListShardsInput{
-		StreamName: &cr.Name,
-	})
-	if err != nil {
-		return count, err
+	// StreamName and NextToken are mutually exclusive, so only the first
+	// page is requested by name.
+	input := &svcsdk.ListShardsInput{
+		StreamName: awsclients.String(meta.GetExternalName(cr)),
+	}
+	for {
+		shards, err := u.client.ListShards(input)
+		if err != nil {
+			return count, err
+		}
+
+		for _, shard := range shards.Shards {
+			if shard.SequenceNumberRange == nil || shard.SequenceNumberRange.EndingSequenceNumber == nil {
+				count++
+			}
+		}
+
+		if shards.NextToken == nil {
+			return count, nil
+		}
+		input = &svcsdk.ListShardsInput{NextToken: shards.NextToken}
 	}
+}
 
-	for _, shard := range shards.Shards {
-		if shard.SequenceNumberRange.EndingSequenceNumber == nil {
-			count++
+// shardLevelMetrics flattens the desired enhanced metrics into a single list
+// of shard-level metric names.
+func shardLevelMetrics(in []*svcapitypes.EnhancedMetrics) []*string {
+	var out []*string
+	for _, m := range in {
+		if m != nil {
+			out = append(out, m.ShardLevelMetrics...)
 		}
 	}
+	return out
+}
 
-	return count, nil
+// shardLevelMonitoring flattens the observed enhanced monitoring settings into
+// a single list of shard-level metric names.
+func shardLevelMonitoring(in []*svcsdk.EnhancedMetrics) []*string {
+	var out []*string
+	for _, m := range in {
+		if m != nil {
+			out = append(out, m.ShardLevelMetrics...)
+		}
+	}
+	return out
 }
 
 // ListTags return the current tags
 func (u *updater) ListTags(cr *svcapitypes.Stream) (*svcsdk.ListTagsForStreamOutput, error) {
 
 	tags, err := u.client.ListTagsForStream(&svcsdk.ListTagsForStreamInput{
-		StreamName: &cr.Name,
+		StreamName: awsclients.String(meta.GetExternalName(cr)),
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/kinesis"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+)
+
+type mockListShards struct {
+	svcsdkapi.KinesisAPI
+	pages map[string]*svcsdk.ListShardsOutput
+}
+
+func (m *mockListShards) ListShards(in *svcsdk.ListShardsInput) (*svcsdk.ListShardsOutput, error) {
+	if in.NextToken != nil {
+		return m.pages[*in.NextToken], nil
+	}
+	return m.pages[aws.StringValue(in.StreamName)], nil
+}
+
+func TestActiveShards(t *testing.T) {
+	open := &svcsdk.Shard{SequenceNumberRange: &svcsdk.SequenceNumberRange{StartingSequenceNumber: aws.String("1")}}
+	closed := &svcsdk.Shard{SequenceNumberRange: &svcsdk.SequenceNumberRange{StartingSequenceNumber: aws.String("1"), EndingSequenceNumber: aws.String("2")}}
+
+	cr := &svcapitypes.Stream{ObjectMeta: metav1.ObjectMeta{Name: "cr-name"}}
+	meta.SetExternalName(cr, "stream")
+
+	cases := map[string]struct {
+		pages map[string]*svcsdk.ListShardsOutput
+		want  int64
+	}{
+		"SinglePage": {
+			pages: map[string]*svcsdk.ListShardsOutput{
+				"stream": {Shards: []*svcsdk.Shard{open, closed, open}},
+			},
+			want: 2,
+		},
+		"MultiplePages": {
+			pages: map[string]*svcsdk.ListShardsOutput{
+				"stream": {Shards: []*svcsdk.Shard{open, closed}, NextToken: aws.String("t1")},
+				"t1":     {Shards: []*svcsdk.Shard{open, open}},
+			},
+			want: 3,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := &updater{client: &mockListShards{pages: tc.pages}}
+			got, err := u.ActiveShards(cr)
+			if err != nil {
+				t.Fatalf("ActiveShards(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDifferenceShardLevelMetrics(t *testing.T) {
+	cases := map[string]struct {
+		local      []*svcapitypes.EnhancedMetrics
+		remote     []*svcsdk.EnhancedMetrics
+		wantCreate []*string
+		wantRemove []*string
+	}{
+		"NoneDesiredNoneObserved": {
+			wantCreate: []*string{},
+			wantRemove: []*string{},
+		},
+		"EnableMetric": {
+			local:      []*svcapitypes.EnhancedMetrics{{ShardLevelMetrics: aws.StringSlice([]string{"IncomingBytes"})}},
+			remote:     []*svcsdk.EnhancedMetrics{{}},
+			wantCreate: aws.StringSlice([]string{"IncomingBytes"}),
+			wantRemove: []*string{},
+		},
+		"DisableMetric": {
+			remote:     []*svcsdk.EnhancedMetrics{{ShardLevelMetrics: aws.StringSlice([]string{"OutgoingBytes"})}},
+			wantCreate: []*string{},
+			wantRemove: aws.StringSlice([]string{"OutgoingBytes"}),
+		},
+		"UpToDate": {
+			local:      []*svcapitypes.EnhancedMetrics{{ShardLevelMetrics: aws.StringSlice([]string{"IncomingBytes"})}},
+			remote:     []*svcsdk.EnhancedMetrics{{ShardLevelMetrics: aws.StringSlice([]string{"IncomingBytes"})}},
+			wantCreate: []*string{},
+			wantRemove: []*string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, remove := DifferenceShardLevelMetrics(shardLevelMetrics(tc.local), shardLevelMonitoring(tc.remote))
+			if diff := cmp.Diff(tc.wantCreate, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
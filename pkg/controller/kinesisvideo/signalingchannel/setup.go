@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signalingchannel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/kinesisvideo"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kinesisvideo/kinesisvideoiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetEndpoint = "cannot get endpoints of SignalingChannel"
+)
+
+// SetupSignalingChannel adds a controller that reconciles SignalingChannel.
+func SetupSignalingChannel(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.SignalingChannelGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.SignalingChannel{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.SignalingChannelGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.KinesisVideoAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.SignalingChannel, obj *svcsdk.DescribeSignalingChannelInput) error {
+	obj.ChannelName = awsclients.String(meta.GetExternalName(cr))
+	obj.ChannelARN = nil
+	return nil
+}
+
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.SignalingChannel, resp *svcsdk.DescribeSignalingChannelOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.ChannelInfo.ChannelStatus) {
+	case svcsdk.StatusActive:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.StatusCreating:
+		cr.SetConditions(xpv1.Creating())
+		return obs, nil
+	case svcsdk.StatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+		return obs, nil
+	default:
+		return obs, nil
+	}
+
+	// Endpoints are only returned for the master role since that is the
+	// side that is usually managed alongside the channel.
+	ep, err := h.client.GetSignalingChannelEndpointWithContext(ctx, &svcsdk.GetSignalingChannelEndpointInput{
+		ChannelARN: resp.ChannelInfo.ChannelARN,
+		SingleMasterChannelEndpointConfiguration: &svcsdk.SingleMasterChannelEndpointConfiguration{
+			Protocols: aws.StringSlice([]string{svcsdk.ChannelProtocolWss, svcsdk.ChannelProtocolHttps}),
+			Role:      awsclients.String(svcsdk.ChannelRoleMaster),
+		},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetEndpoint)
+	}
+	cr.Status.AtProvider.ResourceEndpointList = make([]*svcapitypes.ResourceEndpointListItem, len(ep.ResourceEndpointList))
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"arn": []byte(awsclients.StringValue(resp.ChannelInfo.ChannelARN)),
+	}
+	for i, e := range ep.ResourceEndpointList {
+		cr.Status.AtProvider.ResourceEndpointList[i] = &svcapitypes.ResourceEndpointListItem{
+			Protocol:         e.Protocol,
+			ResourceEndpoint: e.ResourceEndpoint,
+		}
+		obs.ConnectionDetails[strings.ToLower(awsclients.StringValue(e.Protocol))+"Endpoint"] = []byte(awsclients.StringValue(e.ResourceEndpoint))
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.SignalingChannel, resp *svcsdk.DescribeSignalingChannelOutput) (bool, error) {
+	// The channel cannot be modified while it is not active.
+	if awsclients.StringValue(resp.ChannelInfo.ChannelStatus) != svcsdk.StatusActive {
+		return true, nil
+	}
+	desired := cr.Spec.ForProvider.SingleMasterConfiguration
+	if desired == nil || desired.MessageTTLSeconds == nil {
+		return true, nil
+	}
+	observed := resp.ChannelInfo.SingleMasterConfiguration
+	return observed != nil && awsclients.Int64Value(desired.MessageTTLSeconds) == awsclients.Int64Value(observed.MessageTtlSeconds), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.SignalingChannel, obj *svcsdk.CreateSignalingChannelInput) error {
+	obj.ChannelName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.SignalingChannel, obj *svcsdk.UpdateSignalingChannelInput) error {
+	obj.ChannelARN = cr.Status.AtProvider.ChannelARN
+	obj.CurrentVersion = cr.Status.AtProvider.Version
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.SignalingChannel, obj *svcsdk.DeleteSignalingChannelInput) (bool, error) {
+	if cr.Status.AtProvider.ChannelARN == nil {
+		return true, nil
+	}
+	obj.ChannelARN = cr.Status.AtProvider.ChannelARN
+	obj.CurrentVersion = cr.Status.AtProvider.Version
+	return false, nil
+}
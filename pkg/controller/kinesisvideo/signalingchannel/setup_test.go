@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signalingchannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kinesisvideo"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kinesisvideo/kinesisvideoiface"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+)
+
+const channelARN = "arn:aws:kinesisvideo:us-east-1:123456789012:channel/channel/1"
+
+type mockClient struct {
+	svcsdkapi.KinesisVideoAPI
+	endpoint *svcsdk.GetSignalingChannelEndpointInput
+}
+
+func (m *mockClient) GetSignalingChannelEndpointWithContext(_ context.Context, in *svcsdk.GetSignalingChannelEndpointInput, _ ...request.Option) (*svcsdk.GetSignalingChannelEndpointOutput, error) {
+	m.endpoint = in
+	return &svcsdk.GetSignalingChannelEndpointOutput{ResourceEndpointList: []*svcsdk.ResourceEndpointListItem{
+		{Protocol: aws.String(svcsdk.ChannelProtocolWss), ResourceEndpoint: aws.String("wss://m-1.kinesisvideo.us-east-1.amazonaws.com")},
+		{Protocol: aws.String(svcsdk.ChannelProtocolHttps), ResourceEndpoint: aws.String("https://r-1.kinesisvideo.us-east-1.amazonaws.com")},
+	}}, nil
+}
+
+func TestPostObserve(t *testing.T) {
+	cases := map[string]struct {
+		status        string
+		wantEndpoint  *svcsdk.GetSignalingChannelEndpointInput
+		wantEndpoints []*svcapitypes.ResourceEndpointListItem
+		wantConn      managed.ConnectionDetails
+	}{
+		"Active": {
+			status: svcsdk.StatusActive,
+			wantEndpoint: &svcsdk.GetSignalingChannelEndpointInput{
+				ChannelARN: aws.String(channelARN),
+				SingleMasterChannelEndpointConfiguration: &svcsdk.SingleMasterChannelEndpointConfiguration{
+					Protocols: aws.StringSlice([]string{svcsdk.ChannelProtocolWss, svcsdk.ChannelProtocolHttps}),
+					Role:      aws.String(svcsdk.ChannelRoleMaster),
+				},
+			},
+			wantEndpoints: []*svcapitypes.ResourceEndpointListItem{
+				{Protocol: aws.String(svcsdk.ChannelProtocolWss), ResourceEndpoint: aws.String("wss://m-1.kinesisvideo.us-east-1.amazonaws.com")},
+				{Protocol: aws.String(svcsdk.ChannelProtocolHttps), ResourceEndpoint: aws.String("https://r-1.kinesisvideo.us-east-1.amazonaws.com")},
+			},
+			wantConn: managed.ConnectionDetails{
+				"arn":           []byte(channelARN),
+				"wssEndpoint":   []byte("wss://m-1.kinesisvideo.us-east-1.amazonaws.com"),
+				"httpsEndpoint": []byte("https://r-1.kinesisvideo.us-east-1.amazonaws.com"),
+			},
+		},
+		"Creating": {
+			status: svcsdk.StatusCreating,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockClient{}
+			h := &hooks{client: m}
+			cr := &svcapitypes.SignalingChannel{}
+			resp := &svcsdk.DescribeSignalingChannelOutput{ChannelInfo: &svcsdk.ChannelInfo{
+				ChannelARN:    aws.String(channelARN),
+				ChannelStatus: aws.String(tc.status),
+			}}
+			obs, err := h.postObserve(context.Background(), cr, resp, managed.ExternalObservation{ResourceExists: true}, nil)
+			if err != nil {
+				t.Fatalf("postObserve(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantEndpoint, m.endpoint, cmpopts.IgnoreUnexported(svcsdk.GetSignalingChannelEndpointInput{}, svcsdk.SingleMasterChannelEndpointConfiguration{})); diff != "" {
+				t.Errorf("endpoint: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEndpoints, cr.Status.AtProvider.ResourceEndpointList); diff != "" {
+				t.Errorf("endpoints: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConn, obs.ConnectionDetails); diff != "" {
+				t.Errorf("conn: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired *svcapitypes.SingleMasterConfiguration
+		status  string
+		want    bool
+	}{
+		"NoConfiguration": {
+			status: svcsdk.StatusActive,
+			want:   true,
+		},
+		"UpToDate": {
+			desired: &svcapitypes.SingleMasterConfiguration{MessageTTLSeconds: aws.Int64(60)},
+			status:  svcsdk.StatusActive,
+			want:    true,
+		},
+		"MessageTTLChanged": {
+			desired: &svcapitypes.SingleMasterConfiguration{MessageTTLSeconds: aws.Int64(120)},
+			status:  svcsdk.StatusActive,
+			want:    false,
+		},
+		"Updating": {
+			desired: &svcapitypes.SingleMasterConfiguration{MessageTTLSeconds: aws.Int64(120)},
+			status:  svcsdk.StatusUpdating,
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.SignalingChannel{}
+			cr.Spec.ForProvider.SingleMasterConfiguration = tc.desired
+			resp := &svcsdk.DescribeSignalingChannelOutput{ChannelInfo: &svcsdk.ChannelInfo{
+				ChannelStatus:             aws.String(tc.status),
+				SingleMasterConfiguration: &svcsdk.SingleMasterConfiguration{MessageTtlSeconds: aws.Int64(60)},
+			}}
+			got, err := isUpToDate(cr, resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/kinesisvideo"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kinesisvideo/kinesisvideoiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetDataEndpoint     = "cannot get data endpoint of Stream"
+	errUpdateDataRetention = "cannot update data retention of Stream"
+)
+
+// SetupStream adds a controller that reconciles Stream.
+func SetupStream(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.StreamGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = h.postObserve
+			e.lateInitialize = lateInitialize
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.update = h.update
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Stream{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.StreamGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.KinesisVideoAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamInput) error {
+	obj.StreamName = awsclients.String(meta.GetExternalName(cr))
+	obj.StreamARN = nil
+	return nil
+}
+
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Stream, resp *svcsdk.DescribeStreamOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.StreamInfo.Status) {
+	case svcsdk.StatusActive:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.StatusCreating:
+		cr.SetConditions(xpv1.Creating())
+		return obs, nil
+	case svcsdk.StatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+		return obs, nil
+	default:
+		return obs, nil
+	}
+
+	ep, err := h.client.GetDataEndpointWithContext(ctx, &svcsdk.GetDataEndpointInput{
+		StreamARN: resp.StreamInfo.StreamARN,
+		APIName:   awsclients.String(svcsdk.APINamePutMedia),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetDataEndpoint)
+	}
+	cr.Status.AtProvider.DataEndpoint = ep.DataEndpoint
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"arn":          []byte(awsclients.StringValue(resp.StreamInfo.StreamARN)),
+		"dataEndpoint": []byte(awsclients.StringValue(ep.DataEndpoint)),
+	}
+	return obs, nil
+}
+
+func lateInitialize(spec *svcapitypes.StreamParameters, resp *svcsdk.DescribeStreamOutput) error {
+	spec.DataRetentionInHours = awsclients.LateInitializeInt64Ptr(spec.DataRetentionInHours, resp.StreamInfo.DataRetentionInHours)
+	spec.MediaType = awsclients.LateInitializeStringPtr(spec.MediaType, resp.StreamInfo.MediaType)
+	spec.KMSKeyID = awsclients.LateInitializeStringPtr(spec.KMSKeyID, resp.StreamInfo.KmsKeyId)
+	return nil
+}
+
+func isUpToDate(cr *svcapitypes.Stream, resp *svcsdk.DescribeStreamOutput) (bool, error) {
+	// The stream cannot be modified while it is not active.
+	if awsclients.StringValue(resp.StreamInfo.Status) != svcsdk.StatusActive {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	switch {
+	case awsclients.Int64Value(p.DataRetentionInHours) != awsclients.Int64Value(resp.StreamInfo.DataRetentionInHours),
+		p.DeviceName != nil && awsclients.StringValue(p.DeviceName) != awsclients.StringValue(resp.StreamInfo.DeviceName),
+		p.MediaType != nil && awsclients.StringValue(p.MediaType) != awsclients.StringValue(resp.StreamInfo.MediaType):
+		return false, nil
+	}
+	return true, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.CreateStreamInput) error {
+	obj.StreamName = awsclients.String(meta.GetExternalName(cr))
+	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
+	return nil
+}
+
+// update changes the data retention period, which has its own API, and
+// returns. The stream is UPDATING until the change is applied, so the rest of
+// the stream is updated in the following reconciles once it is active again.
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Stream)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := awsclients.String(meta.GetExternalName(cr))
+	resp, err := h.client.DescribeStreamWithContext(ctx, &svcsdk.DescribeStreamInput{StreamName: name})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribe)
+	}
+	desired := awsclients.Int64Value(cr.Spec.ForProvider.DataRetentionInHours)
+	observed := awsclients.Int64Value(resp.StreamInfo.DataRetentionInHours)
+	if desired != observed {
+		in := &svcsdk.UpdateDataRetentionInput{
+			StreamName:     name,
+			CurrentVersion: resp.StreamInfo.Version,
+		}
+		if desired > observed {
+			in.Operation = awsclients.String(svcsdk.UpdateDataRetentionOperationIncreaseDataRetention)
+			in.DataRetentionChangeInHours = awsclients.Int64(int(desired - observed))
+		} else {
+			in.Operation = awsclients.String(svcsdk.UpdateDataRetentionOperationDecreaseDataRetention)
+			in.DataRetentionChangeInHours = awsclients.Int64(int(observed - desired))
+		}
+		_, err := h.client.UpdateDataRetentionWithContext(ctx, in)
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateDataRetention)
+	}
+	_, err = h.client.UpdateStreamWithContext(ctx, &svcsdk.UpdateStreamInput{
+		StreamName:     name,
+		CurrentVersion: resp.StreamInfo.Version,
+		DeviceName:     cr.Spec.ForProvider.DeviceName,
+		MediaType:      cr.Spec.ForProvider.MediaType,
+	})
+	return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.DeleteStreamInput) (bool, error) {
+	if cr.Status.AtProvider.StreamARN == nil {
+		return true, nil
+	}
+	return false, nil
+}
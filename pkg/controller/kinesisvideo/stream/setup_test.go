@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kinesisvideo"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kinesisvideo/kinesisvideoiface"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kinesisvideo/v1alpha1"
+)
+
+type mockClient struct {
+	svcsdkapi.KinesisVideoAPI
+	info *svcsdk.StreamInfo
+
+	endpoint      *svcsdk.GetDataEndpointInput
+	dataRetention *svcsdk.UpdateDataRetentionInput
+	stream        *svcsdk.UpdateStreamInput
+}
+
+func (m *mockClient) DescribeStreamWithContext(context.Context, *svcsdk.DescribeStreamInput, ...request.Option) (*svcsdk.DescribeStreamOutput, error) {
+	return &svcsdk.DescribeStreamOutput{StreamInfo: m.info}, nil
+}
+
+func (m *mockClient) GetDataEndpointWithContext(_ context.Context, in *svcsdk.GetDataEndpointInput, _ ...request.Option) (*svcsdk.GetDataEndpointOutput, error) {
+	m.endpoint = in
+	return &svcsdk.GetDataEndpointOutput{DataEndpoint: aws.String("https://s-1.kinesisvideo.us-east-1.amazonaws.com")}, nil
+}
+
+func (m *mockClient) UpdateDataRetentionWithContext(_ context.Context, in *svcsdk.UpdateDataRetentionInput, _ ...request.Option) (*svcsdk.UpdateDataRetentionOutput, error) {
+	m.dataRetention = in
+	return &svcsdk.UpdateDataRetentionOutput{}, nil
+}
+
+func (m *mockClient) UpdateStreamWithContext(_ context.Context, in *svcsdk.UpdateStreamInput, _ ...request.Option) (*svcsdk.UpdateStreamOutput, error) {
+	m.stream = in
+	return &svcsdk.UpdateStreamOutput{}, nil
+}
+
+func stream(retention int64, mediaType string) *svcapitypes.Stream {
+	cr := &svcapitypes.Stream{ObjectMeta: metav1.ObjectMeta{Name: "cr-name"}}
+	meta.SetExternalName(cr, "stream")
+	cr.Spec.ForProvider.DataRetentionInHours = aws.Int64(retention)
+	cr.Spec.ForProvider.MediaType = aws.String(mediaType)
+	return cr
+}
+
+func TestPostObserve(t *testing.T) {
+	cases := map[string]struct {
+		status       string
+		wantEndpoint *svcsdk.GetDataEndpointInput
+		wantConn     managed.ConnectionDetails
+	}{
+		"Active": {
+			status: svcsdk.StatusActive,
+			wantEndpoint: &svcsdk.GetDataEndpointInput{
+				StreamARN: aws.String("arn:aws:kinesisvideo:us-east-1:123456789012:stream/stream/1"),
+				APIName:   aws.String(svcsdk.APINamePutMedia),
+			},
+			wantConn: managed.ConnectionDetails{
+				"arn":          []byte("arn:aws:kinesisvideo:us-east-1:123456789012:stream/stream/1"),
+				"dataEndpoint": []byte("https://s-1.kinesisvideo.us-east-1.amazonaws.com"),
+			},
+		},
+		"Creating": {
+			status: svcsdk.StatusCreating,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockClient{}
+			h := &hooks{client: m}
+			resp := &svcsdk.DescribeStreamOutput{StreamInfo: &svcsdk.StreamInfo{
+				Status:    aws.String(tc.status),
+				StreamARN: aws.String("arn:aws:kinesisvideo:us-east-1:123456789012:stream/stream/1"),
+			}}
+			obs, err := h.postObserve(context.Background(), stream(24, "video/h264"), resp, managed.ExternalObservation{ResourceExists: true}, nil)
+			if err != nil {
+				t.Fatalf("postObserve(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantEndpoint, m.endpoint, cmpopts.IgnoreUnexported(svcsdk.GetDataEndpointInput{})); diff != "" {
+				t.Errorf("endpoint: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConn, obs.ConnectionDetails); diff != "" {
+				t.Errorf("conn: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	info := &svcsdk.StreamInfo{
+		DataRetentionInHours: aws.Int64(24),
+		MediaType:            aws.String("video/h264"),
+		Status:               aws.String(svcsdk.StatusActive),
+		Version:              aws.String("v1"),
+	}
+
+	cases := map[string]struct {
+		cr                *svcapitypes.Stream
+		wantDataRetention *svcsdk.UpdateDataRetentionInput
+		wantStream        *svcsdk.UpdateStreamInput
+	}{
+		"IncreaseDataRetention": {
+			cr: stream(48, "video/h265"),
+			wantDataRetention: &svcsdk.UpdateDataRetentionInput{
+				StreamName:                 aws.String("stream"),
+				CurrentVersion:             aws.String("v1"),
+				Operation:                  aws.String(svcsdk.UpdateDataRetentionOperationIncreaseDataRetention),
+				DataRetentionChangeInHours: aws.Int64(24),
+			},
+		},
+		"DecreaseDataRetention": {
+			cr: stream(6, "video/h264"),
+			wantDataRetention: &svcsdk.UpdateDataRetentionInput{
+				StreamName:                 aws.String("stream"),
+				CurrentVersion:             aws.String("v1"),
+				Operation:                  aws.String(svcsdk.UpdateDataRetentionOperationDecreaseDataRetention),
+				DataRetentionChangeInHours: aws.Int64(18),
+			},
+		},
+		"UpdateStream": {
+			cr: stream(24, "video/h265"),
+			wantStream: &svcsdk.UpdateStreamInput{
+				StreamName:     aws.String("stream"),
+				CurrentVersion: aws.String("v1"),
+				MediaType:      aws.String("video/h265"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockClient{info: info}
+			h := &hooks{client: m}
+			if _, err := h.update(context.Background(), tc.cr); err != nil {
+				t.Fatalf("update(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantDataRetention, m.dataRetention, cmpopts.IgnoreUnexported(svcsdk.UpdateDataRetentionInput{})); diff != "" {
+				t.Errorf("dataRetention: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantStream, m.stream, cmpopts.IgnoreUnexported(svcsdk.UpdateStreamInput{})); diff != "" {
+				t.Errorf("stream: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package function
 
 import (
 	"context"
+	"sort"
+	"strconv"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
@@ -21,6 +23,17 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errListAliases  = "cannot list aliases of Function in AWS"
+	errListVersions = "cannot list versions of Function in AWS"
+	errCreateAlias  = "cannot create alias of Function in AWS"
+	errUpdateAlias  = "cannot update alias of Function in AWS"
+	errDeleteAlias  = "cannot delete alias of Function in AWS"
+
+	// versionLatest is the unpublished version of a function.
+	versionLatest = "$LATEST"
+)
+
 // SetupFunction adds a controller that reconciles Function.
 func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.FunctionGroupKind)
@@ -30,9 +43,9 @@ func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
 			e.postObserve = postObserve
 			e.preDelete = preDelete
 			e.preCreate = preCreate
-			e.isUpToDate = isUpToDate
 			e.lateInitialize = LateInitialize
 			u := &updater{client: e.client}
+			e.isUpToDate = u.isUpToDate
 			e.update = u.update
 		},
 	}
@@ -43,6 +56,7 @@ func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.FunctionGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -87,6 +101,9 @@ func postObserve(_ context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFu
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	// GetFunctionOutput nests the state under Configuration, which the
+	// generated conversion does not map into the observation.
+	cr.Status.AtProvider.State = resp.Configuration.State
 	switch aws.StringValue(resp.Configuration.State) {
 	case string(svcapitypes.State_Active):
 		cr.SetConditions(xpv1.Available())
@@ -107,12 +124,12 @@ func preDelete(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.DeleteFu
 func isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool, error) {
 
 	// Compare CODE
-	// GetFunctionOutput returns
-	// Code *FunctionCodeLocation `type:"structure"`
-	// which does not map to
-	// Code *FunctionCode `type:"structure" required:"true"`
-	// which is used when creating the function.
-	// We can't currently properly implement a comparison
+	// GetFunctionOutput does not return the S3 location the code was deployed
+	// from, so the code can only be compared through its image URI or its
+	// SHA256 hash.
+	if !isUpToDateCode(cr, obj) {
+		return false, nil
+	}
 
 	// Compare CONFIGURATION
 	if aws.StringValue(cr.Spec.ForProvider.Description) != aws.StringValue(obj.Configuration.Description) {
@@ -173,6 +190,21 @@ func isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool,
 
 }
 
+// isUpToDateCode checks if the deployed code matches the image URI or the
+// SHA256 hash of the deployment package given in the spec.
+func isUpToDateCode(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
+	code := cr.Spec.ForProvider.CustomFunctionCodeParameters
+	if code.ImageURI != nil && obj.Code != nil &&
+		aws.StringValue(code.ImageURI) != aws.StringValue(obj.Code.ImageUri) {
+		return false
+	}
+	if code.CodeSHA256 != nil && obj.Configuration != nil &&
+		aws.StringValue(code.CodeSHA256) != aws.StringValue(obj.Configuration.CodeSha256) {
+		return false
+	}
+	return true
+}
+
 // isUpToDateEnvironment checks if FunctionConfiguration EnvironmentResponse Variables are up to date
 func isUpToDateEnvironment(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
 	// Handle nil pointer refs
@@ -260,6 +292,155 @@ type updater struct {
 	client svcsdkapi.LambdaAPI
 }
 
+func (u *updater) isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool, error) {
+	upToDate, err := isUpToDate(cr, obj)
+	if err != nil || !upToDate {
+		return upToDate, err
+	}
+	create, update, remove, err := u.diffAliases(context.TODO(), cr)
+	if err != nil {
+		return false, err
+	}
+	return len(create) == 0 && len(update) == 0 && len(remove) == 0, nil
+}
+
+// diffAliases returns the aliases that have to be created, updated or
+// removed to match the spec. Aliases are left alone if the spec does not
+// list any.
+func (u *updater) diffAliases(ctx context.Context, cr *svcapitypes.Function) ([]*svcsdk.CreateAliasInput, []*svcsdk.UpdateAliasInput, []*svcsdk.DeleteAliasInput, error) {
+	if cr.Spec.ForProvider.Aliases == nil {
+		return nil, nil, nil, nil
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	var observed []*svcsdk.AliasConfiguration
+	err := u.client.ListAliasesPagesWithContext(ctx, &svcsdk.ListAliasesInput{FunctionName: name}, func(page *svcsdk.ListAliasesOutput, _ bool) bool {
+		observed = append(observed, page.Aliases...)
+		return true
+	})
+	if err != nil {
+		return nil, nil, nil, aws.Wrap(err, errListAliases)
+	}
+	latest := versionLatest
+	if needsLatestVersion(cr.Spec.ForProvider.Aliases) {
+		var versions []*svcsdk.FunctionConfiguration
+		err := u.client.ListVersionsByFunctionPagesWithContext(ctx, &svcsdk.ListVersionsByFunctionInput{FunctionName: name}, func(page *svcsdk.ListVersionsByFunctionOutput, _ bool) bool {
+			versions = append(versions, page.Versions...)
+			return true
+		})
+		if err != nil {
+			return nil, nil, nil, aws.Wrap(err, errListVersions)
+		}
+		latest = LatestPublishedVersion(versions)
+	}
+	create, update, remove := DiffAliases(cr.Spec.ForProvider.Aliases, observed, latest)
+	for _, in := range create {
+		in.FunctionName = name
+	}
+	for _, in := range update {
+		in.FunctionName = name
+	}
+	for _, in := range remove {
+		in.FunctionName = name
+	}
+	return create, update, remove, nil
+}
+
+func (u *updater) syncAliases(ctx context.Context, cr *svcapitypes.Function) error {
+	create, update, remove, err := u.diffAliases(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for _, in := range create {
+		if _, err := u.client.CreateAliasWithContext(ctx, in); err != nil {
+			return aws.Wrap(err, errCreateAlias)
+		}
+	}
+	for _, in := range update {
+		if _, err := u.client.UpdateAliasWithContext(ctx, in); err != nil {
+			return aws.Wrap(err, errUpdateAlias)
+		}
+	}
+	for _, in := range remove {
+		if _, err := u.client.DeleteAliasWithContext(ctx, in); err != nil {
+			return aws.Wrap(err, errDeleteAlias)
+		}
+	}
+	return nil
+}
+
+func needsLatestVersion(aliases []svcapitypes.FunctionAlias) bool {
+	for _, a := range aliases {
+		if a.FunctionVersion == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// LatestPublishedVersion returns the most recently published version among
+// the given versions of a function, or $LATEST if no version was published.
+func LatestPublishedVersion(versions []*svcsdk.FunctionConfiguration) string {
+	latest := versionLatest
+	latestNumber := int64(0)
+	for _, v := range versions {
+		n, err := strconv.ParseInt(aws.StringValue(v.Version), 10, 64)
+		if err != nil {
+			continue
+		}
+		if n > latestNumber {
+			latest, latestNumber = aws.StringValue(v.Version), n
+		}
+	}
+	return latest
+}
+
+// DiffAliases returns the inputs to create, update and delete aliases so that
+// the observed aliases match the desired ones. Aliases without a function
+// version point to the given latest version. The function name is not set in
+// the returned inputs.
+func DiffAliases(desired []svcapitypes.FunctionAlias, observed []*svcsdk.AliasConfiguration, latest string) ([]*svcsdk.CreateAliasInput, []*svcsdk.UpdateAliasInput, []*svcsdk.DeleteAliasInput) {
+	observedByName := make(map[string]*svcsdk.AliasConfiguration, len(observed))
+	for _, a := range observed {
+		observedByName[aws.StringValue(a.Name)] = a
+	}
+	var create []*svcsdk.CreateAliasInput
+	var update []*svcsdk.UpdateAliasInput
+	desiredNames := make(map[string]bool, len(desired))
+	for _, a := range desired {
+		desiredNames[a.Name] = true
+		version := latest
+		if a.FunctionVersion != nil {
+			version = aws.StringValue(a.FunctionVersion)
+		}
+		o, ok := observedByName[a.Name]
+		switch {
+		case !ok:
+			create = append(create, &svcsdk.CreateAliasInput{
+				Name:            aws.String(a.Name),
+				Description:     a.Description,
+				FunctionVersion: aws.String(version),
+			})
+		case version != aws.StringValue(o.FunctionVersion),
+			aws.StringValue(a.Description) != aws.StringValue(o.Description):
+			update = append(update, &svcsdk.UpdateAliasInput{
+				Name:            aws.String(a.Name),
+				Description:     aws.String(aws.StringValue(a.Description)),
+				FunctionVersion: aws.String(version),
+			})
+		}
+	}
+	var remove []*svcsdk.DeleteAliasInput
+	for name := range observedByName {
+		if !desiredNames[name] {
+			remove = append(remove, &svcsdk.DeleteAliasInput{Name: aws.String(name)})
+		}
+	}
+	sort.Slice(remove, func(i, j int) bool {
+		return aws.StringValue(remove[i].Name) < aws.StringValue(remove[j].Name)
+	})
+	return create, update, remove
+}
+
 func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mg.(*svcapitypes.Function)
 	if !ok {
@@ -268,6 +449,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 
 	// https://docs.aws.amazon.com/sdk-for-go/api/service/lambda/#Lambda.UpdateFunctionCode
 	updateFunctionCodeInput := GenerateUpdateFunctionCodeInput(cr)
+	updateFunctionCodeInput.Publish = cr.Spec.ForProvider.Publish
 	if _, err := u.client.UpdateFunctionCodeWithContext(ctx, updateFunctionCodeInput); err != nil {
 		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
 	}
@@ -312,6 +494,10 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		}
 	}
 
+	if err := u.syncAliases(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	//	if _, err := u.client.UpdateFunctionEventInvokeConfigWithContext(ctx, &svcsdk.UpdateFunctionEventInvokeConfigInput{
 	//		FunctionName:       aws.String(meta.GetExternalName(cr)),
 	//		DestinationConfig : cr.Spec.ForProvider.DestinationConfig .,
@@ -326,7 +512,7 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 // Copied almost verbatim from the zz_conversions generated code
 func GenerateUpdateFunctionCodeInput(cr *svcapitypes.Function) *svcsdk.UpdateFunctionCodeInput {
 	f0 := &svcsdk.UpdateFunctionCodeInput{}
-	f0.SetFunctionName(cr.Name)
+	f0.SetFunctionName(meta.GetExternalName(cr))
 	if cr.Spec.ForProvider.CustomFunctionCodeParameters.ImageURI != nil {
 		f0.SetImageUri(*cr.Spec.ForProvider.CustomFunctionCodeParameters.ImageURI)
 	}
@@ -347,7 +533,7 @@ func GenerateUpdateFunctionCodeInput(cr *svcapitypes.Function) *svcsdk.UpdateFun
 // nolint:gocyclo
 func GenerateUpdateFunctionConfigurationInput(cr *svcapitypes.Function) *svcsdk.UpdateFunctionConfigurationInput {
 	res := &svcsdk.UpdateFunctionConfigurationInput{}
-	res.SetFunctionName(cr.Name)
+	res.SetFunctionName(meta.GetExternalName(cr))
 
 	if cr.Spec.ForProvider.DeadLetterConfig != nil {
 		f2 := &svcsdk.DeadLetterConfig{}
@@ -1,11 +1,13 @@
 package function
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 
@@ -26,6 +28,7 @@ func withSpec(p v1beta1.FunctionParameters) functionModifier {
 func function(m ...functionModifier) *v1beta1.Function {
 	cr := &v1beta1.Function{}
 	cr.Name = "test-function-name"
+	meta.SetExternalName(cr, cr.Name)
 	for _, f := range m {
 		f(cr)
 	}
@@ -633,3 +636,165 @@ func TestGenerateUpdateFunctionConfigurationInput(t *testing.T) {
 		})
 	}
 }
+
+func TestIsUpToDateCode(t *testing.T) {
+	type args struct {
+		cr  *v1beta1.Function
+		obj *svcsdk.GetFunctionOutput
+	}
+
+	code := func(c v1beta1.CustomFunctionCodeParameters) functionModifier {
+		return withSpec(v1beta1.FunctionParameters{
+			CustomFunctionParameters: v1beta1.CustomFunctionParameters{CustomFunctionCodeParameters: c},
+		})
+	}
+	observed := &svcsdk.GetFunctionOutput{
+		Code:          &svcsdk.FunctionCodeLocation{ImageUri: aws.String("repo:v1")},
+		Configuration: &svcsdk.FunctionConfiguration{CodeSha256: aws.String("abc=")},
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"S3WithoutHash": {
+			args: args{
+				cr:  function(code(v1beta1.CustomFunctionCodeParameters{S3Bucket: aws.String("bucket"), S3Key: aws.String("key")})),
+				obj: observed,
+			},
+			want: true,
+		},
+		"SameHash": {
+			args: args{
+				cr:  function(code(v1beta1.CustomFunctionCodeParameters{S3Key: aws.String("key"), CodeSHA256: aws.String("abc=")})),
+				obj: observed,
+			},
+			want: true,
+		},
+		"DifferentHash": {
+			args: args{
+				cr:  function(code(v1beta1.CustomFunctionCodeParameters{S3Key: aws.String("key"), CodeSHA256: aws.String("def=")})),
+				obj: observed,
+			},
+			want: false,
+		},
+		"SameImage": {
+			args: args{
+				cr:  function(code(v1beta1.CustomFunctionCodeParameters{ImageURI: aws.String("repo:v1")})),
+				obj: observed,
+			},
+			want: true,
+		},
+		"DifferentImage": {
+			args: args{
+				cr:  function(code(v1beta1.CustomFunctionCodeParameters{ImageURI: aws.String("repo:v2")})),
+				obj: observed,
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isUpToDateCode(tc.args.cr, tc.args.obj); got != tc.want {
+				t.Errorf("isUpToDateCode(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLatestPublishedVersion(t *testing.T) {
+	cases := map[string]struct {
+		versions []*svcsdk.FunctionConfiguration
+		want     string
+	}{
+		"NoPublishedVersion": {
+			versions: []*svcsdk.FunctionConfiguration{{Version: aws.String("$LATEST")}},
+			want:     "$LATEST",
+		},
+		"HighestVersion": {
+			versions: []*svcsdk.FunctionConfiguration{
+				{Version: aws.String("$LATEST")},
+				{Version: aws.String("2")},
+				{Version: aws.String("10")},
+				{Version: aws.String("9")},
+			},
+			want: "10",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := LatestPublishedVersion(tc.versions); got != tc.want {
+				t.Errorf("LatestPublishedVersion(...): want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDiffAliases(t *testing.T) {
+	type want struct {
+		create []*svcsdk.CreateAliasInput
+		update []*svcsdk.UpdateAliasInput
+		remove []*svcsdk.DeleteAliasInput
+	}
+
+	cases := map[string]struct {
+		desired  []v1beta1.FunctionAlias
+		observed []*svcsdk.AliasConfiguration
+		latest   string
+		want
+	}{
+		"UpToDate": {
+			desired: []v1beta1.FunctionAlias{
+				{Name: "live"},
+				{Name: "stable", FunctionVersion: aws.String("1")},
+			},
+			observed: []*svcsdk.AliasConfiguration{
+				{Name: aws.String("live"), FunctionVersion: aws.String("3")},
+				{Name: aws.String("stable"), FunctionVersion: aws.String("1")},
+			},
+			latest: "3",
+		},
+		"Changes": {
+			desired: []v1beta1.FunctionAlias{
+				{Name: "live"},
+				{Name: "stable", FunctionVersion: aws.String("2"), Description: aws.String("stable")},
+			},
+			observed: []*svcsdk.AliasConfiguration{
+				{Name: aws.String("stable"), FunctionVersion: aws.String("1")},
+				{Name: aws.String("old"), FunctionVersion: aws.String("1")},
+			},
+			latest: "3",
+			want: want{
+				create: []*svcsdk.CreateAliasInput{{Name: aws.String("live"), FunctionVersion: aws.String("3")}},
+				update: []*svcsdk.UpdateAliasInput{{Name: aws.String("stable"), FunctionVersion: aws.String("2"), Description: aws.String("stable")}},
+				remove: []*svcsdk.DeleteAliasInput{{Name: aws.String("old")}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, update, remove := DiffAliases(tc.desired, tc.observed, tc.latest)
+			if diff := cmp.Diff(tc.want.create, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.update, update); diff != "" {
+				t.Errorf("update: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffAliasesUnset(t *testing.T) {
+	// The updater has no client, so this fails if the aliases are listed.
+	u := &updater{}
+	create, update, remove, err := u.diffAliases(context.Background(), function())
+	if err != nil {
+		t.Errorf("diffAliases(...): unexpected error: %v", err)
+	}
+	if len(create) != 0 || len(update) != 0 || len(remove) != 0 {
+		t.Errorf("diffAliases(...): want no changes, got create %v, update %v, remove %v", create, update, remove)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"encoding/json"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	conditionArnLike      = "ArnLike"
+	conditionStringEquals = "StringEquals"
+
+	keySourceARN        = "AWS:SourceArn"
+	keySourceAccount    = "AWS:SourceAccount"
+	keyEventSourceToken = "lambda:EventSourceToken"
+)
+
+// policyDocument is the resource-based policy of a function as returned by
+// GetPolicy.
+type policyDocument struct {
+	Statement []json.RawMessage `json:"Statement"`
+}
+
+// PolicyStatement is a statement of the resource-based policy of a function.
+// Action and Principal are either a string or an object.
+type PolicyStatement struct {
+	Sid       string                            `json:"Sid"`
+	Action    interface{}                       `json:"Action"`
+	Principal interface{}                       `json:"Principal"`
+	Condition map[string]map[string]interface{} `json:"Condition,omitempty"`
+}
+
+// GenerateAddPermissionInput returns the input to add the given Permission.
+func GenerateAddPermissionInput(cr *svcapitypes.Permission) *svcsdk.AddPermissionInput {
+	p := cr.Spec.ForProvider
+	return &svcsdk.AddPermissionInput{
+		StatementId:      awsclients.String(meta.GetExternalName(cr)),
+		FunctionName:     p.FunctionName,
+		Qualifier:        p.Qualifier,
+		Action:           awsclients.String(p.Action),
+		Principal:        awsclients.String(p.Principal),
+		SourceArn:        p.SourceARN,
+		SourceAccount:    p.SourceAccount,
+		EventSourceToken: p.EventSourceToken,
+	}
+}
+
+// GenerateRemovePermissionInput returns the input to remove the given
+// Permission.
+func GenerateRemovePermissionInput(cr *svcapitypes.Permission) *svcsdk.RemovePermissionInput {
+	return &svcsdk.RemovePermissionInput{
+		StatementId:  awsclients.String(meta.GetExternalName(cr)),
+		FunctionName: cr.Spec.ForProvider.FunctionName,
+		Qualifier:    cr.Spec.ForProvider.Qualifier,
+	}
+}
+
+// FindStatement returns the statement with the given ID of the given policy
+// both as raw JSON and parsed. The statement is nil if the policy does not
+// contain it.
+func FindStatement(policy, sid string) (string, *PolicyStatement, error) {
+	doc := policyDocument{}
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return "", nil, err
+	}
+	for _, raw := range doc.Statement {
+		s := &PolicyStatement{}
+		if err := json.Unmarshal(raw, s); err != nil {
+			return "", nil, err
+		}
+		if s.Sid == sid {
+			return string(raw), s, nil
+		}
+	}
+	return "", nil, nil
+}
+
+// IsUpToDate returns true if the given statement grants what the given
+// parameters describe.
+func IsUpToDate(p svcapitypes.PermissionParameters, s *PolicyStatement) bool {
+	action, _ := s.Action.(string)
+	return p.Action == action &&
+		p.Principal == principal(s.Principal) &&
+		awsclients.StringValue(p.SourceARN) == condition(s, conditionArnLike, keySourceARN) &&
+		awsclients.StringValue(p.SourceAccount) == condition(s, conditionStringEquals, keySourceAccount) &&
+		awsclients.StringValue(p.EventSourceToken) == condition(s, conditionStringEquals, keyEventSourceToken)
+}
+
+// principal returns the principal of a statement in the form it was given to
+// AddPermission. Lambda stores an account ID as the ARN of the account root.
+func principal(p interface{}) string {
+	switch v := p.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if s, ok := v["Service"].(string); ok {
+			return s
+		}
+		if s, ok := v["AWS"].(string); ok {
+			// arn:aws:iam::123456789012:root
+			if parts := strings.Split(s, ":"); len(parts) == 6 && parts[5] == "root" {
+				return parts[4]
+			}
+			return s
+		}
+	}
+	return ""
+}
+
+func condition(s *PolicyStatement, operator, key string) string {
+	v, _ := s.Condition[operator][key].(string)
+	return v
+}
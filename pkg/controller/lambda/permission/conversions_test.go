@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	apiGatewayStatement = `{"Sid":"apigateway","Effect":"Allow","Principal":{"Service":"apigateway.amazonaws.com"},"Action":"lambda:InvokeFunction","Resource":"arn:aws:lambda:us-east-1:123456789012:function:example","Condition":{"ArnLike":{"AWS:SourceArn":"arn:aws:execute-api:us-east-1:123456789012:abcdef/*"}}}`
+	accountStatement    = `{"Sid":"account","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"lambda:GetFunction","Resource":"arn:aws:lambda:us-east-1:123456789012:function:example"}`
+	testPolicy          = `{"Version":"2012-10-17","Id":"default","Statement":[` + apiGatewayStatement + `,` + accountStatement + `]}`
+)
+
+func TestIsUpToDate(t *testing.T) {
+	apiGateway := svcapitypes.PermissionParameters{
+		Action:    "lambda:InvokeFunction",
+		Principal: "apigateway.amazonaws.com",
+		SourceARN: awsclients.String("arn:aws:execute-api:us-east-1:123456789012:abcdef/*"),
+	}
+	cases := map[string]struct {
+		sid  string
+		p    svcapitypes.PermissionParameters
+		want bool
+	}{
+		"ServiceUpToDate": {
+			sid:  "apigateway",
+			p:    apiGateway,
+			want: true,
+		},
+		"AccountUpToDate": {
+			sid: "account",
+			p: svcapitypes.PermissionParameters{
+				Action:    "lambda:GetFunction",
+				Principal: "210987654321",
+			},
+			want: true,
+		},
+		"SourceARNChanged": {
+			sid: "apigateway",
+			p: svcapitypes.PermissionParameters{
+				Action:    apiGateway.Action,
+				Principal: apiGateway.Principal,
+				SourceARN: awsclients.String("arn:aws:execute-api:us-east-1:123456789012:ghijkl/*"),
+			},
+			want: false,
+		},
+		"SourceAccountAdded": {
+			sid: "apigateway",
+			p: svcapitypes.PermissionParameters{
+				Action:        apiGateway.Action,
+				Principal:     apiGateway.Principal,
+				SourceARN:     apiGateway.SourceARN,
+				SourceAccount: awsclients.String("123456789012"),
+			},
+			want: false,
+		},
+		"PrincipalChanged": {
+			sid: "account",
+			p: svcapitypes.PermissionParameters{
+				Action:    "lambda:GetFunction",
+				Principal: "123456789012",
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, s, err := FindStatement(testPolicy, tc.sid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.p, s)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindStatement(t *testing.T) {
+	type want struct {
+		raw   string
+		found bool
+	}
+	cases := map[string]struct {
+		sid string
+		want
+	}{
+		"Found": {
+			sid:  "account",
+			want: want{raw: accountStatement, found: true},
+		},
+		"NotFound": {
+			sid: "sns",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw, s, err := FindStatement(testPolicy, tc.sid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.raw, raw); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.found, s != nil); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
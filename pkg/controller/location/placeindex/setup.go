@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placeindex
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/locationservice"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/location/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupPlaceIndex adds a controller that reconciles PlaceIndex.
+func SetupPlaceIndex(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.PlaceIndexGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.PlaceIndex{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.PlaceIndexGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.PlaceIndex, obj *svcsdk.DescribePlaceIndexInput) error {
+	obj.IndexName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.PlaceIndex, _ *svcsdk.DescribePlaceIndexOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.PlaceIndex, resp *svcsdk.DescribePlaceIndexOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	if (p.Description != nil && awsclients.StringValue(p.Description) != awsclients.StringValue(resp.Description)) ||
+		(p.PricingPlan != nil && awsclients.StringValue(p.PricingPlan) != awsclients.StringValue(resp.PricingPlan)) {
+		return false, nil
+	}
+	if p.DataSourceConfiguration != nil && (resp.DataSourceConfiguration == nil ||
+		awsclients.StringValue(p.DataSourceConfiguration.IntendedUse) != awsclients.StringValue(resp.DataSourceConfiguration.IntendedUse)) {
+		return false, nil
+	}
+	return true, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.PlaceIndex, obj *svcsdk.CreatePlaceIndexInput) error {
+	obj.IndexName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.PlaceIndex, obj *svcsdk.UpdatePlaceIndexInput) error {
+	obj.IndexName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.PlaceIndex, obj *svcsdk.DeletePlaceIndexInput) (bool, error) {
+	obj.IndexName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracker
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/location/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr   *svcapitypes.Tracker
+		resp *svcsdk.DescribeTrackerOutput
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UnsetFieldsAreIgnored": {
+			args: args{
+				cr: &svcapitypes.Tracker{Spec: svcapitypes.TrackerSpec{ForProvider: svcapitypes.TrackerParameters{
+					PricingPlan: awsclients.String(svcsdk.PricingPlanRequestBasedUsage),
+				}}},
+				resp: &svcsdk.DescribeTrackerOutput{
+					Description:           awsclients.String("set elsewhere"),
+					PositionFiltering:     awsclients.String(svcsdk.PositionFilteringTimeBased),
+					PricingPlan:           awsclients.String(svcsdk.PricingPlanRequestBasedUsage),
+					PricingPlanDataSource: awsclients.String("Esri"),
+				},
+			},
+			want: true,
+		},
+		"PositionFilteringChanged": {
+			args: args{
+				cr: &svcapitypes.Tracker{Spec: svcapitypes.TrackerSpec{ForProvider: svcapitypes.TrackerParameters{
+					PositionFiltering: awsclients.String(svcsdk.PositionFilteringDistanceBased),
+					PricingPlan:       awsclients.String(svcsdk.PricingPlanRequestBasedUsage),
+				}}},
+				resp: &svcsdk.DescribeTrackerOutput{
+					PositionFiltering: awsclients.String(svcsdk.PositionFilteringTimeBased),
+					PricingPlan:       awsclients.String(svcsdk.PricingPlanRequestBasedUsage),
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.args.cr, tc.args.resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
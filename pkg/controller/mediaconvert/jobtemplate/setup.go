@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobtemplate
+
+import (
+	"context"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediaconvert"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mediaconvert/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupJobTemplate adds a controller that reconciles JobTemplate.
+func SetupJobTemplate(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.JobTemplateGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.JobTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.JobTemplateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.JobTemplate, obj *svcsdk.GetJobTemplateInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.JobTemplate, _ *svcsdk.GetJobTemplateOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate reports whether all fields given in the spec match the observed
+// job template. Settings that are not given are defaulted by MediaConvert and
+// are therefore not compared.
+func isUpToDate(cr *svcapitypes.JobTemplate, resp *svcsdk.GetJobTemplateOutput) (bool, error) {
+	if !queueMatches(cr.Spec.ForProvider.Queue, resp.JobTemplate.Queue) {
+		return false, nil
+	}
+	observed := GenerateJobTemplate(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomJobTemplateParameters = cr.Spec.ForProvider.CustomJobTemplateParameters
+
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+// queueMatches reports whether the desired queue, given either as a name or
+// as an ARN, is the observed one. MediaConvert always reports the queue of a
+// job template as an ARN.
+func queueMatches(desired, observed *string) bool {
+	if desired == nil {
+		return true
+	}
+	d, o := awsclients.StringValue(desired), awsclients.StringValue(observed)
+	return d == o || strings.HasSuffix(o, ":queues/"+d)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.JobTemplate, obj *svcsdk.CreateJobTemplateInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.Queue = cr.Spec.ForProvider.Queue
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.JobTemplate, obj *svcsdk.UpdateJobTemplateInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.Queue != nil {
+		obj.Queue = cr.Spec.ForProvider.Queue
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.JobTemplate, obj *svcsdk.DeleteJobTemplateInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobtemplate
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mediaconvert/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const queueARN = "arn:aws:mediaconvert:us-east-1:123456789012:queues/example"
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.GetJobTemplateOutput{JobTemplate: &svcsdk.JobTemplate{
+		Priority: awsclients.Int64(0),
+		Queue:    awsclients.String(queueARN),
+		Settings: &svcsdk.JobTemplateSettings{
+			AdAvailOffset: awsclients.Int64(0),
+			TimecodeConfig: &svcsdk.TimecodeConfig{
+				Source: awsclients.String(svcsdk.TimecodeSourceZerobased),
+			},
+		},
+	}}
+	cases := map[string]struct {
+		spec svcapitypes.JobTemplateParameters
+		want bool
+	}{
+		"QueueGivenAsName": {
+			spec: svcapitypes.JobTemplateParameters{
+				Region: "us-east-1",
+				Settings: &svcapitypes.JobTemplateSettings{
+					TimecodeConfig: &svcapitypes.TimecodeConfig{Source: awsclients.String(svcsdk.TimecodeSourceZerobased)},
+				},
+				CustomJobTemplateParameters: svcapitypes.CustomJobTemplateParameters{
+					Queue: awsclients.String("example"),
+				},
+			},
+			want: true,
+		},
+		"QueueGivenAsARN": {
+			spec: svcapitypes.JobTemplateParameters{
+				Region: "us-east-1",
+				CustomJobTemplateParameters: svcapitypes.CustomJobTemplateParameters{
+					Queue: awsclients.String(queueARN),
+				},
+			},
+			want: true,
+		},
+		"QueueChanged": {
+			spec: svcapitypes.JobTemplateParameters{
+				Region: "us-east-1",
+				CustomJobTemplateParameters: svcapitypes.CustomJobTemplateParameters{
+					Queue: awsclients.String("other"),
+				},
+			},
+			want: false,
+		},
+		"ChangedNestedField": {
+			spec: svcapitypes.JobTemplateParameters{
+				Region: "us-east-1",
+				Settings: &svcapitypes.JobTemplateSettings{
+					TimecodeConfig: &svcapitypes.TimecodeConfig{Source: awsclients.String(svcsdk.TimecodeSourceEmbedded)},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.JobTemplate{Spec: svcapitypes.JobTemplateSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
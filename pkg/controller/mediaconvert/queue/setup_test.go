@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mediaconvert/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.GetQueueOutput{Queue: &svcsdk.Queue{
+		Description: awsclients.String("desc"),
+		PricingPlan: awsclients.String(svcsdk.PricingPlanOnDemand),
+		Status:      awsclients.String(svcsdk.QueueStatusActive),
+	}}
+	cases := map[string]struct {
+		spec svcapitypes.QueueParameters
+		want bool
+	}{
+		"DefaultsNotGiven": {
+			spec: svcapitypes.QueueParameters{
+				Region:      "us-east-1",
+				Description: awsclients.String("desc"),
+				Tags:        map[string]*string{"k": awsclients.String("v")},
+			},
+			want: true,
+		},
+		"Paused": {
+			spec: svcapitypes.QueueParameters{
+				Region: "us-east-1",
+				Status: awsclients.String(svcsdk.QueueStatusPaused),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Queue{Spec: svcapitypes.QueueSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
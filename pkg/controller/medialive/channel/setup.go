@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/medialive"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/medialive/medialiveiface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/medialive/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errStop = "cannot stop Channel before deletion"
+)
+
+// SetupChannel adds a controller that reconciles Channel.
+func SetupChannel(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ChannelGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = h.preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Channel{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ChannelGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.MediaLiveAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Channel, obj *svcsdk.DescribeChannelInput) error {
+	obj.ChannelId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Channel, resp *svcsdk.DescribeChannelOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.State) {
+	case svcsdk.ChannelStateIdle, svcsdk.ChannelStateRunning:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ChannelStateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ChannelStateCreateFailed, svcsdk.ChannelStateUpdateFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	case svcsdk.ChannelStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.ChannelStateDeleted:
+		// Deleted channels can still be described for a while.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	return obs, nil
+}
+
+// isUpToDate reports whether all fields given in the spec match the observed
+// channel. Fields that are not given are defaulted by MediaLive and are
+// therefore not compared.
+func isUpToDate(cr *svcapitypes.Channel, resp *svcsdk.DescribeChannelOutput) (bool, error) {
+	switch awsclients.StringValue(resp.State) {
+	case svcsdk.ChannelStateIdle, svcsdk.ChannelStateRunning:
+	default:
+		// The channel cannot be updated while it is in transition.
+		return true, nil
+	}
+	observed := GenerateChannel(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomChannelParameters = cr.Spec.ForProvider.CustomChannelParameters
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Channel, resp *svcsdk.CreateChannelOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Channel.Id))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Channel, obj *svcsdk.UpdateChannelInput) error {
+	obj.ChannelId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// preDelete stops a running channel first since only idle channels can be
+// deleted. The deletion is retried once the channel is idle.
+func (h *hooks) preDelete(ctx context.Context, cr *svcapitypes.Channel, obj *svcsdk.DeleteChannelInput) (bool, error) {
+	obj.ChannelId = awsclients.String(meta.GetExternalName(cr))
+	switch awsclients.StringValue(cr.Status.AtProvider.State) {
+	case svcsdk.ChannelStateRunning, svcsdk.ChannelStateRecovering:
+		_, err := h.client.StopChannelWithContext(ctx, &svcsdk.StopChannelInput{ChannelId: obj.ChannelId})
+		return true, awsclients.Wrap(err, errStop)
+	case svcsdk.ChannelStateStarting, svcsdk.ChannelStateStopping, svcsdk.ChannelStateDeleting:
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package input
+
+import (
+	"context"
+	"fmt"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/medialive"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/medialive/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupInput adds a controller that reconciles Input.
+func SetupInput(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.InputGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Input{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.InputGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Input, obj *svcsdk.DescribeInputInput) error {
+	obj.InputId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Input, resp *svcsdk.DescribeInputOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.State) {
+	case svcsdk.InputStateAttached, svcsdk.InputStateDetached:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.InputStateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.InputStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.InputStateDeleted:
+		// Deleted inputs can still be described for a while.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	obs.ConnectionDetails = managed.ConnectionDetails{}
+	for i, d := range resp.Destinations {
+		obs.ConnectionDetails[fmt.Sprintf("ingestEndpoint%dUrl", i)] = []byte(awsclients.StringValue(d.Url))
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Input, resp *svcsdk.DescribeInputOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case p.Name != nil && awsclients.StringValue(p.Name) != awsclients.StringValue(resp.Name),
+		p.RoleARN != nil && awsclients.StringValue(p.RoleARN) != awsclients.StringValue(resp.RoleArn),
+		p.InputSecurityGroups != nil && !sameStrings(p.InputSecurityGroups, resp.SecurityGroups):
+		return false, nil
+	}
+	return true, nil
+}
+
+func sameStrings(a, b []*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := map[string]bool{}
+	for _, s := range b {
+		set[awsclients.StringValue(s)] = true
+	}
+	for _, s := range a {
+		if !set[awsclients.StringValue(s)] {
+			return false
+		}
+	}
+	return true
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Input, resp *svcsdk.CreateInputOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Input.Id))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Input, obj *svcsdk.UpdateInputInput) error {
+	obj.InputId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Input, obj *svcsdk.DeleteInputInput) (bool, error) {
+	obj.InputId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
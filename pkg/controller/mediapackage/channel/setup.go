@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediapackage"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mediapackage/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupChannel adds a controller that reconciles Channel.
+func SetupChannel(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ChannelGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Channel{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ChannelGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Channel, obj *svcsdk.DescribeChannelInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Channel, resp *svcsdk.DescribeChannelOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	obs.ConnectionDetails = getConnectionDetails(resp.HlsIngest)
+	return obs, nil
+}
+
+// getConnectionDetails returns the URL and the credentials of every HLS
+// ingest endpoint of the channel.
+func getConnectionDetails(hls *svcsdk.HlsIngest) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if hls == nil {
+		return conn
+	}
+	for i, ep := range hls.IngestEndpoints {
+		conn[fmt.Sprintf("ingestEndpoint%dUrl", i)] = []byte(awsclients.StringValue(ep.Url))
+		conn[fmt.Sprintf("ingestEndpoint%dUsername", i)] = []byte(awsclients.StringValue(ep.Username))
+		conn[fmt.Sprintf("ingestEndpoint%dPassword", i)] = []byte(awsclients.StringValue(ep.Password))
+	}
+	return conn
+}
+
+func isUpToDate(cr *svcapitypes.Channel, resp *svcsdk.DescribeChannelOutput) (bool, error) {
+	return awsclients.StringValue(cr.Spec.ForProvider.Description) == awsclients.StringValue(resp.Description), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Channel, obj *svcsdk.CreateChannelInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Channel, obj *svcsdk.UpdateChannelInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Channel, obj *svcsdk.DeleteChannelInput) (bool, error) {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package channel
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		hls  *svcsdk.HlsIngest
+		want managed.ConnectionDetails
+	}{
+		"NoIngest": {
+			want: managed.ConnectionDetails{},
+		},
+		"TwoEndpoints": {
+			hls: &svcsdk.HlsIngest{IngestEndpoints: []*svcsdk.IngestEndpoint{
+				{Url: awsclients.String("https://a"), Username: awsclients.String("ua"), Password: awsclients.String("pa")},
+				{Url: awsclients.String("https://b"), Username: awsclients.String("ub"), Password: awsclients.String("pb")},
+			}},
+			want: managed.ConnectionDetails{
+				"ingestEndpoint0Url":      []byte("https://a"),
+				"ingestEndpoint0Username": []byte("ua"),
+				"ingestEndpoint0Password": []byte("pa"),
+				"ingestEndpoint1Url":      []byte("https://b"),
+				"ingestEndpoint1Username": []byte("ub"),
+				"ingestEndpoint1Password": []byte("pb"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := getConnectionDetails(tc.hls)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originendpoint
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediapackage"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mediapackage/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupOriginEndpoint adds a controller that reconciles OriginEndpoint.
+func SetupOriginEndpoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.OriginEndpointGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.OriginEndpoint{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.OriginEndpointGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.OriginEndpoint, obj *svcsdk.DescribeOriginEndpointInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.OriginEndpoint, resp *svcsdk.DescribeOriginEndpointOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"url": []byte(awsclients.StringValue(resp.Url)),
+	}
+	return obs, nil
+}
+
+// isUpToDate reports whether all fields given in the spec match the observed
+// endpoint. Fields that are not given are defaulted by MediaPackage and are
+// therefore not compared.
+func isUpToDate(cr *svcapitypes.OriginEndpoint, resp *svcsdk.DescribeOriginEndpointOutput) (bool, error) {
+	observed := GenerateOriginEndpoint(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomOriginEndpointParameters = cr.Spec.ForProvider.CustomOriginEndpointParameters
+
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.OriginEndpoint, obj *svcsdk.CreateOriginEndpointInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	obj.ChannelId = cr.Spec.ForProvider.ChannelID
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.OriginEndpoint, obj *svcsdk.UpdateOriginEndpointInput) error {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.OriginEndpoint, obj *svcsdk.DeleteOriginEndpointInput) (bool, error) {
+	obj.Id = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package originendpoint
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mediapackage/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observed := &svcsdk.DescribeOriginEndpointOutput{
+		ChannelId:   awsclients.String("channel"),
+		Description: awsclients.String("desc"),
+		HlsPackage: &svcsdk.HlsPackage{
+			PlaylistWindowSeconds:  awsclients.Int64(60),
+			SegmentDurationSeconds: awsclients.Int64(6),
+		},
+	}
+	cases := map[string]struct {
+		spec svcapitypes.OriginEndpointParameters
+		want bool
+	}{
+		"DefaultsNotGiven": {
+			spec: svcapitypes.OriginEndpointParameters{
+				Region:     "us-east-1",
+				HlsPackage: &svcapitypes.HlsPackage{SegmentDurationSeconds: awsclients.Int64(6)},
+				CustomOriginEndpointParameters: svcapitypes.CustomOriginEndpointParameters{
+					ChannelID: awsclients.String("channel"),
+				},
+			},
+			want: true,
+		},
+		"ChangedField": {
+			spec: svcapitypes.OriginEndpointParameters{
+				Region:      "us-east-1",
+				Description: awsclients.String("new"),
+			},
+			want: false,
+		},
+		"ChangedNestedField": {
+			spec: svcapitypes.OriginEndpointParameters{
+				Region:     "us-east-1",
+				HlsPackage: &svcapitypes.HlsPackage{SegmentDurationSeconds: awsclients.Int64(2)},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.OriginEndpoint{Spec: svcapitypes.OriginEndpointSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,10 +2,14 @@ package broker
 
 import (
 	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/mq"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/mq/mqiface"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -33,6 +37,9 @@ func SetupBroker(mgr ctrl.Manager, o controller.Options) error {
 			e.preDelete = preDelete
 			e.postObserve = c.postObserve
 			e.lateInitialize = LateInitialize
+			e.isUpToDate = c.isUpToDate
+			e.preUpdate = c.preUpdate
+			e.postUpdate = c.postUpdate
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -48,6 +55,36 @@ func SetupBroker(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+const (
+	errGetPassword   = "cannot get password from the given secret"
+	errEmptyPassword = "password of user %q must not be empty"
+	errListUsers     = "cannot list broker users"
+	errDescribeUser  = "cannot describe broker user"
+	errCreateUser    = "cannot create broker user"
+	errUpdateUser    = "cannot update broker user"
+)
+
+// Connection detail keys of the broker endpoints.
+const (
+	ConnectionDetailOpenWireEndpoint = "OpenWireEndpoint"
+	ConnectionDetailAMQPEndpoint     = "AMQPEndpoint"
+	ConnectionDetailSTOMPEndpoint    = "STOMPEndpoint"
+	ConnectionDetailMQTTEndpoint     = "MQTTEndpoint"
+	ConnectionDetailWSSEndpoint      = "WSSEndpoint"
+	ConnectionDetailConsoleURL       = "ConsoleURL"
+)
+
+// endpointKeys maps the endpoint schemes reported by Amazon MQ to their
+// connection detail keys.
+var endpointKeys = map[string]string{
+	"ssl":       ConnectionDetailOpenWireEndpoint,
+	"amqp+ssl":  ConnectionDetailAMQPEndpoint,
+	"amqps":     ConnectionDetailAMQPEndpoint,
+	"stomp+ssl": ConnectionDetailSTOMPEndpoint,
+	"mqtt+ssl":  ConnectionDetailMQTTEndpoint,
+	"wss":       ConnectionDetailWSSEndpoint,
+}
+
 type custom struct {
 	kube     client.Client
 	client   svcsdkapi.MQAPI
@@ -76,20 +113,25 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.Broker, obj *s
 		cr.SetConditions(xpv1.Unavailable())
 	}
 
-	pw, _, err := mq.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.CustomUsers[0].PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if resource.IgnoreNotFound(err) != nil || pw == "" {
-		return obs, errors.Wrap(err, "cannot get password from the given secret")
-	}
+	obs.ConnectionDetails = GenerateConnectionDetails(obj)
+	obs.ConnectionDetails["BrokerID"] = []byte(meta.GetExternalName(cr))
+	obs.ConnectionDetails["Region"] = []byte(cr.Spec.ForProvider.Region)
 
-	obs.ConnectionDetails = managed.ConnectionDetails{
-		"BrokerID": []byte(awsclients.StringValue(cr.Status.AtProvider.BrokerID)),
-		"Region":   []byte(awsclients.StringValue(&cr.Spec.ForProvider.Region)),
-		"Username": []byte(awsclients.StringValue(cr.Spec.ForProvider.CustomUsers[0].Username)),
-		"Password": []byte(pw),
+	if len(cr.Spec.ForProvider.CustomUsers) > 0 && cr.Spec.ForProvider.CustomUsers[0] != nil {
+		u := cr.Spec.ForProvider.CustomUsers[0]
+		pw, _, err := mq.GetPassword(ctx, e.kube, &u.PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if resource.IgnoreNotFound(err) != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+		}
+		// The password secret may have been deleted already, in which case
+		// the user credentials are left out of the connection details.
+		if err == nil {
+			obs.ConnectionDetails["Username"] = []byte(awsclients.StringValue(u.Username))
+			obs.ConnectionDetails["Password"] = []byte(pw)
+		}
 	}
 
 	return obs, nil
-
 }
 
 func preDelete(_ context.Context, cr *svcapitypes.Broker, obj *svcsdk.DeleteBrokerInput) (bool, error) {
@@ -101,20 +143,25 @@ func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.Broker, obj *svc
 
 	obj.BrokerName = awsclients.String(cr.Name)
 
-	pw, _, err := mq.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.CustomUsers[0].PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if resource.IgnoreNotFound(err) != nil || pw == "" {
-		return errors.Wrap(err, "cannot get password from the given secret")
-	}
-
-	obj.Users = []*svcsdk.User{
-		{
-			Username:      cr.Spec.ForProvider.CustomUsers[0].Username,
+	obj.Users = make([]*svcsdk.User, 0, len(cr.Spec.ForProvider.CustomUsers))
+	for _, u := range cr.Spec.ForProvider.CustomUsers {
+		if u == nil {
+			continue
+		}
+		pw, _, err := mq.GetPassword(ctx, e.kube, &u.PasswordSecretRef, nil)
+		if err != nil {
+			return errors.Wrap(err, errGetPassword)
+		}
+		if pw == "" {
+			return errors.Errorf(errEmptyPassword, awsclients.StringValue(u.Username))
+		}
+		obj.Users = append(obj.Users, &svcsdk.User{
+			Username:      u.Username,
 			Password:      awsclients.String(pw),
-			ConsoleAccess: cr.Spec.ForProvider.CustomUsers[0].ConsoleAccess,
-			Groups:        cr.Spec.ForProvider.CustomUsers[0].Groups,
-		},
+			ConsoleAccess: u.ConsoleAccess,
+			Groups:        u.Groups,
+		})
 	}
-
 	return nil
 }
 
@@ -127,6 +174,235 @@ func postCreate(_ context.Context, cr *svcapitypes.Broker, obj *svcsdk.CreateBro
 	return cre, nil
 }
 
+func (e *custom) isUpToDate(cr *svcapitypes.Broker, obj *svcsdk.DescribeBrokerResponse) (bool, error) {
+	if awsclients.StringValue(obj.BrokerState) != string(svcapitypes.BrokerState_RUNNING) {
+		return true, nil
+	}
+	if !IsBrokerUpToDate(cr.Spec.ForProvider, obj) {
+		return false, nil
+	}
+	if !isActiveMQ(obj.EngineType) {
+		return true, nil
+	}
+	return e.areUsersUpToDate(context.TODO(), cr, obj.Users)
+}
+
+// IsBrokerUpToDate checks whether the updatable broker settings in p match
+// the observed ones. Pending values take precedence over the current ones
+// since Amazon MQ applies most changes only on the next reboot.
+func IsBrokerUpToDate(p svcapitypes.BrokerParameters, obj *svcsdk.DescribeBrokerResponse) bool { // nolint:gocyclo
+	engineVersion := obj.EngineVersion
+	if obj.PendingEngineVersion != nil {
+		engineVersion = obj.PendingEngineVersion
+	}
+	hostInstanceType := obj.HostInstanceType
+	if obj.PendingHostInstanceType != nil {
+		hostInstanceType = obj.PendingHostInstanceType
+	}
+	securityGroups := obj.SecurityGroups
+	if len(obj.PendingSecurityGroups) > 0 {
+		securityGroups = obj.PendingSecurityGroups
+	}
+
+	switch {
+	case p.EngineVersion != nil && awsclients.StringValue(p.EngineVersion) != awsclients.StringValue(engineVersion),
+		p.HostInstanceType != nil && awsclients.StringValue(p.HostInstanceType) != awsclients.StringValue(hostInstanceType),
+		p.AutoMinorVersionUpgrade != nil && awsclients.BoolValue(p.AutoMinorVersionUpgrade) != awsclients.BoolValue(obj.AutoMinorVersionUpgrade),
+		len(p.SecurityGroups) > 0 && !sets.NewString(aws.StringValueSlice(p.SecurityGroups)...).Equal(sets.NewString(aws.StringValueSlice(securityGroups)...)):
+		return false
+	}
+	if p.Logs != nil {
+		var general, audit bool
+		if obj.Logs != nil {
+			general = awsclients.BoolValue(obj.Logs.General)
+			audit = awsclients.BoolValue(obj.Logs.Audit)
+		}
+		if p.Logs.General != nil && awsclients.BoolValue(p.Logs.General) != general ||
+			p.Logs.Audit != nil && awsclients.BoolValue(p.Logs.Audit) != audit {
+			return false
+		}
+	}
+	if p.Configuration != nil && p.Configuration.ID != nil {
+		var current *svcsdk.ConfigurationId
+		if obj.Configurations != nil {
+			current = obj.Configurations.Current
+			if obj.Configurations.Pending != nil {
+				current = obj.Configurations.Pending
+			}
+		}
+		if current == nil ||
+			awsclients.StringValue(p.Configuration.ID) != awsclients.StringValue(current.Id) ||
+			p.Configuration.Revision != nil && awsclients.Int64Value(p.Configuration.Revision) != awsclients.Int64Value(current.Revision) {
+			return false
+		}
+	}
+	if p.MaintenanceWindowStartTime != nil && obj.MaintenanceWindowStartTime != nil {
+		w, o := p.MaintenanceWindowStartTime, obj.MaintenanceWindowStartTime
+		if w.DayOfWeek != nil && awsclients.StringValue(w.DayOfWeek) != awsclients.StringValue(o.DayOfWeek) ||
+			w.TimeOfDay != nil && awsclients.StringValue(w.TimeOfDay) != awsclients.StringValue(o.TimeOfDay) ||
+			w.TimeZone != nil && awsclients.StringValue(w.TimeZone) != awsclients.StringValue(o.TimeZone) {
+			return false
+		}
+	}
+	return true
+}
+
+// areUsersUpToDate checks whether every user in the spec exists on the broker
+// with the desired console access and groups. Users that are not part of the
+// spec are left alone so that they can be managed with the User resource.
+func (e *custom) areUsersUpToDate(ctx context.Context, cr *svcapitypes.Broker, observed []*svcsdk.UserSummary) (bool, error) {
+	existing := map[string]bool{}
+	for _, u := range observed {
+		existing[awsclients.StringValue(u.Username)] = true
+	}
+	for _, u := range cr.Spec.ForProvider.CustomUsers {
+		if u == nil {
+			continue
+		}
+		if !existing[awsclients.StringValue(u.Username)] {
+			return false, nil
+		}
+		resp, err := e.client.DescribeUserWithContext(ctx, &svcsdk.DescribeUserInput{
+			BrokerId: awsclients.String(meta.GetExternalName(cr)),
+			Username: u.Username,
+		})
+		if err != nil {
+			return false, errors.Wrap(err, errDescribeUser)
+		}
+		if !IsUserUpToDate(u, resp) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// IsUserUpToDate checks whether the console access and groups of the given
+// user match the observed ones, including pending changes.
+func IsUserUpToDate(u *svcapitypes.CustomUser, obj *svcsdk.DescribeUserResponse) bool {
+	consoleAccess, groups := obj.ConsoleAccess, obj.Groups
+	if obj.Pending != nil {
+		consoleAccess, groups = obj.Pending.ConsoleAccess, obj.Pending.Groups
+	}
+	if u.ConsoleAccess != nil && awsclients.BoolValue(u.ConsoleAccess) != awsclients.BoolValue(consoleAccess) {
+		return false
+	}
+	return sets.NewString(aws.StringValueSlice(u.Groups)...).Equal(sets.NewString(aws.StringValueSlice(groups)...))
+}
+
+func (e *custom) preUpdate(_ context.Context, cr *svcapitypes.Broker, obj *svcsdk.UpdateBrokerRequest) error {
+	obj.BrokerId = awsclients.String(meta.GetExternalName(cr))
+	obj.SecurityGroups = cr.Spec.ForProvider.SecurityGroups
+	return nil
+}
+
+func (e *custom) postUpdate(ctx context.Context, cr *svcapitypes.Broker, _ *svcsdk.UpdateBrokerResponse, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil || !isActiveMQ(cr.Spec.ForProvider.EngineType) {
+		return upd, err
+	}
+	return upd, e.syncUsers(ctx, cr)
+}
+
+// syncUsers creates the users of the spec that are missing on the broker and
+// updates the console access and groups of the existing ones. Passwords are
+// only set when a user is created.
+func (e *custom) syncUsers(ctx context.Context, cr *svcapitypes.Broker) error {
+	brokerID := awsclients.String(meta.GetExternalName(cr))
+	existing := map[string]bool{}
+	in := &svcsdk.ListUsersInput{BrokerId: brokerID}
+	for {
+		resp, err := e.client.ListUsersWithContext(ctx, in)
+		if err != nil {
+			return errors.Wrap(err, errListUsers)
+		}
+		for _, u := range resp.Users {
+			existing[awsclients.StringValue(u.Username)] = true
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+
+	for _, u := range cr.Spec.ForProvider.CustomUsers {
+		if u == nil {
+			continue
+		}
+		if !existing[awsclients.StringValue(u.Username)] {
+			pw, _, err := mq.GetPassword(ctx, e.kube, &u.PasswordSecretRef, nil)
+			if err != nil {
+				return errors.Wrap(err, errGetPassword)
+			}
+			if pw == "" {
+				return errors.Errorf(errEmptyPassword, awsclients.StringValue(u.Username))
+			}
+			if _, err := e.client.CreateUserWithContext(ctx, &svcsdk.CreateUserRequest{
+				BrokerId:      brokerID,
+				Username:      u.Username,
+				Password:      awsclients.String(pw),
+				ConsoleAccess: u.ConsoleAccess,
+				Groups:        u.Groups,
+			}); err != nil {
+				return errors.Wrap(err, errCreateUser)
+			}
+			continue
+		}
+		resp, err := e.client.DescribeUserWithContext(ctx, &svcsdk.DescribeUserInput{BrokerId: brokerID, Username: u.Username})
+		if err != nil {
+			return errors.Wrap(err, errDescribeUser)
+		}
+		if IsUserUpToDate(u, resp) {
+			continue
+		}
+		if _, err := e.client.UpdateUserWithContext(ctx, &svcsdk.UpdateUserRequest{
+			BrokerId:      brokerID,
+			Username:      u.Username,
+			ConsoleAccess: u.ConsoleAccess,
+			Groups:        u.Groups,
+		}); err != nil {
+			return errors.Wrap(err, errUpdateUser)
+		}
+	}
+	return nil
+}
+
+// GenerateConnectionDetails returns the endpoints and the web console URL of
+// the broker instances. Endpoints of multiple instances are joined with a
+// comma so that they can be used as a failover URI list.
+func GenerateConnectionDetails(obj *svcsdk.DescribeBrokerResponse) managed.ConnectionDetails {
+	endpoints := map[string][]string{}
+	var consoleURLs []string
+	for _, i := range obj.BrokerInstances {
+		if i == nil {
+			continue
+		}
+		if i.ConsoleURL != nil {
+			consoleURLs = append(consoleURLs, awsclients.StringValue(i.ConsoleURL))
+		}
+		for _, ep := range i.Endpoints {
+			s := awsclients.StringValue(ep)
+			idx := strings.Index(s, "://")
+			if idx < 0 {
+				continue
+			}
+			if key, ok := endpointKeys[s[:idx]]; ok {
+				endpoints[key] = append(endpoints[key], s)
+			}
+		}
+	}
+	conn := managed.ConnectionDetails{}
+	for k, v := range endpoints {
+		conn[k] = []byte(strings.Join(v, ","))
+	}
+	if len(consoleURLs) > 0 {
+		conn[ConnectionDetailConsoleURL] = []byte(strings.Join(consoleURLs, ","))
+	}
+	return conn
+}
+
+func isActiveMQ(engineType *string) bool {
+	return strings.EqualFold(awsclients.StringValue(engineType), string(svcapitypes.EngineType_ACTIVEMQ))
+}
+
 // LateInitialize fills the empty fields in *svcapitypes.BrokerParameters with
 // the values seen in svcsdk.DescribeBrokerResponse.
 // nolint:gocyclo
@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/mq"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestGenerateConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		obj  *svcsdk.DescribeBrokerResponse
+		want managed.ConnectionDetails
+	}{
+		"NoInstances": {
+			obj:  &svcsdk.DescribeBrokerResponse{},
+			want: managed.ConnectionDetails{},
+		},
+		"ActiveMQActiveStandby": {
+			obj: &svcsdk.DescribeBrokerResponse{
+				BrokerInstances: []*svcsdk.BrokerInstance{
+					{
+						ConsoleURL: awsclients.String("https://b-1.mq.us-east-1.amazonaws.com:8162"),
+						Endpoints: aws.StringSlice([]string{
+							"ssl://b-1.mq.us-east-1.amazonaws.com:61617",
+							"amqp+ssl://b-1.mq.us-east-1.amazonaws.com:5671",
+							"stomp+ssl://b-1.mq.us-east-1.amazonaws.com:61614",
+							"mqtt+ssl://b-1.mq.us-east-1.amazonaws.com:8883",
+							"wss://b-1.mq.us-east-1.amazonaws.com:61619",
+						}),
+					},
+					{
+						ConsoleURL: awsclients.String("https://b-2.mq.us-east-1.amazonaws.com:8162"),
+						Endpoints: aws.StringSlice([]string{
+							"ssl://b-2.mq.us-east-1.amazonaws.com:61617",
+							"amqp+ssl://b-2.mq.us-east-1.amazonaws.com:5671",
+						}),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				ConnectionDetailOpenWireEndpoint: []byte("ssl://b-1.mq.us-east-1.amazonaws.com:61617,ssl://b-2.mq.us-east-1.amazonaws.com:61617"),
+				ConnectionDetailAMQPEndpoint:     []byte("amqp+ssl://b-1.mq.us-east-1.amazonaws.com:5671,amqp+ssl://b-2.mq.us-east-1.amazonaws.com:5671"),
+				ConnectionDetailSTOMPEndpoint:    []byte("stomp+ssl://b-1.mq.us-east-1.amazonaws.com:61614"),
+				ConnectionDetailMQTTEndpoint:     []byte("mqtt+ssl://b-1.mq.us-east-1.amazonaws.com:8883"),
+				ConnectionDetailWSSEndpoint:      []byte("wss://b-1.mq.us-east-1.amazonaws.com:61619"),
+				ConnectionDetailConsoleURL:       []byte("https://b-1.mq.us-east-1.amazonaws.com:8162,https://b-2.mq.us-east-1.amazonaws.com:8162"),
+			},
+		},
+		"RabbitMQ": {
+			obj: &svcsdk.DescribeBrokerResponse{
+				BrokerInstances: []*svcsdk.BrokerInstance{
+					{
+						ConsoleURL: awsclients.String("https://b-1.mq.us-east-1.amazonaws.com"),
+						Endpoints:  aws.StringSlice([]string{"amqps://b-1.mq.us-east-1.amazonaws.com:5671"}),
+					},
+				},
+			},
+			want: managed.ConnectionDetails{
+				ConnectionDetailAMQPEndpoint: []byte("amqps://b-1.mq.us-east-1.amazonaws.com:5671"),
+				ConnectionDetailConsoleURL:   []byte("https://b-1.mq.us-east-1.amazonaws.com"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateConnectionDetails(tc.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBrokerUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    svcapitypes.BrokerParameters
+		obj  *svcsdk.DescribeBrokerResponse
+		want bool
+	}{
+		"UpToDate": {
+			p: svcapitypes.BrokerParameters{
+				EngineVersion:           awsclients.String("5.16.3"),
+				HostInstanceType:        awsclients.String("mq.t3.micro"),
+				AutoMinorVersionUpgrade: awsclients.Bool(true),
+				Logs:                    &svcapitypes.Logs{General: awsclients.Bool(true)},
+				CustomBrokerParameters: svcapitypes.CustomBrokerParameters{
+					SecurityGroups: aws.StringSlice([]string{"sg-2", "sg-1"}),
+				},
+			},
+			obj: &svcsdk.DescribeBrokerResponse{
+				EngineVersion:           awsclients.String("5.16.3"),
+				HostInstanceType:        awsclients.String("mq.t3.micro"),
+				AutoMinorVersionUpgrade: awsclients.Bool(true),
+				Logs:                    &svcsdk.LogsSummary{General: awsclients.Bool(true)},
+				SecurityGroups:          aws.StringSlice([]string{"sg-1", "sg-2"}),
+			},
+			want: true,
+		},
+		"EngineVersionUpgrade": {
+			p: svcapitypes.BrokerParameters{
+				EngineVersion: awsclients.String("5.17.6"),
+			},
+			obj: &svcsdk.DescribeBrokerResponse{
+				EngineVersion: awsclients.String("5.16.3"),
+			},
+			want: false,
+		},
+		"EngineVersionUpgradePending": {
+			p: svcapitypes.BrokerParameters{
+				EngineVersion: awsclients.String("5.17.6"),
+			},
+			obj: &svcsdk.DescribeBrokerResponse{
+				EngineVersion:        awsclients.String("5.16.3"),
+				PendingEngineVersion: awsclients.String("5.17.6"),
+			},
+			want: true,
+		},
+		"ConfigurationRevision": {
+			p: svcapitypes.BrokerParameters{
+				Configuration: &svcapitypes.ConfigurationID{
+					ID:       awsclients.String("c-1"),
+					Revision: awsclients.Int64(2),
+				},
+			},
+			obj: &svcsdk.DescribeBrokerResponse{
+				Configurations: &svcsdk.Configurations{
+					Current: &svcsdk.ConfigurationId{Id: awsclients.String("c-1"), Revision: awsclients.Int64(1)},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsBrokerUpToDate(tc.p, tc.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUserUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		u    *svcapitypes.CustomUser
+		obj  *svcsdk.DescribeUserResponse
+		want bool
+	}{
+		"UpToDate": {
+			u: &svcapitypes.CustomUser{
+				ConsoleAccess: awsclients.Bool(true),
+				Groups:        aws.StringSlice([]string{"b", "a"}),
+			},
+			obj: &svcsdk.DescribeUserResponse{
+				ConsoleAccess: awsclients.Bool(true),
+				Groups:        aws.StringSlice([]string{"a", "b"}),
+			},
+			want: true,
+		},
+		"GroupsChanged": {
+			u: &svcapitypes.CustomUser{
+				Groups: aws.StringSlice([]string{"a"}),
+			},
+			obj: &svcsdk.DescribeUserResponse{
+				Groups: aws.StringSlice([]string{"a", "b"}),
+			},
+			want: false,
+		},
+		"ChangePending": {
+			u: &svcapitypes.CustomUser{
+				ConsoleAccess: awsclients.Bool(false),
+			},
+			obj: &svcsdk.DescribeUserResponse{
+				ConsoleAccess: awsclients.Bool(true),
+				Pending:       &svcsdk.UserPendingChanges{ConsoleAccess: awsclients.Bool(false)},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUserUpToDate(tc.u, tc.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserveUserPassword(t *testing.T) {
+	errBoom := errors.New("boom")
+	broker := func() *svcapitypes.Broker {
+		cr := &svcapitypes.Broker{}
+		meta.SetExternalName(cr, "broker")
+		cr.Spec.ForProvider.Region = "us-east-1"
+		cr.Spec.ForProvider.CustomUsers = []*svcapitypes.CustomUser{{
+			Username: aws.String("admin"),
+			PasswordSecretRef: xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "pw", Namespace: "default"},
+				Key:             "password",
+			},
+		}}
+		return cr
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		want managed.ConnectionDetails
+		err  error
+	}{
+		"SecretFound": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("secret")}
+					return nil
+				},
+			},
+			want: managed.ConnectionDetails{
+				"BrokerID": []byte("broker"),
+				"Region":   []byte("us-east-1"),
+				"Username": []byte("admin"),
+				"Password": []byte("secret"),
+			},
+		},
+		"SecretNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "pw")),
+			},
+			want: managed.ConnectionDetails{
+				"BrokerID": []byte("broker"),
+				"Region":   []byte("us-east-1"),
+			},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			err: errors.Wrap(errors.Wrap(errBoom, "cannot get password secret"), errGetPassword),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &custom{kube: tc.kube}
+			obs, err := e.postObserve(context.Background(), broker(), &svcsdk.DescribeBrokerResponse{}, managed.ExternalObservation{}, nil)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, obs.ConnectionDetails); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetMasterUserPasswordSecret = "cannot get master user password secret"
+)
+
+// SetupDomain adds a controller that reconciles Domain.
+func SetupDomain(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DomainGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = h.preCreate
+			e.preUpdate = h.preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Domain{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DomainGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	kube client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Domain, obj *svcsdk.DescribeDomainInput) error {
+	obj.DomainName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Domain, resp *svcsdk.DescribeDomainOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	d := resp.DomainStatus
+	switch {
+	case awsclients.BoolValue(d.Deleted):
+		cr.SetConditions(xpv1.Deleting())
+	case !awsclients.BoolValue(d.Created), domainEndpoint(d) == "":
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(domainEndpoint(d)),
+		"arn": []byte(awsclients.StringValue(d.ARN)),
+	}
+	if mu := masterUserOptions(cr.Spec.ForProvider.AdvancedSecurityOptions); mu != nil && mu.MasterUserName != nil {
+		pw, err := h.getMasterUserPassword(ctx, mu.MasterUserPasswordSecretRef)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		obs.ConnectionDetails[xpv1.ResourceCredentialsSecretUserKey] = []byte(awsclients.StringValue(mu.MasterUserName))
+		obs.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Domain, resp *svcsdk.DescribeDomainOutput) (bool, error) {
+	d := resp.DomainStatus
+	// Changes cannot be applied while a configuration change or an upgrade
+	// is in progress.
+	if awsclients.BoolValue(d.Processing) || awsclients.BoolValue(d.UpgradeProcessing) {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	if p.AccessPolicies != nil && !awsclients.IsPolicyUpToDate(p.AccessPolicies, d.AccessPolicies) {
+		return false, nil
+	}
+	if !isAdvancedSecurityOptionsUpToDate(p.AdvancedSecurityOptions, d.AdvancedSecurityOptions) {
+		return false, nil
+	}
+
+	observed := GenerateDomain(resp).Spec.ForProvider
+	observed.Region = p.Region
+	observed.TagList = p.TagList
+	observed.AccessPolicies = p.AccessPolicies
+	// The engine version is changed with UpgradeDomain, and the auto-tune
+	// options are reported with their current state rather than the desired
+	// one.
+	observed.EngineVersion = p.EngineVersion
+	observed.AutoTuneOptions = p.AutoTuneOptions
+	if d.VPCOptions != nil {
+		observed.VPCOptions = &svcapitypes.VPCOptions{
+			SecurityGroupIDs: d.VPCOptions.SecurityGroupIds,
+			SubnetIDs:        d.VPCOptions.SubnetIds,
+		}
+	}
+	return awsclients.IsJSONSubset(p, observed)
+}
+
+func isAdvancedSecurityOptionsUpToDate(p *svcapitypes.AdvancedSecurityOptionsParameters, o *svcsdk.AdvancedSecurityOptions) bool {
+	if p == nil {
+		return true
+	}
+	if o == nil {
+		return !awsclients.BoolValue(p.Enabled)
+	}
+	if p.Enabled != nil && awsclients.BoolValue(p.Enabled) != awsclients.BoolValue(o.Enabled) {
+		return false
+	}
+	return p.InternalUserDatabaseEnabled == nil || awsclients.BoolValue(p.InternalUserDatabaseEnabled) == awsclients.BoolValue(o.InternalUserDatabaseEnabled)
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.Domain, obj *svcsdk.CreateDomainInput) error {
+	obj.DomainName = awsclients.String(meta.GetExternalName(cr))
+	opts, err := h.generateAdvancedSecurityOptions(ctx, cr.Spec.ForProvider.AdvancedSecurityOptions)
+	if err != nil {
+		return err
+	}
+	obj.AdvancedSecurityOptions = opts
+	return nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Domain, obj *svcsdk.UpdateDomainConfigInput) error {
+	obj.DomainName = awsclients.String(meta.GetExternalName(cr))
+	opts, err := h.generateAdvancedSecurityOptions(ctx, cr.Spec.ForProvider.AdvancedSecurityOptions)
+	if err != nil {
+		return err
+	}
+	obj.AdvancedSecurityOptions = opts
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Domain, obj *svcsdk.DeleteDomainInput) (bool, error) {
+	obj.DomainName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+// generateAdvancedSecurityOptions returns the fine-grained access control
+// options with the master user password read from its Secret.
+func (h *hooks) generateAdvancedSecurityOptions(ctx context.Context, p *svcapitypes.AdvancedSecurityOptionsParameters) (*svcsdk.AdvancedSecurityOptionsInput_, error) {
+	if p == nil {
+		return nil, nil
+	}
+	res := &svcsdk.AdvancedSecurityOptionsInput_{
+		Enabled:                     p.Enabled,
+		InternalUserDatabaseEnabled: p.InternalUserDatabaseEnabled,
+	}
+	if mu := p.MasterUserOptions; mu != nil {
+		res.MasterUserOptions = &svcsdk.MasterUserOptions{
+			MasterUserARN:  mu.MasterUserARN,
+			MasterUserName: mu.MasterUserName,
+		}
+		if mu.MasterUserPasswordSecretRef != nil {
+			pw, err := h.getMasterUserPassword(ctx, mu.MasterUserPasswordSecretRef)
+			if err != nil {
+				return nil, err
+			}
+			res.MasterUserOptions.MasterUserPassword = awsclients.String(pw)
+		}
+	}
+	return res, nil
+}
+
+func (h *hooks) getMasterUserPassword(ctx context.Context, ref *xpv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetMasterUserPasswordSecret)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+func masterUserOptions(p *svcapitypes.AdvancedSecurityOptionsParameters) *svcapitypes.MasterUserOptionsParameters {
+	if p == nil {
+		return nil
+	}
+	return p.MasterUserOptions
+}
+
+// domainEndpoint returns the public endpoint of the domain or, for domains
+// in a VPC, the VPC endpoint.
+func domainEndpoint(d *svcsdk.DomainStatus) string {
+	if d.Endpoint != nil {
+		return awsclients.StringValue(d.Endpoint)
+	}
+	return awsclients.StringValue(d.Endpoints["vpc"])
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	policy          = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":["es:ESHttpGet","es:ESHttpPut"],"Resource":"arn:aws:es:us-east-1:123456789012:domain/example/*"}]}`
+	policyReordered = `{"Statement":[{"Resource":"arn:aws:es:us-east-1:123456789012:domain/example/*","Action":["es:ESHttpPut","es:ESHttpGet"],"Principal":{"AWS":"*"},"Effect":"Allow"}],"Version":"2012-10-17"}`
+)
+
+func domain(p svcapitypes.DomainParameters) *svcapitypes.Domain {
+	return &svcapitypes.Domain{Spec: svcapitypes.DomainSpec{ForProvider: p}}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.Domain
+		resp *svcsdk.DescribeDomainOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr: domain(svcapitypes.DomainParameters{
+				AccessPolicies: awsclients.String(policy),
+				ClusterConfig: &svcapitypes.ClusterConfig{
+					InstanceCount:        awsclients.Int64(2),
+					ZoneAwarenessEnabled: awsclients.Bool(true),
+				},
+				VPCOptions: &svcapitypes.VPCOptions{
+					SubnetIDs: []*string{awsclients.String("subnet-1")},
+				},
+			}),
+			resp: &svcsdk.DescribeDomainOutput{DomainStatus: &svcsdk.DomainStatus{
+				AccessPolicies: awsclients.String(policyReordered),
+				ClusterConfig: &svcsdk.ClusterConfig{
+					InstanceCount:        awsclients.Int64(2),
+					InstanceType:         awsclients.String("r6g.large.search"),
+					ZoneAwarenessEnabled: awsclients.Bool(true),
+				},
+				VPCOptions: &svcsdk.VPCDerivedInfo{
+					SubnetIds:        []*string{awsclients.String("subnet-1")},
+					SecurityGroupIds: []*string{awsclients.String("sg-1")},
+				},
+			}},
+			want: true,
+		},
+		"AccessPolicyChanged": {
+			cr: domain(svcapitypes.DomainParameters{
+				AccessPolicies: awsclients.String(policy),
+			}),
+			resp: &svcsdk.DescribeDomainOutput{DomainStatus: &svcsdk.DomainStatus{
+				AccessPolicies: awsclients.String(`{"Version":"2012-10-17","Statement":[]}`),
+			}},
+			want: false,
+		},
+		"InstanceCountChanged": {
+			cr: domain(svcapitypes.DomainParameters{
+				ClusterConfig: &svcapitypes.ClusterConfig{InstanceCount: awsclients.Int64(3)},
+			}),
+			resp: &svcsdk.DescribeDomainOutput{DomainStatus: &svcsdk.DomainStatus{
+				ClusterConfig: &svcsdk.ClusterConfig{InstanceCount: awsclients.Int64(2)},
+			}},
+			want: false,
+		},
+		"FineGrainedAccessControlDisabled": {
+			cr: domain(svcapitypes.DomainParameters{
+				CustomDomainParameters: svcapitypes.CustomDomainParameters{
+					AdvancedSecurityOptions: &svcapitypes.AdvancedSecurityOptionsParameters{Enabled: awsclients.Bool(true)},
+				},
+			}),
+			resp: &svcsdk.DescribeDomainOutput{DomainStatus: &svcsdk.DomainStatus{
+				AdvancedSecurityOptions: &svcsdk.AdvancedSecurityOptions{Enabled: awsclients.Bool(false)},
+			}},
+			want: false,
+		},
+		"Processing": {
+			cr: domain(svcapitypes.DomainParameters{
+				ClusterConfig: &svcapitypes.ClusterConfig{InstanceCount: awsclients.Int64(3)},
+			}),
+			resp: &svcsdk.DescribeDomainOutput{DomainStatus: &svcsdk.DomainStatus{
+				Processing:    awsclients.Bool(true),
+				ClusterConfig: &svcsdk.ClusterConfig{InstanceCount: awsclients.Int64(2)},
+			}},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, tc.resp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDomainEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		d    *svcsdk.DomainStatus
+		want string
+	}{
+		"Public": {
+			d:    &svcsdk.DomainStatus{Endpoint: awsclients.String("search-example.us-east-1.es.amazonaws.com")},
+			want: "search-example.us-east-1.es.amazonaws.com",
+		},
+		"VPC": {
+			d:    &svcsdk.DomainStatus{Endpoints: map[string]*string{"vpc": awsclients.String("vpc-example.us-east-1.es.amazonaws.com")}},
+			want: "vpc-example.us-east-1.es.amazonaws.com",
+		},
+		"NotReady": {
+			d:    &svcsdk.DomainStatus{},
+			want: "",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, domainEndpoint(tc.d)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
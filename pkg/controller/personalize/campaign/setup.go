@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package campaign
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/personalize/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/personalize"
+)
+
+// SetupCampaign adds a controller that reconciles Campaign.
+func SetupCampaign(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.CampaignGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Campaign{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.CampaignGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Campaign, obj *svcsdk.DescribeCampaignInput) error {
+	obj.CampaignArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Campaign, resp *svcsdk.DescribeCampaignOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(personalize.Condition(awsclients.StringValue(resp.Campaign.Status), awsclients.StringValue(resp.Campaign.FailureReason)))
+	return obs, nil
+}
+
+// isUpToDate compares the deployed solution version, the provisioned TPS and
+// the campaign config once the campaign and its latest update are done.
+func isUpToDate(cr *svcapitypes.Campaign, resp *svcsdk.DescribeCampaignOutput) (bool, error) {
+	c := resp.Campaign
+	if awsclients.StringValue(c.Status) != personalize.StatusActive ||
+		(c.LatestCampaignUpdate != nil && personalize.IsInProgress(awsclients.StringValue(c.LatestCampaignUpdate.Status))) {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	if awsclients.StringValue(p.SolutionVersionARN) != awsclients.StringValue(c.SolutionVersionArn) {
+		return false, nil
+	}
+	if p.MinProvisionedTPS != nil && awsclients.Int64Value(p.MinProvisionedTPS) != awsclients.Int64Value(c.MinProvisionedTPS) {
+		return false, nil
+	}
+	var desired, observed map[string]*string
+	if p.CampaignConfig != nil {
+		desired = p.CampaignConfig.ItemExplorationConfig
+	}
+	if c.CampaignConfig != nil {
+		observed = c.CampaignConfig.ItemExplorationConfig
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty()), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Campaign, obj *svcsdk.CreateCampaignInput) error {
+	obj.SolutionVersionArn = cr.Spec.ForProvider.SolutionVersionARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Campaign, resp *svcsdk.CreateCampaignOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.CampaignArn))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Campaign, obj *svcsdk.UpdateCampaignInput) error {
+	obj.CampaignArn = awsclients.String(meta.GetExternalName(cr))
+	obj.SolutionVersionArn = cr.Spec.ForProvider.SolutionVersionARN
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Campaign, obj *svcsdk.DeleteCampaignInput) (bool, error) {
+	if personalize.IsInProgress(awsclients.StringValue(cr.Status.AtProvider.Status)) {
+		return true, nil
+	}
+	obj.CampaignArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package campaign
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/personalize/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/personalize"
+)
+
+const (
+	versionOne = "arn:aws:personalize:us-east-1:123456789012:solution/example/1"
+	versionTwo = "arn:aws:personalize:us-east-1:123456789012:solution/example/2"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p svcapitypes.CampaignParameters
+		c *svcsdk.Campaign
+	}
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: svcapitypes.CampaignParameters{
+					MinProvisionedTPS: awsclients.Int64(1),
+					CustomCampaignParameters: svcapitypes.CustomCampaignParameters{
+						SolutionVersionARN: awsclients.String(versionOne),
+					},
+				},
+				c: &svcsdk.Campaign{
+					Status:             awsclients.String(personalize.StatusActive),
+					SolutionVersionArn: awsclients.String(versionOne),
+					MinProvisionedTPS:  awsclients.Int64(1),
+					CampaignConfig:     &svcsdk.CampaignConfig{ItemExplorationConfig: map[string]*string{}},
+				},
+			},
+			want: true,
+		},
+		"NewSolutionVersion": {
+			args: args{
+				p: svcapitypes.CampaignParameters{
+					CustomCampaignParameters: svcapitypes.CustomCampaignParameters{
+						SolutionVersionARN: awsclients.String(versionTwo),
+					},
+				},
+				c: &svcsdk.Campaign{
+					Status:             awsclients.String(personalize.StatusActive),
+					SolutionVersionArn: awsclients.String(versionOne),
+				},
+			},
+			want: false,
+		},
+		"UpdateInProgress": {
+			args: args{
+				p: svcapitypes.CampaignParameters{
+					CustomCampaignParameters: svcapitypes.CustomCampaignParameters{
+						SolutionVersionARN: awsclients.String(versionTwo),
+					},
+				},
+				c: &svcsdk.Campaign{
+					Status:               awsclients.String(personalize.StatusActive),
+					SolutionVersionArn:   awsclients.String(versionOne),
+					LatestCampaignUpdate: &svcsdk.CampaignUpdateSummary{Status: awsclients.String("UPDATE IN_PROGRESS")},
+				},
+			},
+			want: true,
+		},
+		"ExplorationConfigChanged": {
+			args: args{
+				p: svcapitypes.CampaignParameters{
+					CampaignConfig: &svcapitypes.CampaignConfig{
+						ItemExplorationConfig: map[string]*string{"explorationWeight": awsclients.String("0.5")},
+					},
+					CustomCampaignParameters: svcapitypes.CustomCampaignParameters{
+						SolutionVersionARN: awsclients.String(versionOne),
+					},
+				},
+				c: &svcsdk.Campaign{
+					Status:             awsclients.String(personalize.StatusActive),
+					SolutionVersionArn: awsclients.String(versionOne),
+					CampaignConfig: &svcsdk.CampaignConfig{
+						ItemExplorationConfig: map[string]*string{"explorationWeight": awsclients.String("0.3")},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Campaign{Spec: svcapitypes.CampaignSpec{ForProvider: tc.args.p}}
+			got, _ := isUpToDate(cr, &svcsdk.DescribeCampaignOutput{Campaign: tc.args.c})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/personalize"
+	svcsdk "github.com/aws/aws-sdk-go/service/personalize"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/personalize/personalizeiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/personalize/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/personalize"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Dataset resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create Dataset in AWS"
+	errDescribe      = "failed to describe Dataset"
+	errDelete        = "failed to delete Dataset"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Dataset)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.PersonalizeAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Dataset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	resp, err := e.client.DescribeDatasetWithContext(ctx, &svcsdk.DescribeDatasetInput{
+		DatasetArn: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = svcapitypes.DatasetObservation{
+		DatasetARN: resp.Dataset.DatasetArn,
+		Status:     resp.Dataset.Status,
+	}
+	cr.SetConditions(personalize.Condition(awsclient.StringValue(resp.Dataset.Status), ""))
+	// All parameters of a dataset are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Dataset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	resp, err := e.client.CreateDatasetWithContext(ctx, &svcsdk.CreateDatasetInput{
+		Name:            p.Name,
+		DatasetType:     p.DatasetType,
+		DatasetGroupArn: p.DatasetGroupARN,
+		SchemaArn:       p.SchemaARN,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.DatasetArn))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(_ context.Context, _ cpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Dataset)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	if personalize.IsInProgress(awsclient.StringValue(cr.Status.AtProvider.Status)) {
+		return nil
+	}
+	_, err := e.client.DeleteDatasetWithContext(ctx, &svcsdk.DeleteDatasetInput{
+		DatasetArn: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDelete)
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetgroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/personalize"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/personalize/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/personalize"
+)
+
+// SetupDatasetGroup adds a controller that reconciles DatasetGroup.
+func SetupDatasetGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DatasetGroupGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.DatasetGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DatasetGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.DatasetGroup, obj *svcsdk.DescribeDatasetGroupInput) error {
+	obj.DatasetGroupArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.DatasetGroup, resp *svcsdk.DescribeDatasetGroupOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(personalize.Condition(awsclients.StringValue(resp.DatasetGroup.Status), awsclients.StringValue(resp.DatasetGroup.FailureReason)))
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.DatasetGroup, obj *svcsdk.CreateDatasetGroupInput) error {
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	obj.KmsKeyArn = cr.Spec.ForProvider.KMSKeyARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.DatasetGroup, resp *svcsdk.CreateDatasetGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.DatasetGroupArn))
+	return cre, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.DatasetGroup, obj *svcsdk.DeleteDatasetGroupInput) (bool, error) {
+	if personalize.IsInProgress(awsclients.StringValue(cr.Status.AtProvider.Status)) {
+		return true, nil
+	}
+	obj.DatasetGroupArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
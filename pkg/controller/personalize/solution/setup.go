@@ -0,0 +1,127 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package solution
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/personalize"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/personalize/personalizeiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/personalize/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/personalize"
+)
+
+const (
+	errCreateSolutionVersion = "cannot create solution version"
+)
+
+// SetupSolution adds a controller that reconciles Solution.
+func SetupSolution(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.SolutionGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.update = h.update
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Solution{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.SolutionGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.PersonalizeAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Solution, obj *svcsdk.DescribeSolutionInput) error {
+	obj.SolutionArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Solution, resp *svcsdk.DescribeSolutionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(personalize.Condition(awsclients.StringValue(resp.Solution.Status), ""))
+	return obs, nil
+}
+
+// isUpToDate reports an active solution that has not been trained yet as
+// outdated so that update creates its first solution version.
+func isUpToDate(_ *svcapitypes.Solution, resp *svcsdk.DescribeSolutionOutput) (bool, error) {
+	return awsclients.StringValue(resp.Solution.Status) != personalize.StatusActive ||
+		resp.Solution.LatestSolutionVersion != nil, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Solution, obj *svcsdk.CreateSolutionInput) error {
+	obj.DatasetGroupArn = cr.Spec.ForProvider.DatasetGroupARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Solution, resp *svcsdk.CreateSolutionOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.SolutionArn))
+	return cre, nil
+}
+
+// update trains the solution by creating a solution version, which is the
+// only change of a solution that is reconciled.
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Solution)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := h.client.CreateSolutionVersionWithContext(ctx, &svcsdk.CreateSolutionVersionInput{
+		SolutionArn: awsclients.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalUpdate{}, awsclients.Wrap(err, errCreateSolutionVersion)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Solution, obj *svcsdk.DeleteSolutionInput) (bool, error) {
+	if personalize.IsInProgress(awsclients.StringValue(cr.Status.AtProvider.Status)) {
+		return true, nil
+	}
+	obj.SolutionArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
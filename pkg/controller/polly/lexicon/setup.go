@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lexicon
+
+import (
+	"context"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/polly"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/polly/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetContentConfigMap = "cannot get lexicon content ConfigMap"
+	errContentKeyNotFound  = "lexicon content key not found in ConfigMap"
+	errNoContent           = "either content or contentConfigMapRef has to be given"
+)
+
+// SetupLexicon adds a controller that reconciles Lexicon.
+func SetupLexicon(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.LexiconGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = h.preCreate
+			e.preUpdate = h.preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Lexicon{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.LexiconGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	kube client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Lexicon, obj *svcsdk.GetLexiconInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Lexicon, _ *svcsdk.GetLexiconOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.Lexicon, resp *svcsdk.GetLexiconOutput) (bool, error) {
+	content, err := h.getContent(context.TODO(), cr)
+	if err != nil {
+		return false, err
+	}
+	var observed string
+	if resp.Lexicon != nil {
+		observed = awsclients.StringValue(resp.Lexicon.Content)
+	}
+	return IsContentUpToDate(content, observed), nil
+}
+
+// IsContentUpToDate returns true if the desired and observed lexicon contents
+// are the same, ignoring leading and trailing whitespace.
+func IsContentUpToDate(desired, observed string) bool {
+	return strings.TrimSpace(desired) == strings.TrimSpace(observed)
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.Lexicon, obj *svcsdk.PutLexiconInput) error {
+	return h.setContent(ctx, cr, obj)
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Lexicon, obj *svcsdk.PutLexiconInput) error {
+	return h.setContent(ctx, cr, obj)
+}
+
+func (h *hooks) setContent(ctx context.Context, cr *svcapitypes.Lexicon, obj *svcsdk.PutLexiconInput) error {
+	content, err := h.getContent(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if content == "" {
+		return errors.New(errNoContent)
+	}
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.Content = awsclients.String(content)
+	return nil
+}
+
+// getContent returns the lexicon content given either inline or through a
+// ConfigMap.
+func (h *hooks) getContent(ctx context.Context, cr *svcapitypes.Lexicon) (string, error) {
+	if cr.Spec.ForProvider.Content != nil {
+		return *cr.Spec.ForProvider.Content, nil
+	}
+	ref := cr.Spec.ForProvider.ContentConfigMapRef
+	if ref == nil {
+		return "", nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return "", errors.Wrap(err, errGetContentConfigMap)
+	}
+	content, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.New(errContentKeyNotFound)
+	}
+	return content, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Lexicon, obj *svcsdk.DeleteLexiconInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
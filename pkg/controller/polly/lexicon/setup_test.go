@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lexicon
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/polly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/polly/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	lexiconContent = `<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">
+  <lexeme><grapheme>W3C</grapheme><alias>World Wide Web Consortium</alias></lexeme>
+</lexicon>`
+
+	errBoom = errors.New("boom")
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		kube client.Client
+		cr   *svcapitypes.Lexicon
+		resp *svcsdk.GetLexiconOutput
+	}
+	type want struct {
+		upToDate bool
+		err      error
+	}
+	configMapRef := &svcapitypes.ConfigMapKeySelector{Name: "lexicons", Namespace: "default", Key: "w3c.xml"}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InlineUpToDate": {
+			args: args{
+				cr: &svcapitypes.Lexicon{Spec: svcapitypes.LexiconSpec{ForProvider: svcapitypes.LexiconParameters{
+					CustomLexiconParameters: svcapitypes.CustomLexiconParameters{Content: awsclients.String(lexiconContent + "\n")},
+				}}},
+				resp: &svcsdk.GetLexiconOutput{Lexicon: &svcsdk.Lexicon{Content: awsclients.String(lexiconContent)}},
+			},
+			want: want{upToDate: true},
+		},
+		"ConfigMapChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, key types.NamespacedName, obj client.Object) error {
+						if key.Name != configMapRef.Name || key.Namespace != configMapRef.Namespace {
+							return errBoom
+						}
+						obj.(*corev1.ConfigMap).Data = map[string]string{configMapRef.Key: lexiconContent}
+						return nil
+					},
+				},
+				cr: &svcapitypes.Lexicon{Spec: svcapitypes.LexiconSpec{ForProvider: svcapitypes.LexiconParameters{
+					CustomLexiconParameters: svcapitypes.CustomLexiconParameters{ContentConfigMapRef: configMapRef},
+				}}},
+				resp: &svcsdk.GetLexiconOutput{Lexicon: &svcsdk.Lexicon{Content: awsclients.String("<lexicon/>")}},
+			},
+			want: want{upToDate: false},
+		},
+		"ConfigMapKeyMissing": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: &svcapitypes.Lexicon{Spec: svcapitypes.LexiconSpec{ForProvider: svcapitypes.LexiconParameters{
+					CustomLexiconParameters: svcapitypes.CustomLexiconParameters{ContentConfigMapRef: configMapRef},
+				}}},
+				resp: &svcsdk.GetLexiconOutput{Lexicon: &svcsdk.Lexicon{Content: awsclients.String(lexiconContent)}},
+			},
+			want: want{err: errors.New(errContentKeyNotFound)},
+		},
+		"ConfigMapGetFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				cr: &svcapitypes.Lexicon{Spec: svcapitypes.LexiconSpec{ForProvider: svcapitypes.LexiconParameters{
+					CustomLexiconParameters: svcapitypes.CustomLexiconParameters{ContentConfigMapRef: configMapRef},
+				}}},
+				resp: &svcsdk.GetLexiconOutput{},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetContentConfigMap)},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{kube: tc.args.kube}
+			upToDate, err := h.isUpToDate(tc.args.cr, tc.args.resp)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
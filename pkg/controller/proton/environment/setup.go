@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/proton"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/proton/protoniface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/proton/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/proton"
+)
+
+// SetupEnvironment adds a controller that reconciles Environment.
+func SetupEnvironment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.EnvironmentGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = h.preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Environment{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.EnvironmentGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.ProtonAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Environment, obj *svcsdk.GetEnvironmentInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Environment, resp *svcsdk.GetEnvironmentOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Environment.DeploymentStatus) {
+	case svcsdk.DeploymentStatusSucceeded:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.DeploymentStatusInProgress, svcsdk.DeploymentStatusCancelling:
+		if resp.Environment.LastDeploymentSucceededAt == nil {
+			cr.SetConditions(xpv1.Creating())
+		} else {
+			cr.SetConditions(xpv1.Available())
+		}
+	case svcsdk.DeploymentStatusDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(resp.Environment.DeploymentStatusMessage)))
+	}
+	return obs, nil
+}
+
+// isDeploying reports whether a deployment of the environment is running.
+// AWS Proton rejects updates until it finished.
+func isDeploying(env *svcsdk.Environment) bool {
+	switch awsclients.StringValue(env.DeploymentStatus) {
+	case svcsdk.DeploymentStatusInProgress, svcsdk.DeploymentStatusCancelling, svcsdk.DeploymentStatusDeleteInProgress:
+		return true
+	}
+	return false
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.Environment, resp *svcsdk.GetEnvironmentOutput) (bool, error) {
+	if isDeploying(resp.Environment) {
+		return true, nil
+	}
+	observed := GenerateEnvironment(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomEnvironmentParameters = cr.Spec.ForProvider.CustomEnvironmentParameters
+	if proton.IsSpecUpToDate(cr.Spec.ForProvider.Spec, observed.Spec) {
+		observed.Spec = cr.Spec.ForProvider.Spec
+	}
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	if role := cr.Spec.ForProvider.ProtonServiceRoleARN; role != nil && awsclients.StringValue(role) != awsclients.StringValue(resp.Environment.ProtonServiceRoleArn) {
+		return false, nil
+	}
+	return proton.AreTagsUpToDate(context.TODO(), h.client, cr.Spec.ForProvider.Tags, resp.Environment.Arn)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Environment, obj *svcsdk.CreateEnvironmentInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.TemplateName = cr.Spec.ForProvider.TemplateName
+	obj.ProtonServiceRoleArn = cr.Spec.ForProvider.ProtonServiceRoleARN
+	return nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Environment, obj *svcsdk.UpdateEnvironmentInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.ProtonServiceRoleArn = cr.Spec.ForProvider.ProtonServiceRoleARN
+	resp, err := h.client.GetEnvironmentWithContext(ctx, &svcsdk.GetEnvironmentInput{Name: obj.Name})
+	if err != nil {
+		return errors.Wrap(err, errDescribe)
+	}
+	obj.DeploymentType = awsclients.String(deploymentType(cr.Spec.ForProvider, resp.Environment))
+	switch awsclients.StringValue(obj.DeploymentType) {
+	case svcsdk.DeploymentUpdateTypeNone:
+		obj.Spec = nil
+		fallthrough
+	case svcsdk.DeploymentUpdateTypeCurrentVersion:
+		obj.TemplateMajorVersion = nil
+		obj.TemplateMinorVersion = nil
+	}
+	return nil
+}
+
+// deploymentType returns the kind of deployment that is needed to bring the
+// observed environment to the desired state. Only the metadata of an
+// environment is updated if neither its spec nor its template version
+// changed.
+func deploymentType(desired svcapitypes.EnvironmentParameters, observed *svcsdk.Environment) string {
+	switch {
+	case awsclients.StringValue(desired.TemplateMajorVersion) != awsclients.StringValue(observed.TemplateMajorVersion):
+		return svcsdk.DeploymentUpdateTypeMajorVersion
+	case desired.TemplateMinorVersion != nil && awsclients.StringValue(desired.TemplateMinorVersion) != awsclients.StringValue(observed.TemplateMinorVersion):
+		return svcsdk.DeploymentUpdateTypeMinorVersion
+	case !proton.IsSpecUpToDate(desired.Spec, observed.Spec):
+		return svcsdk.DeploymentUpdateTypeCurrentVersion
+	}
+	return svcsdk.DeploymentUpdateTypeNone
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Environment, _ *svcsdk.UpdateEnvironmentOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	return upd, proton.UpdateTagsForResource(ctx, h.client, cr.Spec.ForProvider.Tags, cr.Status.AtProvider.ARN)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Environment, obj *svcsdk.DeleteEnvironmentInput) (bool, error) {
+	if awsclients.StringValue(cr.Status.AtProvider.DeploymentStatus) == svcsdk.DeploymentStatusDeleteInProgress {
+		return true, nil
+	}
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environment
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/proton"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/proton/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const spec = "proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.0.0.0/16\n"
+
+func TestDeploymentType(t *testing.T) {
+	observed := &svcsdk.Environment{
+		Spec:                 awsclients.String(spec),
+		TemplateMajorVersion: awsclients.String("1"),
+		TemplateMinorVersion: awsclients.String("2"),
+	}
+	cases := map[string]struct {
+		desired svcapitypes.EnvironmentParameters
+		want    string
+	}{
+		"MetadataOnly": {
+			desired: svcapitypes.EnvironmentParameters{
+				Description:          awsclients.String("changed"),
+				Spec:                 awsclients.String(spec),
+				TemplateMajorVersion: awsclients.String("1"),
+			},
+			want: svcsdk.DeploymentUpdateTypeNone,
+		},
+		"SpecChanged": {
+			desired: svcapitypes.EnvironmentParameters{
+				Spec:                 awsclients.String("proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.1.0.0/16\n"),
+				TemplateMajorVersion: awsclients.String("1"),
+				TemplateMinorVersion: awsclients.String("2"),
+			},
+			want: svcsdk.DeploymentUpdateTypeCurrentVersion,
+		},
+		"MinorVersionChanged": {
+			desired: svcapitypes.EnvironmentParameters{
+				Spec:                 awsclients.String(spec),
+				TemplateMajorVersion: awsclients.String("1"),
+				TemplateMinorVersion: awsclients.String("3"),
+			},
+			want: svcsdk.DeploymentUpdateTypeMinorVersion,
+		},
+		"MajorVersionChanged": {
+			desired: svcapitypes.EnvironmentParameters{
+				Spec:                 awsclients.String(spec),
+				TemplateMajorVersion: awsclients.String("2"),
+				TemplateMinorVersion: awsclients.String("0"),
+			},
+			want: svcsdk.DeploymentUpdateTypeMajorVersion,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := deploymentType(tc.desired, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
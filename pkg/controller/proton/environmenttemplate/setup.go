@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package environmenttemplate
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/proton"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/proton/protoniface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/proton/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/proton"
+)
+
+// SetupEnvironmentTemplate adds a controller that reconciles EnvironmentTemplate.
+func SetupEnvironmentTemplate(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.EnvironmentTemplateGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.EnvironmentTemplate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.EnvironmentTemplateGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.ProtonAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.EnvironmentTemplate, obj *svcsdk.GetEnvironmentTemplateInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.EnvironmentTemplate, _ *svcsdk.GetEnvironmentTemplateOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.EnvironmentTemplate, resp *svcsdk.GetEnvironmentTemplateOutput) (bool, error) {
+	observed := GenerateEnvironmentTemplate(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	return proton.AreTagsUpToDate(context.TODO(), h.client, cr.Spec.ForProvider.Tags, resp.EnvironmentTemplate.Arn)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.EnvironmentTemplate, obj *svcsdk.CreateEnvironmentTemplateInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.EnvironmentTemplate, obj *svcsdk.UpdateEnvironmentTemplateInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.EnvironmentTemplate, _ *svcsdk.UpdateEnvironmentTemplateOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	return upd, proton.UpdateTagsForResource(ctx, h.client, cr.Spec.ForProvider.Tags, cr.Status.AtProvider.ARN)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.EnvironmentTemplate, obj *svcsdk.DeleteEnvironmentTemplateInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/proton"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/proton/protoniface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/proton/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/proton"
+)
+
+// SetupService adds a controller that reconciles Service.
+func SetupService(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ServiceGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Service{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ServiceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.ProtonAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.GetServiceInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Service, resp *svcsdk.GetServiceOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Service.Status) {
+	case svcsdk.ServiceStatusActive, svcsdk.ServiceStatusUpdateInProgress, svcsdk.ServiceStatusUpdateCompleteCleanupFailed:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ServiceStatusCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ServiceStatusDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(resp.Service.StatusMessage)))
+	}
+	return obs, nil
+}
+
+// isInProgress reports whether the service is being created, updated or
+// deleted. AWS Proton rejects updates until it finished.
+func isInProgress(svc *svcsdk.Service) bool {
+	switch awsclients.StringValue(svc.Status) {
+	case svcsdk.ServiceStatusCreateInProgress,
+		svcsdk.ServiceStatusCreateFailedCleanupInProgress,
+		svcsdk.ServiceStatusUpdateInProgress,
+		svcsdk.ServiceStatusUpdateFailedCleanupInProgress,
+		svcsdk.ServiceStatusDeleteInProgress:
+		return true
+	}
+	return false
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.Service, resp *svcsdk.GetServiceOutput) (bool, error) {
+	if isInProgress(resp.Service) {
+		return true, nil
+	}
+	observed := GenerateService(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.CustomServiceParameters = cr.Spec.ForProvider.CustomServiceParameters
+	// The template version of a service is set per service instance and is
+	// not part of the service itself.
+	observed.TemplateMajorVersion = cr.Spec.ForProvider.TemplateMajorVersion
+	observed.TemplateMinorVersion = cr.Spec.ForProvider.TemplateMinorVersion
+	if proton.IsSpecUpToDate(cr.Spec.ForProvider.Spec, observed.Spec) {
+		observed.Spec = cr.Spec.ForProvider.Spec
+	}
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	return proton.AreTagsUpToDate(context.TODO(), h.client, cr.Spec.ForProvider.Tags, resp.Service.Arn)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.CreateServiceInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.TemplateName = cr.Spec.ForProvider.TemplateName
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.UpdateServiceInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Service, _ *svcsdk.UpdateServiceOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	return upd, proton.UpdateTagsForResource(ctx, h.client, cr.Spec.ForProvider.Tags, cr.Status.AtProvider.ARN)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Service, obj *svcsdk.DeleteServiceInput) (bool, error) {
+	if awsclients.StringValue(cr.Status.AtProvider.Status) == svcsdk.ServiceStatusDeleteInProgress {
+		return true, nil
+	}
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proton
+
+import (
+	"reflect"
+
+	"sigs.k8s.io/yaml"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsSpecUpToDate reports whether the desired and the observed YAML spec
+// files are equivalent. Formatting, comments and the order of keys are
+// ignored. Specs that cannot be parsed are compared as strings.
+func IsSpecUpToDate(desired, observed *string) bool {
+	d, o := awsclient.StringValue(desired), awsclient.StringValue(observed)
+	if d == o {
+		return true
+	}
+	var dv, ov interface{}
+	if err := yaml.Unmarshal([]byte(d), &dv); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal([]byte(o), &ov); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(dv, ov)
+}
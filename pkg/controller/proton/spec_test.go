@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proton
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsSpecUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *string
+		observed *string
+		want     bool
+	}{
+		"Equal": {
+			desired:  awsclient.String("proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.0.0.0/16\n"),
+			observed: awsclient.String("proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.0.0.0/16\n"),
+			want:     true,
+		},
+		"Reformatted": {
+			desired:  awsclient.String("proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.0.0.0/16\n  subnet_count: 2\n"),
+			observed: awsclient.String("# generated\nspec: {subnet_count: 2, vpc_cidr: 10.0.0.0/16}\nproton: EnvironmentSpec"),
+			want:     true,
+		},
+		"Changed": {
+			desired:  awsclient.String("proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.0.0.0/16\n"),
+			observed: awsclient.String("proton: EnvironmentSpec\nspec:\n  vpc_cidr: 10.1.0.0/16\n"),
+			want:     false,
+		},
+		"Invalid": {
+			desired:  awsclient.String("spec: ["),
+			observed: awsclient.String("spec: []"),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSpecUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
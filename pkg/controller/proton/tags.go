@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proton
+
+import (
+	"context"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/proton"
+	"github.com/aws/aws-sdk-go/service/proton/protoniface"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/proton/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTagsForResource = "cannot list tags"
+	errTagResource         = "cannot tag resource"
+	errUntagResource       = "cannot untag resource"
+)
+
+// managedTagPrefixes are the key prefixes of the tags AWS and AWS Proton add
+// to resources on their own. They are never removed.
+var managedTagPrefixes = []string{"aws:", "proton:"}
+
+// AreTagsUpToDate reports whether the tags of the resource with the given ARN
+// match the spec.
+func AreTagsUpToDate(ctx context.Context, client protoniface.ProtonAPI, spec []*svcapitypes.Tag, arn *string) (bool, error) {
+	current, err := ListTags(ctx, client, arn)
+	if err != nil {
+		return false, err
+	}
+	add, remove := DiffTags(spec, current)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+// UpdateTagsForResource adds and removes tags of the resource with the given
+// ARN until they match the spec.
+func UpdateTagsForResource(ctx context.Context, client protoniface.ProtonAPI, spec []*svcapitypes.Tag, arn *string) error {
+	current, err := ListTags(ctx, client, arn)
+	if err != nil {
+		return err
+	}
+	add, remove := DiffTags(spec, current)
+	if len(remove) != 0 {
+		if _, err := client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceArn: arn,
+			TagKeys:     remove,
+		}); err != nil {
+			return errors.Wrap(err, errUntagResource)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceArn: arn,
+			Tags:        add,
+		}); err != nil {
+			return errors.Wrap(err, errTagResource)
+		}
+	}
+	return nil
+}
+
+// ListTags returns all tags of the resource with the given ARN.
+func ListTags(ctx context.Context, client protoniface.ProtonAPI, arn *string) ([]*svcsdk.Tag, error) {
+	var tags []*svcsdk.Tag
+	err := client.ListTagsForResourcePagesWithContext(ctx, &svcsdk.ListTagsForResourceInput{ResourceArn: arn},
+		func(page *svcsdk.ListTagsForResourceOutput, _ bool) bool {
+			tags = append(tags, page.Tags...)
+			return true
+		})
+	return tags, errors.Wrap(err, errListTagsForResource)
+}
+
+// DiffTags returns the tags that have to be added to and the keys of the tags
+// that have to be removed from a resource. Tags managed by AWS or AWS Proton
+// are left alone.
+func DiffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk.Tag, removeTags []*string) {
+	currentMap := make(map[string]string, len(current))
+	for _, t := range current {
+		currentMap[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	specMap := make(map[string]struct{}, len(spec))
+	for _, t := range spec {
+		key := awsclient.StringValue(t.Key)
+		val := awsclient.StringValue(t.Value)
+		specMap[key] = struct{}{}
+		if currentVal, exists := currentMap[key]; !exists || currentVal != val {
+			addTags = append(addTags, &svcsdk.Tag{
+				Key:   awsclient.String(key),
+				Value: awsclient.String(val),
+			})
+		}
+	}
+	for _, t := range current {
+		key := awsclient.StringValue(t.Key)
+		if _, exists := specMap[key]; !exists && !isManagedTag(key) {
+			removeTags = append(removeTags, awsclient.String(key))
+		}
+	}
+	return addTags, removeTags
+}
+
+func isManagedTag(key string) bool {
+	for _, p := range managedTagPrefixes {
+		if strings.HasPrefix(key, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -21,6 +21,12 @@ import (
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
 )
 
+// error constants
+const (
+	errFailover          = "cannot fail over DBCluster"
+	errUpdateDBClusterCR = "cannot update DBCluster custom resource"
+)
+
 // SetupDBCluster adds a controller that reconciles DbCluster.
 func SetupDBCluster(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.DBClusterGroupKind)
@@ -42,7 +48,7 @@ func SetupDBCluster(mgr ctrl.Manager, o controller.Options) error {
 		For(&svcapitypes.DBCluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(&failoverConnector{connector: &connector{kube: mgr.GetClient(), opts: opts}}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -82,6 +88,70 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBCluster, res
 	return obs, nil
 }
 
+// failoverConnector connects DBClusters with an external client that fails
+// them over on request, which the generated external client cannot do.
+type failoverConnector struct {
+	*connector
+}
+
+func (c *failoverConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &failoverExternal{external: ec.(*external)}, nil
+}
+
+type failoverExternal struct {
+	*external
+}
+
+// Update fails the DBCluster over if a failover is pending. It is modified
+// with the next update after the failover.
+func (e *failoverExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.DBCluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if failoverPending(cr) {
+		return managed.ExternalUpdate{}, e.failover(ctx, cr)
+	}
+	return e.external.Update(ctx, mg)
+}
+
+// failoverPending returns true if a failover was requested through the
+// AnnotationKeyFailover annotation that was not performed yet.
+func failoverPending(cr *svcapitypes.DBCluster) bool {
+	id := cr.GetAnnotations()[svcapitypes.AnnotationKeyFailover]
+	return id != "" && id != cr.GetAnnotations()[svcapitypes.AnnotationKeyLastFailover]
+}
+
+// failover fails the DBCluster over as soon as it is available and records
+// the identifier of the request in the AnnotationKeyLastFailover annotation.
+func (e *external) failover(ctx context.Context, cr *svcapitypes.DBCluster) error {
+	if aws.StringValue(cr.Status.AtProvider.Status) != "available" {
+		return nil
+	}
+	in := &svcsdk.FailoverDBClusterInput{DBClusterIdentifier: aws.String(meta.GetExternalName(cr))}
+	if target := cr.GetAnnotations()[svcapitypes.AnnotationKeyFailoverTarget]; target != "" {
+		in.TargetDBInstanceIdentifier = aws.String(target)
+	}
+	if _, err := e.client.FailoverDBClusterWithContext(ctx, in); err != nil {
+		return aws.Wrap(err, errFailover)
+	}
+	meta.AddAnnotations(cr, map[string]string{
+		svcapitypes.AnnotationKeyLastFailover: cr.GetAnnotations()[svcapitypes.AnnotationKeyFailover],
+	})
+	// Updates of the spec and metadata replace the status with the one that
+	// was last persisted, so we need to restore it.
+	status := cr.Status.DeepCopy()
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errUpdateDBClusterCR)
+	}
+	cr.Status = *status
+	return nil
+}
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
@@ -107,6 +177,10 @@ func isUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput)
 		return true, nil
 	}
 
+	if failoverPending(cr) {
+		return false, nil
+	}
+
 	if aws.BoolValue(cr.Spec.ForProvider.EnableIAMDatabaseAuthentication) != aws.BoolValue(out.DBClusters[0].IAMDatabaseAuthenticationEnabled) {
 		return false, nil
 	}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const clusterName = "cluster"
+
+var errBoom = errors.New("boom")
+
+type mockRDSClient struct {
+	svcsdkapi.RDSAPI
+
+	failover *svcsdk.FailoverDBClusterInput
+	err      error
+}
+
+func (m *mockRDSClient) FailoverDBClusterWithContext(_ context.Context, in *svcsdk.FailoverDBClusterInput, _ ...request.Option) (*svcsdk.FailoverDBClusterOutput, error) {
+	m.failover = in
+	return &svcsdk.FailoverDBClusterOutput{}, m.err
+}
+
+func TestFailover(t *testing.T) {
+	type want struct {
+		failover    *svcsdk.FailoverDBClusterInput
+		annotations map[string]string
+		updated     bool
+		err         error
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		status      string
+		err         error
+		want        want
+	}{
+		"Requested": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+			status:      "available",
+			want: want{
+				failover: &svcsdk.FailoverDBClusterInput{DBClusterIdentifier: aws.String(clusterName)},
+				annotations: map[string]string{
+					svcapitypes.AnnotationKeyFailover:     "change-1",
+					svcapitypes.AnnotationKeyLastFailover: "change-1",
+				},
+				updated: true,
+			},
+		},
+		"RequestedWithTarget": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyFailover:       "change-1",
+				svcapitypes.AnnotationKeyFailoverTarget: "reader",
+			},
+			status: "available",
+			want: want{
+				failover: &svcsdk.FailoverDBClusterInput{DBClusterIdentifier: aws.String(clusterName), TargetDBInstanceIdentifier: aws.String("reader")},
+				annotations: map[string]string{
+					svcapitypes.AnnotationKeyFailover:       "change-1",
+					svcapitypes.AnnotationKeyFailoverTarget: "reader",
+					svcapitypes.AnnotationKeyLastFailover:   "change-1",
+				},
+				updated: true,
+			},
+		},
+		"NotAvailable": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+			status:      "failing-over",
+			want: want{
+				annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+			},
+		},
+		"FailoverFailed": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+			status:      "available",
+			err:         errBoom,
+			want: want{
+				failover:    &svcsdk.FailoverDBClusterInput{DBClusterIdentifier: aws.String(clusterName)},
+				annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+				err:         aws.Wrap(errBoom, errFailover),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockRDSClient{err: tc.err}
+			updated := false
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+				updated = true
+				return nil
+			}}
+			cr := &svcapitypes.DBCluster{}
+			cr.SetAnnotations(tc.annotations)
+			cr.Status.AtProvider.Status = aws.String(tc.status)
+			meta.SetExternalName(cr, clusterName)
+			e := &failoverExternal{external: &external{client: m, kube: kube}}
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.failover, m.failover); diff != "" {
+				t.Errorf("FailoverDBCluster(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("kube.Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(aws.String(tc.status), cr.Status.AtProvider.Status); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+			tc.want.annotations[meta.AnnotationKeyExternalName] = clusterName
+			if diff := cmp.Diff(tc.want.annotations, cr.GetAnnotations()); diff != "" {
+				t.Errorf("annotations: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		status      string
+		want        bool
+	}{
+		"UpToDate": {
+			status: "available",
+			want:   true,
+		},
+		"FailoverPending": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+			status:      "available",
+			want:        false,
+		},
+		"AlreadyFailedOver": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyFailover:     "change-1",
+				svcapitypes.AnnotationKeyLastFailover: "change-1",
+			},
+			status: "available",
+			want:   true,
+		},
+		"Modifying": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyFailover: "change-1"},
+			status:      "modifying",
+			want:        true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.DBCluster{}
+			cr.SetAnnotations(tc.annotations)
+			out := &svcsdk.DescribeDBClustersOutput{DBClusters: []*svcsdk.DBCluster{{Status: aws.String(tc.status)}}}
+			got, err := isUpToDate(cr, out)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
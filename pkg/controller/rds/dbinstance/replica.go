@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbinstance
+
+import (
+	"context"
+
+	awsarn "github.com/aws/aws-sdk-go/aws/arn"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errCreateReplica  = "cannot create DBInstance read replica in AWS"
+	errPromoteReplica = "cannot promote DBInstance read replica in AWS"
+)
+
+// replicaConnector connects DBInstances that are created as or promoted from
+// read replicas, which the generated external client cannot do.
+type replicaConnector struct {
+	*connector
+}
+
+func (c *replicaConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.connector.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &replicaExternal{external: ec.(*external)}, nil
+}
+
+type replicaExternal struct {
+	*external
+}
+
+// Create creates the DBInstance as a read replica if a source DB instance
+// is given.
+func (e *replicaExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.DBInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.ReplicateSourceDBInstanceIdentifier == nil {
+		return e.external.Create(ctx, mg)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateDBInstanceReadReplicaWithContext(ctx, GenerateCreateDBInstanceReadReplicaInput(cr))
+	return managed.ExternalCreation{}, aws.Wrap(err, errCreateReplica)
+}
+
+// Update promotes the DBInstance if it is a read replica and promotion is
+// requested, starts or stops it if it is not in its desired state, or reboots
+// it if a reboot is pending. It is modified with the next update after any of
+// them.
+func (e *replicaExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.DBInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if needsPromotion(cr, cr.Status.AtProvider.ReadReplicaSourceDBInstanceIdentifier) {
+		_, err := e.client.PromoteReadReplicaWithContext(ctx, &svcsdk.PromoteReadReplicaInput{
+			DBInstanceIdentifier:  aws.String(meta.GetExternalName(cr)),
+			BackupRetentionPeriod: cr.Spec.ForProvider.BackupRetentionPeriod,
+			PreferredBackupWindow: cr.Spec.ForProvider.PreferredBackupWindow,
+		})
+		return managed.ExternalUpdate{}, aws.Wrap(err, errPromoteReplica)
+	}
+	if !isStateUpToDate(cr.Spec.ForProvider.DesiredState, aws.StringValue(cr.Status.AtProvider.DBInstanceStatus)) {
+		return managed.ExternalUpdate{}, e.changeState(ctx, cr)
+	}
+	if rebootPending(cr) {
+		return managed.ExternalUpdate{}, e.reboot(ctx, cr)
+	}
+	return e.external.Update(ctx, mg)
+}
+
+func needsPromotion(cr *svcapitypes.DBInstance, source *string) bool {
+	return cr.Spec.ForProvider.PromoteReadReplica && aws.StringValue(source) != ""
+}
+
+// GenerateCreateDBInstanceReadReplicaInput returns the input to create the
+// given DBInstance as a read replica of its source DB instance. The source
+// region is set for a source in another region, so that the request is
+// presigned for it.
+func GenerateCreateDBInstanceReadReplicaInput(cr *svcapitypes.DBInstance) *svcsdk.CreateDBInstanceReadReplicaInput { // nolint:gocyclo
+	p := cr.Spec.ForProvider
+	res := &svcsdk.CreateDBInstanceReadReplicaInput{
+		DBInstanceIdentifier:               aws.String(meta.GetExternalName(cr)),
+		SourceDBInstanceIdentifier:         p.ReplicateSourceDBInstanceIdentifier,
+		AutoMinorVersionUpgrade:            p.AutoMinorVersionUpgrade,
+		AvailabilityZone:                   p.AvailabilityZone,
+		CopyTagsToSnapshot:                 p.CopyTagsToSnapshot,
+		CustomIamInstanceProfile:           p.CustomIAMInstanceProfile,
+		DBInstanceClass:                    p.DBInstanceClass,
+		DBParameterGroupName:               p.DBParameterGroupName,
+		DBSubnetGroupName:                  p.DBSubnetGroupName,
+		DeletionProtection:                 p.DeletionProtection,
+		Domain:                             p.Domain,
+		DomainIAMRoleName:                  p.DomainIAMRoleName,
+		EnableCloudwatchLogsExports:        p.EnableCloudwatchLogsExports,
+		EnableIAMDatabaseAuthentication:    p.EnableIAMDatabaseAuthentication,
+		EnablePerformanceInsights:          p.EnablePerformanceInsights,
+		Iops:                               p.IOPS,
+		KmsKeyId:                           p.KMSKeyID,
+		MaxAllocatedStorage:                p.MaxAllocatedStorage,
+		MonitoringInterval:                 p.MonitoringInterval,
+		MonitoringRoleArn:                  p.MonitoringRoleARN,
+		MultiAZ:                            p.MultiAZ,
+		OptionGroupName:                    p.OptionGroupName,
+		PerformanceInsightsKMSKeyId:        p.PerformanceInsightsKMSKeyID,
+		PerformanceInsightsRetentionPeriod: p.PerformanceInsightsRetentionPeriod,
+		Port:                               p.Port,
+		PubliclyAccessible:                 p.PubliclyAccessible,
+		StorageType:                        p.StorageType,
+	}
+	if a, err := awsarn.Parse(aws.StringValue(p.ReplicateSourceDBInstanceIdentifier)); err == nil && a.Region != p.Region {
+		res.SourceRegion = aws.String(a.Region)
+	}
+	for _, f := range p.ProcessorFeatures {
+		res.ProcessorFeatures = append(res.ProcessorFeatures, &svcsdk.ProcessorFeature{Name: f.Name, Value: f.Value})
+	}
+	for _, t := range p.Tags {
+		res.Tags = append(res.Tags, &svcsdk.Tag{Key: t.Key, Value: t.Value})
+	}
+	for _, v := range p.VPCSecurityGroupIDs {
+		res.VpcSecurityGroupIds = append(res.VpcSecurityGroupIds, aws.String(v))
+	}
+	return res
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbinstance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	replicaName = "replica"
+	sourceARN   = "arn:aws:rds:us-east-1:123456789012:db:source"
+)
+
+var errBoom = errors.New("boom")
+
+type mockRDSClient struct {
+	svcsdkapi.RDSAPI
+
+	replica *svcsdk.CreateDBInstanceReadReplicaInput
+	promote *svcsdk.PromoteReadReplicaInput
+	reboot  *svcsdk.RebootDBInstanceInput
+	start   *svcsdk.StartDBInstanceInput
+	stop    *svcsdk.StopDBInstanceInput
+	err     error
+}
+
+func (m *mockRDSClient) RebootDBInstanceWithContext(_ context.Context, in *svcsdk.RebootDBInstanceInput, _ ...request.Option) (*svcsdk.RebootDBInstanceOutput, error) {
+	m.reboot = in
+	return &svcsdk.RebootDBInstanceOutput{}, m.err
+}
+
+func (m *mockRDSClient) StartDBInstanceWithContext(_ context.Context, in *svcsdk.StartDBInstanceInput, _ ...request.Option) (*svcsdk.StartDBInstanceOutput, error) {
+	m.start = in
+	return &svcsdk.StartDBInstanceOutput{}, m.err
+}
+
+func (m *mockRDSClient) StopDBInstanceWithContext(_ context.Context, in *svcsdk.StopDBInstanceInput, _ ...request.Option) (*svcsdk.StopDBInstanceOutput, error) {
+	m.stop = in
+	return &svcsdk.StopDBInstanceOutput{}, m.err
+}
+
+func (m *mockRDSClient) CreateDBInstanceReadReplicaWithContext(_ context.Context, in *svcsdk.CreateDBInstanceReadReplicaInput, _ ...request.Option) (*svcsdk.CreateDBInstanceReadReplicaOutput, error) {
+	m.replica = in
+	return &svcsdk.CreateDBInstanceReadReplicaOutput{}, m.err
+}
+
+func (m *mockRDSClient) PromoteReadReplicaWithContext(_ context.Context, in *svcsdk.PromoteReadReplicaInput, _ ...request.Option) (*svcsdk.PromoteReadReplicaOutput, error) {
+	m.promote = in
+	return &svcsdk.PromoteReadReplicaOutput{}, m.err
+}
+
+func replica(region, source string) *svcapitypes.DBInstance {
+	cr := &svcapitypes.DBInstance{}
+	meta.SetExternalName(cr, replicaName)
+	cr.Spec.ForProvider.Region = region
+	cr.Spec.ForProvider.DBInstanceClass = aws.String("db.t3.micro")
+	cr.Spec.ForProvider.ReplicateSourceDBInstanceIdentifier = aws.String(source)
+	cr.Spec.ForProvider.VPCSecurityGroupIDs = []string{"sg-1"}
+	return cr
+}
+
+func TestGenerateCreateDBInstanceReadReplicaInput(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.DBInstance
+		want *svcsdk.CreateDBInstanceReadReplicaInput
+	}{
+		"SameRegion": {
+			cr: replica("us-east-1", sourceARN),
+			want: &svcsdk.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       aws.String(replicaName),
+				SourceDBInstanceIdentifier: aws.String(sourceARN),
+				DBInstanceClass:            aws.String("db.t3.micro"),
+				VpcSecurityGroupIds:        []*string{aws.String("sg-1")},
+			},
+		},
+		"SourceIdentifier": {
+			cr: replica("us-east-1", "source"),
+			want: &svcsdk.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       aws.String(replicaName),
+				SourceDBInstanceIdentifier: aws.String("source"),
+				DBInstanceClass:            aws.String("db.t3.micro"),
+				VpcSecurityGroupIds:        []*string{aws.String("sg-1")},
+			},
+		},
+		"CrossRegion": {
+			cr: replica("eu-west-1", sourceARN),
+			want: &svcsdk.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       aws.String(replicaName),
+				SourceDBInstanceIdentifier: aws.String(sourceARN),
+				SourceRegion:               aws.String("us-east-1"),
+				DBInstanceClass:            aws.String("db.t3.micro"),
+				VpcSecurityGroupIds:        []*string{aws.String("sg-1")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateCreateDBInstanceReadReplicaInput(tc.cr)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReplicaCreate(t *testing.T) {
+	type want struct {
+		input *svcsdk.CreateDBInstanceReadReplicaInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		client *mockRDSClient
+		want   want
+	}{
+		"Successful": {
+			client: &mockRDSClient{},
+			want: want{
+				input: GenerateCreateDBInstanceReadReplicaInput(replica("us-east-1", sourceARN)),
+			},
+		},
+		"Failed": {
+			client: &mockRDSClient{err: errBoom},
+			want: want{
+				input: GenerateCreateDBInstanceReadReplicaInput(replica("us-east-1", sourceARN)),
+				err:   aws.Wrap(errBoom, errCreateReplica),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &replicaExternal{external: &external{client: tc.client}}
+			got, err := e.Create(context.Background(), replica("us-east-1", sourceARN))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(managed.ExternalCreation{}, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, tc.client.replica); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReplicaUpdate(t *testing.T) {
+	promoted := func(retention int64) *svcapitypes.DBInstance {
+		cr := replica("us-east-1", sourceARN)
+		cr.Spec.ForProvider.PromoteReadReplica = true
+		cr.Spec.ForProvider.BackupRetentionPeriod = &retention
+		cr.Status.AtProvider.ReadReplicaSourceDBInstanceIdentifier = aws.String(sourceARN)
+		return cr
+	}
+
+	type want struct {
+		input *svcsdk.PromoteReadReplicaInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		client *mockRDSClient
+		want   want
+	}{
+		"Successful": {
+			client: &mockRDSClient{},
+			want: want{
+				input: &svcsdk.PromoteReadReplicaInput{
+					DBInstanceIdentifier:  aws.String(replicaName),
+					BackupRetentionPeriod: aws.Int64(7),
+				},
+			},
+		},
+		"Failed": {
+			client: &mockRDSClient{err: errBoom},
+			want: want{
+				input: &svcsdk.PromoteReadReplicaInput{
+					DBInstanceIdentifier:  aws.String(replicaName),
+					BackupRetentionPeriod: aws.Int64(7),
+				},
+				err: aws.Wrap(errBoom, errPromoteReplica),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &replicaExternal{external: &external{client: tc.client}}
+			_, err := e.Update(context.Background(), promoted(7))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, tc.client.promote); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,11 +28,16 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
+	"github.com/crossplane/provider-aws/pkg/controller/requeue"
 )
 
 // error constants
 const (
-	errSaveSecretFailed = "failed to save generated password to Kubernetes secret"
+	errSaveSecretFailed   = "failed to save generated password to Kubernetes secret"
+	errReboot             = "cannot reboot DBInstance"
+	errUpdateDBInstanceCR = "cannot update DBInstance custom resource"
+	errStart              = "cannot start DBInstance"
+	errStop               = "cannot stop DBInstance"
 )
 
 // time formats
@@ -44,9 +49,10 @@ const (
 // SetupDBInstance adds a controller that reconciles DBInstance
 func SetupDBInstance(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.DBInstanceGroupKind)
+	hints := requeue.NewHints()
 	opts := []option{
 		func(e *external) {
-			c := &custom{client: e.client, kube: e.kube, external: e}
+			c := &custom{client: e.client, kube: e.kube, external: e, hints: hints}
 			e.lateInitialize = lateInitialize
 			e.isUpToDate = c.isUpToDate
 			e.preObserve = preObserve
@@ -62,18 +68,19 @@ func SetupDBInstance(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&svcapitypes.DBInstance{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(requeue.NewReconciler(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(&replicaConnector{connector: &connector{kube: mgr.GetClient(), opts: opts}}),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))), hints))
 }
 
 type custom struct {
 	kube     client.Client
 	client   svcsdkapi.RDSAPI
 	external *external
+	hints    *requeue.Hints
 }
 
 func preObserve(_ context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.DescribeDBInstancesInput) error {
@@ -174,19 +181,87 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBInstance, re
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
-	switch aws.StringValue(resp.DBInstances[0].DBInstanceStatus) {
+	status := aws.StringValue(resp.DBInstances[0].DBInstanceStatus)
+	switch status {
 	case "available", "modifying":
 		cr.SetConditions(xpv1.Available())
-	case "deleting", "stopped", "stopping":
+	case "stopped":
+		// A DB instance that was stopped on purpose is where it should be.
+		if aws.StringValue(cr.Spec.ForProvider.DesiredState) == svcapitypes.DBInstanceStateStopped {
+			cr.SetConditions(xpv1.Available())
+		} else {
+			cr.SetConditions(xpv1.Unavailable())
+		}
+	case "deleting", "stopping":
 		cr.SetConditions(xpv1.Unavailable())
 	case "creating":
 		cr.SetConditions(xpv1.Creating())
 	}
+	if d, ok := rds.InstanceOperationDuration(status); ok {
+		e.hints.InProgress(cr, requeue.Operation{Name: status, Expected: d})
+	}
 
 	obs.ConnectionDetails, _ = e.assembleConnectionDetails(ctx, cr)
 	return obs, nil
 }
 
+// isStateUpToDate returns false if the DBInstance has settled in a status
+// other than its desired state.
+func isStateUpToDate(desired *string, status string) bool {
+	switch aws.StringValue(desired) {
+	case svcapitypes.DBInstanceStateAvailable:
+		return status != "stopped"
+	case svcapitypes.DBInstanceStateStopped:
+		return status != "available"
+	}
+	return true
+}
+
+// changeState starts or stops the DBInstance so that it reaches its desired
+// state.
+func (e *external) changeState(ctx context.Context, cr *svcapitypes.DBInstance) error {
+	id := aws.String(meta.GetExternalName(cr))
+	if aws.StringValue(cr.Spec.ForProvider.DesiredState) == svcapitypes.DBInstanceStateStopped {
+		_, err := e.client.StopDBInstanceWithContext(ctx, &svcsdk.StopDBInstanceInput{DBInstanceIdentifier: id})
+		return aws.Wrap(err, errStop)
+	}
+	_, err := e.client.StartDBInstanceWithContext(ctx, &svcsdk.StartDBInstanceInput{DBInstanceIdentifier: id})
+	return aws.Wrap(err, errStart)
+}
+
+// rebootPending returns true if a reboot was requested through the
+// AnnotationKeyReboot annotation that was not performed yet.
+func rebootPending(cr *svcapitypes.DBInstance) bool {
+	id := cr.GetAnnotations()[svcapitypes.AnnotationKeyReboot]
+	return id != "" && id != cr.GetAnnotations()[svcapitypes.AnnotationKeyLastReboot]
+}
+
+// reboot reboots the DBInstance as soon as it is available and records the
+// identifier of the request in the AnnotationKeyLastReboot annotation.
+func (e *external) reboot(ctx context.Context, cr *svcapitypes.DBInstance) error {
+	if aws.StringValue(cr.Status.AtProvider.DBInstanceStatus) != "available" {
+		return nil
+	}
+	in := &svcsdk.RebootDBInstanceInput{DBInstanceIdentifier: aws.String(meta.GetExternalName(cr))}
+	if cr.GetAnnotations()[svcapitypes.AnnotationKeyRebootForceFailover] == "true" {
+		in.ForceFailover = aws.Bool(true)
+	}
+	if _, err := e.client.RebootDBInstanceWithContext(ctx, in); err != nil {
+		return aws.Wrap(err, errReboot)
+	}
+	meta.AddAnnotations(cr, map[string]string{
+		svcapitypes.AnnotationKeyLastReboot: cr.GetAnnotations()[svcapitypes.AnnotationKeyReboot],
+	})
+	// Updates of the spec and metadata replace the status with the one that
+	// was last persisted, so we need to restore it.
+	status := cr.Status.DeepCopy()
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errUpdateDBInstanceCR)
+	}
+	cr.Status = *status
+	return nil
+}
+
 func lateInitialize(in *svcapitypes.DBInstanceParameters, out *svcsdk.DescribeDBInstancesOutput) error { // nolint:gocyclo
 	// (PocketMobsters): The controller should already be checking if out is nil so we *should* have a dbinstance here, always
 	db := out.DBInstances[0]
@@ -297,6 +372,18 @@ func (e *custom) isUpToDate(cr *svcapitypes.DBInstance, out *svcsdk.DescribeDBIn
 	if status == "modifying" || status == "upgrading" {
 		return true, nil
 	}
+	if !isStateUpToDate(cr.Spec.ForProvider.DesiredState, status) {
+		return false, nil
+	}
+	// Stopped DB instances can't be modified. Modifications are sent once the
+	// DB instance has been started again.
+	if status == "stopped" || status == "stopping" || status == "starting" {
+		return true, nil
+	}
+
+	if needsPromotion(cr, db.ReadReplicaSourceDBInstanceIdentifier) || rebootPending(cr) {
+		return false, nil
+	}
 
 	_, pwChanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
@@ -325,6 +412,9 @@ func (e *custom) isUpToDate(cr *svcapitypes.DBInstance, out *svcsdk.DescribeDBIn
 		cmpopts.IgnoreFields(svcapitypes.DBInstanceParameters{}, "PreferredMaintenanceWindow"),
 		cmpopts.IgnoreFields(svcapitypes.DBInstanceParameters{}, "PreferredBackupWindow"),
 		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "ApplyImmediately"),
+		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "DesiredState"),
+		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "PromoteReadReplica"),
+		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "ReplicateSourceDBInstanceIdentifier"),
 	) && !maintenanceWindowChanged && !backupWindowChanged && !pwChanged, nil
 }
 
@@ -0,0 +1,272 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbinstance
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestReboot(t *testing.T) {
+	type want struct {
+		reboot      *svcsdk.RebootDBInstanceInput
+		annotations map[string]string
+		updated     bool
+		err         error
+	}
+
+	cases := map[string]struct {
+		annotations map[string]string
+		status      string
+		err         error
+		want        want
+	}{
+		"Requested": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyReboot: "change-1"},
+			status:      "available",
+			want: want{
+				reboot: &svcsdk.RebootDBInstanceInput{DBInstanceIdentifier: aws.String(replicaName)},
+				annotations: map[string]string{
+					svcapitypes.AnnotationKeyReboot:     "change-1",
+					svcapitypes.AnnotationKeyLastReboot: "change-1",
+				},
+				updated: true,
+			},
+		},
+		"RequestedWithFailover": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyReboot:              "change-1",
+				svcapitypes.AnnotationKeyRebootForceFailover: "true",
+			},
+			status: "available",
+			want: want{
+				reboot: &svcsdk.RebootDBInstanceInput{DBInstanceIdentifier: aws.String(replicaName), ForceFailover: aws.Bool(true)},
+				annotations: map[string]string{
+					svcapitypes.AnnotationKeyReboot:              "change-1",
+					svcapitypes.AnnotationKeyRebootForceFailover: "true",
+					svcapitypes.AnnotationKeyLastReboot:          "change-1",
+				},
+				updated: true,
+			},
+		},
+		"NotAvailable": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyReboot: "change-1"},
+			status:      "backing-up",
+			want: want{
+				annotations: map[string]string{svcapitypes.AnnotationKeyReboot: "change-1"},
+			},
+		},
+		"RebootFailed": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyReboot: "change-1"},
+			status:      "available",
+			err:         errBoom,
+			want: want{
+				reboot:      &svcsdk.RebootDBInstanceInput{DBInstanceIdentifier: aws.String(replicaName)},
+				annotations: map[string]string{svcapitypes.AnnotationKeyReboot: "change-1"},
+				err:         aws.Wrap(errBoom, errReboot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockRDSClient{err: tc.err}
+			updated := false
+			kube := &test.MockClient{MockUpdate: func(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+				updated = true
+				return nil
+			}}
+			cr := &svcapitypes.DBInstance{}
+			cr.SetAnnotations(tc.annotations)
+			cr.Status.AtProvider.DBInstanceStatus = aws.String(tc.status)
+			meta.SetExternalName(cr, replicaName)
+			e := &replicaExternal{external: &external{client: m, kube: kube}}
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reboot, m.reboot); diff != "" {
+				t.Errorf("RebootDBInstance(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("kube.Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(aws.String(tc.status), cr.Status.AtProvider.DBInstanceStatus); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+			tc.want.annotations[meta.AnnotationKeyExternalName] = replicaName
+			if diff := cmp.Diff(tc.want.annotations, cr.GetAnnotations()); diff != "" {
+				t.Errorf("annotations: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRebootPending(t *testing.T) {
+	cases := map[string]struct {
+		annotations map[string]string
+		want        bool
+	}{
+		"NotRequested": {
+			want: false,
+		},
+		"Requested": {
+			annotations: map[string]string{svcapitypes.AnnotationKeyReboot: "change-1"},
+			want:        true,
+		},
+		"AlreadyRebooted": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyReboot:     "change-1",
+				svcapitypes.AnnotationKeyLastReboot: "change-1",
+			},
+			want: false,
+		},
+		"RequestedAgain": {
+			annotations: map[string]string{
+				svcapitypes.AnnotationKeyReboot:     "change-2",
+				svcapitypes.AnnotationKeyLastReboot: "change-1",
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.DBInstance{}
+			cr.SetAnnotations(tc.annotations)
+			if diff := cmp.Diff(tc.want, rebootPending(cr)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeState(t *testing.T) {
+	type want struct {
+		start *svcsdk.StartDBInstanceInput
+		stop  *svcsdk.StopDBInstanceInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		desired *string
+		status  string
+		err     error
+		want    want
+	}{
+		"Start": {
+			desired: aws.String(svcapitypes.DBInstanceStateAvailable),
+			status:  "stopped",
+			want: want{
+				start: &svcsdk.StartDBInstanceInput{DBInstanceIdentifier: aws.String(replicaName)},
+			},
+		},
+		"Stop": {
+			desired: aws.String(svcapitypes.DBInstanceStateStopped),
+			status:  "available",
+			want: want{
+				stop: &svcsdk.StopDBInstanceInput{DBInstanceIdentifier: aws.String(replicaName)},
+			},
+		},
+		"StopFailed": {
+			desired: aws.String(svcapitypes.DBInstanceStateStopped),
+			status:  "available",
+			err:     errBoom,
+			want: want{
+				stop: &svcsdk.StopDBInstanceInput{DBInstanceIdentifier: aws.String(replicaName)},
+				err:  aws.Wrap(errBoom, errStop),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := &mockRDSClient{err: tc.err}
+			cr := &svcapitypes.DBInstance{}
+			cr.Spec.ForProvider.DesiredState = tc.desired
+			cr.Status.AtProvider.DBInstanceStatus = aws.String(tc.status)
+			meta.SetExternalName(cr, replicaName)
+			e := &replicaExternal{external: &external{client: m}}
+			_, err := e.Update(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.start, m.start); diff != "" {
+				t.Errorf("StartDBInstance(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.stop, m.stop); diff != "" {
+				t.Errorf("StopDBInstance(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsStateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired *string
+		status  string
+		want    bool
+	}{
+		"NotManaged": {
+			status: "stopped",
+			want:   true,
+		},
+		"Available": {
+			desired: aws.String(svcapitypes.DBInstanceStateAvailable),
+			status:  "available",
+			want:    true,
+		},
+		"Stopped": {
+			desired: aws.String(svcapitypes.DBInstanceStateAvailable),
+			status:  "stopped",
+			want:    false,
+		},
+		"Stopping": {
+			desired: aws.String(svcapitypes.DBInstanceStateAvailable),
+			status:  "stopping",
+			want:    true,
+		},
+		"NotStopped": {
+			desired: aws.String(svcapitypes.DBInstanceStateStopped),
+			status:  "available",
+			want:    false,
+		},
+		"BackingUp": {
+			desired: aws.String(svcapitypes.DBInstanceStateStopped),
+			status:  "backing-up",
+			want:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, isStateUpToDate(tc.desired, tc.status)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
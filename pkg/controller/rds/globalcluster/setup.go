@@ -3,8 +3,11 @@ package globalcluster
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -26,7 +29,8 @@ func SetupGlobalCluster(mgr ctrl.Manager, o controller.Options) error {
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.filterList = filterList
-			e.postObserve = postObserve
+			h := &hooks{kube: e.kube, client: e.client, newClientFn: newRegionalClient}
+			e.postObserve = h.postObserve
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -56,10 +60,26 @@ func preDelete(_ context.Context, cr *svcapitypes.GlobalCluster, obj *svcsdk.Del
 	return false, nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.GlobalCluster, resp *svcsdk.DescribeGlobalClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+type hooks struct {
+	kube        client.Client
+	client      svcsdkapi.RDSAPI
+	newClientFn func(ctx context.Context, kube client.Client, cr *svcapitypes.GlobalCluster, region string) (svcsdkapi.RDSAPI, error)
+}
+
+func newRegionalClient(ctx context.Context, kube client.Client, cr *svcapitypes.GlobalCluster, region string) (svcsdkapi.RDSAPI, error) {
+	sess, err := aws.GetConfigV1(ctx, kube, cr, region)
+	if err != nil {
+		return nil, err
+	}
+	return svcsdk.New(sess), nil
+}
+
+func (e *hooks) postObserve(ctx context.Context, cr *svcapitypes.GlobalCluster, resp *svcsdk.DescribeGlobalClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	cr.Status.AtProvider.MemberEndpoints = e.memberEndpoints(ctx, cr, resp.GlobalClusters[0].GlobalClusterMembers)
+
 	switch aws.StringValue(resp.GlobalClusters[0].Status) {
 	case "available":
 		cr.SetConditions(xpv1.Available())
@@ -81,3 +101,44 @@ func filterList(cr *svcapitypes.GlobalCluster, obj *svcsdk.DescribeGlobalCluster
 	}
 	return resp
 }
+
+// memberEndpoints returns the writer and reader endpoints of the given global
+// cluster members. Members are described in their own region. Members that
+// cannot be described, e.g. because they were deleted, belong to another
+// account or are in a region without credentials, are reported without
+// endpoints so that they don't block observing or deleting the global
+// cluster.
+func (e *hooks) memberEndpoints(ctx context.Context, cr *svcapitypes.GlobalCluster, members []*svcsdk.GlobalClusterMember) []svcapitypes.GlobalClusterMemberEndpoints {
+	if len(members) == 0 {
+		return nil
+	}
+	clients := map[string]svcsdkapi.RDSAPI{cr.Spec.ForProvider.Region: e.client}
+	res := make([]svcapitypes.GlobalClusterMemberEndpoints, len(members))
+	for i, m := range members {
+		res[i] = svcapitypes.GlobalClusterMemberEndpoints{
+			DBClusterARN: aws.StringValue(m.DBClusterArn),
+			IsWriter:     aws.BoolValue(m.IsWriter),
+		}
+		a, err := arn.Parse(aws.StringValue(m.DBClusterArn))
+		if err != nil {
+			continue
+		}
+		res[i].Region = a.Region
+		c, ok := clients[a.Region]
+		if !ok {
+			if c, err = e.newClientFn(ctx, e.kube, cr, a.Region); err != nil {
+				continue
+			}
+			clients[a.Region] = c
+		}
+		out, err := c.DescribeDBClustersWithContext(ctx, &svcsdk.DescribeDBClustersInput{
+			DBClusterIdentifier: m.DBClusterArn,
+		})
+		if err != nil || len(out.DBClusters) == 0 {
+			continue
+		}
+		res[i].Endpoint = aws.StringValue(out.DBClusters[0].Endpoint)
+		res[i].ReaderEndpoint = aws.StringValue(out.DBClusters[0].ReaderEndpoint)
+	}
+	return res
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalcluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	primaryARN   = "arn:aws:rds:us-east-1:123456789012:cluster:primary"
+	secondaryARN = "arn:aws:rds:eu-west-1:123456789012:cluster:secondary"
+)
+
+var errBoom = errors.New("boom")
+
+type mockRDSClient struct {
+	svcsdkapi.RDSAPI
+
+	clusters map[string]*svcsdk.DBCluster
+	err      error
+}
+
+func (m *mockRDSClient) DescribeDBClustersWithContext(_ context.Context, in *svcsdk.DescribeDBClustersInput, _ ...request.Option) (*svcsdk.DescribeDBClustersOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	c, ok := m.clusters[aws.StringValue(in.DBClusterIdentifier)]
+	if !ok {
+		return nil, awserr.New(svcsdk.ErrCodeDBClusterNotFoundFault, "not found", nil)
+	}
+	return &svcsdk.DescribeDBClustersOutput{DBClusters: []*svcsdk.DBCluster{c}}, nil
+}
+
+func globalCluster() *svcapitypes.GlobalCluster {
+	cr := &svcapitypes.GlobalCluster{}
+	cr.Spec.ForProvider.Region = "us-east-1"
+	return cr
+}
+
+func describeOutput(status string) *svcsdk.DescribeGlobalClustersOutput {
+	return &svcsdk.DescribeGlobalClustersOutput{
+		GlobalClusters: []*svcsdk.GlobalCluster{{
+			Status: aws.String(status),
+			GlobalClusterMembers: []*svcsdk.GlobalClusterMember{
+				{DBClusterArn: aws.String(primaryARN), IsWriter: aws.Bool(true)},
+				{DBClusterArn: aws.String(secondaryARN), IsWriter: aws.Bool(false)},
+			},
+		}},
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	primary := &mockRDSClient{clusters: map[string]*svcsdk.DBCluster{
+		primaryARN: {Endpoint: aws.String("primary.example.com"), ReaderEndpoint: aws.String("primary-ro.example.com")},
+	}}
+	secondary := &mockRDSClient{clusters: map[string]*svcsdk.DBCluster{
+		secondaryARN: {Endpoint: aws.String("secondary.example.com"), ReaderEndpoint: aws.String("secondary-ro.example.com")},
+	}}
+
+	type want struct {
+		endpoints []svcapitypes.GlobalClusterMemberEndpoints
+		err       error
+	}
+
+	cases := map[string]struct {
+		newClientFn func(ctx context.Context, kube client.Client, cr *svcapitypes.GlobalCluster, region string) (svcsdkapi.RDSAPI, error)
+		want        want
+	}{
+		"AllMembersDescribed": {
+			newClientFn: func(context.Context, client.Client, *svcapitypes.GlobalCluster, string) (svcsdkapi.RDSAPI, error) {
+				return secondary, nil
+			},
+			want: want{
+				endpoints: []svcapitypes.GlobalClusterMemberEndpoints{
+					{Region: "us-east-1", DBClusterARN: primaryARN, IsWriter: true, Endpoint: "primary.example.com", ReaderEndpoint: "primary-ro.example.com"},
+					{Region: "eu-west-1", DBClusterARN: secondaryARN, Endpoint: "secondary.example.com", ReaderEndpoint: "secondary-ro.example.com"},
+				},
+			},
+		},
+		"MemberNotFound": {
+			newClientFn: func(context.Context, client.Client, *svcapitypes.GlobalCluster, string) (svcsdkapi.RDSAPI, error) {
+				return &mockRDSClient{}, nil
+			},
+			want: want{
+				endpoints: []svcapitypes.GlobalClusterMemberEndpoints{
+					{Region: "us-east-1", DBClusterARN: primaryARN, IsWriter: true, Endpoint: "primary.example.com", ReaderEndpoint: "primary-ro.example.com"},
+					{Region: "eu-west-1", DBClusterARN: secondaryARN},
+				},
+			},
+		},
+		"MemberDescribeFailed": {
+			newClientFn: func(context.Context, client.Client, *svcapitypes.GlobalCluster, string) (svcsdkapi.RDSAPI, error) {
+				return &mockRDSClient{err: errBoom}, nil
+			},
+			want: want{
+				endpoints: []svcapitypes.GlobalClusterMemberEndpoints{
+					{Region: "us-east-1", DBClusterARN: primaryARN, IsWriter: true, Endpoint: "primary.example.com", ReaderEndpoint: "primary-ro.example.com"},
+					{Region: "eu-west-1", DBClusterARN: secondaryARN},
+				},
+			},
+		},
+		"NoCredentialsForMemberRegion": {
+			newClientFn: func(context.Context, client.Client, *svcapitypes.GlobalCluster, string) (svcsdkapi.RDSAPI, error) {
+				return nil, errBoom
+			},
+			want: want{
+				endpoints: []svcapitypes.GlobalClusterMemberEndpoints{
+					{Region: "us-east-1", DBClusterARN: primaryARN, IsWriter: true, Endpoint: "primary.example.com", ReaderEndpoint: "primary-ro.example.com"},
+					{Region: "eu-west-1", DBClusterARN: secondaryARN},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: primary, newClientFn: tc.newClientFn}
+			cr := globalCluster()
+			_, err := h.postObserve(context.Background(), cr, describeOutput("available"), managed.ExternalObservation{ResourceExists: true}, nil)
+			if diff := cmp.Diff(tc.want.err, err); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.endpoints, cr.Status.AtProvider.MemberEndpoints); diff != "" {
+				t.Errorf("endpoints: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
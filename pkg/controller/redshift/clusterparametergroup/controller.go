@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterparametergroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsredshift "github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/redshift"
+)
+
+// Error strings.
+const (
+	errNotParameterGroup   = "managed resource is not a Redshift cluster parameter group"
+	errDescribe            = "cannot describe Redshift cluster parameter group"
+	errDescribeParameters  = "cannot describe parameters of Redshift cluster parameter group"
+	errCreate              = "cannot create Redshift cluster parameter group"
+	errModifyParameters    = "cannot modify parameters of Redshift cluster parameter group"
+	errResetParameters     = "cannot reset parameters of Redshift cluster parameter group"
+	errDelete              = "cannot delete Redshift cluster parameter group"
+	errMultipleParamGroups = "multiple cluster parameter groups with the same name found"
+)
+
+// SetupClusterParameterGroup adds a controller that reconciles Redshift
+// cluster parameter groups.
+func SetupClusterParameterGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ClusterParameterGroupGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.ClusterParameterGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ClusterParameterGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: redshift.NewClusterParameterGroupClient}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) redshift.ClusterParameterGroupClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ClusterParameterGroup)
+	if !ok {
+		return nil, errors.New(errNotParameterGroup)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client redshift.ClusterParameterGroupClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterParameterGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotParameterGroup)
+	}
+
+	rsp, err := e.client.DescribeClusterParameterGroups(ctx, &awsredshift.DescribeClusterParameterGroupsInput{
+		ParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(redshift.IsClusterParameterGroupNotFound, err), errDescribe)
+	}
+	if len(rsp.ParameterGroups) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleParamGroups)
+	}
+
+	params, err := redshift.GetUserParameters(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeParameters)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: redshift.IsClusterParameterGroupUpToDate(cr.Spec.ForProvider, params),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ClusterParameterGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotParameterGroup)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateClusterParameterGroup(ctx, redshift.GenerateCreateClusterParameterGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ClusterParameterGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotParameterGroup)
+	}
+
+	params, err := redshift.GetUserParameters(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribeParameters)
+	}
+
+	modify, reset := redshift.DiffClusterParameters(cr.Spec.ForProvider.Parameters, params)
+	if len(reset) != 0 {
+		if _, err := e.client.ResetClusterParameterGroup(ctx, &awsredshift.ResetClusterParameterGroupInput{
+			ParameterGroupName: aws.String(meta.GetExternalName(cr)),
+			Parameters:         reset,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errResetParameters)
+		}
+	}
+	if len(modify) != 0 {
+		if _, err := e.client.ModifyClusterParameterGroup(ctx, &awsredshift.ModifyClusterParameterGroupInput{
+			ParameterGroupName: aws.String(meta.GetExternalName(cr)),
+			Parameters:         modify,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyParameters)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ClusterParameterGroup)
+	if !ok {
+		return errors.New(errNotParameterGroup)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteClusterParameterGroup(ctx, &awsredshift.DeleteClusterParameterGroupInput{
+		ParameterGroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(redshift.IsClusterParameterGroupNotFound, err), errDelete)
+}
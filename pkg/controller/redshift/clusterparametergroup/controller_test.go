@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterparametergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsredshift "github.com/aws/aws-sdk-go-v2/service/redshift"
+	awsredshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/redshift"
+	"github.com/crossplane/provider-aws/pkg/clients/redshift/fake"
+)
+
+var (
+	groupName = "some-group"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	redshift redshift.ClusterParameterGroupClient
+	cr       *v1alpha1.ClusterParameterGroup
+}
+
+type groupModifier func(*v1alpha1.ClusterParameterGroup)
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(r *v1alpha1.ClusterParameterGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(p ...v1alpha1.ClusterParameter) groupModifier {
+	return func(r *v1alpha1.ClusterParameterGroup) { r.Spec.ForProvider.Parameters = p }
+}
+
+func parameterGroup(m ...groupModifier) *v1alpha1.ClusterParameterGroup {
+	cr := &v1alpha1.ClusterParameterGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeGroups(ctx context.Context, input *awsredshift.DescribeClusterParameterGroupsInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClusterParameterGroupsOutput, error) {
+	return &awsredshift.DescribeClusterParameterGroupsOutput{
+		ParameterGroups: []awsredshifttypes.ClusterParameterGroup{{ParameterGroupName: aws.String(groupName)}},
+	}, nil
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.ClusterParameterGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				redshift: &fake.MockClusterParameterGroupClient{
+					MockDescribe: describeGroups,
+					MockDescribeParams: func(ctx context.Context, input *awsredshift.DescribeClusterParametersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClusterParametersOutput, error) {
+						return &awsredshift.DescribeClusterParametersOutput{
+							Parameters: []awsredshifttypes.Parameter{{ParameterName: aws.String("require_ssl"), ParameterValue: aws.String("true")}},
+						}, nil
+					},
+				},
+				cr: parameterGroup(withParameters(v1alpha1.ClusterParameter{ParameterName: "require_ssl", ParameterValue: "true"})),
+			},
+			want: want{
+				cr: parameterGroup(withParameters(v1alpha1.ClusterParameter{ParameterName: "require_ssl", ParameterValue: "true"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ParameterNotReset": {
+			args: args{
+				redshift: &fake.MockClusterParameterGroupClient{
+					MockDescribe: describeGroups,
+					MockDescribeParams: func(ctx context.Context, input *awsredshift.DescribeClusterParametersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClusterParametersOutput, error) {
+						return &awsredshift.DescribeClusterParametersOutput{
+							Parameters: []awsredshifttypes.Parameter{{ParameterName: aws.String("require_ssl"), ParameterValue: aws.String("true")}},
+						}, nil
+					},
+				},
+				cr: parameterGroup(),
+			},
+			want: want{
+				cr: parameterGroup(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				redshift: &fake.MockClusterParameterGroupClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClusterParameterGroupsInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClusterParameterGroupsOutput, error) {
+						return nil, &awsredshifttypes.ClusterParameterGroupNotFoundFault{}
+					},
+				},
+				cr: parameterGroup(),
+			},
+			want: want{
+				cr: parameterGroup(),
+			},
+		},
+		"FailedDescribeParameters": {
+			args: args{
+				redshift: &fake.MockClusterParameterGroupClient{
+					MockDescribe: describeGroups,
+					MockDescribeParams: func(ctx context.Context, input *awsredshift.DescribeClusterParametersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClusterParametersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: parameterGroup(),
+			},
+			want: want{
+				cr:  parameterGroup(),
+				err: awsclient.Wrap(errBoom, errDescribeParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.redshift}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modified []string
+		reset    []string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyAndReset": {
+			args: args{
+				cr: parameterGroup(withParameters(v1alpha1.ClusterParameter{ParameterName: "require_ssl", ParameterValue: "true"})),
+			},
+			want: want{
+				modified: []string{"require_ssl"},
+				reset:    []string{"enable_user_activity_logging"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modified, reset []string
+			c := &fake.MockClusterParameterGroupClient{
+				MockDescribeParams: func(ctx context.Context, input *awsredshift.DescribeClusterParametersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClusterParametersOutput, error) {
+					return &awsredshift.DescribeClusterParametersOutput{
+						Parameters: []awsredshifttypes.Parameter{{ParameterName: aws.String("enable_user_activity_logging"), ParameterValue: aws.String("true")}},
+					}, nil
+				},
+				MockModify: func(ctx context.Context, input *awsredshift.ModifyClusterParameterGroupInput, opts []func(*awsredshift.Options)) (*awsredshift.ModifyClusterParameterGroupOutput, error) {
+					for _, p := range input.Parameters {
+						modified = append(modified, aws.ToString(p.ParameterName))
+					}
+					return &awsredshift.ModifyClusterParameterGroupOutput{}, nil
+				},
+				MockReset: func(ctx context.Context, input *awsredshift.ResetClusterParameterGroupInput, opts []func(*awsredshift.Options)) (*awsredshift.ResetClusterParameterGroupOutput, error) {
+					for _, p := range input.Parameters {
+						reset = append(reset, aws.ToString(p.ParameterName))
+					}
+					return &awsredshift.ResetClusterParameterGroupOutput{}, nil
+				},
+			}
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modified, modified); diff != "" {
+				t.Errorf("modified: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
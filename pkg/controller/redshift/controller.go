@@ -45,6 +45,9 @@ const (
 	errMultipleCluster  = "multiple clusters with the same name found"
 	errCreateFailed     = "cannot create Redshift cluster"
 	errModifyFailed     = "cannot modify Redshift cluster"
+	errModifyIAMFailed  = "cannot modify IAM roles of Redshift cluster"
+	errPauseFailed      = "cannot pause Redshift cluster"
+	errResumeFailed     = "cannot resume Redshift cluster"
 	errDeleteFailed     = "cannot delete Redshift cluster"
 	errDescribeFailed   = "cannot describe Redshift cluster"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
@@ -124,6 +127,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.Status.SetConditions(xpv1.Creating())
 	case v1alpha1.StateDeleting:
 		cr.Status.SetConditions(xpv1.Deleting())
+	case v1alpha1.StatePaused:
+		// A cluster that was paused on purpose is where it should be.
+		if aws.ToString(cr.Spec.ForProvider.DesiredState) == v1alpha1.StatePaused {
+			cr.Status.SetConditions(xpv1.Available())
+		} else {
+			cr.Status.SetConditions(xpv1.Unavailable())
+		}
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
@@ -173,10 +183,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 	switch cr.Status.AtProvider.ClusterStatus {
-	case v1alpha1.StateModifying, v1alpha1.StateCreating:
+	case v1alpha1.StateModifying, v1alpha1.StateCreating, v1alpha1.StatePausing, v1alpha1.StateResuming:
 		return managed.ExternalUpdate{}, nil
 	}
 
+	// Pausing and resuming are done through their own API calls. Any other
+	// changes will be picked up in the next reconciliation.
+	id := aws.String(meta.GetExternalName(cr))
+	switch {
+	case aws.ToString(cr.Spec.ForProvider.DesiredState) == v1alpha1.StatePaused && cr.Status.AtProvider.ClusterStatus == v1alpha1.StateAvailable:
+		_, err := e.client.PauseCluster(ctx, &awsredshift.PauseClusterInput{ClusterIdentifier: id})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPauseFailed)
+	case aws.ToString(cr.Spec.ForProvider.DesiredState) == v1alpha1.StateAvailable && cr.Status.AtProvider.ClusterStatus == v1alpha1.StatePaused:
+		_, err := e.client.ResumeCluster(ctx, &awsredshift.ResumeClusterInput{ClusterIdentifier: id})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errResumeFailed)
+	}
+
 	rsp, err := e.client.DescribeClusters(ctx, &awsredshift.DescribeClustersInput{
 		ClusterIdentifier: aws.String(meta.GetExternalName(cr)),
 	})
@@ -184,6 +206,13 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(redshift.IsNotFound, err), errDescribeFailed)
 	}
 
+	// IAM roles are associated through their own API call. Any other changes
+	// will be picked up in the next reconciliation.
+	if input := redshift.GenerateModifyClusterIamRolesInput(&cr.Spec.ForProvider, rsp.Clusters[0]); input != nil {
+		_, err := e.client.ModifyClusterIamRoles(ctx, input)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyIAMFailed)
+	}
+
 	_, err = e.client.ModifyCluster(ctx, redshift.GenerateModifyClusterInput(&cr.Spec.ForProvider, rsp.Clusters[0]))
 
 	if err == nil && aws.ToString(cr.Spec.ForProvider.NewClusterIdentifier) != meta.GetExternalName(cr) {
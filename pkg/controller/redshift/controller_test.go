@@ -71,6 +71,14 @@ func withNewClusterIdentifier(s string) redshiftModifier {
 	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.NewClusterIdentifier = aws.String(s) }
 }
 
+func withIAMRoles(s ...string) redshiftModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.IAMRoles = s }
+}
+
+func withDesiredState(s string) redshiftModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.DesiredState = &s }
+}
+
 func withNewExternalName(s string) redshiftModifier {
 	return func(r *v1alpha1.Cluster) { meta.SetExternalName(r, s) }
 }
@@ -401,6 +409,83 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withNewClusterIdentifier("update"), withNewExternalName("update")),
 			},
 		},
+		"ModifyIAMRoles": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockModifyIamRoles: func(ctx context.Context, input *awsredshift.ModifyClusterIamRolesInput, opts []func(*awsredshift.Options)) (*awsredshift.ModifyClusterIamRolesOutput, error) {
+						return &awsredshift.ModifyClusterIamRolesOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{{}},
+						}, nil
+					},
+				},
+				cr: cluster(withIAMRoles("arn:role")),
+			},
+			want: want{
+				cr: cluster(withIAMRoles("arn:role")),
+			},
+		},
+		"FailedModifyIAMRoles": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockModifyIamRoles: func(ctx context.Context, input *awsredshift.ModifyClusterIamRolesInput, opts []func(*awsredshift.Options)) (*awsredshift.ModifyClusterIamRolesOutput, error) {
+						return nil, errBoom
+					},
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{{}},
+						}, nil
+					},
+				},
+				cr: cluster(withIAMRoles("arn:role")),
+			},
+			want: want{
+				cr:  cluster(withIAMRoles("arn:role")),
+				err: awsclient.Wrap(errBoom, errModifyIAMFailed),
+			},
+		},
+		"Pause": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockPause: func(ctx context.Context, input *awsredshift.PauseClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.PauseClusterOutput, error) {
+						return &awsredshift.PauseClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withDesiredState(v1alpha1.StatePaused), withClusterStatus(v1alpha1.StateAvailable)),
+			},
+			want: want{
+				cr: cluster(withDesiredState(v1alpha1.StatePaused), withClusterStatus(v1alpha1.StateAvailable)),
+			},
+		},
+		"FailedPause": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockPause: func(ctx context.Context, input *awsredshift.PauseClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.PauseClusterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withDesiredState(v1alpha1.StatePaused), withClusterStatus(v1alpha1.StateAvailable)),
+			},
+			want: want{
+				cr:  cluster(withDesiredState(v1alpha1.StatePaused), withClusterStatus(v1alpha1.StateAvailable)),
+				err: awsclient.Wrap(errBoom, errPauseFailed),
+			},
+		},
+		"Resume": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockResume: func(ctx context.Context, input *awsredshift.ResumeClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.ResumeClusterOutput, error) {
+						return &awsredshift.ResumeClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withDesiredState(v1alpha1.StateAvailable), withClusterStatus(v1alpha1.StatePaused)),
+			},
+			want: want{
+				cr: cluster(withDesiredState(v1alpha1.StateAvailable), withClusterStatus(v1alpha1.StatePaused)),
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: cluster(withClusterStatus(v1alpha1.StateModifying)),
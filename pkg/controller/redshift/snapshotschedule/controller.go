@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotschedule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsredshift "github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/redshift"
+)
+
+// Error strings.
+const (
+	errNotSnapshotSchedule = "managed resource is not a Redshift snapshot schedule"
+	errDescribe            = "cannot describe Redshift snapshot schedule"
+	errCreate              = "cannot create Redshift snapshot schedule"
+	errModify              = "cannot modify Redshift snapshot schedule"
+	errDelete              = "cannot delete Redshift snapshot schedule"
+)
+
+// SetupSnapshotSchedule adds a controller that reconciles Redshift snapshot
+// schedules.
+func SetupSnapshotSchedule(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.SnapshotScheduleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.SnapshotSchedule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SnapshotScheduleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: redshift.NewSnapshotScheduleClient}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) redshift.SnapshotScheduleClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return nil, errors.New(errNotSnapshotSchedule)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client redshift.SnapshotScheduleClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshotSchedule)
+	}
+
+	rsp, err := e.client.DescribeSnapshotSchedules(ctx, &awsredshift.DescribeSnapshotSchedulesInput{
+		ScheduleIdentifier: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(redshift.IsSnapshotScheduleNotFound, err), errDescribe)
+	}
+	// Describing a schedule that does not exist returns an empty list rather
+	// than an error.
+	if len(rsp.SnapshotSchedules) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	schedule := rsp.SnapshotSchedules[0]
+
+	cr.Status.AtProvider = redshift.GenerateSnapshotScheduleObservation(schedule)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: redshift.IsSnapshotScheduleUpToDate(cr.Spec.ForProvider, schedule),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSnapshotSchedule)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateSnapshotSchedule(ctx, redshift.GenerateCreateSnapshotScheduleInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSnapshotSchedule)
+	}
+
+	_, err := e.client.ModifySnapshotSchedule(ctx, &awsredshift.ModifySnapshotScheduleInput{
+		ScheduleIdentifier:  aws.String(meta.GetExternalName(cr)),
+		ScheduleDefinitions: cr.Spec.ForProvider.Definitions,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SnapshotSchedule)
+	if !ok {
+		return errors.New(errNotSnapshotSchedule)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteSnapshotSchedule(ctx, &awsredshift.DeleteSnapshotScheduleInput{
+		ScheduleIdentifier: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(redshift.IsSnapshotScheduleNotFound, err), errDelete)
+}
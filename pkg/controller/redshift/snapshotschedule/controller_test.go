@@ -0,0 +1,253 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshotschedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsredshift "github.com/aws/aws-sdk-go-v2/service/redshift"
+	awsredshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/redshift"
+	"github.com/crossplane/provider-aws/pkg/clients/redshift/fake"
+)
+
+var (
+	scheduleName = "some-schedule"
+	definition   = "rate(12 hours)"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	redshift redshift.SnapshotScheduleClient
+	cr       *v1alpha1.SnapshotSchedule
+}
+
+type scheduleModifier func(*v1alpha1.SnapshotSchedule)
+
+func withConditions(c ...xpv1.Condition) scheduleModifier {
+	return func(r *v1alpha1.SnapshotSchedule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDefinitions(d ...string) scheduleModifier {
+	return func(r *v1alpha1.SnapshotSchedule) { r.Spec.ForProvider.Definitions = d }
+}
+
+func withObservation(o v1alpha1.SnapshotScheduleObservation) scheduleModifier {
+	return func(r *v1alpha1.SnapshotSchedule) { r.Status.AtProvider = o }
+}
+
+func schedule(m ...scheduleModifier) *v1alpha1.SnapshotSchedule {
+	cr := &v1alpha1.SnapshotSchedule{}
+	meta.SetExternalName(cr, scheduleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.SnapshotSchedule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeSnapshotSchedulesInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeSnapshotSchedulesOutput, error) {
+						return &awsredshift.DescribeSnapshotSchedulesOutput{
+							SnapshotSchedules: []awsredshifttypes.SnapshotSchedule{{
+								ScheduleIdentifier:     aws.String(scheduleName),
+								ScheduleDefinitions:    []string{definition},
+								AssociatedClusterCount: aws.Int32(1),
+								AssociatedClusters:     []awsredshifttypes.ClusterAssociatedToSchedule{{ClusterIdentifier: aws.String("some-cluster")}},
+							}},
+						}, nil
+					},
+				},
+				cr: schedule(withDefinitions(definition)),
+			},
+			want: want{
+				cr: schedule(withDefinitions(definition),
+					withConditions(xpv1.Available()),
+					withObservation(v1alpha1.SnapshotScheduleObservation{
+						AssociatedClusterCount: 1,
+						AssociatedClusters:     []string{"some-cluster"},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DefinitionsChanged": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeSnapshotSchedulesInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeSnapshotSchedulesOutput, error) {
+						return &awsredshift.DescribeSnapshotSchedulesOutput{
+							SnapshotSchedules: []awsredshifttypes.SnapshotSchedule{{
+								ScheduleIdentifier:  aws.String(scheduleName),
+								ScheduleDefinitions: []string{"rate(1 day)"},
+							}},
+						}, nil
+					},
+				},
+				cr: schedule(withDefinitions(definition)),
+			},
+			want: want{
+				cr: schedule(withDefinitions(definition),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeSnapshotSchedulesInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeSnapshotSchedulesOutput, error) {
+						return &awsredshift.DescribeSnapshotSchedulesOutput{}, nil
+					},
+				},
+				cr: schedule(withDefinitions(definition)),
+			},
+			want: want{
+				cr: schedule(withDefinitions(definition)),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeSnapshotSchedulesInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeSnapshotSchedulesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: schedule(withDefinitions(definition)),
+			},
+			want: want{
+				cr:  schedule(withDefinitions(definition)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.redshift}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.SnapshotSchedule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDelete: func(ctx context.Context, input *awsredshift.DeleteSnapshotScheduleInput, opts []func(*awsredshift.Options)) (*awsredshift.DeleteSnapshotScheduleOutput, error) {
+						return &awsredshift.DeleteSnapshotScheduleOutput{}, nil
+					},
+				},
+				cr: schedule(),
+			},
+			want: want{
+				cr: schedule(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDelete: func(ctx context.Context, input *awsredshift.DeleteSnapshotScheduleInput, opts []func(*awsredshift.Options)) (*awsredshift.DeleteSnapshotScheduleOutput, error) {
+						return nil, &awsredshifttypes.SnapshotScheduleNotFoundFault{}
+					},
+				},
+				cr: schedule(),
+			},
+			want: want{
+				cr: schedule(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				redshift: &fake.MockSnapshotScheduleClient{
+					MockDelete: func(ctx context.Context, input *awsredshift.DeleteSnapshotScheduleInput, opts []func(*awsredshift.Options)) (*awsredshift.DeleteSnapshotScheduleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: schedule(),
+			},
+			want: want{
+				cr:  schedule(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.redshift}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"context"
+	"strings"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rekognition"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rekognition/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupProject adds a controller that reconciles Project.
+func SetupProject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ProjectGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Project{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ProjectGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Project, obj *svcsdk.DescribeProjectsInput) error {
+	obj.ProjectNames = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+// filterList returns the project with the name of the external name. The
+// project description does not contain the name, so it is matched against
+// the ARN, which has the form
+// arn:aws:rekognition:<region>:<account>:project/<name>/<timestamp>.
+func filterList(cr *svcapitypes.Project, list *svcsdk.DescribeProjectsOutput) *svcsdk.DescribeProjectsOutput {
+	infix := ":project/" + meta.GetExternalName(cr) + "/"
+	for _, p := range list.ProjectDescriptions {
+		if strings.Contains(awsclients.StringValue(p.ProjectArn), infix) {
+			return &svcsdk.DescribeProjectsOutput{ProjectDescriptions: []*svcsdk.ProjectDescription{p}}
+		}
+	}
+	return &svcsdk.DescribeProjectsOutput{}
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Project, _ *svcsdk.DescribeProjectsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(cr.Status.AtProvider.Status) {
+	case svcsdk.ProjectStatusCreated:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ProjectStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ProjectStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Project, obj *svcsdk.CreateProjectInput) error {
+	obj.ProjectName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Project, _ *svcsdk.DeleteProjectInput) (bool, error) {
+	return awsclients.StringValue(cr.Status.AtProvider.Status) == svcsdk.ProjectStatusDeleting, nil
+}
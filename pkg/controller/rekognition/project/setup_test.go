@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package project
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rekognition/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestFilterList(t *testing.T) {
+	target := &svcsdk.ProjectDescription{
+		ProjectArn: awsclients.String("arn:aws:rekognition:us-east-1:123456789012:project/example/1638800000000"),
+		Status:     awsclients.String(svcsdk.ProjectStatusCreated),
+	}
+	prefixed := &svcsdk.ProjectDescription{
+		ProjectArn: awsclients.String("arn:aws:rekognition:us-east-1:123456789012:project/example-two/1638800000000"),
+	}
+	cases := map[string]struct {
+		list *svcsdk.DescribeProjectsOutput
+		want *svcsdk.DescribeProjectsOutput
+	}{
+		"Found": {
+			list: &svcsdk.DescribeProjectsOutput{ProjectDescriptions: []*svcsdk.ProjectDescription{prefixed, target}},
+			want: &svcsdk.DescribeProjectsOutput{ProjectDescriptions: []*svcsdk.ProjectDescription{target}},
+		},
+		"NotFound": {
+			list: &svcsdk.DescribeProjectsOutput{ProjectDescriptions: []*svcsdk.ProjectDescription{prefixed}},
+			want: &svcsdk.DescribeProjectsOutput{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Project{}
+			meta.SetExternalName(cr, "example")
+			got := filterList(cr, tc.list)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamprocessor
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rekognition"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rekognition/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupStreamProcessor adds a controller that reconciles StreamProcessor.
+func SetupStreamProcessor(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.StreamProcessorGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.StreamProcessor{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.StreamProcessorGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.StreamProcessor, obj *svcsdk.DescribeStreamProcessorInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.StreamProcessor, resp *svcsdk.DescribeStreamProcessorOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.Status) {
+	case svcsdk.StreamProcessorStatusStopped, svcsdk.StreamProcessorStatusStarting,
+		svcsdk.StreamProcessorStatusRunning, svcsdk.StreamProcessorStatusStopping:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.StreamProcessorStatusFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(resp.StatusMessage)))
+	}
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.StreamProcessor, obj *svcsdk.CreateStreamProcessorInput) error {
+	p := cr.Spec.ForProvider
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	obj.RoleArn = p.RoleARN
+	obj.Input = &svcsdk.StreamProcessorInput{
+		KinesisVideoStream: &svcsdk.KinesisVideoStream{Arn: p.KinesisVideoStreamARN},
+	}
+	obj.Output = &svcsdk.StreamProcessorOutput{
+		KinesisDataStream: &svcsdk.KinesisDataStream{Arn: p.KinesisDataStreamARN},
+	}
+	obj.Settings = &svcsdk.StreamProcessorSettings{
+		FaceSearch: &svcsdk.FaceSearchSettings{
+			CollectionId:       p.FaceSearch.CollectionID,
+			FaceMatchThreshold: p.FaceSearch.FaceMatchThreshold,
+		},
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.StreamProcessor, obj *svcsdk.DeleteStreamProcessorInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
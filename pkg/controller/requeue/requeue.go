@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package requeue observes managed resources with an asynchronous operation
+// in progress again after a time derived from how long the operation is
+// expected to take, rather than after the poll interval.
+package requeue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// MinAfter is the shortest time to wait before observing an operation
+	// again.
+	MinAfter = 15 * time.Second
+
+	// MaxAfter is the longest time to wait before observing an operation
+	// again.
+	MaxAfter = 5 * time.Minute
+)
+
+// An Operation in progress on an external resource.
+type Operation struct {
+	// Name of the operation, usually the status of the external resource
+	// while it is in progress.
+	Name string
+
+	// Expected is how long the operation typically takes in total.
+	Expected time.Duration
+
+	// Progress of the operation in percent, or zero if it is not reported.
+	Progress float64
+}
+
+type started struct {
+	name     string
+	at       time.Time
+	progress float64
+}
+
+// Hints holds how long to wait before observing managed resources again
+// while an operation is in progress on them. A nil Hints records nothing.
+type Hints struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	started map[types.NamespacedName]started
+	after   map[types.NamespacedName]time.Duration
+}
+
+// NewHints returns empty Hints.
+func NewHints() *Hints {
+	return &Hints{
+		now:     time.Now,
+		started: map[types.NamespacedName]started{},
+		after:   map[types.NamespacedName]time.Duration{},
+	}
+}
+
+func key(o resource.Object) types.NamespacedName {
+	return types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}
+}
+
+// InProgress records that op is in progress on the external resource of the
+// supplied managed resource and returns how long to wait before it is
+// observed again.
+func (h *Hints) InProgress(o resource.Object, op Operation) time.Duration {
+	if h == nil {
+		return 0
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	k := key(o)
+	now := h.now()
+	s, ok := h.started[k]
+	if !ok || s.name != op.Name {
+		s = started{name: op.Name, at: now, progress: op.Progress}
+		h.started[k] = s
+	}
+	d := After(op, now.Sub(s.at), s.progress)
+	h.after[k] = d
+	return d
+}
+
+// reset forgets the hint of the last reconcile of the resource.
+func (h *Hints) reset(k types.NamespacedName) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.after, k)
+}
+
+// take returns the hint recorded while the resource was reconciled.
+func (h *Hints) take(k types.NamespacedName) (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	d, ok := h.after[k]
+	delete(h.after, k)
+	return d, ok
+}
+
+// forget the operation that was in progress on the resource.
+func (h *Hints) forget(k types.NamespacedName) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.started, k)
+}
+
+// After returns how long to wait before observing op again after it has
+// been observed in progress for elapsed, starting at progress percent. The
+// remaining time is estimated from the progress made so far if the operation
+// reports one, or else from its expected duration. Half of the remaining time
+// is waited, so the operation is observed more often as it nears its end.
+// Operations that are overdue are observed at an eighth of their expected
+// duration.
+func After(op Operation, elapsed time.Duration, progress float64) time.Duration {
+	var remaining time.Duration
+	switch {
+	case op.Progress > progress && elapsed > 0:
+		remaining = time.Duration(float64(elapsed) * (100 - op.Progress) / (op.Progress - progress))
+	case op.Progress > 0:
+		remaining = time.Duration(float64(op.Expected) * (100 - op.Progress) / 100)
+	default:
+		remaining = op.Expected - elapsed
+	}
+	d := remaining / 2
+	if remaining <= 0 {
+		d = op.Expected / 8
+	}
+	switch {
+	case d < MinAfter:
+		return MinAfter
+	case d > MaxAfter:
+		return MaxAfter
+	}
+	return d
+}
+
+// A Reconciler requeues managed resources that were observed or updated
+// successfully after the hint recorded while they were reconciled, if any.
+// Other results of the wrapped reconciler are returned unchanged.
+type Reconciler struct {
+	reconcile.Reconciler
+	hints *Hints
+}
+
+// NewReconciler returns a Reconciler that wraps r and requeues after the
+// supplied hints.
+func NewReconciler(r reconcile.Reconciler, h *Hints) *Reconciler {
+	return &Reconciler{Reconciler: r, hints: h}
+}
+
+// Reconcile the managed resource and requeue it after its hint.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	r.hints.reset(req.NamespacedName)
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	d, ok := r.hints.take(req.NamespacedName)
+	if err != nil || res.Requeue {
+		return res, err
+	}
+	if ok && res.RequeueAfter != 0 {
+		res.RequeueAfter = d
+		return res, nil
+	}
+	// No operation is in progress on the resource any more, or it is gone.
+	r.hints.forget(req.NamespacedName)
+	return res, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requeue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var errBoom = errors.New("boom")
+
+func TestAfter(t *testing.T) {
+	type args struct {
+		op       Operation
+		elapsed  time.Duration
+		progress float64
+	}
+	cases := map[string]struct {
+		args args
+		want time.Duration
+	}{
+		"Started": {
+			args: args{
+				op: Operation{Expected: 10 * time.Minute},
+			},
+			want: MaxAfter,
+		},
+		"NearlyDone": {
+			args: args{
+				op:      Operation{Expected: 10 * time.Minute},
+				elapsed: 8 * time.Minute,
+			},
+			want: time.Minute,
+		},
+		"Overdue": {
+			args: args{
+				op:      Operation{Expected: 10 * time.Minute},
+				elapsed: 12 * time.Minute,
+			},
+			want: 75 * time.Second,
+		},
+		"AlmostDone": {
+			args: args{
+				op:      Operation{Expected: 3 * time.Minute},
+				elapsed: 170 * time.Second,
+			},
+			want: MinAfter,
+		},
+		"ProgressRate": {
+			args: args{
+				op:       Operation{Expected: 10 * time.Minute, Progress: 60},
+				elapsed:  4 * time.Minute,
+				progress: 20,
+			},
+			want: 2 * time.Minute,
+		},
+		"ProgressWithoutRate": {
+			args: args{
+				op:       Operation{Expected: 10 * time.Minute, Progress: 50},
+				progress: 50,
+			},
+			want: 150 * time.Second,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := After(tc.args.op, tc.args.elapsed, tc.args.progress)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "cool"}}
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+	cases := map[string]struct {
+		reason string
+		r      func(h *Hints) reconcile.Reconciler
+		want   want
+	}{
+		"NoOperation": {
+			reason: "The poll interval should be kept if no operation is in progress.",
+			r: func(_ *Hints) reconcile.Reconciler {
+				return reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return reconcile.Result{RequeueAfter: time.Minute}, nil
+				})
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"OperationInProgress": {
+			reason: "The hint should replace the poll interval while an operation is in progress.",
+			r: func(h *Hints) reconcile.Reconciler {
+				return reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					h.InProgress(mg, Operation{Name: "modifying", Expected: 10 * time.Minute})
+					return reconcile.Result{RequeueAfter: time.Minute}, nil
+				})
+			},
+			want: want{result: reconcile.Result{RequeueAfter: MaxAfter}},
+		},
+		"Requeue": {
+			reason: "Immediate requeues should not be delayed by a hint.",
+			r: func(h *Hints) reconcile.Reconciler {
+				return reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					h.InProgress(mg, Operation{Name: "modifying", Expected: 10 * time.Minute})
+					return reconcile.Result{Requeue: true}, nil
+				})
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"Error": {
+			reason: "Errors should be returned without the hint.",
+			r: func(h *Hints) reconcile.Reconciler {
+				return reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					h.InProgress(mg, Operation{Name: "modifying", Expected: 10 * time.Minute})
+					return reconcile.Result{}, errBoom
+				})
+			},
+			want: want{err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := NewHints()
+			got, err := NewReconciler(tc.r(h), h).Reconcile(context.Background(), req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestHints(t *testing.T) {
+	mg := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "cool"}}
+	k := types.NamespacedName{Name: "cool"}
+	now := time.Now()
+	h := NewHints()
+	h.now = func() time.Time { return now }
+
+	// The operation is first seen now, so all of it remains.
+	if diff := cmp.Diff(MaxAfter, h.InProgress(mg, Operation{Name: "modifying", Expected: 10 * time.Minute})); diff != "" {
+		t.Errorf("InProgress(...): -want, +got:\n%s", diff)
+	}
+	now = now.Add(8 * time.Minute)
+	if diff := cmp.Diff(time.Minute, h.InProgress(mg, Operation{Name: "modifying", Expected: 10 * time.Minute})); diff != "" {
+		t.Errorf("InProgress(...): -want, +got:\n%s", diff)
+	}
+
+	// Another operation starts over.
+	if diff := cmp.Diff(MaxAfter, h.InProgress(mg, Operation{Name: "upgrading", Expected: 30 * time.Minute})); diff != "" {
+		t.Errorf("InProgress(...): -want, +got:\n%s", diff)
+	}
+
+	// A finished operation is forgotten.
+	h.forget(k)
+	if _, ok := h.started[k]; ok {
+		t.Errorf("forget(...): operation was not forgotten")
+	}
+}
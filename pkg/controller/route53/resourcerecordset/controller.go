@@ -57,7 +57,7 @@ func SetupResourceRecordSet(mgr ctrl.Manager, o controller.Options) error {
 		For(&v1alpha1.ResourceRecordSet{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ResourceRecordSetGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: resourcerecordset.NewClient}),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: resourcerecordset.NewClient, batcher: resourcerecordset.NewBatcher(resourcerecordset.DefaultBatchWindow)}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(o.PollInterval),
@@ -68,6 +68,7 @@ func SetupResourceRecordSet(mgr ctrl.Manager, o controller.Options) error {
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) resourcerecordset.Client
+	batcher     *resourcerecordset.Batcher
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -75,12 +76,25 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, batcher: c.batcher}, nil
 }
 
 type external struct {
-	kube   client.Client
-	client resourcerecordset.Client
+	kube    client.Client
+	client  resourcerecordset.Client
+	batcher *resourcerecordset.Batcher
+}
+
+// change submits the action for the record set together with the changes of
+// the other ResourceRecordSets in the same hosted zone that use the same
+// ProviderConfig.
+func (e *external) change(ctx context.Context, cr *v1alpha1.ResourceRecordSet, action route53types.ChangeAction) error {
+	account := ""
+	if ref := cr.GetProviderConfigReference(); ref != nil {
+		account = ref.Name
+	}
+	input := resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, action)
+	return e.batcher.Change(ctx, account, e.client, input)
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -125,8 +139,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	input := resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, route53types.ChangeActionUpsert)
-	_, err := e.client.ChangeResourceRecordSets(ctx, input)
+	err := e.change(ctx, cr, route53types.ChangeActionUpsert)
 
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 }
@@ -136,8 +149,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
-	input := resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, route53types.ChangeActionUpsert)
-	_, err := e.client.ChangeResourceRecordSets(ctx, input)
+	err := e.change(ctx, cr, route53types.ChangeActionUpsert)
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 }
 
@@ -148,9 +160,7 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
-	_, err := e.client.ChangeResourceRecordSets(ctx,
-		resourcerecordset.GenerateChangeResourceRecordSetsInput(meta.GetExternalName(cr), cr.Spec.ForProvider, route53types.ChangeActionDelete),
-	)
+	err := e.change(ctx, cr, route53types.ChangeActionDelete)
 
 	// There is no way to confirm 404 (from response) when deleting a recordset
 	// which isn't present using ChangeResourceRecordSetRequest.
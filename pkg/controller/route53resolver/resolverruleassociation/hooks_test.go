@@ -0,0 +1,297 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resolverruleassociation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	route53resolvertypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/route53resolver/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	resolverruleassociation "github.com/crossplane/provider-aws/pkg/clients/resolverruleassociation"
+	"github.com/crossplane/provider-aws/pkg/clients/resolverruleassociation/fake"
+)
+
+var (
+	errBoom = errors.New("boom")
+	id      = "rslvr-rrassoc-97242eaf88example"
+	ruleID  = "rslvr-rr-42b60677c0example"
+	vpcID   = "vpc-03cf94c75cexample"
+)
+
+type args struct {
+	client resolverruleassociation.Client
+	cr     resource.Managed
+}
+
+type associationModifier func(*manualv1alpha1.ResolverRuleAssociation)
+
+func withExternalName(s string) associationModifier {
+	return func(r *manualv1alpha1.ResolverRuleAssociation) { meta.SetExternalName(r, s) }
+}
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *manualv1alpha1.ResolverRuleAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func association(m ...associationModifier) *manualv1alpha1.ResolverRuleAssociation {
+	cr := &manualv1alpha1.ResolverRuleAssociation{
+		Spec: manualv1alpha1.ResolverRuleAssociationSpec{
+			ForProvider: manualv1alpha1.ResolverRuleAssociationParameters{
+				Region:         "us-east-1",
+				ResolverRuleID: &ruleID,
+				VPCId:          &vpcID,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: func(ctx context.Context, input *route53resolver.GetResolverRuleAssociationInput, opts []func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error) {
+						return &route53resolver.GetResolverRuleAssociationOutput{
+							ResolverRuleAssociation: &route53resolvertypes.ResolverRuleAssociation{
+								Id:     &id,
+								Status: route53resolvertypes.ResolverRuleAssociationStatusComplete,
+							},
+						}, nil
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+			want: want{
+				cr: association(withExternalName(id), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: func(ctx context.Context, input *route53resolver.GetResolverRuleAssociationInput, opts []func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error) {
+						return &route53resolver.GetResolverRuleAssociationOutput{
+							ResolverRuleAssociation: &route53resolvertypes.ResolverRuleAssociation{
+								Id:     &id,
+								Status: route53resolvertypes.ResolverRuleAssociationStatusCreating,
+							},
+						}, nil
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+			want: want{
+				cr: association(withExternalName(id), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{},
+				cr:     association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: func(ctx context.Context, input *route53resolver.GetResolverRuleAssociationInput, opts []func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error) {
+						return nil, &route53resolvertypes.ResourceNotFoundException{}
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+			want: want{
+				cr: association(withExternalName(id)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockGetResolverRuleAssociation: func(ctx context.Context, input *route53resolver.GetResolverRuleAssociationInput, opts []func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+			want: want{
+				cr:  association(withExternalName(id)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockAssociateResolverRule: func(ctx context.Context, input *route53resolver.AssociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.AssociateResolverRuleOutput, error) {
+						return &route53resolver.AssociateResolverRuleOutput{
+							ResolverRuleAssociation: &route53resolvertypes.ResolverRuleAssociation{Id: &id},
+						}, nil
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withExternalName(id)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockAssociateResolverRule: func(ctx context.Context, input *route53resolver.AssociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.AssociateResolverRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRule: func(ctx context.Context, input *route53resolver.DisassociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.DisassociateResolverRuleOutput, error) {
+						return &route53resolver.DisassociateResolverRuleOutput{}, nil
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRule: func(ctx context.Context, input *route53resolver.DisassociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.DisassociateResolverRuleOutput, error) {
+						return nil, &route53resolvertypes.ResourceNotFoundException{}
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				client: &fake.MockResolverRuleAssociationClient{
+					MockDisassociateResolverRule: func(ctx context.Context, input *route53resolver.DisassociateResolverRuleInput, opts []func(*route53resolver.Options)) (*route53resolver.DisassociateResolverRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withExternalName(id)),
+			},
+			want: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
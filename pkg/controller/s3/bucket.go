@@ -60,6 +60,9 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.BucketGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: o.Logger.WithValues("controller", name)}),
+			managed.WithInitializers(
+				managed.NewNameAsExternalName(mgr.GetClient()),
+				&replicator{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -103,6 +106,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = s3.GenerateBucketObservation(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.WebsiteConfiguration != nil {
+		region := cr.Spec.ForProvider.LocationConstraint
+		cr.Status.AtProvider.WebsiteEndpoint = s3.WebsiteEndpoint(meta.GetExternalName(cr), region)
+		cr.Status.AtProvider.WebsiteDomain = s3.WebsiteDomain(region)
+		cr.Status.AtProvider.WebsiteHostedZoneID = s3.WebsiteHostedZoneID(region)
+	}
 
 	lateInit := false
 	current := cr.Spec.ForProvider.DeepCopy()
@@ -128,7 +137,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			return managed.ExternalObservation{}, err
 		}
 		if obs != bucket.Updated {
-			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: lateInit}, nil
+			diff := ""
+			if d, ok := awsClient.(bucket.SubresourceDiffer); ok {
+				if diff, err = d.Diff(ctx, cr); err != nil {
+					return managed.ExternalObservation{}, err
+				}
+			}
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ResourceLateInitialized: lateInit, Diff: diff}, nil
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
@@ -123,13 +124,16 @@ func GeneratePutBucketCorsInput(name string, config *v1beta1.CORSConfiguration)
 			AllowedMethods: cors.AllowedMethods,
 			AllowedOrigins: cors.AllowedOrigins,
 			ExposeHeaders:  cors.ExposeHeaders,
+			ID:             cors.ID,
 			MaxAgeSeconds:  cors.MaxAgeSeconds,
 		})
 	}
 	return bci
 }
 
-// CompareCORS compares the external and internal representations for the list of CORSRules
+// CompareCORS compares the external and internal representations for the list
+// of CORSRules. The order of the rules matters since Amazon S3 applies the
+// first matching rule, while the order of the values within a rule does not.
 func CompareCORS(local []v1beta1.CORSRule, external []types.CORSRule) ResourceStatus { // nolint:gocyclo
 	switch {
 	case len(local) == 0 && len(external) != 0:
@@ -142,10 +146,11 @@ func CompareCORS(local []v1beta1.CORSRule, external []types.CORSRule) ResourceSt
 
 	for i := range local {
 		outputRule := external[i]
-		if !(cmp.Equal(local[i].AllowedHeaders, outputRule.AllowedHeaders) &&
-			cmp.Equal(local[i].AllowedMethods, outputRule.AllowedMethods) &&
-			cmp.Equal(local[i].AllowedOrigins, outputRule.AllowedOrigins) &&
-			cmp.Equal(local[i].ExposeHeaders, outputRule.ExposeHeaders) &&
+		if !(equalValues(local[i].AllowedHeaders, outputRule.AllowedHeaders) &&
+			equalValues(local[i].AllowedMethods, outputRule.AllowedMethods) &&
+			equalValues(local[i].AllowedOrigins, outputRule.AllowedOrigins) &&
+			equalValues(local[i].ExposeHeaders, outputRule.ExposeHeaders) &&
+			awsclient.StringValue(local[i].ID) == awsclient.StringValue(outputRule.ID) &&
 			local[i].MaxAgeSeconds == outputRule.MaxAgeSeconds) {
 			return NeedsUpdate
 		}
@@ -154,6 +159,12 @@ func CompareCORS(local []v1beta1.CORSRule, external []types.CORSRule) ResourceSt
 	return Updated
 }
 
+// equalValues returns true if both lists contain the same values regardless
+// of their order. Nil and empty lists are considered equal.
+func equalValues(a, b []string) bool {
+	return cmp.Equal(a, b, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
+
 // GenerateCORSRule creates the cors rule from a GetBucketCORS request from the S3 Client
 func GenerateCORSRule(config []types.CORSRule) []v1beta1.CORSRule {
 	output := make([]v1beta1.CORSRule, len(config))
@@ -163,6 +174,7 @@ func GenerateCORSRule(config []types.CORSRule) []v1beta1.CORSRule {
 			AllowedMethods: cors.AllowedMethods,
 			AllowedOrigins: cors.AllowedOrigins,
 			ExposeHeaders:  cors.ExposeHeaders,
+			ID:             cors.ID,
 			MaxAgeSeconds:  cors.MaxAgeSeconds,
 		}
 	}
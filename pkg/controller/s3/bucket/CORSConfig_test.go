@@ -396,3 +396,52 @@ func TestCORSLateInit(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareCORS(t *testing.T) {
+	rule := func(id string, methods ...string) v1beta1.CORSRule {
+		return v1beta1.CORSRule{ID: awsclient.String(id), AllowedMethods: methods, AllowedOrigins: []string{"test.origin"}}
+	}
+	awsRule := func(id string, methods ...string) s3types.CORSRule {
+		return s3types.CORSRule{ID: awsclient.String(id), AllowedMethods: methods, AllowedOrigins: []string{"test.origin"}, AllowedHeaders: []string{}}
+	}
+
+	cases := map[string]struct {
+		local    []v1beta1.CORSRule
+		external []s3types.CORSRule
+		want     ResourceStatus
+	}{
+		"Updated": {
+			local:    []v1beta1.CORSRule{rule("a", "GET"), rule("b", "PUT")},
+			external: []s3types.CORSRule{awsRule("a", "GET"), awsRule("b", "PUT")},
+			want:     Updated,
+		},
+		"ValueOrderIgnored": {
+			local:    []v1beta1.CORSRule{rule("a", "GET", "HEAD")},
+			external: []s3types.CORSRule{awsRule("a", "HEAD", "GET")},
+			want:     Updated,
+		},
+		"RuleOrderChanged": {
+			local:    []v1beta1.CORSRule{rule("b", "PUT"), rule("a", "GET")},
+			external: []s3types.CORSRule{awsRule("a", "GET"), awsRule("b", "PUT")},
+			want:     NeedsUpdate,
+		},
+		"IDChanged": {
+			local:    []v1beta1.CORSRule{rule("a", "GET")},
+			external: []s3types.CORSRule{awsRule("b", "GET")},
+			want:     NeedsUpdate,
+		},
+		"NeedsDeletion": {
+			external: []s3types.CORSRule{awsRule("a", "GET")},
+			want:     NeedsDeletion,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := CompareCORS(tc.local, tc.external)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("CompareCORS(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
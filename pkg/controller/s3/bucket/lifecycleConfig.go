@@ -71,11 +71,7 @@ func (in *LifecycleConfigurationClient) Observe(ctx context.Context, bucket *v1b
 	switch {
 	case len(external) != 0 && len(local) == 0:
 		return NeedsDeletion, nil
-	// NOTE(muvaf): We ignore ID because it might have been auto-assigned by AWS
-	// and we don't have late-init for this subresource. Besides, a change in ID
-	// is almost never expected.
-	case cmp.Equal(external, GenerateLifecycleRules(local),
-		cmpopts.IgnoreFields(types.LifecycleRule{}, "ID"), cmpopts.IgnoreTypes(document.NoSerde{})):
+	case IsLifecycleUpToDate(external, GenerateLifecycleRules(local)):
 		return Updated, nil
 	default:
 		return NeedsUpdate, nil
@@ -216,6 +212,59 @@ func GenerateLifecycleRules(in []v1beta1.LifecycleRule) []types.LifecycleRule {
 	return result
 }
 
+// lifecycleRuleCompareOpts are used to compare a single lifecycle rule. The
+// order of transitions is not significant to AWS, so it is ignored.
+var lifecycleRuleCompareOpts = []cmp.Option{
+	cmpopts.IgnoreFields(types.LifecycleRule{}, "ID"),
+	cmpopts.IgnoreTypes(document.NoSerde{}),
+	cmpopts.EquateEmpty(),
+	cmpopts.SortSlices(func(a, b types.Transition) bool {
+		return a.Days < b.Days || a.Days == b.Days && aws.ToTime(a.Date).Before(aws.ToTime(b.Date))
+	}),
+	cmpopts.SortSlices(func(a, b types.NoncurrentVersionTransition) bool {
+		return a.NoncurrentDays < b.NoncurrentDays
+	}),
+}
+
+// IsLifecycleUpToDate returns true if the external lifecycle rules match the
+// desired ones. Rules are compared one by one rather than as a list, so a
+// reordering by AWS does not cause an update. A rule with an ID is only
+// matched against the external rule with the same ID; a rule without one
+// matches any equal external rule since AWS assigns IDs on its own and we
+// don't have late-init for this subresource.
+func IsLifecycleUpToDate(external, local []types.LifecycleRule) bool {
+	if len(external) != len(local) {
+		return false
+	}
+	matched := make([]bool, len(external))
+	// Rules with an ID are matched first so that a rule without one cannot
+	// claim their counterpart.
+	for _, withID := range []bool{true, false} {
+		for _, l := range local {
+			if (l.ID != nil) != withID {
+				continue
+			}
+			if !matchLifecycleRule(l, external, matched) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchLifecycleRule(l types.LifecycleRule, external []types.LifecycleRule, matched []bool) bool {
+	for i, e := range external {
+		if matched[i] || (l.ID != nil && aws.ToString(l.ID) != aws.ToString(e.ID)) {
+			continue
+		}
+		if cmp.Equal(e, l, lifecycleRuleCompareOpts...) {
+			matched[i] = true
+			return true
+		}
+	}
+	return false
+}
+
 func sortFilterTags(rules []types.LifecycleRule) {
 	for i := range rules {
 		andOperator, ok := rules[i].Filter.(*types.LifecycleRuleFilterMemberAnd)
@@ -268,6 +268,66 @@ func TestLifecycleObserve(t *testing.T) {
 	}
 }
 
+func TestIsLifecycleUpToDate(t *testing.T) {
+	rule := func(id *string, days int32, transitions ...s3types.Transition) s3types.LifecycleRule {
+		return s3types.LifecycleRule{
+			ID:          id,
+			Status:      s3types.ExpirationStatusEnabled,
+			Expiration:  &s3types.LifecycleExpiration{Days: days},
+			Filter:      &s3types.LifecycleRuleFilterMemberPrefix{},
+			Transitions: transitions,
+		}
+	}
+	glacier := s3types.Transition{Days: 30, StorageClass: s3types.TransitionStorageClassGlacier}
+	onezone := s3types.Transition{Days: 10, StorageClass: s3types.TransitionStorageClassOnezoneIa}
+
+	cases := map[string]struct {
+		external []s3types.LifecycleRule
+		local    []s3types.LifecycleRule
+		want     bool
+	}{
+		"Reordered": {
+			external: []s3types.LifecycleRule{rule(awsclient.String("b"), 2), rule(awsclient.String("a"), 1)},
+			local:    []s3types.LifecycleRule{rule(awsclient.String("a"), 1), rule(awsclient.String("b"), 2)},
+			want:     true,
+		},
+		"TransitionsReordered": {
+			external: []s3types.LifecycleRule{rule(awsclient.String("a"), 1, glacier, onezone)},
+			local:    []s3types.LifecycleRule{rule(awsclient.String("a"), 1, onezone, glacier)},
+			want:     true,
+		},
+		"AssignedID": {
+			external: []s3types.LifecycleRule{rule(awsclient.String("generated"), 1)},
+			local:    []s3types.LifecycleRule{rule(nil, 1)},
+			want:     true,
+		},
+		"DifferentID": {
+			external: []s3types.LifecycleRule{rule(awsclient.String("a"), 1)},
+			local:    []s3types.LifecycleRule{rule(awsclient.String("b"), 1)},
+			want:     false,
+		},
+		"RuleChanged": {
+			external: []s3types.LifecycleRule{rule(awsclient.String("a"), 1), rule(awsclient.String("b"), 2)},
+			local:    []s3types.LifecycleRule{rule(awsclient.String("a"), 1), rule(awsclient.String("b"), 3)},
+			want:     false,
+		},
+		"RuleAdded": {
+			external: []s3types.LifecycleRule{rule(awsclient.String("a"), 1)},
+			local:    []s3types.LifecycleRule{rule(awsclient.String("a"), 1), rule(nil, 2)},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLifecycleUpToDate(tc.external, tc.local)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLifecycleCreateOrUpdate(t *testing.T) {
 	type args struct {
 		cl *LifecycleConfigurationClient
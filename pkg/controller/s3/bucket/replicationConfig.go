@@ -18,7 +18,9 @@ package bucket
 
 import (
 	"context"
+	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
@@ -84,6 +86,72 @@ func IsUpToDate(external *types.ReplicationConfiguration, source *types.Replicat
 	return NeedsUpdate, nil
 }
 
+// Diff returns a description of how the external replication configuration
+// diverged from the desired one, naming each rule that differs.
+func (in *ReplicationConfigurationClient) Diff(ctx context.Context, bucket *v1beta1.Bucket) (string, error) {
+	config := bucket.Spec.ForProvider.ReplicationConfiguration
+	external, err := in.client.GetBucketReplication(ctx, &awss3.GetBucketReplicationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil && !s3.ReplicationConfigurationNotFound(err) {
+		return "", awsclient.Wrap(err, replicationGetFailed)
+	}
+	var observed *types.ReplicationConfiguration
+	if external != nil {
+		observed = external.ReplicationConfiguration
+	}
+	switch {
+	case observed == nil && config == nil:
+		return "", nil
+	case observed == nil:
+		return "replication configuration is missing", nil
+	case config == nil:
+		return "replication configuration is not desired", nil
+	}
+	return ReplicationDiff(observed, GenerateReplicationConfiguration(config)), nil
+}
+
+// ReplicationDiff returns a description of each replication rule that differs
+// between the external and the desired replication configuration. Rules are
+// matched by their ID. It returns an empty string if there's no difference.
+func ReplicationDiff(external *types.ReplicationConfiguration, source *types.ReplicationConfiguration) string {
+	var diffs []string
+	if awsclient.StringValue(external.Role) != awsclient.StringValue(source.Role) {
+		diffs = append(diffs, fmt.Sprintf("role: %q != %q", awsclient.StringValue(external.Role), awsclient.StringValue(source.Role)))
+	}
+	sortReplicationRules(external.Rules)
+	existing := make(map[string]types.ReplicationRule, len(external.Rules))
+	for i, r := range external.Rules {
+		existing[replicationRuleName(i, r)] = r
+	}
+	desired := make(map[string]bool, len(source.Rules))
+	for i, r := range source.Rules {
+		name := replicationRuleName(i, r)
+		desired[name] = true
+		e, ok := existing[name]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("rule %s: missing", name))
+			continue
+		}
+		if diff := cmp.Diff(r, e, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+			diffs = append(diffs, fmt.Sprintf("rule %s: -desired, +external:\n%s", name, diff))
+		}
+	}
+	for i, r := range external.Rules {
+		if name := replicationRuleName(i, r); !desired[name] {
+			diffs = append(diffs, fmt.Sprintf("rule %s: not desired", name))
+		}
+	}
+	return strings.Join(diffs, "\n")
+}
+
+// replicationRuleName returns the ID of the rule, or its position if it has
+// no ID.
+func replicationRuleName(i int, r types.ReplicationRule) string {
+	if r.ID != nil {
+		return fmt.Sprintf("%q", aws.ToString(r.ID))
+	}
+	return fmt.Sprintf("#%d", i)
+}
+
 // CreateOrUpdate sends a request to have resource created on awsclient.
 func (in *ReplicationConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
 	if bucket.Spec.ForProvider.ReplicationConfiguration == nil {
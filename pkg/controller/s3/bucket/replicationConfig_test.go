@@ -18,6 +18,7 @@ package bucket
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -911,3 +912,67 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestReplicationDiff(t *testing.T) {
+	other := "other-id"
+	rule := func(id, bucket *string) s3types.ReplicationRule {
+		return s3types.ReplicationRule{
+			ID:          id,
+			Destination: &s3types.Destination{Bucket: bucket},
+			Status:      s3types.ReplicationRuleStatusEnabled,
+		}
+	}
+
+	type args struct {
+		external *s3types.ReplicationConfiguration
+		source   *s3types.ReplicationConfiguration
+	}
+	cases := map[string]struct {
+		args
+		// want is the prefix of every line starting a rule diff.
+		want []string
+	}{
+		"NoDiff": {
+			args: args{
+				external: &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&id, &bucketName)}},
+				source:   &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&id, &bucketName)}},
+			},
+		},
+		"RuleDiverged": {
+			args: args{
+				external: &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&id, &bucketName)}},
+				source:   &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&id, awsclient.String("bucket-1"))}},
+			},
+			want: []string{`rule "test-id": -desired, +external:`},
+		},
+		"RuleMissingAndNotDesired": {
+			args: args{
+				external: &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&other, &bucketName)}},
+				source:   &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&id, &bucketName)}},
+			},
+			want: []string{`rule "test-id": missing`, `rule "other-id": not desired`},
+		},
+		"RoleDiverged": {
+			args: args{
+				external: &s3types.ReplicationConfiguration{Role: awsclient.String("old-role"), Rules: []s3types.ReplicationRule{rule(&id, &bucketName)}},
+				source:   &s3types.ReplicationConfiguration{Role: &role, Rules: []s3types.ReplicationRule{rule(&id, &bucketName)}},
+			},
+			want: []string{`role: "old-role" != "replication-role"`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ReplicationDiff(tc.args.external, tc.args.source)
+			var starts []string
+			for _, l := range strings.Split(got, "\n") {
+				if strings.HasPrefix(l, "rule ") || strings.HasPrefix(l, "role: ") {
+					starts = append(starts, l)
+				}
+			}
+			if diff := cmp.Diff(tc.want, starts); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,6 +32,12 @@ type SubresourceClient interface {
 	SubresourceExists(bucket *v1beta1.Bucket) bool
 }
 
+// SubresourceDiffer is implemented by the SubresourceClients that can describe
+// how the external state of their sub-resource diverged from the desired one.
+type SubresourceDiffer interface {
+	Diff(ctx context.Context, bucket *v1beta1.Bucket) (string, error)
+}
+
 // NewSubresourceClients creates the array of all clients for a given BucketProvider
 func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 	return []SubresourceClient{
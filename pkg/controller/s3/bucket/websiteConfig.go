@@ -151,7 +151,7 @@ func GenerateWebsiteConfiguration(config *v1beta1.WebsiteConfiguration) *types.W
 		for i, rule := range config.RoutingRules {
 			rr := types.RoutingRule{
 				Redirect: &types.Redirect{
-					HostName:             rule.Redirect.HostName,
+					HostName:             redirectHostName(rule.Redirect),
 					HttpRedirectCode:     rule.Redirect.HTTPRedirectCode,
 					Protocol:             types.Protocol(rule.Redirect.Protocol),
 					ReplaceKeyPrefixWith: rule.Redirect.ReplaceKeyPrefixWith,
@@ -163,8 +163,8 @@ func GenerateWebsiteConfiguration(config *v1beta1.WebsiteConfiguration) *types.W
 					HttpErrorCodeReturnedEquals: rule.Condition.HTTPErrorCodeReturnedEquals,
 					KeyPrefixEquals:             rule.Condition.KeyPrefixEquals,
 				}
-				wi.RoutingRules[i] = rr
 			}
+			wi.RoutingRules[i] = rr
 		}
 	}
 
@@ -251,3 +251,12 @@ func createWebsiteConfigFromExternal(external *awss3.GetBucketWebsiteOutput, con
 		}
 	}
 }
+
+// redirectHostName returns the host name of the redirect, falling back to
+// the deprecated field for objects that were created with the old key.
+func redirectHostName(r v1beta1.Redirect) *string {
+	if r.HostName != nil {
+		return r.HostName
+	}
+	return r.DeprecatedHostName
+}
@@ -23,8 +23,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/document"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
@@ -463,3 +465,48 @@ func TestWebsiteLateInit(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateWebsiteConfiguration(t *testing.T) {
+	cases := map[string]struct {
+		config *v1beta1.WebsiteConfiguration
+		want   *s3types.WebsiteConfiguration
+	}{
+		"Full": {
+			config: generateWebsiteConfig(),
+			want:   generateAWSWebsite(),
+		},
+		"RoutingRuleWithoutCondition": {
+			config: &v1beta1.WebsiteConfiguration{
+				RoutingRules: []v1beta1.RoutingRule{
+					{Redirect: v1beta1.Redirect{ReplaceKeyWith: &replaceKey}},
+				},
+			},
+			want: &s3types.WebsiteConfiguration{
+				RoutingRules: []s3types.RoutingRule{
+					{Redirect: &s3types.Redirect{ReplaceKeyWith: &replaceKey}},
+				},
+			},
+		},
+		"DeprecatedRedirectHostName": {
+			config: &v1beta1.WebsiteConfiguration{
+				RoutingRules: []v1beta1.RoutingRule{
+					{Redirect: v1beta1.Redirect{DeprecatedHostName: awsclient.String("example.com")}},
+				},
+			},
+			want: &s3types.WebsiteConfiguration{
+				RoutingRules: []s3types.RoutingRule{
+					{Redirect: &s3types.Redirect{HostName: awsclient.String("example.com")}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateWebsiteConfiguration(tc.config)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
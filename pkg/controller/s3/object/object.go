@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Object resource"
+	errHead             = "failed to get Object"
+	errPut              = "failed to put Object"
+	errDelete           = "failed to delete Object"
+	errGetConfigMap     = "cannot get content ConfigMap"
+	errGetSecret        = "cannot get content Secret"
+	errKeyNotFound      = "content key not found"
+	errNoContent        = "either content, contentConfigMapRef or contentSecretRef must be specified"
+)
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha3.ObjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha3.Object{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.ObjectGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewObjectClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.ObjectClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.IsErrorObjectNotFound, err), errHead)
+	}
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.IsObjectUpToDate(cr.Spec.ForProvider, content, cr.Status.AtProvider, resp),
+	}, nil
+}
+
+// getContent returns the content of the object given either inline, through
+// a ConfigMap or through a Secret.
+func (e *external) getContent(ctx context.Context, cr *v1alpha3.Object) (string, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case p.Content != nil:
+		return *p.Content, nil
+	case p.ContentConfigMapRef != nil:
+		cm := &corev1.ConfigMap{}
+		ref := p.ContentConfigMapRef
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		if content, ok := cm.Data[ref.Key]; ok {
+			return content, nil
+		}
+		if content, ok := cm.BinaryData[ref.Key]; ok {
+			return string(content), nil
+		}
+		return "", errors.New(errKeyNotFound)
+	case p.ContentSecretRef != nil:
+		s := &corev1.Secret{}
+		ref := p.ContentSecretRef
+		if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return "", errors.Wrap(err, errGetSecret)
+		}
+		content, ok := s.Data[ref.Key]
+		if !ok {
+			return "", errors.New(errKeyNotFound)
+		}
+		return string(content), nil
+	}
+	return "", errors.New(errNoContent)
+}
+
+// put writes the object and records the ETag and content digest S3 reported
+// for it.
+func (e *external) put(ctx context.Context, cr *v1alpha3.Object) error {
+	content, err := e.getContent(ctx, cr)
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(cr.Spec.ForProvider, content))
+	if err != nil {
+		return err
+	}
+	cr.Status.AtProvider = v1alpha3.ObjectObservation{
+		ETag:       resp.ETag,
+		ContentMD5: awsclient.String(s3.ContentMD5(content)),
+		VersionID:  resp.VersionId,
+	}
+	return nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, awsclient.Wrap(e.put(ctx, cr), errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, awsclient.Wrap(e.put(ctx, cr), errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if s3.IsErrorBucketNotFound(err) {
+		return nil
+	}
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorObjectNotFound, err), errDelete)
+}
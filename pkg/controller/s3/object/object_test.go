@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	bucketName = "test.s3.crossplane.com"
+	content    = "<html>error</html>"
+	// otherETag is the ETag of content other than the desired one, or of
+	// a KMS encrypted object.
+	otherETag = `"b0b8e4a5b5b2b4e8a4f8f1e9b7c9d8a1"`
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.ObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha3.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContent(c string) objectModifier {
+	return func(r *v1alpha3.Object) { r.Spec.ForProvider.Content = &c }
+}
+
+func withConfigMapRef(ref *v1alpha3.ConfigMapKeySelector) objectModifier {
+	return func(r *v1alpha3.Object) { r.Spec.ForProvider.ContentConfigMapRef = ref }
+}
+
+func withObservation(o v1alpha3.ObjectObservation) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.AtProvider = o }
+}
+
+func object(m ...objectModifier) *v1alpha3.Object {
+	cr := &v1alpha3.Object{
+		Spec: v1alpha3.ObjectSpec{
+			ForProvider: v1alpha3.ObjectParameters{
+				BucketName: &bucketName,
+				Key:        "error.html",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	etag := `"` + s3.ContentMD5(content) + `"`
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{ETag: &etag}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChangedOutsideOfCrossplane": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{ETag: &otherETag}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RecordedETagOfEncryptedObject": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{ETag: &otherETag}, nil
+					},
+				},
+				cr: object(withContent(content), withObservation(v1alpha3.ObjectObservation{
+					ETag:       &otherETag,
+					ContentMD5: awsclient.String(s3.ContentMD5(content)),
+				})),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Available()), withObservation(v1alpha3.ObjectObservation{
+					ETag:       &otherETag,
+					ContentMD5: awsclient.String(s3.ContentMD5(content)),
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentFromConfigMap": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{ETag: &etag}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"error.html": content}
+						return nil
+					},
+				},
+				cr: object(withConfigMapRef(&v1alpha3.ConfigMapKeySelector{Name: "pages", Namespace: "default", Key: "error.html"})),
+			},
+			want: want{
+				cr: object(
+					withConfigMapRef(&v1alpha3.ConfigMapKeySelector{Name: "pages", Namespace: "default", Key: "error.html"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoContent": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return &awss3.HeadObjectOutput{ETag: &etag}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: errors.New(errNoContent),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	etag := `"` + s3.ContentMD5(content) + `"`
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RecordsETag": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return &awss3.PutObjectOutput{ETag: &etag}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Creating()), withObservation(v1alpha3.ObjectObservation{
+					ETag:       &etag,
+					ContentMD5: awsclient.String(s3.ContentMD5(content)),
+				})),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
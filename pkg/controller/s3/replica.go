@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+const (
+	errGetReplica    = "cannot get paired Bucket"
+	errCreateReplica = "cannot create paired Bucket"
+
+	statusEnabled = "Enabled"
+)
+
+// A replicator creates the paired Bucket of a Bucket that replicates to
+// another region and defaults the configuration replication requires.
+type replicator struct {
+	kube client.Client
+}
+
+func (r *replicator) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.Bucket)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.ReplicateTo == nil {
+		return nil
+	}
+
+	replica := GenerateReplica(cr)
+	err := r.kube.Get(ctx, types.NamespacedName{Name: replica.GetName()}, &v1beta1.Bucket{})
+	if resource.IgnoreNotFound(err) != nil {
+		return errors.Wrap(err, errGetReplica)
+	}
+	if kerrors.IsNotFound(err) {
+		if err := r.kube.Create(ctx, replica); err != nil {
+			return errors.Wrap(err, errCreateReplica)
+		}
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	p := &cr.Spec.ForProvider
+	if p.VersioningConfiguration == nil {
+		p.VersioningConfiguration = &v1beta1.VersioningConfiguration{Status: aws.String(statusEnabled)}
+	}
+	if p.ReplicationConfiguration == nil {
+		p.ReplicationConfiguration = GenerateReplicationConfiguration(replica.GetName(), *p.ReplicateTo)
+	}
+	if cmp.Equal(current, p) {
+		return nil
+	}
+	return errors.Wrap(r.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
+// GenerateReplica returns the paired Bucket of the supplied Bucket. It is
+// named after the Bucket and the region it is in, and is controlled by the
+// Bucket so that it is deleted along with it.
+func GenerateReplica(cr *v1beta1.Bucket) *v1beta1.Bucket {
+	region := cr.Spec.ForProvider.ReplicateTo.Region
+	replica := &v1beta1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            cr.GetName() + "-" + region,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(cr, v1beta1.BucketGroupVersionKind))},
+		},
+		Spec: v1beta1.BucketSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				DeletionPolicy: cr.GetDeletionPolicy(),
+			},
+			ForProvider: v1beta1.BucketParameters{
+				ACL:                     aws.String("private"),
+				LocationConstraint:      region,
+				VersioningConfiguration: &v1beta1.VersioningConfiguration{Status: aws.String(statusEnabled)},
+			},
+		},
+	}
+	if ref := cr.GetProviderConfigReference(); ref != nil {
+		replica.SetProviderConfigReference(&xpv1.Reference{Name: ref.Name})
+	}
+	meta.SetExternalName(replica, meta.GetExternalName(cr)+"-"+region)
+	return replica
+}
+
+// GenerateReplicationConfiguration returns a replication configuration that
+// replicates all objects and delete markers to the supplied paired Bucket.
+func GenerateReplicationConfiguration(replica string, rt v1beta1.ReplicateTo) *v1beta1.ReplicationConfiguration {
+	return &v1beta1.ReplicationConfiguration{
+		Role:         rt.Role,
+		RoleRef:      rt.RoleRef,
+		RoleSelector: rt.RoleSelector,
+		Rules: []v1beta1.ReplicationRule{{
+			ID:                      aws.String("replicate-to-" + rt.Region),
+			Status:                  statusEnabled,
+			Filter:                  &v1beta1.ReplicationRuleFilter{Prefix: aws.String("")},
+			DeleteMarkerReplication: &v1beta1.DeleteMarkerReplication{Status: statusEnabled},
+			Destination: v1beta1.Destination{
+				BucketRef:    &xpv1.Reference{Name: replica},
+				StorageClass: rt.StorageClass,
+			},
+		}},
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+func TestReplicatorInitialize(t *testing.T) {
+	role := "arn:aws:iam::123456789012:role/replication"
+
+	source := func(m ...func(*v1beta1.Bucket)) *v1beta1.Bucket {
+		cr := &v1beta1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{Name: "data"},
+			Spec: v1beta1.BucketSpec{
+				ResourceSpec: xpv1.ResourceSpec{
+					ProviderConfigReference: &xpv1.Reference{Name: "example"},
+					DeletionPolicy:          xpv1.DeletionDelete,
+				},
+				ForProvider: v1beta1.BucketParameters{
+					LocationConstraint: "us-east-1",
+					ReplicateTo:        &v1beta1.ReplicateTo{Region: "us-west-2", Role: &role},
+				},
+			},
+		}
+		meta.SetExternalName(cr, "data")
+		for _, f := range m {
+			f(cr)
+		}
+		return cr
+	}
+	defaulted := func(cr *v1beta1.Bucket) {
+		cr.Spec.ForProvider.VersioningConfiguration = &v1beta1.VersioningConfiguration{Status: aws.String("Enabled")}
+		cr.Spec.ForProvider.ReplicationConfiguration = &v1beta1.ReplicationConfiguration{
+			Role: &role,
+			Rules: []v1beta1.ReplicationRule{{
+				ID:                      aws.String("replicate-to-us-west-2"),
+				Status:                  "Enabled",
+				Filter:                  &v1beta1.ReplicationRuleFilter{Prefix: aws.String("")},
+				DeleteMarkerReplication: &v1beta1.DeleteMarkerReplication{Status: "Enabled"},
+				Destination: v1beta1.Destination{
+					BucketRef: &xpv1.Reference{Name: "data-us-west-2"},
+				},
+			}},
+		}
+	}
+
+	replica := &v1beta1.Bucket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "data-us-west-2",
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: "data-us-west-2",
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1beta1.SchemeGroupVersion.String(),
+				Kind:       v1beta1.BucketKind,
+				Name:       "data",
+				Controller: aws.Bool(true),
+			}},
+		},
+		Spec: v1beta1.BucketSpec{
+			ResourceSpec: xpv1.ResourceSpec{
+				ProviderConfigReference: &xpv1.Reference{Name: "example"},
+				DeletionPolicy:          xpv1.DeletionDelete,
+			},
+			ForProvider: v1beta1.BucketParameters{
+				ACL:                     aws.String("private"),
+				LocationConstraint:      "us-west-2",
+				VersioningConfiguration: &v1beta1.VersioningConfiguration{Status: aws.String("Enabled")},
+			},
+		},
+	}
+
+	type want struct {
+		cr      *v1beta1.Bucket
+		created *v1beta1.Bucket
+		err     error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		cr   *v1beta1.Bucket
+		want want
+	}{
+		"NoReplicateTo": {
+			cr: source(func(cr *v1beta1.Bucket) { cr.Spec.ForProvider.ReplicateTo = nil }),
+			want: want{
+				cr: source(func(cr *v1beta1.Bucket) { cr.Spec.ForProvider.ReplicateTo = nil }),
+			},
+		},
+		"CreateReplica": {
+			kube: &test.MockClient{
+				MockGet:    test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "data-us-west-2")),
+				MockCreate: test.NewMockCreateFn(nil),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			cr: source(),
+			want: want{
+				cr:      source(defaulted),
+				created: replica,
+			},
+		},
+		"ReplicaExists": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			cr: source(defaulted),
+			want: want{
+				cr: source(defaulted),
+			},
+		},
+		"GetError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: source(),
+			want: want{
+				cr:  source(),
+				err: errors.Wrap(errBoom, errGetReplica),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created *v1beta1.Bucket
+			if mc, ok := tc.kube.(*test.MockClient); ok && mc.MockCreate != nil {
+				mc.MockCreate = func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					created = obj.(*v1beta1.Bucket)
+					return nil
+				}
+			}
+			r := &replicator{kube: tc.kube}
+			err := r.Initialize(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebookinstance
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/sagemaker"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/sagemaker/sagemakeriface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errStop = "cannot stop NotebookInstance"
+)
+
+// SetupNotebookInstance adds a controller that reconciles NotebookInstance.
+func SetupNotebookInstance(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.NotebookInstanceGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = h.preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.NotebookInstance{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.NotebookInstanceGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.SageMakerAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.NotebookInstance, obj *svcsdk.DescribeNotebookInstanceInput) error {
+	obj.NotebookInstanceName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.NotebookInstance, resp *svcsdk.DescribeNotebookInstanceOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.NotebookInstanceStatus) {
+	case svcsdk.NotebookInstanceStatusInService, svcsdk.NotebookInstanceStatusStopped:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.NotebookInstanceStatusPending, svcsdk.NotebookInstanceStatusUpdating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.NotebookInstanceStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.NotebookInstanceStatusStopping, svcsdk.NotebookInstanceStatusFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+// isUpToDate reports whether the notebook instance matches the spec. SageMaker
+// only accepts updates of stopped notebook instances, so changes are not
+// reported before the notebook instance is stopped. A running notebook is
+// never stopped by the controller to apply an update.
+func isUpToDate(cr *svcapitypes.NotebookInstance, resp *svcsdk.DescribeNotebookInstanceOutput) (bool, error) {
+	if s := awsclients.StringValue(resp.NotebookInstanceStatus); s != svcsdk.NotebookInstanceStatusStopped && s != svcsdk.NotebookInstanceStatusFailed {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	if p.RoleARN != nil && awsclients.StringValue(p.RoleARN) != awsclients.StringValue(resp.RoleArn) {
+		return false, nil
+	}
+	if p.LifecycleConfigName != nil && awsclients.StringValue(p.LifecycleConfigName) != awsclients.StringValue(resp.NotebookInstanceLifecycleConfigName) {
+		return false, nil
+	}
+	observed := GenerateNotebookInstance(resp).Spec.ForProvider
+	observed.Region = p.Region
+	observed.Tags = p.Tags
+	observed.CustomNotebookInstanceParameters = p.CustomNotebookInstanceParameters
+	return awsclients.IsJSONSubset(p, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.NotebookInstance, obj *svcsdk.CreateNotebookInstanceInput) error {
+	obj.NotebookInstanceName = awsclients.String(meta.GetExternalName(cr))
+	obj.KmsKeyId = cr.Spec.ForProvider.KMSKeyID
+	obj.LifecycleConfigName = cr.Spec.ForProvider.LifecycleConfigName
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	obj.SecurityGroupIds = cr.Spec.ForProvider.SecurityGroupIDs
+	obj.SubnetId = cr.Spec.ForProvider.SubnetID
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.NotebookInstance, obj *svcsdk.UpdateNotebookInstanceInput) error {
+	obj.NotebookInstanceName = awsclients.String(meta.GetExternalName(cr))
+	obj.LifecycleConfigName = cr.Spec.ForProvider.LifecycleConfigName
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
+// preDelete stops the notebook instance first since only stopped notebook
+// instances can be deleted.
+func (e *hooks) preDelete(ctx context.Context, cr *svcapitypes.NotebookInstance, obj *svcsdk.DeleteNotebookInstanceInput) (bool, error) {
+	obj.NotebookInstanceName = awsclients.String(meta.GetExternalName(cr))
+	switch awsclients.StringValue(cr.Status.AtProvider.NotebookInstanceStatus) {
+	case svcsdk.NotebookInstanceStatusPending, svcsdk.NotebookInstanceStatusUpdating,
+		svcsdk.NotebookInstanceStatusStopping, svcsdk.NotebookInstanceStatusDeleting:
+		return true, nil
+	case svcsdk.NotebookInstanceStatusInService:
+		_, err := e.client.StopNotebookInstanceWithContext(ctx, &svcsdk.StopNotebookInstanceInput{NotebookInstanceName: obj.NotebookInstanceName})
+		return true, awsclients.Wrap(err, errStop)
+	}
+	return false, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebookinstance
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const roleARN = "arn:aws:iam::123456789012:role/AmazonBraketServiceSageMakerNotebookRole-example"
+
+func TestIsUpToDate(t *testing.T) {
+	observed := func(status string) *svcsdk.DescribeNotebookInstanceOutput {
+		return &svcsdk.DescribeNotebookInstanceOutput{
+			InstanceType:                        awsclients.String("ml.t3.medium"),
+			NotebookInstanceLifecycleConfigName: awsclients.String("amazon-braket-example-setup"),
+			NotebookInstanceStatus:              awsclients.String(status),
+			RoleArn:                             awsclients.String(roleARN),
+			VolumeSizeInGB:                      awsclients.Int64(10),
+		}
+	}
+	cases := map[string]struct {
+		spec   svcapitypes.NotebookInstanceParameters
+		status string
+		want   bool
+	}{
+		"UpToDate": {
+			spec: svcapitypes.NotebookInstanceParameters{
+				Region:         "us-east-1",
+				InstanceType:   awsclients.String("ml.t3.medium"),
+				VolumeSizeInGB: awsclients.Int64(10),
+				CustomNotebookInstanceParameters: svcapitypes.CustomNotebookInstanceParameters{
+					LifecycleConfigName: awsclients.String("amazon-braket-example-setup"),
+					RoleARN:             awsclients.String(roleARN),
+				},
+			},
+			status: svcsdk.NotebookInstanceStatusStopped,
+			want:   true,
+		},
+		"InstanceTypeChanged": {
+			spec: svcapitypes.NotebookInstanceParameters{
+				Region:       "us-east-1",
+				InstanceType: awsclients.String("ml.t3.large"),
+			},
+			status: svcsdk.NotebookInstanceStatusStopped,
+			want:   false,
+		},
+		"RoleChanged": {
+			spec: svcapitypes.NotebookInstanceParameters{
+				Region:       "us-east-1",
+				InstanceType: awsclients.String("ml.t3.medium"),
+				CustomNotebookInstanceParameters: svcapitypes.CustomNotebookInstanceParameters{
+					RoleARN: awsclients.String(roleARN + "-2"),
+				},
+			},
+			status: svcsdk.NotebookInstanceStatusStopped,
+			want:   false,
+		},
+		"LifecycleConfigChanged": {
+			spec: svcapitypes.NotebookInstanceParameters{
+				Region:       "us-east-1",
+				InstanceType: awsclients.String("ml.t3.medium"),
+				CustomNotebookInstanceParameters: svcapitypes.CustomNotebookInstanceParameters{
+					LifecycleConfigName: awsclients.String("other-setup"),
+				},
+			},
+			status: svcsdk.NotebookInstanceStatusFailed,
+			want:   false,
+		},
+		"ChangedWhileInService": {
+			spec: svcapitypes.NotebookInstanceParameters{
+				Region:       "us-east-1",
+				InstanceType: awsclients.String("ml.t3.large"),
+			},
+			status: svcsdk.NotebookInstanceStatusInService,
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.NotebookInstance{Spec: svcapitypes.NotebookInstanceSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, observed(tc.status))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notebookinstancelifecycleconfig
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/sagemaker"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/sagemaker/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupNotebookInstanceLifecycleConfig adds a controller that reconciles
+// NotebookInstanceLifecycleConfig.
+func SetupNotebookInstanceLifecycleConfig(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.NotebookInstanceLifecycleConfigGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.NotebookInstanceLifecycleConfig{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.NotebookInstanceLifecycleConfigGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.NotebookInstanceLifecycleConfig, obj *svcsdk.DescribeNotebookInstanceLifecycleConfigInput) error {
+	obj.NotebookInstanceLifecycleConfigName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.NotebookInstanceLifecycleConfig, _ *svcsdk.DescribeNotebookInstanceLifecycleConfigOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.NotebookInstanceLifecycleConfig, resp *svcsdk.DescribeNotebookInstanceLifecycleConfigOutput) (bool, error) {
+	observed := GenerateNotebookInstanceLifecycleConfig(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.NotebookInstanceLifecycleConfig, obj *svcsdk.CreateNotebookInstanceLifecycleConfigInput) error {
+	obj.NotebookInstanceLifecycleConfigName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.NotebookInstanceLifecycleConfig, obj *svcsdk.UpdateNotebookInstanceLifecycleConfigInput) error {
+	obj.NotebookInstanceLifecycleConfigName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.NotebookInstanceLifecycleConfig, obj *svcsdk.DeleteNotebookInstanceLifecycleConfigInput) (bool, error) {
+	obj.NotebookInstanceLifecycleConfigName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
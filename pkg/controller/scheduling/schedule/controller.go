@@ -0,0 +1,350 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sjson "k8s.io/apimachinery/pkg/util/json"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	ec2manualv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	eksmanualv1alpha1 "github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/scheduling/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/scheduling"
+)
+
+const (
+	// AnnotationKeyResumeState is set on the targets a Schedule turned off.
+	// It holds the name of that Schedule and the values of the fields it
+	// changed, so that they can be restored after the window.
+	AnnotationKeyResumeState = "scheduling.aws.crossplane.io/resume-state"
+
+	errUnexpectedObject = "managed resource is not a Schedule"
+	errWindow           = "cannot compute the windows of the Schedule"
+	errFmtUnsupported   = "targets of kind %s are not supported"
+	errFmtGetTarget     = "cannot get target %s %s"
+	errFmtPatchTarget   = "cannot patch target %s %s"
+	errResumeState      = "cannot decode the resume state annotation"
+	errFmtOverlap       = "target %s %s is turned off by Schedule %s"
+)
+
+// A resumeState is the value of the resume state annotation.
+type resumeState struct {
+	// Schedule is the name of the Schedule that turned the target off. Only
+	// this Schedule turns it back on.
+	Schedule string `json:"schedule"`
+
+	// Fields are the values the fields of the target had before it was
+	// turned off, keyed by their dot separated paths.
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// A toggle is a field of the spec of a managed resource that is set to off
+// during a window. After the window the field gets back the value it had
+// before, or on if it had none.
+type toggle struct {
+	path []string
+	off  interface{}
+	on   interface{}
+}
+
+// toggles are the fields that turn off the supported kinds of targets.
+var toggles = map[string][]toggle{
+	ec2manualv1alpha1.InstanceGroupKind: {
+		{path: []string{"spec", "forProvider", "desiredState"}, off: ec2manualv1alpha1.InstanceDesiredStateStopped, on: ec2manualv1alpha1.InstanceDesiredStateRunning},
+	},
+	eksmanualv1alpha1.NodeGroupGroupKind: {
+		{path: []string{"spec", "forProvider", "scalingConfig", "minSize"}, off: int64(0)},
+		{path: []string{"spec", "forProvider", "scalingConfig", "desiredSize"}, off: int64(0)},
+	},
+	rdsv1alpha1.DBInstanceGroupKind: {
+		{path: []string{"spec", "forProvider", "desiredState"}, off: rdsv1alpha1.DBInstanceStateStopped, on: rdsv1alpha1.DBInstanceStateAvailable},
+	},
+	redshiftv1alpha1.ClusterGroupKind: {
+		{path: []string{"spec", "forProvider", "desiredState"}, off: redshiftv1alpha1.StatePaused, on: redshiftv1alpha1.StateAvailable},
+	},
+}
+
+// SetupSchedule adds a controller that reconciles Schedules. Windows start and
+// end at the next poll, so the poll interval bounds how late they do.
+func SetupSchedule(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ScheduleGroupKind)
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Schedule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ScheduleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube client.Client
+}
+
+// Connect does not need any AWS credentials, a Schedule only changes other
+// managed resources.
+func (c *connector) Connect(_ context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.Schedule); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	return &external{kube: c.kube, now: time.Now}, nil
+}
+
+type external struct {
+	kube client.Client
+	now  func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	active, err := e.observe(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	// A Schedule that is deleted exists until all of its targets are back
+	// on.
+	upToDate := true
+	for _, t := range cr.Spec.ForProvider.Targets {
+		u, tg, err := e.getTarget(ctx, t)
+		if err != nil {
+			if meta.WasDeleted(cr) && resource.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return managed.ExternalObservation{}, err
+		}
+		rs, err := getResumeState(u)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		owned := rs != nil && rs.Schedule == cr.GetName()
+		if (active && !(owned && isOff(u, tg))) || (!active && owned) {
+			upToDate = false
+		}
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: !upToDate}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// observe updates the observed state of the Schedule and returns whether its
+// targets should be turned off now.
+func (e *external) observe(cr *v1alpha1.Schedule) (bool, error) {
+	p := cr.Spec.ForProvider
+	w, err := scheduling.NewWindow(p.Start, p.Duration.Duration, awsclient.StringValue(p.TimeZone))
+	if err != nil {
+		return false, errors.Wrap(err, errWindow)
+	}
+	start, active := w.Active(e.now())
+	cr.Status.AtProvider = v1alpha1.ScheduleObservation{Active: active}
+	if active {
+		cr.Status.AtProvider.WindowStart = &metav1.Time{Time: start}
+		cr.Status.AtProvider.WindowEnd = &metav1.Time{Time: w.End(start)}
+	}
+	return active && !meta.WasDeleted(cr), nil
+}
+
+// Create is never called, a Schedule always exists until it is deleted.
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	active, err := e.observe(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.switchTargets(ctx, cr, active)
+}
+
+// Delete turns all targets back on.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Schedule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	return e.switchTargets(ctx, cr, false)
+}
+
+// switchTargets turns the targets of the Schedule off or back on. A target
+// that was turned off by another Schedule is left alone. Turning it off is
+// reported as an error, since Schedules with overlapping targets would
+// restore each other's targets.
+func (e *external) switchTargets(ctx context.Context, cr *v1alpha1.Schedule, off bool) error {
+	var overlap error
+	for _, t := range cr.Spec.ForProvider.Targets {
+		u, tg, err := e.getTarget(ctx, t)
+		if err != nil {
+			if !off && resource.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return err
+		}
+		rs, err := getResumeState(u)
+		if err != nil {
+			return err
+		}
+		if rs != nil && rs.Schedule != cr.GetName() {
+			if off && overlap == nil {
+				overlap = errors.Errorf(errFmtOverlap, t.Kind, t.Name, rs.Schedule)
+			}
+			continue
+		}
+		orig := u.DeepCopy()
+		switch {
+		case off && !(rs != nil && isOff(u, tg)):
+			err = turnOff(u, tg, rs, cr.GetName())
+		case !off && rs != nil:
+			err = turnOn(u, tg, rs)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := e.kube.Patch(ctx, u, client.MergeFrom(orig)); err != nil {
+			return errors.Wrapf(err, errFmtPatchTarget, t.Kind, t.Name)
+		}
+	}
+	return overlap
+}
+
+func (e *external) getTarget(ctx context.Context, t v1alpha1.ScheduleTarget) (*unstructured.Unstructured, []toggle, error) {
+	gvk := schema.FromAPIVersionAndKind(t.APIVersion, t.Kind)
+	tg, ok := toggles[gvk.GroupKind().String()]
+	if !ok {
+		return nil, nil, errors.Errorf(errFmtUnsupported, gvk.GroupKind())
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: t.Name}, u); err != nil {
+		return nil, nil, errors.Wrapf(err, errFmtGetTarget, t.Kind, t.Name)
+	}
+	return u, tg, nil
+}
+
+// getResumeState returns the decoded resume state annotation of the target,
+// or nil if it was not turned off.
+func getResumeState(u *unstructured.Unstructured) (*resumeState, error) {
+	v, ok := u.GetAnnotations()[AnnotationKeyResumeState]
+	if !ok {
+		return nil, nil
+	}
+	rs := &resumeState{}
+	// Unlike encoding/json, this keeps integers as int64.
+	if err := k8sjson.Unmarshal([]byte(v), rs); err != nil {
+		return nil, errors.Wrap(err, errResumeState)
+	}
+	return rs, nil
+}
+
+// isOff returns true if the fields of the target hold their off values.
+func isOff(u *unstructured.Unstructured, tg []toggle) bool {
+	for _, t := range tg {
+		v, ok, err := unstructured.NestedFieldNoCopy(u.Object, t.path...)
+		if err != nil || !ok || v != t.off {
+			return false
+		}
+	}
+	return true
+}
+
+// turnOff sets the fields of the target to their off values. The values they
+// had before are kept in an annotation together with the name of the
+// Schedule, unless the target already has one.
+func turnOff(u *unstructured.Unstructured, tg []toggle, rs *resumeState, schedule string) error {
+	if rs == nil {
+		rs = &resumeState{Schedule: schedule, Fields: map[string]interface{}{}}
+		for _, t := range tg {
+			v, _, err := unstructured.NestedFieldCopy(u.Object, t.path...)
+			if err != nil {
+				return err
+			}
+			rs.Fields[strings.Join(t.path, ".")] = v
+		}
+		b, err := json.Marshal(rs)
+		if err != nil {
+			return err
+		}
+		meta.AddAnnotations(u, map[string]string{AnnotationKeyResumeState: string(b)})
+	}
+	for _, t := range tg {
+		if err := unstructured.SetNestedField(u.Object, t.off, t.path...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// turnOn restores the fields of the target from its resume state and removes
+// the annotation. Fields that had no value before are set to their on value,
+// or removed if they have none.
+func turnOn(u *unstructured.Unstructured, tg []toggle, rs *resumeState) error {
+	for _, t := range tg {
+		v := rs.Fields[strings.Join(t.path, ".")]
+		if v == nil {
+			v = t.on
+		}
+		if v == nil {
+			unstructured.RemoveNestedField(u.Object, t.path...)
+			continue
+		}
+		if err := unstructured.SetNestedField(u.Object, v, t.path...); err != nil {
+			return err
+		}
+	}
+	meta.RemoveAnnotations(u, AnnotationKeyResumeState)
+	return nil
+}
@@ -0,0 +1,492 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/scheduling/v1alpha1"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	// The Schedules below have a window from 20:00 to 08:00.
+	inWindow     = time.Date(2021, 3, 4, 22, 0, 0, 0, time.UTC)
+	outOfWindow  = time.Date(2021, 3, 4, 12, 0, 0, 0, time.UTC)
+	windowStart  = metav1.NewTime(time.Date(2021, 3, 4, 20, 0, 0, 0, time.UTC))
+	windowEnd    = metav1.NewTime(time.Date(2021, 3, 5, 8, 0, 0, 0, time.UTC))
+	dbInstance   = v1alpha1.ScheduleTarget{APIVersion: "rds.aws.crossplane.io/v1alpha1", Kind: "DBInstance", Name: "db"}
+	nodeGroup    = v1alpha1.ScheduleTarget{APIVersion: "eks.aws.crossplane.io/v1alpha1", Kind: "NodeGroup", Name: "ng"}
+	bucket       = v1alpha1.ScheduleTarget{APIVersion: "s3.aws.crossplane.io/v1beta1", Kind: "Bucket", Name: "b"}
+	savedRunning = `{"schedule":"off-hours","fields":{"spec.forProvider.desiredState":"available"}}`
+	savedEmpty   = `{"schedule":"off-hours","fields":{"spec.forProvider.desiredState":null}}`
+	savedOther   = `{"schedule":"weekend","fields":{"spec.forProvider.desiredState":"available"}}`
+	savedNodes   = `{"schedule":"off-hours","fields":{"spec.forProvider.scalingConfig.desiredSize":null,"spec.forProvider.scalingConfig.minSize":2}}`
+)
+
+type scheduleModifier func(*v1alpha1.Schedule)
+
+func withTargets(t ...v1alpha1.ScheduleTarget) scheduleModifier {
+	return func(r *v1alpha1.Schedule) { r.Spec.ForProvider.Targets = t }
+}
+
+func withActive(active bool) scheduleModifier {
+	return func(r *v1alpha1.Schedule) {
+		r.Status.AtProvider.Active = active
+		if active {
+			r.Status.AtProvider.WindowStart = &windowStart
+			r.Status.AtProvider.WindowEnd = &windowEnd
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) scheduleModifier {
+	return func(r *v1alpha1.Schedule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionTimestamp() scheduleModifier {
+	return func(r *v1alpha1.Schedule) {
+		t := metav1.NewTime(inWindow)
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func schedule(m ...scheduleModifier) *v1alpha1.Schedule {
+	cr := &v1alpha1.Schedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "off-hours"},
+		Spec: v1alpha1.ScheduleSpec{
+			ForProvider: v1alpha1.ScheduleParameters{
+				Start:    "0 20 * * *",
+				Duration: metav1.Duration{Duration: 12 * time.Hour},
+				Targets:  []v1alpha1.ScheduleTarget{dbInstance},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// db returns a DBInstance with the given desired state. It has the resume
+// state annotation if saved is not empty.
+func db(desiredState string, saved string) map[string]interface{} {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "rds.aws.crossplane.io/v1alpha1",
+		"kind":       "DBInstance",
+		"metadata":   map[string]interface{}{"name": "db"},
+		"spec": map[string]interface{}{
+			"forProvider": map[string]interface{}{
+				"desiredState": desiredState,
+			},
+		},
+	}}
+	if saved != "" {
+		u.SetAnnotations(map[string]string{AnnotationKeyResumeState: saved})
+	}
+	return u.Object
+}
+
+func ng(scalingConfig map[string]interface{}, saved string) map[string]interface{} {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "eks.aws.crossplane.io/v1alpha1",
+		"kind":       "NodeGroup",
+		"metadata":   map[string]interface{}{"name": "ng"},
+		"spec": map[string]interface{}{
+			"forProvider": map[string]interface{}{
+				"scalingConfig": scalingConfig,
+			},
+		},
+	}}
+	if saved != "" {
+		u.SetAnnotations(map[string]string{AnnotationKeyResumeState: saved})
+	}
+	return u.Object
+}
+
+// resumed returns the object as it looks after its resume state annotation
+// was removed.
+func resumed(obj map[string]interface{}) map[string]interface{} {
+	u := &unstructured.Unstructured{Object: obj}
+	u.SetAnnotations(map[string]string{})
+	return u.Object
+}
+
+// getFn returns the object with the requested name.
+func getFn(objs ...map[string]interface{}) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		for _, o := range objs {
+			u := &unstructured.Unstructured{Object: o}
+			if u.GetName() == key.Name {
+				obj.(*unstructured.Unstructured).Object = u.DeepCopy().Object
+				return nil
+			}
+		}
+		return errBoom
+	}
+}
+
+// patchFn records the patched objects.
+func patchFn(patched *[]map[string]interface{}) test.MockPatchFn {
+	return func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+		*patched = append(*patched, obj.(*unstructured.Unstructured).Object)
+		return nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		kube client.Client
+		now  time.Time
+		cr   *v1alpha1.Schedule
+	}
+	type want struct {
+		cr     *v1alpha1.Schedule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ActiveTargetOn": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("available", ""))},
+				now:  inWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withActive(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ActiveTargetOff": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedRunning))},
+				now:  inWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withActive(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ActiveTargetTurnedBackOn": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("available", savedRunning))},
+				now:  inWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withActive(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InactiveTargetOff": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedRunning))},
+				now:  outOfWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InactiveTargetOn": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("available", ""))},
+				now:  outOfWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ActiveTargetOffByOther": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedOther))},
+				now:  inWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withActive(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"InactiveTargetOffByOther": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedOther))},
+				now:  outOfWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:     schedule(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DeletedTargetOff": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedRunning))},
+				now:  inWindow,
+				cr:   schedule(withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     schedule(withDeletionTimestamp(), withActive(true)),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"DeletedTargetOn": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("available", ""))},
+				now:  inWindow,
+				cr:   schedule(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: schedule(withDeletionTimestamp(), withActive(true)),
+			},
+		},
+		"Unsupported": {
+			args: args{
+				now: inWindow,
+				cr:  schedule(withTargets(bucket)),
+			},
+			want: want{
+				cr:  schedule(withTargets(bucket), withActive(true)),
+				err: errors.Errorf(errFmtUnsupported, "Bucket.s3.aws.crossplane.io"),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				now:  inWindow,
+				cr:   schedule(),
+			},
+			want: want{
+				cr:  schedule(withActive(true)),
+				err: errors.Wrapf(errBoom, errFmtGetTarget, "DBInstance", "db"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.args.kube, now: func() time.Time { return tc.args.now }}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		get test.MockGetFn
+		now time.Time
+		cr  *v1alpha1.Schedule
+	}
+	type want struct {
+		patched []map[string]interface{}
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TurnOff": {
+			args: args{
+				get: getFn(db("available", "")),
+				now: inWindow,
+				cr:  schedule(),
+			},
+			want: want{
+				patched: []map[string]interface{}{db("stopped", savedRunning)},
+			},
+		},
+		"TurnOffAgain": {
+			args: args{
+				get: getFn(db("available", savedEmpty)),
+				now: inWindow,
+				cr:  schedule(),
+			},
+			want: want{
+				patched: []map[string]interface{}{db("stopped", savedEmpty)},
+			},
+		},
+		"AlreadyOff": {
+			args: args{
+				get: getFn(db("stopped", savedRunning)),
+				now: inWindow,
+				cr:  schedule(),
+			},
+			want: want{},
+		},
+		"TurnOn": {
+			args: args{
+				get: getFn(db("stopped", savedEmpty)),
+				now: outOfWindow,
+				cr:  schedule(),
+			},
+			want: want{
+				patched: []map[string]interface{}{resumed(db("available", ""))},
+			},
+		},
+		"TurnedOffByOther": {
+			args: args{
+				get: getFn(db("stopped", savedOther)),
+				now: inWindow,
+				cr:  schedule(),
+			},
+			want: want{
+				err: errors.Errorf(errFmtOverlap, "DBInstance", "db", "weekend"),
+			},
+		},
+		"NotTurnedOnForOther": {
+			args: args{
+				get: getFn(db("stopped", savedOther)),
+				now: outOfWindow,
+				cr:  schedule(),
+			},
+			want: want{},
+		},
+		"ScaleNodeGroupDown": {
+			args: args{
+				get: getFn(ng(map[string]interface{}{"minSize": int64(2), "maxSize": int64(4)}, "")),
+				now: inWindow,
+				cr:  schedule(withTargets(nodeGroup)),
+			},
+			want: want{
+				patched: []map[string]interface{}{
+					ng(map[string]interface{}{"minSize": int64(0), "desiredSize": int64(0), "maxSize": int64(4)},
+						savedNodes),
+				},
+			},
+		},
+		"ScaleNodeGroupUp": {
+			args: args{
+				get: getFn(ng(map[string]interface{}{"minSize": int64(0), "desiredSize": int64(0), "maxSize": int64(4)},
+					savedNodes)),
+				now: outOfWindow,
+				cr:  schedule(withTargets(nodeGroup)),
+			},
+			want: want{
+				patched: []map[string]interface{}{resumed(ng(map[string]interface{}{"minSize": int64(2), "maxSize": int64(4)}, ""))},
+			},
+		},
+		"BadResumeState": {
+			args: args{
+				get: getFn(db("stopped", "{")),
+				now: outOfWindow,
+				cr:  schedule(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("unexpected end of JSON input"), errResumeState),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patched []map[string]interface{}
+			kube := &test.MockClient{MockGet: tc.args.get, MockPatch: patchFn(&patched)}
+			e := &external{kube: kube, now: func() time.Time { return tc.args.now }}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		kube client.Client
+		cr   *v1alpha1.Schedule
+	}
+	type want struct {
+		patched []map[string]interface{}
+		err     error
+	}
+
+	var patched []map[string]interface{}
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"TurnOnDuringWindow": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedRunning)), MockPatch: patchFn(&patched)},
+				cr:   schedule(),
+			},
+			want: want{
+				patched: []map[string]interface{}{resumed(db("available", ""))},
+			},
+		},
+		"PatchFailed": {
+			args: args{
+				kube: &test.MockClient{MockGet: getFn(db("stopped", savedRunning)), MockPatch: test.NewMockPatchFn(errBoom)},
+				cr:   schedule(),
+			},
+			want: want{
+				err: errors.Wrapf(errBoom, errFmtPatchTarget, "DBInstance", "db"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched = nil
+			e := &external{kube: tc.args.kube, now: func() time.Time { return inWindow }}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patched, patched); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,8 +18,10 @@ package statemachine
 
 import (
 	"context"
+	"encoding/json"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/google/go-cmp/cmp"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -33,6 +35,12 @@ import (
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	// ConnectionDetailsStateMachineARN is the key of the connection detail
+	// that holds the ARN of the state machine.
+	ConnectionDetailsStateMachineARN = "stateMachineArn"
+)
+
 // SetupStateMachine adds a controller that reconciles StateMachine.
 func SetupStateMachine(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.StateMachineGroupKind)
@@ -40,8 +48,10 @@ func SetupStateMachine(mgr ctrl.Manager, o controller.Options) error {
 		func(e *external) {
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
 			e.preCreate = preCreate
 			e.postCreate = postCreate
+			e.preUpdate = preUpdate
 			e.preDelete = preDelete
 		},
 	}
@@ -52,6 +62,7 @@ func SetupStateMachine(mgr ctrl.Manager, o controller.Options) error {
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.StateMachineGroupVersionKind),
 			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -73,9 +84,87 @@ func postObserve(_ context.Context, cr *svcapitypes.StateMachine, resp *svcsdk.D
 	case string(svcapitypes.StateMachineStatus_SDK_DELETING):
 		cr.SetConditions(xpv1.Deleting())
 	}
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		ConnectionDetailsStateMachineARN: []byte(aws.StringValue(resp.StateMachineArn)),
+	}
 	return obs, nil
 }
 
+func isUpToDate(cr *svcapitypes.StateMachine, resp *svcsdk.DescribeStateMachineOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case !isDefinitionUpToDate(p.Definition, resp.Definition),
+		aws.StringValue(p.RoleARN) != aws.StringValue(resp.RoleArn),
+		!isLoggingConfigurationUpToDate(p.LoggingConfiguration, resp.LoggingConfiguration),
+		!isTracingConfigurationUpToDate(p.TracingConfiguration, resp.TracingConfiguration):
+		return false, nil
+	}
+	return true, nil
+}
+
+// isDefinitionUpToDate compares the Amazon States Language definitions as
+// JSON documents so that formatting and key order do not matter.
+func isDefinitionUpToDate(desired, observed *string) bool {
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(aws.StringValue(desired)), &d); err != nil {
+		return aws.StringValue(desired) == aws.StringValue(observed)
+	}
+	if err := json.Unmarshal([]byte(aws.StringValue(observed)), &o); err != nil {
+		return false
+	}
+	return cmp.Equal(d, o)
+}
+
+func isLoggingConfigurationUpToDate(desired *svcapitypes.LoggingConfiguration, observed *svcsdk.LoggingConfiguration) bool {
+	if desired == nil {
+		desired = &svcapitypes.LoggingConfiguration{}
+	}
+	if observed == nil {
+		observed = &svcsdk.LoggingConfiguration{}
+	}
+	level := aws.StringValue(desired.Level)
+	if level == "" {
+		level = svcsdk.LogLevelOff
+	}
+	observedLevel := aws.StringValue(observed.Level)
+	if observedLevel == "" {
+		observedLevel = svcsdk.LogLevelOff
+	}
+	if level != observedLevel ||
+		aws.BoolValue(desired.IncludeExecutionData) != aws.BoolValue(observed.IncludeExecutionData) ||
+		len(desired.Destinations) != len(observed.Destinations) {
+		return false
+	}
+	for i, d := range desired.Destinations {
+		var observedARN string
+		if o := observed.Destinations[i]; o != nil && o.CloudWatchLogsLogGroup != nil {
+			observedARN = aws.StringValue(o.CloudWatchLogsLogGroup.LogGroupArn)
+		}
+		if logGroupARN(d) != observedARN {
+			return false
+		}
+	}
+	return true
+}
+
+func logGroupARN(d *svcapitypes.LogDestination) string {
+	if d == nil || d.CloudWatchLogsLogGroup == nil {
+		return ""
+	}
+	return aws.StringValue(d.CloudWatchLogsLogGroup.LogGroupARN)
+}
+
+func isTracingConfigurationUpToDate(desired *svcapitypes.TracingConfiguration, observed *svcsdk.TracingConfiguration) bool {
+	var enabled, observedEnabled bool
+	if desired != nil {
+		enabled = aws.BoolValue(desired.Enabled)
+	}
+	if observed != nil {
+		observedEnabled = aws.BoolValue(observed.Enabled)
+	}
+	return enabled == observedEnabled
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.StateMachine, obj *svcsdk.CreateStateMachineInput) error {
 	obj.Type = aws.String(string(cr.Spec.ForProvider.Type))
 	obj.RoleArn = cr.Spec.ForProvider.RoleARN
@@ -90,6 +179,12 @@ func postCreate(_ context.Context, cr *svcapitypes.StateMachine, resp *svcsdk.Cr
 	return cre, nil
 }
 
+func preUpdate(_ context.Context, cr *svcapitypes.StateMachine, obj *svcsdk.UpdateStateMachineInput) error {
+	obj.StateMachineArn = aws.String(meta.GetExternalName(cr))
+	obj.RoleArn = cr.Spec.ForProvider.RoleARN
+	return nil
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.StateMachine, obj *svcsdk.DeleteStateMachineInput) (bool, error) {
 	obj.StateMachineArn = aws.String(meta.GetExternalName(cr))
 	return false, nil
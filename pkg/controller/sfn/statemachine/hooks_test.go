@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statemachine
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/sfn"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	definition = `{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","End":true}}}`
+	roleARN    = "arn:aws:iam::123456789012:role/sfn"
+	logGroup   = "arn:aws:logs:us-east-1:123456789012:log-group:sfn:*"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	observed := func() *svcsdk.DescribeStateMachineOutput {
+		return &svcsdk.DescribeStateMachineOutput{
+			Definition: aws.String(definition),
+			RoleArn:    aws.String(roleARN),
+			LoggingConfiguration: &svcsdk.LoggingConfiguration{
+				Level:                aws.String(svcsdk.LogLevelOff),
+				IncludeExecutionData: aws.Bool(false),
+			},
+			TracingConfiguration: &svcsdk.TracingConfiguration{Enabled: aws.Bool(false)},
+		}
+	}
+	stateMachine := func(p svcapitypes.StateMachineParameters) *svcapitypes.StateMachine {
+		if p.Definition == nil {
+			p.Definition = aws.String(definition)
+		}
+		p.RoleARN = aws.String(roleARN)
+		return &svcapitypes.StateMachine{Spec: svcapitypes.StateMachineSpec{ForProvider: p}}
+	}
+
+	cases := map[string]struct {
+		cr   *svcapitypes.StateMachine
+		resp *svcsdk.DescribeStateMachineOutput
+		want bool
+	}{
+		"UpToDate": {
+			cr:   stateMachine(svcapitypes.StateMachineParameters{}),
+			resp: observed(),
+			want: true,
+		},
+		"DefinitionFormatting": {
+			cr: stateMachine(svcapitypes.StateMachineParameters{
+				Definition: aws.String("{\n  \"States\": {\"Hello\": {\"End\": true, \"Type\": \"Pass\"}},\n  \"StartAt\": \"Hello\"\n}"),
+			}),
+			resp: observed(),
+			want: true,
+		},
+		"DefinitionChanged": {
+			cr: stateMachine(svcapitypes.StateMachineParameters{
+				Definition: aws.String(`{"StartAt":"Hello","States":{"Hello":{"Type":"Succeed"}}}`),
+			}),
+			resp: observed(),
+			want: false,
+		},
+		"LoggingChanged": {
+			cr: stateMachine(svcapitypes.StateMachineParameters{
+				LoggingConfiguration: &svcapitypes.LoggingConfiguration{
+					Level: aws.String(svcsdk.LogLevelAll),
+					Destinations: []*svcapitypes.LogDestination{{
+						CloudWatchLogsLogGroup: &svcapitypes.CloudWatchLogsLogGroup{LogGroupARN: aws.String(logGroup)},
+					}},
+				},
+			}),
+			resp: observed(),
+			want: false,
+		},
+		"TracingChanged": {
+			cr: stateMachine(svcapitypes.StateMachineParameters{
+				TracingConfiguration: &svcapitypes.TracingConfiguration{Enabled: aws.Bool(true)},
+			}),
+			resp: observed(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, tc.resp)
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("isUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindowtarget
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupMaintenanceWindowTarget adds a controller that reconciles MaintenanceWindowTarget.
+func SetupMaintenanceWindowTarget(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MaintenanceWindowTargetGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.MaintenanceWindowTarget{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.MaintenanceWindowTargetGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// filterWindowTargetID is the DescribeMaintenanceWindowTargets filter key
+// for the ID of a target.
+const filterWindowTargetID = "WindowTargetId"
+
+func preObserve(_ context.Context, cr *svcapitypes.MaintenanceWindowTarget, obj *svcsdk.DescribeMaintenanceWindowTargetsInput) error {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.Filters = []*svcsdk.MaintenanceWindowFilter{{
+		Key:    awsclients.String(filterWindowTargetID),
+		Values: []*string{awsclients.String(meta.GetExternalName(cr))},
+	}}
+	return nil
+}
+
+func filterList(cr *svcapitypes.MaintenanceWindowTarget, list *svcsdk.DescribeMaintenanceWindowTargetsOutput) *svcsdk.DescribeMaintenanceWindowTargetsOutput {
+	for _, t := range list.Targets {
+		if awsclients.StringValue(t.WindowTargetId) == meta.GetExternalName(cr) {
+			return &svcsdk.DescribeMaintenanceWindowTargetsOutput{Targets: []*svcsdk.MaintenanceWindowTarget{t}}
+		}
+	}
+	return &svcsdk.DescribeMaintenanceWindowTargetsOutput{}
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.MaintenanceWindowTarget, _ *svcsdk.DescribeMaintenanceWindowTargetsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.MaintenanceWindowTarget, resp *svcsdk.DescribeMaintenanceWindowTargetsOutput) (bool, error) {
+	observed := GenerateMaintenanceWindowTarget(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.CustomMaintenanceWindowTargetParameters = cr.Spec.ForProvider.CustomMaintenanceWindowTargetParameters
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.MaintenanceWindowTarget, obj *svcsdk.RegisterTargetWithMaintenanceWindowInput) error {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.MaintenanceWindowTarget, resp *svcsdk.RegisterTargetWithMaintenanceWindowOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.WindowTargetId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.MaintenanceWindowTarget, obj *svcsdk.UpdateMaintenanceWindowTargetInput) error {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.WindowTargetId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.MaintenanceWindowTarget, obj *svcsdk.DeregisterTargetFromMaintenanceWindowInput) (bool, error) {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.WindowTargetId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindowtask
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupMaintenanceWindowTask adds a controller that reconciles MaintenanceWindowTask.
+func SetupMaintenanceWindowTask(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MaintenanceWindowTaskGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.MaintenanceWindowTask{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.MaintenanceWindowTaskGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.MaintenanceWindowTask, obj *svcsdk.GetMaintenanceWindowTaskInput) error {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.WindowTaskId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.MaintenanceWindowTask, _ *svcsdk.GetMaintenanceWindowTaskOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.MaintenanceWindowTask, resp *svcsdk.GetMaintenanceWindowTaskOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	if p.ServiceRoleARN != nil && awsclients.StringValue(p.ServiceRoleARN) != awsclients.StringValue(resp.ServiceRoleArn) {
+		return false, nil
+	}
+	observed := GenerateMaintenanceWindowTask(resp).Spec.ForProvider
+	observed.Region = p.Region
+	observed.CustomMaintenanceWindowTaskParameters = p.CustomMaintenanceWindowTaskParameters
+	return awsclients.IsJSONSubset(p, observed)
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.MaintenanceWindowTask, obj *svcsdk.RegisterTaskWithMaintenanceWindowInput) error {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.ServiceRoleArn = cr.Spec.ForProvider.ServiceRoleARN
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.MaintenanceWindowTask, resp *svcsdk.RegisterTaskWithMaintenanceWindowOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.WindowTaskId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.MaintenanceWindowTask, obj *svcsdk.UpdateMaintenanceWindowTaskInput) error {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.WindowTaskId = awsclients.String(meta.GetExternalName(cr))
+	obj.ServiceRoleArn = cr.Spec.ForProvider.ServiceRoleARN
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.MaintenanceWindowTask, obj *svcsdk.DeregisterTaskFromMaintenanceWindowInput) (bool, error) {
+	obj.WindowId = cr.Spec.ForProvider.WindowID
+	obj.WindowTaskId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
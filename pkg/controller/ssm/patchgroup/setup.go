@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchgroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupPatchGroup adds a controller that reconciles PatchGroup.
+func SetupPatchGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.PatchGroupGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.PatchGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.PatchGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+// filterNamePrefix is the DescribePatchGroups filter key for the prefix of
+// the patch group name.
+const filterNamePrefix = "NAME_PREFIX"
+
+func preObserve(_ context.Context, cr *svcapitypes.PatchGroup, obj *svcsdk.DescribePatchGroupsInput) error {
+	obj.Filters = []*svcsdk.PatchOrchestratorFilter{{
+		Key:    awsclients.String(filterNamePrefix),
+		Values: []*string{awsclients.String(cr.Spec.ForProvider.PatchGroup)},
+	}}
+	return nil
+}
+
+// filterList returns the mapping of the patch group to the desired baseline.
+// A patch group that is registered with another baseline is treated as not
+// registered.
+func filterList(cr *svcapitypes.PatchGroup, list *svcsdk.DescribePatchGroupsOutput) *svcsdk.DescribePatchGroupsOutput {
+	for _, m := range list.Mappings {
+		if awsclients.StringValue(m.PatchGroup) != cr.Spec.ForProvider.PatchGroup || m.BaselineIdentity == nil {
+			continue
+		}
+		if awsclients.StringValue(m.BaselineIdentity.BaselineId) == awsclients.StringValue(cr.Spec.ForProvider.BaselineID) {
+			return &svcsdk.DescribePatchGroupsOutput{Mappings: []*svcsdk.PatchGroupPatchBaselineMapping{m}}
+		}
+	}
+	return &svcsdk.DescribePatchGroupsOutput{}
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.PatchGroup, _ *svcsdk.DescribePatchGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.BaselineID = cr.Spec.ForProvider.BaselineID
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.PatchGroup, obj *svcsdk.RegisterPatchBaselineForPatchGroupInput) error {
+	obj.BaselineId = cr.Spec.ForProvider.BaselineID
+	obj.PatchGroup = awsclients.String(cr.Spec.ForProvider.PatchGroup)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.PatchGroup, resp *svcsdk.RegisterPatchBaselineForPatchGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.PatchGroup))
+	return cre, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.PatchGroup, obj *svcsdk.DeregisterPatchBaselineForPatchGroupInput) (bool, error) {
+	obj.BaselineId = cr.Spec.ForProvider.BaselineID
+	obj.PatchGroup = awsclients.String(cr.Spec.ForProvider.PatchGroup)
+	return false, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patchgroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssm/v1alpha1"
+)
+
+func TestFilterList(t *testing.T) {
+	mapping := func(group, baseline string) *svcsdk.PatchGroupPatchBaselineMapping {
+		return &svcsdk.PatchGroupPatchBaselineMapping{
+			PatchGroup:       aws.String(group),
+			BaselineIdentity: &svcsdk.PatchBaselineIdentity{BaselineId: aws.String(baseline)},
+		}
+	}
+	cr := &svcapitypes.PatchGroup{
+		Spec: svcapitypes.PatchGroupSpec{
+			ForProvider: svcapitypes.PatchGroupParameters{
+				CustomPatchGroupParameters: svcapitypes.CustomPatchGroupParameters{
+					PatchGroup: "web",
+					BaselineID: aws.String("pb-1"),
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		list *svcsdk.DescribePatchGroupsOutput
+		want *svcsdk.DescribePatchGroupsOutput
+	}{
+		"Registered": {
+			list: &svcsdk.DescribePatchGroupsOutput{Mappings: []*svcsdk.PatchGroupPatchBaselineMapping{
+				mapping("web-canary", "pb-1"),
+				mapping("web", "pb-1"),
+			}},
+			want: &svcsdk.DescribePatchGroupsOutput{Mappings: []*svcsdk.PatchGroupPatchBaselineMapping{
+				mapping("web", "pb-1"),
+			}},
+		},
+		"RegisteredWithOtherBaseline": {
+			list: &svcsdk.DescribePatchGroupsOutput{Mappings: []*svcsdk.PatchGroupPatchBaselineMapping{
+				mapping("web", "pb-2"),
+			}},
+			want: &svcsdk.DescribePatchGroupsOutput{},
+		},
+		"NotRegistered": {
+			list: &svcsdk.DescribePatchGroupsOutput{},
+			want: &svcsdk.DescribePatchGroupsOutput{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := filterList(cr, tc.list)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contactchannel
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssmcontacts/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errGetDeliveryAddressSecret = "cannot get delivery address secret"
+	errEmptyDeliveryAddress     = "delivery address must not be empty"
+
+	msgNotActivated = "contact channel has not been activated yet"
+)
+
+// SetupContactChannel adds a controller that reconciles ContactChannel.
+func SetupContactChannel(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ContactChannelGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{kube: e.kube}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = h.isUpToDate
+			e.preCreate = h.preCreate
+			e.postCreate = postCreate
+			e.preUpdate = h.preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ContactChannel{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ContactChannelGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	kube client.Client
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ContactChannel, obj *svcsdk.GetContactChannelInput) error {
+	obj.ContactChannelId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ContactChannel, resp *svcsdk.GetContactChannelOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	// Channels have to be activated with the code that is sent to the
+	// delivery address before any engagement is delivered to them.
+	if awsclients.StringValue(resp.ActivationStatus) == svcsdk.ActivationStatusActivated {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgNotActivated))
+	}
+	return obs, nil
+}
+
+func (h *hooks) isUpToDate(cr *svcapitypes.ContactChannel, resp *svcsdk.GetContactChannelOutput) (bool, error) {
+	if awsclients.StringValue(cr.Spec.ForProvider.Name) != awsclients.StringValue(resp.Name) {
+		return false, nil
+	}
+	addr, err := h.getDeliveryAddress(context.TODO(), cr.Spec.ForProvider.DeliveryAddressSecretRef)
+	if err != nil {
+		return false, err
+	}
+	return resp.DeliveryAddress != nil && addr == awsclients.StringValue(resp.DeliveryAddress.SimpleAddress), nil
+}
+
+func (h *hooks) preCreate(ctx context.Context, cr *svcapitypes.ContactChannel, obj *svcsdk.CreateContactChannelInput) error {
+	addr, err := h.getDeliveryAddress(ctx, cr.Spec.ForProvider.DeliveryAddressSecretRef)
+	if err != nil {
+		return err
+	}
+	obj.ContactId = cr.Spec.ForProvider.ContactID
+	obj.DeliveryAddress = &svcsdk.ContactChannelAddress{SimpleAddress: awsclients.String(addr)}
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.ContactChannel, resp *svcsdk.CreateContactChannelOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.ContactChannelArn))
+	return cre, nil
+}
+
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.ContactChannel, obj *svcsdk.UpdateContactChannelInput) error {
+	addr, err := h.getDeliveryAddress(ctx, cr.Spec.ForProvider.DeliveryAddressSecretRef)
+	if err != nil {
+		return err
+	}
+	obj.ContactChannelId = awsclients.String(meta.GetExternalName(cr))
+	obj.DeliveryAddress = &svcsdk.ContactChannelAddress{SimpleAddress: awsclients.String(addr)}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ContactChannel, obj *svcsdk.DeleteContactChannelInput) (bool, error) {
+	obj.ContactChannelId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func (h *hooks) getDeliveryAddress(ctx context.Context, ref xpv1.SecretKeySelector) (string, error) {
+	s := &corev1.Secret{}
+	if err := h.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetDeliveryAddressSecret)
+	}
+	addr := string(s.Data[ref.Key])
+	if addr == "" {
+		return "", errors.New(errEmptyDeliveryAddress)
+	}
+	return addr, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contactchannel
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssmcontacts/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func withSecret(addr string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{"email": []byte(addr)}
+			return nil
+		},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cr := &svcapitypes.ContactChannel{
+		Spec: svcapitypes.ContactChannelSpec{
+			ForProvider: svcapitypes.ContactChannelParameters{
+				Name: awsclients.String("email"),
+				CustomContactChannelParameters: svcapitypes.CustomContactChannelParameters{
+					DeliveryAddressSecretRef: xpv1.SecretKeySelector{Key: "email"},
+				},
+			},
+		},
+	}
+	type want struct {
+		upToDate bool
+		err      error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		resp *svcsdk.GetContactChannelOutput
+		want want
+	}{
+		"UpToDate": {
+			kube: withSecret("oncall@example.com"),
+			resp: &svcsdk.GetContactChannelOutput{
+				Name:            awsclients.String("email"),
+				DeliveryAddress: &svcsdk.ContactChannelAddress{SimpleAddress: awsclients.String("oncall@example.com")},
+			},
+			want: want{upToDate: true},
+		},
+		"AddressChanged": {
+			kube: withSecret("oncall@example.org"),
+			resp: &svcsdk.GetContactChannelOutput{
+				Name:            awsclients.String("email"),
+				DeliveryAddress: &svcsdk.ContactChannelAddress{SimpleAddress: awsclients.String("oncall@example.com")},
+			},
+			want: want{upToDate: false},
+		},
+		"NameChanged": {
+			kube: withSecret("oncall@example.com"),
+			resp: &svcsdk.GetContactChannelOutput{
+				Name:            awsclients.String("mail"),
+				DeliveryAddress: &svcsdk.ContactChannelAddress{SimpleAddress: awsclients.String("oncall@example.com")},
+			},
+			want: want{upToDate: false},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{kube: tc.kube}
+			got, err := h.isUpToDate(cr, tc.resp)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseplan
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssmincidents"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssmincidents/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupResponsePlan adds a controller that reconciles ResponsePlan.
+func SetupResponsePlan(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ResponsePlanGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ResponsePlan{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ResponsePlanGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ResponsePlan, obj *svcsdk.GetResponsePlanInput) error {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ResponsePlan, _ *svcsdk.GetResponsePlanOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.ResponsePlan, resp *svcsdk.GetResponsePlanOutput) (bool, error) {
+	observed := GenerateResponsePlan(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	return awsclients.IsJSONSubset(cr.Spec.ForProvider, observed)
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.ResponsePlan, resp *svcsdk.CreateResponsePlanOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.Arn))
+	return cre, nil
+}
+
+// preUpdate sets the ARN and the incident template, which the update API
+// expects as separate top-level fields.
+func preUpdate(_ context.Context, cr *svcapitypes.ResponsePlan, obj *svcsdk.UpdateResponsePlanInput) error {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	t := cr.Spec.ForProvider.IncidentTemplate
+	if t == nil {
+		return nil
+	}
+	obj.IncidentTemplateDedupeString = t.DedupeString
+	obj.IncidentTemplateImpact = t.Impact
+	obj.IncidentTemplateSummary = t.Summary
+	obj.IncidentTemplateTitle = t.Title
+	if t.NotificationTargets != nil {
+		obj.IncidentTemplateNotificationTargets = make([]*svcsdk.NotificationTargetItem, 0, len(t.NotificationTargets))
+		for _, n := range t.NotificationTargets {
+			if n == nil {
+				continue
+			}
+			obj.IncidentTemplateNotificationTargets = append(obj.IncidentTemplateNotificationTargets, &svcsdk.NotificationTargetItem{SnsTopicArn: n.SNSTopicARN})
+		}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ResponsePlan, obj *svcsdk.DeleteResponsePlanInput) (bool, error) {
+	obj.Arn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountassignment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcapi "github.com/aws/aws-sdk-go/service/ssoadmin"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssoadmin"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ssoadmin/ssoadminiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssoadmin/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AccountAssignment resource"
+
+	errCreateSession  = "cannot create a new session"
+	errCreate         = "cannot create AccountAssignment in AWS"
+	errDelete         = "cannot delete AccountAssignment in AWS"
+	errList           = "failed to list AccountAssignments"
+	errCreationStatus = "failed to describe AccountAssignment creation status"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.AccountAssignment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.SSOAdminAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.AccountAssignment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	found, err := e.isAssigned(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errList)
+	}
+	if found {
+		cr.SetConditions(xpv1.Available())
+		// An account assignment has no mutable fields.
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	// The assignment is only listed once its creation request succeeded.
+	if cr.Status.AtProvider.RequestID == nil || meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	resp, err := e.client.DescribeAccountAssignmentCreationStatusWithContext(ctx, &svcsdk.DescribeAccountAssignmentCreationStatusInput{
+		InstanceArn:                        awsclient.String(cr.Spec.ForProvider.InstanceARN),
+		AccountAssignmentCreationRequestId: cr.Status.AtProvider.RequestID,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errCreationStatus)
+	}
+	setObservation(cr, resp.AccountAssignmentCreationStatus)
+	if awsclient.StringValue(cr.Status.AtProvider.Status) == svcsdk.StatusValuesInProgress {
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if awsclient.StringValue(cr.Status.AtProvider.Status) == svcsdk.StatusValuesFailed {
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclient.StringValue(cr.Status.AtProvider.FailureReason)))
+	}
+	return managed.ExternalObservation{ResourceExists: false}, nil
+}
+
+// isAssigned returns whether the permission set is assigned to the principal
+// in the target account.
+func (e *external) isAssigned(ctx context.Context, p svcapitypes.AccountAssignmentParameters) (bool, error) {
+	found := false
+	err := e.client.ListAccountAssignmentsPagesWithContext(ctx, &svcsdk.ListAccountAssignmentsInput{
+		InstanceArn:      awsclient.String(p.InstanceARN),
+		AccountId:        awsclient.String(p.TargetID),
+		PermissionSetArn: p.PermissionSetARN,
+	}, func(page *svcsdk.ListAccountAssignmentsOutput, _ bool) bool {
+		for _, a := range page.AccountAssignments {
+			if awsclient.StringValue(a.PrincipalId) == p.PrincipalID && awsclient.StringValue(a.PrincipalType) == p.PrincipalType {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return found, err
+}
+
+func setObservation(cr *svcapitypes.AccountAssignment, s *svcsdk.AccountAssignmentOperationStatus) {
+	if s == nil {
+		return
+	}
+	cr.Status.AtProvider = svcapitypes.AccountAssignmentObservation{
+		RequestID:     s.RequestId,
+		Status:        s.Status,
+		FailureReason: s.FailureReason,
+	}
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.AccountAssignment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateAccountAssignmentWithContext(ctx, &svcsdk.CreateAccountAssignmentInput{
+		InstanceArn:      awsclient.String(cr.Spec.ForProvider.InstanceARN),
+		PermissionSetArn: cr.Spec.ForProvider.PermissionSetARN,
+		PrincipalId:      awsclient.String(cr.Spec.ForProvider.PrincipalID),
+		PrincipalType:    awsclient.String(cr.Spec.ForProvider.PrincipalType),
+		TargetId:         awsclient.String(cr.Spec.ForProvider.TargetID),
+		TargetType:       cr.Spec.ForProvider.TargetType,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	setObservation(cr, resp.AccountAssignmentCreationStatus)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(_ context.Context, _ cpresource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.AccountAssignment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	resp, err := e.client.DeleteAccountAssignmentWithContext(ctx, &svcsdk.DeleteAccountAssignmentInput{
+		InstanceArn:      awsclient.String(cr.Spec.ForProvider.InstanceARN),
+		PermissionSetArn: cr.Spec.ForProvider.PermissionSetARN,
+		PrincipalId:      awsclient.String(cr.Spec.ForProvider.PrincipalID),
+		PrincipalType:    awsclient.String(cr.Spec.ForProvider.PrincipalType),
+		TargetId:         awsclient.String(cr.Spec.ForProvider.TargetID),
+		TargetType:       cr.Spec.ForProvider.TargetType,
+	})
+	if err != nil {
+		// A conflict means the deletion requested by an earlier reconcile is
+		// still in progress.
+		return awsclient.Wrap(cpresource.Ignore(isNotFoundOrConflict, err), errDelete)
+	}
+	setObservation(cr, resp.AccountAssignmentDeletionStatus)
+	return nil
+}
+
+func isNotFoundOrConflict(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && (awsErr.Code() == svcsdk.ErrCodeResourceNotFoundException || awsErr.Code() == svcsdk.ErrCodeConflictException)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceaccesscontrolattributeconfiguration
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/google/go-cmp/cmp"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssoadmin/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupInstanceAccessControlAttributeConfiguration adds a controller that
+// reconciles InstanceAccessControlAttributeConfiguration.
+func SetupInstanceAccessControlAttributeConfiguration(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.InstanceAccessControlAttributeConfigurationGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.InstanceAccessControlAttributeConfiguration{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.InstanceAccessControlAttributeConfigurationGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.InstanceAccessControlAttributeConfiguration, obj *svcsdk.DescribeInstanceAccessControlAttributeConfigurationInput) error {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.InstanceAccessControlAttributeConfiguration, resp *svcsdk.DescribeInstanceAccessControlAttributeConfigurationOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.Status = resp.Status
+	cr.Status.AtProvider.StatusReason = resp.StatusReason
+	switch awsclients.StringValue(resp.Status) {
+	case svcsdk.InstanceAccessControlAttributeConfigurationStatusEnabled:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.InstanceAccessControlAttributeConfigurationStatusCreationInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.InstanceAccessControlAttributeConfigurationStatusCreationFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(resp.StatusReason)))
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.InstanceAccessControlAttributeConfiguration, resp *svcsdk.DescribeInstanceAccessControlAttributeConfigurationOutput) (bool, error) {
+	desired := map[string][]string{}
+	if c := cr.Spec.ForProvider.InstanceAccessControlAttributeConfiguration; c != nil {
+		for _, a := range c.AccessControlAttributes {
+			var sources []string
+			if a.Value != nil {
+				sources = aws.StringValueSlice(a.Value.Source)
+			}
+			sort.Strings(sources)
+			desired[awsclients.StringValue(a.Key)] = sources
+		}
+	}
+	observed := map[string][]string{}
+	if c := resp.InstanceAccessControlAttributeConfiguration; c != nil {
+		for _, a := range c.AccessControlAttributes {
+			var sources []string
+			if a.Value != nil {
+				sources = aws.StringValueSlice(a.Value.Source)
+			}
+			sort.Strings(sources)
+			observed[awsclients.StringValue(a.Key)] = sources
+		}
+	}
+	return cmp.Equal(desired, observed), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.InstanceAccessControlAttributeConfiguration, obj *svcsdk.CreateInstanceAccessControlAttributeConfigurationInput) error {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.InstanceAccessControlAttributeConfiguration, _ *svcsdk.CreateInstanceAccessControlAttributeConfigurationOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	// There is exactly one attribute configuration per SSO instance, so it is
+	// identified by the instance ARN.
+	meta.SetExternalName(cr, cr.Spec.ForProvider.InstanceARN)
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.InstanceAccessControlAttributeConfiguration, obj *svcsdk.UpdateInstanceAccessControlAttributeConfigurationInput) error {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.InstanceAccessControlAttributeConfiguration, obj *svcsdk.DeleteInstanceAccessControlAttributeConfigurationInput) (bool, error) {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	return false, nil
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionset
+
+import (
+	"context"
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ssoadmin"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ssoadmin/ssoadminiface"
+	"github.com/google/go-cmp/cmp"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ssoadmin/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListManagedPolicies = "cannot list managed policies of permission set"
+	errGetInlinePolicy     = "cannot get inline policy of permission set"
+	errAttachPolicy        = "cannot attach managed policy to permission set"
+	errDetachPolicy        = "cannot detach managed policy from permission set"
+	errPutInlinePolicy     = "cannot put inline policy to permission set"
+	errDeleteInlinePolicy  = "cannot delete inline policy from permission set"
+	errProvision           = "cannot provision permission set"
+)
+
+// SetupPermissionSet adds a controller that reconciles PermissionSet.
+func SetupPermissionSet(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.PermissionSetGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = h.postCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.PermissionSet{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.PermissionSetGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.SSOAdminAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.PermissionSet, obj *svcsdk.DescribePermissionSetInput) error {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	obj.PermissionSetArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.PermissionSet, _ *svcsdk.DescribePermissionSetOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	if !obs.ResourceUpToDate {
+		return obs, nil
+	}
+	attached, err := h.listManagedPolicies(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !cmp.Equal(sortedCopy(cr.Spec.ForProvider.ManagedPolicyARNs), sortedCopy(attached)) {
+		obs.ResourceUpToDate = false
+		return obs, nil
+	}
+	resp, err := h.client.GetInlinePolicyForPermissionSetWithContext(ctx, &svcsdk.GetInlinePolicyForPermissionSetInput{
+		InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+		PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetInlinePolicy)
+	}
+	obs.ResourceUpToDate = isInlinePolicyUpToDate(cr.Spec.ForProvider.InlinePolicy, resp.InlinePolicy)
+	return obs, nil
+}
+
+// isInlinePolicyUpToDate returns whether the observed inline policy matches
+// the desired one. AWS reports a permission set without an inline policy
+// with an empty string.
+func isInlinePolicyUpToDate(desired, observed *string) bool {
+	if awsclients.StringValue(desired) == "" || awsclients.StringValue(observed) == "" {
+		return awsclients.StringValue(desired) == awsclients.StringValue(observed)
+	}
+	return awsclients.IsPolicyUpToDate(desired, observed)
+}
+
+func (h *hooks) listManagedPolicies(ctx context.Context, cr *svcapitypes.PermissionSet) ([]string, error) {
+	var arns []string
+	err := h.client.ListManagedPoliciesInPermissionSetPagesWithContext(ctx, &svcsdk.ListManagedPoliciesInPermissionSetInput{
+		InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+		PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+	}, func(page *svcsdk.ListManagedPoliciesInPermissionSetOutput, _ bool) bool {
+		for _, p := range page.AttachedManagedPolicies {
+			arns = append(arns, awsclients.StringValue(p.Arn))
+		}
+		return true
+	})
+	return arns, awsclients.Wrap(err, errListManagedPolicies)
+}
+
+func sortedCopy(s []string) []string {
+	res := make([]string, len(s))
+	copy(res, s)
+	sort.Strings(res)
+	return res
+}
+
+func isUpToDate(cr *svcapitypes.PermissionSet, resp *svcsdk.DescribePermissionSetOutput) (bool, error) {
+	p := cr.Spec.ForProvider
+	ps := resp.PermissionSet
+	switch {
+	case p.Description != nil && awsclients.StringValue(p.Description) != awsclients.StringValue(ps.Description),
+		p.RelayState != nil && awsclients.StringValue(p.RelayState) != awsclients.StringValue(ps.RelayState),
+		p.SessionDuration != nil && awsclients.StringValue(p.SessionDuration) != awsclients.StringValue(ps.SessionDuration):
+		return false, nil
+	}
+	return true, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.PermissionSet, obj *svcsdk.CreatePermissionSetInput) error {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	return nil
+}
+
+func (h *hooks) postCreate(ctx context.Context, cr *svcapitypes.PermissionSet, resp *svcsdk.CreatePermissionSetOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.PermissionSet.PermissionSetArn))
+	// NOTE: The policies are attached by the first update after creation,
+	// once the observation reports them as missing.
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.PermissionSet, obj *svcsdk.UpdatePermissionSetInput) error {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	obj.PermissionSetArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+// postUpdate syncs the managed and inline policies of the permission set and
+// provisions it to all accounts it is assigned to, so the changes take
+// effect.
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.PermissionSet, _ *svcsdk.UpdatePermissionSetOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := h.syncManagedPolicies(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := h.syncInlinePolicy(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = h.client.ProvisionPermissionSetWithContext(ctx, &svcsdk.ProvisionPermissionSetInput{
+		InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+		PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+		TargetType:       awsclients.String(svcsdk.ProvisionTargetTypeAllProvisionedAccounts),
+	})
+	return upd, awsclients.Wrap(err, errProvision)
+}
+
+func (h *hooks) syncManagedPolicies(ctx context.Context, cr *svcapitypes.PermissionSet) error {
+	attached, err := h.listManagedPolicies(ctx, cr)
+	if err != nil {
+		return err
+	}
+	desired := map[string]bool{}
+	for _, arn := range cr.Spec.ForProvider.ManagedPolicyARNs {
+		desired[arn] = true
+	}
+	observed := map[string]bool{}
+	for _, arn := range attached {
+		observed[arn] = true
+		if desired[arn] {
+			continue
+		}
+		if _, err := h.client.DetachManagedPolicyFromPermissionSetWithContext(ctx, &svcsdk.DetachManagedPolicyFromPermissionSetInput{
+			InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+			PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+			ManagedPolicyArn: awsclients.String(arn),
+		}); err != nil {
+			return awsclients.Wrap(err, errDetachPolicy)
+		}
+	}
+	for _, arn := range cr.Spec.ForProvider.ManagedPolicyARNs {
+		if observed[arn] {
+			continue
+		}
+		if _, err := h.client.AttachManagedPolicyToPermissionSetWithContext(ctx, &svcsdk.AttachManagedPolicyToPermissionSetInput{
+			InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+			PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+			ManagedPolicyArn: awsclients.String(arn),
+		}); err != nil {
+			return awsclients.Wrap(err, errAttachPolicy)
+		}
+	}
+	return nil
+}
+
+func (h *hooks) syncInlinePolicy(ctx context.Context, cr *svcapitypes.PermissionSet) error {
+	if awsclients.StringValue(cr.Spec.ForProvider.InlinePolicy) == "" {
+		_, err := h.client.DeleteInlinePolicyFromPermissionSetWithContext(ctx, &svcsdk.DeleteInlinePolicyFromPermissionSetInput{
+			InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+			PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+		})
+		return awsclients.Wrap(resource.Ignore(IsNotFound, err), errDeleteInlinePolicy)
+	}
+	_, err := h.client.PutInlinePolicyToPermissionSetWithContext(ctx, &svcsdk.PutInlinePolicyToPermissionSetInput{
+		InstanceArn:      awsclients.String(cr.Spec.ForProvider.InstanceARN),
+		PermissionSetArn: awsclients.String(meta.GetExternalName(cr)),
+		InlinePolicy:     cr.Spec.ForProvider.InlinePolicy,
+	})
+	return awsclients.Wrap(err, errPutInlinePolicy)
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.PermissionSet, obj *svcsdk.DeletePermissionSetInput) (bool, error) {
+	obj.InstanceArn = awsclients.String(cr.Spec.ForProvider.InstanceARN)
+	obj.PermissionSetArn = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionset
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsInlinePolicyUpToDate(t *testing.T) {
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+	reformatted := `{
+  "Statement": [{"Action": "s3:GetObject", "Effect": "Allow", "Resource": "*"}],
+  "Version": "2012-10-17"
+}`
+
+	cases := map[string]struct {
+		desired  *string
+		observed *string
+		want     bool
+	}{
+		"NoPolicy": {
+			observed: awsclients.String(""),
+			want:     true,
+		},
+		"PolicyAdded": {
+			desired:  awsclients.String(policy),
+			observed: awsclients.String(""),
+			want:     false,
+		},
+		"PolicyRemoved": {
+			observed: awsclients.String(policy),
+			want:     false,
+		},
+		"SamePolicyDifferentFormatting": {
+			desired:  awsclients.String(policy),
+			observed: awsclients.String(reformatted),
+			want:     true,
+		},
+		"PolicyChanged": {
+			desired:  awsclients.String(policy),
+			observed: awsclients.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isInlinePolicyUpToDate(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
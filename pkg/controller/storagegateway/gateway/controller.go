@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+
+	svcapi "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdk "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/storagegateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/storagegateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Gateway resource"
+
+	errCreateSession = "cannot create a new session"
+	errActivate      = "cannot activate Gateway in AWS"
+	errUpdate        = "cannot update Gateway in AWS"
+	errDelete        = "cannot delete Gateway in AWS"
+	errDescribe      = "failed to describe Gateway"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Gateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.StorageGatewayAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Gateway)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The ARN of a gateway is only known once it is activated.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	resp, err := e.client.DescribeGatewayInformationWithContext(ctx, &svcsdk.DescribeGatewayInformationInput{
+		GatewayARN: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(cpresource.Ignore(storagegateway.IsGatewayNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = svcapitypes.GatewayObservation{
+		GatewayARN:      resp.GatewayARN,
+		GatewayID:       resp.GatewayId,
+		GatewayState:    resp.GatewayState,
+		EndpointType:    resp.EndpointType,
+		HostEnvironment: resp.HostEnvironment,
+		EC2InstanceID:   resp.Ec2InstanceId,
+	}
+	if awsclient.StringValue(resp.GatewayState) == svcapitypes.GatewayStateRunning {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	current := cr.Spec.ForProvider.CloudWatchLogGroupARN
+	cr.Spec.ForProvider.CloudWatchLogGroupARN = awsclient.LateInitializeStringPtr(current, resp.CloudWatchLogGroupARN)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(cr.Spec.ForProvider, resp),
+		ResourceLateInitialized: current != cr.Spec.ForProvider.CloudWatchLogGroupARN,
+	}, nil
+}
+
+func isUpToDate(p svcapitypes.GatewayParameters, resp *svcsdk.DescribeGatewayInformationOutput) bool {
+	return p.GatewayName == awsclient.StringValue(resp.GatewayName) &&
+		p.GatewayTimezone == awsclient.StringValue(resp.GatewayTimezone) &&
+		awsclient.StringValue(p.CloudWatchLogGroupARN) == awsclient.StringValue(resp.CloudWatchLogGroupARN)
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Gateway)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	resp, err := e.client.ActivateGatewayWithContext(ctx, &svcsdk.ActivateGatewayInput{
+		ActivationKey:     awsclient.String(cr.Spec.ForProvider.ActivationKey),
+		GatewayName:       awsclient.String(cr.Spec.ForProvider.GatewayName),
+		GatewayRegion:     awsclient.String(cr.Spec.ForProvider.Region),
+		GatewayTimezone:   awsclient.String(cr.Spec.ForProvider.GatewayTimezone),
+		GatewayType:       cr.Spec.ForProvider.GatewayType,
+		MediumChangerType: cr.Spec.ForProvider.MediumChangerType,
+		TapeDriveType:     cr.Spec.ForProvider.TapeDriveType,
+		Tags:              storagegateway.GenerateTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errActivate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.GatewayARN))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Gateway)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.UpdateGatewayInformationWithContext(ctx, &svcsdk.UpdateGatewayInformationInput{
+		GatewayARN:            awsclient.String(meta.GetExternalName(cr)),
+		GatewayName:           awsclient.String(cr.Spec.ForProvider.GatewayName),
+		GatewayTimezone:       awsclient.String(cr.Spec.ForProvider.GatewayTimezone),
+		CloudWatchLogGroupARN: cr.Spec.ForProvider.CloudWatchLogGroupARN,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Gateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteGatewayWithContext(ctx, &svcsdk.DeleteGatewayInput{
+		GatewayARN: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(cpresource.Ignore(storagegateway.IsGatewayNotFound, err), errDelete)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nfsfileshare
+
+import (
+	"context"
+
+	svcapi "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdk "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/storagegateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/storagegateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a NFSFileShare resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create NFSFileShare in AWS"
+	errUpdate        = "cannot update NFSFileShare in AWS"
+	errDelete        = "cannot delete NFSFileShare in AWS"
+	errDescribe      = "failed to describe NFSFileShare"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.NFSFileShare)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.StorageGatewayAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.NFSFileShare)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The ARN of a file share is only known once it is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	fs, err := storagegateway.GetFileShare(ctx, e.client, awsclient.StringValue(cr.Spec.ForProvider.GatewayARN), meta.GetExternalName(cr))
+	if err != nil || fs == nil {
+		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(err, errDescribe)
+	}
+	resp, err := e.client.DescribeNFSFileSharesWithContext(ctx, &svcsdk.DescribeNFSFileSharesInput{
+		FileShareARNList: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	if err != nil || len(resp.NFSFileShareInfoList) == 0 {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	info := resp.NFSFileShareInfoList[0]
+	cr.Status.AtProvider.FileShareObservation = svcapitypes.FileShareObservation{
+		FileShareARN:    info.FileShareARN,
+		FileShareID:     info.FileShareId,
+		FileShareStatus: info.FileShareStatus,
+		Path:            info.Path,
+	}
+	switch awsclient.StringValue(info.FileShareStatus) {
+	case svcapitypes.FileShareStatusAvailable:
+		cr.SetConditions(xpv1.Available())
+	case svcapitypes.FileShareStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcapitypes.FileShareStatusDeleting, svcapitypes.FileShareStatusForceDelete:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(&cr.Spec.ForProvider, info)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(cr.Spec.ForProvider, info),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func attributes(info *svcsdk.NFSFileShareInfo) storagegateway.FileShareAttributes {
+	return storagegateway.FileShareAttributes{
+		AuditDestinationARN:  info.AuditDestinationARN,
+		CacheAttributes:      info.CacheAttributes,
+		DefaultStorageClass:  info.DefaultStorageClass,
+		FileShareName:        info.FileShareName,
+		GuessMIMETypeEnabled: info.GuessMIMETypeEnabled,
+		KMSEncrypted:         info.KMSEncrypted,
+		KMSKey:               info.KMSKey,
+		NotificationPolicy:   info.NotificationPolicy,
+		ObjectACL:            info.ObjectACL,
+		ReadOnly:             info.ReadOnly,
+		RequesterPays:        info.RequesterPays,
+	}
+}
+
+func lateInitialize(p *svcapitypes.NFSFileShareParameters, info *svcsdk.NFSFileShareInfo) {
+	storagegateway.LateInitializeFileShare(&p.FileShareParameters, attributes(info))
+	p.ClientList = awsclient.LateInitializeStringPtrSlice(p.ClientList, info.ClientList)
+	p.Squash = awsclient.LateInitializeStringPtr(p.Squash, info.Squash)
+	if p.NFSFileShareDefaults == nil && info.NFSFileShareDefaults != nil {
+		p.NFSFileShareDefaults = &svcapitypes.NFSFileShareDefaults{
+			DirectoryMode: info.NFSFileShareDefaults.DirectoryMode,
+			FileMode:      info.NFSFileShareDefaults.FileMode,
+			GroupID:       info.NFSFileShareDefaults.GroupId,
+			OwnerID:       info.NFSFileShareDefaults.OwnerId,
+		}
+	}
+}
+
+func isUpToDate(p svcapitypes.NFSFileShareParameters, info *svcsdk.NFSFileShareInfo) bool {
+	if !storagegateway.IsFileShareUpToDate(p.FileShareParameters, attributes(info)) {
+		return false
+	}
+	if awsclient.StringValue(p.Squash) != awsclient.StringValue(info.Squash) {
+		return false
+	}
+	if !cmp.Equal(generateDefaults(p.NFSFileShareDefaults), info.NFSFileShareDefaults, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(svcsdk.NFSFileShareDefaults{})) {
+		return false
+	}
+	return cmp.Equal(p.ClientList, info.ClientList, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *string) bool { return awsclient.StringValue(a) < awsclient.StringValue(b) }))
+}
+
+func generateDefaults(in *svcapitypes.NFSFileShareDefaults) *svcsdk.NFSFileShareDefaults {
+	if in == nil {
+		return nil
+	}
+	return &svcsdk.NFSFileShareDefaults{
+		DirectoryMode: in.DirectoryMode,
+		FileMode:      in.FileMode,
+		GroupId:       in.GroupID,
+		OwnerId:       in.OwnerID,
+	}
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.NFSFileShare)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	resp, err := e.client.CreateNFSFileShareWithContext(ctx, &svcsdk.CreateNFSFileShareInput{
+		// The client token makes retries of a create idempotent.
+		ClientToken:          awsclient.String(string(cr.GetUID())),
+		GatewayARN:           p.GatewayARN,
+		LocationARN:          p.LocationARN,
+		Role:                 p.Role,
+		BucketRegion:         p.BucketRegion,
+		VPCEndpointDNSName:   p.VPCEndpointDNSName,
+		AuditDestinationARN:  p.AuditDestinationARN,
+		CacheAttributes:      storagegateway.GenerateCacheAttributes(p.CacheAttributes),
+		ClientList:           p.ClientList,
+		DefaultStorageClass:  p.DefaultStorageClass,
+		FileShareName:        p.FileShareName,
+		GuessMIMETypeEnabled: p.GuessMIMETypeEnabled,
+		KMSEncrypted:         p.KMSEncrypted,
+		KMSKey:               p.KMSKey,
+		NFSFileShareDefaults: generateDefaults(p.NFSFileShareDefaults),
+		NotificationPolicy:   p.NotificationPolicy,
+		ObjectACL:            p.ObjectACL,
+		ReadOnly:             p.ReadOnly,
+		RequesterPays:        p.RequesterPays,
+		Squash:               p.Squash,
+		Tags:                 storagegateway.GenerateTags(p.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.FileShareARN))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.NFSFileShare)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// File shares can only be updated while they are available.
+	if awsclient.StringValue(cr.Status.AtProvider.FileShareStatus) != svcapitypes.FileShareStatusAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+	p := cr.Spec.ForProvider
+	_, err := e.client.UpdateNFSFileShareWithContext(ctx, &svcsdk.UpdateNFSFileShareInput{
+		FileShareARN:         awsclient.String(meta.GetExternalName(cr)),
+		AuditDestinationARN:  p.AuditDestinationARN,
+		CacheAttributes:      storagegateway.GenerateCacheAttributes(p.CacheAttributes),
+		ClientList:           p.ClientList,
+		DefaultStorageClass:  p.DefaultStorageClass,
+		FileShareName:        p.FileShareName,
+		GuessMIMETypeEnabled: p.GuessMIMETypeEnabled,
+		KMSEncrypted:         p.KMSEncrypted,
+		KMSKey:               p.KMSKey,
+		NFSFileShareDefaults: generateDefaults(p.NFSFileShareDefaults),
+		NotificationPolicy:   p.NotificationPolicy,
+		ObjectACL:            p.ObjectACL,
+		ReadOnly:             p.ReadOnly,
+		RequesterPays:        p.RequesterPays,
+		Squash:               p.Squash,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.NFSFileShare)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	switch awsclient.StringValue(cr.Status.AtProvider.FileShareStatus) {
+	case svcapitypes.FileShareStatusDeleting, svcapitypes.FileShareStatusForceDelete:
+		return nil
+	}
+	_, err := e.client.DeleteFileShareWithContext(ctx, &svcsdk.DeleteFileShareInput{
+		FileShareARN: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(err, errDelete)
+}
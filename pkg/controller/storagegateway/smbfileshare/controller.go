@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package smbfileshare
+
+import (
+	"context"
+
+	svcapi "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdk "github.com/aws/aws-sdk-go/service/storagegateway"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/storagegateway/storagegatewayiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/storagegateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/storagegateway"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an SMBFileShare resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create SMBFileShare in AWS"
+	errUpdate        = "cannot update SMBFileShare in AWS"
+	errDelete        = "cannot delete SMBFileShare in AWS"
+	errDescribe      = "failed to describe SMBFileShare"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.SMBFileShare)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.StorageGatewayAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.SMBFileShare)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The ARN of a file share is only known once it is created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	fs, err := storagegateway.GetFileShare(ctx, e.client, awsclient.StringValue(cr.Spec.ForProvider.GatewayARN), meta.GetExternalName(cr))
+	if err != nil || fs == nil {
+		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(err, errDescribe)
+	}
+	resp, err := e.client.DescribeSMBFileSharesWithContext(ctx, &svcsdk.DescribeSMBFileSharesInput{
+		FileShareARNList: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	if err != nil || len(resp.SMBFileShareInfoList) == 0 {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	info := resp.SMBFileShareInfoList[0]
+	cr.Status.AtProvider.FileShareObservation = svcapitypes.FileShareObservation{
+		FileShareARN:    info.FileShareARN,
+		FileShareID:     info.FileShareId,
+		FileShareStatus: info.FileShareStatus,
+		Path:            info.Path,
+	}
+	switch awsclient.StringValue(info.FileShareStatus) {
+	case svcapitypes.FileShareStatusAvailable:
+		cr.SetConditions(xpv1.Available())
+	case svcapitypes.FileShareStatusCreating:
+		cr.SetConditions(xpv1.Creating())
+	case svcapitypes.FileShareStatusDeleting, svcapitypes.FileShareStatusForceDelete:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	lateInitialize(&cr.Spec.ForProvider, info)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(cr.Spec.ForProvider, info),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func attributes(info *svcsdk.SMBFileShareInfo) storagegateway.FileShareAttributes {
+	return storagegateway.FileShareAttributes{
+		AuditDestinationARN:  info.AuditDestinationARN,
+		CacheAttributes:      info.CacheAttributes,
+		DefaultStorageClass:  info.DefaultStorageClass,
+		FileShareName:        info.FileShareName,
+		GuessMIMETypeEnabled: info.GuessMIMETypeEnabled,
+		KMSEncrypted:         info.KMSEncrypted,
+		KMSKey:               info.KMSKey,
+		NotificationPolicy:   info.NotificationPolicy,
+		ObjectACL:            info.ObjectACL,
+		ReadOnly:             info.ReadOnly,
+		RequesterPays:        info.RequesterPays,
+	}
+}
+
+func lateInitialize(p *svcapitypes.SMBFileShareParameters, info *svcsdk.SMBFileShareInfo) {
+	storagegateway.LateInitializeFileShare(&p.FileShareParameters, attributes(info))
+	p.AccessBasedEnumeration = awsclient.LateInitializeBoolPtr(p.AccessBasedEnumeration, info.AccessBasedEnumeration)
+	p.AdminUserList = awsclient.LateInitializeStringPtrSlice(p.AdminUserList, info.AdminUserList)
+	p.Authentication = awsclient.LateInitializeStringPtr(p.Authentication, info.Authentication)
+	p.CaseSensitivity = awsclient.LateInitializeStringPtr(p.CaseSensitivity, info.CaseSensitivity)
+	p.InvalidUserList = awsclient.LateInitializeStringPtrSlice(p.InvalidUserList, info.InvalidUserList)
+	p.OplocksEnabled = awsclient.LateInitializeBoolPtr(p.OplocksEnabled, info.OplocksEnabled)
+	p.SMBACLEnabled = awsclient.LateInitializeBoolPtr(p.SMBACLEnabled, info.SMBACLEnabled)
+	p.ValidUserList = awsclient.LateInitializeStringPtrSlice(p.ValidUserList, info.ValidUserList)
+}
+
+func isUpToDate(p svcapitypes.SMBFileShareParameters, info *svcsdk.SMBFileShareInfo) bool {
+	if !storagegateway.IsFileShareUpToDate(p.FileShareParameters, attributes(info)) {
+		return false
+	}
+	switch {
+	case awsclient.BoolValue(p.AccessBasedEnumeration) != awsclient.BoolValue(info.AccessBasedEnumeration),
+		awsclient.StringValue(p.CaseSensitivity) != awsclient.StringValue(info.CaseSensitivity),
+		awsclient.BoolValue(p.OplocksEnabled) != awsclient.BoolValue(info.OplocksEnabled),
+		awsclient.BoolValue(p.SMBACLEnabled) != awsclient.BoolValue(info.SMBACLEnabled):
+		return false
+	}
+	return isUserListUpToDate(p.AdminUserList, info.AdminUserList) &&
+		isUserListUpToDate(p.InvalidUserList, info.InvalidUserList) &&
+		isUserListUpToDate(p.ValidUserList, info.ValidUserList)
+}
+
+func isUserListUpToDate(desired, observed []*string) bool {
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *string) bool { return awsclient.StringValue(a) < awsclient.StringValue(b) }))
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.SMBFileShare)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	p := cr.Spec.ForProvider
+	resp, err := e.client.CreateSMBFileShareWithContext(ctx, &svcsdk.CreateSMBFileShareInput{
+		// The client token makes retries of a create idempotent.
+		ClientToken:            awsclient.String(string(cr.GetUID())),
+		GatewayARN:             p.GatewayARN,
+		LocationARN:            p.LocationARN,
+		Role:                   p.Role,
+		BucketRegion:           p.BucketRegion,
+		VPCEndpointDNSName:     p.VPCEndpointDNSName,
+		AuditDestinationARN:    p.AuditDestinationARN,
+		CacheAttributes:        storagegateway.GenerateCacheAttributes(p.CacheAttributes),
+		DefaultStorageClass:    p.DefaultStorageClass,
+		FileShareName:          p.FileShareName,
+		GuessMIMETypeEnabled:   p.GuessMIMETypeEnabled,
+		KMSEncrypted:           p.KMSEncrypted,
+		KMSKey:                 p.KMSKey,
+		NotificationPolicy:     p.NotificationPolicy,
+		ObjectACL:              p.ObjectACL,
+		ReadOnly:               p.ReadOnly,
+		RequesterPays:          p.RequesterPays,
+		Authentication:         p.Authentication,
+		AccessBasedEnumeration: p.AccessBasedEnumeration,
+		AdminUserList:          p.AdminUserList,
+		CaseSensitivity:        p.CaseSensitivity,
+		InvalidUserList:        p.InvalidUserList,
+		OplocksEnabled:         p.OplocksEnabled,
+		SMBACLEnabled:          p.SMBACLEnabled,
+		ValidUserList:          p.ValidUserList,
+		Tags:                   storagegateway.GenerateTags(p.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.FileShareARN))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.SMBFileShare)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// File shares can only be updated while they are available.
+	if awsclient.StringValue(cr.Status.AtProvider.FileShareStatus) != svcapitypes.FileShareStatusAvailable {
+		return managed.ExternalUpdate{}, nil
+	}
+	p := cr.Spec.ForProvider
+	_, err := e.client.UpdateSMBFileShareWithContext(ctx, &svcsdk.UpdateSMBFileShareInput{
+		FileShareARN:           awsclient.String(meta.GetExternalName(cr)),
+		AuditDestinationARN:    p.AuditDestinationARN,
+		CacheAttributes:        storagegateway.GenerateCacheAttributes(p.CacheAttributes),
+		DefaultStorageClass:    p.DefaultStorageClass,
+		FileShareName:          p.FileShareName,
+		GuessMIMETypeEnabled:   p.GuessMIMETypeEnabled,
+		KMSEncrypted:           p.KMSEncrypted,
+		KMSKey:                 p.KMSKey,
+		NotificationPolicy:     p.NotificationPolicy,
+		ObjectACL:              p.ObjectACL,
+		ReadOnly:               p.ReadOnly,
+		RequesterPays:          p.RequesterPays,
+		AccessBasedEnumeration: p.AccessBasedEnumeration,
+		AdminUserList:          p.AdminUserList,
+		CaseSensitivity:        p.CaseSensitivity,
+		InvalidUserList:        p.InvalidUserList,
+		OplocksEnabled:         p.OplocksEnabled,
+		SMBACLEnabled:          p.SMBACLEnabled,
+		ValidUserList:          p.ValidUserList,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.SMBFileShare)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	switch awsclient.StringValue(cr.Status.AtProvider.FileShareStatus) {
+	case svcapitypes.FileShareStatusDeleting, svcapitypes.FileShareStatusForceDelete:
+		return nil
+	}
+	_, err := e.client.DeleteFileShareWithContext(ctx, &svcsdk.DeleteFileShareInput{
+		FileShareARN: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(err, errDelete)
+}
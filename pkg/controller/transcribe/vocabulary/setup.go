@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vocabulary
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/transcribeservice"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/transcribe/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupVocabulary adds a controller that reconciles Vocabulary.
+func SetupVocabulary(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.VocabularyGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Vocabulary{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.VocabularyGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Vocabulary, obj *svcsdk.GetVocabularyInput) error {
+	obj.VocabularyName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Vocabulary, resp *svcsdk.GetVocabularyOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.VocabularyState) {
+	case string(svcapitypes.VocabularyState_READY):
+		cr.SetConditions(xpv1.Available())
+	case string(svcapitypes.VocabularyState_PENDING):
+		cr.SetConditions(xpv1.Creating())
+	case string(svcapitypes.VocabularyState_FAILED):
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclients.StringValue(resp.FailureReason)))
+	}
+	return obs, nil
+}
+
+// isUpToDate only compares the language code since the phrases and the file
+// URI are not returned by GetVocabulary.
+func isUpToDate(cr *svcapitypes.Vocabulary, resp *svcsdk.GetVocabularyOutput) (bool, error) {
+	// A vocabulary cannot be updated while it is being processed.
+	if awsclients.StringValue(resp.VocabularyState) == string(svcapitypes.VocabularyState_PENDING) {
+		return true, nil
+	}
+	return awsclients.StringValue(cr.Spec.ForProvider.LanguageCode) == awsclients.StringValue(resp.LanguageCode), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Vocabulary, obj *svcsdk.CreateVocabularyInput) error {
+	obj.VocabularyName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Vocabulary, obj *svcsdk.UpdateVocabularyInput) error {
+	obj.VocabularyName = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Vocabulary, obj *svcsdk.DeleteVocabularyInput) (bool, error) {
+	obj.VocabularyName = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package paralleldata
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/translate"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/translate/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupParallelData adds a controller that reconciles ParallelData.
+func SetupParallelData(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ParallelDataGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ParallelData{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.ParallelDataGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.ParallelData, obj *svcsdk.GetParallelDataInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.ParallelData, resp *svcsdk.GetParallelDataOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(resp.ParallelDataProperties.Status) {
+	case svcsdk.ParallelDataStatusActive:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.ParallelDataStatusCreating, svcsdk.ParallelDataStatusUpdating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.ParallelDataStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.ParallelDataStatusFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.ParallelData, resp *svcsdk.GetParallelDataOutput) (bool, error) {
+	props := resp.ParallelDataProperties
+	// An update can only be started once the previous one has finished.
+	if awsclients.StringValue(props.Status) != svcsdk.ParallelDataStatusActive ||
+		awsclients.StringValue(props.LatestUpdateAttemptStatus) == svcsdk.ParallelDataStatusUpdating {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	if awsclients.StringValue(p.Description) != awsclients.StringValue(props.Description) {
+		return false, nil
+	}
+	if p.ParallelDataConfig == nil || props.ParallelDataConfig == nil {
+		return p.ParallelDataConfig == nil && props.ParallelDataConfig == nil, nil
+	}
+	return awsclients.StringValue(p.ParallelDataConfig.S3URI) == awsclients.StringValue(props.ParallelDataConfig.S3Uri) &&
+		awsclients.StringValue(p.ParallelDataConfig.Format) == awsclients.StringValue(props.ParallelDataConfig.Format), nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.ParallelData, obj *svcsdk.CreateParallelDataInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.ParallelData, obj *svcsdk.UpdateParallelDataInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.ParallelData, obj *svcsdk.DeleteParallelDataInput) (bool, error) {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/wellarchitected"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/wellarchitected/wellarchitectediface"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/wellarchitected/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errAssociateLenses    = "cannot associate lenses"
+	errDisassociateLenses = "cannot disassociate lenses"
+	errTagResource        = "cannot tag resource"
+	errUntagResource      = "cannot untag resource"
+)
+
+// SetupWorkload adds a controller that reconciles Workload.
+func SetupWorkload(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.WorkloadGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = h.postUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Workload{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.WorkloadGroupVersionKind),
+			managed.WithInitializers(),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.WellArchitectedAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Workload, obj *svcsdk.GetWorkloadInput) error {
+	obj.WorkloadId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Workload, _ *svcsdk.GetWorkloadOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Workload, resp *svcsdk.GetWorkloadOutput) (bool, error) {
+	observed := GenerateWorkload(resp).Spec.ForProvider
+	observed.Region = cr.Spec.ForProvider.Region
+	observed.Tags = cr.Spec.ForProvider.Tags
+	observed.Lenses = cr.Spec.ForProvider.Lenses
+	observed.CustomWorkloadParameters = cr.Spec.ForProvider.CustomWorkloadParameters
+	if upToDate, err := awsclients.IsJSONSubset(cr.Spec.ForProvider, observed); err != nil || !upToDate {
+		return upToDate, err
+	}
+	if add, remove := diffLenses(cr.Spec.ForProvider.Lenses, resp.Workload.Lenses); len(add) != 0 || len(remove) != 0 {
+		return false, nil
+	}
+	add, remove := awsclients.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, resp.Workload.Tags)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Workload, obj *svcsdk.CreateWorkloadInput) error {
+	obj.WorkloadName = awsclients.String(cr.GetName())
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Workload, resp *svcsdk.CreateWorkloadOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.WorkloadId))
+	cre.ExternalNameAssigned = true
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.Workload, obj *svcsdk.UpdateWorkloadInput) error {
+	obj.WorkloadId = awsclients.String(meta.GetExternalName(cr))
+	obj.WorkloadName = awsclients.String(cr.GetName())
+	return nil
+}
+
+// postUpdate associates and disassociates lenses and tags, which
+// UpdateWorkload does not cover.
+func (h *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Workload, _ *svcsdk.UpdateWorkloadOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	id := awsclients.String(meta.GetExternalName(cr))
+	resp, err := h.client.GetWorkloadWithContext(ctx, &svcsdk.GetWorkloadInput{WorkloadId: id})
+	if err != nil {
+		return upd, awsclients.Wrap(err, errDescribe)
+	}
+	addLenses, removeLenses := diffLenses(cr.Spec.ForProvider.Lenses, resp.Workload.Lenses)
+	if len(addLenses) != 0 {
+		if _, err := h.client.AssociateLensesWithContext(ctx, &svcsdk.AssociateLensesInput{
+			WorkloadId:  id,
+			LensAliases: addLenses,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errAssociateLenses)
+		}
+	}
+	if len(removeLenses) != 0 {
+		if _, err := h.client.DisassociateLensesWithContext(ctx, &svcsdk.DisassociateLensesInput{
+			WorkloadId:  id,
+			LensAliases: removeLenses,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errDisassociateLenses)
+		}
+	}
+	addTags, removeTags := awsclients.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, resp.Workload.Tags)
+	if len(removeTags) != 0 {
+		if _, err := h.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			WorkloadArn: resp.Workload.WorkloadArn,
+			TagKeys:     removeTags,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errUntagResource)
+		}
+	}
+	if len(addTags) != 0 {
+		if _, err := h.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			WorkloadArn: resp.Workload.WorkloadArn,
+			Tags:        addTags,
+		}); err != nil {
+			return upd, awsclients.Wrap(err, errTagResource)
+		}
+	}
+	return upd, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Workload, obj *svcsdk.DeleteWorkloadInput) (bool, error) {
+	obj.WorkloadId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+// diffLenses returns the aliases of the lenses that have to be associated
+// with and disassociated from the workload.
+func diffLenses(spec, current []*string) (add, remove []*string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, l := range current {
+		currentSet[awsclients.StringValue(l)] = struct{}{}
+	}
+	specSet := make(map[string]struct{}, len(spec))
+	for _, l := range spec {
+		alias := awsclients.StringValue(l)
+		specSet[alias] = struct{}{}
+		if _, ok := currentSet[alias]; !ok {
+			add = append(add, awsclients.String(alias))
+		}
+	}
+	for alias := range currentSet {
+		if _, ok := specSet[alias]; !ok {
+			remove = append(remove, awsclients.String(alias))
+		}
+	}
+	sort.Slice(remove, func(i, j int) bool { return *remove[i] < *remove[j] })
+	return add, remove
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/wellarchitected"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/wellarchitected/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	lensWellArchitected = "wellarchitected"
+	lensServerless      = "serverless"
+	lensSaaS            = "softwareasaservice"
+	reviewOwner         = "platform-team"
+)
+
+func TestDiffLenses(t *testing.T) {
+	type want struct {
+		add    []*string
+		remove []*string
+	}
+	cases := map[string]struct {
+		spec    []*string
+		current []*string
+		want    want
+	}{
+		"Equal": {
+			spec:    []*string{awsclients.String(lensWellArchitected), awsclients.String(lensServerless)},
+			current: []*string{awsclients.String(lensServerless), awsclients.String(lensWellArchitected)},
+			want:    want{},
+		},
+		"AddAndRemove": {
+			spec:    []*string{awsclients.String(lensWellArchitected), awsclients.String(lensSaaS)},
+			current: []*string{awsclients.String(lensWellArchitected), awsclients.String(lensServerless)},
+			want: want{
+				add:    []*string{awsclients.String(lensSaaS)},
+				remove: []*string{awsclients.String(lensServerless)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := diffLenses(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec svcapitypes.WorkloadParameters
+		obs  *svcsdk.Workload
+		want bool
+	}{
+		"UpToDate": {
+			spec: svcapitypes.WorkloadParameters{
+				Region:      "us-east-1",
+				Lenses:      []*string{awsclients.String(lensServerless), awsclients.String(lensWellArchitected)},
+				ReviewOwner: awsclients.String(reviewOwner),
+				Tags:        map[string]*string{"env": awsclients.String("dev")},
+			},
+			obs: &svcsdk.Workload{
+				Lenses:      []*string{awsclients.String(lensWellArchitected), awsclients.String(lensServerless)},
+				ReviewOwner: awsclients.String(reviewOwner),
+				Tags:        map[string]*string{"env": awsclients.String("dev")},
+			},
+			want: true,
+		},
+		"ReviewOwnerChanged": {
+			spec: svcapitypes.WorkloadParameters{
+				Lenses:      []*string{awsclients.String(lensWellArchitected)},
+				ReviewOwner: awsclients.String(reviewOwner),
+			},
+			obs: &svcsdk.Workload{
+				Lenses:      []*string{awsclients.String(lensWellArchitected)},
+				ReviewOwner: awsclients.String("someone-else"),
+			},
+			want: false,
+		},
+		"LensAdded": {
+			spec: svcapitypes.WorkloadParameters{
+				Lenses: []*string{awsclients.String(lensWellArchitected), awsclients.String(lensServerless)},
+			},
+			obs: &svcsdk.Workload{
+				Lenses: []*string{awsclients.String(lensWellArchitected)},
+			},
+			want: false,
+		},
+		"TagRemoved": {
+			spec: svcapitypes.WorkloadParameters{
+				Lenses: []*string{awsclients.String(lensWellArchitected)},
+			},
+			obs: &svcsdk.Workload{
+				Lenses: []*string{awsclients.String(lensWellArchitected)},
+				Tags:   map[string]*string{"env": awsclients.String("dev")},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Workload{Spec: svcapitypes.WorkloadSpec{ForProvider: tc.spec}}
+			got, err := isUpToDate(cr, &svcsdk.GetWorkloadOutput{Workload: tc.obs})
+			if err != nil {
+				t.Fatalf("isUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
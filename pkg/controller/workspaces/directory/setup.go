@@ -0,0 +1,197 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directory
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/workspaces"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/workspaces/workspacesiface"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errAssociateIPGroups    = "cannot associate IP groups with Directory"
+	errDisassociateIPGroups = "cannot disassociate IP groups from Directory"
+)
+
+// SetupDirectory adds a controller that reconciles Directory.
+func SetupDirectory(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DirectoryGroupKind)
+	opts := []option{
+		func(e *external) {
+			h := &hooks{client: e.client}
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.update = h.update
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.Directory{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.DirectoryGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type hooks struct {
+	client svcsdkapi.WorkSpacesAPI
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.Directory, obj *svcsdk.DescribeWorkspaceDirectoriesInput) error {
+	obj.DirectoryIds = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func filterList(cr *svcapitypes.Directory, list *svcsdk.DescribeWorkspaceDirectoriesOutput) *svcsdk.DescribeWorkspaceDirectoriesOutput {
+	for _, d := range list.Directories {
+		// Deregistered directories are still listed for a while.
+		if awsclients.StringValue(d.DirectoryId) == meta.GetExternalName(cr) &&
+			awsclients.StringValue(d.State) != svcsdk.WorkspaceDirectoryStateDeregistered {
+			return &svcsdk.DescribeWorkspaceDirectoriesOutput{Directories: []*svcsdk.WorkspaceDirectory{d}}
+		}
+	}
+	return &svcsdk.DescribeWorkspaceDirectoriesOutput{}
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.Directory, resp *svcsdk.DescribeWorkspaceDirectoriesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	switch awsclients.StringValue(cr.Status.AtProvider.State) {
+	case svcsdk.WorkspaceDirectoryStateRegistered:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.WorkspaceDirectoryStateRegistering:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.WorkspaceDirectoryStateDeregistering:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.WorkspaceDirectoryStateError:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"registrationCode": []byte(awsclients.StringValue(cr.Status.AtProvider.RegistrationCode)),
+	}
+	return obs, nil
+}
+
+func isUpToDate(cr *svcapitypes.Directory, resp *svcsdk.DescribeWorkspaceDirectoriesOutput) (bool, error) {
+	add, remove := diffIPGroups(cr.Spec.ForProvider.IPGroupIDs, resp.Directories[0].IpGroupIds)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+// diffIPGroups returns the IP groups that have to be associated with and
+// disassociated from the directory.
+func diffIPGroups(desired []string, observed []*string) (add, remove []*string) {
+	current := map[string]bool{}
+	for _, id := range observed {
+		current[awsclients.StringValue(id)] = true
+	}
+	for _, id := range desired {
+		if !current[id] {
+			add = append(add, awsclients.String(id))
+		}
+		delete(current, id)
+	}
+	for _, id := range observed {
+		if current[awsclients.StringValue(id)] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.Directory, obj *svcsdk.RegisterWorkspaceDirectoryInput) error {
+	obj.DirectoryId = awsclients.String(cr.Spec.ForProvider.DirectoryID)
+	for i := range cr.Spec.ForProvider.SubnetIDs {
+		obj.SubnetIds = append(obj.SubnetIds, awsclients.String(cr.Spec.ForProvider.SubnetIDs[i]))
+	}
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.Directory, _ *svcsdk.RegisterWorkspaceDirectoryOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.DirectoryID)
+	return cre, nil
+}
+
+// update associates and disassociates IP groups, which is the only change
+// of a registered directory that is reconciled.
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Directory)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := h.client.DescribeWorkspaceDirectoriesWithContext(ctx, &svcsdk.DescribeWorkspaceDirectoriesInput{
+		DirectoryIds: []*string{awsclients.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribe)
+	}
+	resp = filterList(cr, resp)
+	if len(resp.Directories) == 0 {
+		return managed.ExternalUpdate{}, errors.New(errDescribe)
+	}
+	add, remove := diffIPGroups(cr.Spec.ForProvider.IPGroupIDs, resp.Directories[0].IpGroupIds)
+	if len(add) > 0 {
+		if _, err := h.client.AssociateIpGroupsWithContext(ctx, &svcsdk.AssociateIpGroupsInput{
+			DirectoryId: awsclients.String(meta.GetExternalName(cr)),
+			GroupIds:    add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errAssociateIPGroups)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := h.client.DisassociateIpGroupsWithContext(ctx, &svcsdk.DisassociateIpGroupsInput{
+			DirectoryId: awsclients.String(meta.GetExternalName(cr)),
+			GroupIds:    remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errDisassociateIPGroups)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.Directory, obj *svcsdk.DeregisterWorkspaceDirectoryInput) (bool, error) {
+	if awsclients.StringValue(cr.Status.AtProvider.State) == svcsdk.WorkspaceDirectoryStateDeregistering {
+		return true, nil
+	}
+	obj.DirectoryId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
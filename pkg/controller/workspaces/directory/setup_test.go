@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package directory
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestDiffIPGroups(t *testing.T) {
+	type want struct {
+		add    []*string
+		remove []*string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []*string
+		want
+	}{
+		"NoChange": {
+			desired:  []string{"a", "b"},
+			observed: []*string{awsclients.String("b"), awsclients.String("a")},
+		},
+		"Add": {
+			desired:  []string{"a", "b"},
+			observed: []*string{awsclients.String("a")},
+			want:     want{add: []*string{awsclients.String("b")}},
+		},
+		"Remove": {
+			observed: []*string{awsclients.String("a")},
+			want:     want{remove: []*string{awsclients.String("a")}},
+		},
+		"Replace": {
+			desired:  []string{"b"},
+			observed: []*string{awsclients.String("a")},
+			want: want{
+				add:    []*string{awsclients.String("b")},
+				remove: []*string{awsclients.String("a")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := diffIPGroups(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
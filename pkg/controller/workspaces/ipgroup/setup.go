@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipgroup
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupIPGroup adds a controller that reconciles IPGroup.
+func SetupIPGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.IPGroupGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.IPGroup{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.IPGroupGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.IPGroup, obj *svcsdk.DescribeIpGroupsInput) error {
+	obj.GroupIds = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func filterList(cr *svcapitypes.IPGroup, list *svcsdk.DescribeIpGroupsOutput) *svcsdk.DescribeIpGroupsOutput {
+	for _, g := range list.Result {
+		if awsclients.StringValue(g.GroupId) == meta.GetExternalName(cr) {
+			return &svcsdk.DescribeIpGroupsOutput{Result: []*svcsdk.IpGroup{g}}
+		}
+	}
+	return &svcsdk.DescribeIpGroupsOutput{}
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.IPGroup, _ *svcsdk.DescribeIpGroupsOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate compares the rules of the group regardless of their order. The
+// name and the description of a group cannot be changed.
+func isUpToDate(cr *svcapitypes.IPGroup, resp *svcsdk.DescribeIpGroupsOutput) (bool, error) {
+	observed := GenerateIPGroup(resp).Spec.ForProvider.UserRules
+	return cmp.Equal(cr.Spec.ForProvider.UserRules, observed, cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b *svcapitypes.IPRuleItem) bool {
+			return awsclients.StringValue(a.IPRule) < awsclients.StringValue(b.IPRule)
+		})), nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.IPGroup, resp *svcsdk.CreateIpGroupOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.GroupId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.IPGroup, obj *svcsdk.UpdateRulesOfIpGroupInput) error {
+	obj.GroupId = awsclients.String(meta.GetExternalName(cr))
+	if obj.UserRules == nil {
+		obj.UserRules = []*svcsdk.IpRuleItem{}
+	}
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.IPGroup, obj *svcsdk.DeleteIpGroupInput) (bool, error) {
+	obj.GroupId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
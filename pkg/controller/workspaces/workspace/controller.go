@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+
+	svcapi "github.com/aws/aws-sdk-go/service/workspaces"
+	svcsdk "github.com/aws/aws-sdk-go/service/workspaces"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/workspaces/workspacesiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Workspace resource"
+
+	errCreateSession = "cannot create a new session"
+	errCreate        = "cannot create Workspace in AWS"
+	errUpdate        = "cannot update Workspace in AWS"
+	errDescribe      = "failed to describe Workspace"
+	errDelete        = "failed to delete Workspace"
+)
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg cpresource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Workspace)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcapi.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.WorkSpacesAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Workspace)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	resp, err := e.client.DescribeWorkspacesWithContext(ctx, &svcsdk.DescribeWorkspacesInput{
+		WorkspaceIds: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	// Terminated WorkSpaces are still described for a while.
+	if len(resp.Workspaces) == 0 || awsclient.StringValue(resp.Workspaces[0].State) == svcsdk.WorkspaceStateTerminated {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	w := resp.Workspaces[0]
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	LateInitialize(&cr.Spec.ForProvider, w)
+	cr.Status.AtProvider = GenerateObservation(w)
+
+	switch awsclient.StringValue(w.State) {
+	case svcsdk.WorkspaceStateAvailable, svcsdk.WorkspaceStateStopped:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.WorkspaceStatePending:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.WorkspaceStateTerminating:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.WorkspaceStateError, svcsdk.WorkspaceStateUnhealthy, svcsdk.WorkspaceStateImpaired:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(awsclient.StringValue(w.ErrorMessage)))
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate := true
+	// Properties can only be modified while the WorkSpace is not in
+	// transition.
+	if s := awsclient.StringValue(w.State); s == svcsdk.WorkspaceStateAvailable || s == svcsdk.WorkspaceStateStopped {
+		if upToDate, err = IsUpToDate(cr.Spec.ForProvider, w); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, "isUpToDate check failed")
+		}
+	}
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
+		ConnectionDetails: managed.ConnectionDetails{
+			"ipAddress":    []byte(awsclient.StringValue(w.IpAddress)),
+			"computerName": []byte(awsclient.StringValue(w.ComputerName)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Workspace)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateWorkspacesWithContext(ctx, &svcsdk.CreateWorkspacesInput{
+		Workspaces: []*svcsdk.WorkspaceRequest{GenerateWorkspaceRequest(cr)},
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if len(resp.FailedRequests) > 0 {
+		f := resp.FailedRequests[0]
+		return managed.ExternalCreation{}, errors.Errorf("%s: %s: %s", errCreate, awsclient.StringValue(f.ErrorCode), awsclient.StringValue(f.ErrorMessage))
+	}
+	if len(resp.PendingRequests) == 0 {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.PendingRequests[0].WorkspaceId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg cpresource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Workspace)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.ModifyWorkspacePropertiesWithContext(ctx, &svcsdk.ModifyWorkspacePropertiesInput{
+		WorkspaceId:         awsclient.String(meta.GetExternalName(cr)),
+		WorkspaceProperties: GenerateWorkspaceProperties(cr.Spec.ForProvider.WorkspaceProperties),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg cpresource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Workspace)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	if awsclient.StringValue(cr.Status.AtProvider.State) == svcsdk.WorkspaceStateTerminating {
+		return nil
+	}
+	resp, err := e.client.TerminateWorkspacesWithContext(ctx, &svcsdk.TerminateWorkspacesInput{
+		TerminateWorkspaceRequests: []*svcsdk.TerminateRequest{{WorkspaceId: awsclient.String(meta.GetExternalName(cr))}},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDelete)
+	}
+	if len(resp.FailedRequests) > 0 {
+		f := resp.FailedRequests[0]
+		return errors.Errorf("%s: %s: %s", errDelete, awsclient.StringValue(f.ErrorCode), awsclient.StringValue(f.ErrorMessage))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	svcsdk "github.com/aws/aws-sdk-go/service/workspaces"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// GenerateWorkspaceRequest returns the request to create the given Workspace.
+func GenerateWorkspaceRequest(cr *svcapitypes.Workspace) *svcsdk.WorkspaceRequest {
+	p := cr.Spec.ForProvider
+	res := &svcsdk.WorkspaceRequest{
+		BundleId:                    p.BundleID,
+		DirectoryId:                 p.DirectoryID,
+		RootVolumeEncryptionEnabled: p.RootVolumeEncryptionEnabled,
+		UserName:                    p.UserName,
+		UserVolumeEncryptionEnabled: p.UserVolumeEncryptionEnabled,
+		VolumeEncryptionKey:         p.VolumeEncryptionKey,
+		WorkspaceProperties:         GenerateWorkspaceProperties(p.WorkspaceProperties),
+	}
+	for _, t := range p.Tags {
+		res.Tags = append(res.Tags, &svcsdk.Tag{Key: t.Key, Value: t.Value})
+	}
+	return res
+}
+
+// GenerateWorkspaceProperties returns the SDK representation of the given
+// properties.
+func GenerateWorkspaceProperties(in *svcapitypes.WorkspaceProperties) *svcsdk.WorkspaceProperties {
+	if in == nil {
+		return nil
+	}
+	return &svcsdk.WorkspaceProperties{
+		ComputeTypeName:                     in.ComputeTypeName,
+		RootVolumeSizeGib:                   in.RootVolumeSizeGib,
+		RunningMode:                         in.RunningMode,
+		RunningModeAutoStopTimeoutInMinutes: in.RunningModeAutoStopTimeoutInMinutes,
+		UserVolumeSizeGib:                   in.UserVolumeSizeGib,
+	}
+}
+
+// GenerateObservation returns the observation of the given WorkSpace.
+func GenerateObservation(w *svcsdk.Workspace) svcapitypes.WorkspaceObservation {
+	return svcapitypes.WorkspaceObservation{
+		ComputerName: w.ComputerName,
+		ErrorCode:    w.ErrorCode,
+		ErrorMessage: w.ErrorMessage,
+		IPAddress:    w.IpAddress,
+		State:        w.State,
+		SubnetID:     w.SubnetId,
+		WorkspaceID:  w.WorkspaceId,
+	}
+}
+
+// LateInitialize fills the empty fields of the given parameters with the
+// values of the observed WorkSpace.
+func LateInitialize(p *svcapitypes.WorkspaceParameters, w *svcsdk.Workspace) {
+	p.RootVolumeEncryptionEnabled = awsclients.LateInitializeBoolPtr(p.RootVolumeEncryptionEnabled, w.RootVolumeEncryptionEnabled)
+	p.UserVolumeEncryptionEnabled = awsclients.LateInitializeBoolPtr(p.UserVolumeEncryptionEnabled, w.UserVolumeEncryptionEnabled)
+	p.VolumeEncryptionKey = awsclients.LateInitializeStringPtr(p.VolumeEncryptionKey, w.VolumeEncryptionKey)
+	if w.WorkspaceProperties == nil {
+		return
+	}
+	if p.WorkspaceProperties == nil {
+		p.WorkspaceProperties = &svcapitypes.WorkspaceProperties{}
+	}
+	wp, o := p.WorkspaceProperties, w.WorkspaceProperties
+	wp.ComputeTypeName = awsclients.LateInitializeStringPtr(wp.ComputeTypeName, o.ComputeTypeName)
+	wp.RootVolumeSizeGib = awsclients.LateInitializeInt64Ptr(wp.RootVolumeSizeGib, o.RootVolumeSizeGib)
+	wp.RunningMode = awsclients.LateInitializeStringPtr(wp.RunningMode, o.RunningMode)
+	wp.RunningModeAutoStopTimeoutInMinutes = awsclients.LateInitializeInt64Ptr(wp.RunningModeAutoStopTimeoutInMinutes, o.RunningModeAutoStopTimeoutInMinutes)
+	wp.UserVolumeSizeGib = awsclients.LateInitializeInt64Ptr(wp.UserVolumeSizeGib, o.UserVolumeSizeGib)
+}
+
+// IsUpToDate reports whether the properties of the observed WorkSpace match
+// the desired ones. Only the properties of a WorkSpace can be modified.
+func IsUpToDate(p svcapitypes.WorkspaceParameters, w *svcsdk.Workspace) (bool, error) {
+	if p.WorkspaceProperties == nil {
+		return true, nil
+	}
+	return awsclients.IsJSONSubset(GenerateWorkspaceProperties(p.WorkspaceProperties), w.WorkspaceProperties)
+}
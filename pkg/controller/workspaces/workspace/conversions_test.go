@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		p svcapitypes.WorkspaceParameters
+		w *svcsdk.Workspace
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoProperties": {
+			args: args{
+				w: &svcsdk.Workspace{WorkspaceProperties: &svcsdk.WorkspaceProperties{RunningMode: awsclients.String("ALWAYS_ON")}},
+			},
+			want: true,
+		},
+		"SameSubset": {
+			args: args{
+				p: svcapitypes.WorkspaceParameters{WorkspaceProperties: &svcapitypes.WorkspaceProperties{RunningMode: awsclients.String("AUTO_STOP")}},
+				w: &svcsdk.Workspace{WorkspaceProperties: &svcsdk.WorkspaceProperties{
+					RunningMode:       awsclients.String("AUTO_STOP"),
+					RootVolumeSizeGib: awsclients.Int64(80),
+				}},
+			},
+			want: true,
+		},
+		"DifferentRunningMode": {
+			args: args{
+				p: svcapitypes.WorkspaceParameters{WorkspaceProperties: &svcapitypes.WorkspaceProperties{RunningMode: awsclients.String("AUTO_STOP")}},
+				w: &svcsdk.Workspace{WorkspaceProperties: &svcsdk.WorkspaceProperties{RunningMode: awsclients.String("ALWAYS_ON")}},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.args.p, tc.args.w)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspacebundle
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/workspaces"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/workspaces/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// SetupWorkspaceBundle adds a controller that reconciles WorkspaceBundle.
+func SetupWorkspaceBundle(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.WorkspaceBundleGroupKind)
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.WorkspaceBundle{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.WorkspaceBundleGroupVersionKind),
+			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithPollInterval(o.PollInterval),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.WorkspaceBundle, obj *svcsdk.DescribeWorkspaceBundlesInput) error {
+	obj.BundleIds = []*string{awsclients.String(meta.GetExternalName(cr))}
+	return nil
+}
+
+func filterList(cr *svcapitypes.WorkspaceBundle, list *svcsdk.DescribeWorkspaceBundlesOutput) *svcsdk.DescribeWorkspaceBundlesOutput {
+	for _, b := range list.Bundles {
+		if awsclients.StringValue(b.BundleId) == meta.GetExternalName(cr) {
+			return &svcsdk.DescribeWorkspaceBundlesOutput{Bundles: []*svcsdk.WorkspaceBundle{b}}
+		}
+	}
+	return &svcsdk.DescribeWorkspaceBundlesOutput{}
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.WorkspaceBundle, _ *svcsdk.DescribeWorkspaceBundlesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+// isUpToDate checks the image of the bundle, which is the only field that
+// can be updated.
+func isUpToDate(cr *svcapitypes.WorkspaceBundle, resp *svcsdk.DescribeWorkspaceBundlesOutput) (bool, error) {
+	return awsclients.StringValue(cr.Spec.ForProvider.ImageID) == awsclients.StringValue(resp.Bundles[0].ImageId), nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.WorkspaceBundle, resp *svcsdk.CreateWorkspaceBundleOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(resp.WorkspaceBundle.BundleId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.WorkspaceBundle, obj *svcsdk.UpdateWorkspaceBundleInput) error {
+	obj.BundleId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.WorkspaceBundle, obj *svcsdk.DeleteWorkspaceBundleInput) (bool, error) {
+	obj.BundleId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}